@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -3426,6 +3427,66 @@ var (
 				},
 				Action: gctScriptExecute,
 			},
+			{
+				Name:      "test",
+				Usage:     "dry run script filename against recorded candles without sending orders or withdrawals",
+				ArgsUsage: "<filename> <exchange> <pair> <asset> <interval> <start> <end>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:        "filename",
+						Usage:       "the script filename",
+						Destination: &filename,
+					},
+					&cli.StringFlag{
+						Name:        "path",
+						Usage:       "the directory of the script file",
+						Destination: &path,
+					},
+					&cli.StringFlag{
+						Name:    "exchange",
+						Aliases: []string{"e"},
+						Usage:   "the exchange to simulate",
+					},
+					&cli.StringFlag{
+						Name:    "pair",
+						Aliases: []string{"p"},
+						Usage:   "the currency pair to simulate",
+					},
+					&cli.StringFlag{
+						Name:    "asset",
+						Aliases: []string{"a"},
+						Usage:   "the asset type of the currency pair",
+					},
+					&cli.Int64Flag{
+						Name:        "interval",
+						Aliases:     []string{"i"},
+						Usage:       klineMessage,
+						Value:       86400,
+						Destination: &candleGranularity,
+					},
+					&cli.StringFlag{
+						Name:  "start",
+						Usage: "the date to begin the simulation, required when candles are loaded from the database",
+					},
+					&cli.StringFlag{
+						Name:  "end",
+						Usage: "the date to end the simulation, required when candles are loaded from the database",
+					},
+					&cli.StringFlag{
+						Name:  "datafile",
+						Usage: "a csv file of candles in the script directory of the server to simulate, candles are loaded from the database when not set",
+					},
+					&cli.StringFlag{
+						Name:  "balances",
+						Usage: "the starting balances e.g. 'USDT:1000,BTC:0.1', funds are not checked when not set",
+					},
+					&cli.BoolFlag{
+						Name:  "trace",
+						Usage: "include each module call made by the script and its result",
+					},
+				},
+				Action: gctScriptTest,
+			},
 			{
 				Name:  "query",
 				Usage: "query running virtual machine",
@@ -3604,6 +3665,131 @@ func gctScriptExecute(c *cli.Context) error {
 	return nil
 }
 
+func gctScriptTest(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	if !c.IsSet("filename") {
+		filename = c.Args().First()
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("interval") && c.Args().Get(4) != "" {
+		candleGranularity, err = strconv.ParseInt(c.Args().Get(4), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var start, end string
+	if c.IsSet("start") {
+		start = c.String("start")
+	} else {
+		start = c.Args().Get(5)
+	}
+	if start != "" {
+		s, err := time.ParseInLocation(time.DateTime, start, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		start = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if c.IsSet("end") {
+		end = c.String("end")
+	} else {
+		end = c.Args().Get(6)
+	}
+	if end != "" {
+		e, err := time.ParseInLocation(time.DateTime, end, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		end = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+
+	var balances map[string]float64
+	if c.IsSet("balances") {
+		balances = make(map[string]float64)
+		for b := range strings.SplitSeq(c.String("balances"), ",") {
+			code, amount, ok := strings.Cut(strings.TrimSpace(b), ":")
+			if !ok {
+				return fmt.Errorf("invalid balance %q, expected currency:amount", b)
+			}
+			balances[code], err = strconv.ParseFloat(amount, 64)
+			if err != nil {
+				return fmt.Errorf("invalid balance %q: %w", b, err)
+			}
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+
+	executeCommand, err := client.GCTScriptExecute(c.Context,
+		&gctrpc.GCTScriptExecuteRequest{
+			Script: &gctrpc.GCTScript{
+				Name: filename,
+				Path: path,
+			},
+			Simulation: &gctrpc.GCTScriptSimulation{
+				Exchange: exchangeName,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: p.Delimiter,
+					Base:      p.Base.String(),
+					Quote:     p.Quote.String(),
+				},
+				AssetType:    assetType,
+				TimeInterval: int64(time.Duration(candleGranularity) * time.Second),
+				Start:        start,
+				End:          end,
+				DataFile:     c.String("datafile"),
+				Balances:     balances,
+				Trace:        c.Bool("trace"),
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(executeCommand)
+	return nil
+}
+
 func gctScriptStatus(c *cli.Context) error {
 	conn, cancel, err := setupClient(c)
 	if err != nil {
@@ -4606,3 +4792,1077 @@ func getCurrencyTradeURL(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var getRateLimitStateCommand = &cli.Command{
+	Name:      "getratelimitstate",
+	Usage:     "gets the rate limiter state and exchange reported quotas for an exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getRateLimitState,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the rate limit state for",
+		},
+	},
+}
+
+func getRateLimitState(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRateLimitState(c.Context,
+		&gctrpc.GetRateLimitStateRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getExchangeHealthCommand = &cli.Command{
+	Name:      "getexchangehealth",
+	Usage:     "gets the rated health of an exchange, or of all exchanges if none is specified",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeHealth,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the health for",
+		},
+	},
+}
+
+func getExchangeHealth(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetExchangeHealth(c.Context,
+		&gctrpc.GetExchangeHealthRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var optionPricingFlags = []cli.Flag{
+	&cli.Float64Flag{
+		Name:  "underlyingprice",
+		Usage: "the underlying price to value options against, defaults to the index price of the option ticker",
+	},
+	&cli.StringFlag{
+		Name:  "model",
+		Usage: "the pricing model, 'black76' or 'blackscholes'",
+		Value: "black76",
+	},
+	&cli.Float64Flag{
+		Name:  "riskfreerate",
+		Usage: "the annualised risk free interest rate, eg 0.05 for 5%",
+	},
+	&cli.Float64Flag{
+		Name:  "dividendyield",
+		Usage: "the annualised carry yield of the underlying, only used by the blackscholes model",
+	},
+}
+
+var getOptionAnalyticsCommand = &cli.Command{
+	Name:      "getoptionanalytics",
+	Usage:     "gets the implied volatility and greeks of an option contract",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getOptionAnalytics,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange the option contract is listed on",
+		},
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the options asset type",
+		},
+		&cli.StringFlag{
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the option contract, eg BTC-27DEC24-50000-C",
+		},
+	}, optionPricingFlags...),
+}
+
+func getOptionAnalytics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var cp string
+	if c.IsSet("pair") {
+		cp = c.String("pair")
+	} else {
+		cp = c.Args().Get(2)
+	}
+
+	if !validPair(cp) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(cp, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOptionAnalytics(c.Context,
+		&gctrpc.GetOptionAnalyticsRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			UnderlyingPrice: c.Float64("underlyingprice"),
+			Model:           c.String("model"),
+			RiskFreeRate:    c.Float64("riskfreerate"),
+			DividendYield:   c.Float64("dividendyield"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getVolatilitySurfaceCommand = &cli.Command{
+	Name:      "getvolatilitysurface",
+	Usage:     "gets the volatility smile of every expiry of an underlying from its enabled option contracts",
+	ArgsUsage: "<exchange> <asset> <underlying>",
+	Action:    getVolatilitySurface,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange the option contracts are listed on",
+		},
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the options asset type",
+		},
+		&cli.StringFlag{
+			Name:    "underlying",
+			Aliases: []string{"u"},
+			Usage:   "the underlying currency, eg BTC",
+		},
+		&cli.BoolFlag{
+			Name:  "includecontracts",
+			Usage: "includes the analytics of every contract used to build the surface",
+		},
+	}, optionPricingFlags...),
+}
+
+func getVolatilitySurface(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var underlying string
+	if c.IsSet("underlying") {
+		underlying = c.String("underlying")
+	} else {
+		underlying = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetVolatilitySurface(c.Context,
+		&gctrpc.GetVolatilitySurfaceRequest{
+			Exchange:         exchangeName,
+			Asset:            assetType,
+			Underlying:       underlying,
+			UnderlyingPrice:  c.Float64("underlyingprice"),
+			Model:            c.String("model"),
+			RiskFreeRate:     c.Float64("riskfreerate"),
+			DividendYield:    c.Float64("dividendyield"),
+			IncludeContracts: c.Bool("includecontracts"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var carryFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "limits the snapshots to an exchange",
+	},
+	&cli.StringFlag{
+		Name:  "underlying",
+		Usage: "limits the snapshots to an underlying spot pair e.g. BTC-USDT",
+	},
+	&cli.BoolFlag{
+		Name:  "opportunities",
+		Usage: "only returns contracts flagged as carry opportunities",
+	},
+}
+
+var getCarrySnapshotsCommand = &cli.Command{
+	Name:      "getcarrysnapshots",
+	Usage:     "gets the latest funding rate and basis of the futures contracts monitored by the carry monitor",
+	ArgsUsage: "<exchange> <underlying>",
+	Action:    getCarrySnapshots,
+	Flags:     carryFlags,
+}
+
+var getCarrySnapshotStreamCommand = &cli.Command{
+	Name:      "getcarrysnapshotstream",
+	Usage:     "streams the funding rate and basis of the futures contracts monitored by the carry monitor after each check",
+	ArgsUsage: "<exchange> <underlying>",
+	Action:    getCarrySnapshotStream,
+	Flags:     carryFlags,
+}
+
+var getCarryHistoryCommand = &cli.Command{
+	Name:      "getcarryhistory",
+	Usage:     "gets the stored carry snapshots of an underlying from the database",
+	ArgsUsage: "<underlying> <exchange> <start> <end>",
+	Action:    getCarryHistory,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "underlying",
+			Usage: "the underlying spot pair e.g. BTC-USDT",
+		},
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "limits the snapshots to an exchange",
+		},
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, 0, -7).Format(time.DateTime),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &endTime,
+		},
+	},
+}
+
+// carryRequest returns the carry snapshots request from the command flags or
+// arguments
+func carryRequest(c *cli.Context) (*gctrpc.GetCarrySnapshotsRequest, error) {
+	req := &gctrpc.GetCarrySnapshotsRequest{OpportunitiesOnly: c.Bool("opportunities")}
+	if c.IsSet("exchange") {
+		req.Exchange = c.String("exchange")
+	} else {
+		req.Exchange = c.Args().First()
+	}
+	var underlying string
+	if c.IsSet("underlying") {
+		underlying = c.String("underlying")
+	} else {
+		underlying = c.Args().Get(1)
+	}
+	if underlying != "" {
+		if !validPair(underlying) {
+			return nil, errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(underlying, pairDelimiter)
+		if err != nil {
+			return nil, err
+		}
+		req.Underlying = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+	return req, nil
+}
+
+func getCarrySnapshots(c *cli.Context) error {
+	req, err := carryRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCarrySnapshots(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getCarrySnapshotStream(c *cli.Context) error {
+	req, err := carryRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCarrySnapshotStream(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+func getCarryHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var underlying string
+	if c.IsSet("underlying") {
+		underlying = c.String("underlying")
+	} else {
+		underlying = c.Args().First()
+	}
+	if !validPair(underlying) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(underlying, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(2) != "" {
+			startTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(3) != "" {
+			endTime = c.Args().Get(3)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCarryHistory(c.Context,
+		&gctrpc.GetCarryHistoryRequest{
+			Exchange: exchangeName,
+			Underlying: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Start: s.Format(common.SimpleTimeFormatWithTimezone),
+			End:   e.Format(common.SimpleTimeFormatWithTimezone),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getMarginHealthCommand = &cli.Command{
+	Name:      "getmarginhealth",
+	Usage:     "gets the liquidation distance and margin ratio of the open futures positions monitored by the margin monitor",
+	ArgsUsage: "<exchange>",
+	Action:    getMarginHealth,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "limits the positions to an exchange",
+		},
+	},
+}
+
+func getMarginHealth(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetMarginHealth(c.Context, &gctrpc.GetMarginHealthRequest{Exchange: exchangeName})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getRebalanceTransfersCommand = &cli.Command{
+	Name:      "getrebalancetransfers",
+	Usage:     "gets the transfers planned by the rebalancer",
+	ArgsUsage: "<status>",
+	Action:    getRebalanceTransfers,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "status",
+			Usage: "limits the transfers to a status e.g. 'pending approval', 'submitted', 'dry run', 'rejected', 'expired' or 'failed'",
+		},
+	},
+}
+
+var approveRebalanceTransferCommand = &cli.Command{
+	Name:      "approverebalancetransfer",
+	Usage:     "approves a rebalance transfer pending approval, submitting its withdrawal",
+	ArgsUsage: "<id>",
+	Action:    approveRebalanceTransfer,
+	Flags:     rebalanceTransferFlags,
+}
+
+var rejectRebalanceTransferCommand = &cli.Command{
+	Name:      "rejectrebalancetransfer",
+	Usage:     "rejects a rebalance transfer pending approval",
+	ArgsUsage: "<id>",
+	Action:    rejectRebalanceTransfer,
+	Flags:     rebalanceTransferFlags,
+}
+
+var rebalanceTransferFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the rebalance transfer ID",
+	},
+}
+
+func getRebalanceTransfers(c *cli.Context) error {
+	var status string
+	if c.IsSet("status") {
+		status = c.String("status")
+	} else {
+		status = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRebalanceTransfers(c.Context, &gctrpc.GetRebalanceTransfersRequest{Status: status})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rebalanceTransferRequest(c *cli.Context) (*gctrpc.RebalanceTransferRequest, error) {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return nil, errors.New("a rebalance transfer ID must be set")
+	}
+	return &gctrpc.RebalanceTransferRequest{Id: id}, nil
+}
+
+func approveRebalanceTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	req, err := rebalanceTransferRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveRebalanceTransfer(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rejectRebalanceTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	req, err := rebalanceTransferRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectRebalanceTransfer(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getQueuedWithdrawalsCommand = &cli.Command{
+	Name:      "getqueuedwithdrawals",
+	Usage:     "gets the withdrawals held by the withdrawal policy",
+	ArgsUsage: "<status>",
+	Action:    getQueuedWithdrawals,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "status",
+			Usage: "limits the withdrawals to a status e.g. 'pending approval', 'time locked', 'submitted', 'rejected', 'expired' or 'failed'",
+		},
+	},
+}
+
+var approveQueuedWithdrawalCommand = &cli.Command{
+	Name:      "approvequeuedwithdrawal",
+	Usage:     "approves a withdrawal pending approval as the authenticated approver",
+	ArgsUsage: "<id>",
+	Action:    approveQueuedWithdrawal,
+	Flags:     queuedWithdrawalFlags,
+}
+
+var rejectQueuedWithdrawalCommand = &cli.Command{
+	Name:      "rejectqueuedwithdrawal",
+	Usage:     "rejects a withdrawal held by the withdrawal policy as the authenticated approver",
+	ArgsUsage: "<id> <reason>",
+	Action:    rejectQueuedWithdrawal,
+	Flags: append(queuedWithdrawalFlags, &cli.StringFlag{
+		Name:  "reason",
+		Usage: "the reason the withdrawal is rejected",
+	}),
+}
+
+var queuedWithdrawalFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the queued withdrawal ID",
+	},
+}
+
+func getQueuedWithdrawals(c *cli.Context) error {
+	var status string
+	if c.IsSet("status") {
+		status = c.String("status")
+	} else {
+		status = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetQueuedWithdrawals(c.Context, &gctrpc.GetQueuedWithdrawalsRequest{Status: status})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func queuedWithdrawalRequest(c *cli.Context) (*gctrpc.QueuedWithdrawalRequest, error) {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return nil, errors.New("a queued withdrawal ID must be set")
+	}
+
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().Get(1)
+	}
+	return &gctrpc.QueuedWithdrawalRequest{Id: id, Reason: reason}, nil
+}
+
+func approveQueuedWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	req, err := queuedWithdrawalRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveQueuedWithdrawal(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rejectQueuedWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	req, err := queuedWithdrawalRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectQueuedWithdrawal(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPortfolioValuationCommand = &cli.Command{
+	Name:   "getportfoliovaluation",
+	Usage:  "gets the most recent valuation of the portfolio's holdings in the reporting currency",
+	Action: getPortfolioValuation,
+}
+
+var getPortfolioEquityCurveCommand = &cli.Command{
+	Name:      "getportfolioequitycurve",
+	Usage:     "gets the total value of the portfolio at each stored valuation between the dates",
+	ArgsUsage: "<start> <end>",
+	Action:    getPortfolioEquityCurve,
+	Flags:     portfolioValuationTimeFlags(),
+}
+
+var getPortfolioDailyPNLCommand = &cli.Command{
+	Name:      "getportfoliodailypnl",
+	Usage:     "gets the change in the portfolio's value over each UTC day between the dates",
+	ArgsUsage: "<start> <end>",
+	Action:    getPortfolioDailyPNL,
+	Flags:     portfolioValuationTimeFlags(),
+}
+
+var getPortfolioAttributionCommand = &cli.Command{
+	Name:      "getportfolioattribution",
+	Usage:     "gets the change in value of each exchange or currency between the first and last stored valuations between the dates",
+	ArgsUsage: "<groupby> <start> <end>",
+	Action:    getPortfolioAttribution,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "groupby",
+			Usage: "attributes the change by 'exchange' or 'currency'",
+			Value: "exchange",
+		},
+	}, portfolioValuationTimeFlags()...),
+}
+
+func portfolioValuationTimeFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, 0, -30).Format(time.DateTime),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &endTime,
+		},
+	}
+}
+
+// portfolioValuationRange returns the request start and end times from the
+// command flags or the arguments from the offset
+func portfolioValuationRange(c *cli.Context, offset int) (start, end string, err error) {
+	if !c.IsSet("start") {
+		if c.Args().Get(offset) != "" {
+			startTime = c.Args().Get(offset)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(offset+1) != "" {
+			endTime = c.Args().Get(offset + 1)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid time format for end: %v", err)
+	}
+	return s.Format(common.SimpleTimeFormatWithTimezone), e.Format(common.SimpleTimeFormatWithTimezone), nil
+}
+
+func getPortfolioValuation(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioValuation(c.Context, &gctrpc.GetPortfolioValuationRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getPortfolioEquityCurve(c *cli.Context) error {
+	start, end, err := portfolioValuationRange(c, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioEquityCurve(c.Context, &gctrpc.PortfolioValuationHistoryRequest{Start: start, End: end})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getPortfolioDailyPNL(c *cli.Context) error {
+	start, end, err := portfolioValuationRange(c, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioDailyPNL(c.Context, &gctrpc.PortfolioValuationHistoryRequest{Start: start, End: end})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getPortfolioAttribution(c *cli.Context) error {
+	groupBy := c.String("groupby")
+	if !c.IsSet("groupby") && c.Args().First() != "" {
+		groupBy = c.Args().First()
+	}
+	start, end, err := portfolioValuationRange(c, 1)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioAttribution(c.Context, &gctrpc.GetPortfolioAttributionRequest{
+		Start:   start,
+		End:     end,
+		GroupBy: groupBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTradeLedgerFillsCommand = &cli.Command{
+	Name:      "gettradeledgerfills",
+	Usage:     "gets the spot fills stored by the trade ledger executed between the dates",
+	ArgsUsage: "<start> <end>",
+	Action:    getTradeLedgerFills,
+	Flags:     portfolioValuationTimeFlags(),
+}
+
+var getTradeLedgerLotsCommand = &cli.Command{
+	Name:   "gettradeledgerlots",
+	Usage:  "gets the open lots of the trade ledger with their cost basis and unrealised gains at current prices",
+	Action: getTradeLedgerLots,
+}
+
+var getTaxReportCommand = &cli.Command{
+	Name:      "gettaxreport",
+	Usage:     "gets the realised gains of a tax year as a CSV report of each disposal",
+	ArgsUsage: "<year> <output>",
+	Action:    getTaxReport,
+	Flags: []cli.Flag{
+		&cli.Int64Flag{
+			Name:  "year",
+			Usage: "the calendar year the tax year starts in",
+			Value: int64(time.Now().Year()),
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "writes the CSV report to the file instead of including it in the output",
+		},
+	},
+}
+
+func getTradeLedgerFills(c *cli.Context) error {
+	start, end, err := portfolioValuationRange(c, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTradeLedgerFills(c.Context, &gctrpc.GetTradeLedgerFillsRequest{
+		Start: start,
+		End:   end,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getTradeLedgerLots(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTradeLedgerLots(c.Context, &gctrpc.GetTradeLedgerLotsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getTaxReport(c *cli.Context) error {
+	year := c.Int64("year")
+	if !c.IsSet("year") && c.Args().First() != "" {
+		var err error
+		year, err = strconv.ParseInt(c.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid year: %w", err)
+		}
+	}
+	output := c.String("output")
+	if !c.IsSet("output") {
+		output = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTaxReport(c.Context, &gctrpc.GetTaxReportRequest{
+		Year: year,
+	})
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		if err := file.Write(output, []byte(result.Csv)); err != nil {
+			return err
+		}
+		result.Csv = ""
+		fmt.Printf("Tax report written to %s\n", output)
+	}
+	jsonOutput(result)
+	return nil
+}
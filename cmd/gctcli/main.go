@@ -29,6 +29,7 @@ var (
 	certPath      string
 	timeout       time.Duration
 	exchangeCreds accounts.Credentials
+	account       string
 	verbose       bool
 	ignoreTimeout bool
 )
@@ -65,6 +66,9 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 		flag, values := exchangeCreds.GetMetaData()
 		c.Context = metadata.AppendToOutgoingContext(c.Context, flag, values)
 	}
+	if account != "" {
+		c.Context = metadata.AppendToOutgoingContext(c.Context, string(accounts.ContextAccountFlag), account)
+	}
 	if verbose {
 		c.Context = metadata.AppendToOutgoingContext(c.Context, "verbose", "true")
 	}
@@ -145,6 +149,11 @@ func main() {
 			Usage:       "override config API One Time Password (OTP) for request",
 			Destination: &exchangeCreds.OneTimePassword,
 		},
+		&cli.StringFlag{
+			Name:        "account",
+			Usage:       "the exchange credential profile to use for request, defaults to the config API credentials",
+			Destination: &account,
+		},
 		&cli.BoolFlag{
 			Name:        "verbose",
 			Usage:       "allows the request to generate a more verbose outputs server side",
@@ -220,6 +229,27 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		getRateLimitStateCommand,
+		getExchangeHealthCommand,
+		getOptionAnalyticsCommand,
+		getVolatilitySurfaceCommand,
+		getCarrySnapshotsCommand,
+		getCarrySnapshotStreamCommand,
+		getCarryHistoryCommand,
+		getMarginHealthCommand,
+		getRebalanceTransfersCommand,
+		approveRebalanceTransferCommand,
+		rejectRebalanceTransferCommand,
+		getQueuedWithdrawalsCommand,
+		approveQueuedWithdrawalCommand,
+		rejectQueuedWithdrawalCommand,
+		getPortfolioValuationCommand,
+		getPortfolioEquityCurveCommand,
+		getPortfolioDailyPNLCommand,
+		getPortfolioAttributionCommand,
+		getTradeLedgerFillsCommand,
+		getTradeLedgerLotsCommand,
+		getTaxReportCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
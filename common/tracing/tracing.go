@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Validate checks the config for any values that would prevent the provider
+// from starting
+func (c *Config) Validate() error {
+	if c == nil {
+		return errNilConfig
+	}
+	switch c.Exporter {
+	case ExporterOTLPGRPC, ExporterOTLPHTTP:
+	case ExporterFile:
+		if c.FilePath == "" {
+			return errFilePathUnset
+		}
+	default:
+		return fmt.Errorf("%w: %q", errUnsupportedExport, c.Exporter)
+	}
+	if r := c.sampleRatio(); r < 0 || r > 1 {
+		return fmt.Errorf("%w: %v", errInvalidSampleRatio, r)
+	}
+	return nil
+}
+
+// sampleRatio returns the configured sample ratio, or the default when unset
+func (c *Config) sampleRatio() float64 {
+	if c.SampleRatio == nil {
+		return DefaultSampleRatio
+	}
+	return *c.SampleRatio
+}
+
+// Setup creates a tracer provider from the supplied config and registers it
+// globally along with W3C trace context propagation. Spans started before
+// Setup is called, or when tracing is disabled, are no-ops.
+func Setup(ctx context.Context, cfg *Config) (*Provider, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &Provider{}
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterOTLPGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case ExporterFile:
+		if err = os.MkdirAll(filepath.Dir(cfg.FilePath), file.DefaultPermissionOctal); err != nil {
+			return nil, err
+		}
+		var f *os.File
+		f, err = os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, file.DefaultPermissionOctal)
+		if err != nil {
+			return nil, err
+		}
+		p.closer = f
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	}
+	if err != nil {
+		if p.closer != nil {
+			_ = p.closer.Close()
+		}
+		return nil, fmt.Errorf("cannot create %s exporter: %w", cfg.Exporter, err)
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+
+	p.tp = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.sampleRatio()))),
+		sdktrace.WithResource(resource.NewSchemaless(serviceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(p.tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return p, nil
+}
+
+// Shutdown flushes any pending spans and releases exporter resources
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p == nil || p.tp == nil {
+		return nil
+	}
+	err := p.tp.Shutdown(ctx)
+	if p.closer != nil {
+		if closeErr := p.closer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Tracer returns the GoCryptoTrader tracer from the globally registered
+// provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a new span as a child of any span carried by the context
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// End records the error against the span, if any, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// AddEvent adds an event to the span carried by the context. This is a no-op
+// when the context has no recording span.
+func AddEvent(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.AddEvent(name, trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	var c *Config
+	require.ErrorIs(t, c.Validate(), errNilConfig)
+
+	c = &Config{Exporter: "carrier pigeon"}
+	require.ErrorIs(t, c.Validate(), errUnsupportedExport)
+
+	c.Exporter = ExporterFile
+	require.ErrorIs(t, c.Validate(), errFilePathUnset)
+
+	c.FilePath = "traces.json"
+	c.SampleRatio = new(1.5)
+	require.ErrorIs(t, c.Validate(), errInvalidSampleRatio)
+
+	c.SampleRatio = new(0.5)
+	require.NoError(t, c.Validate())
+
+	c.Exporter = ExporterOTLPGRPC
+	require.NoError(t, c.Validate())
+}
+
+func TestSetupFileExporter(t *testing.T) {
+	original := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	path := filepath.Join(t.TempDir(), "nested", DefaultFileName)
+	p, err := Setup(t.Context(), &Config{Enabled: true, Exporter: ExporterFile, FilePath: path, SampleRatio: new(1.0)})
+	require.NoError(t, err, "Setup must not error")
+
+	_, span := Start(t.Context(), "test.span", ExchangeKey.String("Bitstamp"))
+	span.End()
+	require.NoError(t, p.Shutdown(context.Background()), "Shutdown must not error")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err, "ReadFile must not error")
+	assert.Contains(t, string(data), "test.span", "exported spans should contain span name")
+	assert.Contains(t, string(data), "Bitstamp", "exported spans should contain attributes")
+
+	assert.NoError(t, (*Provider)(nil).Shutdown(t.Context()), "Shutdown on a nil provider should not error")
+}
+
+func TestEndAndAddEvent(t *testing.T) {
+	t.Parallel()
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+	ctx, span := tp.Tracer("test").Start(t.Context(), "test.span")
+	AddEvent(ctx, EventRetry, AttemptKey.Int(2))
+	End(span, errors.New("bad things"))
+
+	// No recording span in context should be a no-op
+	AddEvent(t.Context(), EventRetry)
+
+	ended := rec.Ended()
+	require.Len(t, ended, 1, "must record a single span")
+	assert.Equal(t, codes.Error, ended[0].Status().Code, "status should be set to error")
+	var names []string
+	for _, e := range ended[0].Events() {
+		names = append(names, e.Name)
+	}
+	assert.Contains(t, names, EventRetry, "span should contain retry event")
+	assert.Contains(t, names, "exception", "span should contain recorded error")
+}
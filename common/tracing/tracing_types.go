@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporter types supported by the tracing provider
+const (
+	ExporterOTLPGRPC = "otlpgrpc"
+	ExporterOTLPHTTP = "otlphttp"
+	ExporterFile     = "file"
+)
+
+// Default values applied when config fields are unset
+const (
+	DefaultServiceName      = "gocryptotrader"
+	DefaultOTLPGRPCEndpoint = "localhost:4317"
+	DefaultOTLPHTTPEndpoint = "localhost:4318"
+	DefaultFileName         = "traces.json"
+	DefaultSampleRatio      = 1.0
+
+	instrumentationName = "github.com/thrasher-corp/gocryptotrader"
+	serviceNameKey      = attribute.Key("service.name")
+)
+
+// Span attribute keys shared across the code base so that spans emitted by
+// different layers can be correlated by a collector
+const (
+	ExchangeKey      = attribute.Key("gct.exchange")
+	EndpointKey      = attribute.Key("gct.endpoint")
+	AssetKey         = attribute.Key("gct.asset")
+	PairKey          = attribute.Key("gct.pair")
+	OrderSideKey     = attribute.Key("gct.order.side")
+	OrderTypeKey     = attribute.Key("gct.order.type")
+	OrderIDKey       = attribute.Key("gct.order.id")
+	AttemptKey       = attribute.Key("gct.request.attempt")
+	RateLimitWaitKey = attribute.Key("gct.ratelimit.wait")
+	RetryDelayKey    = attribute.Key("gct.request.retry.delay")
+	AuthenticatedKey = attribute.Key("gct.request.authenticated")
+	PriorityKey      = attribute.Key("gct.request.priority")
+)
+
+// Span event names
+const (
+	EventRateLimitWait = "rate_limit_wait"
+	EventRetry         = "retry"
+)
+
+var (
+	errNilConfig          = errors.New("tracing config is nil")
+	errUnsupportedExport  = errors.New("unsupported tracing exporter")
+	errFilePathUnset      = errors.New("tracing file exporter requires a file path")
+	errInvalidSampleRatio = errors.New("sample ratio must be between 0 and 1")
+)
+
+// Config defines the tracing configuration used to set up span exporting
+type Config struct {
+	Enabled  bool   `json:"enabled"`
+	Exporter string `json:"exporter"`
+	// Endpoint is the collector host:port used by the OTLP exporters
+	Endpoint string `json:"endpoint,omitempty"`
+	// Insecure disables TLS for the OTLP exporters
+	Insecure bool              `json:"insecure,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	// FilePath is the destination of the file exporter, spans are appended
+	// as JSON lines
+	FilePath string `json:"filePath,omitempty"`
+	// SampleRatio is the fraction of new traces recorded, the default ratio
+	// is used when unset while 0 records none
+	SampleRatio *float64 `json:"sampleRatio,omitempty"`
+	ServiceName string   `json:"serviceName,omitempty"`
+}
+
+// Provider holds the active tracer provider and any resources which need to
+// be released on shutdown
+type Provider struct {
+	tp     *sdktrace.TracerProvider
+	closer io.Closer
+}
@@ -15,8 +15,15 @@ type Base struct {
 
 // Event is a generalise event type
 type Event struct {
-	Type    string
-	Message string
+	Type     string
+	Message  string
+	Severity Severity
+	// Data is the typed payload of the event, relayer templates are executed
+	// with the event and can access it
+	Data any
+	// Key identifies duplicate events, Type and Message are used when empty
+	Key  string
+	Time time.Time
 }
 
 // CommsStatus stores the status of a comms relayer
@@ -56,10 +63,14 @@ func (b *Base) SetServiceStarted(t time.Time) {
 // CommunicationsConfig holds all the information needed for each
 // enabled communication package
 type CommunicationsConfig struct {
-	SlackConfig     SlackConfig     `json:"slack"`
-	SMSGlobalConfig SMSGlobalConfig `json:"smsGlobal"`
-	SMTPConfig      SMTPConfig      `json:"smtp"`
-	TelegramConfig  TelegramConfig  `json:"telegram"`
+	SlackConfig     SlackConfig         `json:"slack"`
+	SMSGlobalConfig SMSGlobalConfig     `json:"smsGlobal"`
+	SMTPConfig      SMTPConfig          `json:"smtp"`
+	TelegramConfig  TelegramConfig      `json:"telegram"`
+	WebhookConfig   WebhookConfig       `json:"webhook"`
+	DiscordConfig   DiscordConfig       `json:"discord"`
+	MatrixConfig    MatrixConfig        `json:"matrix"`
+	Notifications   NotificationsConfig `json:"notifications"`
 }
 
 // IsAnyEnabled returns whether any comms relayers
@@ -68,12 +79,44 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 	if c.SMSGlobalConfig.Enabled ||
 		c.SMTPConfig.Enabled ||
 		c.SlackConfig.Enabled ||
-		c.TelegramConfig.Enabled {
+		c.TelegramConfig.Enabled ||
+		c.WebhookConfig.Enabled ||
+		c.DiscordConfig.Enabled ||
+		c.MatrixConfig.Enabled {
 		return true
 	}
 	return false
 }
 
+// NotificationsConfig holds the routing, templating, deduplication and
+// throttling rules applied to events before they are pushed to the relayers
+type NotificationsConfig struct {
+	// Routes restrict the events a relayer receives, relayers without a route
+	// receive every event
+	Routes []NotificationRoute `json:"routes,omitempty"`
+	// Templates are Go templates keyed by relayer name then event type which
+	// are executed with the event to produce the message. The default event
+	// type is used for event types without a template
+	Templates map[string]map[string]string `json:"templates,omitempty"`
+	// DedupeWindow drops events with the same key seen within the window
+	DedupeWindow time.Duration `json:"dedupeWindow,omitempty"`
+	// ThrottleLimit is the number of events a relayer can be sent within the
+	// throttle window, further events are dropped and counted
+	ThrottleLimit  int           `json:"throttleLimit,omitempty"`
+	ThrottleWindow time.Duration `json:"throttleWindow,omitempty"`
+	// DigestInterval batches info events into a single digest per relayer
+	// sent at the interval
+	DigestInterval time.Duration `json:"digestInterval,omitempty"`
+}
+
+// NotificationRoute routes events matching the severities and event types to
+// a relayer, empty severities or event types match all
+type NotificationRoute struct {
+	Relayer    string   `json:"relayer"`
+	Severities []string `json:"severities,omitempty"`
+	EventTypes []string `json:"eventTypes,omitempty"`
+}
+
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
 	Name              string `json:"name"`
@@ -81,6 +124,8 @@ type SlackConfig struct {
 	Verbose           bool   `json:"verbose"`
 	TargetChannel     string `json:"targetChannel"`
 	VerificationToken string `json:"verificationToken"`
+	// CommandUsers lists the Slack user names allowed to run chat commands
+	CommandUsers []string `json:"commandUsers,omitempty"`
 }
 
 // SMSContact stores the SMS contact info
@@ -122,4 +167,44 @@ type TelegramConfig struct {
 	Verbose           bool             `json:"verbose"`
 	VerificationToken string           `json:"verificationToken"`
 	AuthorisedClients map[string]int64 `json:"authorisedClients"`
+	// CommandUsers lists the authorised clients allowed to run chat commands
+	CommandUsers []string `json:"commandUsers,omitempty"`
+}
+
+// WebhookConfig holds all variables to start and run the webhook package
+type WebhookConfig struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	Verbose bool   `json:"verbose"`
+	// URL and BodyTemplate are Go text templates executed with each event
+	URL          string            `json:"url"`
+	Method       string            `json:"method"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	BodyTemplate string            `json:"bodyTemplate"`
+	// Secret signs each request body with HMAC-SHA256 in the signature header
+	Secret          string        `json:"secret,omitempty"`
+	SignatureHeader string        `json:"signatureHeader,omitempty"`
+	MaxRetries      int           `json:"maxRetries"`
+	RetryDelay      time.Duration `json:"retryDelay"`
+	// RateLimit is the minimum interval between requests
+	RateLimit time.Duration `json:"rateLimit"`
+}
+
+// DiscordConfig holds all variables to start and run the Discord package
+type DiscordConfig struct {
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	Verbose    bool   `json:"verbose"`
+	WebhookURL string `json:"webhookURL"`
+	Username   string `json:"username,omitempty"`
+}
+
+// MatrixConfig holds all variables to start and run the Matrix package
+type MatrixConfig struct {
+	Name          string `json:"name"`
+	Enabled       bool   `json:"enabled"`
+	Verbose       bool   `json:"verbose"`
+	HomeserverURL string `json:"homeserverURL"`
+	RoomID        string `json:"roomID"`
+	AccessToken   string `json:"accessToken"`
 }
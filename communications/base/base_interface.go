@@ -21,6 +21,18 @@ type ICommunicate interface {
 	SetServiceStarted(time.Time)
 }
 
+// CommandRelayer is implemented by communication packages which accept chat
+// commands from their users
+type CommandRelayer interface {
+	SetCommands(*Commands)
+}
+
+// Shutdowner is implemented by communication packages which deliver events in
+// the background until they are shut down
+type Shutdowner interface {
+	Shutdown()
+}
+
 // Setup sets up communication variables and initiates a connection to the
 // communication mediums
 func (c IComm) Setup() {
@@ -50,6 +62,25 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// Shutdown shuts down the relayers which deliver events in the background,
+// Setup connects them again
+func (c IComm) Shutdown() {
+	for i := range c {
+		if s, ok := c[i].(Shutdowner); ok {
+			s.Shutdown()
+		}
+	}
+}
+
+// SetCommands sets the chat commands for all relayers which accept commands
+func (c IComm) SetCommands(cmds *Commands) {
+	for i := range c {
+		if r, ok := c[i].(CommandRelayer); ok {
+			r.SetCommands(cmds)
+		}
+	}
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
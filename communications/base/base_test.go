@@ -151,3 +151,21 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+type shutdownProvider struct {
+	CommunicationProvider
+	shutdownCalled bool
+}
+
+func (p *shutdownProvider) Shutdown() {
+	p.shutdownCalled = true
+}
+
+func TestShutdown(t *testing.T) {
+	p := &shutdownProvider{}
+	ic := IComm{&CommunicationProvider{}, p}
+	ic.Shutdown()
+	if !p.shutdownCalled {
+		t.Fatal("relayers which deliver events in the background should be shut down")
+	}
+}
@@ -0,0 +1,254 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultConfirmTimeout is how long a destructive command waits to be
+	// confirmed before it is discarded
+	DefaultConfirmTimeout = time.Minute
+	// DefaultCommandTimeout is how long a command may run before its context
+	// is cancelled
+	DefaultCommandTimeout = time.Minute
+
+	cmdConfirm = "confirm"
+)
+
+var (
+	errCommandNameEmpty   = errors.New("command name is empty")
+	errCommandFuncNil     = errors.New("command function is nil")
+	errCommandExists      = errors.New("command already registered")
+	errCommandNotFound    = errors.New("command not found")
+	errUserNotAuthorised  = errors.New("user is not authorised to run commands")
+	errNoPendingCommand   = errors.New("no command awaiting confirmation")
+	errCommandNameInvalid = errors.New("command name cannot contain whitespace")
+)
+
+// CommandFunc runs a chat command with the arguments following the command
+// name and returns the reply
+type CommandFunc func(ctx context.Context, args []string) (string, error)
+
+// AuditFunc records a chat command issued by a relayer user and its outcome
+type AuditFunc func(relayer, user, command, outcome string)
+
+// Command is a chat command which authorised relayer users can run
+type Command struct {
+	Name        string
+	Usage       string
+	Description string
+	// Destructive commands must be confirmed by the same user before they are
+	// run
+	Destructive bool
+	Func        CommandFunc
+}
+
+// CommandRequest is a chat message received by a relayer
+type CommandRequest struct {
+	Relayer string
+	User    string
+	Text    string
+	// AllowedUsers lists the relayer users allowed to run commands
+	AllowedUsers []string
+}
+
+// Commands holds the chat commands shared across the relayers
+type Commands struct {
+	ConfirmTimeout time.Duration
+	CommandTimeout time.Duration
+	Audit          AuditFunc
+
+	m        sync.Mutex
+	commands map[string]*Command
+	names    []string
+	pending  map[string]*pendingCommand
+}
+
+// commandUserKey is the context key of the relayer user running a command
+type commandUserKey struct{}
+
+// commandUser is the relayer user running a command
+type commandUser struct {
+	relayer string
+	user    string
+}
+
+// pendingCommand is a destructive command awaiting confirmation
+type pendingCommand struct {
+	command *Command
+	args    []string
+	expires time.Time
+}
+
+// NewCommands returns an empty command set which records commands with the
+// audit function
+func NewCommands(audit AuditFunc) *Commands {
+	return &Commands{
+		ConfirmTimeout: DefaultConfirmTimeout,
+		CommandTimeout: DefaultCommandTimeout,
+		Audit:          audit,
+		commands:       make(map[string]*Command),
+		pending:        make(map[string]*pendingCommand),
+	}
+}
+
+// Register adds a command to the set
+func (c *Commands) Register(cmd *Command) error {
+	if cmd == nil || cmd.Func == nil {
+		return errCommandFuncNil
+	}
+	if cmd.Name == "" {
+		return errCommandNameEmpty
+	}
+	name := strings.ToLower(cmd.Name)
+	if strings.ContainsAny(name, " \t\n") {
+		return fmt.Errorf("%w: %q", errCommandNameInvalid, cmd.Name)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	if _, ok := c.commands[name]; ok || name == cmdConfirm {
+		return fmt.Errorf("%w: %q", errCommandExists, name)
+	}
+	c.commands[name] = cmd
+	c.names = append(c.names, name)
+	return nil
+}
+
+// Help returns the usage of each command with the prefix used by the relayer
+func (c *Commands) Help(prefix string) string {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var sb strings.Builder
+	for _, name := range c.names {
+		cmd := c.commands[name]
+		usage := prefix + name
+		if cmd.Usage != "" {
+			usage += " " + cmd.Usage
+		}
+		fmt.Fprintf(&sb, "\n%s - %s", usage, cmd.Description)
+		if cmd.Destructive {
+			sb.WriteString(" (requires confirmation)")
+		}
+	}
+	if len(c.names) != 0 {
+		fmt.Fprintf(&sb, "\n%s%s - Runs the command awaiting confirmation", prefix, cmdConfirm)
+	}
+	return sb.String()
+}
+
+// IsCommand returns whether the message is a registered command or a
+// confirmation
+func (c *Commands) IsCommand(text string) bool {
+	name, _ := parseCommand(text)
+	if name == cmdConfirm {
+		return true
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	_, ok := c.commands[name]
+	return ok
+}
+
+// Handle runs the command in the message for an allowed user and returns the
+// reply for the relayer to send. Destructive commands are held until the user
+// sends confirm within the confirm timeout. Every command is audited
+// including those refused
+func (c *Commands) Handle(ctx context.Context, req *CommandRequest) string {
+	if req == nil {
+		return ""
+	}
+	name, args := parseCommand(req.Text)
+	command := strings.TrimSpace(name + " " + strings.Join(args, " "))
+	ctx = context.WithValue(ctx, commandUserKey{}, commandUser{relayer: req.Relayer, user: req.User})
+	reply, outcome, err := c.handle(ctx, req, name, args)
+	if err != nil {
+		outcome = err.Error()
+		reply = fmt.Sprintf("Command %s failed: %v", name, err)
+	}
+	if c.Audit != nil {
+		c.Audit(req.Relayer, req.User, command, outcome)
+	}
+	return reply
+}
+
+// handle returns the reply to the command and the outcome to audit
+func (c *Commands) handle(ctx context.Context, req *CommandRequest, name string, args []string) (reply, outcome string, err error) {
+	if req.User == "" || !slices.ContainsFunc(req.AllowedUsers, func(u string) bool {
+		return strings.EqualFold(u, req.User)
+	}) {
+		return "", "", errUserNotAuthorised
+	}
+	key := req.Relayer + "|" + strings.ToLower(req.User)
+
+	c.m.Lock()
+	if name == cmdConfirm {
+		p, ok := c.pending[key]
+		delete(c.pending, key)
+		c.m.Unlock()
+		if !ok || time.Now().After(p.expires) {
+			return "", "", errNoPendingCommand
+		}
+		command := strings.TrimSpace(strings.ToLower(p.command.Name) + " " + strings.Join(p.args, " "))
+		if reply, err = c.run(ctx, p.command, p.args); err != nil {
+			return "", "", fmt.Errorf("%s: %w", command, err)
+		}
+		return reply, "confirmed " + command, nil
+	}
+	cmd, ok := c.commands[name]
+	if !ok {
+		c.m.Unlock()
+		return "", "", errCommandNotFound
+	}
+	if cmd.Destructive {
+		c.pending[key] = &pendingCommand{
+			command: cmd,
+			args:    args,
+			expires: time.Now().Add(c.ConfirmTimeout),
+		}
+		c.m.Unlock()
+		return fmt.Sprintf("Send %s within %s to run: %s", cmdConfirm, c.ConfirmTimeout, strings.TrimSpace(name+" "+strings.Join(args, " "))), "awaiting confirmation", nil
+	}
+	c.m.Unlock()
+	if reply, err = c.run(ctx, cmd, args); err != nil {
+		return "", "", err
+	}
+	return reply, "success", nil
+}
+
+// run runs the command bound by the command timeout
+func (c *Commands) run(ctx context.Context, cmd *Command, args []string) (string, error) {
+	if c.CommandTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.CommandTimeout)
+		defer cancel()
+	}
+	return cmd.Func(ctx, args)
+}
+
+// CommandUser returns the relayer and user running a chat command from the
+// context passed to its function
+func CommandUser(ctx context.Context) (relayer, user string, ok bool) {
+	u, ok := ctx.Value(commandUserKey{}).(commandUser)
+	return u.relayer, u.user, ok
+}
+
+// parseCommand returns the lower case command name and its arguments from a
+// message, the relayer command prefix and any Telegram bot mention are
+// removed from the name
+func parseCommand(text string) (name string, args []string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", nil
+	}
+	name = strings.TrimLeft(fields[0], "/!")
+	if i := strings.IndexByte(name, '@'); i != -1 {
+		name = name[:i]
+	}
+	return strings.ToLower(name), fields[1:]
+}
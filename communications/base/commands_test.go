@@ -0,0 +1,121 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandsRegister(t *testing.T) {
+	t.Parallel()
+	c := NewCommands(nil)
+	fn := func(context.Context, []string) (string, error) { return "", nil }
+	assert.ErrorIs(t, c.Register(nil), errCommandFuncNil)
+	assert.ErrorIs(t, c.Register(&Command{Name: "test"}), errCommandFuncNil)
+	assert.ErrorIs(t, c.Register(&Command{Func: fn}), errCommandNameEmpty)
+	assert.ErrorIs(t, c.Register(&Command{Name: "te st", Func: fn}), errCommandNameInvalid)
+	assert.ErrorIs(t, c.Register(&Command{Name: cmdConfirm, Func: fn}), errCommandExists)
+	require.NoError(t, c.Register(&Command{Name: "Test", Usage: "<arg>", Description: "Does a test", Func: fn}), "Register must not error")
+	assert.ErrorIs(t, c.Register(&Command{Name: "test", Func: fn}), errCommandExists)
+	require.NoError(t, c.Register(&Command{Name: "wipe", Description: "Wipes", Destructive: true, Func: fn}), "Register must not error")
+
+	assert.Equal(t, "\n/test <arg> - Does a test\n/wipe - Wipes (requires confirmation)\n/confirm - Runs the command awaiting confirmation", c.Help("/"), "Help should list the commands in order")
+	assert.Empty(t, NewCommands(nil).Help("/"), "Help should be empty without commands")
+
+	assert.True(t, c.IsCommand("/test 1"), "IsCommand should return true for a registered command")
+	assert.True(t, c.IsCommand("!CONFIRM"), "IsCommand should return true for confirm")
+	assert.True(t, c.IsCommand("/test@gctbot"), "IsCommand should ignore bot mentions")
+	assert.False(t, c.IsCommand("/help"), "IsCommand should return false for an unregistered command")
+	assert.False(t, c.IsCommand(""), "IsCommand should return false for an empty message")
+}
+
+func TestCommandsHandle(t *testing.T) {
+	t.Parallel()
+	type audited struct{ relayer, user, command, outcome string }
+	var audits []audited
+	c := NewCommands(func(relayer, user, command, outcome string) {
+		audits = append(audits, audited{relayer, user, command, outcome})
+	})
+	var wiped int
+	require.NoError(t, c.Register(&Command{Name: "echo", Func: func(_ context.Context, args []string) (string, error) {
+		if len(args) == 0 {
+			return "", errors.New("nothing to echo")
+		}
+		return strings.Join(args, " "), nil
+	}}), "Register must not error")
+	require.NoError(t, c.Register(&Command{Name: "wipe", Destructive: true, Func: func(context.Context, []string) (string, error) {
+		wiped++
+		return "wiped", nil
+	}}), "Register must not error")
+
+	assert.Empty(t, c.Handle(t.Context(), nil), "Handle should return nothing for a nil request")
+
+	req := &CommandRequest{Relayer: "Telegram", User: "mallory", Text: "/echo hi", AllowedUsers: []string{"Alice"}}
+	assert.Contains(t, c.Handle(t.Context(), req), errUserNotAuthorised.Error(), "Handle should refuse a user not allowed")
+	req.User = ""
+	assert.Contains(t, c.Handle(t.Context(), req), errUserNotAuthorised.Error(), "Handle should refuse an unknown user")
+
+	req.User = "alice"
+	assert.Equal(t, "hi", c.Handle(t.Context(), req), "Handle should run the command for an allowed user")
+	req.Text = "/echo"
+	assert.Equal(t, "Command echo failed: nothing to echo", c.Handle(t.Context(), req), "Handle should return the command error")
+	req.Text = "/nope"
+	assert.Contains(t, c.Handle(t.Context(), req), errCommandNotFound.Error(), "Handle should error on an unknown command")
+	req.Text = "/confirm"
+	assert.Contains(t, c.Handle(t.Context(), req), errNoPendingCommand.Error(), "Handle should error without a pending command")
+
+	req.Text = "/wipe all"
+	assert.Equal(t, "Send confirm within 1m0s to run: wipe all", c.Handle(t.Context(), req), "Handle should ask for confirmation")
+	assert.Zero(t, wiped, "Handle should not run a destructive command before confirmation")
+	other := &CommandRequest{Relayer: "Slack", User: "alice", Text: "!confirm", AllowedUsers: []string{"alice"}}
+	assert.Contains(t, c.Handle(t.Context(), other), errNoPendingCommand.Error(), "Handle should not confirm a command from another relayer")
+	req.Text = "/confirm"
+	assert.Equal(t, "wiped", c.Handle(t.Context(), req), "Handle should run the confirmed command")
+	assert.Equal(t, 1, wiped, "Handle should run the confirmed command once")
+	assert.Contains(t, c.Handle(t.Context(), req), errNoPendingCommand.Error(), "Handle should not confirm a command twice")
+
+	c.ConfirmTimeout = -time.Second
+	req.Text = "/wipe"
+	c.Handle(t.Context(), req)
+	req.Text = "/confirm"
+	assert.Contains(t, c.Handle(t.Context(), req), errNoPendingCommand.Error(), "Handle should not confirm an expired command")
+	assert.Equal(t, 1, wiped, "Handle should not run an expired command")
+
+	require.Len(t, audits, 12, "Handle must audit every command")
+	assert.Equal(t, audited{"Telegram", "mallory", "echo hi", errUserNotAuthorised.Error()}, audits[0], "Handle should audit refused commands")
+	assert.Equal(t, audited{"Telegram", "alice", "echo hi", "success"}, audits[2], "Handle should audit successful commands")
+	assert.Equal(t, audited{"Telegram", "alice", "wipe all", "awaiting confirmation"}, audits[6], "Handle should audit commands awaiting confirmation")
+	assert.Equal(t, audited{"Telegram", "alice", "confirm", "confirmed wipe all"}, audits[8], "Handle should audit the confirmed command")
+}
+
+func TestCommandUser(t *testing.T) {
+	t.Parallel()
+	_, _, ok := CommandUser(t.Context())
+	assert.False(t, ok, "CommandUser should not return a user outside of a command")
+
+	c := NewCommands(nil)
+	var relayer, user string
+	require.NoError(t, c.Register(&Command{Name: "whoami", Func: func(ctx context.Context, _ []string) (string, error) {
+		relayer, user, ok = CommandUser(ctx)
+		return user, nil
+	}}), "Register must not error")
+	c.Handle(t.Context(), &CommandRequest{Relayer: "Slack", User: "alice", Text: "!whoami", AllowedUsers: []string{"alice"}})
+	assert.True(t, ok, "CommandUser should return the user running a command")
+	assert.Equal(t, "Slack", relayer)
+	assert.Equal(t, "alice", user)
+}
+
+func TestParseCommand(t *testing.T) {
+	t.Parallel()
+	name, args := parseCommand("  /Balances@gct_bot  Binance spot ")
+	assert.Equal(t, "balances", name, "parseCommand should return the lower case command name")
+	assert.Equal(t, []string{"Binance", "spot"}, args, "parseCommand should return the arguments")
+	name, args = parseCommand("")
+	assert.Empty(t, name, "parseCommand should return no name for an empty message")
+	assert.Empty(t, args, "parseCommand should return no arguments for an empty message")
+}
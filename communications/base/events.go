@@ -0,0 +1,397 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Severity levels of events, events without a severity are info events
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityCritical
+)
+
+// Event types of the typed event payloads
+const (
+	OrderFilledEventType          = "order_filled"
+	OrderPartiallyFilledEventType = "order_partially_filled"
+	OrderRejectedEventType        = "order_rejected"
+	OrderExpiredEventType         = "order_expired"
+	PositionClosedEventType       = "position_closed"
+	WithdrawalStatusEventType     = "withdrawal_status"
+	WithdrawalStuckEventType      = "withdrawal_stuck"
+	ExchangeDisconnectEventType   = "exchange_disconnect"
+	ExchangeIncidentEventType     = "exchange_incident"
+	ExchangeRecoveredEventType    = "exchange_recovered"
+	CarryOpportunityEventType     = "carry_opportunity"
+	RiskBreachEventType           = "risk_breach"
+	MarginAlertEventType          = "margin_alert"
+	MarginRecoveredEventType      = "margin_recovered"
+	MarginDeRiskEventType         = "margin_derisk"
+	RebalanceTransferEventType    = "rebalance_transfer"
+	WithdrawalApprovalEventType   = "withdrawal_approval"
+)
+
+var errInvalidSeverity = errors.New("invalid severity")
+
+// Severity is the importance of an event, used to route events to relayers
+// and to decide which events are batched into digests
+type Severity uint8
+
+// Payload is a typed event payload
+type Payload interface {
+	EventType() string
+	String() string
+}
+
+// OrderFilled is the payload of an order fill event, Amount is the amount
+// filled since the order was last seen
+type OrderFilled struct {
+	Exchange string
+	Asset    string
+	Pair     string
+	Side     string
+	OrderID  string
+	Price    float64
+	Amount   float64
+	Executed float64
+	Total    float64
+	Partial  bool
+}
+
+// OrderStatus is the payload of an order rejected or expired event
+type OrderStatus struct {
+	Exchange string
+	Asset    string
+	Pair     string
+	Side     string
+	OrderID  string
+	Status   string
+}
+
+// PositionClosed is the payload of a futures position closed event
+type PositionClosed struct {
+	Exchange    string
+	Asset       string
+	Pair        string
+	Direction   string
+	RealisedPNL float64
+	Currency    string
+}
+
+// WithdrawalStatus is the payload of a withdrawal status change event
+type WithdrawalStatus struct {
+	Exchange string
+	ID       string
+	Currency string
+	Amount   float64
+	Status   string
+	TxID     string
+	// Stuck is set when the withdrawal has not completed within the expected
+	// time, Age being how long ago it was submitted
+	Stuck bool
+	Age   time.Duration
+}
+
+// ExchangeDisconnect is the payload of an exchange connectivity loss event
+type ExchangeDisconnect struct {
+	Exchange string
+	Reason   string
+}
+
+// ExchangeHealth is the payload of an exchange health incident or recovery
+// event, an incident is raised when the exchange status worsens
+type ExchangeHealth struct {
+	Exchange  string
+	Status    string
+	Previous  string
+	Reasons   []string
+	Recovered bool
+}
+
+// CarryOpportunity is the payload of an event raised when the annualised
+// funding rate or basis of a futures contract crosses its threshold. Positive
+// carry is earned long the underlying and short the contract
+type CarryOpportunity struct {
+	Exchange   string
+	Asset      string
+	Pair       string
+	Underlying string
+	// Kind is either funding or basis
+	Kind       string
+	Annualised float64
+	Threshold  float64
+}
+
+// RiskBreach is the payload of an event raised when a risk limit is breached
+type RiskBreach struct {
+	Rule     string
+	Exchange string
+	Value    float64
+	Limit    float64
+}
+
+// MarginHealth is the payload of a futures position margin alert or recovery
+// event, an alert is raised when the position enters a more severe margin
+// level
+type MarginHealth struct {
+	Exchange  string
+	Account   string
+	Asset     string
+	Pair      string
+	Side      string
+	Level     string
+	Previous  string
+	Reasons   []string
+	Recovered bool
+}
+
+// MarginDeRisk is the payload of an event raised when a futures position is
+// reduced with a reduce only order after entering a margin level
+type MarginDeRisk struct {
+	Exchange string
+	Account  string
+	Asset    string
+	Pair     string
+	Side     string
+	Level    string
+	Amount   float64
+	OrderID  string
+	Error    string
+}
+
+// RebalanceTransfer is the payload of an event raised when a rebalancing
+// transfer between exchanges awaits approval, is submitted, completes, is
+// rejected, expires or fails
+type RebalanceTransfer struct {
+	ID       string
+	Currency string
+	From     string
+	To       string
+	Chain    string
+	Amount   float64
+	Status   string
+	Error    string
+}
+
+// WithdrawalApproval is the payload of an event raised when a withdrawal held
+// by the withdrawal policy awaits approval, is submitted, rejected, expired,
+// denied or fails
+type WithdrawalApproval struct {
+	ID        string
+	Exchange  string
+	Currency  string
+	Amount    float64
+	Address   string
+	Status    string
+	Approvals int
+	Required  int
+	Reason    string
+}
+
+// NewEvent returns an event for a typed payload
+func NewEvent(p Payload, s Severity) Event {
+	return Event{
+		Type:     p.EventType(),
+		Message:  p.String(),
+		Severity: s,
+		Data:     p,
+		Time:     time.Now(),
+	}
+}
+
+// String implements the stringer interface
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("severity(%d)", uint8(s))
+	}
+}
+
+// ParseSeverity returns the severity matching the name
+func ParseSeverity(name string) (Severity, error) {
+	switch strings.ToLower(name) {
+	case "info":
+		return SeverityInfo, nil
+	case "warning", "warn":
+		return SeverityWarning, nil
+	case "critical":
+		return SeverityCritical, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errInvalidSeverity, name)
+	}
+}
+
+// EventType returns the event type of the payload
+func (o *OrderFilled) EventType() string {
+	if o.Partial {
+		return OrderPartiallyFilledEventType
+	}
+	return OrderFilledEventType
+}
+
+// String implements the stringer interface
+func (o *OrderFilled) String() string {
+	if o.Partial {
+		return fmt.Sprintf("Exchange %s %s %s order ID=%s %s partially filled %v@%v, %v of %v executed", o.Exchange, o.Asset, o.Pair, o.OrderID, o.Side, o.Amount, o.Price, o.Executed, o.Total)
+	}
+	return fmt.Sprintf("Exchange %s %s %s order ID=%s %s filled %v@%v", o.Exchange, o.Asset, o.Pair, o.OrderID, o.Side, o.Amount, o.Price)
+}
+
+// EventType returns the event type of the payload
+func (o *OrderStatus) EventType() string {
+	if strings.EqualFold(o.Status, "expired") {
+		return OrderExpiredEventType
+	}
+	return OrderRejectedEventType
+}
+
+// String implements the stringer interface
+func (o *OrderStatus) String() string {
+	return fmt.Sprintf("Exchange %s %s %s order ID=%s %s %s", o.Exchange, o.Asset, o.Pair, o.OrderID, o.Side, strings.ToLower(o.Status))
+}
+
+// EventType returns the event type of the payload
+func (p *PositionClosed) EventType() string { return PositionClosedEventType }
+
+// String implements the stringer interface
+func (p *PositionClosed) String() string {
+	return fmt.Sprintf("Exchange %s %s %s %s position closed, realised PNL %v %s", p.Exchange, p.Asset, p.Pair, p.Direction, p.RealisedPNL, p.Currency)
+}
+
+// EventType returns the event type of the payload
+func (w *WithdrawalStatus) EventType() string {
+	if w.Stuck {
+		return WithdrawalStuckEventType
+	}
+	return WithdrawalStatusEventType
+}
+
+// String implements the stringer interface
+func (w *WithdrawalStatus) String() string {
+	s := fmt.Sprintf("Exchange %s withdrawal ID=%s of %v %s is %s", w.Exchange, w.ID, w.Amount, w.Currency, w.Status)
+	if w.Stuck {
+		s += fmt.Sprintf(" and has not completed after %s", w.Age)
+	}
+	if w.TxID != "" {
+		s += ", tx " + w.TxID
+	}
+	return s
+}
+
+// EventType returns the event type of the payload
+func (e *ExchangeDisconnect) EventType() string { return ExchangeDisconnectEventType }
+
+// String implements the stringer interface
+func (e *ExchangeDisconnect) String() string {
+	return fmt.Sprintf("Exchange %s disconnected: %s", e.Exchange, e.Reason)
+}
+
+// EventType returns the event type of the payload
+func (e *ExchangeHealth) EventType() string {
+	if e.Recovered {
+		return ExchangeRecoveredEventType
+	}
+	return ExchangeIncidentEventType
+}
+
+// String implements the stringer interface
+func (e *ExchangeHealth) String() string {
+	if e.Recovered {
+		return fmt.Sprintf("Exchange %s recovered from %s", e.Exchange, e.Previous)
+	}
+	return fmt.Sprintf("Exchange %s is %s: %s", e.Exchange, e.Status, strings.Join(e.Reasons, ", "))
+}
+
+// EventType returns the event type of the payload
+func (c *CarryOpportunity) EventType() string { return CarryOpportunityEventType }
+
+// String implements the stringer interface
+func (c *CarryOpportunity) String() string {
+	return fmt.Sprintf("Exchange %s %s %s annualised %s carry against %s is %.2f%%, threshold %.2f%%", c.Exchange, c.Asset, c.Pair, c.Kind, c.Underlying, c.Annualised*100, c.Threshold*100)
+}
+
+// EventType returns the event type of the payload
+func (r *RiskBreach) EventType() string { return RiskBreachEventType }
+
+// String implements the stringer interface
+func (r *RiskBreach) String() string {
+	return fmt.Sprintf("Exchange %s breached risk rule %s: %v exceeds limit %v", r.Exchange, r.Rule, r.Value, r.Limit)
+}
+
+// EventType returns the event type of the payload
+func (m *MarginHealth) EventType() string {
+	if m.Recovered {
+		return MarginRecoveredEventType
+	}
+	return MarginAlertEventType
+}
+
+// String implements the stringer interface
+func (m *MarginHealth) String() string {
+	if m.Recovered {
+		return fmt.Sprintf("Exchange %s %s %s %s position%s recovered from margin level %s", m.Exchange, m.Asset, m.Pair, m.Side, accountSuffix(m.Account), m.Previous)
+	}
+	return fmt.Sprintf("Exchange %s %s %s %s position%s is at margin level %s: %s", m.Exchange, m.Asset, m.Pair, m.Side, accountSuffix(m.Account), m.Level, strings.Join(m.Reasons, ", "))
+}
+
+// EventType returns the event type of the payload
+func (m *MarginDeRisk) EventType() string { return MarginDeRiskEventType }
+
+// String implements the stringer interface
+func (m *MarginDeRisk) String() string {
+	if m.Error != "" {
+		return fmt.Sprintf("Exchange %s %s %s %s position%s failed to reduce by %v at margin level %s: %s", m.Exchange, m.Asset, m.Pair, m.Side, accountSuffix(m.Account), m.Amount, m.Level, m.Error)
+	}
+	return fmt.Sprintf("Exchange %s %s %s %s position%s reduced by %v at margin level %s, order ID=%s", m.Exchange, m.Asset, m.Pair, m.Side, accountSuffix(m.Account), m.Amount, m.Level, m.OrderID)
+}
+
+// EventType returns the event type of the payload
+func (r *RebalanceTransfer) EventType() string { return RebalanceTransferEventType }
+
+// String implements the stringer interface
+func (r *RebalanceTransfer) String() string {
+	chain := ""
+	if r.Chain != "" {
+		chain = " on chain " + r.Chain
+	}
+	if r.Error != "" {
+		return fmt.Sprintf("Rebalance transfer %s of %v %s from %s to %s%s %s: %s", r.ID, r.Amount, r.Currency, r.From, r.To, chain, r.Status, r.Error)
+	}
+	return fmt.Sprintf("Rebalance transfer %s of %v %s from %s to %s%s %s", r.ID, r.Amount, r.Currency, r.From, r.To, chain, r.Status)
+}
+
+// EventType returns the event type of the payload
+func (w *WithdrawalApproval) EventType() string { return WithdrawalApprovalEventType }
+
+// String implements the stringer interface
+func (w *WithdrawalApproval) String() string {
+	s := fmt.Sprintf("Withdrawal %s of %v %s from %s", w.ID, w.Amount, w.Currency, w.Exchange)
+	if w.Address != "" {
+		s += " to " + w.Address
+	}
+	s += " " + w.Status
+	if w.Required > 0 {
+		s += fmt.Sprintf(" with %d/%d approvals", w.Approvals, w.Required)
+	}
+	if w.Reason != "" {
+		s += ": " + w.Reason
+	}
+	return s
+}
+
+func accountSuffix(account string) string {
+	if account == "" {
+		return ""
+	}
+	return " for account " + account
+}
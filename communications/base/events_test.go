@@ -0,0 +1,62 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeverity(t *testing.T) {
+	t.Parallel()
+	for _, s := range []Severity{SeverityInfo, SeverityWarning, SeverityCritical} {
+		parsed, err := ParseSeverity(s.String())
+		require.NoError(t, err, "ParseSeverity must not error")
+		assert.Equal(t, s, parsed, "ParseSeverity should return the severity")
+	}
+	parsed, err := ParseSeverity("WARN")
+	require.NoError(t, err, "ParseSeverity must not error")
+	assert.Equal(t, SeverityWarning, parsed, "ParseSeverity should be case insensitive")
+	_, err = ParseSeverity("urgent")
+	assert.ErrorIs(t, err, errInvalidSeverity)
+	assert.Equal(t, "severity(9)", Severity(9).String(), "String should return an unknown severity")
+}
+
+func TestNewEvent(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		payload   Payload
+		eventType string
+		message   string
+	}{
+		{&OrderFilled{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT", Side: "BUY", OrderID: "1", Price: 100, Amount: 0.5}, OrderFilledEventType, "Exchange Binance spot BTC-USDT order ID=1 BUY filled 0.5@100"},
+		{&OrderFilled{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT", Side: "BUY", OrderID: "1", Price: 100, Amount: 0.5, Executed: 0.5, Total: 2, Partial: true}, OrderPartiallyFilledEventType, "Exchange Binance spot BTC-USDT order ID=1 BUY partially filled 0.5@100, 0.5 of 2 executed"},
+		{&OrderStatus{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT", Side: "BUY", OrderID: "1", Status: "REJECTED"}, OrderRejectedEventType, "Exchange Binance spot BTC-USDT order ID=1 BUY rejected"},
+		{&OrderStatus{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT", Side: "BUY", OrderID: "1", Status: "EXPIRED"}, OrderExpiredEventType, "Exchange Binance spot BTC-USDT order ID=1 BUY expired"},
+		{&PositionClosed{Exchange: "Binance", Asset: "usdtmarginedfutures", Pair: "BTC-USDT", Direction: "LONG", RealisedPNL: 12.5, Currency: "USDT"}, PositionClosedEventType, "Exchange Binance usdtmarginedfutures BTC-USDT LONG position closed, realised PNL 12.5 USDT"},
+		{&WithdrawalStatus{Exchange: "Kraken", ID: "2", Currency: "BTC", Amount: 1, Status: "completed"}, WithdrawalStatusEventType, "Exchange Kraken withdrawal ID=2 of 1 BTC is completed"},
+		{&WithdrawalStatus{Exchange: "Kraken", ID: "2", Currency: "BTC", Amount: 1, Status: "completed", TxID: "0xabc"}, WithdrawalStatusEventType, "Exchange Kraken withdrawal ID=2 of 1 BTC is completed, tx 0xabc"},
+		{&WithdrawalStatus{Exchange: "Kraken", ID: "2", Currency: "BTC", Amount: 1, Status: "pending", Stuck: true, Age: 3 * time.Hour}, WithdrawalStuckEventType, "Exchange Kraken withdrawal ID=2 of 1 BTC is pending and has not completed after 3h0m0s"},
+		{&ExchangeDisconnect{Exchange: "Bitstamp", Reason: "websocket closed"}, ExchangeDisconnectEventType, "Exchange Bitstamp disconnected: websocket closed"},
+		{&ExchangeHealth{Exchange: "Bitstamp", Status: "down", Previous: "healthy", Reasons: []string{"websocket disconnected", "REST error rate 60%"}}, ExchangeIncidentEventType, "Exchange Bitstamp is down: websocket disconnected, REST error rate 60%"},
+		{&ExchangeHealth{Exchange: "Bitstamp", Status: "healthy", Previous: "down", Recovered: true}, ExchangeRecoveredEventType, "Exchange Bitstamp recovered from down"},
+		{&CarryOpportunity{Exchange: "Binance", Asset: "usdtmarginedfutures", Pair: "BTC-USDT", Underlying: "BTC-USDT", Kind: "funding", Annualised: 0.2190, Threshold: 0.15}, CarryOpportunityEventType, "Exchange Binance usdtmarginedfutures BTC-USDT annualised funding carry against BTC-USDT is 21.90%, threshold 15.00%"},
+		{&RiskBreach{Rule: "max position", Exchange: "Okx", Value: 12, Limit: 10}, RiskBreachEventType, "Exchange Okx breached risk rule max position: 12 exceeds limit 10"},
+		{&MarginHealth{Exchange: "Okx", Asset: "perpetualswap", Pair: "BTC-USDT", Side: "LONG", Level: "critical", Previous: "warning", Reasons: []string{"margin ratio 82%", "3.10% from liquidation"}}, MarginAlertEventType, "Exchange Okx perpetualswap BTC-USDT LONG position is at margin level critical: margin ratio 82%, 3.10% from liquidation"},
+		{&MarginHealth{Exchange: "Okx", Account: "sub1", Asset: "perpetualswap", Pair: "BTC-USDT", Side: "LONG", Previous: "critical", Recovered: true}, MarginRecoveredEventType, "Exchange Okx perpetualswap BTC-USDT LONG position for account sub1 recovered from margin level critical"},
+		{&MarginDeRisk{Exchange: "Okx", Asset: "perpetualswap", Pair: "BTC-USDT", Side: "SHORT", Level: "critical", Amount: 0.5, OrderID: "1337"}, MarginDeRiskEventType, "Exchange Okx perpetualswap BTC-USDT SHORT position reduced by 0.5 at margin level critical, order ID=1337"},
+		{&MarginDeRisk{Exchange: "Okx", Account: "sub1", Asset: "perpetualswap", Pair: "BTC-USDT", Side: "SHORT", Level: "critical", Amount: 0.5, Error: "insufficient balance"}, MarginDeRiskEventType, "Exchange Okx perpetualswap BTC-USDT SHORT position for account sub1 failed to reduce by 0.5 at margin level critical: insufficient balance"},
+		{&RebalanceTransfer{ID: "1", Currency: "BTC", From: "Binance", To: "Kraken", Chain: "BTC", Amount: 0.25, Status: "pending approval"}, RebalanceTransferEventType, "Rebalance transfer 1 of 0.25 BTC from Binance to Kraken on chain BTC pending approval"},
+		{&RebalanceTransfer{ID: "2", Currency: "USDT", From: "Binance", To: "Kraken", Amount: 1000, Status: "failed", Error: "address is not whitelisted for withdrawals"}, RebalanceTransferEventType, "Rebalance transfer 2 of 1000 USDT from Binance to Kraken failed: address is not whitelisted for withdrawals"},
+		{&WithdrawalApproval{ID: "1", Exchange: "Binance", Currency: "BTC", Amount: 1, Address: "bc1q", Status: "pending approval", Approvals: 1, Required: 2}, WithdrawalApprovalEventType, "Withdrawal 1 of 1 BTC from Binance to bc1q pending approval with 1/2 approvals"},
+		{&WithdrawalApproval{ID: "2", Exchange: "Binance", Currency: "USD", Amount: 100, Status: "denied", Reason: "daily limit exceeded"}, WithdrawalApprovalEventType, "Withdrawal 2 of 100 USD from Binance denied: daily limit exceeded"},
+	} {
+		e := NewEvent(tc.payload, SeverityCritical)
+		assert.Equal(t, tc.eventType, e.Type, "NewEvent should set the payload event type")
+		assert.Equal(t, tc.message, e.Message, "NewEvent should set the payload message")
+		assert.Equal(t, SeverityCritical, e.Severity, "NewEvent should set the severity")
+		assert.Equal(t, tc.payload, e.Data, "NewEvent should set the payload")
+		assert.False(t, e.Time.IsZero(), "NewEvent should set the time")
+	}
+}
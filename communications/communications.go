@@ -4,15 +4,19 @@ import (
 	"errors"
 
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/discord"
+	"github.com/thrasher-corp/gocryptotrader/communications/matrix"
 	"github.com/thrasher-corp/gocryptotrader/communications/slack"
 	"github.com/thrasher-corp/gocryptotrader/communications/smsglobal"
 	"github.com/thrasher-corp/gocryptotrader/communications/smtpservice"
 	"github.com/thrasher-corp/gocryptotrader/communications/telegram"
+	"github.com/thrasher-corp/gocryptotrader/communications/webhook"
 )
 
 // Communications is the overarching type across the communications packages
 type Communications struct {
 	base.IComm
+	notifier *notifier
 }
 
 // ErrNoRelayersEnabled returns when no communication relayers are enabled
@@ -24,7 +28,11 @@ func NewComm(cfg *base.CommunicationsConfig) (*Communications, error) {
 		return nil, ErrNoRelayersEnabled
 	}
 
-	var comm Communications
+	n, err := newNotifier(&cfg.Notifications)
+	if err != nil {
+		return nil, err
+	}
+	comm := Communications{notifier: n}
 	if cfg.TelegramConfig.Enabled {
 		Telegram := new(telegram.Telegram)
 		Telegram.Setup(cfg)
@@ -49,6 +57,24 @@ func NewComm(cfg *base.CommunicationsConfig) (*Communications, error) {
 		comm.IComm = append(comm.IComm, Slack)
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		Webhook := new(webhook.Webhook)
+		Webhook.Setup(cfg)
+		comm.IComm = append(comm.IComm, Webhook)
+	}
+
+	if cfg.DiscordConfig.Enabled {
+		Discord := new(discord.Discord)
+		Discord.Setup(cfg)
+		comm.IComm = append(comm.IComm, Discord)
+	}
+
+	if cfg.MatrixConfig.Enabled {
+		Matrix := new(matrix.Matrix)
+		Matrix.Setup(cfg)
+		comm.IComm = append(comm.IComm, Matrix)
+	}
+
 	comm.Setup()
 	return &comm, nil
 }
@@ -0,0 +1,43 @@
+// Package discord is used to relay events to a Discord channel through a
+// channel webhook, see https://discord.com/developers/docs/resources/webhook
+package discord
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/webhook"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	contentTemplate = `{"content":{{json (printf "%s: %s" .Type .Message)}}`
+	maxRetries      = 3
+	rateLimit       = 500 * time.Millisecond
+)
+
+// Discord relays events to a Discord channel webhook
+type Discord struct {
+	webhook.Webhook
+	Username string
+}
+
+// Setup takes in a Discord configuration and sets the channel webhook URL
+func (d *Discord) Setup(cfg *base.CommunicationsConfig) {
+	d.Username = cfg.DiscordConfig.Username
+	body := contentTemplate
+	if d.Username != "" {
+		if username, err := json.Marshal(d.Username); err == nil {
+			body += `,"username":` + string(username)
+		}
+	}
+	d.SetupWebhook(&base.WebhookConfig{
+		Name:         cfg.DiscordConfig.Name,
+		Enabled:      cfg.DiscordConfig.Enabled,
+		Verbose:      cfg.DiscordConfig.Verbose,
+		URL:          cfg.DiscordConfig.WebhookURL,
+		BodyTemplate: body + "}",
+		MaxRetries:   maxRetries,
+		RateLimit:    rateLimit,
+	})
+}
@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	bodies := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "PushEvent should POST to the webhook")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		bodies <- string(body)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	var d Discord
+	d.Setup(&base.CommunicationsConfig{DiscordConfig: base.DiscordConfig{
+		Name:       "Discord",
+		Enabled:    true,
+		WebhookURL: srv.URL,
+		Username:   `GCT "bot"`,
+	}})
+	assert.Equal(t, "Discord", d.GetName(), "Setup should set the name")
+	assert.True(t, d.IsEnabled(), "Setup should set enabled")
+	require.NoError(t, d.Connect(), "Connect must not error")
+	require.NoError(t, d.PushEvent(base.Event{Type: "order", Message: "filled"}), "PushEvent must not error")
+	assert.JSONEq(t, `{"content":"order: filled","username":"GCT \"bot\""}`, <-bodies, "PushEvent should send the Discord message")
+}
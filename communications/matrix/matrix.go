@@ -0,0 +1,48 @@
+// Package matrix is used to relay events to a Matrix room using the
+// client-server API, see https://spec.matrix.org/latest/client-server-api
+package matrix
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/webhook"
+)
+
+const (
+	// sendPath uses the event ID as the transaction ID so retried requests are
+	// not posted to the room twice
+	sendPath     = "/_matrix/client/v3/rooms/%s/send/m.room.message/{{.ID}}"
+	bodyTemplate = `{"msgtype":"m.text","body":{{json (printf "%s: %s" .Type .Message)}}}`
+	maxRetries   = 3
+	rateLimit    = 200 * time.Millisecond
+)
+
+// Matrix relays events to a Matrix room
+type Matrix struct {
+	webhook.Webhook
+	HomeserverURL string
+	RoomID        string
+}
+
+// Setup takes in a Matrix configuration and sets the homeserver, room and
+// access token
+func (m *Matrix) Setup(cfg *base.CommunicationsConfig) {
+	m.HomeserverURL = cfg.MatrixConfig.HomeserverURL
+	m.RoomID = cfg.MatrixConfig.RoomID
+	m.SetupWebhook(&base.WebhookConfig{
+		Name:         cfg.MatrixConfig.Name,
+		Enabled:      cfg.MatrixConfig.Enabled,
+		Verbose:      cfg.MatrixConfig.Verbose,
+		URL:          strings.TrimSuffix(m.HomeserverURL, "/") + fmt.Sprintf(sendPath, url.PathEscape(m.RoomID)),
+		Method:       http.MethodPut,
+		Headers:      map[string]string{"Authorization": "Bearer " + cfg.MatrixConfig.AccessToken},
+		BodyTemplate: bodyTemplate,
+		MaxRetries:   maxRetries,
+		RateLimit:    rateLimit,
+	})
+}
@@ -0,0 +1,47 @@
+package matrix
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	type received struct{ path, auth, body string }
+	reqs := make(chan received, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "PushEvent should PUT the room event")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		reqs <- received{r.URL.EscapedPath(), r.Header.Get("Authorization"), string(body)}
+		_, err = rw.Write([]byte(`{"event_id":"$1"}`))
+		assert.NoError(t, err, "Write should not error")
+	}))
+	defer srv.Close()
+
+	var m Matrix
+	m.Setup(&base.CommunicationsConfig{MatrixConfig: base.MatrixConfig{
+		Name:          "Matrix",
+		Enabled:       true,
+		HomeserverURL: srv.URL + "/",
+		RoomID:        "!room:matrix.org",
+		AccessToken:   "token",
+	}})
+	assert.Equal(t, "Matrix", m.GetName(), "Setup should set the name")
+	require.NoError(t, m.Connect(), "Connect must not error")
+	for range 2 {
+		require.NoError(t, m.PushEvent(base.Event{Type: "order", Message: "filled"}), "PushEvent must not error")
+	}
+
+	first, second := <-reqs, <-reqs
+	assert.Equal(t, "Bearer token", first.auth, "PushEvent should send the access token")
+	assert.Regexp(t, `^/_matrix/client/v3/rooms/%21room:matrix.org/send/m.room.message/\d+-1$`, first.path, "PushEvent should send to the room")
+	assert.NotEqual(t, first.path, second.path, "PushEvent should use a new transaction ID for each event")
+	assert.JSONEq(t, `{"msgtype":"m.text","body":"order: filled"}`, first.body, "PushEvent should send a text message")
+}
@@ -0,0 +1,283 @@
+package communications
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	// DigestEventType is the event type of the digests of batched info events
+	DigestEventType = "digest"
+	// DefaultTemplate is the event type key of the template used for event
+	// types without a template
+	DefaultTemplate = "default"
+)
+
+var (
+	errRouteRelayerEmpty = errors.New("notification route relayer is empty")
+	errThrottleInvalid   = errors.New("notification throttle limit and window must both be set")
+)
+
+// notifier applies the notification rules to events before they are pushed to
+// the relayers
+type notifier struct {
+	routes         map[string][]route
+	templates      map[string]map[string]*template.Template
+	dedupeWindow   time.Duration
+	throttleLimit  int
+	throttleWindow time.Duration
+	digestInterval time.Duration
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	sent      map[string][]time.Time
+	throttled map[string]int
+	digests   map[string][]base.Event
+}
+
+// route is a parsed notification route
+type route struct {
+	severities []base.Severity
+	eventTypes []string
+}
+
+// delivery is an event rendered for a relayer
+type delivery struct {
+	relayer base.ICommunicate
+	event   base.Event
+}
+
+// newNotifier parses the notification rules
+func newNotifier(cfg *base.NotificationsConfig) (*notifier, error) {
+	if (cfg.ThrottleLimit > 0) != (cfg.ThrottleWindow > 0) {
+		return nil, errThrottleInvalid
+	}
+	n := &notifier{
+		routes:         make(map[string][]route),
+		templates:      make(map[string]map[string]*template.Template),
+		dedupeWindow:   cfg.DedupeWindow,
+		throttleLimit:  cfg.ThrottleLimit,
+		throttleWindow: cfg.ThrottleWindow,
+		digestInterval: cfg.DigestInterval,
+		seen:           make(map[string]time.Time),
+		sent:           make(map[string][]time.Time),
+		throttled:      make(map[string]int),
+		digests:        make(map[string][]base.Event),
+	}
+	for i := range cfg.Routes {
+		if cfg.Routes[i].Relayer == "" {
+			return nil, errRouteRelayerEmpty
+		}
+		r := route{eventTypes: cfg.Routes[i].EventTypes}
+		for _, name := range cfg.Routes[i].Severities {
+			s, err := base.ParseSeverity(name)
+			if err != nil {
+				return nil, fmt.Errorf("%s route: %w", cfg.Routes[i].Relayer, err)
+			}
+			r.severities = append(r.severities, s)
+		}
+		relayer := strings.ToLower(cfg.Routes[i].Relayer)
+		n.routes[relayer] = append(n.routes[relayer], r)
+	}
+	for relayer, templates := range cfg.Templates {
+		parsed := make(map[string]*template.Template, len(templates))
+		for eventType, text := range templates {
+			t, err := template.New(relayer + " " + eventType).Parse(text)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s template: %w", relayer, eventType, err)
+			}
+			parsed[strings.ToLower(eventType)] = t
+		}
+		n.templates[strings.ToLower(relayer)] = parsed
+	}
+	return n, nil
+}
+
+// PushEvent pushes an event to the enabled relayers it is routed to. Duplicate
+// events are dropped, info events are held for the digest when digests are
+// enabled and events beyond a relayer's throttle limit are dropped
+func (c *Communications) PushEvent(evt base.Event) {
+	if c.notifier == nil {
+		c.IComm.PushEvent(evt)
+		return
+	}
+	now := time.Now()
+	if evt.Time.IsZero() {
+		evt.Time = now
+	}
+	c.push(c.notifier.process(c.IComm, evt, now))
+}
+
+// FlushDigest pushes a digest of the held info events to each relayer
+func (c *Communications) FlushDigest() {
+	if c.notifier == nil {
+		return
+	}
+	c.push(c.notifier.flushDigests(c.IComm, time.Now()))
+}
+
+// DigestInterval returns the interval at which digests should be flushed, zero
+// when digests are disabled
+func (c *Communications) DigestInterval() time.Duration {
+	if c.notifier == nil {
+		return 0
+	}
+	return c.notifier.digestInterval
+}
+
+func (c *Communications) push(deliveries []delivery) {
+	for i := range deliveries {
+		if err := deliveries[i].relayer.PushEvent(deliveries[i].event); err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications error - PushEvent() in package %s with %v. Err %s",
+				deliveries[i].relayer.GetName(), deliveries[i].event, err)
+		}
+	}
+}
+
+// process returns the deliveries of an event
+func (n *notifier) process(relayers base.IComm, evt base.Event, now time.Time) []delivery {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.isDuplicate(&evt, now) {
+		return nil
+	}
+	var deliveries []delivery
+	for _, r := range relayers {
+		if !r.IsEnabled() || !r.IsConnected() {
+			continue
+		}
+		name := strings.ToLower(r.GetName())
+		if !n.isRouted(name, &evt) {
+			continue
+		}
+		if n.digestInterval > 0 && evt.Severity == base.SeverityInfo {
+			n.digests[name] = append(n.digests[name], evt)
+			continue
+		}
+		if !n.allow(name, now) {
+			continue
+		}
+		rendered := evt
+		rendered.Message = n.render(name, &evt)
+		if dropped := n.throttled[name]; dropped > 0 {
+			rendered.Message += fmt.Sprintf("\n%d notifications were throttled", dropped)
+			delete(n.throttled, name)
+		}
+		deliveries = append(deliveries, delivery{relayer: r, event: rendered})
+	}
+	return deliveries
+}
+
+// flushDigests returns a digest delivery for each relayer with held events
+func (n *notifier) flushDigests(relayers base.IComm, now time.Time) []delivery {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var deliveries []delivery
+	for _, r := range relayers {
+		name := strings.ToLower(r.GetName())
+		events := n.digests[name]
+		if len(events) == 0 {
+			continue
+		}
+		delete(n.digests, name)
+		if !r.IsEnabled() || !r.IsConnected() {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%d notifications:", len(events))
+		for i := range events {
+			sb.WriteString("\n")
+			sb.WriteString(n.render(name, &events[i]))
+		}
+		deliveries = append(deliveries, delivery{relayer: r, event: base.Event{
+			Type:    DigestEventType,
+			Message: sb.String(),
+			Data:    events,
+			Time:    now,
+		}})
+	}
+	return deliveries
+}
+
+// isDuplicate returns whether an event with the same key was seen within the
+// dedupe window
+func (n *notifier) isDuplicate(evt *base.Event, now time.Time) bool {
+	if n.dedupeWindow <= 0 {
+		return false
+	}
+	for k, t := range n.seen {
+		if now.Sub(t) >= n.dedupeWindow {
+			delete(n.seen, k)
+		}
+	}
+	key := evt.Key
+	if key == "" {
+		key = evt.Type + "|" + evt.Message
+	}
+	if _, ok := n.seen[key]; ok {
+		return true
+	}
+	n.seen[key] = now
+	return false
+}
+
+// isRouted returns whether the event is routed to the relayer
+func (n *notifier) isRouted(relayer string, evt *base.Event) bool {
+	routes, ok := n.routes[relayer]
+	if !ok {
+		return true
+	}
+	return slices.ContainsFunc(routes, func(r route) bool {
+		return (len(r.severities) == 0 || slices.Contains(r.severities, evt.Severity)) &&
+			(len(r.eventTypes) == 0 || slices.ContainsFunc(r.eventTypes, func(t string) bool {
+				return strings.EqualFold(t, evt.Type)
+			}))
+	})
+}
+
+// allow returns whether the relayer is within its throttle limit and records
+// the send if so
+func (n *notifier) allow(relayer string, now time.Time) bool {
+	if n.throttleLimit <= 0 {
+		return true
+	}
+	sent := slices.DeleteFunc(n.sent[relayer], func(t time.Time) bool {
+		return now.Sub(t) >= n.throttleWindow
+	})
+	if len(sent) >= n.throttleLimit {
+		if n.throttled[relayer] == 0 {
+			log.Warnf(log.CommunicationMgr, "Communications: %s exceeded %d notifications within %s, throttling", relayer, n.throttleLimit, n.throttleWindow)
+		}
+		n.throttled[relayer]++
+		n.sent[relayer] = sent
+		return false
+	}
+	n.sent[relayer] = append(sent, now)
+	return true
+}
+
+// render returns the event message from the relayer template for the event
+// type, the event message is used when the relayer has no template
+func (n *notifier) render(relayer string, evt *base.Event) string {
+	templates := n.templates[relayer]
+	t, ok := templates[strings.ToLower(evt.Type)]
+	if !ok {
+		if t, ok = templates[DefaultTemplate]; !ok {
+			return evt.Message
+		}
+	}
+	var sb strings.Builder
+	if err := t.Execute(&sb, evt); err != nil {
+		log.Errorf(log.CommunicationMgr, "Communications: %s template for %s event failed: %v", relayer, evt.Type, err)
+		return evt.Message
+	}
+	return sb.String()
+}
@@ -0,0 +1,127 @@
+package communications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+// testRelayer records the events pushed to it
+type testRelayer struct {
+	base.Base
+	events []base.Event
+}
+
+func (r *testRelayer) Setup(*base.CommunicationsConfig) {}
+func (r *testRelayer) Connect() error                   { return nil }
+func (r *testRelayer) PushEvent(e base.Event) error     { r.events = append(r.events, e); return nil }
+
+func newTestRelayer(name string) *testRelayer {
+	return &testRelayer{Base: base.Base{Name: name, Enabled: true, Connected: true}}
+}
+
+func TestNewNotifier(t *testing.T) {
+	t.Parallel()
+	_, err := newNotifier(&base.NotificationsConfig{ThrottleLimit: 1})
+	assert.ErrorIs(t, err, errThrottleInvalid)
+	_, err = newNotifier(&base.NotificationsConfig{Routes: []base.NotificationRoute{{}}})
+	assert.ErrorIs(t, err, errRouteRelayerEmpty)
+	_, err = newNotifier(&base.NotificationsConfig{Routes: []base.NotificationRoute{{Relayer: "Slack", Severities: []string{"urgent"}}}})
+	assert.Error(t, err, "newNotifier should error on an invalid severity")
+	_, err = newNotifier(&base.NotificationsConfig{Templates: map[string]map[string]string{"Slack": {"default": "{{"}}})
+	assert.Error(t, err, "newNotifier should error on an invalid template")
+	_, err = NewComm(&base.CommunicationsConfig{SMTPConfig: base.SMTPConfig{Enabled: true}, Notifications: base.NotificationsConfig{ThrottleWindow: time.Second}})
+	assert.ErrorIs(t, err, errThrottleInvalid)
+}
+
+func TestPushEventRouting(t *testing.T) {
+	t.Parallel()
+	n, err := newNotifier(&base.NotificationsConfig{
+		Routes: []base.NotificationRoute{
+			{Relayer: "SMSGlobal", Severities: []string{"critical"}},
+			{Relayer: "slack", Severities: []string{"info"}},
+			{Relayer: "Slack", EventTypes: []string{base.OrderFilledEventType}},
+		},
+		Templates: map[string]map[string]string{
+			"Slack": {
+				"default":                 "{{.Severity}} {{.Type}}: {{.Message}}",
+				base.OrderFilledEventType: "Filled {{.Data.Amount}} {{.Data.Pair}} on {{.Data.Exchange}}",
+			},
+			"SMSGlobal": {"default": "{{.Data.Missing}}"},
+		},
+	})
+	require.NoError(t, err, "newNotifier must not error")
+	sms, slack, other := newTestRelayer("SMSGlobal"), newTestRelayer("Slack"), newTestRelayer("SMTP")
+	c := &Communications{IComm: base.IComm{sms, slack, other}, notifier: n}
+
+	c.PushEvent(base.Event{Type: "order", Message: "cancelled"})
+	c.PushEvent(base.Event{Type: "order", Message: "cancel failed", Severity: base.SeverityCritical})
+	c.PushEvent(base.NewEvent(&base.OrderFilled{Exchange: "Binance", Pair: "BTC-USDT", Amount: 2}, base.SeverityWarning))
+
+	require.Len(t, sms.events, 1, "SMSGlobal must only receive critical events")
+	assert.Equal(t, "cancel failed", sms.events[0].Message, "PushEvent should use the message when the template fails")
+	require.Len(t, slack.events, 2, "Slack must receive info and order filled events")
+	assert.Equal(t, "info order: cancelled", slack.events[0].Message, "PushEvent should render the default template")
+	assert.False(t, slack.events[0].Time.IsZero(), "PushEvent should set the event time")
+	assert.Equal(t, "Filled 2 BTC-USDT on Binance", slack.events[1].Message, "PushEvent should render the event type template")
+	assert.Len(t, other.events, 3, "A relayer without routes should receive every event")
+}
+
+func TestPushEventDedupeAndThrottle(t *testing.T) {
+	t.Parallel()
+	n, err := newNotifier(&base.NotificationsConfig{DedupeWindow: time.Hour, ThrottleLimit: 2, ThrottleWindow: time.Hour})
+	require.NoError(t, err, "newNotifier must not error")
+	r := newTestRelayer("Slack")
+	c := &Communications{IComm: base.IComm{r}, notifier: n}
+
+	c.PushEvent(base.Event{Type: "order", Message: "1"})
+	c.PushEvent(base.Event{Type: "order", Message: "1"})
+	c.PushEvent(base.Event{Type: "order", Message: "2", Key: "k"})
+	c.PushEvent(base.Event{Type: "order", Message: "3", Key: "k"})
+	require.Len(t, r.events, 2, "PushEvent must drop duplicate events")
+
+	c.PushEvent(base.Event{Type: "order", Message: "4"})
+	c.PushEvent(base.Event{Type: "order", Message: "5"})
+	require.Len(t, r.events, 2, "PushEvent must drop events beyond the throttle limit")
+	assert.Equal(t, 2, n.throttled["slack"], "PushEvent should count throttled events")
+
+	n.sent["slack"] = nil
+	c.PushEvent(base.Event{Type: "order", Message: "6"})
+	require.Len(t, r.events, 3, "PushEvent must send once the throttle window passes")
+	assert.Equal(t, "6\n2 notifications were throttled", r.events[2].Message, "PushEvent should report throttled events")
+
+	n.seen["order|1"] = time.Now().Add(-time.Hour)
+	c.PushEvent(base.Event{Type: "order", Message: "1"})
+	assert.Len(t, r.events, 4, "PushEvent should send a duplicate after the dedupe window")
+}
+
+func TestFlushDigest(t *testing.T) {
+	t.Parallel()
+	var c Communications
+	c.FlushDigest()
+	assert.Zero(t, c.DigestInterval(), "DigestInterval should be zero without a notifier")
+
+	n, err := newNotifier(&base.NotificationsConfig{DigestInterval: time.Minute})
+	require.NoError(t, err, "newNotifier must not error")
+	r, offline := newTestRelayer("Slack"), newTestRelayer("SMTP")
+	c = Communications{IComm: base.IComm{r, offline}, notifier: n}
+	assert.Equal(t, time.Minute, c.DigestInterval(), "DigestInterval should return the digest interval")
+
+	c.PushEvent(base.Event{Type: "order", Message: "1"})
+	c.PushEvent(base.Event{Type: "order", Message: "2"})
+	c.PushEvent(base.Event{Type: "order", Message: "3", Severity: base.SeverityWarning})
+	require.Len(t, r.events, 1, "PushEvent must only send events above info")
+	offline.Connected = false
+
+	c.FlushDigest()
+	require.Len(t, r.events, 2, "FlushDigest must send a digest")
+	assert.Equal(t, DigestEventType, r.events[1].Type, "FlushDigest should send a digest event")
+	assert.Equal(t, "2 notifications:\n1\n2", r.events[1].Message, "FlushDigest should batch the info events")
+	assert.Len(t, offline.events, 1, "FlushDigest should not send to a disconnected relayer")
+	assert.Empty(t, n.digests, "FlushDigest should clear the held events")
+	c.FlushDigest()
+	assert.Len(t, r.events, 2, "FlushDigest should not send an empty digest")
+}
@@ -40,6 +40,8 @@ type Slack struct {
 
 	TargetChannel     string
 	VerificationToken string
+	CommandUsers      []string
+	Commands          *base.Commands
 
 	TargetChannelID string
 	Details         Response
@@ -63,6 +65,12 @@ func (s *Slack) Setup(cfg *base.CommunicationsConfig) {
 	s.Verbose = cfg.SlackConfig.Verbose
 	s.TargetChannel = cfg.SlackConfig.TargetChannel
 	s.VerificationToken = cfg.SlackConfig.VerificationToken
+	s.CommandUsers = cfg.SlackConfig.CommandUsers
+}
+
+// SetCommands sets the chat commands which command users can run
+func (s *Slack) SetCommands(c *base.Commands) {
+	s.Commands = c
 }
 
 // Connect connects to the service
@@ -385,13 +393,26 @@ func (s *Slack) HandleMessage(msg *Message) error {
 		return errors.New("slack msg is nil")
 	}
 
+	if s.Commands != nil && s.Commands.IsCommand(msg.Text) {
+		return s.WebsocketSend("message", s.Commands.Handle(context.TODO(), &base.CommandRequest{
+			Relayer:      s.Name,
+			User:         s.GetUsernameByID(msg.User),
+			Text:         msg.Text,
+			AllowedUsers: s.CommandUsers,
+		}))
+	}
+
 	msg.Text = strings.ToLower(msg.Text)
 	switch {
 	case strings.Contains(msg.Text, cmdStatus):
 		return s.WebsocketSend("message", s.GetStatus())
 
 	case strings.Contains(msg.Text, cmdHelp):
-		return s.WebsocketSend("message", getHelp)
+		help := getHelp
+		if s.Commands != nil {
+			help += s.Commands.Help("!")
+		}
+		return s.WebsocketSend("message", help)
 
 	default:
 		return s.WebsocketSend("message", "GoCryptoTrader SlackBot - Command Unknown!")
@@ -56,6 +56,8 @@ type Telegram struct {
 	Token             string
 	Offset            int64
 	AuthorisedClients map[string]int64
+	CommandUsers      []string
+	Commands          *base.Commands
 }
 
 // IsConnected returns whether or not the connection is connected
@@ -68,6 +70,12 @@ func (t *Telegram) Setup(cfg *base.CommunicationsConfig) {
 	t.Token = cfg.TelegramConfig.VerificationToken
 	t.Verbose = cfg.TelegramConfig.Verbose
 	t.AuthorisedClients = cfg.TelegramConfig.AuthorisedClients
+	t.CommandUsers = cfg.TelegramConfig.CommandUsers
+}
+
+// SetCommands sets the chat commands which command users can run
+func (t *Telegram) SetCommands(c *base.Commands) {
+	t.Commands = c
 }
 
 // Connect starts an initial connection
@@ -129,12 +137,9 @@ func (t *Telegram) PollerStart() {
 
 		for i := range resp.Result {
 			if resp.Result[i].UpdateID > t.Offset {
-				username := resp.Result[i].Message.From.UserName
-				if id, ok := t.AuthorisedClients[username]; ok && resp.Result[i].Message.Text[0] == '/' {
-					if id == 0 {
-						t.AuthorisedClients[username] = resp.Result[i].Message.From.ID
-					}
-					err = t.HandleMessages(resp.Result[i].Message.Text, resp.Result[i].Message.From.ID)
+				msg := &resp.Result[i].Message
+				if _, ok := t.authorisedUser(&msg.From); ok && strings.HasPrefix(msg.Text, "/") {
+					err = t.HandleMessages(msg.Text, msg.Chat.ID, msg.From.ID)
 					if err != nil {
 						log.Errorf(log.CommunicationMgr, "Telegram: Unable to HandleMessages. Error: %s\n", err)
 						continue
@@ -162,14 +167,12 @@ func (t *Telegram) InitialConnect() error {
 	for i := range resp.Result {
 		if resp.Result[i].Message.From.UserName != "" && resp.Result[i].Message.From.ID != 0 {
 			username := resp.Result[i].Message.From.UserName
-			if _, ok := t.AuthorisedClients[username]; !ok {
+			if _, ok := t.authorisedUser(&resp.Result[i].Message.From); !ok {
 				if !knownBadUsers[username] {
 					log.Warnf(log.CommunicationMgr, "Telegram: Received message from unauthorised user: %s\n", username)
 					knownBadUsers[username] = true
 				}
-				continue
 			}
-			t.AuthorisedClients[username] = resp.Result[i].Message.From.ID
 		}
 	}
 
@@ -192,15 +195,30 @@ func (t *Telegram) InitialConnect() error {
 	return nil
 }
 
-// HandleMessages handles incoming message from the long polling routine
-func (t *Telegram) HandleMessages(text string, chatID int64) error {
+// HandleMessages handles incoming message from the long polling routine,
+// replying to the chat the message was sent in. Chat commands are authorised by
+// the user ID of the sender
+func (t *Telegram) HandleMessages(text string, chatID, senderID int64) error {
 	if t.Verbose {
 		log.Debugf(log.CommunicationMgr, "Telegram: Received message: %s\n", text)
 	}
 
+	if t.Commands != nil && t.Commands.IsCommand(text) {
+		return t.SendMessage(t.Commands.Handle(context.TODO(), &base.CommandRequest{
+			Relayer:      t.Name,
+			User:         t.getUserByID(senderID),
+			Text:         text,
+			AllowedUsers: t.CommandUsers,
+		}), chatID)
+	}
+
 	switch {
 	case strings.Contains(text, cmdHelp):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
+		reply := cmdHelpReply
+		if t.Commands != nil {
+			reply += t.Commands.Help("/")
+		}
+		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, reply), chatID)
 
 	case strings.Contains(text, cmdStart):
 		return t.SendMessage(talkRoot+": START COMMANDS HERE", chatID)
@@ -213,6 +231,36 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 	}
 }
 
+// getUserByID returns the authorised client user name for a user ID
+func (t *Telegram) getUserByID(userID int64) string {
+	if userID == 0 {
+		return ""
+	}
+	for user, id := range t.AuthorisedClients {
+		if id == userID {
+			return user
+		}
+	}
+	return ""
+}
+
+// authorisedUser returns the authorised client user name of a message sender,
+// recording the sender's user ID when the client first messages the bot. Once
+// recorded the user ID must match, so an account which later takes an
+// authorised client's username is not authorised
+func (t *Telegram) authorisedUser(from *UserType) (string, bool) {
+	id, ok := t.AuthorisedClients[from.UserName]
+	switch {
+	case !ok || from.ID == 0:
+		return "", false
+	case id == 0:
+		t.AuthorisedClients[from.UserName] = from.ID
+	case id != from.ID:
+		return "", false
+	}
+	return from.UserName, true
+}
+
 // GetUpdates gets new updates via a long poll connection
 func (t *Telegram) GetUpdates() (GetUpdateResponse, error) {
 	var newUpdates GetUpdateResponse
@@ -1,9 +1,11 @@
 package telegram
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 )
@@ -63,7 +65,7 @@ func TestHandleMessages(t *testing.T) {
 	t.Parallel()
 	var T Telegram
 	for _, c := range []string{cmdHelp, cmdStart, cmdStatus, "Not a command"} {
-		assert.ErrorContainsf(t, T.HandleMessages(c, 1337), testErrNotFound,
+		assert.ErrorContainsf(t, T.HandleMessages(c, 1337, 1337), testErrNotFound,
 			"HandleMessages with command %q should error correctly", c)
 	}
 }
@@ -99,3 +101,40 @@ func TestSendHTTPRequest(t *testing.T) {
 		t.Error("telegram SendHTTPRequest() error")
 	}
 }
+
+func TestHandleMessagesCommands(t *testing.T) {
+	t.Parallel()
+	T := Telegram{AuthorisedClients: map[string]int64{"alice": 1337}, CommandUsers: []string{"alice"}}
+	c := base.NewCommands(nil)
+	var ran bool
+	require.NoError(t, c.Register(&base.Command{Name: "ping", Func: func(context.Context, []string) (string, error) {
+		ran = true
+		return "pong", nil
+	}}), "Register must not error")
+	T.SetCommands(c)
+	assert.Equal(t, c, T.Commands, "SetCommands should set the commands")
+	assert.Error(t, T.HandleMessages("/ping", -100, 1337), "HandleMessages should error sending the reply without a token")
+	assert.True(t, ran, "HandleMessages should run the command of an authorised sender in a group chat")
+	ran = false
+	assert.Error(t, T.HandleMessages("/ping", 1337, 1), "HandleMessages should error sending the reply without a token")
+	assert.False(t, ran, "HandleMessages should not run the command of an unauthorised sender in an authorised user's chat")
+	assert.Equal(t, "alice", T.getUserByID(1337), "getUserByID should return the user")
+	assert.Empty(t, T.getUserByID(1), "getUserByID should return nothing for an unknown user")
+}
+
+func TestAuthorisedUser(t *testing.T) {
+	t.Parallel()
+	T := Telegram{AuthorisedClients: map[string]int64{"alice": 0, "bob": 42}}
+	_, ok := T.authorisedUser(&UserType{ID: 7, UserName: "mallory"})
+	assert.False(t, ok, "authorisedUser should not authorise an unknown username")
+	_, ok = T.authorisedUser(&UserType{UserName: "alice"})
+	assert.False(t, ok, "authorisedUser should not authorise a sender without a user ID")
+	user, ok := T.authorisedUser(&UserType{ID: 1337, UserName: "alice"})
+	assert.True(t, ok, "authorisedUser should authorise a client's first message")
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, int64(1337), T.AuthorisedClients["alice"], "authorisedUser should record the sender's user ID")
+	_, ok = T.authorisedUser(&UserType{ID: 7, UserName: "bob"})
+	assert.False(t, ok, "authorisedUser should not authorise another account using an authorised username")
+	_, ok = T.authorisedUser(&UserType{ID: 42, UserName: "bob"})
+	assert.True(t, ok, "authorisedUser should authorise the recorded user ID")
+}
@@ -0,0 +1,338 @@
+// Package webhook is used to relay events to any HTTP endpoint as a templated
+// JSON body. Events are queued and delivered in the background, requests can
+// be signed with HMAC-SHA256, are rate limited and are retried when the
+// endpoint is unavailable or rate limits the relayer
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultBodyTemplate is used when no body template is configured
+	DefaultBodyTemplate = `{"name":{{json .Name}},"type":{{json .Type}},"message":{{json .Message}},"time":{{json .Time}}}`
+	// DefaultSignatureHeader is the header holding the request signature when
+	// a secret is configured
+	DefaultSignatureHeader = "X-GCT-Signature"
+
+	defaultRetryDelay = time.Second
+	defaultTimeout    = 15 * time.Second
+	maxRetryDelay     = time.Minute
+	// queueSize is the number of events waiting for delivery before new
+	// events are dropped
+	queueSize = 100
+	// shutdownTimeout is how long queued events are delivered for on
+	// shutdown before the remaining events are dropped
+	shutdownTimeout = 5 * time.Second
+)
+
+var (
+	// ErrNotConnected is returned when an event is pushed before Connect
+	ErrNotConnected = errors.New("webhook not connected")
+
+	errInvalidURL     = errors.New("invalid webhook URL")
+	errInvalidMethod  = errors.New("invalid webhook method")
+	errInvalidBody    = errors.New("webhook body template must produce valid JSON")
+	errRequestFailed  = errors.New("webhook request failed")
+	errRetriesExpired = errors.New("webhook retries exhausted")
+	errQueueFull      = errors.New("webhook delivery queue is full")
+)
+
+// Webhook sends events as a templated JSON body to an HTTP endpoint
+type Webhook struct {
+	base.Base
+	URL             string
+	Method          string
+	Headers         map[string]string
+	BodyTemplate    string
+	Secret          string
+	SignatureHeader string
+	MaxRetries      int
+	RetryDelay      time.Duration
+	RateLimit       time.Duration
+	// HTTPClient sends the requests, a client with a default timeout is used
+	// when nil
+	HTTPClient *http.Client
+
+	urlTemplate  *template.Template
+	bodyTemplate *template.Template
+	limiter      *rate.Limiter
+	sequence     atomic.Uint64
+	queue        chan delivery
+	stopping     chan struct{}
+	done         chan struct{}
+}
+
+// Setup takes in a webhook configuration and sets the endpoint details
+func (w *Webhook) Setup(cfg *base.CommunicationsConfig) {
+	w.SetupWebhook(&cfg.WebhookConfig)
+}
+
+// SetupWebhook sets the endpoint details from a webhook configuration. It is
+// used by relayers built on top of the webhook relayer
+func (w *Webhook) SetupWebhook(cfg *base.WebhookConfig) {
+	w.Name = cfg.Name
+	w.Enabled = cfg.Enabled
+	w.Verbose = cfg.Verbose
+	w.URL = cfg.URL
+	w.Method = cfg.Method
+	w.Headers = cfg.Headers
+	w.BodyTemplate = cfg.BodyTemplate
+	w.Secret = cfg.Secret
+	w.SignatureHeader = cfg.SignatureHeader
+	w.MaxRetries = cfg.MaxRetries
+	w.RetryDelay = cfg.RetryDelay
+	w.RateLimit = cfg.RateLimit
+}
+
+// IsConnected returns whether or not the connection is connected
+func (w *Webhook) IsConnected() bool {
+	return w.Connected
+}
+
+// Connect parses the templates, checks the endpoint URL and starts delivering
+// queued events, no request is sent until an event is pushed
+func (w *Webhook) Connect() error {
+	funcs := template.FuncMap{"json": toJSON}
+	var err error
+	if w.urlTemplate, err = template.New("url").Funcs(funcs).Parse(w.URL); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidURL, err)
+	}
+	if w.BodyTemplate == "" {
+		w.BodyTemplate = DefaultBodyTemplate
+	}
+	if w.bodyTemplate, err = template.New("body").Funcs(funcs).Parse(w.BodyTemplate); err != nil {
+		return err
+	}
+	sample := &Payload{ID: "0", Name: w.Name, Type: "test", Message: "test", Time: time.Now()}
+	u, err := execute(w.urlTemplate, sample)
+	if err != nil {
+		return err
+	}
+	if p, err := url.Parse(u); err != nil || (p.Scheme != "http" && p.Scheme != "https") || p.Host == "" {
+		return fmt.Errorf("%w: %q", errInvalidURL, u)
+	}
+	body, err := execute(w.bodyTemplate, sample)
+	if err != nil {
+		return err
+	}
+	if !json.Valid([]byte(body)) {
+		return fmt.Errorf("%w: %s", errInvalidBody, body)
+	}
+	if w.Method == "" {
+		w.Method = http.MethodPost
+	}
+	w.Method = strings.ToUpper(w.Method)
+	if w.Method != http.MethodPost && w.Method != http.MethodPut {
+		return fmt.Errorf("%w: %q", errInvalidMethod, w.Method)
+	}
+	if w.SignatureHeader == "" {
+		w.SignatureHeader = DefaultSignatureHeader
+	}
+	if w.RetryDelay <= 0 {
+		w.RetryDelay = defaultRetryDelay
+	}
+	w.limiter = rate.NewLimiter(rate.Inf, 1)
+	if w.RateLimit > 0 {
+		w.limiter = rate.NewLimiter(rate.Every(w.RateLimit), 1)
+	}
+	if w.HTTPClient == nil {
+		w.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	w.queue = make(chan delivery, queueSize)
+	w.stopping = make(chan struct{})
+	w.done = make(chan struct{})
+	go w.deliver()
+	w.Connected = true
+	return nil
+}
+
+// Shutdown stops accepting events and delivers the queued events for up to
+// the shutdown timeout, the remaining events are dropped. Connect starts
+// delivering events again
+func (w *Webhook) Shutdown() {
+	if !w.Connected {
+		return
+	}
+	w.Connected = false
+	close(w.stopping)
+	<-w.done
+}
+
+// PushEvent queues an event to be sent to the endpoint, the event is dropped
+// when the queue is full
+func (w *Webhook) PushEvent(event base.Event) error {
+	if !w.Connected {
+		return ErrNotConnected
+	}
+	p := &Payload{
+		ID:      strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + strconv.FormatUint(w.sequence.Add(1), 10),
+		Name:    w.Name,
+		Type:    event.Type,
+		Message: event.Message,
+		Time:    time.Now(),
+	}
+	path, err := execute(w.urlTemplate, p)
+	if err != nil {
+		return err
+	}
+	body, err := execute(w.bodyTemplate, p)
+	if err != nil {
+		return err
+	}
+	select {
+	case w.queue <- delivery{path: path, body: []byte(body)}:
+		return nil
+	default:
+		return fmt.Errorf("%w, dropping %s event", errQueueFull, event.Type)
+	}
+}
+
+// deliver sends queued events until shutdown, when the events still queued
+// are sent until the shutdown timeout cancels their delivery
+func (w *Webhook) deliver() {
+	defer close(w.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for {
+		select {
+		case d := <-w.queue:
+			w.sendQueued(ctx, d)
+		case <-w.stopping:
+			ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+			defer cancel()
+			for {
+				select {
+				case d := <-w.queue:
+					w.sendQueued(ctx, d)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// sendQueued sends a queued event, logging when it cannot be delivered
+func (w *Webhook) sendQueued(ctx context.Context, d delivery) {
+	if err := w.send(ctx, d.path, d.body); err != nil {
+		log.Errorf(log.CommunicationMgr, "%s: Failed to deliver event: %v", w.Name, err)
+	}
+}
+
+// Send sends a body to the endpoint path, waiting on the rate limiter before
+// each attempt and retrying with backoff on network errors, rate limiting and
+// server errors
+func (w *Webhook) Send(ctx context.Context, path string, body []byte) error {
+	if !w.Connected {
+		return ErrNotConnected
+	}
+	return w.send(ctx, path, body)
+}
+
+// send sends a body to the endpoint path with rate limiting and retries
+func (w *Webhook) send(ctx context.Context, path string, body []byte) error {
+	delay := w.RetryDelay
+	for attempt := 0; ; attempt++ {
+		if err := w.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		retryAfter, retry, err := w.sendRequest(ctx, path, body)
+		if err == nil {
+			return nil
+		}
+		if !retry {
+			return err
+		}
+		if attempt >= w.MaxRetries {
+			return fmt.Errorf("%w after %d attempts: %w", errRetriesExpired, attempt+1, err)
+		}
+		wait := max(delay, retryAfter)
+		if w.Verbose {
+			log.Debugf(log.CommunicationMgr, "%s: Retrying in %s: %v", w.Name, wait, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// sendRequest sends a single request and returns how long the endpoint asked
+// to wait and whether the request can be retried
+func (w *Webhook) sendRequest(ctx context.Context, path string, body []byte) (retryAfter time.Duration, retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, w.Method, path, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.Headers {
+		req.Header.Set(k, v)
+	}
+	if w.Secret != "" {
+		sig, err := crypto.GetHMAC(crypto.HashSHA256, body, []byte(w.Secret))
+		if err != nil {
+			return 0, false, err
+		}
+		req.Header.Set(w.SignatureHeader, "sha256="+hex.EncodeToString(sig))
+	}
+	if w.Verbose {
+		log.Debugf(log.CommunicationMgr, "%s: Sending %s %s", w.Name, w.Method, body)
+	}
+	resp, err := w.HTTPClient.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+	contents, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return 0, true, err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return 0, false, nil
+	}
+	err = fmt.Errorf("%w: %s %s", errRequestFailed, resp.Status, contents)
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+		return 0, false, err
+	}
+	if s, convErr := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); convErr == nil && s > 0 {
+		retryAfter = min(time.Duration(s*float64(time.Second)), maxRetryDelay)
+	}
+	return retryAfter, true, err
+}
+
+// execute renders a template with the event payload
+func execute(t *template.Template, p *Payload) (string, error) {
+	var sb strings.Builder
+	if err := t.Execute(&sb, p); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// toJSON is the json template function which encodes a value so it can be
+// safely embedded in a JSON body
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
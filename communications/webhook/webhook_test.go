@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	var w Webhook
+	w.Setup(&base.CommunicationsConfig{WebhookConfig: base.WebhookConfig{
+		Name:       "Webhook",
+		Enabled:    true,
+		URL:        "https://localhost",
+		Secret:     "secret",
+		MaxRetries: 2,
+		RateLimit:  time.Second,
+	}})
+	assert.Equal(t, "Webhook", w.Name, "Setup should set the name")
+	assert.True(t, w.Enabled, "Setup should set enabled")
+	assert.Equal(t, "https://localhost", w.URL, "Setup should set the URL")
+	assert.Equal(t, "secret", w.Secret, "Setup should set the secret")
+	assert.Equal(t, 2, w.MaxRetries, "Setup should set the max retries")
+	assert.Equal(t, time.Second, w.RateLimit, "Setup should set the rate limit")
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		w   *Webhook
+		err error
+	}{
+		{&Webhook{URL: "{{"}, errInvalidURL},
+		{&Webhook{URL: "localhost"}, errInvalidURL},
+		{&Webhook{URL: "ftp://localhost"}, errInvalidURL},
+		{&Webhook{URL: "https://localhost", BodyTemplate: `{"a":{{.Message}}}`}, errInvalidBody},
+		{&Webhook{URL: "https://localhost", Method: "GET"}, errInvalidMethod},
+	} {
+		assert.ErrorIs(t, tc.w.Connect(), tc.err)
+		assert.False(t, tc.w.IsConnected(), "Connect should not connect on error")
+	}
+	w := &Webhook{URL: "https://localhost/{{.ID}}", BodyTemplate: `{"a":`}
+	assert.Error(t, w.Connect(), "Connect should error on an invalid body template")
+
+	w = &Webhook{URL: "https://localhost/{{.ID}}", Method: "put"}
+	require.NoError(t, w.Connect(), "Connect must not error")
+	assert.True(t, w.IsConnected(), "Connect should connect")
+	assert.Equal(t, http.MethodPut, w.Method, "Connect should upper case the method")
+	assert.Equal(t, DefaultBodyTemplate, w.BodyTemplate, "Connect should set the default body template")
+	assert.Equal(t, DefaultSignatureHeader, w.SignatureHeader, "Connect should set the default signature header")
+	assert.Equal(t, defaultRetryDelay, w.RetryDelay, "Connect should set the default retry delay")
+}
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	type received struct {
+		path, signature, header string
+		body                    []byte
+	}
+	reqs := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		reqs <- received{r.URL.Path, r.Header.Get("X-Sig"), r.Header.Get("X-Test"), body}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := &Webhook{
+		Base:            base.Base{Name: "test"},
+		URL:             srv.URL + "/events/{{.Type}}",
+		Headers:         map[string]string{"X-Test": "1"},
+		BodyTemplate:    `{"text":{{json (printf "%s %s" .Type .Message)}}}`,
+		Secret:          "secret",
+		SignatureHeader: "X-Sig",
+	}
+	assert.ErrorIs(t, w.PushEvent(base.Event{}), ErrNotConnected)
+	require.NoError(t, w.Connect(), "Connect must not error")
+	require.NoError(t, w.PushEvent(base.Event{Type: "order", Message: `"filled"`}), "PushEvent must not error")
+
+	r := <-reqs
+	assert.Equal(t, "/events/order", r.path, "PushEvent should execute the URL template")
+	assert.Equal(t, "1", r.header, "PushEvent should set the configured headers")
+	var body struct{ Text string }
+	require.NoError(t, json.Unmarshal(r.body, &body), "Unmarshal must not error")
+	assert.Equal(t, `order "filled"`, body.Text, "PushEvent should escape the message")
+	sig, err := crypto.GetHMAC(crypto.HashSHA256, r.body, []byte("secret"))
+	require.NoError(t, err, "GetHMAC must not error")
+	assert.Equal(t, "sha256="+hex.EncodeToString(sig), r.signature, "PushEvent should sign the body")
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch r.URL.Path {
+		case "/retry":
+			switch n {
+			case 1:
+				rw.WriteHeader(http.StatusInternalServerError)
+			case 2:
+				rw.Header().Set("Retry-After", "0.01")
+				rw.WriteHeader(http.StatusTooManyRequests)
+			default:
+				rw.WriteHeader(http.StatusOK)
+			}
+		case "/bad":
+			rw.WriteHeader(http.StatusBadRequest)
+		default:
+			rw.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	w := &Webhook{URL: srv.URL, MaxRetries: 2, RetryDelay: time.Millisecond}
+	assert.ErrorIs(t, w.Send(t.Context(), srv.URL, nil), ErrNotConnected)
+	require.NoError(t, w.Connect(), "Connect must not error")
+
+	require.NoError(t, w.Send(t.Context(), srv.URL+"/retry", []byte("{}")), "Send must retry until success")
+	assert.Equal(t, int32(3), calls.Load(), "Send should retry on server errors and rate limiting")
+
+	calls.Store(0)
+	assert.ErrorIs(t, w.Send(t.Context(), srv.URL+"/bad", []byte("{}")), errRequestFailed)
+	assert.Equal(t, int32(1), calls.Load(), "Send should not retry client errors")
+
+	calls.Store(0)
+	err := w.Send(t.Context(), srv.URL+"/down", []byte("{}"))
+	assert.ErrorIs(t, err, errRetriesExpired)
+	assert.ErrorIs(t, err, errRequestFailed)
+	assert.Equal(t, int32(3), calls.Load(), "Send should stop after the max retries")
+}
+
+func TestSendRateLimit(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	w := &Webhook{URL: srv.URL, RateLimit: 50 * time.Millisecond}
+	require.NoError(t, w.Connect(), "Connect must not error")
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, w.Send(t.Context(), srv.URL, []byte("{}")), "Send must not error")
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond, "Send should wait for the rate limiter")
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		<-release
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	w := &Webhook{URL: srv.URL}
+	w.Shutdown()
+	require.NoError(t, w.Connect(), "Connect must not error")
+	require.NoError(t, w.PushEvent(base.Event{Type: "order"}), "PushEvent must not wait for the endpoint")
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, 10*time.Millisecond, "PushEvent must deliver the event in the background")
+	for range queueSize {
+		require.NoError(t, w.PushEvent(base.Event{Type: "order"}), "PushEvent must not error until the queue is full")
+	}
+	assert.ErrorIs(t, w.PushEvent(base.Event{Type: "order"}), errQueueFull)
+
+	close(release)
+	w.Shutdown()
+	assert.False(t, w.IsConnected(), "Shutdown should disconnect")
+	assert.Equal(t, int32(queueSize+1), calls.Load(), "Shutdown should deliver the queued events")
+	assert.ErrorIs(t, w.PushEvent(base.Event{Type: "order"}), ErrNotConnected)
+}
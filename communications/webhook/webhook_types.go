@@ -0,0 +1,19 @@
+package webhook
+
+import "time"
+
+// Payload is the data the URL and body templates are executed with
+type Payload struct {
+	// ID is unique for each event and can be used as a transaction ID
+	ID      string
+	Name    string
+	Type    string
+	Message string
+	Time    time.Time
+}
+
+// delivery is a rendered event waiting to be sent
+type delivery struct {
+	path string
+	body []byte
+}
@@ -3,20 +3,24 @@ package config
 import (
 	"bufio"
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
@@ -24,16 +28,22 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 )
 
 var (
 	errExchangeConfigIsNil = errors.New("exchange config is nil")
 	errPairsManagerIsNil   = errors.New("currency pairs manager is nil")
 	errDecryptFailed       = errors.New("failed to decrypt config after 3 attempts")
+
+	errCredentialProfileNameUnset     = errors.New("credential profile name not set")
+	errCredentialProfileDuplicate     = errors.New("duplicate credential profile name")
+	errCredentialProfileDefaultValues = errors.New("credential profile has default/empty APIKey/Secret/ClientID values")
 )
 
 // GetCurrencyConfig returns currency configurations
@@ -169,6 +179,7 @@ func (c *Config) PurgeExchangeAPICredentials() {
 
 		c.Exchanges[x].API.Credentials.PEMKey = ""
 		c.Exchanges[x].API.Credentials.OTPSecret = ""
+		c.Exchanges[x].API.CredentialProfiles = nil
 	}
 }
 
@@ -302,10 +313,35 @@ func (c *Config) CheckCommunicationsConfig() {
 		c.Communications.TelegramConfig.AuthorisedClients = map[string]int64{"user_example": 0}
 	}
 
+	if c.Communications.WebhookConfig.Name == "" {
+		c.Communications.WebhookConfig = base.WebhookConfig{
+			Name:       "Webhook",
+			Method:     http.MethodPost,
+			MaxRetries: 3,
+			RetryDelay: time.Second,
+		}
+	}
+
+	if c.Communications.DiscordConfig.Name == "" {
+		c.Communications.DiscordConfig = base.DiscordConfig{
+			Name: "Discord",
+		}
+	}
+
+	if c.Communications.MatrixConfig.Name == "" {
+		c.Communications.MatrixConfig = base.MatrixConfig{
+			Name:          "Matrix",
+			HomeserverURL: "https://matrix.org",
+		}
+	}
+
 	if c.Communications.SlackConfig.Name != "Slack" ||
 		c.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		c.Communications.SMTPConfig.Name != "SMTP" ||
-		c.Communications.TelegramConfig.Name != "Telegram" {
+		c.Communications.TelegramConfig.Name != "Telegram" ||
+		c.Communications.WebhookConfig.Name != "Webhook" ||
+		c.Communications.DiscordConfig.Name != "Discord" ||
+		c.Communications.MatrixConfig.Name != "Matrix" {
 		log.Warnln(log.ConfigMgr, "Communications config name/s not set correctly")
 	}
 	if c.Communications.SlackConfig.Enabled {
@@ -342,6 +378,22 @@ func (c *Config) CheckCommunicationsConfig() {
 			log.Warnln(log.ConfigMgr, "Telegram enabled in config but variable data not set, disabling.")
 		}
 	}
+	if c.Communications.WebhookConfig.Enabled && c.Communications.WebhookConfig.URL == "" {
+		c.Communications.WebhookConfig.Enabled = false
+		log.Warnln(log.ConfigMgr, "Webhook enabled in config but variable data not set, disabling.")
+	}
+	if c.Communications.DiscordConfig.Enabled && c.Communications.DiscordConfig.WebhookURL == "" {
+		c.Communications.DiscordConfig.Enabled = false
+		log.Warnln(log.ConfigMgr, "Discord enabled in config but variable data not set, disabling.")
+	}
+	if c.Communications.MatrixConfig.Enabled {
+		if c.Communications.MatrixConfig.HomeserverURL == "" ||
+			c.Communications.MatrixConfig.RoomID == "" ||
+			c.Communications.MatrixConfig.AccessToken == "" {
+			c.Communications.MatrixConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Matrix enabled in config but variable data not set, disabling.")
+		}
+	}
 }
 
 // GetExchangeAssetTypes returns the exchanges supported asset types
@@ -831,6 +883,45 @@ func (c *Config) UpdateExchangeConfig(e *Exchange) error {
 	return fmt.Errorf("%s %w", e.Name, ErrExchangeNotFound)
 }
 
+// checkCredentialProfiles removes any credential profiles which cannot be
+// selected by name or do not satisfy the exchange credential requirements.
+// Credential values are not checked when they are loaded from a secrets
+// provider
+func checkCredentialProfiles(e *Exchange, secretsEnabled bool) {
+	if len(e.API.CredentialProfiles) == 0 {
+		return
+	}
+	seen := make(map[string]bool, len(e.API.CredentialProfiles))
+	profiles := make([]APICredentialProfileConfig, 0, len(e.API.CredentialProfiles))
+	for i := range e.API.CredentialProfiles {
+		p := &e.API.CredentialProfiles[i]
+		var err error
+		switch {
+		case p.Name == "":
+			err = errCredentialProfileNameUnset
+		case seen[p.Name]:
+			err = fmt.Errorf("%w: %q", errCredentialProfileDuplicate, p.Name)
+		case !secretsEnabled && e.API.CredentialsValidator != nil && !credentialsValid(&p.Credentials, e.API.CredentialsValidator):
+			err = fmt.Errorf("%w: %q", errCredentialProfileDefaultValues, p.Name)
+		}
+		if err != nil {
+			log.Warnf(log.ConfigMgr, warningCredentialProfileRemoved, e.Name, i, err)
+			continue
+		}
+		seen[p.Name] = true
+		profiles = append(profiles, *p)
+	}
+	e.API.CredentialProfiles = profiles
+}
+
+// credentialsValid returns whether the credentials contain the values required
+// by the exchange credentials validator
+func credentialsValid(creds *APICredentialsConfig, v *APICredentialsValidatorConfig) bool {
+	return (!v.RequiresKey || creds.Key != "" && creds.Key != DefaultAPIKey) &&
+		(!v.RequiresSecret || creds.Secret != "" && creds.Secret != DefaultAPISecret) &&
+		(!v.RequiresClientID || creds.ClientID != "" && creds.ClientID != DefaultAPIClientID)
+}
+
 // CheckExchangeConfigValues returns configuration values for all enabled
 // exchanges
 func (c *Config) CheckExchangeConfigValues() error {
@@ -920,29 +1011,14 @@ func (c *Config) CheckExchangeConfigValues() error {
 			continue
 		}
 		if (e.API.AuthenticatedSupport || e.API.AuthenticatedWebsocketSupport) &&
-			e.API.CredentialsValidator != nil {
-			var failed bool
-			if e.API.CredentialsValidator.RequiresKey &&
-				(e.API.Credentials.Key == "" || e.API.Credentials.Key == DefaultAPIKey) {
-				failed = true
-			}
-
-			if e.API.CredentialsValidator.RequiresSecret &&
-				(e.API.Credentials.Secret == "" || e.API.Credentials.Secret == DefaultAPISecret) {
-				failed = true
-			}
-
-			if e.API.CredentialsValidator.RequiresClientID &&
-				(e.API.Credentials.ClientID == DefaultAPIClientID || e.API.Credentials.ClientID == "") {
-				failed = true
-			}
-
-			if failed {
-				e.API.AuthenticatedSupport = false
-				e.API.AuthenticatedWebsocketSupport = false
-				log.Warnf(log.ConfigMgr, warningExchangeAuthAPIDefaultOrEmptyValues, e.Name)
-			}
-		}
+			!c.Secrets.Enabled &&
+			e.API.CredentialsValidator != nil &&
+			!credentialsValid(&e.API.Credentials, e.API.CredentialsValidator) {
+			e.API.AuthenticatedSupport = false
+			e.API.AuthenticatedWebsocketSupport = false
+			log.Warnf(log.ConfigMgr, warningExchangeAuthAPIDefaultOrEmptyValues, e.Name)
+		}
+		checkCredentialProfiles(e, c.Secrets.Enabled)
 		if !e.Features.Supports.RESTCapabilities.AutoPairUpdates &&
 			!e.Features.Supports.WebsocketCapabilities.AutoPairUpdates {
 			lastUpdated := time.Unix(e.CurrencyPairs.LastUpdated, 0)
@@ -1198,6 +1274,15 @@ func (c *Config) checkGCTScriptConfig() error {
 		c.GCTScript.MaxVirtualMachines = gctscript.DefaultMaxVirtualMachines
 	}
 
+	if c.GCTScript.EventQueueSize <= 0 {
+		c.GCTScript.EventQueueSize = gctscript.DefaultEventQueueSize
+	}
+
+	if err := c.GCTScript.ValidatePolicies(); err != nil {
+		c.GCTScript.Enabled = false
+		return err
+	}
+
 	scriptPath := c.GetDataPath("scripts")
 	err := common.CreateDir(scriptPath)
 	if err != nil {
@@ -1330,6 +1415,279 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckExchangeHealthConfig ensures the exchange health config is valid, or
+// sets default values
+func (c *Config) CheckExchangeHealthConfig() {
+	m.Lock()
+	defer m.Unlock()
+	h := &c.ExchangeHealth
+	if h.CheckInterval <= 0 {
+		h.CheckInterval = defaultExchangeHealthCheckInterval
+	}
+	if h.MinRequests <= 0 {
+		h.MinRequests = defaultExchangeHealthMinRequests
+	}
+	if h.DegradedErrorRate <= 0 || h.DegradedErrorRate > 1 {
+		h.DegradedErrorRate = defaultExchangeHealthDegradedErrRate
+	}
+	if h.DownErrorRate <= 0 || h.DownErrorRate > 1 {
+		h.DownErrorRate = defaultExchangeHealthDownErrRate
+	}
+	if h.DownErrorRate < h.DegradedErrorRate {
+		log.Warnf(log.ConfigMgr, "Exchange health down error rate %v is below the degraded error rate %v, setting to the degraded error rate\n", h.DownErrorRate, h.DegradedErrorRate)
+		h.DownErrorRate = h.DegradedErrorRate
+	}
+	if h.MaxLatency <= 0 {
+		h.MaxLatency = defaultExchangeHealthMaxLatency
+	}
+	if h.MaxOrderbookStaleness <= 0 {
+		h.MaxOrderbookStaleness = defaultExchangeHealthMaxStaleness
+	}
+	if h.MaxTimeDrift <= 0 {
+		h.MaxTimeDrift = defaultExchangeHealthMaxTimeDrift
+	}
+	if h.RecoveryChecks <= 0 {
+		h.RecoveryChecks = defaultExchangeHealthRecoveryChecks
+	}
+}
+
+// CheckCarryMonitorConfig ensures the carry monitor config is valid, or sets
+// default values
+func (c *Config) CheckCarryMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+	cm := &c.CarryMonitor
+	if cm.CheckInterval <= 0 {
+		cm.CheckInterval = defaultCarryMonitorCheckInterval
+	}
+	if cm.FundingInterval <= 0 {
+		cm.FundingInterval = defaultCarryMonitorFundingInterval
+	}
+	if cm.FundingRateThreshold <= 0 {
+		cm.FundingRateThreshold = defaultCarryMonitorFundingThreshold
+	}
+	if cm.BasisThreshold <= 0 {
+		cm.BasisThreshold = defaultCarryMonitorBasisThreshold
+	}
+	for i := len(cm.Underlyings) - 1; i >= 0; i-- {
+		if cm.Underlyings[i].Pair.IsEmpty() {
+			log.Warnf(log.ConfigMgr, "Carry monitor underlying %d has no pair, removing\n", i)
+			cm.Underlyings = append(cm.Underlyings[:i], cm.Underlyings[i+1:]...)
+		}
+	}
+}
+
+// CheckMarginMonitorConfig ensures the margin monitor config is valid, or sets
+// default values
+func (c *Config) CheckMarginMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+	mm := &c.MarginMonitor
+	if mm.CheckInterval <= 0 {
+		mm.CheckInterval = defaultMarginMonitorCheckInterval
+	}
+	if mm.PositionRefreshInterval <= 0 {
+		mm.PositionRefreshInterval = defaultMarginPositionRefresh
+	}
+	if len(mm.Levels) == 0 {
+		mm.Levels = []MarginLevel{
+			{Name: "warning", MarginRatio: 0.5, LiquidationDistance: 0.1},
+			{Name: "critical", MarginRatio: 0.8, LiquidationDistance: 0.05},
+		}
+	}
+	for i := len(mm.Levels) - 1; i >= 0; i-- {
+		l := &mm.Levels[i]
+		if l.MarginRatio <= 0 && l.LiquidationDistance <= 0 {
+			log.Warnf(log.ConfigMgr, "Margin monitor level %d has no thresholds, removing\n", i)
+			mm.Levels = append(mm.Levels[:i], mm.Levels[i+1:]...)
+			continue
+		}
+		if l.Name == "" {
+			l.Name = "level " + strconv.Itoa(i+1)
+		}
+		if l.ReduceFraction < 0 || l.ReduceFraction > 1 {
+			log.Warnf(log.ConfigMgr, "Margin monitor level %s reduce fraction %v is outside 0 to 1, disabling de-risking for the level\n", l.Name, l.ReduceFraction)
+			l.ReduceFraction = 0
+		}
+	}
+	// Levels are rated from the most severe down, so they are sorted by
+	// increasing margin ratio and decreasing liquidation distance
+	slices.SortStableFunc(mm.Levels, compareMarginLevels)
+	for i := 1; i < len(mm.Levels); i++ {
+		prev, l := &mm.Levels[i-1], &mm.Levels[i]
+		if (prev.MarginRatio > 0 && l.MarginRatio > 0 && l.MarginRatio <= prev.MarginRatio) ||
+			(prev.LiquidationDistance > 0 && l.LiquidationDistance > 0 && l.LiquidationDistance >= prev.LiquidationDistance) {
+			log.Warnf(log.ConfigMgr, "Margin monitor level %s is not more severe than level %s for both thresholds\n", l.Name, prev.Name)
+		}
+	}
+}
+
+// compareMarginLevels orders margin levels from least to most severe by their
+// margin ratio, falling back to their liquidation distance
+func compareMarginLevels(a, b MarginLevel) int {
+	if a.MarginRatio > 0 && b.MarginRatio > 0 && a.MarginRatio != b.MarginRatio {
+		return cmp.Compare(a.MarginRatio, b.MarginRatio)
+	}
+	if a.LiquidationDistance > 0 && b.LiquidationDistance > 0 {
+		return cmp.Compare(b.LiquidationDistance, a.LiquidationDistance)
+	}
+	return 0
+}
+
+// CheckRebalancerConfig ensures the rebalancer config is valid, or sets
+// default values
+func (c *Config) CheckRebalancerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	r := &c.Rebalancer
+	if r.CheckInterval <= 0 {
+		r.CheckInterval = defaultRebalancerCheckInterval
+	}
+	if r.Cooldown <= 0 {
+		r.Cooldown = defaultRebalancerCooldown
+	}
+	if r.ApprovalTimeout <= 0 {
+		r.ApprovalTimeout = defaultRebalancerApprovalTimeout
+	}
+	for i := len(r.Targets) - 1; i >= 0; i-- {
+		t := &r.Targets[i]
+		t.Allocations = slices.DeleteFunc(t.Allocations, func(a RebalanceAllocation) bool {
+			return a.Exchange == "" || a.Weight <= 0
+		})
+		if t.Currency.IsEmpty() || len(t.Allocations) < 2 {
+			log.Warnf(log.ConfigMgr, "Rebalancer target %d requires a currency and at least two allocations, removing\n", i)
+			r.Targets = append(r.Targets[:i], r.Targets[i+1:]...)
+			continue
+		}
+		if t.Tolerance <= 0 {
+			t.Tolerance = defaultRebalancerTolerance
+		}
+		if t.MaxTransfer > 0 && t.MinTransfer > t.MaxTransfer {
+			log.Warnf(log.ConfigMgr, "Rebalancer target %s minimum transfer %v exceeds maximum transfer %v, resetting minimum\n", t.Currency, t.MinTransfer, t.MaxTransfer)
+			t.MinTransfer = 0
+		}
+	}
+}
+
+// CheckWithdrawalReconcilerConfig checks and if zero value assigns default
+// values
+func (c *Config) CheckWithdrawalReconcilerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	w := &c.WithdrawalReconciler
+	if w.CheckInterval <= 0 {
+		w.CheckInterval = defaultWithdrawalReconcileInterval
+	}
+	if w.Lookback <= 0 {
+		w.Lookback = defaultWithdrawalReconcileLookback
+	}
+	if w.StuckAfter <= 0 {
+		w.StuckAfter = defaultWithdrawalStuckAfter
+	}
+}
+
+// CheckWithdrawalPolicyConfig checks and if zero value assigns default values,
+// removing empty and duplicate approvers and currencies
+func (c *Config) CheckWithdrawalPolicyConfig() {
+	m.Lock()
+	defer m.Unlock()
+	w := &c.WithdrawalPolicy
+	if w.CheckInterval <= 0 {
+		w.CheckInterval = defaultWithdrawalPolicyInterval
+	}
+	if w.ApprovalTimeout <= 0 {
+		w.ApprovalTimeout = defaultWithdrawalApprovalTimeout
+	}
+	approvers := make([]string, 0, len(w.Approvers))
+	for _, a := range w.Approvers {
+		a = strings.TrimSpace(a)
+		if a == "" || common.StringSliceContainsInsensitive(approvers, a) {
+			continue
+		}
+		approvers = append(approvers, a)
+	}
+	w.Approvers = approvers
+	if w.RequiredApprovals < 0 {
+		w.RequiredApprovals = 0
+	}
+	w.Currencies = slices.DeleteFunc(w.Currencies, func(p WithdrawalCurrencyPolicy) bool {
+		return p.Currency.IsEmpty()
+	})
+	for i := range w.Currencies {
+		if w.Currencies[i].DailyLimit < 0 {
+			w.Currencies[i].DailyLimit = 0
+		}
+		if slices.ContainsFunc(w.Currencies[:i], func(p WithdrawalCurrencyPolicy) bool { return p.Currency.Equal(w.Currencies[i].Currency) }) {
+			log.Warnf(log.ConfigMgr, "Withdrawal policy currency %s is duplicated, only the first is used\n", w.Currencies[i].Currency)
+		}
+		if r := w.Currencies[i].RequiredApprovals; r > len(w.Approvers) {
+			log.Warnf(log.ConfigMgr, "Withdrawal policy requires %d approvals of %s withdrawals with %d approvers, they cannot be approved\n", r, w.Currencies[i].Currency, len(w.Approvers))
+		}
+	}
+	if w.RequiredApprovals > len(w.Approvers) {
+		log.Warnf(log.ConfigMgr, "Withdrawal policy requires %d approvals with %d approvers, withdrawals cannot be approved\n", w.RequiredApprovals, len(w.Approvers))
+	}
+}
+
+// CheckPortfolioValuationConfig checks and if zero value assigns default
+// values
+func (c *Config) CheckPortfolioValuationConfig() {
+	m.Lock()
+	defer m.Unlock()
+	p := &c.PortfolioValuation
+	if p.CheckInterval <= 0 {
+		p.CheckInterval = defaultPortfolioValuationInterval
+	}
+	if p.ReportingCurrency.IsEmpty() {
+		p.ReportingCurrency = currency.USD
+	}
+	if !p.ReportingCurrency.IsFiatCurrency() {
+		log.Warnf(log.ConfigMgr, "Portfolio valuation reporting currency %s is not a fiat currency, defaulting to %s\n", p.ReportingCurrency, currency.USD)
+		p.ReportingCurrency = currency.USD
+	}
+	if len(p.QuoteCurrencies) == 0 {
+		p.QuoteCurrencies = currency.Currencies{currency.USD, currency.USDT, currency.USDC}
+	}
+}
+
+// CheckTradeLedgerConfig checks and if zero value assigns default values
+func (c *Config) CheckTradeLedgerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	l := &c.TradeLedger
+	if l.CheckInterval <= 0 {
+		l.CheckInterval = defaultTradeLedgerInterval
+	}
+	if l.Lookback <= 0 {
+		l.Lookback = defaultTradeLedgerLookback
+	}
+	if method, err := ledger.ParseMethod(string(l.Method)); err != nil {
+		if l.Method != "" {
+			log.Warnf(log.ConfigMgr, "Trade ledger %v, defaulting to %s\n", err, ledger.FIFO)
+		}
+		l.Method = ledger.FIFO
+	} else {
+		l.Method = method
+	}
+	if l.ReportingCurrency.IsEmpty() {
+		l.ReportingCurrency = currency.USD
+	}
+	if !l.ReportingCurrency.IsFiatCurrency() {
+		log.Warnf(log.ConfigMgr, "Trade ledger reporting currency %s is not a fiat currency, defaulting to %s\n", l.ReportingCurrency, currency.USD)
+		l.ReportingCurrency = currency.USD
+	}
+	if len(l.QuoteCurrencies) == 0 {
+		l.QuoteCurrencies = currency.Currencies{currency.USD, currency.USDT, currency.USDC}
+	}
+	if l.TaxYearStartMonth == 0 && l.TaxYearStartDay == 0 {
+		l.TaxYearStartMonth, l.TaxYearStartDay = time.January, 1
+	}
+	if _, _, err := ledger.TaxYear(time.Now().Year(), l.TaxYearStartMonth, l.TaxYearStartDay); err != nil {
+		log.Warnf(log.ConfigMgr, "Trade ledger %v, defaulting to January 1\n", err)
+		l.TaxYearStartMonth, l.TaxYearStartDay = time.January, 1
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1348,6 +1706,75 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckTracingConfig checks and if zero value assigns default tracing values
+func (c *Config) CheckTracingConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Tracing.Exporter == "" {
+		c.Tracing.Exporter = tracing.ExporterOTLPGRPC
+	}
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterOTLPGRPC:
+		setDefaultIfZeroWarn("Tracing", "endpoint", &c.Tracing.Endpoint, tracing.DefaultOTLPGRPCEndpoint)
+	case tracing.ExporterOTLPHTTP:
+		setDefaultIfZeroWarn("Tracing", "endpoint", &c.Tracing.Endpoint, tracing.DefaultOTLPHTTPEndpoint)
+	case tracing.ExporterFile:
+		if c.Tracing.FilePath == "" {
+			c.Tracing.FilePath = c.GetDataPath("traces", tracing.DefaultFileName)
+		}
+	}
+
+	// A sample ratio of 0 is kept as it disables sampling, only an absent
+	// ratio is defaulted
+	switch {
+	case c.Tracing.SampleRatio == nil:
+		log.Warnf(log.ConfigMgr, "Tracing field %q not set, defaulting to `%v`", "sampleRatio", tracing.DefaultSampleRatio)
+		c.Tracing.SampleRatio = new(tracing.DefaultSampleRatio)
+	case *c.Tracing.SampleRatio < 0 || *c.Tracing.SampleRatio > 1:
+		log.Warnf(log.ConfigMgr, "Tracing sample ratio %v invalid, defaulting to %v", *c.Tracing.SampleRatio, tracing.DefaultSampleRatio)
+		*c.Tracing.SampleRatio = tracing.DefaultSampleRatio
+	}
+	setDefaultIfZeroWarn("Tracing", "serviceName", &c.Tracing.ServiceName, tracing.DefaultServiceName)
+
+	if err := c.Tracing.Validate(); err != nil && c.Tracing.Enabled {
+		log.Errorf(log.ConfigMgr, "Tracing config invalid, tracing has been disabled: %v", err)
+		c.Tracing.Enabled = false
+	}
+}
+
+// CheckSecretsConfig checks and if zero value assigns default secrets
+// provider values
+func (c *Config) CheckSecretsConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.Secrets.Enabled {
+		return
+	}
+
+	setDefaultIfZeroWarn("Secrets", "provider", &c.Secrets.Provider, secrets.ProviderEnvironment)
+	setDefaultIfZeroWarn("Secrets", "refreshInterval", &c.Secrets.RefreshInterval, secrets.DefaultRefreshInterval)
+
+	switch c.Secrets.Provider {
+	case secrets.ProviderEnvironment:
+		setDefaultIfZeroWarn("Secrets", "environment.prefix", &c.Secrets.Environment.Prefix, secrets.DefaultEnvironmentPrefix)
+	case secrets.ProviderKeystore:
+		setDefaultIfZeroWarn("Secrets", "keystore.path", &c.Secrets.Keystore.Path, c.GetDataPath(secrets.DefaultKeystoreFileName))
+		setDefaultIfZeroWarn("Secrets", "keystore.keyEnvVar", &c.Secrets.Keystore.KeyEnvVar, secrets.DefaultKeystoreKeyEnvVar)
+	case secrets.ProviderVault:
+		setDefaultIfZeroWarn("Secrets", "vault.address", &c.Secrets.Vault.Address, secrets.DefaultVaultAddress)
+		setDefaultIfZeroWarn("Secrets", "vault.mountPath", &c.Secrets.Vault.MountPath, secrets.DefaultVaultMountPath)
+		setDefaultIfZeroWarn("Secrets", "vault.secretPath", &c.Secrets.Vault.SecretPath, secrets.DefaultVaultSecretPath)
+		setDefaultIfZeroWarn("Secrets", "vault.tokenEnvVar", &c.Secrets.Vault.TokenEnvVar, secrets.DefaultVaultTokenEnvVar)
+		setDefaultIfZeroWarn("Secrets", "vault.timeout", &c.Secrets.Vault.Timeout, secrets.DefaultVaultTimeout)
+	default:
+		log.Errorf(log.ConfigMgr, "Secrets provider %q unsupported, secrets provider has been disabled", c.Secrets.Provider)
+		c.Secrets.Enabled = false
+	}
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1620,6 +2047,31 @@ func (c *Config) CheckRemoteControlConfig() {
 		log.Warnln(log.ConfigMgr, "gRPC proxy cannot be enabled when gRPC is disabled, disabling gRPC proxy")
 		c.RemoteControl.GRPC.GRPCProxyEnabled = false
 	}
+
+	usernames := []string{strings.ToLower(c.RemoteControl.Username)}
+	c.RemoteControl.Operators = slices.DeleteFunc(c.RemoteControl.Operators, func(o RemoteControlOperator) bool {
+		switch {
+		case o.Username == "" || o.Password == "":
+			log.Warnln(log.ConfigMgr, "Remote control operators must have a username and password, removing operator")
+			return true
+		case slices.Contains(usernames, strings.ToLower(o.Username)):
+			log.Warnf(log.ConfigMgr, "Remote control operator username %q is already in use, removing operator", o.Username)
+			return true
+		}
+		usernames = append(usernames, strings.ToLower(o.Username))
+		return false
+	})
+}
+
+// Authenticate reports whether a gRPC username and password match the remote
+// control credentials or an operator's
+func (r *RemoteControlConfig) Authenticate(username, password string) bool {
+	if username == r.Username && password == r.Password {
+		return true
+	}
+	return slices.ContainsFunc(r.Operators, func(o RemoteControlOperator) bool {
+		return username == o.Username && password == o.Password
+	})
 }
 
 // CheckConfig checks all config settings
@@ -1641,8 +2093,18 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckTracingConfig()
+	c.CheckSecretsConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckExchangeHealthConfig()
+	c.CheckCarryMonitorConfig()
+	c.CheckMarginMonitorConfig()
+	c.CheckRebalancerConfig()
+	c.CheckWithdrawalReconcilerConfig()
+	c.CheckWithdrawalPolicyConfig()
+	c.CheckPortfolioValuationConfig()
+	c.CheckTradeLedgerConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
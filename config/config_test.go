@@ -19,16 +19,20 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 )
 
 const (
@@ -427,7 +431,10 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	if cfg.Communications.SlackConfig.Name != "Slack" ||
 		cfg.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		cfg.Communications.SMTPConfig.Name != "SMTP" ||
-		cfg.Communications.TelegramConfig.Name != "Telegram" {
+		cfg.Communications.TelegramConfig.Name != "Telegram" ||
+		cfg.Communications.WebhookConfig.Name != "Webhook" ||
+		cfg.Communications.DiscordConfig.Name != "Discord" ||
+		cfg.Communications.MatrixConfig.Name != "Matrix" {
 		t.Error("CheckCommunicationsConfig unexpected data:",
 			cfg.Communications)
 	}
@@ -502,6 +509,14 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	if cfg.Communications.TelegramConfig.Enabled {
 		t.Error("CheckCommunicationsConfig TelegramConfig is enabled when it shouldn't be.")
 	}
+
+	cfg.Communications.WebhookConfig.Enabled = true
+	cfg.Communications.DiscordConfig.Enabled = true
+	cfg.Communications.MatrixConfig.Enabled = true
+	cfg.CheckCommunicationsConfig()
+	assert.False(t, cfg.Communications.WebhookConfig.Enabled, "CheckCommunicationsConfig should disable webhook without a URL")
+	assert.False(t, cfg.Communications.DiscordConfig.Enabled, "CheckCommunicationsConfig should disable Discord without a webhook URL")
+	assert.False(t, cfg.Communications.MatrixConfig.Enabled, "CheckCommunicationsConfig should disable Matrix without a room and access token")
 }
 
 func TestGetExchangeAssetTypes(t *testing.T) {
@@ -1238,6 +1253,32 @@ func TestUpdateExchangeConfig(t *testing.T) {
 }
 
 // TestCheckExchangeConfigValues logic test
+func TestCheckCredentialProfiles(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{
+		Name: "test",
+		API: APIConfig{
+			CredentialsValidator: &APICredentialsValidatorConfig{RequiresKey: true, RequiresSecret: true},
+			CredentialProfiles: []APICredentialProfileConfig{
+				{Name: "hedging", Credentials: APICredentialsConfig{Key: "k1", Secret: "s1"}},
+				{Credentials: APICredentialsConfig{Key: "k2", Secret: "s2"}},
+				{Name: "hedging", Credentials: APICredentialsConfig{Key: "k3", Secret: "s3"}},
+				{Name: "unset", Credentials: APICredentialsConfig{Key: DefaultAPIKey, Secret: "s4"}},
+				{Name: "market-making", Credentials: APICredentialsConfig{Key: "k5", Secret: "s5"}},
+			},
+		},
+	}
+	checkCredentialProfiles(e, false)
+	require.Len(t, e.API.CredentialProfiles, 2, "invalid profiles must be removed")
+	assert.Equal(t, "hedging", e.API.CredentialProfiles[0].Name)
+	assert.Equal(t, "market-making", e.API.CredentialProfiles[1].Name)
+
+	e.API.CredentialProfiles = append(e.API.CredentialProfiles, APICredentialProfileConfig{Name: "vault"})
+	checkCredentialProfiles(e, true)
+	require.Len(t, e.API.CredentialProfiles, 3, "profiles without credentials must be kept when a secrets provider is enabled")
+	assert.Equal(t, "vault", e.API.CredentialProfiles[2].Name)
+}
+
 func TestCheckExchangeConfigValues(t *testing.T) {
 	var cfg Config
 	if err := cfg.CheckExchangeConfigValues(); err == nil {
@@ -1818,6 +1859,238 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	assert.Equal(t, connchecker.DefaultDomainList, c.ConnectionMonitor.PublicDomainList)
 }
 
+func TestCheckExchangeHealthConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckExchangeHealthConfig()
+	assert.Equal(t, defaultExchangeHealthCheckInterval, c.ExchangeHealth.CheckInterval)
+	assert.Equal(t, defaultExchangeHealthMinRequests, c.ExchangeHealth.MinRequests)
+	assert.Equal(t, defaultExchangeHealthDegradedErrRate, c.ExchangeHealth.DegradedErrorRate)
+	assert.Equal(t, defaultExchangeHealthDownErrRate, c.ExchangeHealth.DownErrorRate)
+	assert.Equal(t, defaultExchangeHealthMaxLatency, c.ExchangeHealth.MaxLatency)
+	assert.Equal(t, defaultExchangeHealthMaxStaleness, c.ExchangeHealth.MaxOrderbookStaleness)
+	assert.Equal(t, defaultExchangeHealthMaxTimeDrift, c.ExchangeHealth.MaxTimeDrift)
+	assert.Equal(t, defaultExchangeHealthRecoveryChecks, c.ExchangeHealth.RecoveryChecks)
+
+	c = Config{ExchangeHealth: ExchangeHealthConfig{DegradedErrorRate: 0.6, DownErrorRate: 0.3}}
+	c.CheckExchangeHealthConfig()
+	assert.Equal(t, 0.6, c.ExchangeHealth.DownErrorRate, "down error rate should not be below the degraded error rate")
+
+	c = Config{ExchangeHealth: ExchangeHealthConfig{DegradedErrorRate: 2}}
+	c.CheckExchangeHealthConfig()
+	assert.Equal(t, defaultExchangeHealthDegradedErrRate, c.ExchangeHealth.DegradedErrorRate, "an error rate above 1 should be reset")
+}
+
+func TestCheckCarryMonitorConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckCarryMonitorConfig()
+	assert.Equal(t, defaultCarryMonitorCheckInterval, c.CarryMonitor.CheckInterval)
+	assert.Equal(t, defaultCarryMonitorFundingInterval, c.CarryMonitor.FundingInterval)
+	assert.Equal(t, defaultCarryMonitorFundingThreshold, c.CarryMonitor.FundingRateThreshold)
+	assert.Equal(t, defaultCarryMonitorBasisThreshold, c.CarryMonitor.BasisThreshold)
+
+	c = Config{CarryMonitor: CarryMonitorConfig{Underlyings: []CarryUnderlying{
+		{},
+		{Pair: currency.NewBTCUSDT()},
+		{},
+	}}}
+	c.CheckCarryMonitorConfig()
+	require.Len(t, c.CarryMonitor.Underlyings, 1, "underlyings without a pair must be removed")
+	assert.Equal(t, currency.NewBTCUSDT(), c.CarryMonitor.Underlyings[0].Pair)
+}
+
+func TestCheckMarginMonitorConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckMarginMonitorConfig()
+	assert.Equal(t, defaultMarginMonitorCheckInterval, c.MarginMonitor.CheckInterval)
+	require.Len(t, c.MarginMonitor.Levels, 2, "default levels must be set")
+	assert.Equal(t, "warning", c.MarginMonitor.Levels[0].Name)
+	assert.Equal(t, "critical", c.MarginMonitor.Levels[1].Name)
+
+	c = Config{MarginMonitor: MarginMonitorConfig{Levels: []MarginLevel{
+		{Name: "empty"},
+		{MarginRatio: 0.7, ReduceFraction: 2},
+	}}}
+	c.CheckMarginMonitorConfig()
+	require.Len(t, c.MarginMonitor.Levels, 1, "levels without thresholds must be removed")
+	assert.Equal(t, "level 2", c.MarginMonitor.Levels[0].Name, "an unnamed level should be named")
+	assert.Zero(t, c.MarginMonitor.Levels[0].ReduceFraction, "an invalid reduce fraction should be disabled")
+	assert.Equal(t, defaultMarginPositionRefresh, c.MarginMonitor.PositionRefreshInterval)
+
+	c = Config{MarginMonitor: MarginMonitorConfig{Levels: []MarginLevel{
+		{Name: "liquidation", MarginRatio: 0.9, LiquidationDistance: 0.02},
+		{Name: "warning", MarginRatio: 0.5, LiquidationDistance: 0.1},
+		{Name: "close", LiquidationDistance: 0.01},
+		{Name: "critical", MarginRatio: 0.8, LiquidationDistance: 0.05},
+	}}}
+	c.CheckMarginMonitorConfig()
+	names := make([]string, len(c.MarginMonitor.Levels))
+	for i := range c.MarginMonitor.Levels {
+		names[i] = c.MarginMonitor.Levels[i].Name
+	}
+	assert.Equal(t, []string{"warning", "critical", "liquidation", "close"}, names, "levels should be sorted from least to most severe")
+}
+
+func TestCheckRebalancerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{Rebalancer: RebalancerConfig{Targets: []RebalanceTarget{
+		{Allocations: []RebalanceAllocation{{Exchange: "Binance", Weight: 1}, {Exchange: "Kraken", Weight: 1}}},
+		{Currency: currency.BTC, Allocations: []RebalanceAllocation{{Exchange: "Binance", Weight: 1}, {Exchange: "Kraken"}}},
+		{Currency: currency.USDT, MinTransfer: 100, MaxTransfer: 10, Allocations: []RebalanceAllocation{{Exchange: "Binance", Weight: 1}, {Exchange: "Kraken", Weight: 3}, {Weight: 1}}},
+	}}}
+	c.CheckRebalancerConfig()
+	assert.Equal(t, defaultRebalancerCheckInterval, c.Rebalancer.CheckInterval)
+	assert.Equal(t, defaultRebalancerCooldown, c.Rebalancer.Cooldown)
+	assert.Equal(t, defaultRebalancerApprovalTimeout, c.Rebalancer.ApprovalTimeout)
+	require.Len(t, c.Rebalancer.Targets, 1, "targets without a currency or two allocations must be removed")
+	tgt := c.Rebalancer.Targets[0]
+	assert.Len(t, tgt.Allocations, 2, "allocations without an exchange or weight should be removed")
+	assert.Equal(t, defaultRebalancerTolerance, tgt.Tolerance)
+	assert.Zero(t, tgt.MinTransfer, "a minimum transfer above the maximum should be reset")
+}
+
+func TestCheckWithdrawalReconcilerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckWithdrawalReconcilerConfig()
+	assert.Equal(t, defaultWithdrawalReconcileInterval, c.WithdrawalReconciler.CheckInterval)
+	assert.Equal(t, defaultWithdrawalReconcileLookback, c.WithdrawalReconciler.Lookback)
+	assert.Equal(t, defaultWithdrawalStuckAfter, c.WithdrawalReconciler.StuckAfter)
+
+	c = Config{WithdrawalReconciler: WithdrawalReconciler{CheckInterval: time.Minute}}
+	c.CheckWithdrawalReconcilerConfig()
+	assert.Equal(t, time.Minute, c.WithdrawalReconciler.CheckInterval, "a configured interval should be kept")
+}
+
+func TestCheckWithdrawalPolicyConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{WithdrawalPolicy: WithdrawalPolicy{
+		Approvers:         []string{"alice", " ", "Bob", "ALICE"},
+		RequiredApprovals: -1,
+		Currencies:        []WithdrawalCurrencyPolicy{{DailyLimit: 1}, {Currency: currency.BTC, DailyLimit: -1}},
+	}}
+	c.CheckWithdrawalPolicyConfig()
+	assert.Equal(t, defaultWithdrawalPolicyInterval, c.WithdrawalPolicy.CheckInterval)
+	assert.Equal(t, defaultWithdrawalApprovalTimeout, c.WithdrawalPolicy.ApprovalTimeout)
+	assert.Equal(t, []string{"alice", "Bob"}, c.WithdrawalPolicy.Approvers, "empty and duplicate approvers should be removed")
+	assert.Zero(t, c.WithdrawalPolicy.RequiredApprovals, "negative required approvals should be reset")
+	require.Len(t, c.WithdrawalPolicy.Currencies, 1, "currencies without a code must be removed")
+	assert.Zero(t, c.WithdrawalPolicy.Currencies[0].DailyLimit, "a negative daily limit should be reset")
+}
+
+func TestCheckPortfolioValuationConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckPortfolioValuationConfig()
+	assert.Equal(t, defaultPortfolioValuationInterval, c.PortfolioValuation.CheckInterval)
+	assert.Equal(t, currency.USD, c.PortfolioValuation.ReportingCurrency)
+	assert.Equal(t, currency.Currencies{currency.USD, currency.USDT, currency.USDC}, c.PortfolioValuation.QuoteCurrencies)
+
+	c = Config{PortfolioValuation: PortfolioValuation{ReportingCurrency: currency.BTC, QuoteCurrencies: currency.Currencies{currency.EUR}}}
+	c.CheckPortfolioValuationConfig()
+	assert.Equal(t, currency.USD, c.PortfolioValuation.ReportingCurrency, "a cryptocurrency reporting currency should be reset")
+	assert.Equal(t, currency.Currencies{currency.EUR}, c.PortfolioValuation.QuoteCurrencies)
+
+	c = Config{PortfolioValuation: PortfolioValuation{ReportingCurrency: currency.EUR}}
+	c.CheckPortfolioValuationConfig()
+	assert.Equal(t, currency.EUR, c.PortfolioValuation.ReportingCurrency)
+}
+
+func TestCheckTradeLedgerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckTradeLedgerConfig()
+	assert.Equal(t, defaultTradeLedgerInterval, c.TradeLedger.CheckInterval)
+	assert.Equal(t, defaultTradeLedgerLookback, c.TradeLedger.Lookback)
+	assert.Equal(t, ledger.FIFO, c.TradeLedger.Method)
+	assert.Equal(t, currency.USD, c.TradeLedger.ReportingCurrency)
+	assert.Equal(t, currency.Currencies{currency.USD, currency.USDT, currency.USDC}, c.TradeLedger.QuoteCurrencies)
+	assert.Equal(t, time.January, c.TradeLedger.TaxYearStartMonth)
+	assert.Equal(t, 1, c.TradeLedger.TaxYearStartDay)
+
+	c = Config{TradeLedger: TradeLedger{Method: "HIFO", ReportingCurrency: currency.GBP, TaxYearStartMonth: time.April, TaxYearStartDay: 6}}
+	c.CheckTradeLedgerConfig()
+	assert.Equal(t, ledger.HIFO, c.TradeLedger.Method, "method should be normalised")
+	assert.Equal(t, currency.GBP, c.TradeLedger.ReportingCurrency)
+	assert.Equal(t, time.April, c.TradeLedger.TaxYearStartMonth)
+	assert.Equal(t, 6, c.TradeLedger.TaxYearStartDay)
+
+	c = Config{TradeLedger: TradeLedger{Method: "average", ReportingCurrency: currency.BTC, TaxYearStartMonth: time.February, TaxYearStartDay: 30}}
+	c.CheckTradeLedgerConfig()
+	assert.Equal(t, ledger.FIFO, c.TradeLedger.Method, "an invalid method should be reset")
+	assert.Equal(t, currency.USD, c.TradeLedger.ReportingCurrency, "a cryptocurrency reporting currency should be reset")
+	assert.Equal(t, time.January, c.TradeLedger.TaxYearStartMonth, "an invalid tax year start should be reset")
+	assert.Equal(t, 1, c.TradeLedger.TaxYearStartDay, "an invalid tax year start should be reset")
+}
+
+func TestCheckTracingConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckTracingConfig()
+	assert.Equal(t, tracing.ExporterOTLPGRPC, c.Tracing.Exporter)
+	assert.Equal(t, tracing.DefaultOTLPGRPCEndpoint, c.Tracing.Endpoint)
+	require.NotNil(t, c.Tracing.SampleRatio, "an unset sample ratio must be defaulted")
+	assert.Equal(t, tracing.DefaultSampleRatio, *c.Tracing.SampleRatio)
+	assert.Equal(t, tracing.DefaultServiceName, c.Tracing.ServiceName)
+
+	c = Config{DataDirectory: "gct", Tracing: tracing.Config{Enabled: true, Exporter: tracing.ExporterFile, SampleRatio: new(2.0)}}
+	c.CheckTracingConfig()
+	assert.Equal(t, filepath.Join("gct", "traces", tracing.DefaultFileName), c.Tracing.FilePath)
+	assert.Equal(t, tracing.DefaultSampleRatio, *c.Tracing.SampleRatio, "an invalid sample ratio should be reset")
+	assert.True(t, c.Tracing.Enabled)
+
+	c = Config{Tracing: tracing.Config{SampleRatio: new(0.0)}}
+	c.CheckTracingConfig()
+	assert.Zero(t, *c.Tracing.SampleRatio, "an explicit zero sample ratio should be kept")
+
+	c = Config{Tracing: tracing.Config{Enabled: true, Exporter: "smoke signals"}}
+	c.CheckTracingConfig()
+	assert.False(t, c.Tracing.Enabled, "invalid exporter should disable tracing")
+}
+
+func TestCheckSecretsConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckSecretsConfig()
+	assert.Empty(t, c.Secrets.Provider, "defaults should not be set when secrets are disabled")
+
+	c = Config{Secrets: secrets.Config{Enabled: true}}
+	c.CheckSecretsConfig()
+	assert.Equal(t, secrets.ProviderEnvironment, c.Secrets.Provider)
+	assert.Equal(t, secrets.DefaultRefreshInterval, c.Secrets.RefreshInterval)
+	assert.Equal(t, secrets.DefaultEnvironmentPrefix, c.Secrets.Environment.Prefix)
+
+	c = Config{DataDirectory: "gct", Secrets: secrets.Config{Enabled: true, Provider: secrets.ProviderKeystore}}
+	c.CheckSecretsConfig()
+	assert.Equal(t, filepath.Join("gct", secrets.DefaultKeystoreFileName), c.Secrets.Keystore.Path)
+	assert.Equal(t, secrets.DefaultKeystoreKeyEnvVar, c.Secrets.Keystore.KeyEnvVar)
+
+	c = Config{Secrets: secrets.Config{Enabled: true, Provider: secrets.ProviderVault, RefreshInterval: -1}}
+	c.CheckSecretsConfig()
+	assert.Equal(t, time.Duration(-1), c.Secrets.RefreshInterval, "negative refresh interval should be kept")
+	assert.Equal(t, secrets.DefaultVaultAddress, c.Secrets.Vault.Address)
+	assert.Equal(t, secrets.DefaultVaultMountPath, c.Secrets.Vault.MountPath)
+	assert.Equal(t, secrets.DefaultVaultSecretPath, c.Secrets.Vault.SecretPath)
+	assert.Equal(t, secrets.DefaultVaultTokenEnvVar, c.Secrets.Vault.TokenEnvVar)
+	assert.Equal(t, secrets.DefaultVaultTimeout, c.Secrets.Vault.Timeout)
+
+	c = Config{Secrets: secrets.Config{Enabled: true, Provider: "post-it note"}}
+	c.CheckSecretsConfig()
+	assert.False(t, c.Secrets.Enabled, "unsupported provider should disable secrets")
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -1877,6 +2150,24 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 	c.CheckRemoteControlConfig()
 	assert.True(t, c.RemoteControl.GRPC.Enabled, "gRPC should be true")
 	assert.True(t, c.RemoteControl.GRPC.GRPCProxyEnabled, "gRPCProxyEnabled should be true when gRPC is enabled")
+
+	c.RemoteControl.Operators = []RemoteControlOperator{
+		{Username: "alice", Password: "secret"},
+		{Username: "bob"},
+		{Username: "Admin", Password: "secret"},
+		{Username: "ALICE", Password: "secret"},
+	}
+	c.CheckRemoteControlConfig()
+	assert.Equal(t, []RemoteControlOperator{{Username: "alice", Password: "secret"}}, c.RemoteControl.Operators, "operators without a password or with a username in use should be removed")
+}
+
+func TestRemoteControlAuthenticate(t *testing.T) {
+	t.Parallel()
+	r := &RemoteControlConfig{Username: "admin", Password: "Password", Operators: []RemoteControlOperator{{Username: "alice", Password: "secret"}}}
+	assert.True(t, r.Authenticate("admin", "Password"), "Authenticate should accept the remote control credentials")
+	assert.True(t, r.Authenticate("alice", "secret"), "Authenticate should accept an operator's credentials")
+	assert.False(t, r.Authenticate("alice", "Password"), "Authenticate should not accept another user's password")
+	assert.False(t, r.Authenticate("bob", "secret"), "Authenticate should not accept an unknown user")
 }
 
 func TestCheckConfig(t *testing.T) {
@@ -2020,6 +2311,15 @@ func TestCheckGCTScriptConfig(t *testing.T) {
 	if c.GCTScript.MaxVirtualMachines != gctscript.DefaultMaxVirtualMachines {
 		t.Fatal("unexpected value return")
 	}
+
+	if c.GCTScript.EventQueueSize != gctscript.DefaultEventQueueSize {
+		t.Fatal("unexpected value return")
+	}
+
+	c.GCTScript.Enabled = true
+	c.GCTScript.Policies = map[string]*gct.Policy{"test": {MaxNotional: -1}}
+	require.Error(t, c.checkGCTScriptConfig(), "checkGCTScriptConfig must error on an invalid policy")
+	assert.False(t, c.GCTScript.Enabled, "checkGCTScriptConfig should disable gctscript on an invalid policy")
 }
 
 func TestCheckDatabaseConfig(t *testing.T) {
@@ -5,15 +5,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 )
 
 // Constants declared here are filename strings and test strings
@@ -39,6 +42,32 @@ const (
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultExchangeHealthCheckInterval   = 30 * time.Second
+	defaultExchangeHealthMinRequests     = 10
+	defaultExchangeHealthDegradedErrRate = 0.1
+	defaultExchangeHealthDownErrRate     = 0.5
+	defaultExchangeHealthMaxLatency      = 2 * time.Second
+	defaultExchangeHealthMaxStaleness    = time.Minute
+	defaultExchangeHealthMaxTimeDrift    = time.Second
+	defaultExchangeHealthRecoveryChecks  = 2
+	defaultCarryMonitorCheckInterval     = 5 * time.Minute
+	defaultCarryMonitorFundingInterval   = 8 * time.Hour
+	defaultCarryMonitorFundingThreshold  = 0.2
+	defaultCarryMonitorBasisThreshold    = 0.1
+	defaultMarginMonitorCheckInterval    = 30 * time.Second
+	defaultMarginPositionRefresh         = time.Hour
+	defaultRebalancerCheckInterval       = 15 * time.Minute
+	defaultRebalancerCooldown            = time.Hour
+	defaultRebalancerApprovalTimeout     = time.Hour
+	defaultRebalancerTolerance           = 0.05
+	defaultWithdrawalReconcileInterval   = 5 * time.Minute
+	defaultWithdrawalReconcileLookback   = 7 * 24 * time.Hour
+	defaultWithdrawalStuckAfter          = 2 * time.Hour
+	defaultWithdrawalPolicyInterval      = time.Minute
+	defaultWithdrawalApprovalTimeout     = 24 * time.Hour
+	defaultPortfolioValuationInterval    = time.Hour
+	defaultTradeLedgerInterval           = time.Hour
+	defaultTradeLedgerLookback           = 30 * 24 * time.Hour
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
 	// DefaultSyncerTimeoutREST the default time to switch from REST to websocket protocols without a response
@@ -59,6 +88,7 @@ const (
 // Constants here hold some messages
 const (
 	warningExchangeAuthAPIDefaultOrEmptyValues = "exchange %s authenticated API support disabled due to default/empty APIKey/Secret/ClientID values"
+	warningCredentialProfileRemoved            = "exchange %s credential profile #%d removed: %v"
 	warningPairsLastUpdatedThresholdExceeded   = "exchange %s last manual update of available currency pairs has exceeded %d days. Manual update required!"
 )
 
@@ -104,7 +134,17 @@ type Config struct {
 	OrderManager         OrderManager              `json:"orderManager"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	ExchangeHealth       ExchangeHealthConfig      `json:"exchangeHealth"`
+	CarryMonitor         CarryMonitorConfig        `json:"carryMonitor"`
+	MarginMonitor        MarginMonitorConfig       `json:"marginMonitor"`
+	Rebalancer           RebalancerConfig          `json:"rebalancer"`
+	WithdrawalReconciler WithdrawalReconciler      `json:"withdrawalReconciler"`
+	WithdrawalPolicy     WithdrawalPolicy          `json:"withdrawalPolicy"`
+	PortfolioValuation   PortfolioValuation        `json:"portfolioValuation"`
+	TradeLedger          TradeLedger               `json:"tradeLedger"`
 	Profiler             Profiler                  `json:"profiler"`
+	Tracing              tracing.Config            `json:"tracing"`
+	Secrets              secrets.Config            `json:"secrets"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
 	Currency             currency.Config           `json:"currencyConfig"`
@@ -136,6 +176,35 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	// PersistFuturesPositions stores tracked futures positions, PNL history
+	// and funding payments in the database so they survive a restart
+	PersistFuturesPositions bool `json:"persistFuturesPositions"`
+	// Notifications configures the order lifecycle notifications pushed to
+	// the communications manager
+	Notifications OrderNotifications `json:"notifications"`
+}
+
+// OrderNotifications holds the rules deciding which order lifecycle changes
+// are pushed to the communications manager
+type OrderNotifications struct {
+	Enabled bool `json:"enabled"`
+	// Rules are matched by exchange and asset, the most specific rule is used.
+	// When no rules are set every lifecycle event is notified
+	Rules []OrderNotificationRule `json:"rules,omitempty"`
+}
+
+// OrderNotificationRule selects the order lifecycle events notified for an
+// exchange and asset, an empty exchange or asset matches all
+type OrderNotificationRule struct {
+	Exchange string `json:"exchange,omitempty"`
+	Asset    string `json:"asset,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+	// Events lists the event types notified, all when empty
+	Events []string `json:"events,omitempty"`
+	// MinFillAmount and MinFillNotional suppress fills smaller than the
+	// thresholds
+	MinFillAmount   float64 `json:"minFillAmount,omitempty"`
+	MinFillNotional float64 `json:"minFillNotional,omitempty"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -154,6 +223,209 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// ExchangeHealthConfig defines the thresholds the exchange health manager uses
+// to rate the health of each exchange
+type ExchangeHealthConfig struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// MinRequests is the number of REST requests within a check interval
+	// required before the error rate is rated
+	MinRequests           int           `json:"minRequests"`
+	DegradedErrorRate     float64       `json:"degradedErrorRate"`
+	DownErrorRate         float64       `json:"downErrorRate"`
+	MaxLatency            time.Duration `json:"maxLatency"`
+	MaxOrderbookStaleness time.Duration `json:"maxOrderbookStaleness"`
+	MaxTimeDrift          time.Duration `json:"maxTimeDrift"`
+	// RecoveryChecks is the number of consecutive healthy checks required
+	// before an exchange is considered recovered
+	RecoveryChecks int `json:"recoveryChecks"`
+}
+
+// CarryMonitorConfig defines the underlyings the carry monitor collects
+// funding rates and basis for, and the annualised rates at which carry
+// opportunities are flagged
+type CarryMonitorConfig struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// FundingRateThreshold and BasisThreshold are absolute annualised rates,
+	// 0.1 being 10% a year
+	FundingRateThreshold float64 `json:"fundingRateThreshold"`
+	BasisThreshold       float64 `json:"basisThreshold"`
+	// FundingInterval is used to annualise funding rates when an exchange
+	// does not return the time of the next funding rate
+	FundingInterval time.Duration     `json:"fundingInterval"`
+	Underlyings     []CarryUnderlying `json:"underlyings"`
+}
+
+// CarryUnderlying is a spot pair whose perpetual and dated futures contracts
+// are monitored by the carry monitor
+type CarryUnderlying struct {
+	Pair currency.Pair `json:"pair"`
+	// Exchanges limits collection to the named exchanges, all enabled
+	// exchanges are used when empty
+	Exchanges []string `json:"exchanges,omitempty"`
+}
+
+// MarginMonitorConfig defines the margin levels the margin monitor rates open
+// futures positions against
+type MarginMonitorConfig struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// PositionRefreshInterval is how often the open positions reported by
+	// exchanges are requested, positions are still checked each interval
+	PositionRefreshInterval time.Duration `json:"positionRefreshInterval"`
+	// AutoDeRisk reduces positions with reduce only market orders when they
+	// enter a level with a reduce fraction
+	AutoDeRisk bool `json:"autoDeRisk"`
+	// Levels are sorted from least to most severe
+	Levels []MarginLevel `json:"levels"`
+}
+
+// MarginLevel is a tier of margin health, a position enters the level when
+// either threshold is crossed
+type MarginLevel struct {
+	Name string `json:"name"`
+	// MarginRatio is the maintenance margin requirement as a fraction of the
+	// margin balance
+	MarginRatio float64 `json:"marginRatio"`
+	// LiquidationDistance is the distance from the mid price to the
+	// liquidation price as a fraction of the mid price
+	LiquidationDistance float64 `json:"liquidationDistance"`
+	// ReduceFraction is the fraction of the position closed when the level is
+	// entered and AutoDeRisk is enabled
+	ReduceFraction float64 `json:"reduceFraction,omitempty"`
+}
+
+// RebalancerConfig defines the target allocations the rebalancer keeps
+// currency holdings at across exchanges with withdrawals
+type RebalancerConfig struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// DryRun plans transfers without submitting withdrawals
+	DryRun bool `json:"dryRun"`
+	// RequireApproval holds planned transfers until they are approved
+	RequireApproval bool `json:"requireApproval"`
+	// ApprovalTimeout expires transfers which are not approved in time
+	ApprovalTimeout time.Duration `json:"approvalTimeout"`
+	// Cooldown is the time after a transfer before its currency is
+	// rebalanced again, allowing the withdrawal to be deposited
+	Cooldown time.Duration     `json:"cooldown"`
+	Targets  []RebalanceTarget `json:"targets"`
+}
+
+// RebalanceTarget is the allocation of a currency across exchanges
+type RebalanceTarget struct {
+	Currency currency.Code `json:"currency"`
+	// Tolerance is the deviation from an allocation, as a fraction of the
+	// total holdings, before transfers are planned
+	Tolerance float64 `json:"tolerance"`
+	// MinTransfer skips transfers smaller than the amount
+	MinTransfer float64 `json:"minTransfer"`
+	// MaxTransfer limits the amount of a single transfer, zero is unlimited
+	MaxTransfer float64 `json:"maxTransfer"`
+	// DailyLimit limits the amount transferred within a day, zero is
+	// unlimited
+	DailyLimit float64 `json:"dailyLimit"`
+	// Chains are the preferred transfer chains in order, when empty the first
+	// chain supported by both exchanges is used
+	Chains      []string              `json:"chains,omitempty"`
+	Allocations []RebalanceAllocation `json:"allocations"`
+}
+
+// RebalanceAllocation is the weight of a currency's total holdings held on an
+// exchange
+type RebalanceAllocation struct {
+	Exchange string  `json:"exchange"`
+	Weight   float64 `json:"weight"`
+}
+
+// WithdrawalReconciler defines how stored withdrawals are reconciled
+// against the withdrawal history of their exchanges
+type WithdrawalReconciler struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// Lookback is how far back submitted withdrawals are reconciled
+	Lookback time.Duration `json:"lookback"`
+	// StuckAfter is the time after submission a withdrawal which has not
+	// completed is notified as stuck
+	StuckAfter time.Duration `json:"stuckAfter"`
+}
+
+// WithdrawalPolicy defines the safeguards applied to withdrawals before they
+// are submitted to exchanges
+type WithdrawalPolicy struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// Approvers are the operators allowed to approve withdrawals, matched
+	// against chat command users and the authenticated gRPC username
+	Approvers []string `json:"approvers"`
+	// RequiredApprovals is the number of distinct approvers each withdrawal
+	// requires before it is submitted, zero requires none
+	RequiredApprovals int `json:"requiredApprovals"`
+	// ApprovalTimeout expires withdrawals which are not approved in time
+	ApprovalTimeout time.Duration `json:"approvalTimeout"`
+	// TimeLock delays the submission of withdrawals from when they are
+	// requested, leaving time for them to be rejected
+	TimeLock time.Duration `json:"timeLock"`
+	// AddressCooldown denies withdrawals to addresses whitelisted more
+	// recently
+	AddressCooldown time.Duration              `json:"addressCooldown"`
+	Currencies      []WithdrawalCurrencyPolicy `json:"currencies"`
+}
+
+// WithdrawalCurrencyPolicy defines the withdrawal limits of a currency
+type WithdrawalCurrencyPolicy struct {
+	Currency currency.Code `json:"currency"`
+	// DailyLimit limits the amount requested within a day across exchanges,
+	// zero is unlimited
+	DailyLimit float64 `json:"dailyLimit"`
+	// RequiredApprovals overrides the default number of approvals when
+	// positive
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+}
+
+// PortfolioValuation defines how often the portfolio's holdings are valued
+// and stored
+type PortfolioValuation struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// ReportingCurrency is the fiat currency holdings are valued in
+	ReportingCurrency currency.Code `json:"reportingCurrency"`
+	// QuoteCurrencies are the quotes, in order of preference, of the spot
+	// pairs used to price cryptocurrencies before converting to the
+	// reporting currency
+	QuoteCurrencies currency.Currencies `json:"quoteCurrencies"`
+}
+
+// TradeLedger defines how often fills are imported into the trade ledger and
+// how their cost basis and gains are calculated
+type TradeLedger struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// Lookback is how far back order history is imported at each check
+	Lookback time.Duration `json:"lookback"`
+	// Method is the cost basis method matching disposals to lots
+	Method ledger.Method `json:"method"`
+	// ReportingCurrency is the fiat currency gains are calculated in
+	ReportingCurrency currency.Code `json:"reportingCurrency"`
+	// QuoteCurrencies are the quotes, in order of preference, of the spot
+	// pairs used to price cryptocurrencies before converting to the
+	// reporting currency
+	QuoteCurrencies currency.Currencies `json:"quoteCurrencies"`
+	// TaxYearStartMonth and TaxYearStartDay are the first day of each tax
+	// year in UTC
+	TaxYearStartMonth time.Month `json:"taxYearStartMonth"`
+	TaxYearStartDay   int        `json:"taxYearStartDay"`
+}
+
 // SyncManagerConfig stores the currency pair synchronization manager config
 type SyncManagerConfig struct {
 	Enabled                 bool                 `json:"enabled"`
@@ -249,6 +521,16 @@ type RemoteControlConfig struct {
 	Username string     `json:"username"`
 	Password string     `json:"password"`
 	GRPC     GRPCConfig `json:"gRPC"`
+	// Operators are additional gRPC credentials which authenticate as their
+	// own user, so a withdrawal requested by one operator can be approved by
+	// others
+	Operators []RemoteControlOperator `json:"operators,omitempty"`
+}
+
+// RemoteControlOperator is a gRPC username and password of an operator
+type RemoteControlOperator struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
 }
 
 // Post holds the bot configuration data
@@ -314,6 +596,13 @@ type APICredentialsConfig struct {
 	PIN           string `json:"pin,omitempty"`
 }
 
+// APICredentialProfileConfig stores the API credentials for an additional
+// named account on an exchange
+type APICredentialProfileConfig struct {
+	Name        string               `json:"name"`
+	Credentials APICredentialsConfig `json:"credentials"`
+}
+
 // APICredentialsValidatorConfig stores the API credentials validator settings
 type APICredentialsValidatorConfig struct {
 	// For Huobi (optional)
@@ -332,6 +621,7 @@ type APIConfig struct {
 	PEMKeySupport                 bool `json:"pemKeySupport,omitempty"`
 
 	Credentials          APICredentialsConfig           `json:"credentials"`
+	CredentialProfiles   []APICredentialProfileConfig   `json:"credentialProfiles,omitempty"`
 	CredentialsValidator *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
 	OldEndPoints         *APIEndpointsConfig            `json:"endpoints,omitempty"`
 	Endpoints            map[string]string              `json:"urlEndpoints"`
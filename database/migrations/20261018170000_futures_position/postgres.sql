@@ -0,0 +1,74 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS futures_position
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    account varchar NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    underlying varchar(30) NOT NULL,
+    collateral_currency varchar(30) NOT NULL,
+    status varchar NOT NULL,
+    opening_direction varchar NOT NULL,
+    opening_price DOUBLE PRECISION NOT NULL,
+    opening_size DOUBLE PRECISION NOT NULL,
+    opening_time TIMESTAMPTZ NOT NULL,
+    latest_direction varchar NOT NULL,
+    latest_price DOUBLE PRECISION NOT NULL,
+    latest_size DOUBLE PRECISION NOT NULL,
+    realised_pnl DOUBLE PRECISION NOT NULL,
+    unrealised_pnl DOUBLE PRECISION NOT NULL,
+    closing_price DOUBLE PRECISION NOT NULL,
+    closing_time TIMESTAMPTZ NULL,
+    last_updated TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefuturesposition
+        unique(exchange_name_id, account, asset, base, quote, opening_time)
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_order
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    position_id uuid NOT NULL REFERENCES futures_position(id) ON DELETE CASCADE,
+    order_id varchar NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    leverage DOUBLE PRECISION NOT NULL,
+    date TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_pnl_entry
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    position_id uuid NOT NULL REFERENCES futures_position(id) ON DELETE CASCADE,
+    timestamp TIMESTAMPTZ NOT NULL,
+    status varchar NOT NULL,
+    unrealised_pnl DOUBLE PRECISION NOT NULL,
+    realised_pnl_before_fees DOUBLE PRECISION NOT NULL,
+    realised_pnl DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    exposure DOUBLE PRECISION NOT NULL,
+    direction varchar NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    is_liquidated BOOLEAN NOT NULL,
+    is_order BOOLEAN NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_funding
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    position_id uuid NOT NULL REFERENCES futures_position(id) ON DELETE CASCADE,
+    timestamp TIMESTAMPTZ NOT NULL,
+    rate DOUBLE PRECISION NOT NULL,
+    payment DOUBLE PRECISION NOT NULL
+);
+-- +goose Down
+DROP TABLE futures_position_funding;
+DROP TABLE futures_position_pnl_entry;
+DROP TABLE futures_position_order;
+DROP TABLE futures_position;
@@ -0,0 +1,77 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS futures_position
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    account text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    underlying text NOT NULL,
+    collateral_currency text NOT NULL,
+    status text NOT NULL,
+    opening_direction text NOT NULL,
+    opening_price real NOT NULL,
+    opening_size real NOT NULL,
+    opening_time timestamp NOT NULL,
+    latest_direction text NOT NULL,
+    latest_price real NOT NULL,
+    latest_size real NOT NULL,
+    realised_pnl real NOT NULL,
+    unrealised_pnl real NOT NULL,
+    closing_price real NOT NULL,
+    closing_time timestamp NULL,
+    last_updated timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, account, asset, base, quote, opening_time) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_order
+(
+    id text NOT NULL primary key,
+    position_id text NOT NULL,
+    order_id text NOT NULL,
+    side text NOT NULL,
+    type text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    fee real NOT NULL,
+    leverage real NOT NULL,
+    date timestamp NOT NULL,
+    FOREIGN KEY(position_id) REFERENCES futures_position(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_pnl_entry
+(
+    id text NOT NULL primary key,
+    position_id text NOT NULL,
+    timestamp timestamp NOT NULL,
+    status text NOT NULL,
+    unrealised_pnl real NOT NULL,
+    realised_pnl_before_fees real NOT NULL,
+    realised_pnl real NOT NULL,
+    price real NOT NULL,
+    exposure real NOT NULL,
+    direction text NOT NULL,
+    fee real NOT NULL,
+    is_liquidated integer NOT NULL,
+    is_order integer NOT NULL,
+    FOREIGN KEY(position_id) REFERENCES futures_position(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS futures_position_funding
+(
+    id text NOT NULL primary key,
+    position_id text NOT NULL,
+    timestamp timestamp NOT NULL,
+    rate real NOT NULL,
+    payment real NOT NULL,
+    FOREIGN KEY(position_id) REFERENCES futures_position(id) ON DELETE CASCADE
+);
+-- +goose Down
+DROP TABLE futures_position_funding;
+DROP TABLE futures_position_pnl_entry;
+DROP TABLE futures_position_order;
+DROP TABLE futures_position;
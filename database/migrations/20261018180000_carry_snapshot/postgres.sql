@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS carry_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    underlying_base varchar(30) NOT NULL,
+    underlying_quote varchar(30) NOT NULL,
+    contract_type varchar NOT NULL,
+    expiry TIMESTAMPTZ NULL,
+    spot_price DOUBLE PRECISION NOT NULL,
+    contract_price DOUBLE PRECISION NOT NULL,
+    basis DOUBLE PRECISION NOT NULL,
+    annualised_basis DOUBLE PRECISION NOT NULL,
+    funding_rate DOUBLE PRECISION NOT NULL,
+    annualised_funding_rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquecarrysnapshot
+        unique(exchange_name_id, asset, base, quote, timestamp)
+);
+-- +goose Down
+DROP TABLE carry_snapshot;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS carry_snapshot
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    underlying_base text NOT NULL,
+    underlying_quote text NOT NULL,
+    contract_type text NOT NULL,
+    expiry timestamp NULL,
+    spot_price real NOT NULL,
+    contract_price real NOT NULL,
+    basis real NOT NULL,
+    annualised_basis real NOT NULL,
+    funding_rate real NOT NULL,
+    annualised_funding_rate real NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, asset, base, quote, timestamp) ON CONFLICT IGNORE
+);
+-- +goose Down
+DROP TABLE carry_snapshot;
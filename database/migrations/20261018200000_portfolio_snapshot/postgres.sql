@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    reporting_currency varchar(30) NOT NULL,
+    total_value DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueportfoliosnapshot
+        unique(reporting_currency, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_holding
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    snapshot_id uuid NOT NULL REFERENCES portfolio_snapshot(id) ON DELETE CASCADE,
+    source varchar NOT NULL,
+    address varchar NOT NULL,
+    currency varchar(30) NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    value DOUBLE PRECISION NOT NULL
+);
+-- +goose Down
+DROP TABLE portfolio_snapshot_holding;
+DROP TABLE portfolio_snapshot;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id text NOT NULL primary key,
+    reporting_currency text NOT NULL,
+    total_value real NOT NULL,
+    timestamp timestamp NOT NULL,
+    UNIQUE(reporting_currency, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_holding
+(
+    id text NOT NULL primary key,
+    snapshot_id text NOT NULL,
+    source text NOT NULL,
+    address text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    price real NOT NULL,
+    value real NOT NULL,
+    FOREIGN KEY(snapshot_id) REFERENCES portfolio_snapshot(id) ON DELETE CASCADE
+);
+-- +goose Down
+DROP TABLE portfolio_snapshot_holding;
+DROP TABLE portfolio_snapshot;
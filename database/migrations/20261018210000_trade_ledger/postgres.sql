@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade_ledger_fill
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange varchar NOT NULL,
+    account varchar NOT NULL,
+    order_id varchar NOT NULL,
+    trade_id varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    side varchar(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_currency varchar(30) NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquetradeledgerfill
+        unique(exchange, account, order_id, trade_id)
+);
+-- +goose Down
+DROP TABLE trade_ledger_fill;
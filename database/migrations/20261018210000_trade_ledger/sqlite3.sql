@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade_ledger_fill
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    account text NOT NULL,
+    order_id text NOT NULL,
+    trade_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    side text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    fee_currency text NOT NULL,
+    timestamp timestamp NOT NULL,
+    UNIQUE(exchange, account, order_id, trade_id)
+);
+-- +goose Down
+DROP TABLE trade_ledger_fill;
@@ -0,0 +1,1101 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// CarrySnapshot is an object representing the database table.
+type CarrySnapshot struct {
+	ID                    string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID        string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Asset                 string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base                  string    `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote                 string    `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	UnderlyingBase        string    `boil:"underlying_base" json:"underlying_base" toml:"underlying_base" yaml:"underlying_base"`
+	UnderlyingQuote       string    `boil:"underlying_quote" json:"underlying_quote" toml:"underlying_quote" yaml:"underlying_quote"`
+	ContractType          string    `boil:"contract_type" json:"contract_type" toml:"contract_type" yaml:"contract_type"`
+	Expiry                null.Time `boil:"expiry" json:"expiry,omitempty" toml:"expiry" yaml:"expiry,omitempty"`
+	SpotPrice             float64   `boil:"spot_price" json:"spot_price" toml:"spot_price" yaml:"spot_price"`
+	ContractPrice         float64   `boil:"contract_price" json:"contract_price" toml:"contract_price" yaml:"contract_price"`
+	Basis                 float64   `boil:"basis" json:"basis" toml:"basis" yaml:"basis"`
+	AnnualisedBasis       float64   `boil:"annualised_basis" json:"annualised_basis" toml:"annualised_basis" yaml:"annualised_basis"`
+	FundingRate           float64   `boil:"funding_rate" json:"funding_rate" toml:"funding_rate" yaml:"funding_rate"`
+	AnnualisedFundingRate float64   `boil:"annualised_funding_rate" json:"annualised_funding_rate" toml:"annualised_funding_rate" yaml:"annualised_funding_rate"`
+	Timestamp             time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *carrySnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L carrySnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CarrySnapshotColumns = struct {
+	ID                    string
+	ExchangeNameID        string
+	Asset                 string
+	Base                  string
+	Quote                 string
+	UnderlyingBase        string
+	UnderlyingQuote       string
+	ContractType          string
+	Expiry                string
+	SpotPrice             string
+	ContractPrice         string
+	Basis                 string
+	AnnualisedBasis       string
+	FundingRate           string
+	AnnualisedFundingRate string
+	Timestamp             string
+}{
+	ID:                    "id",
+	ExchangeNameID:        "exchange_name_id",
+	Asset:                 "asset",
+	Base:                  "base",
+	Quote:                 "quote",
+	UnderlyingBase:        "underlying_base",
+	UnderlyingQuote:       "underlying_quote",
+	ContractType:          "contract_type",
+	Expiry:                "expiry",
+	SpotPrice:             "spot_price",
+	ContractPrice:         "contract_price",
+	Basis:                 "basis",
+	AnnualisedBasis:       "annualised_basis",
+	FundingRate:           "funding_rate",
+	AnnualisedFundingRate: "annualised_funding_rate",
+	Timestamp:             "timestamp",
+}
+
+// Generated where
+
+var CarrySnapshotWhere = struct {
+	ID                    whereHelperstring
+	ExchangeNameID        whereHelperstring
+	Asset                 whereHelperstring
+	Base                  whereHelperstring
+	Quote                 whereHelperstring
+	UnderlyingBase        whereHelperstring
+	UnderlyingQuote       whereHelperstring
+	ContractType          whereHelperstring
+	Expiry                whereHelpernull_Time
+	SpotPrice             whereHelperfloat64
+	ContractPrice         whereHelperfloat64
+	Basis                 whereHelperfloat64
+	AnnualisedBasis       whereHelperfloat64
+	FundingRate           whereHelperfloat64
+	AnnualisedFundingRate whereHelperfloat64
+	Timestamp             whereHelpertime_Time
+}{
+	ID:                    whereHelperstring{field: "\"carry_snapshot\".\"id\""},
+	ExchangeNameID:        whereHelperstring{field: "\"carry_snapshot\".\"exchange_name_id\""},
+	Asset:                 whereHelperstring{field: "\"carry_snapshot\".\"asset\""},
+	Base:                  whereHelperstring{field: "\"carry_snapshot\".\"base\""},
+	Quote:                 whereHelperstring{field: "\"carry_snapshot\".\"quote\""},
+	UnderlyingBase:        whereHelperstring{field: "\"carry_snapshot\".\"underlying_base\""},
+	UnderlyingQuote:       whereHelperstring{field: "\"carry_snapshot\".\"underlying_quote\""},
+	ContractType:          whereHelperstring{field: "\"carry_snapshot\".\"contract_type\""},
+	Expiry:                whereHelpernull_Time{field: "\"carry_snapshot\".\"expiry\""},
+	SpotPrice:             whereHelperfloat64{field: "\"carry_snapshot\".\"spot_price\""},
+	ContractPrice:         whereHelperfloat64{field: "\"carry_snapshot\".\"contract_price\""},
+	Basis:                 whereHelperfloat64{field: "\"carry_snapshot\".\"basis\""},
+	AnnualisedBasis:       whereHelperfloat64{field: "\"carry_snapshot\".\"annualised_basis\""},
+	FundingRate:           whereHelperfloat64{field: "\"carry_snapshot\".\"funding_rate\""},
+	AnnualisedFundingRate: whereHelperfloat64{field: "\"carry_snapshot\".\"annualised_funding_rate\""},
+	Timestamp:             whereHelpertime_Time{field: "\"carry_snapshot\".\"timestamp\""},
+}
+
+// CarrySnapshotRels is where relationship names are stored.
+var CarrySnapshotRels = struct {
+	ExchangeName string
+}{
+	ExchangeName: "ExchangeName",
+}
+
+// carrySnapshotR is where relationships are stored.
+type carrySnapshotR struct {
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*carrySnapshotR) NewStruct() *carrySnapshotR {
+	return &carrySnapshotR{}
+}
+
+// carrySnapshotL is where Load methods for each relationship are stored.
+type carrySnapshotL struct{}
+
+var (
+	carrySnapshotAllColumns            = []string{"id", "exchange_name_id", "asset", "base", "quote", "underlying_base", "underlying_quote", "contract_type", "expiry", "spot_price", "contract_price", "basis", "annualised_basis", "funding_rate", "annualised_funding_rate", "timestamp"}
+	carrySnapshotColumnsWithoutDefault = []string{"exchange_name_id", "asset", "base", "quote", "underlying_base", "underlying_quote", "contract_type", "expiry", "spot_price", "contract_price", "basis", "annualised_basis", "funding_rate", "annualised_funding_rate", "timestamp"}
+	carrySnapshotColumnsWithDefault    = []string{"id"}
+	carrySnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CarrySnapshotSlice is an alias for a slice of pointers to CarrySnapshot.
+	// This should generally be used opposed to []CarrySnapshot.
+	CarrySnapshotSlice []*CarrySnapshot
+	// CarrySnapshotHook is the signature for custom CarrySnapshot hook methods
+	CarrySnapshotHook func(context.Context, boil.ContextExecutor, *CarrySnapshot) error
+
+	carrySnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	carrySnapshotType                 = reflect.TypeOf(&CarrySnapshot{})
+	carrySnapshotMapping              = queries.MakeStructMapping(carrySnapshotType)
+	carrySnapshotPrimaryKeyMapping, _ = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, carrySnapshotPrimaryKeyColumns)
+	carrySnapshotInsertCacheMut       sync.RWMutex
+	carrySnapshotInsertCache          = make(map[string]insertCache)
+	carrySnapshotUpdateCacheMut       sync.RWMutex
+	carrySnapshotUpdateCache          = make(map[string]updateCache)
+	carrySnapshotUpsertCacheMut       sync.RWMutex
+	carrySnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var carrySnapshotBeforeInsertHooks []CarrySnapshotHook
+var carrySnapshotBeforeUpdateHooks []CarrySnapshotHook
+var carrySnapshotBeforeDeleteHooks []CarrySnapshotHook
+var carrySnapshotBeforeUpsertHooks []CarrySnapshotHook
+
+var carrySnapshotAfterInsertHooks []CarrySnapshotHook
+var carrySnapshotAfterSelectHooks []CarrySnapshotHook
+var carrySnapshotAfterUpdateHooks []CarrySnapshotHook
+var carrySnapshotAfterDeleteHooks []CarrySnapshotHook
+var carrySnapshotAfterUpsertHooks []CarrySnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CarrySnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CarrySnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CarrySnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CarrySnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CarrySnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CarrySnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CarrySnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CarrySnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CarrySnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range carrySnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCarrySnapshotHook registers your hook function for all future operations.
+func AddCarrySnapshotHook(hookPoint boil.HookPoint, carrySnapshotHook CarrySnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		carrySnapshotBeforeInsertHooks = append(carrySnapshotBeforeInsertHooks, carrySnapshotHook)
+	case boil.BeforeUpdateHook:
+		carrySnapshotBeforeUpdateHooks = append(carrySnapshotBeforeUpdateHooks, carrySnapshotHook)
+	case boil.BeforeDeleteHook:
+		carrySnapshotBeforeDeleteHooks = append(carrySnapshotBeforeDeleteHooks, carrySnapshotHook)
+	case boil.BeforeUpsertHook:
+		carrySnapshotBeforeUpsertHooks = append(carrySnapshotBeforeUpsertHooks, carrySnapshotHook)
+	case boil.AfterInsertHook:
+		carrySnapshotAfterInsertHooks = append(carrySnapshotAfterInsertHooks, carrySnapshotHook)
+	case boil.AfterSelectHook:
+		carrySnapshotAfterSelectHooks = append(carrySnapshotAfterSelectHooks, carrySnapshotHook)
+	case boil.AfterUpdateHook:
+		carrySnapshotAfterUpdateHooks = append(carrySnapshotAfterUpdateHooks, carrySnapshotHook)
+	case boil.AfterDeleteHook:
+		carrySnapshotAfterDeleteHooks = append(carrySnapshotAfterDeleteHooks, carrySnapshotHook)
+	case boil.AfterUpsertHook:
+		carrySnapshotAfterUpsertHooks = append(carrySnapshotAfterUpsertHooks, carrySnapshotHook)
+	}
+}
+
+// One returns a single carrySnapshot record from the query.
+func (q carrySnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CarrySnapshot, error) {
+	o := &CarrySnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for carry_snapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CarrySnapshot records from the query.
+func (q carrySnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (CarrySnapshotSlice, error) {
+	var o []*CarrySnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to CarrySnapshot slice")
+	}
+
+	if len(carrySnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CarrySnapshot records in the query.
+func (q carrySnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count carry_snapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q carrySnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if carry_snapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *CarrySnapshot) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (carrySnapshotL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCarrySnapshot interface{}, mods queries.Applicator) error {
+	var slice []*CarrySnapshot
+	var object *CarrySnapshot
+
+	if singular {
+		object = maybeCarrySnapshot.(*CarrySnapshot)
+	} else {
+		slice = *maybeCarrySnapshot.(*[]*CarrySnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &carrySnapshotR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &carrySnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(carrySnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameCarrySnapshots = append(foreign.R.ExchangeNameCarrySnapshots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameCarrySnapshots = append(foreign.R.ExchangeNameCarrySnapshots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the carrySnapshot to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameCarrySnapshots.
+func (o *CarrySnapshot) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"carry_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 2, carrySnapshotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &carrySnapshotR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameCarrySnapshots: CarrySnapshotSlice{o},
+		}
+	} else {
+		related.R.ExchangeNameCarrySnapshots = append(related.R.ExchangeNameCarrySnapshots, o)
+	}
+
+	return nil
+}
+
+// CarrySnapshots retrieves all the records using an executor.
+func CarrySnapshots(mods ...qm.QueryMod) carrySnapshotQuery {
+	mods = append(mods, qm.From("\"carry_snapshot\""))
+	return carrySnapshotQuery{NewQuery(mods...)}
+}
+
+// FindCarrySnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCarrySnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*CarrySnapshot, error) {
+	carrySnapshotObj := &CarrySnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"carry_snapshot\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, carrySnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from carry_snapshot")
+	}
+
+	return carrySnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CarrySnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no carry_snapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(carrySnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	carrySnapshotInsertCacheMut.RLock()
+	cache, cached := carrySnapshotInsertCache[key]
+	carrySnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			carrySnapshotAllColumns,
+			carrySnapshotColumnsWithDefault,
+			carrySnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"carry_snapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"carry_snapshot\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into carry_snapshot")
+	}
+
+	if !cached {
+		carrySnapshotInsertCacheMut.Lock()
+		carrySnapshotInsertCache[key] = cache
+		carrySnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CarrySnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CarrySnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	carrySnapshotUpdateCacheMut.RLock()
+	cache, cached := carrySnapshotUpdateCache[key]
+	carrySnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			carrySnapshotAllColumns,
+			carrySnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update carry_snapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"carry_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, carrySnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, append(wl, carrySnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update carry_snapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for carry_snapshot")
+	}
+
+	if !cached {
+		carrySnapshotUpdateCacheMut.Lock()
+		carrySnapshotUpdateCache[key] = cache
+		carrySnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q carrySnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for carry_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for carry_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CarrySnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), carrySnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"carry_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, carrySnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in carrySnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all carrySnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CarrySnapshot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no carry_snapshot provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(carrySnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	carrySnapshotUpsertCacheMut.RLock()
+	cache, cached := carrySnapshotUpsertCache[key]
+	carrySnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			carrySnapshotAllColumns,
+			carrySnapshotColumnsWithDefault,
+			carrySnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			carrySnapshotAllColumns,
+			carrySnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert carry_snapshot, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(carrySnapshotPrimaryKeyColumns))
+			copy(conflict, carrySnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"carry_snapshot\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(carrySnapshotType, carrySnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert carry_snapshot")
+	}
+
+	if !cached {
+		carrySnapshotUpsertCacheMut.Lock()
+		carrySnapshotUpsertCache[key] = cache
+		carrySnapshotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CarrySnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CarrySnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no CarrySnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), carrySnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"carry_snapshot\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from carry_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for carry_snapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q carrySnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no carrySnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from carry_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for carry_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CarrySnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(carrySnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), carrySnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"carry_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, carrySnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from carrySnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for carry_snapshot")
+	}
+
+	if len(carrySnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CarrySnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCarrySnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CarrySnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CarrySnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), carrySnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"carry_snapshot\".* FROM \"carry_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, carrySnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in CarrySnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CarrySnapshotExists checks if the CarrySnapshot row exists.
+func CarrySnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"carry_snapshot\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if carry_snapshot exists")
+	}
+
+	return exists, nil
+}
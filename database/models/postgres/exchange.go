@@ -53,12 +53,16 @@ var ExchangeRels = struct {
 	ExchangeNameCandles              string
 	ExchangeNameDatahistoryjobs      string
 	SecondaryExchangeDatahistoryjobs string
+	ExchangeNameCarrySnapshots       string
+	ExchangeNameFuturesPositions     string
 	ExchangeNameTrades               string
 	ExchangeNameWithdrawalHistories  string
 }{
 	ExchangeNameCandles:              "ExchangeNameCandles",
 	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
 	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameCarrySnapshots:       "ExchangeNameCarrySnapshots",
+	ExchangeNameFuturesPositions:     "ExchangeNameFuturesPositions",
 	ExchangeNameTrades:               "ExchangeNameTrades",
 	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
 }
@@ -68,6 +72,8 @@ type exchangeR struct {
 	ExchangeNameCandles              CandleSlice
 	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
 	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
+	ExchangeNameCarrySnapshots       CarrySnapshotSlice
+	ExchangeNameFuturesPositions     FuturesPositionSlice
 	ExchangeNameTrades               TradeSlice
 	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
 }
@@ -425,6 +431,48 @@ func (o *Exchange) SecondaryExchangeDatahistoryjobs(mods ...qm.QueryMod) datahis
 	return query
 }
 
+// ExchangeNameCarrySnapshots retrieves all the carry_snapshot's CarrySnapshots with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameCarrySnapshots(mods ...qm.QueryMod) carrySnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"carry_snapshot\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := CarrySnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"carry_snapshot\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"carry_snapshot\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameFuturesPositions retrieves all the futures_position's FuturesPositions with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFuturesPositions(mods ...qm.QueryMod) futuresPositionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"futures_position\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := FuturesPositions(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"futures_position\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameTrades retrieves all the trade's Trades with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	var queryMods []qm.QueryMod
@@ -752,6 +800,196 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 	return nil
 }
 
+// LoadExchangeNameCarrySnapshots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameCarrySnapshots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`carry_snapshot`), qm.WhereIn(`carry_snapshot.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load carry_snapshot")
+	}
+
+	var resultSlice []*CarrySnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice carry_snapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on carry_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for carry_snapshot")
+	}
+
+	if len(carrySnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameCarrySnapshots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &carrySnapshotR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameCarrySnapshots = append(local.R.ExchangeNameCarrySnapshots, foreign)
+				if foreign.R == nil {
+					foreign.R = &carrySnapshotR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameFuturesPositions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameFuturesPositions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position`), qm.WhereIn(`futures_position.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load futures_position")
+	}
+
+	var resultSlice []*FuturesPosition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice futures_position")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on futures_position")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position")
+	}
+
+	if len(futuresPositionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameFuturesPositions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &futuresPositionR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameFuturesPositions = append(local.R.ExchangeNameFuturesPositions, foreign)
+				if foreign.R == nil {
+					foreign.R = &futuresPositionR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadExchangeNameTrades allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
@@ -1171,6 +1409,112 @@ func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, e
 	return nil
 }
 
+// AddExchangeNameCarrySnapshots adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameCarrySnapshots.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameCarrySnapshots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CarrySnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"carry_snapshot\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, carrySnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCarrySnapshots: related,
+		}
+	} else {
+		o.R.ExchangeNameCarrySnapshots = append(o.R.ExchangeNameCarrySnapshots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &carrySnapshotR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameFuturesPositions adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFuturesPositions.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFuturesPositions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FuturesPosition) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"futures_position\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, futuresPositionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFuturesPositions: related,
+		}
+	} else {
+		o.R.ExchangeNameFuturesPositions = append(o.R.ExchangeNameFuturesPositions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &futuresPositionR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameTrades adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameTrades.
@@ -0,0 +1,1665 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// FuturesPosition is an object representing the database table.
+type FuturesPosition struct {
+	ID                 string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID     string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Account            string    `boil:"account" json:"account" toml:"account" yaml:"account"`
+	Asset              string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base               string    `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote              string    `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Underlying         string    `boil:"underlying" json:"underlying" toml:"underlying" yaml:"underlying"`
+	CollateralCurrency string    `boil:"collateral_currency" json:"collateral_currency" toml:"collateral_currency" yaml:"collateral_currency"`
+	Status             string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	OpeningDirection   string    `boil:"opening_direction" json:"opening_direction" toml:"opening_direction" yaml:"opening_direction"`
+	OpeningPrice       float64   `boil:"opening_price" json:"opening_price" toml:"opening_price" yaml:"opening_price"`
+	OpeningSize        float64   `boil:"opening_size" json:"opening_size" toml:"opening_size" yaml:"opening_size"`
+	OpeningTime        time.Time `boil:"opening_time" json:"opening_time" toml:"opening_time" yaml:"opening_time"`
+	LatestDirection    string    `boil:"latest_direction" json:"latest_direction" toml:"latest_direction" yaml:"latest_direction"`
+	LatestPrice        float64   `boil:"latest_price" json:"latest_price" toml:"latest_price" yaml:"latest_price"`
+	LatestSize         float64   `boil:"latest_size" json:"latest_size" toml:"latest_size" yaml:"latest_size"`
+	RealisedPNL        float64   `boil:"realised_pnl" json:"realised_pnl" toml:"realised_pnl" yaml:"realised_pnl"`
+	UnrealisedPNL      float64   `boil:"unrealised_pnl" json:"unrealised_pnl" toml:"unrealised_pnl" yaml:"unrealised_pnl"`
+	ClosingPrice       float64   `boil:"closing_price" json:"closing_price" toml:"closing_price" yaml:"closing_price"`
+	ClosingTime        null.Time `boil:"closing_time" json:"closing_time,omitempty" toml:"closing_time" yaml:"closing_time,omitempty"`
+	LastUpdated        time.Time `boil:"last_updated" json:"last_updated" toml:"last_updated" yaml:"last_updated"`
+
+	R *futuresPositionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L futuresPositionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FuturesPositionColumns = struct {
+	ID                 string
+	ExchangeNameID     string
+	Account            string
+	Asset              string
+	Base               string
+	Quote              string
+	Underlying         string
+	CollateralCurrency string
+	Status             string
+	OpeningDirection   string
+	OpeningPrice       string
+	OpeningSize        string
+	OpeningTime        string
+	LatestDirection    string
+	LatestPrice        string
+	LatestSize         string
+	RealisedPNL        string
+	UnrealisedPNL      string
+	ClosingPrice       string
+	ClosingTime        string
+	LastUpdated        string
+}{
+	ID:                 "id",
+	ExchangeNameID:     "exchange_name_id",
+	Account:            "account",
+	Asset:              "asset",
+	Base:               "base",
+	Quote:              "quote",
+	Underlying:         "underlying",
+	CollateralCurrency: "collateral_currency",
+	Status:             "status",
+	OpeningDirection:   "opening_direction",
+	OpeningPrice:       "opening_price",
+	OpeningSize:        "opening_size",
+	OpeningTime:        "opening_time",
+	LatestDirection:    "latest_direction",
+	LatestPrice:        "latest_price",
+	LatestSize:         "latest_size",
+	RealisedPNL:        "realised_pnl",
+	UnrealisedPNL:      "unrealised_pnl",
+	ClosingPrice:       "closing_price",
+	ClosingTime:        "closing_time",
+	LastUpdated:        "last_updated",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var FuturesPositionWhere = struct {
+	ID                 whereHelperstring
+	ExchangeNameID     whereHelperstring
+	Account            whereHelperstring
+	Asset              whereHelperstring
+	Base               whereHelperstring
+	Quote              whereHelperstring
+	Underlying         whereHelperstring
+	CollateralCurrency whereHelperstring
+	Status             whereHelperstring
+	OpeningDirection   whereHelperstring
+	OpeningPrice       whereHelperfloat64
+	OpeningSize        whereHelperfloat64
+	OpeningTime        whereHelpertime_Time
+	LatestDirection    whereHelperstring
+	LatestPrice        whereHelperfloat64
+	LatestSize         whereHelperfloat64
+	RealisedPNL        whereHelperfloat64
+	UnrealisedPNL      whereHelperfloat64
+	ClosingPrice       whereHelperfloat64
+	ClosingTime        whereHelpernull_Time
+	LastUpdated        whereHelpertime_Time
+}{
+	ID:                 whereHelperstring{field: "\"futures_position\".\"id\""},
+	ExchangeNameID:     whereHelperstring{field: "\"futures_position\".\"exchange_name_id\""},
+	Account:            whereHelperstring{field: "\"futures_position\".\"account\""},
+	Asset:              whereHelperstring{field: "\"futures_position\".\"asset\""},
+	Base:               whereHelperstring{field: "\"futures_position\".\"base\""},
+	Quote:              whereHelperstring{field: "\"futures_position\".\"quote\""},
+	Underlying:         whereHelperstring{field: "\"futures_position\".\"underlying\""},
+	CollateralCurrency: whereHelperstring{field: "\"futures_position\".\"collateral_currency\""},
+	Status:             whereHelperstring{field: "\"futures_position\".\"status\""},
+	OpeningDirection:   whereHelperstring{field: "\"futures_position\".\"opening_direction\""},
+	OpeningPrice:       whereHelperfloat64{field: "\"futures_position\".\"opening_price\""},
+	OpeningSize:        whereHelperfloat64{field: "\"futures_position\".\"opening_size\""},
+	OpeningTime:        whereHelpertime_Time{field: "\"futures_position\".\"opening_time\""},
+	LatestDirection:    whereHelperstring{field: "\"futures_position\".\"latest_direction\""},
+	LatestPrice:        whereHelperfloat64{field: "\"futures_position\".\"latest_price\""},
+	LatestSize:         whereHelperfloat64{field: "\"futures_position\".\"latest_size\""},
+	RealisedPNL:        whereHelperfloat64{field: "\"futures_position\".\"realised_pnl\""},
+	UnrealisedPNL:      whereHelperfloat64{field: "\"futures_position\".\"unrealised_pnl\""},
+	ClosingPrice:       whereHelperfloat64{field: "\"futures_position\".\"closing_price\""},
+	ClosingTime:        whereHelpernull_Time{field: "\"futures_position\".\"closing_time\""},
+	LastUpdated:        whereHelpertime_Time{field: "\"futures_position\".\"last_updated\""},
+}
+
+// FuturesPositionRels is where relationship names are stored.
+var FuturesPositionRels = struct {
+	ExchangeName                      string
+	PositionFuturesPositionFundings   string
+	PositionFuturesPositionOrders     string
+	PositionFuturesPositionPNLEntries string
+}{
+	ExchangeName:                      "ExchangeName",
+	PositionFuturesPositionFundings:   "PositionFuturesPositionFundings",
+	PositionFuturesPositionOrders:     "PositionFuturesPositionOrders",
+	PositionFuturesPositionPNLEntries: "PositionFuturesPositionPNLEntries",
+}
+
+// futuresPositionR is where relationships are stored.
+type futuresPositionR struct {
+	ExchangeName                      *Exchange
+	PositionFuturesPositionFundings   FuturesPositionFundingSlice
+	PositionFuturesPositionOrders     FuturesPositionOrderSlice
+	PositionFuturesPositionPNLEntries FuturesPositionPNLEntrySlice
+}
+
+// NewStruct creates a new relationship struct
+func (*futuresPositionR) NewStruct() *futuresPositionR {
+	return &futuresPositionR{}
+}
+
+// futuresPositionL is where Load methods for each relationship are stored.
+type futuresPositionL struct{}
+
+var (
+	futuresPositionAllColumns            = []string{"id", "exchange_name_id", "account", "asset", "base", "quote", "underlying", "collateral_currency", "status", "opening_direction", "opening_price", "opening_size", "opening_time", "latest_direction", "latest_price", "latest_size", "realised_pnl", "unrealised_pnl", "closing_price", "closing_time", "last_updated"}
+	futuresPositionColumnsWithoutDefault = []string{"exchange_name_id", "account", "asset", "base", "quote", "underlying", "collateral_currency", "status", "opening_direction", "opening_price", "opening_size", "opening_time", "latest_direction", "latest_price", "latest_size", "realised_pnl", "unrealised_pnl", "closing_price", "closing_time", "last_updated"}
+	futuresPositionColumnsWithDefault    = []string{"id"}
+	futuresPositionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FuturesPositionSlice is an alias for a slice of pointers to FuturesPosition.
+	// This should generally be used opposed to []FuturesPosition.
+	FuturesPositionSlice []*FuturesPosition
+	// FuturesPositionHook is the signature for custom FuturesPosition hook methods
+	FuturesPositionHook func(context.Context, boil.ContextExecutor, *FuturesPosition) error
+
+	futuresPositionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	futuresPositionType                 = reflect.TypeOf(&FuturesPosition{})
+	futuresPositionMapping              = queries.MakeStructMapping(futuresPositionType)
+	futuresPositionPrimaryKeyMapping, _ = queries.BindMapping(futuresPositionType, futuresPositionMapping, futuresPositionPrimaryKeyColumns)
+	futuresPositionInsertCacheMut       sync.RWMutex
+	futuresPositionInsertCache          = make(map[string]insertCache)
+	futuresPositionUpdateCacheMut       sync.RWMutex
+	futuresPositionUpdateCache          = make(map[string]updateCache)
+	futuresPositionUpsertCacheMut       sync.RWMutex
+	futuresPositionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var futuresPositionBeforeInsertHooks []FuturesPositionHook
+var futuresPositionBeforeUpdateHooks []FuturesPositionHook
+var futuresPositionBeforeDeleteHooks []FuturesPositionHook
+var futuresPositionBeforeUpsertHooks []FuturesPositionHook
+
+var futuresPositionAfterInsertHooks []FuturesPositionHook
+var futuresPositionAfterSelectHooks []FuturesPositionHook
+var futuresPositionAfterUpdateHooks []FuturesPositionHook
+var futuresPositionAfterDeleteHooks []FuturesPositionHook
+var futuresPositionAfterUpsertHooks []FuturesPositionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FuturesPosition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FuturesPosition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FuturesPosition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FuturesPosition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FuturesPosition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FuturesPosition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FuturesPosition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FuturesPosition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FuturesPosition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFuturesPositionHook registers your hook function for all future operations.
+func AddFuturesPositionHook(hookPoint boil.HookPoint, futuresPositionHook FuturesPositionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		futuresPositionBeforeInsertHooks = append(futuresPositionBeforeInsertHooks, futuresPositionHook)
+	case boil.BeforeUpdateHook:
+		futuresPositionBeforeUpdateHooks = append(futuresPositionBeforeUpdateHooks, futuresPositionHook)
+	case boil.BeforeDeleteHook:
+		futuresPositionBeforeDeleteHooks = append(futuresPositionBeforeDeleteHooks, futuresPositionHook)
+	case boil.BeforeUpsertHook:
+		futuresPositionBeforeUpsertHooks = append(futuresPositionBeforeUpsertHooks, futuresPositionHook)
+	case boil.AfterInsertHook:
+		futuresPositionAfterInsertHooks = append(futuresPositionAfterInsertHooks, futuresPositionHook)
+	case boil.AfterSelectHook:
+		futuresPositionAfterSelectHooks = append(futuresPositionAfterSelectHooks, futuresPositionHook)
+	case boil.AfterUpdateHook:
+		futuresPositionAfterUpdateHooks = append(futuresPositionAfterUpdateHooks, futuresPositionHook)
+	case boil.AfterDeleteHook:
+		futuresPositionAfterDeleteHooks = append(futuresPositionAfterDeleteHooks, futuresPositionHook)
+	case boil.AfterUpsertHook:
+		futuresPositionAfterUpsertHooks = append(futuresPositionAfterUpsertHooks, futuresPositionHook)
+	}
+}
+
+// One returns a single futuresPosition record from the query.
+func (q futuresPositionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FuturesPosition, error) {
+	o := &FuturesPosition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for futures_position")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FuturesPosition records from the query.
+func (q futuresPositionQuery) All(ctx context.Context, exec boil.ContextExecutor) (FuturesPositionSlice, error) {
+	var o []*FuturesPosition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to FuturesPosition slice")
+	}
+
+	if len(futuresPositionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FuturesPosition records in the query.
+func (q futuresPositionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count futures_position rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q futuresPositionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if futures_position exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *FuturesPosition) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// PositionFuturesPositionFundings retrieves all the futures_position_funding's FuturesPositionFundings with an executor via position_id column.
+func (o *FuturesPosition) PositionFuturesPositionFundings(mods ...qm.QueryMod) futuresPositionFundingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"futures_position_funding\".\"position_id\"=?", o.ID),
+	)
+
+	query := FuturesPositionFundings(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position_funding\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"futures_position_funding\".*"})
+	}
+
+	return query
+}
+
+// PositionFuturesPositionOrders retrieves all the futures_position_order's FuturesPositionOrders with an executor via position_id column.
+func (o *FuturesPosition) PositionFuturesPositionOrders(mods ...qm.QueryMod) futuresPositionOrderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"futures_position_order\".\"position_id\"=?", o.ID),
+	)
+
+	query := FuturesPositionOrders(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position_order\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"futures_position_order\".*"})
+	}
+
+	return query
+}
+
+// PositionFuturesPositionPNLEntries retrieves all the futures_position_pnl_entry's FuturesPositionPNLEntries with an executor via position_id column.
+func (o *FuturesPosition) PositionFuturesPositionPNLEntries(mods ...qm.QueryMod) futuresPositionPNLEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"futures_position_pnl_entry\".\"position_id\"=?", o.ID),
+	)
+
+	query := FuturesPositionPNLEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position_pnl_entry\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"futures_position_pnl_entry\".*"})
+	}
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (futuresPositionL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPosition interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPosition
+	var object *FuturesPosition
+
+	if singular {
+		object = maybeFuturesPosition.(*FuturesPosition)
+	} else {
+		slice = *maybeFuturesPosition.(*[]*FuturesPosition)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(futuresPositionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameFuturesPositions = append(foreign.R.ExchangeNameFuturesPositions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameFuturesPositions = append(foreign.R.ExchangeNameFuturesPositions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositionFuturesPositionFundings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (futuresPositionL) LoadPositionFuturesPositionFundings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPosition interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPosition
+	var object *FuturesPosition
+
+	if singular {
+		object = maybeFuturesPosition.(*FuturesPosition)
+	} else {
+		slice = *maybeFuturesPosition.(*[]*FuturesPosition)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position_funding`), qm.WhereIn(`futures_position_funding.position_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load futures_position_funding")
+	}
+
+	var resultSlice []*FuturesPositionFunding
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice futures_position_funding")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on futures_position_funding")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position_funding")
+	}
+
+	if len(futuresPositionFundingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PositionFuturesPositionFundings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &futuresPositionFundingR{}
+			}
+			foreign.R.Position = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PositionID {
+				local.R.PositionFuturesPositionFundings = append(local.R.PositionFuturesPositionFundings, foreign)
+				if foreign.R == nil {
+					foreign.R = &futuresPositionFundingR{}
+				}
+				foreign.R.Position = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositionFuturesPositionOrders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (futuresPositionL) LoadPositionFuturesPositionOrders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPosition interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPosition
+	var object *FuturesPosition
+
+	if singular {
+		object = maybeFuturesPosition.(*FuturesPosition)
+	} else {
+		slice = *maybeFuturesPosition.(*[]*FuturesPosition)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position_order`), qm.WhereIn(`futures_position_order.position_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load futures_position_order")
+	}
+
+	var resultSlice []*FuturesPositionOrder
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice futures_position_order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on futures_position_order")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position_order")
+	}
+
+	if len(futuresPositionOrderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PositionFuturesPositionOrders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &futuresPositionOrderR{}
+			}
+			foreign.R.Position = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PositionID {
+				local.R.PositionFuturesPositionOrders = append(local.R.PositionFuturesPositionOrders, foreign)
+				if foreign.R == nil {
+					foreign.R = &futuresPositionOrderR{}
+				}
+				foreign.R.Position = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositionFuturesPositionPNLEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (futuresPositionL) LoadPositionFuturesPositionPNLEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPosition interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPosition
+	var object *FuturesPosition
+
+	if singular {
+		object = maybeFuturesPosition.(*FuturesPosition)
+	} else {
+		slice = *maybeFuturesPosition.(*[]*FuturesPosition)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position_pnl_entry`), qm.WhereIn(`futures_position_pnl_entry.position_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load futures_position_pnl_entry")
+	}
+
+	var resultSlice []*FuturesPositionPNLEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice futures_position_pnl_entry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on futures_position_pnl_entry")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position_pnl_entry")
+	}
+
+	if len(futuresPositionPNLEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PositionFuturesPositionPNLEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &futuresPositionPNLEntryR{}
+			}
+			foreign.R.Position = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PositionID {
+				local.R.PositionFuturesPositionPNLEntries = append(local.R.PositionFuturesPositionPNLEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &futuresPositionPNLEntryR{}
+				}
+				foreign.R.Position = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the futuresPosition to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameFuturesPositions.
+func (o *FuturesPosition) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"futures_position\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 2, futuresPositionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &futuresPositionR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameFuturesPositions: FuturesPositionSlice{o},
+		}
+	} else {
+		related.R.ExchangeNameFuturesPositions = append(related.R.ExchangeNameFuturesPositions, o)
+	}
+
+	return nil
+}
+
+// AddPositionFuturesPositionFundings adds the given related objects to the existing relationships
+// of the futures_position, optionally inserting them as new records.
+// Appends related to o.R.PositionFuturesPositionFundings.
+// Sets related.R.Position appropriately.
+func (o *FuturesPosition) AddPositionFuturesPositionFundings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FuturesPositionFunding) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PositionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"futures_position_funding\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"position_id"}),
+				strmangle.WhereClause("\"", "\"", 2, futuresPositionFundingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PositionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &futuresPositionR{
+			PositionFuturesPositionFundings: related,
+		}
+	} else {
+		o.R.PositionFuturesPositionFundings = append(o.R.PositionFuturesPositionFundings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &futuresPositionFundingR{
+				Position: o,
+			}
+		} else {
+			rel.R.Position = o
+		}
+	}
+	return nil
+}
+
+// AddPositionFuturesPositionOrders adds the given related objects to the existing relationships
+// of the futures_position, optionally inserting them as new records.
+// Appends related to o.R.PositionFuturesPositionOrders.
+// Sets related.R.Position appropriately.
+func (o *FuturesPosition) AddPositionFuturesPositionOrders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FuturesPositionOrder) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PositionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"futures_position_order\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"position_id"}),
+				strmangle.WhereClause("\"", "\"", 2, futuresPositionOrderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PositionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &futuresPositionR{
+			PositionFuturesPositionOrders: related,
+		}
+	} else {
+		o.R.PositionFuturesPositionOrders = append(o.R.PositionFuturesPositionOrders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &futuresPositionOrderR{
+				Position: o,
+			}
+		} else {
+			rel.R.Position = o
+		}
+	}
+	return nil
+}
+
+// AddPositionFuturesPositionPNLEntries adds the given related objects to the existing relationships
+// of the futures_position, optionally inserting them as new records.
+// Appends related to o.R.PositionFuturesPositionPNLEntries.
+// Sets related.R.Position appropriately.
+func (o *FuturesPosition) AddPositionFuturesPositionPNLEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FuturesPositionPNLEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PositionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"futures_position_pnl_entry\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"position_id"}),
+				strmangle.WhereClause("\"", "\"", 2, futuresPositionPNLEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PositionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &futuresPositionR{
+			PositionFuturesPositionPNLEntries: related,
+		}
+	} else {
+		o.R.PositionFuturesPositionPNLEntries = append(o.R.PositionFuturesPositionPNLEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &futuresPositionPNLEntryR{
+				Position: o,
+			}
+		} else {
+			rel.R.Position = o
+		}
+	}
+	return nil
+}
+
+// FuturesPositions retrieves all the records using an executor.
+func FuturesPositions(mods ...qm.QueryMod) futuresPositionQuery {
+	mods = append(mods, qm.From("\"futures_position\""))
+	return futuresPositionQuery{NewQuery(mods...)}
+}
+
+// FindFuturesPosition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFuturesPosition(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FuturesPosition, error) {
+	futuresPositionObj := &FuturesPosition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"futures_position\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, futuresPositionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from futures_position")
+	}
+
+	return futuresPositionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FuturesPosition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	futuresPositionInsertCacheMut.RLock()
+	cache, cached := futuresPositionInsertCache[key]
+	futuresPositionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			futuresPositionAllColumns,
+			futuresPositionColumnsWithDefault,
+			futuresPositionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionType, futuresPositionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(futuresPositionType, futuresPositionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"futures_position\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"futures_position\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into futures_position")
+	}
+
+	if !cached {
+		futuresPositionInsertCacheMut.Lock()
+		futuresPositionInsertCache[key] = cache
+		futuresPositionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FuturesPosition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FuturesPosition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	futuresPositionUpdateCacheMut.RLock()
+	cache, cached := futuresPositionUpdateCache[key]
+	futuresPositionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			futuresPositionAllColumns,
+			futuresPositionPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update futures_position, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"futures_position\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, futuresPositionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(futuresPositionType, futuresPositionMapping, append(wl, futuresPositionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update futures_position row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for futures_position")
+	}
+
+	if !cached {
+		futuresPositionUpdateCacheMut.Lock()
+		futuresPositionUpdateCache[key] = cache
+		futuresPositionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q futuresPositionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for futures_position")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for futures_position")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FuturesPositionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"futures_position\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, futuresPositionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in futuresPosition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all futuresPosition")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FuturesPosition) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	futuresPositionUpsertCacheMut.RLock()
+	cache, cached := futuresPositionUpsertCache[key]
+	futuresPositionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			futuresPositionAllColumns,
+			futuresPositionColumnsWithDefault,
+			futuresPositionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			futuresPositionAllColumns,
+			futuresPositionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert futures_position, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(futuresPositionPrimaryKeyColumns))
+			copy(conflict, futuresPositionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"futures_position\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionType, futuresPositionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(futuresPositionType, futuresPositionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert futures_position")
+	}
+
+	if !cached {
+		futuresPositionUpsertCacheMut.Lock()
+		futuresPositionUpsertCache[key] = cache
+		futuresPositionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FuturesPosition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FuturesPosition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no FuturesPosition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), futuresPositionPrimaryKeyMapping)
+	sql := "DELETE FROM \"futures_position\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from futures_position")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for futures_position")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q futuresPositionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no futuresPositionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futures_position")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FuturesPositionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(futuresPositionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"futures_position\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futuresPosition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position")
+	}
+
+	if len(futuresPositionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FuturesPosition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFuturesPosition(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FuturesPositionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FuturesPositionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"futures_position\".* FROM \"futures_position\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in FuturesPositionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FuturesPositionExists checks if the FuturesPosition row exists.
+func FuturesPositionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"futures_position\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if futures_position exists")
+	}
+
+	return exists, nil
+}
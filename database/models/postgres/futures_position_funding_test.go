@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFuturesPositionFundings(t *testing.T) {
+	t.Parallel()
+
+	query := FuturesPositionFundings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFuturesPositionFundingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionFundingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FuturesPositionFundings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionFundingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionFundingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionFundingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FuturesPositionFundingExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FuturesPositionFunding exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FuturesPositionFundingExists to return true, but got false.")
+	}
+}
+
+func testFuturesPositionFundingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	futuresPositionFundingFound, err := FindFuturesPositionFunding(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if futuresPositionFundingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFuturesPositionFundingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FuturesPositionFundings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionFundingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FuturesPositionFundings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFuturesPositionFundingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	futuresPositionFundingOne := &FuturesPositionFunding{}
+	futuresPositionFundingTwo := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, futuresPositionFundingOne, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionFundingTwo, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionFundingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionFundingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionFundings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFuturesPositionFundingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	futuresPositionFundingOne := &FuturesPositionFunding{}
+	futuresPositionFundingTwo := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, futuresPositionFundingOne, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionFundingTwo, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionFundingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionFundingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func futuresPositionFundingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func futuresPositionFundingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionFunding) error {
+	*o = FuturesPositionFunding{}
+	return nil
+}
+
+func testFuturesPositionFundingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FuturesPositionFunding{}
+	o := &FuturesPositionFunding{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding object: %s", err)
+	}
+
+	AddFuturesPositionFundingHook(boil.BeforeInsertHook, futuresPositionFundingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingBeforeInsertHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.AfterInsertHook, futuresPositionFundingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingAfterInsertHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.AfterSelectHook, futuresPositionFundingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingAfterSelectHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.BeforeUpdateHook, futuresPositionFundingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingBeforeUpdateHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.AfterUpdateHook, futuresPositionFundingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingAfterUpdateHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.BeforeDeleteHook, futuresPositionFundingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingBeforeDeleteHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.AfterDeleteHook, futuresPositionFundingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingAfterDeleteHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.BeforeUpsertHook, futuresPositionFundingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingBeforeUpsertHooks = []FuturesPositionFundingHook{}
+
+	AddFuturesPositionFundingHook(boil.AfterUpsertHook, futuresPositionFundingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionFundingAfterUpsertHooks = []FuturesPositionFundingHook{}
+}
+
+func testFuturesPositionFundingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionFundingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(futuresPositionFundingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionFundingToOneFuturesPositionUsingPosition(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FuturesPositionFunding
+	var foreign FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PositionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Position().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FuturesPositionFundingSlice{&local}
+	if err = local.L.LoadPosition(ctx, tx, false, (*[]*FuturesPositionFunding)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Position = nil
+	if err = local.L.LoadPosition(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFuturesPositionFundingToOneSetOpFuturesPositionUsingPosition(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPositionFunding
+	var b, c FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionFundingDBTypes, false, strmangle.SetComplement(futuresPositionFundingPrimaryKeyColumns, futuresPositionFundingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*FuturesPosition{&b, &c} {
+		err = a.SetPosition(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Position != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PositionFuturesPositionFundings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PositionID))
+		reflect.Indirect(reflect.ValueOf(&a.PositionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID, x.ID)
+		}
+	}
+}
+
+func testFuturesPositionFundingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionFundingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionFundingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionFundingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionFundings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	futuresPositionFundingDBTypes = map[string]string{`ID`: `uuid`, `PositionID`: `uuid`, `Timestamp`: `timestamp with time zone`, `Rate`: `double precision`, `Payment`: `double precision`}
+	_                             = bytes.MinRead
+)
+
+func testFuturesPositionFundingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(futuresPositionFundingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(futuresPositionFundingAllColumns) == len(futuresPositionFundingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFuturesPositionFundingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionFundingAllColumns) == len(futuresPositionFundingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionFunding{}
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionFundingDBTypes, true, futuresPositionFundingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(futuresPositionFundingAllColumns, futuresPositionFundingPrimaryKeyColumns) {
+		fields = futuresPositionFundingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			futuresPositionFundingAllColumns,
+			futuresPositionFundingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FuturesPositionFundingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFuturesPositionFundingsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionFundingAllColumns) == len(futuresPositionFundingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FuturesPositionFunding{}
+	if err = randomize.Struct(seed, &o, futuresPositionFundingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionFunding: %s", err)
+	}
+
+	count, err := FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, futuresPositionFundingDBTypes, false, futuresPositionFundingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionFunding struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionFunding: %s", err)
+	}
+
+	count, err = FuturesPositionFundings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1080 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FuturesPositionOrder is an object representing the database table.
+type FuturesPositionOrder struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PositionID     string    `boil:"position_id" json:"position_id" toml:"position_id" yaml:"position_id"`
+	OrderID        string    `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	Side           string    `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Type           string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Status         string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price          float64   `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount         float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	ExecutedAmount float64   `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	Fee            float64   `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Leverage       float64   `boil:"leverage" json:"leverage" toml:"leverage" yaml:"leverage"`
+	Date           time.Time `boil:"date" json:"date" toml:"date" yaml:"date"`
+
+	R *futuresPositionOrderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L futuresPositionOrderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FuturesPositionOrderColumns = struct {
+	ID             string
+	PositionID     string
+	OrderID        string
+	Side           string
+	Type           string
+	Status         string
+	Price          string
+	Amount         string
+	ExecutedAmount string
+	Fee            string
+	Leverage       string
+	Date           string
+}{
+	ID:             "id",
+	PositionID:     "position_id",
+	OrderID:        "order_id",
+	Side:           "side",
+	Type:           "type",
+	Status:         "status",
+	Price:          "price",
+	Amount:         "amount",
+	ExecutedAmount: "executed_amount",
+	Fee:            "fee",
+	Leverage:       "leverage",
+	Date:           "date",
+}
+
+// Generated where
+
+var FuturesPositionOrderWhere = struct {
+	ID             whereHelperstring
+	PositionID     whereHelperstring
+	OrderID        whereHelperstring
+	Side           whereHelperstring
+	Type           whereHelperstring
+	Status         whereHelperstring
+	Price          whereHelperfloat64
+	Amount         whereHelperfloat64
+	ExecutedAmount whereHelperfloat64
+	Fee            whereHelperfloat64
+	Leverage       whereHelperfloat64
+	Date           whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"futures_position_order\".\"id\""},
+	PositionID:     whereHelperstring{field: "\"futures_position_order\".\"position_id\""},
+	OrderID:        whereHelperstring{field: "\"futures_position_order\".\"order_id\""},
+	Side:           whereHelperstring{field: "\"futures_position_order\".\"side\""},
+	Type:           whereHelperstring{field: "\"futures_position_order\".\"type\""},
+	Status:         whereHelperstring{field: "\"futures_position_order\".\"status\""},
+	Price:          whereHelperfloat64{field: "\"futures_position_order\".\"price\""},
+	Amount:         whereHelperfloat64{field: "\"futures_position_order\".\"amount\""},
+	ExecutedAmount: whereHelperfloat64{field: "\"futures_position_order\".\"executed_amount\""},
+	Fee:            whereHelperfloat64{field: "\"futures_position_order\".\"fee\""},
+	Leverage:       whereHelperfloat64{field: "\"futures_position_order\".\"leverage\""},
+	Date:           whereHelpertime_Time{field: "\"futures_position_order\".\"date\""},
+}
+
+// FuturesPositionOrderRels is where relationship names are stored.
+var FuturesPositionOrderRels = struct {
+	Position string
+}{
+	Position: "Position",
+}
+
+// futuresPositionOrderR is where relationships are stored.
+type futuresPositionOrderR struct {
+	Position *FuturesPosition
+}
+
+// NewStruct creates a new relationship struct
+func (*futuresPositionOrderR) NewStruct() *futuresPositionOrderR {
+	return &futuresPositionOrderR{}
+}
+
+// futuresPositionOrderL is where Load methods for each relationship are stored.
+type futuresPositionOrderL struct{}
+
+var (
+	futuresPositionOrderAllColumns            = []string{"id", "position_id", "order_id", "side", "type", "status", "price", "amount", "executed_amount", "fee", "leverage", "date"}
+	futuresPositionOrderColumnsWithoutDefault = []string{"position_id", "order_id", "side", "type", "status", "price", "amount", "executed_amount", "fee", "leverage", "date"}
+	futuresPositionOrderColumnsWithDefault    = []string{"id"}
+	futuresPositionOrderPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FuturesPositionOrderSlice is an alias for a slice of pointers to FuturesPositionOrder.
+	// This should generally be used opposed to []FuturesPositionOrder.
+	FuturesPositionOrderSlice []*FuturesPositionOrder
+	// FuturesPositionOrderHook is the signature for custom FuturesPositionOrder hook methods
+	FuturesPositionOrderHook func(context.Context, boil.ContextExecutor, *FuturesPositionOrder) error
+
+	futuresPositionOrderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	futuresPositionOrderType                 = reflect.TypeOf(&FuturesPositionOrder{})
+	futuresPositionOrderMapping              = queries.MakeStructMapping(futuresPositionOrderType)
+	futuresPositionOrderPrimaryKeyMapping, _ = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, futuresPositionOrderPrimaryKeyColumns)
+	futuresPositionOrderInsertCacheMut       sync.RWMutex
+	futuresPositionOrderInsertCache          = make(map[string]insertCache)
+	futuresPositionOrderUpdateCacheMut       sync.RWMutex
+	futuresPositionOrderUpdateCache          = make(map[string]updateCache)
+	futuresPositionOrderUpsertCacheMut       sync.RWMutex
+	futuresPositionOrderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var futuresPositionOrderBeforeInsertHooks []FuturesPositionOrderHook
+var futuresPositionOrderBeforeUpdateHooks []FuturesPositionOrderHook
+var futuresPositionOrderBeforeDeleteHooks []FuturesPositionOrderHook
+var futuresPositionOrderBeforeUpsertHooks []FuturesPositionOrderHook
+
+var futuresPositionOrderAfterInsertHooks []FuturesPositionOrderHook
+var futuresPositionOrderAfterSelectHooks []FuturesPositionOrderHook
+var futuresPositionOrderAfterUpdateHooks []FuturesPositionOrderHook
+var futuresPositionOrderAfterDeleteHooks []FuturesPositionOrderHook
+var futuresPositionOrderAfterUpsertHooks []FuturesPositionOrderHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FuturesPositionOrder) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FuturesPositionOrder) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FuturesPositionOrder) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FuturesPositionOrder) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FuturesPositionOrder) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FuturesPositionOrder) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FuturesPositionOrder) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FuturesPositionOrder) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FuturesPositionOrder) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionOrderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFuturesPositionOrderHook registers your hook function for all future operations.
+func AddFuturesPositionOrderHook(hookPoint boil.HookPoint, futuresPositionOrderHook FuturesPositionOrderHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		futuresPositionOrderBeforeInsertHooks = append(futuresPositionOrderBeforeInsertHooks, futuresPositionOrderHook)
+	case boil.BeforeUpdateHook:
+		futuresPositionOrderBeforeUpdateHooks = append(futuresPositionOrderBeforeUpdateHooks, futuresPositionOrderHook)
+	case boil.BeforeDeleteHook:
+		futuresPositionOrderBeforeDeleteHooks = append(futuresPositionOrderBeforeDeleteHooks, futuresPositionOrderHook)
+	case boil.BeforeUpsertHook:
+		futuresPositionOrderBeforeUpsertHooks = append(futuresPositionOrderBeforeUpsertHooks, futuresPositionOrderHook)
+	case boil.AfterInsertHook:
+		futuresPositionOrderAfterInsertHooks = append(futuresPositionOrderAfterInsertHooks, futuresPositionOrderHook)
+	case boil.AfterSelectHook:
+		futuresPositionOrderAfterSelectHooks = append(futuresPositionOrderAfterSelectHooks, futuresPositionOrderHook)
+	case boil.AfterUpdateHook:
+		futuresPositionOrderAfterUpdateHooks = append(futuresPositionOrderAfterUpdateHooks, futuresPositionOrderHook)
+	case boil.AfterDeleteHook:
+		futuresPositionOrderAfterDeleteHooks = append(futuresPositionOrderAfterDeleteHooks, futuresPositionOrderHook)
+	case boil.AfterUpsertHook:
+		futuresPositionOrderAfterUpsertHooks = append(futuresPositionOrderAfterUpsertHooks, futuresPositionOrderHook)
+	}
+}
+
+// One returns a single futuresPositionOrder record from the query.
+func (q futuresPositionOrderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FuturesPositionOrder, error) {
+	o := &FuturesPositionOrder{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for futures_position_order")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FuturesPositionOrder records from the query.
+func (q futuresPositionOrderQuery) All(ctx context.Context, exec boil.ContextExecutor) (FuturesPositionOrderSlice, error) {
+	var o []*FuturesPositionOrder
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to FuturesPositionOrder slice")
+	}
+
+	if len(futuresPositionOrderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FuturesPositionOrder records in the query.
+func (q futuresPositionOrderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count futures_position_order rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q futuresPositionOrderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if futures_position_order exists")
+	}
+
+	return count > 0, nil
+}
+
+// Position pointed to by the foreign key.
+func (o *FuturesPositionOrder) Position(mods ...qm.QueryMod) futuresPositionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PositionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FuturesPositions(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position\"")
+
+	return query
+}
+
+// LoadPosition allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (futuresPositionOrderL) LoadPosition(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPositionOrder interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPositionOrder
+	var object *FuturesPositionOrder
+
+	if singular {
+		object = maybeFuturesPositionOrder.(*FuturesPositionOrder)
+	} else {
+		slice = *maybeFuturesPositionOrder.(*[]*FuturesPositionOrder)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionOrderR{}
+		}
+		args = append(args, object.PositionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionOrderR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PositionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PositionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position`), qm.WhereIn(`futures_position.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FuturesPosition")
+	}
+
+	var resultSlice []*FuturesPosition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FuturesPosition")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for futures_position")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position")
+	}
+
+	if len(futuresPositionOrderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Position = foreign
+		if foreign.R == nil {
+			foreign.R = &futuresPositionR{}
+		}
+		foreign.R.PositionFuturesPositionOrders = append(foreign.R.PositionFuturesPositionOrders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PositionID == foreign.ID {
+				local.R.Position = foreign
+				if foreign.R == nil {
+					foreign.R = &futuresPositionR{}
+				}
+				foreign.R.PositionFuturesPositionOrders = append(foreign.R.PositionFuturesPositionOrders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPosition of the futuresPositionOrder to the related item.
+// Sets o.R.Position to related.
+// Adds o to related.R.PositionFuturesPositionOrders.
+func (o *FuturesPositionOrder) SetPosition(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FuturesPosition) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"futures_position_order\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"position_id"}),
+		strmangle.WhereClause("\"", "\"", 2, futuresPositionOrderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PositionID = related.ID
+	if o.R == nil {
+		o.R = &futuresPositionOrderR{
+			Position: related,
+		}
+	} else {
+		o.R.Position = related
+	}
+
+	if related.R == nil {
+		related.R = &futuresPositionR{
+			PositionFuturesPositionOrders: FuturesPositionOrderSlice{o},
+		}
+	} else {
+		related.R.PositionFuturesPositionOrders = append(related.R.PositionFuturesPositionOrders, o)
+	}
+
+	return nil
+}
+
+// FuturesPositionOrders retrieves all the records using an executor.
+func FuturesPositionOrders(mods ...qm.QueryMod) futuresPositionOrderQuery {
+	mods = append(mods, qm.From("\"futures_position_order\""))
+	return futuresPositionOrderQuery{NewQuery(mods...)}
+}
+
+// FindFuturesPositionOrder retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFuturesPositionOrder(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FuturesPositionOrder, error) {
+	futuresPositionOrderObj := &FuturesPositionOrder{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"futures_position_order\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, futuresPositionOrderObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from futures_position_order")
+	}
+
+	return futuresPositionOrderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FuturesPositionOrder) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position_order provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionOrderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	futuresPositionOrderInsertCacheMut.RLock()
+	cache, cached := futuresPositionOrderInsertCache[key]
+	futuresPositionOrderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			futuresPositionOrderAllColumns,
+			futuresPositionOrderColumnsWithDefault,
+			futuresPositionOrderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"futures_position_order\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"futures_position_order\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into futures_position_order")
+	}
+
+	if !cached {
+		futuresPositionOrderInsertCacheMut.Lock()
+		futuresPositionOrderInsertCache[key] = cache
+		futuresPositionOrderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FuturesPositionOrder.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FuturesPositionOrder) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	futuresPositionOrderUpdateCacheMut.RLock()
+	cache, cached := futuresPositionOrderUpdateCache[key]
+	futuresPositionOrderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			futuresPositionOrderAllColumns,
+			futuresPositionOrderPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update futures_position_order, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"futures_position_order\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, futuresPositionOrderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, append(wl, futuresPositionOrderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update futures_position_order row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for futures_position_order")
+	}
+
+	if !cached {
+		futuresPositionOrderUpdateCacheMut.Lock()
+		futuresPositionOrderUpdateCache[key] = cache
+		futuresPositionOrderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q futuresPositionOrderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for futures_position_order")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for futures_position_order")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FuturesPositionOrderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionOrderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"futures_position_order\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, futuresPositionOrderPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in futuresPositionOrder slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all futuresPositionOrder")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FuturesPositionOrder) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position_order provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionOrderColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	futuresPositionOrderUpsertCacheMut.RLock()
+	cache, cached := futuresPositionOrderUpsertCache[key]
+	futuresPositionOrderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			futuresPositionOrderAllColumns,
+			futuresPositionOrderColumnsWithDefault,
+			futuresPositionOrderColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			futuresPositionOrderAllColumns,
+			futuresPositionOrderPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert futures_position_order, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(futuresPositionOrderPrimaryKeyColumns))
+			copy(conflict, futuresPositionOrderPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"futures_position_order\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(futuresPositionOrderType, futuresPositionOrderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert futures_position_order")
+	}
+
+	if !cached {
+		futuresPositionOrderUpsertCacheMut.Lock()
+		futuresPositionOrderUpsertCache[key] = cache
+		futuresPositionOrderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FuturesPositionOrder record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FuturesPositionOrder) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no FuturesPositionOrder provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), futuresPositionOrderPrimaryKeyMapping)
+	sql := "DELETE FROM \"futures_position_order\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from futures_position_order")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for futures_position_order")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q futuresPositionOrderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no futuresPositionOrderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futures_position_order")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position_order")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FuturesPositionOrderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(futuresPositionOrderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionOrderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"futures_position_order\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionOrderPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futuresPositionOrder slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position_order")
+	}
+
+	if len(futuresPositionOrderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FuturesPositionOrder) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFuturesPositionOrder(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FuturesPositionOrderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FuturesPositionOrderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionOrderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"futures_position_order\".* FROM \"futures_position_order\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionOrderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in FuturesPositionOrderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FuturesPositionOrderExists checks if the FuturesPositionOrder row exists.
+func FuturesPositionOrderExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"futures_position_order\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if futures_position_order exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFuturesPositionOrders(t *testing.T) {
+	t.Parallel()
+
+	query := FuturesPositionOrders()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFuturesPositionOrdersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionOrdersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FuturesPositionOrders().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionOrdersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionOrderSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionOrdersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FuturesPositionOrderExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FuturesPositionOrder exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FuturesPositionOrderExists to return true, but got false.")
+	}
+}
+
+func testFuturesPositionOrdersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	futuresPositionOrderFound, err := FindFuturesPositionOrder(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if futuresPositionOrderFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFuturesPositionOrdersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FuturesPositionOrders().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionOrdersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FuturesPositionOrders().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFuturesPositionOrdersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	futuresPositionOrderOne := &FuturesPositionOrder{}
+	futuresPositionOrderTwo := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, futuresPositionOrderOne, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionOrderTwo, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionOrderOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionOrderTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionOrders().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFuturesPositionOrdersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	futuresPositionOrderOne := &FuturesPositionOrder{}
+	futuresPositionOrderTwo := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, futuresPositionOrderOne, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionOrderTwo, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionOrderOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionOrderTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func futuresPositionOrderBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func futuresPositionOrderAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionOrder) error {
+	*o = FuturesPositionOrder{}
+	return nil
+}
+
+func testFuturesPositionOrdersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FuturesPositionOrder{}
+	o := &FuturesPositionOrder{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder object: %s", err)
+	}
+
+	AddFuturesPositionOrderHook(boil.BeforeInsertHook, futuresPositionOrderBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderBeforeInsertHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.AfterInsertHook, futuresPositionOrderAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderAfterInsertHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.AfterSelectHook, futuresPositionOrderAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderAfterSelectHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.BeforeUpdateHook, futuresPositionOrderBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderBeforeUpdateHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.AfterUpdateHook, futuresPositionOrderAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderAfterUpdateHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.BeforeDeleteHook, futuresPositionOrderBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderBeforeDeleteHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.AfterDeleteHook, futuresPositionOrderAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderAfterDeleteHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.BeforeUpsertHook, futuresPositionOrderBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderBeforeUpsertHooks = []FuturesPositionOrderHook{}
+
+	AddFuturesPositionOrderHook(boil.AfterUpsertHook, futuresPositionOrderAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionOrderAfterUpsertHooks = []FuturesPositionOrderHook{}
+}
+
+func testFuturesPositionOrdersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionOrdersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(futuresPositionOrderColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionOrderToOneFuturesPositionUsingPosition(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FuturesPositionOrder
+	var foreign FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PositionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Position().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FuturesPositionOrderSlice{&local}
+	if err = local.L.LoadPosition(ctx, tx, false, (*[]*FuturesPositionOrder)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Position = nil
+	if err = local.L.LoadPosition(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFuturesPositionOrderToOneSetOpFuturesPositionUsingPosition(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPositionOrder
+	var b, c FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionOrderDBTypes, false, strmangle.SetComplement(futuresPositionOrderPrimaryKeyColumns, futuresPositionOrderColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*FuturesPosition{&b, &c} {
+		err = a.SetPosition(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Position != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PositionFuturesPositionOrders[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PositionID))
+		reflect.Indirect(reflect.ValueOf(&a.PositionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID, x.ID)
+		}
+	}
+}
+
+func testFuturesPositionOrdersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionOrdersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionOrderSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionOrdersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionOrders().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	futuresPositionOrderDBTypes = map[string]string{`ID`: `uuid`, `PositionID`: `uuid`, `OrderID`: `character varying`, `Side`: `character varying`, `Type`: `character varying`, `Status`: `character varying`, `Price`: `double precision`, `Amount`: `double precision`, `ExecutedAmount`: `double precision`, `Fee`: `double precision`, `Leverage`: `double precision`, `Date`: `timestamp with time zone`}
+	_                           = bytes.MinRead
+)
+
+func testFuturesPositionOrdersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(futuresPositionOrderPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(futuresPositionOrderAllColumns) == len(futuresPositionOrderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFuturesPositionOrdersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionOrderAllColumns) == len(futuresPositionOrderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionOrder{}
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionOrderDBTypes, true, futuresPositionOrderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(futuresPositionOrderAllColumns, futuresPositionOrderPrimaryKeyColumns) {
+		fields = futuresPositionOrderAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			futuresPositionOrderAllColumns,
+			futuresPositionOrderPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FuturesPositionOrderSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFuturesPositionOrdersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionOrderAllColumns) == len(futuresPositionOrderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FuturesPositionOrder{}
+	if err = randomize.Struct(seed, &o, futuresPositionOrderDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionOrder: %s", err)
+	}
+
+	count, err := FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, futuresPositionOrderDBTypes, false, futuresPositionOrderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionOrder struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionOrder: %s", err)
+	}
+
+	count, err = FuturesPositionOrders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1094 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FuturesPositionPNLEntry is an object representing the database table.
+type FuturesPositionPNLEntry struct {
+	ID                    string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PositionID            string    `boil:"position_id" json:"position_id" toml:"position_id" yaml:"position_id"`
+	Timestamp             time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Status                string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	UnrealisedPNL         float64   `boil:"unrealised_pnl" json:"unrealised_pnl" toml:"unrealised_pnl" yaml:"unrealised_pnl"`
+	RealisedPNLBeforeFees float64   `boil:"realised_pnl_before_fees" json:"realised_pnl_before_fees" toml:"realised_pnl_before_fees" yaml:"realised_pnl_before_fees"`
+	RealisedPNL           float64   `boil:"realised_pnl" json:"realised_pnl" toml:"realised_pnl" yaml:"realised_pnl"`
+	Price                 float64   `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Exposure              float64   `boil:"exposure" json:"exposure" toml:"exposure" yaml:"exposure"`
+	Direction             string    `boil:"direction" json:"direction" toml:"direction" yaml:"direction"`
+	Fee                   float64   `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	IsLiquidated          bool      `boil:"is_liquidated" json:"is_liquidated" toml:"is_liquidated" yaml:"is_liquidated"`
+	IsOrder               bool      `boil:"is_order" json:"is_order" toml:"is_order" yaml:"is_order"`
+
+	R *futuresPositionPNLEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L futuresPositionPNLEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FuturesPositionPNLEntryColumns = struct {
+	ID                    string
+	PositionID            string
+	Timestamp             string
+	Status                string
+	UnrealisedPNL         string
+	RealisedPNLBeforeFees string
+	RealisedPNL           string
+	Price                 string
+	Exposure              string
+	Direction             string
+	Fee                   string
+	IsLiquidated          string
+	IsOrder               string
+}{
+	ID:                    "id",
+	PositionID:            "position_id",
+	Timestamp:             "timestamp",
+	Status:                "status",
+	UnrealisedPNL:         "unrealised_pnl",
+	RealisedPNLBeforeFees: "realised_pnl_before_fees",
+	RealisedPNL:           "realised_pnl",
+	Price:                 "price",
+	Exposure:              "exposure",
+	Direction:             "direction",
+	Fee:                   "fee",
+	IsLiquidated:          "is_liquidated",
+	IsOrder:               "is_order",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var FuturesPositionPNLEntryWhere = struct {
+	ID                    whereHelperstring
+	PositionID            whereHelperstring
+	Timestamp             whereHelpertime_Time
+	Status                whereHelperstring
+	UnrealisedPNL         whereHelperfloat64
+	RealisedPNLBeforeFees whereHelperfloat64
+	RealisedPNL           whereHelperfloat64
+	Price                 whereHelperfloat64
+	Exposure              whereHelperfloat64
+	Direction             whereHelperstring
+	Fee                   whereHelperfloat64
+	IsLiquidated          whereHelperbool
+	IsOrder               whereHelperbool
+}{
+	ID:                    whereHelperstring{field: "\"futures_position_pnl_entry\".\"id\""},
+	PositionID:            whereHelperstring{field: "\"futures_position_pnl_entry\".\"position_id\""},
+	Timestamp:             whereHelpertime_Time{field: "\"futures_position_pnl_entry\".\"timestamp\""},
+	Status:                whereHelperstring{field: "\"futures_position_pnl_entry\".\"status\""},
+	UnrealisedPNL:         whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"unrealised_pnl\""},
+	RealisedPNLBeforeFees: whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"realised_pnl_before_fees\""},
+	RealisedPNL:           whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"realised_pnl\""},
+	Price:                 whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"price\""},
+	Exposure:              whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"exposure\""},
+	Direction:             whereHelperstring{field: "\"futures_position_pnl_entry\".\"direction\""},
+	Fee:                   whereHelperfloat64{field: "\"futures_position_pnl_entry\".\"fee\""},
+	IsLiquidated:          whereHelperbool{field: "\"futures_position_pnl_entry\".\"is_liquidated\""},
+	IsOrder:               whereHelperbool{field: "\"futures_position_pnl_entry\".\"is_order\""},
+}
+
+// FuturesPositionPNLEntryRels is where relationship names are stored.
+var FuturesPositionPNLEntryRels = struct {
+	Position string
+}{
+	Position: "Position",
+}
+
+// futuresPositionPNLEntryR is where relationships are stored.
+type futuresPositionPNLEntryR struct {
+	Position *FuturesPosition
+}
+
+// NewStruct creates a new relationship struct
+func (*futuresPositionPNLEntryR) NewStruct() *futuresPositionPNLEntryR {
+	return &futuresPositionPNLEntryR{}
+}
+
+// futuresPositionPNLEntryL is where Load methods for each relationship are stored.
+type futuresPositionPNLEntryL struct{}
+
+var (
+	futuresPositionPNLEntryAllColumns            = []string{"id", "position_id", "timestamp", "status", "unrealised_pnl", "realised_pnl_before_fees", "realised_pnl", "price", "exposure", "direction", "fee", "is_liquidated", "is_order"}
+	futuresPositionPNLEntryColumnsWithoutDefault = []string{"position_id", "timestamp", "status", "unrealised_pnl", "realised_pnl_before_fees", "realised_pnl", "price", "exposure", "direction", "fee", "is_liquidated", "is_order"}
+	futuresPositionPNLEntryColumnsWithDefault    = []string{"id"}
+	futuresPositionPNLEntryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FuturesPositionPNLEntrySlice is an alias for a slice of pointers to FuturesPositionPNLEntry.
+	// This should generally be used opposed to []FuturesPositionPNLEntry.
+	FuturesPositionPNLEntrySlice []*FuturesPositionPNLEntry
+	// FuturesPositionPNLEntryHook is the signature for custom FuturesPositionPNLEntry hook methods
+	FuturesPositionPNLEntryHook func(context.Context, boil.ContextExecutor, *FuturesPositionPNLEntry) error
+
+	futuresPositionPNLEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	futuresPositionPNLEntryType                 = reflect.TypeOf(&FuturesPositionPNLEntry{})
+	futuresPositionPNLEntryMapping              = queries.MakeStructMapping(futuresPositionPNLEntryType)
+	futuresPositionPNLEntryPrimaryKeyMapping, _ = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, futuresPositionPNLEntryPrimaryKeyColumns)
+	futuresPositionPNLEntryInsertCacheMut       sync.RWMutex
+	futuresPositionPNLEntryInsertCache          = make(map[string]insertCache)
+	futuresPositionPNLEntryUpdateCacheMut       sync.RWMutex
+	futuresPositionPNLEntryUpdateCache          = make(map[string]updateCache)
+	futuresPositionPNLEntryUpsertCacheMut       sync.RWMutex
+	futuresPositionPNLEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var futuresPositionPNLEntryBeforeInsertHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryBeforeUpdateHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryBeforeDeleteHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryBeforeUpsertHooks []FuturesPositionPNLEntryHook
+
+var futuresPositionPNLEntryAfterInsertHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryAfterSelectHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryAfterUpdateHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryAfterDeleteHooks []FuturesPositionPNLEntryHook
+var futuresPositionPNLEntryAfterUpsertHooks []FuturesPositionPNLEntryHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FuturesPositionPNLEntry) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FuturesPositionPNLEntry) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FuturesPositionPNLEntry) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FuturesPositionPNLEntry) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FuturesPositionPNLEntry) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FuturesPositionPNLEntry) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FuturesPositionPNLEntry) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FuturesPositionPNLEntry) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FuturesPositionPNLEntry) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionPNLEntryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFuturesPositionPNLEntryHook registers your hook function for all future operations.
+func AddFuturesPositionPNLEntryHook(hookPoint boil.HookPoint, futuresPositionPNLEntryHook FuturesPositionPNLEntryHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		futuresPositionPNLEntryBeforeInsertHooks = append(futuresPositionPNLEntryBeforeInsertHooks, futuresPositionPNLEntryHook)
+	case boil.BeforeUpdateHook:
+		futuresPositionPNLEntryBeforeUpdateHooks = append(futuresPositionPNLEntryBeforeUpdateHooks, futuresPositionPNLEntryHook)
+	case boil.BeforeDeleteHook:
+		futuresPositionPNLEntryBeforeDeleteHooks = append(futuresPositionPNLEntryBeforeDeleteHooks, futuresPositionPNLEntryHook)
+	case boil.BeforeUpsertHook:
+		futuresPositionPNLEntryBeforeUpsertHooks = append(futuresPositionPNLEntryBeforeUpsertHooks, futuresPositionPNLEntryHook)
+	case boil.AfterInsertHook:
+		futuresPositionPNLEntryAfterInsertHooks = append(futuresPositionPNLEntryAfterInsertHooks, futuresPositionPNLEntryHook)
+	case boil.AfterSelectHook:
+		futuresPositionPNLEntryAfterSelectHooks = append(futuresPositionPNLEntryAfterSelectHooks, futuresPositionPNLEntryHook)
+	case boil.AfterUpdateHook:
+		futuresPositionPNLEntryAfterUpdateHooks = append(futuresPositionPNLEntryAfterUpdateHooks, futuresPositionPNLEntryHook)
+	case boil.AfterDeleteHook:
+		futuresPositionPNLEntryAfterDeleteHooks = append(futuresPositionPNLEntryAfterDeleteHooks, futuresPositionPNLEntryHook)
+	case boil.AfterUpsertHook:
+		futuresPositionPNLEntryAfterUpsertHooks = append(futuresPositionPNLEntryAfterUpsertHooks, futuresPositionPNLEntryHook)
+	}
+}
+
+// One returns a single futuresPositionPNLEntry record from the query.
+func (q futuresPositionPNLEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FuturesPositionPNLEntry, error) {
+	o := &FuturesPositionPNLEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for futures_position_pnl_entry")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FuturesPositionPNLEntry records from the query.
+func (q futuresPositionPNLEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (FuturesPositionPNLEntrySlice, error) {
+	var o []*FuturesPositionPNLEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to FuturesPositionPNLEntry slice")
+	}
+
+	if len(futuresPositionPNLEntryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FuturesPositionPNLEntry records in the query.
+func (q futuresPositionPNLEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count futures_position_pnl_entry rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q futuresPositionPNLEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if futures_position_pnl_entry exists")
+	}
+
+	return count > 0, nil
+}
+
+// Position pointed to by the foreign key.
+func (o *FuturesPositionPNLEntry) Position(mods ...qm.QueryMod) futuresPositionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PositionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FuturesPositions(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position\"")
+
+	return query
+}
+
+// LoadPosition allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (futuresPositionPNLEntryL) LoadPosition(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPositionPNLEntry interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPositionPNLEntry
+	var object *FuturesPositionPNLEntry
+
+	if singular {
+		object = maybeFuturesPositionPNLEntry.(*FuturesPositionPNLEntry)
+	} else {
+		slice = *maybeFuturesPositionPNLEntry.(*[]*FuturesPositionPNLEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionPNLEntryR{}
+		}
+		args = append(args, object.PositionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionPNLEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PositionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PositionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position`), qm.WhereIn(`futures_position.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FuturesPosition")
+	}
+
+	var resultSlice []*FuturesPosition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FuturesPosition")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for futures_position")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position")
+	}
+
+	if len(futuresPositionPNLEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Position = foreign
+		if foreign.R == nil {
+			foreign.R = &futuresPositionR{}
+		}
+		foreign.R.PositionFuturesPositionPNLEntries = append(foreign.R.PositionFuturesPositionPNLEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PositionID == foreign.ID {
+				local.R.Position = foreign
+				if foreign.R == nil {
+					foreign.R = &futuresPositionR{}
+				}
+				foreign.R.PositionFuturesPositionPNLEntries = append(foreign.R.PositionFuturesPositionPNLEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPosition of the futuresPositionPNLEntry to the related item.
+// Sets o.R.Position to related.
+// Adds o to related.R.PositionFuturesPositionPNLEntries.
+func (o *FuturesPositionPNLEntry) SetPosition(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FuturesPosition) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"futures_position_pnl_entry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"position_id"}),
+		strmangle.WhereClause("\"", "\"", 2, futuresPositionPNLEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PositionID = related.ID
+	if o.R == nil {
+		o.R = &futuresPositionPNLEntryR{
+			Position: related,
+		}
+	} else {
+		o.R.Position = related
+	}
+
+	if related.R == nil {
+		related.R = &futuresPositionR{
+			PositionFuturesPositionPNLEntries: FuturesPositionPNLEntrySlice{o},
+		}
+	} else {
+		related.R.PositionFuturesPositionPNLEntries = append(related.R.PositionFuturesPositionPNLEntries, o)
+	}
+
+	return nil
+}
+
+// FuturesPositionPNLEntries retrieves all the records using an executor.
+func FuturesPositionPNLEntries(mods ...qm.QueryMod) futuresPositionPNLEntryQuery {
+	mods = append(mods, qm.From("\"futures_position_pnl_entry\""))
+	return futuresPositionPNLEntryQuery{NewQuery(mods...)}
+}
+
+// FindFuturesPositionPNLEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFuturesPositionPNLEntry(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FuturesPositionPNLEntry, error) {
+	futuresPositionPNLEntryObj := &FuturesPositionPNLEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"futures_position_pnl_entry\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, futuresPositionPNLEntryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from futures_position_pnl_entry")
+	}
+
+	return futuresPositionPNLEntryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FuturesPositionPNLEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position_pnl_entry provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionPNLEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	futuresPositionPNLEntryInsertCacheMut.RLock()
+	cache, cached := futuresPositionPNLEntryInsertCache[key]
+	futuresPositionPNLEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			futuresPositionPNLEntryAllColumns,
+			futuresPositionPNLEntryColumnsWithDefault,
+			futuresPositionPNLEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"futures_position_pnl_entry\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"futures_position_pnl_entry\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into futures_position_pnl_entry")
+	}
+
+	if !cached {
+		futuresPositionPNLEntryInsertCacheMut.Lock()
+		futuresPositionPNLEntryInsertCache[key] = cache
+		futuresPositionPNLEntryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FuturesPositionPNLEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FuturesPositionPNLEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	futuresPositionPNLEntryUpdateCacheMut.RLock()
+	cache, cached := futuresPositionPNLEntryUpdateCache[key]
+	futuresPositionPNLEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			futuresPositionPNLEntryAllColumns,
+			futuresPositionPNLEntryPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update futures_position_pnl_entry, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"futures_position_pnl_entry\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, futuresPositionPNLEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, append(wl, futuresPositionPNLEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update futures_position_pnl_entry row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for futures_position_pnl_entry")
+	}
+
+	if !cached {
+		futuresPositionPNLEntryUpdateCacheMut.Lock()
+		futuresPositionPNLEntryUpdateCache[key] = cache
+		futuresPositionPNLEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q futuresPositionPNLEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for futures_position_pnl_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for futures_position_pnl_entry")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FuturesPositionPNLEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPNLEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"futures_position_pnl_entry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, futuresPositionPNLEntryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in futuresPositionPNLEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all futuresPositionPNLEntry")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FuturesPositionPNLEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no futures_position_pnl_entry provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionPNLEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	futuresPositionPNLEntryUpsertCacheMut.RLock()
+	cache, cached := futuresPositionPNLEntryUpsertCache[key]
+	futuresPositionPNLEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			futuresPositionPNLEntryAllColumns,
+			futuresPositionPNLEntryColumnsWithDefault,
+			futuresPositionPNLEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			futuresPositionPNLEntryAllColumns,
+			futuresPositionPNLEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert futures_position_pnl_entry, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(futuresPositionPNLEntryPrimaryKeyColumns))
+			copy(conflict, futuresPositionPNLEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"futures_position_pnl_entry\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(futuresPositionPNLEntryType, futuresPositionPNLEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert futures_position_pnl_entry")
+	}
+
+	if !cached {
+		futuresPositionPNLEntryUpsertCacheMut.Lock()
+		futuresPositionPNLEntryUpsertCache[key] = cache
+		futuresPositionPNLEntryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FuturesPositionPNLEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FuturesPositionPNLEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no FuturesPositionPNLEntry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), futuresPositionPNLEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"futures_position_pnl_entry\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from futures_position_pnl_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for futures_position_pnl_entry")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q futuresPositionPNLEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no futuresPositionPNLEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futures_position_pnl_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position_pnl_entry")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FuturesPositionPNLEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(futuresPositionPNLEntryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPNLEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"futures_position_pnl_entry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionPNLEntryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from futuresPositionPNLEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for futures_position_pnl_entry")
+	}
+
+	if len(futuresPositionPNLEntryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FuturesPositionPNLEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFuturesPositionPNLEntry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FuturesPositionPNLEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FuturesPositionPNLEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionPNLEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"futures_position_pnl_entry\".* FROM \"futures_position_pnl_entry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, futuresPositionPNLEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in FuturesPositionPNLEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FuturesPositionPNLEntryExists checks if the FuturesPositionPNLEntry row exists.
+func FuturesPositionPNLEntryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"futures_position_pnl_entry\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if futures_position_pnl_entry exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFuturesPositionPNLEntries(t *testing.T) {
+	t.Parallel()
+
+	query := FuturesPositionPNLEntries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFuturesPositionPNLEntriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionPNLEntriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FuturesPositionPNLEntries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionPNLEntriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionPNLEntrySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionPNLEntriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FuturesPositionPNLEntryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FuturesPositionPNLEntry exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FuturesPositionPNLEntryExists to return true, but got false.")
+	}
+}
+
+func testFuturesPositionPNLEntriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	futuresPositionPNLEntryFound, err := FindFuturesPositionPNLEntry(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if futuresPositionPNLEntryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFuturesPositionPNLEntriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FuturesPositionPNLEntries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionPNLEntriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FuturesPositionPNLEntries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFuturesPositionPNLEntriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	futuresPositionPNLEntryOne := &FuturesPositionPNLEntry{}
+	futuresPositionPNLEntryTwo := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, futuresPositionPNLEntryOne, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionPNLEntryTwo, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionPNLEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionPNLEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionPNLEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFuturesPositionPNLEntriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	futuresPositionPNLEntryOne := &FuturesPositionPNLEntry{}
+	futuresPositionPNLEntryTwo := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, futuresPositionPNLEntryOne, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionPNLEntryTwo, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionPNLEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionPNLEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func futuresPositionPNLEntryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func futuresPositionPNLEntryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPositionPNLEntry) error {
+	*o = FuturesPositionPNLEntry{}
+	return nil
+}
+
+func testFuturesPositionPNLEntriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FuturesPositionPNLEntry{}
+	o := &FuturesPositionPNLEntry{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry object: %s", err)
+	}
+
+	AddFuturesPositionPNLEntryHook(boil.BeforeInsertHook, futuresPositionPNLEntryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryBeforeInsertHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.AfterInsertHook, futuresPositionPNLEntryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryAfterInsertHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.AfterSelectHook, futuresPositionPNLEntryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryAfterSelectHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.BeforeUpdateHook, futuresPositionPNLEntryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryBeforeUpdateHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.AfterUpdateHook, futuresPositionPNLEntryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryAfterUpdateHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.BeforeDeleteHook, futuresPositionPNLEntryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryBeforeDeleteHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.AfterDeleteHook, futuresPositionPNLEntryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryAfterDeleteHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.BeforeUpsertHook, futuresPositionPNLEntryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryBeforeUpsertHooks = []FuturesPositionPNLEntryHook{}
+
+	AddFuturesPositionPNLEntryHook(boil.AfterUpsertHook, futuresPositionPNLEntryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionPNLEntryAfterUpsertHooks = []FuturesPositionPNLEntryHook{}
+}
+
+func testFuturesPositionPNLEntriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionPNLEntriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(futuresPositionPNLEntryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionPNLEntryToOneFuturesPositionUsingPosition(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FuturesPositionPNLEntry
+	var foreign FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PositionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Position().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FuturesPositionPNLEntrySlice{&local}
+	if err = local.L.LoadPosition(ctx, tx, false, (*[]*FuturesPositionPNLEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Position = nil
+	if err = local.L.LoadPosition(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Position == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFuturesPositionPNLEntryToOneSetOpFuturesPositionUsingPosition(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPositionPNLEntry
+	var b, c FuturesPosition
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionPNLEntryDBTypes, false, strmangle.SetComplement(futuresPositionPNLEntryPrimaryKeyColumns, futuresPositionPNLEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*FuturesPosition{&b, &c} {
+		err = a.SetPosition(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Position != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PositionFuturesPositionPNLEntries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PositionID))
+		reflect.Indirect(reflect.ValueOf(&a.PositionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PositionID != x.ID {
+			t.Error("foreign key was wrong value", a.PositionID, x.ID)
+		}
+	}
+}
+
+func testFuturesPositionPNLEntriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionPNLEntriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionPNLEntrySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionPNLEntriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositionPNLEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	futuresPositionPNLEntryDBTypes = map[string]string{`ID`: `uuid`, `PositionID`: `uuid`, `Timestamp`: `timestamp with time zone`, `Status`: `character varying`, `UnrealisedPNL`: `double precision`, `RealisedPNLBeforeFees`: `double precision`, `RealisedPNL`: `double precision`, `Price`: `double precision`, `Exposure`: `double precision`, `Direction`: `character varying`, `Fee`: `double precision`, `IsLiquidated`: `boolean`, `IsOrder`: `boolean`}
+	_                              = bytes.MinRead
+)
+
+func testFuturesPositionPNLEntriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(futuresPositionPNLEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(futuresPositionPNLEntryAllColumns) == len(futuresPositionPNLEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFuturesPositionPNLEntriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionPNLEntryAllColumns) == len(futuresPositionPNLEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionPNLEntryDBTypes, true, futuresPositionPNLEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(futuresPositionPNLEntryAllColumns, futuresPositionPNLEntryPrimaryKeyColumns) {
+		fields = futuresPositionPNLEntryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			futuresPositionPNLEntryAllColumns,
+			futuresPositionPNLEntryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FuturesPositionPNLEntrySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFuturesPositionPNLEntriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionPNLEntryAllColumns) == len(futuresPositionPNLEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FuturesPositionPNLEntry{}
+	if err = randomize.Struct(seed, &o, futuresPositionPNLEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionPNLEntry: %s", err)
+	}
+
+	count, err := FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPositionPNLEntry struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPositionPNLEntry: %s", err)
+	}
+
+	count, err = FuturesPositionPNLEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
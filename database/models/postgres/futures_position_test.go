@@ -0,0 +1,1300 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFuturesPositions(t *testing.T) {
+	t.Parallel()
+
+	query := FuturesPositions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFuturesPositionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FuturesPositions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFuturesPositionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FuturesPositionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FuturesPosition exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FuturesPositionExists to return true, but got false.")
+	}
+}
+
+func testFuturesPositionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	futuresPositionFound, err := FindFuturesPosition(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if futuresPositionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFuturesPositionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FuturesPositions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FuturesPositions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFuturesPositionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	futuresPositionOne := &FuturesPosition{}
+	futuresPositionTwo := &FuturesPosition{}
+	if err = randomize.Struct(seed, futuresPositionOne, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionTwo, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFuturesPositionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	futuresPositionOne := &FuturesPosition{}
+	futuresPositionTwo := &FuturesPosition{}
+	if err = randomize.Struct(seed, futuresPositionOne, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, futuresPositionTwo, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = futuresPositionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = futuresPositionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func futuresPositionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func futuresPositionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FuturesPosition) error {
+	*o = FuturesPosition{}
+	return nil
+}
+
+func testFuturesPositionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FuturesPosition{}
+	o := &FuturesPosition{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition object: %s", err)
+	}
+
+	AddFuturesPositionHook(boil.BeforeInsertHook, futuresPositionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionBeforeInsertHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.AfterInsertHook, futuresPositionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionAfterInsertHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.AfterSelectHook, futuresPositionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionAfterSelectHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.BeforeUpdateHook, futuresPositionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionBeforeUpdateHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.AfterUpdateHook, futuresPositionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionAfterUpdateHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.BeforeDeleteHook, futuresPositionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionBeforeDeleteHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.AfterDeleteHook, futuresPositionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionAfterDeleteHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.BeforeUpsertHook, futuresPositionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionBeforeUpsertHooks = []FuturesPositionHook{}
+
+	AddFuturesPositionHook(boil.AfterUpsertHook, futuresPositionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	futuresPositionAfterUpsertHooks = []FuturesPositionHook{}
+}
+
+func testFuturesPositionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(futuresPositionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFuturesPositionToManyPositionFuturesPositionFundings(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c FuturesPositionFunding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionFundingDBTypes, false, futuresPositionFundingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PositionID = a.ID
+	c.PositionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PositionFuturesPositionFundings().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PositionID == b.PositionID {
+			bFound = true
+		}
+		if v.PositionID == c.PositionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FuturesPositionSlice{&a}
+	if err = a.L.LoadPositionFuturesPositionFundings(ctx, tx, false, (*[]*FuturesPosition)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionFundings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PositionFuturesPositionFundings = nil
+	if err = a.L.LoadPositionFuturesPositionFundings(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionFundings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFuturesPositionToManyPositionFuturesPositionOrders(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c FuturesPositionOrder
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionOrderDBTypes, false, futuresPositionOrderColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PositionID = a.ID
+	c.PositionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PositionFuturesPositionOrders().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PositionID == b.PositionID {
+			bFound = true
+		}
+		if v.PositionID == c.PositionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FuturesPositionSlice{&a}
+	if err = a.L.LoadPositionFuturesPositionOrders(ctx, tx, false, (*[]*FuturesPosition)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionOrders); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PositionFuturesPositionOrders = nil
+	if err = a.L.LoadPositionFuturesPositionOrders(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionOrders); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFuturesPositionToManyPositionFuturesPositionPNLEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c FuturesPositionPNLEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, futuresPositionPNLEntryDBTypes, false, futuresPositionPNLEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PositionID = a.ID
+	c.PositionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PositionFuturesPositionPNLEntries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PositionID == b.PositionID {
+			bFound = true
+		}
+		if v.PositionID == c.PositionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FuturesPositionSlice{&a}
+	if err = a.L.LoadPositionFuturesPositionPNLEntries(ctx, tx, false, (*[]*FuturesPosition)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionPNLEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PositionFuturesPositionPNLEntries = nil
+	if err = a.L.LoadPositionFuturesPositionPNLEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PositionFuturesPositionPNLEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFuturesPositionToManyAddOpPositionFuturesPositionFundings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c, d, e FuturesPositionFunding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FuturesPositionFunding{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, futuresPositionFundingDBTypes, false, strmangle.SetComplement(futuresPositionFundingPrimaryKeyColumns, futuresPositionFundingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FuturesPositionFunding{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPositionFuturesPositionFundings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PositionID {
+			t.Error("foreign key was wrong value", a.ID, first.PositionID)
+		}
+		if a.ID != second.PositionID {
+			t.Error("foreign key was wrong value", a.ID, second.PositionID)
+		}
+
+		if first.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PositionFuturesPositionFundings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PositionFuturesPositionFundings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PositionFuturesPositionFundings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFuturesPositionToManyAddOpPositionFuturesPositionOrders(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c, d, e FuturesPositionOrder
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FuturesPositionOrder{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, futuresPositionOrderDBTypes, false, strmangle.SetComplement(futuresPositionOrderPrimaryKeyColumns, futuresPositionOrderColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FuturesPositionOrder{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPositionFuturesPositionOrders(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PositionID {
+			t.Error("foreign key was wrong value", a.ID, first.PositionID)
+		}
+		if a.ID != second.PositionID {
+			t.Error("foreign key was wrong value", a.ID, second.PositionID)
+		}
+
+		if first.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PositionFuturesPositionOrders[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PositionFuturesPositionOrders[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PositionFuturesPositionOrders().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFuturesPositionToManyAddOpPositionFuturesPositionPNLEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c, d, e FuturesPositionPNLEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FuturesPositionPNLEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, futuresPositionPNLEntryDBTypes, false, strmangle.SetComplement(futuresPositionPNLEntryPrimaryKeyColumns, futuresPositionPNLEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FuturesPositionPNLEntry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPositionFuturesPositionPNLEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PositionID {
+			t.Error("foreign key was wrong value", a.ID, first.PositionID)
+		}
+		if a.ID != second.PositionID {
+			t.Error("foreign key was wrong value", a.ID, second.PositionID)
+		}
+
+		if first.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Position != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PositionFuturesPositionPNLEntries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PositionFuturesPositionPNLEntries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PositionFuturesPositionPNLEntries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFuturesPositionToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FuturesPosition
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, futuresPositionDBTypes, false, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FuturesPositionSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*FuturesPosition)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFuturesPositionToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FuturesPosition
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, futuresPositionDBTypes, false, strmangle.SetComplement(futuresPositionPrimaryKeyColumns, futuresPositionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameFuturesPositions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testFuturesPositionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FuturesPositionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFuturesPositionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FuturesPositions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	futuresPositionDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `Account`: `character varying`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `Underlying`: `character varying`, `CollateralCurrency`: `character varying`, `Status`: `character varying`, `OpeningDirection`: `character varying`, `OpeningPrice`: `double precision`, `OpeningSize`: `double precision`, `OpeningTime`: `timestamp with time zone`, `LatestDirection`: `character varying`, `LatestPrice`: `double precision`, `LatestSize`: `double precision`, `RealisedPNL`: `double precision`, `UnrealisedPNL`: `double precision`, `ClosingPrice`: `double precision`, `ClosingTime`: `timestamp with time zone`, `LastUpdated`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testFuturesPositionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(futuresPositionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(futuresPositionAllColumns) == len(futuresPositionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFuturesPositionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionAllColumns) == len(futuresPositionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FuturesPosition{}
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, futuresPositionDBTypes, true, futuresPositionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(futuresPositionAllColumns, futuresPositionPrimaryKeyColumns) {
+		fields = futuresPositionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			futuresPositionAllColumns,
+			futuresPositionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FuturesPositionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFuturesPositionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(futuresPositionAllColumns) == len(futuresPositionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FuturesPosition{}
+	if err = randomize.Struct(seed, &o, futuresPositionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPosition: %s", err)
+	}
+
+	count, err := FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, futuresPositionDBTypes, false, futuresPositionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FuturesPosition struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FuturesPosition: %s", err)
+	}
+
+	count, err = FuturesPositions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
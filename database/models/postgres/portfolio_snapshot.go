@@ -0,0 +1,1047 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// PortfolioSnapshot is an object representing the database table.
+type PortfolioSnapshot struct {
+	ID                string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReportingCurrency string    `boil:"reporting_currency" json:"reporting_currency" toml:"reporting_currency" yaml:"reporting_currency"`
+	TotalValue        float64   `boil:"total_value" json:"total_value" toml:"total_value" yaml:"total_value"`
+	Timestamp         time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *portfolioSnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L portfolioSnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PortfolioSnapshotColumns = struct {
+	ID                string
+	ReportingCurrency string
+	TotalValue        string
+	Timestamp         string
+}{
+	ID:                "id",
+	ReportingCurrency: "reporting_currency",
+	TotalValue:        "total_value",
+	Timestamp:         "timestamp",
+}
+
+// Generated where
+
+var PortfolioSnapshotWhere = struct {
+	ID                whereHelperstring
+	ReportingCurrency whereHelperstring
+	TotalValue        whereHelperfloat64
+	Timestamp         whereHelpertime_Time
+}{
+	ID:                whereHelperstring{field: "\"portfolio_snapshot\".\"id\""},
+	ReportingCurrency: whereHelperstring{field: "\"portfolio_snapshot\".\"reporting_currency\""},
+	TotalValue:        whereHelperfloat64{field: "\"portfolio_snapshot\".\"total_value\""},
+	Timestamp:         whereHelpertime_Time{field: "\"portfolio_snapshot\".\"timestamp\""},
+}
+
+// PortfolioSnapshotRels is where relationship names are stored.
+var PortfolioSnapshotRels = struct {
+	SnapshotPortfolioSnapshotHoldings string
+}{
+	SnapshotPortfolioSnapshotHoldings: "SnapshotPortfolioSnapshotHoldings",
+}
+
+// portfolioSnapshotR is where relationships are stored.
+type portfolioSnapshotR struct {
+	SnapshotPortfolioSnapshotHoldings PortfolioSnapshotHoldingSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*portfolioSnapshotR) NewStruct() *portfolioSnapshotR {
+	return &portfolioSnapshotR{}
+}
+
+// portfolioSnapshotL is where Load methods for each relationship are stored.
+type portfolioSnapshotL struct{}
+
+var (
+	portfolioSnapshotAllColumns            = []string{"id", "reporting_currency", "total_value", "timestamp"}
+	portfolioSnapshotColumnsWithoutDefault = []string{"reporting_currency", "total_value", "timestamp"}
+	portfolioSnapshotColumnsWithDefault    = []string{"id"}
+	portfolioSnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PortfolioSnapshotSlice is an alias for a slice of pointers to PortfolioSnapshot.
+	// This should generally be used opposed to []PortfolioSnapshot.
+	PortfolioSnapshotSlice []*PortfolioSnapshot
+	// PortfolioSnapshotHook is the signature for custom PortfolioSnapshot hook methods
+	PortfolioSnapshotHook func(context.Context, boil.ContextExecutor, *PortfolioSnapshot) error
+
+	portfolioSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	portfolioSnapshotType                 = reflect.TypeOf(&PortfolioSnapshot{})
+	portfolioSnapshotMapping              = queries.MakeStructMapping(portfolioSnapshotType)
+	portfolioSnapshotPrimaryKeyMapping, _ = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, portfolioSnapshotPrimaryKeyColumns)
+	portfolioSnapshotInsertCacheMut       sync.RWMutex
+	portfolioSnapshotInsertCache          = make(map[string]insertCache)
+	portfolioSnapshotUpdateCacheMut       sync.RWMutex
+	portfolioSnapshotUpdateCache          = make(map[string]updateCache)
+	portfolioSnapshotUpsertCacheMut       sync.RWMutex
+	portfolioSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var portfolioSnapshotBeforeInsertHooks []PortfolioSnapshotHook
+var portfolioSnapshotBeforeUpdateHooks []PortfolioSnapshotHook
+var portfolioSnapshotBeforeDeleteHooks []PortfolioSnapshotHook
+var portfolioSnapshotBeforeUpsertHooks []PortfolioSnapshotHook
+
+var portfolioSnapshotAfterInsertHooks []PortfolioSnapshotHook
+var portfolioSnapshotAfterSelectHooks []PortfolioSnapshotHook
+var portfolioSnapshotAfterUpdateHooks []PortfolioSnapshotHook
+var portfolioSnapshotAfterDeleteHooks []PortfolioSnapshotHook
+var portfolioSnapshotAfterUpsertHooks []PortfolioSnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PortfolioSnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PortfolioSnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PortfolioSnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PortfolioSnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PortfolioSnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PortfolioSnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PortfolioSnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PortfolioSnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PortfolioSnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPortfolioSnapshotHook registers your hook function for all future operations.
+func AddPortfolioSnapshotHook(hookPoint boil.HookPoint, portfolioSnapshotHook PortfolioSnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		portfolioSnapshotBeforeInsertHooks = append(portfolioSnapshotBeforeInsertHooks, portfolioSnapshotHook)
+	case boil.BeforeUpdateHook:
+		portfolioSnapshotBeforeUpdateHooks = append(portfolioSnapshotBeforeUpdateHooks, portfolioSnapshotHook)
+	case boil.BeforeDeleteHook:
+		portfolioSnapshotBeforeDeleteHooks = append(portfolioSnapshotBeforeDeleteHooks, portfolioSnapshotHook)
+	case boil.BeforeUpsertHook:
+		portfolioSnapshotBeforeUpsertHooks = append(portfolioSnapshotBeforeUpsertHooks, portfolioSnapshotHook)
+	case boil.AfterInsertHook:
+		portfolioSnapshotAfterInsertHooks = append(portfolioSnapshotAfterInsertHooks, portfolioSnapshotHook)
+	case boil.AfterSelectHook:
+		portfolioSnapshotAfterSelectHooks = append(portfolioSnapshotAfterSelectHooks, portfolioSnapshotHook)
+	case boil.AfterUpdateHook:
+		portfolioSnapshotAfterUpdateHooks = append(portfolioSnapshotAfterUpdateHooks, portfolioSnapshotHook)
+	case boil.AfterDeleteHook:
+		portfolioSnapshotAfterDeleteHooks = append(portfolioSnapshotAfterDeleteHooks, portfolioSnapshotHook)
+	case boil.AfterUpsertHook:
+		portfolioSnapshotAfterUpsertHooks = append(portfolioSnapshotAfterUpsertHooks, portfolioSnapshotHook)
+	}
+}
+
+// One returns a single portfolioSnapshot record from the query.
+func (q portfolioSnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PortfolioSnapshot, error) {
+	o := &PortfolioSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for portfolio_snapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PortfolioSnapshot records from the query.
+func (q portfolioSnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (PortfolioSnapshotSlice, error) {
+	var o []*PortfolioSnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to PortfolioSnapshot slice")
+	}
+
+	if len(portfolioSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PortfolioSnapshot records in the query.
+func (q portfolioSnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count portfolio_snapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q portfolioSnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if portfolio_snapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// SnapshotPortfolioSnapshotHoldings retrieves all the portfolio_snapshot_holding's PortfolioSnapshotHoldings with an executor via snapshot_id column.
+func (o *PortfolioSnapshot) SnapshotPortfolioSnapshotHoldings(mods ...qm.QueryMod) portfolioSnapshotHoldingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"portfolio_snapshot_holding\".\"snapshot_id\"=?", o.ID),
+	)
+
+	query := PortfolioSnapshotHoldings(queryMods...)
+	queries.SetFrom(query.Query, "\"portfolio_snapshot_holding\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"portfolio_snapshot_holding\".*"})
+	}
+
+	return query
+}
+
+// LoadSnapshotPortfolioSnapshotHoldings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (portfolioSnapshotL) LoadSnapshotPortfolioSnapshotHoldings(ctx context.Context, e boil.ContextExecutor, singular bool, maybePortfolioSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*PortfolioSnapshot
+	var object *PortfolioSnapshot
+
+	if singular {
+		object = maybePortfolioSnapshot.(*PortfolioSnapshot)
+	} else {
+		slice = *maybePortfolioSnapshot.(*[]*PortfolioSnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &portfolioSnapshotR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &portfolioSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`portfolio_snapshot_holding`), qm.WhereIn(`portfolio_snapshot_holding.snapshot_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load portfolio_snapshot_holding")
+	}
+
+	var resultSlice []*PortfolioSnapshotHolding
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice portfolio_snapshot_holding")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on portfolio_snapshot_holding")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for portfolio_snapshot_holding")
+	}
+
+	if len(portfolioSnapshotHoldingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SnapshotPortfolioSnapshotHoldings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &portfolioSnapshotHoldingR{}
+			}
+			foreign.R.Snapshot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SnapshotID {
+				local.R.SnapshotPortfolioSnapshotHoldings = append(local.R.SnapshotPortfolioSnapshotHoldings, foreign)
+				if foreign.R == nil {
+					foreign.R = &portfolioSnapshotHoldingR{}
+				}
+				foreign.R.Snapshot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddSnapshotPortfolioSnapshotHoldings adds the given related objects to the existing relationships
+// of the portfolio_snapshot, optionally inserting them as new records.
+// Appends related to o.R.SnapshotPortfolioSnapshotHoldings.
+// Sets related.R.Snapshot appropriately.
+func (o *PortfolioSnapshot) AddSnapshotPortfolioSnapshotHoldings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PortfolioSnapshotHolding) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SnapshotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"portfolio_snapshot_holding\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"snapshot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, portfolioSnapshotHoldingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SnapshotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &portfolioSnapshotR{
+			SnapshotPortfolioSnapshotHoldings: related,
+		}
+	} else {
+		o.R.SnapshotPortfolioSnapshotHoldings = append(o.R.SnapshotPortfolioSnapshotHoldings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &portfolioSnapshotHoldingR{
+				Snapshot: o,
+			}
+		} else {
+			rel.R.Snapshot = o
+		}
+	}
+	return nil
+}
+
+// PortfolioSnapshots retrieves all the records using an executor.
+func PortfolioSnapshots(mods ...qm.QueryMod) portfolioSnapshotQuery {
+	mods = append(mods, qm.From("\"portfolio_snapshot\""))
+	return portfolioSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindPortfolioSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPortfolioSnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PortfolioSnapshot, error) {
+	portfolioSnapshotObj := &PortfolioSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"portfolio_snapshot\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, portfolioSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from portfolio_snapshot")
+	}
+
+	return portfolioSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PortfolioSnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no portfolio_snapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfolioSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	portfolioSnapshotInsertCacheMut.RLock()
+	cache, cached := portfolioSnapshotInsertCache[key]
+	portfolioSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			portfolioSnapshotAllColumns,
+			portfolioSnapshotColumnsWithDefault,
+			portfolioSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"portfolio_snapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"portfolio_snapshot\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into portfolio_snapshot")
+	}
+
+	if !cached {
+		portfolioSnapshotInsertCacheMut.Lock()
+		portfolioSnapshotInsertCache[key] = cache
+		portfolioSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PortfolioSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PortfolioSnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	portfolioSnapshotUpdateCacheMut.RLock()
+	cache, cached := portfolioSnapshotUpdateCache[key]
+	portfolioSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			portfolioSnapshotAllColumns,
+			portfolioSnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update portfolio_snapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"portfolio_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, portfolioSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, append(wl, portfolioSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update portfolio_snapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for portfolio_snapshot")
+	}
+
+	if !cached {
+		portfolioSnapshotUpdateCacheMut.Lock()
+		portfolioSnapshotUpdateCache[key] = cache
+		portfolioSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q portfolioSnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for portfolio_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for portfolio_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PortfolioSnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"portfolio_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, portfolioSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in portfolioSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all portfolioSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PortfolioSnapshot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no portfolio_snapshot provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfolioSnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	portfolioSnapshotUpsertCacheMut.RLock()
+	cache, cached := portfolioSnapshotUpsertCache[key]
+	portfolioSnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			portfolioSnapshotAllColumns,
+			portfolioSnapshotColumnsWithDefault,
+			portfolioSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			portfolioSnapshotAllColumns,
+			portfolioSnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert portfolio_snapshot, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(portfolioSnapshotPrimaryKeyColumns))
+			copy(conflict, portfolioSnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"portfolio_snapshot\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(portfolioSnapshotType, portfolioSnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert portfolio_snapshot")
+	}
+
+	if !cached {
+		portfolioSnapshotUpsertCacheMut.Lock()
+		portfolioSnapshotUpsertCache[key] = cache
+		portfolioSnapshotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PortfolioSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PortfolioSnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no PortfolioSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), portfolioSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"portfolio_snapshot\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from portfolio_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for portfolio_snapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q portfolioSnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no portfolioSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from portfolio_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for portfolio_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PortfolioSnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(portfolioSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"portfolio_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, portfolioSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from portfolioSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for portfolio_snapshot")
+	}
+
+	if len(portfolioSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PortfolioSnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPortfolioSnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PortfolioSnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PortfolioSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"portfolio_snapshot\".* FROM \"portfolio_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, portfolioSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in PortfolioSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PortfolioSnapshotExists checks if the PortfolioSnapshot row exists.
+func PortfolioSnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"portfolio_snapshot\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if portfolio_snapshot exists")
+	}
+
+	return exists, nil
+}
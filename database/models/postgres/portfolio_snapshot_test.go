@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPortfolioSnapshots(t *testing.T) {
+	t.Parallel()
+
+	query := PortfolioSnapshots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPortfolioSnapshotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PortfolioSnapshots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfolioSnapshotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PortfolioSnapshotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PortfolioSnapshot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PortfolioSnapshotExists to return true, but got false.")
+	}
+}
+
+func testPortfolioSnapshotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	portfolioSnapshotFound, err := FindPortfolioSnapshot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if portfolioSnapshotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPortfolioSnapshotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PortfolioSnapshots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PortfolioSnapshots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPortfolioSnapshotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	portfolioSnapshotOne := &PortfolioSnapshot{}
+	portfolioSnapshotTwo := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, portfolioSnapshotOne, portfolioSnapshotDBTypes, false, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfolioSnapshotTwo, portfolioSnapshotDBTypes, false, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfolioSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfolioSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PortfolioSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPortfolioSnapshotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	portfolioSnapshotOne := &PortfolioSnapshot{}
+	portfolioSnapshotTwo := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, portfolioSnapshotOne, portfolioSnapshotDBTypes, false, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfolioSnapshotTwo, portfolioSnapshotDBTypes, false, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfolioSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfolioSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func portfolioSnapshotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func portfolioSnapshotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshot) error {
+	*o = PortfolioSnapshot{}
+	return nil
+}
+
+func testPortfolioSnapshotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PortfolioSnapshot{}
+	o := &PortfolioSnapshot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot object: %s", err)
+	}
+
+	AddPortfolioSnapshotHook(boil.BeforeInsertHook, portfolioSnapshotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotBeforeInsertHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.AfterInsertHook, portfolioSnapshotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotAfterInsertHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.AfterSelectHook, portfolioSnapshotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotAfterSelectHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.BeforeUpdateHook, portfolioSnapshotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotBeforeUpdateHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.AfterUpdateHook, portfolioSnapshotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotAfterUpdateHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.BeforeDeleteHook, portfolioSnapshotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotBeforeDeleteHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.AfterDeleteHook, portfolioSnapshotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotAfterDeleteHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.BeforeUpsertHook, portfolioSnapshotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotBeforeUpsertHooks = []PortfolioSnapshotHook{}
+
+	AddPortfolioSnapshotHook(boil.AfterUpsertHook, portfolioSnapshotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotAfterUpsertHooks = []PortfolioSnapshotHook{}
+}
+
+func testPortfolioSnapshotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfolioSnapshotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(portfolioSnapshotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfolioSnapshotToManySnapshotPortfolioSnapshotHoldings(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PortfolioSnapshot
+	var b, c PortfolioSnapshotHolding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SnapshotID = a.ID
+	c.SnapshotID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SnapshotPortfolioSnapshotHoldings().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SnapshotID == b.SnapshotID {
+			bFound = true
+		}
+		if v.SnapshotID == c.SnapshotID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PortfolioSnapshotSlice{&a}
+	if err = a.L.LoadSnapshotPortfolioSnapshotHoldings(ctx, tx, false, (*[]*PortfolioSnapshot)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SnapshotPortfolioSnapshotHoldings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SnapshotPortfolioSnapshotHoldings = nil
+	if err = a.L.LoadSnapshotPortfolioSnapshotHoldings(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SnapshotPortfolioSnapshotHoldings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPortfolioSnapshotToManyAddOpSnapshotPortfolioSnapshotHoldings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PortfolioSnapshot
+	var b, c, d, e PortfolioSnapshotHolding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfolioSnapshotDBTypes, false, strmangle.SetComplement(portfolioSnapshotPrimaryKeyColumns, portfolioSnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PortfolioSnapshotHolding{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, portfolioSnapshotHoldingDBTypes, false, strmangle.SetComplement(portfolioSnapshotHoldingPrimaryKeyColumns, portfolioSnapshotHoldingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PortfolioSnapshotHolding{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSnapshotPortfolioSnapshotHoldings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SnapshotID {
+			t.Error("foreign key was wrong value", a.ID, first.SnapshotID)
+		}
+		if a.ID != second.SnapshotID {
+			t.Error("foreign key was wrong value", a.ID, second.SnapshotID)
+		}
+
+		if first.R.Snapshot != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Snapshot != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SnapshotPortfolioSnapshotHoldings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SnapshotPortfolioSnapshotHoldings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SnapshotPortfolioSnapshotHoldings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPortfolioSnapshotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfolioSnapshotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PortfolioSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	portfolioSnapshotDBTypes = map[string]string{`ID`: `uuid`, `ReportingCurrency`: `character varying`, `TotalValue`: `double precision`, `Timestamp`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testPortfolioSnapshotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(portfolioSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(portfolioSnapshotAllColumns) == len(portfolioSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPortfolioSnapshotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(portfolioSnapshotAllColumns) == len(portfolioSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshot{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfolioSnapshotDBTypes, true, portfolioSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(portfolioSnapshotAllColumns, portfolioSnapshotPrimaryKeyColumns) {
+		fields = portfolioSnapshotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			portfolioSnapshotAllColumns,
+			portfolioSnapshotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PortfolioSnapshotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPortfolioSnapshotsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(portfolioSnapshotAllColumns) == len(portfolioSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PortfolioSnapshot{}
+	if err = randomize.Struct(seed, &o, portfolioSnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PortfolioSnapshot: %s", err)
+	}
+
+	count, err := PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, portfolioSnapshotDBTypes, false, portfolioSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PortfolioSnapshot: %s", err)
+	}
+
+	count, err = PortfolioSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTradeLedgerFills(t *testing.T) {
+	t.Parallel()
+
+	query := TradeLedgerFills()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTradeLedgerFillsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTradeLedgerFillsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TradeLedgerFills().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTradeLedgerFillsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TradeLedgerFillSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTradeLedgerFillsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TradeLedgerFillExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TradeLedgerFill exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TradeLedgerFillExists to return true, but got false.")
+	}
+}
+
+func testTradeLedgerFillsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	tradeLedgerFillFound, err := FindTradeLedgerFill(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if tradeLedgerFillFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTradeLedgerFillsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TradeLedgerFills().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTradeLedgerFillsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TradeLedgerFills().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTradeLedgerFillsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	tradeLedgerFillOne := &TradeLedgerFill{}
+	tradeLedgerFillTwo := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, tradeLedgerFillOne, tradeLedgerFillDBTypes, false, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tradeLedgerFillTwo, tradeLedgerFillDBTypes, false, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tradeLedgerFillOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tradeLedgerFillTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TradeLedgerFills().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTradeLedgerFillsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	tradeLedgerFillOne := &TradeLedgerFill{}
+	tradeLedgerFillTwo := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, tradeLedgerFillOne, tradeLedgerFillDBTypes, false, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tradeLedgerFillTwo, tradeLedgerFillDBTypes, false, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tradeLedgerFillOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tradeLedgerFillTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func tradeLedgerFillBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func tradeLedgerFillAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TradeLedgerFill) error {
+	*o = TradeLedgerFill{}
+	return nil
+}
+
+func testTradeLedgerFillsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TradeLedgerFill{}
+	o := &TradeLedgerFill{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill object: %s", err)
+	}
+
+	AddTradeLedgerFillHook(boil.BeforeInsertHook, tradeLedgerFillBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillBeforeInsertHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.AfterInsertHook, tradeLedgerFillAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillAfterInsertHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.AfterSelectHook, tradeLedgerFillAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillAfterSelectHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.BeforeUpdateHook, tradeLedgerFillBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillBeforeUpdateHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.AfterUpdateHook, tradeLedgerFillAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillAfterUpdateHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.BeforeDeleteHook, tradeLedgerFillBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillBeforeDeleteHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.AfterDeleteHook, tradeLedgerFillAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillAfterDeleteHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.BeforeUpsertHook, tradeLedgerFillBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillBeforeUpsertHooks = []TradeLedgerFillHook{}
+
+	AddTradeLedgerFillHook(boil.AfterUpsertHook, tradeLedgerFillAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	tradeLedgerFillAfterUpsertHooks = []TradeLedgerFillHook{}
+}
+
+func testTradeLedgerFillsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTradeLedgerFillsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(tradeLedgerFillColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTradeLedgerFillsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTradeLedgerFillsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TradeLedgerFillSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTradeLedgerFillsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TradeLedgerFills().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	tradeLedgerFillDBTypes = map[string]string{`ID`: `uuid`, `Exchange`: `character varying`, `Account`: `character varying`, `OrderID`: `character varying`, `TradeID`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `Side`: `character varying`, `Price`: `double precision`, `Amount`: `double precision`, `Fee`: `double precision`, `FeeCurrency`: `character varying`, `Timestamp`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testTradeLedgerFillsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(tradeLedgerFillPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(tradeLedgerFillAllColumns) == len(tradeLedgerFillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTradeLedgerFillsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(tradeLedgerFillAllColumns) == len(tradeLedgerFillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TradeLedgerFill{}
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tradeLedgerFillDBTypes, true, tradeLedgerFillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(tradeLedgerFillAllColumns, tradeLedgerFillPrimaryKeyColumns) {
+		fields = tradeLedgerFillAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			tradeLedgerFillAllColumns,
+			tradeLedgerFillPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TradeLedgerFillSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTradeLedgerFillsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(tradeLedgerFillAllColumns) == len(tradeLedgerFillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TradeLedgerFill{}
+	if err = randomize.Struct(seed, &o, tradeLedgerFillDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TradeLedgerFill: %s", err)
+	}
+
+	count, err := TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, tradeLedgerFillDBTypes, false, tradeLedgerFillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TradeLedgerFill struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TradeLedgerFill: %s", err)
+	}
+
+	count, err = TradeLedgerFills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -34,6 +34,7 @@ type WithdrawalHistory struct {
 	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	ExchangeNameID string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	TxID           null.String `boil:"tx_id" json:"tx_id,omitempty" toml:"tx_id" yaml:"tx_id,omitempty"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	ExchangeNameID string
+	TxID           string
 }{
 	ID:             "id",
 	ExchangeID:     "exchange_id",
@@ -61,6 +63,7 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	ExchangeNameID: "exchange_name_id",
+	TxID:           "tx_id",
 }
 
 // Generated where
@@ -92,6 +95,7 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	ExchangeNameID whereHelperstring
+	TxID           whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeID:     whereHelperstring{field: "\"withdrawal_history\".\"exchange_id\""},
@@ -103,6 +107,7 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"updated_at\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
+	TxID:           whereHelpernull_String{field: "\"withdrawal_history\".\"tx_id\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -132,8 +137,8 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id"}
-	withdrawalHistoryColumnsWithoutDefault = []string{"exchange_id", "status", "currency", "amount", "description", "withdraw_type", "exchange_name_id"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id", "tx_id"}
+	withdrawalHistoryColumnsWithoutDefault = []string{"exchange_id", "status", "currency", "amount", "description", "withdraw_type", "exchange_name_id", "tx_id"}
 	withdrawalHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
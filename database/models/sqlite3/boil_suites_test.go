@@ -17,8 +17,16 @@ func TestParent(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("CarrySnapshots", testCarrySnapshots)
+	t.Run("FuturesPositions", testFuturesPositions)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundings)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrders)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntries)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshots)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldings)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("TradeLedgerFills", testTradeLedgerFills)
 	t.Run("Trades", testTrades)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
@@ -31,8 +39,16 @@ func TestDelete(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("CarrySnapshots", testCarrySnapshotsDelete)
+	t.Run("FuturesPositions", testFuturesPositionsDelete)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsDelete)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersDelete)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesDelete)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsDelete)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsDelete)
 	t.Run("Trades", testTradesDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
@@ -45,8 +61,16 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("CarrySnapshots", testCarrySnapshotsQueryDeleteAll)
+	t.Run("FuturesPositions", testFuturesPositionsQueryDeleteAll)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsQueryDeleteAll)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersQueryDeleteAll)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesQueryDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsQueryDeleteAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
@@ -59,8 +83,16 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("CarrySnapshots", testCarrySnapshotsSliceDeleteAll)
+	t.Run("FuturesPositions", testFuturesPositionsSliceDeleteAll)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsSliceDeleteAll)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersSliceDeleteAll)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesSliceDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceDeleteAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
@@ -73,8 +105,16 @@ func TestExists(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("CarrySnapshots", testCarrySnapshotsExists)
+	t.Run("FuturesPositions", testFuturesPositionsExists)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsExists)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersExists)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesExists)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsExists)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsExists)
 	t.Run("Trades", testTradesExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
@@ -87,8 +127,16 @@ func TestFind(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("CarrySnapshots", testCarrySnapshotsFind)
+	t.Run("FuturesPositions", testFuturesPositionsFind)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsFind)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersFind)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesFind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsFind)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsFind)
 	t.Run("Trades", testTradesFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
@@ -101,8 +149,16 @@ func TestBind(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("CarrySnapshots", testCarrySnapshotsBind)
+	t.Run("FuturesPositions", testFuturesPositionsBind)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsBind)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersBind)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesBind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsBind)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsBind)
 	t.Run("Trades", testTradesBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
@@ -115,8 +171,16 @@ func TestOne(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("CarrySnapshots", testCarrySnapshotsOne)
+	t.Run("FuturesPositions", testFuturesPositionsOne)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsOne)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersOne)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesOne)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsOne)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsOne)
 	t.Run("Trades", testTradesOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
@@ -129,8 +193,16 @@ func TestAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("CarrySnapshots", testCarrySnapshotsAll)
+	t.Run("FuturesPositions", testFuturesPositionsAll)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsAll)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersAll)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsAll)
 	t.Run("Trades", testTradesAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
@@ -143,8 +215,16 @@ func TestCount(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("CarrySnapshots", testCarrySnapshotsCount)
+	t.Run("FuturesPositions", testFuturesPositionsCount)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsCount)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersCount)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesCount)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsCount)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsCount)
 	t.Run("Trades", testTradesCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
@@ -157,8 +237,16 @@ func TestHooks(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("CarrySnapshots", testCarrySnapshotsHooks)
+	t.Run("FuturesPositions", testFuturesPositionsHooks)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsHooks)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersHooks)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesHooks)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsHooks)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsHooks)
 	t.Run("Trades", testTradesHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
@@ -176,10 +264,26 @@ func TestInsert(t *testing.T) {
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("CarrySnapshots", testCarrySnapshotsInsert)
+	t.Run("FuturesPositions", testFuturesPositionsInsert)
+	t.Run("CarrySnapshots", testCarrySnapshotsInsertWhitelist)
+	t.Run("FuturesPositions", testFuturesPositionsInsertWhitelist)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsInsert)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsInsertWhitelist)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersInsert)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersInsertWhitelist)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesInsert)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesInsertWhitelist)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsert)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsertWhitelist)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsInsert)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsInsert)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
@@ -199,6 +303,12 @@ func TestToOne(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("CarrySnapshotToExchangeUsingExchangeName", testCarrySnapshotToOneExchangeUsingExchangeName)
+	t.Run("FuturesPositionToExchangeUsingExchangeName", testFuturesPositionToOneExchangeUsingExchangeName)
+	t.Run("FuturesPositionFundingToFuturesPositionUsingPosition", testFuturesPositionFundingToOneFuturesPositionUsingPosition)
+	t.Run("FuturesPositionOrderToFuturesPositionUsingPosition", testFuturesPositionOrderToOneFuturesPositionUsingPosition)
+	t.Run("FuturesPositionPNLEntryToFuturesPositionUsingPosition", testFuturesPositionPNLEntryToOneFuturesPositionUsingPosition)
+	t.Run("PortfolioSnapshotHoldingToPortfolioSnapshotUsingSnapshot", testPortfolioSnapshotHoldingToOnePortfolioSnapshotUsingSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -223,7 +333,13 @@ func TestToMany(t *testing.T) {
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameCarrySnapshots", testExchangeToManyExchangeNameCarrySnapshots)
+	t.Run("ExchangeToExchangeNameFuturesPositions", testExchangeToManyExchangeNameFuturesPositions)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("FuturesPositionToPositionFuturesPositionFundings", testFuturesPositionToManyPositionFuturesPositionFundings)
+	t.Run("FuturesPositionToPositionFuturesPositionOrders", testFuturesPositionToManyPositionFuturesPositionOrders)
+	t.Run("FuturesPositionToPositionFuturesPositionPNLEntries", testFuturesPositionToManyPositionFuturesPositionPNLEntries)
+	t.Run("PortfolioSnapshotToSnapshotPortfolioSnapshotHoldings", testPortfolioSnapshotToManySnapshotPortfolioSnapshotHoldings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
@@ -238,6 +354,12 @@ func TestToOneSet(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("CarrySnapshotToExchangeUsingExchangeNameCarrySnapshots", testCarrySnapshotToOneSetOpExchangeUsingExchangeName)
+	t.Run("FuturesPositionToExchangeUsingExchangeNameFuturesPositions", testFuturesPositionToOneSetOpExchangeUsingExchangeName)
+	t.Run("FuturesPositionFundingToFuturesPositionUsingPositionFuturesPositionFundings", testFuturesPositionFundingToOneSetOpFuturesPositionUsingPosition)
+	t.Run("FuturesPositionOrderToFuturesPositionUsingPositionFuturesPositionOrders", testFuturesPositionOrderToOneSetOpFuturesPositionUsingPosition)
+	t.Run("FuturesPositionPNLEntryToFuturesPositionUsingPositionFuturesPositionPNLEntries", testFuturesPositionPNLEntryToOneSetOpFuturesPositionUsingPosition)
+	t.Run("PortfolioSnapshotHoldingToPortfolioSnapshotUsingSnapshotPortfolioSnapshotHoldings", testPortfolioSnapshotHoldingToOneSetOpPortfolioSnapshotUsingSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -274,7 +396,13 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameCarrySnapshots", testExchangeToManyAddOpExchangeNameCarrySnapshots)
+	t.Run("ExchangeToExchangeNameFuturesPositions", testExchangeToManyAddOpExchangeNameFuturesPositions)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("FuturesPositionToPositionFuturesPositionFundings", testFuturesPositionToManyAddOpPositionFuturesPositionFundings)
+	t.Run("FuturesPositionToPositionFuturesPositionOrders", testFuturesPositionToManyAddOpPositionFuturesPositionOrders)
+	t.Run("FuturesPositionToPositionFuturesPositionPNLEntries", testFuturesPositionToManyAddOpPositionFuturesPositionPNLEntries)
+	t.Run("PortfolioSnapshotToSnapshotPortfolioSnapshotHoldings", testPortfolioSnapshotToManyAddOpSnapshotPortfolioSnapshotHoldings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
@@ -306,8 +434,16 @@ func TestReload(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("CarrySnapshots", testCarrySnapshotsReload)
+	t.Run("FuturesPositions", testFuturesPositionsReload)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsReload)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersReload)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesReload)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReload)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsReload)
 	t.Run("Trades", testTradesReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
@@ -320,8 +456,16 @@ func TestReloadAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("CarrySnapshots", testCarrySnapshotsReloadAll)
+	t.Run("FuturesPositions", testFuturesPositionsReloadAll)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsReloadAll)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersReloadAll)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesReloadAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReloadAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
@@ -334,8 +478,16 @@ func TestSelect(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("CarrySnapshots", testCarrySnapshotsSelect)
+	t.Run("FuturesPositions", testFuturesPositionsSelect)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsSelect)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersSelect)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesSelect)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSelect)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsSelect)
 	t.Run("Trades", testTradesSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
@@ -348,8 +500,16 @@ func TestUpdate(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("CarrySnapshots", testCarrySnapshotsUpdate)
+	t.Run("FuturesPositions", testFuturesPositionsUpdate)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsUpdate)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersUpdate)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesUpdate)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsUpdate)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsUpdate)
 	t.Run("Trades", testTradesUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
@@ -362,8 +522,16 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("CarrySnapshots", testCarrySnapshotsSliceUpdateAll)
+	t.Run("FuturesPositions", testFuturesPositionsSliceUpdateAll)
+	t.Run("FuturesPositionFundings", testFuturesPositionFundingsSliceUpdateAll)
+	t.Run("FuturesPositionOrders", testFuturesPositionOrdersSliceUpdateAll)
+	t.Run("FuturesPositionPNLEntries", testFuturesPositionPNLEntriesSliceUpdateAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceUpdateAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("TradeLedgerFills", testTradeLedgerFillsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
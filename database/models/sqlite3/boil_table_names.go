@@ -4,29 +4,45 @@
 package sqlite3
 
 var TableNames = struct {
-	AuditEvent              string
-	Candle                  string
-	Datahistoryjob          string
-	Datahistoryjobrelations string
-	Datahistoryjobresult    string
-	Exchange                string
-	Script                  string
-	ScriptExecution         string
-	Trade                   string
-	WithdrawalCrypto        string
-	WithdrawalFiat          string
-	WithdrawalHistory       string
+	AuditEvent               string
+	Candle                   string
+	CarrySnapshot            string
+	Datahistoryjob           string
+	Datahistoryjobrelations  string
+	Datahistoryjobresult     string
+	Exchange                 string
+	FuturesPosition          string
+	FuturesPositionFunding   string
+	FuturesPositionOrder     string
+	FuturesPositionPNLEntry  string
+	PortfolioSnapshot        string
+	PortfolioSnapshotHolding string
+	TradeLedgerFill          string
+	Script                   string
+	ScriptExecution          string
+	Trade                    string
+	WithdrawalCrypto         string
+	WithdrawalFiat           string
+	WithdrawalHistory        string
 }{
-	AuditEvent:              "audit_event",
-	Candle:                  "candle",
-	Datahistoryjob:          "datahistoryjob",
-	Datahistoryjobrelations: "datahistoryjobrelations",
-	Datahistoryjobresult:    "datahistoryjobresult",
-	Exchange:                "exchange",
-	Script:                  "script",
-	ScriptExecution:         "script_execution",
-	Trade:                   "trade",
-	WithdrawalCrypto:        "withdrawal_crypto",
-	WithdrawalFiat:          "withdrawal_fiat",
-	WithdrawalHistory:       "withdrawal_history",
+	AuditEvent:               "audit_event",
+	Candle:                   "candle",
+	CarrySnapshot:            "carry_snapshot",
+	Datahistoryjob:           "datahistoryjob",
+	Datahistoryjobrelations:  "datahistoryjobrelations",
+	Datahistoryjobresult:     "datahistoryjobresult",
+	Exchange:                 "exchange",
+	FuturesPosition:          "futures_position",
+	FuturesPositionFunding:   "futures_position_funding",
+	FuturesPositionOrder:     "futures_position_order",
+	FuturesPositionPNLEntry:  "futures_position_pnl_entry",
+	PortfolioSnapshot:        "portfolio_snapshot",
+	PortfolioSnapshotHolding: "portfolio_snapshot_holding",
+	TradeLedgerFill:          "trade_ledger_fill",
+	Script:                   "script",
+	ScriptExecution:          "script_execution",
+	Trade:                    "trade",
+	WithdrawalCrypto:         "withdrawal_crypto",
+	WithdrawalFiat:           "withdrawal_fiat",
+	WithdrawalHistory:        "withdrawal_history",
 }
@@ -0,0 +1,793 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCarrySnapshots(t *testing.T) {
+	t.Parallel()
+
+	query := CarrySnapshots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCarrySnapshotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCarrySnapshotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CarrySnapshots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCarrySnapshotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CarrySnapshotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCarrySnapshotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CarrySnapshotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CarrySnapshot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CarrySnapshotExists to return true, but got false.")
+	}
+}
+
+func testCarrySnapshotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	carrySnapshotFound, err := FindCarrySnapshot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if carrySnapshotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCarrySnapshotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CarrySnapshots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCarrySnapshotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CarrySnapshots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCarrySnapshotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	carrySnapshotOne := &CarrySnapshot{}
+	carrySnapshotTwo := &CarrySnapshot{}
+	if err = randomize.Struct(seed, carrySnapshotOne, carrySnapshotDBTypes, false, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, carrySnapshotTwo, carrySnapshotDBTypes, false, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = carrySnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = carrySnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CarrySnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCarrySnapshotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	carrySnapshotOne := &CarrySnapshot{}
+	carrySnapshotTwo := &CarrySnapshot{}
+	if err = randomize.Struct(seed, carrySnapshotOne, carrySnapshotDBTypes, false, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, carrySnapshotTwo, carrySnapshotDBTypes, false, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = carrySnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = carrySnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func carrySnapshotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func carrySnapshotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CarrySnapshot) error {
+	*o = CarrySnapshot{}
+	return nil
+}
+
+func testCarrySnapshotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CarrySnapshot{}
+	o := &CarrySnapshot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot object: %s", err)
+	}
+
+	AddCarrySnapshotHook(boil.BeforeInsertHook, carrySnapshotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotBeforeInsertHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.AfterInsertHook, carrySnapshotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotAfterInsertHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.AfterSelectHook, carrySnapshotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotAfterSelectHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.BeforeUpdateHook, carrySnapshotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotBeforeUpdateHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.AfterUpdateHook, carrySnapshotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotAfterUpdateHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.BeforeDeleteHook, carrySnapshotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotBeforeDeleteHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.AfterDeleteHook, carrySnapshotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotAfterDeleteHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.BeforeUpsertHook, carrySnapshotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotBeforeUpsertHooks = []CarrySnapshotHook{}
+
+	AddCarrySnapshotHook(boil.AfterUpsertHook, carrySnapshotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	carrySnapshotAfterUpsertHooks = []CarrySnapshotHook{}
+}
+
+func testCarrySnapshotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCarrySnapshotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(carrySnapshotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCarrySnapshotToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CarrySnapshot
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, carrySnapshotDBTypes, false, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CarrySnapshotSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*CarrySnapshot)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCarrySnapshotToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CarrySnapshot
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, carrySnapshotDBTypes, false, strmangle.SetComplement(carrySnapshotPrimaryKeyColumns, carrySnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameCarrySnapshots[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testCarrySnapshotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCarrySnapshotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CarrySnapshotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCarrySnapshotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CarrySnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	carrySnapshotDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `Asset`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `UnderlyingBase`: `TEXT`, `UnderlyingQuote`: `TEXT`, `ContractType`: `TEXT`, `Expiry`: `TIMESTAMP`, `SpotPrice`: `REAL`, `ContractPrice`: `REAL`, `Basis`: `REAL`, `AnnualisedBasis`: `REAL`, `FundingRate`: `REAL`, `AnnualisedFundingRate`: `REAL`, `Timestamp`: `TIMESTAMP`}
+	_                    = bytes.MinRead
+)
+
+func testCarrySnapshotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(carrySnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(carrySnapshotAllColumns) == len(carrySnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCarrySnapshotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(carrySnapshotAllColumns) == len(carrySnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CarrySnapshot{}
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CarrySnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, carrySnapshotDBTypes, true, carrySnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CarrySnapshot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(carrySnapshotAllColumns, carrySnapshotPrimaryKeyColumns) {
+		fields = carrySnapshotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			carrySnapshotAllColumns,
+			carrySnapshotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CarrySnapshotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
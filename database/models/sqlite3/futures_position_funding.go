@@ -0,0 +1,946 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FuturesPositionFunding is an object representing the database table.
+type FuturesPositionFunding struct {
+	ID         string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PositionID string  `boil:"position_id" json:"position_id" toml:"position_id" yaml:"position_id"`
+	Timestamp  string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Rate       float64 `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	Payment    float64 `boil:"payment" json:"payment" toml:"payment" yaml:"payment"`
+
+	R *futuresPositionFundingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L futuresPositionFundingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FuturesPositionFundingColumns = struct {
+	ID         string
+	PositionID string
+	Timestamp  string
+	Rate       string
+	Payment    string
+}{
+	ID:         "id",
+	PositionID: "position_id",
+	Timestamp:  "timestamp",
+	Rate:       "rate",
+	Payment:    "payment",
+}
+
+// Generated where
+
+var FuturesPositionFundingWhere = struct {
+	ID         whereHelperstring
+	PositionID whereHelperstring
+	Timestamp  whereHelperstring
+	Rate       whereHelperfloat64
+	Payment    whereHelperfloat64
+}{
+	ID:         whereHelperstring{field: "\"futures_position_funding\".\"id\""},
+	PositionID: whereHelperstring{field: "\"futures_position_funding\".\"position_id\""},
+	Timestamp:  whereHelperstring{field: "\"futures_position_funding\".\"timestamp\""},
+	Rate:       whereHelperfloat64{field: "\"futures_position_funding\".\"rate\""},
+	Payment:    whereHelperfloat64{field: "\"futures_position_funding\".\"payment\""},
+}
+
+// FuturesPositionFundingRels is where relationship names are stored.
+var FuturesPositionFundingRels = struct {
+	Position string
+}{
+	Position: "Position",
+}
+
+// futuresPositionFundingR is where relationships are stored.
+type futuresPositionFundingR struct {
+	Position *FuturesPosition
+}
+
+// NewStruct creates a new relationship struct
+func (*futuresPositionFundingR) NewStruct() *futuresPositionFundingR {
+	return &futuresPositionFundingR{}
+}
+
+// futuresPositionFundingL is where Load methods for each relationship are stored.
+type futuresPositionFundingL struct{}
+
+var (
+	futuresPositionFundingAllColumns            = []string{"id", "position_id", "timestamp", "rate", "payment"}
+	futuresPositionFundingColumnsWithoutDefault = []string{"id", "position_id", "timestamp", "rate", "payment"}
+	futuresPositionFundingColumnsWithDefault    = []string{}
+	futuresPositionFundingPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FuturesPositionFundingSlice is an alias for a slice of pointers to FuturesPositionFunding.
+	// This should generally be used opposed to []FuturesPositionFunding.
+	FuturesPositionFundingSlice []*FuturesPositionFunding
+	// FuturesPositionFundingHook is the signature for custom FuturesPositionFunding hook methods
+	FuturesPositionFundingHook func(context.Context, boil.ContextExecutor, *FuturesPositionFunding) error
+
+	futuresPositionFundingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	futuresPositionFundingType                 = reflect.TypeOf(&FuturesPositionFunding{})
+	futuresPositionFundingMapping              = queries.MakeStructMapping(futuresPositionFundingType)
+	futuresPositionFundingPrimaryKeyMapping, _ = queries.BindMapping(futuresPositionFundingType, futuresPositionFundingMapping, futuresPositionFundingPrimaryKeyColumns)
+	futuresPositionFundingInsertCacheMut       sync.RWMutex
+	futuresPositionFundingInsertCache          = make(map[string]insertCache)
+	futuresPositionFundingUpdateCacheMut       sync.RWMutex
+	futuresPositionFundingUpdateCache          = make(map[string]updateCache)
+	futuresPositionFundingUpsertCacheMut       sync.RWMutex
+	futuresPositionFundingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var futuresPositionFundingBeforeInsertHooks []FuturesPositionFundingHook
+var futuresPositionFundingBeforeUpdateHooks []FuturesPositionFundingHook
+var futuresPositionFundingBeforeDeleteHooks []FuturesPositionFundingHook
+var futuresPositionFundingBeforeUpsertHooks []FuturesPositionFundingHook
+
+var futuresPositionFundingAfterInsertHooks []FuturesPositionFundingHook
+var futuresPositionFundingAfterSelectHooks []FuturesPositionFundingHook
+var futuresPositionFundingAfterUpdateHooks []FuturesPositionFundingHook
+var futuresPositionFundingAfterDeleteHooks []FuturesPositionFundingHook
+var futuresPositionFundingAfterUpsertHooks []FuturesPositionFundingHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FuturesPositionFunding) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FuturesPositionFunding) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FuturesPositionFunding) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FuturesPositionFunding) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FuturesPositionFunding) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FuturesPositionFunding) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FuturesPositionFunding) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FuturesPositionFunding) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FuturesPositionFunding) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range futuresPositionFundingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFuturesPositionFundingHook registers your hook function for all future operations.
+func AddFuturesPositionFundingHook(hookPoint boil.HookPoint, futuresPositionFundingHook FuturesPositionFundingHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		futuresPositionFundingBeforeInsertHooks = append(futuresPositionFundingBeforeInsertHooks, futuresPositionFundingHook)
+	case boil.BeforeUpdateHook:
+		futuresPositionFundingBeforeUpdateHooks = append(futuresPositionFundingBeforeUpdateHooks, futuresPositionFundingHook)
+	case boil.BeforeDeleteHook:
+		futuresPositionFundingBeforeDeleteHooks = append(futuresPositionFundingBeforeDeleteHooks, futuresPositionFundingHook)
+	case boil.BeforeUpsertHook:
+		futuresPositionFundingBeforeUpsertHooks = append(futuresPositionFundingBeforeUpsertHooks, futuresPositionFundingHook)
+	case boil.AfterInsertHook:
+		futuresPositionFundingAfterInsertHooks = append(futuresPositionFundingAfterInsertHooks, futuresPositionFundingHook)
+	case boil.AfterSelectHook:
+		futuresPositionFundingAfterSelectHooks = append(futuresPositionFundingAfterSelectHooks, futuresPositionFundingHook)
+	case boil.AfterUpdateHook:
+		futuresPositionFundingAfterUpdateHooks = append(futuresPositionFundingAfterUpdateHooks, futuresPositionFundingHook)
+	case boil.AfterDeleteHook:
+		futuresPositionFundingAfterDeleteHooks = append(futuresPositionFundingAfterDeleteHooks, futuresPositionFundingHook)
+	case boil.AfterUpsertHook:
+		futuresPositionFundingAfterUpsertHooks = append(futuresPositionFundingAfterUpsertHooks, futuresPositionFundingHook)
+	}
+}
+
+// One returns a single futuresPositionFunding record from the query.
+func (q futuresPositionFundingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FuturesPositionFunding, error) {
+	o := &FuturesPositionFunding{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for futures_position_funding")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FuturesPositionFunding records from the query.
+func (q futuresPositionFundingQuery) All(ctx context.Context, exec boil.ContextExecutor) (FuturesPositionFundingSlice, error) {
+	var o []*FuturesPositionFunding
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to FuturesPositionFunding slice")
+	}
+
+	if len(futuresPositionFundingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FuturesPositionFunding records in the query.
+func (q futuresPositionFundingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count futures_position_funding rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q futuresPositionFundingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if futures_position_funding exists")
+	}
+
+	return count > 0, nil
+}
+
+// Position pointed to by the foreign key.
+func (o *FuturesPositionFunding) Position(mods ...qm.QueryMod) futuresPositionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PositionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FuturesPositions(queryMods...)
+	queries.SetFrom(query.Query, "\"futures_position\"")
+
+	return query
+}
+
+// LoadPosition allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (futuresPositionFundingL) LoadPosition(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFuturesPositionFunding interface{}, mods queries.Applicator) error {
+	var slice []*FuturesPositionFunding
+	var object *FuturesPositionFunding
+
+	if singular {
+		object = maybeFuturesPositionFunding.(*FuturesPositionFunding)
+	} else {
+		slice = *maybeFuturesPositionFunding.(*[]*FuturesPositionFunding)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &futuresPositionFundingR{}
+		}
+		args = append(args, object.PositionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &futuresPositionFundingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PositionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PositionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`futures_position`), qm.WhereIn(`futures_position.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FuturesPosition")
+	}
+
+	var resultSlice []*FuturesPosition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FuturesPosition")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for futures_position")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for futures_position")
+	}
+
+	if len(futuresPositionFundingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Position = foreign
+		if foreign.R == nil {
+			foreign.R = &futuresPositionR{}
+		}
+		foreign.R.PositionFuturesPositionFundings = append(foreign.R.PositionFuturesPositionFundings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PositionID == foreign.ID {
+				local.R.Position = foreign
+				if foreign.R == nil {
+					foreign.R = &futuresPositionR{}
+				}
+				foreign.R.PositionFuturesPositionFundings = append(foreign.R.PositionFuturesPositionFundings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPosition of the futuresPositionFunding to the related item.
+// Sets o.R.Position to related.
+// Adds o to related.R.PositionFuturesPositionFundings.
+func (o *FuturesPositionFunding) SetPosition(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FuturesPosition) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"futures_position_funding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"position_id"}),
+		strmangle.WhereClause("\"", "\"", 0, futuresPositionFundingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PositionID = related.ID
+	if o.R == nil {
+		o.R = &futuresPositionFundingR{
+			Position: related,
+		}
+	} else {
+		o.R.Position = related
+	}
+
+	if related.R == nil {
+		related.R = &futuresPositionR{
+			PositionFuturesPositionFundings: FuturesPositionFundingSlice{o},
+		}
+	} else {
+		related.R.PositionFuturesPositionFundings = append(related.R.PositionFuturesPositionFundings, o)
+	}
+
+	return nil
+}
+
+// FuturesPositionFundings retrieves all the records using an executor.
+func FuturesPositionFundings(mods ...qm.QueryMod) futuresPositionFundingQuery {
+	mods = append(mods, qm.From("\"futures_position_funding\""))
+	return futuresPositionFundingQuery{NewQuery(mods...)}
+}
+
+// FindFuturesPositionFunding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFuturesPositionFunding(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FuturesPositionFunding, error) {
+	futuresPositionFundingObj := &FuturesPositionFunding{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"futures_position_funding\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, futuresPositionFundingObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from futures_position_funding")
+	}
+
+	return futuresPositionFundingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FuturesPositionFunding) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no futures_position_funding provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(futuresPositionFundingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	futuresPositionFundingInsertCacheMut.RLock()
+	cache, cached := futuresPositionFundingInsertCache[key]
+	futuresPositionFundingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			futuresPositionFundingAllColumns,
+			futuresPositionFundingColumnsWithDefault,
+			futuresPositionFundingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(futuresPositionFundingType, futuresPositionFundingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(futuresPositionFundingType, futuresPositionFundingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"futures_position_funding\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"futures_position_funding\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"futures_position_funding\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, futuresPositionFundingPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into futures_position_funding")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for futures_position_funding")
+	}
+
+CacheNoHooks:
+	if !cached {
+		futuresPositionFundingInsertCacheMut.Lock()
+		futuresPositionFundingInsertCache[key] = cache
+		futuresPositionFundingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FuturesPositionFunding.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FuturesPositionFunding) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	futuresPositionFundingUpdateCacheMut.RLock()
+	cache, cached := futuresPositionFundingUpdateCache[key]
+	futuresPositionFundingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			futuresPositionFundingAllColumns,
+			futuresPositionFundingPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update futures_position_funding, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"futures_position_funding\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, futuresPositionFundingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(futuresPositionFundingType, futuresPositionFundingMapping, append(wl, futuresPositionFundingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update futures_position_funding row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for futures_position_funding")
+	}
+
+	if !cached {
+		futuresPositionFundingUpdateCacheMut.Lock()
+		futuresPositionFundingUpdateCache[key] = cache
+		futuresPositionFundingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q futuresPositionFundingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for futures_position_funding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for futures_position_funding")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FuturesPositionFundingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionFundingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"futures_position_funding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, futuresPositionFundingPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in futuresPositionFunding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all futuresPositionFunding")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single FuturesPositionFunding record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FuturesPositionFunding) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no FuturesPositionFunding provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), futuresPositionFundingPrimaryKeyMapping)
+	sql := "DELETE FROM \"futures_position_funding\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from futures_position_funding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for futures_position_funding")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q futuresPositionFundingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no futuresPositionFundingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from futures_position_funding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for futures_position_funding")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FuturesPositionFundingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(futuresPositionFundingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionFundingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"futures_position_funding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, futuresPositionFundingPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from futuresPositionFunding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for futures_position_funding")
+	}
+
+	if len(futuresPositionFundingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FuturesPositionFunding) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFuturesPositionFunding(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FuturesPositionFundingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FuturesPositionFundingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), futuresPositionFundingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"futures_position_funding\".* FROM \"futures_position_funding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, futuresPositionFundingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in FuturesPositionFundingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FuturesPositionFundingExists checks if the FuturesPositionFunding row exists.
+func FuturesPositionFundingExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"futures_position_funding\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if futures_position_funding exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,961 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// PortfolioSnapshotHolding is an object representing the database table.
+type PortfolioSnapshotHolding struct {
+	ID         string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SnapshotID string  `boil:"snapshot_id" json:"snapshot_id" toml:"snapshot_id" yaml:"snapshot_id"`
+	Source     string  `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Address    string  `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Currency   string  `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount     float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Price      float64 `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Value      float64 `boil:"value" json:"value" toml:"value" yaml:"value"`
+
+	R *portfolioSnapshotHoldingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L portfolioSnapshotHoldingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PortfolioSnapshotHoldingColumns = struct {
+	ID         string
+	SnapshotID string
+	Source     string
+	Address    string
+	Currency   string
+	Amount     string
+	Price      string
+	Value      string
+}{
+	ID:         "id",
+	SnapshotID: "snapshot_id",
+	Source:     "source",
+	Address:    "address",
+	Currency:   "currency",
+	Amount:     "amount",
+	Price:      "price",
+	Value:      "value",
+}
+
+// Generated where
+
+var PortfolioSnapshotHoldingWhere = struct {
+	ID         whereHelperstring
+	SnapshotID whereHelperstring
+	Source     whereHelperstring
+	Address    whereHelperstring
+	Currency   whereHelperstring
+	Amount     whereHelperfloat64
+	Price      whereHelperfloat64
+	Value      whereHelperfloat64
+}{
+	ID:         whereHelperstring{field: "\"portfolio_snapshot_holding\".\"id\""},
+	SnapshotID: whereHelperstring{field: "\"portfolio_snapshot_holding\".\"snapshot_id\""},
+	Source:     whereHelperstring{field: "\"portfolio_snapshot_holding\".\"source\""},
+	Address:    whereHelperstring{field: "\"portfolio_snapshot_holding\".\"address\""},
+	Currency:   whereHelperstring{field: "\"portfolio_snapshot_holding\".\"currency\""},
+	Amount:     whereHelperfloat64{field: "\"portfolio_snapshot_holding\".\"amount\""},
+	Price:      whereHelperfloat64{field: "\"portfolio_snapshot_holding\".\"price\""},
+	Value:      whereHelperfloat64{field: "\"portfolio_snapshot_holding\".\"value\""},
+}
+
+// PortfolioSnapshotHoldingRels is where relationship names are stored.
+var PortfolioSnapshotHoldingRels = struct {
+	Snapshot string
+}{
+	Snapshot: "Snapshot",
+}
+
+// portfolioSnapshotHoldingR is where relationships are stored.
+type portfolioSnapshotHoldingR struct {
+	Snapshot *PortfolioSnapshot
+}
+
+// NewStruct creates a new relationship struct
+func (*portfolioSnapshotHoldingR) NewStruct() *portfolioSnapshotHoldingR {
+	return &portfolioSnapshotHoldingR{}
+}
+
+// portfolioSnapshotHoldingL is where Load methods for each relationship are stored.
+type portfolioSnapshotHoldingL struct{}
+
+var (
+	portfolioSnapshotHoldingAllColumns            = []string{"id", "snapshot_id", "source", "address", "currency", "amount", "price", "value"}
+	portfolioSnapshotHoldingColumnsWithoutDefault = []string{"id", "snapshot_id", "source", "address", "currency", "amount", "price", "value"}
+	portfolioSnapshotHoldingColumnsWithDefault    = []string{}
+	portfolioSnapshotHoldingPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PortfolioSnapshotHoldingSlice is an alias for a slice of pointers to PortfolioSnapshotHolding.
+	// This should generally be used opposed to []PortfolioSnapshotHolding.
+	PortfolioSnapshotHoldingSlice []*PortfolioSnapshotHolding
+	// PortfolioSnapshotHoldingHook is the signature for custom PortfolioSnapshotHolding hook methods
+	PortfolioSnapshotHoldingHook func(context.Context, boil.ContextExecutor, *PortfolioSnapshotHolding) error
+
+	portfolioSnapshotHoldingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	portfolioSnapshotHoldingType                 = reflect.TypeOf(&PortfolioSnapshotHolding{})
+	portfolioSnapshotHoldingMapping              = queries.MakeStructMapping(portfolioSnapshotHoldingType)
+	portfolioSnapshotHoldingPrimaryKeyMapping, _ = queries.BindMapping(portfolioSnapshotHoldingType, portfolioSnapshotHoldingMapping, portfolioSnapshotHoldingPrimaryKeyColumns)
+	portfolioSnapshotHoldingInsertCacheMut       sync.RWMutex
+	portfolioSnapshotHoldingInsertCache          = make(map[string]insertCache)
+	portfolioSnapshotHoldingUpdateCacheMut       sync.RWMutex
+	portfolioSnapshotHoldingUpdateCache          = make(map[string]updateCache)
+	portfolioSnapshotHoldingUpsertCacheMut       sync.RWMutex
+	portfolioSnapshotHoldingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var portfolioSnapshotHoldingBeforeInsertHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingBeforeUpdateHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingBeforeDeleteHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingBeforeUpsertHooks []PortfolioSnapshotHoldingHook
+
+var portfolioSnapshotHoldingAfterInsertHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingAfterSelectHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingAfterUpdateHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingAfterDeleteHooks []PortfolioSnapshotHoldingHook
+var portfolioSnapshotHoldingAfterUpsertHooks []PortfolioSnapshotHoldingHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PortfolioSnapshotHolding) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PortfolioSnapshotHolding) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PortfolioSnapshotHolding) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PortfolioSnapshotHolding) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PortfolioSnapshotHolding) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PortfolioSnapshotHolding) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PortfolioSnapshotHolding) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PortfolioSnapshotHolding) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PortfolioSnapshotHolding) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfolioSnapshotHoldingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPortfolioSnapshotHoldingHook registers your hook function for all future operations.
+func AddPortfolioSnapshotHoldingHook(hookPoint boil.HookPoint, portfolioSnapshotHoldingHook PortfolioSnapshotHoldingHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		portfolioSnapshotHoldingBeforeInsertHooks = append(portfolioSnapshotHoldingBeforeInsertHooks, portfolioSnapshotHoldingHook)
+	case boil.BeforeUpdateHook:
+		portfolioSnapshotHoldingBeforeUpdateHooks = append(portfolioSnapshotHoldingBeforeUpdateHooks, portfolioSnapshotHoldingHook)
+	case boil.BeforeDeleteHook:
+		portfolioSnapshotHoldingBeforeDeleteHooks = append(portfolioSnapshotHoldingBeforeDeleteHooks, portfolioSnapshotHoldingHook)
+	case boil.BeforeUpsertHook:
+		portfolioSnapshotHoldingBeforeUpsertHooks = append(portfolioSnapshotHoldingBeforeUpsertHooks, portfolioSnapshotHoldingHook)
+	case boil.AfterInsertHook:
+		portfolioSnapshotHoldingAfterInsertHooks = append(portfolioSnapshotHoldingAfterInsertHooks, portfolioSnapshotHoldingHook)
+	case boil.AfterSelectHook:
+		portfolioSnapshotHoldingAfterSelectHooks = append(portfolioSnapshotHoldingAfterSelectHooks, portfolioSnapshotHoldingHook)
+	case boil.AfterUpdateHook:
+		portfolioSnapshotHoldingAfterUpdateHooks = append(portfolioSnapshotHoldingAfterUpdateHooks, portfolioSnapshotHoldingHook)
+	case boil.AfterDeleteHook:
+		portfolioSnapshotHoldingAfterDeleteHooks = append(portfolioSnapshotHoldingAfterDeleteHooks, portfolioSnapshotHoldingHook)
+	case boil.AfterUpsertHook:
+		portfolioSnapshotHoldingAfterUpsertHooks = append(portfolioSnapshotHoldingAfterUpsertHooks, portfolioSnapshotHoldingHook)
+	}
+}
+
+// One returns a single portfolioSnapshotHolding record from the query.
+func (q portfolioSnapshotHoldingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PortfolioSnapshotHolding, error) {
+	o := &PortfolioSnapshotHolding{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for portfolio_snapshot_holding")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PortfolioSnapshotHolding records from the query.
+func (q portfolioSnapshotHoldingQuery) All(ctx context.Context, exec boil.ContextExecutor) (PortfolioSnapshotHoldingSlice, error) {
+	var o []*PortfolioSnapshotHolding
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to PortfolioSnapshotHolding slice")
+	}
+
+	if len(portfolioSnapshotHoldingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PortfolioSnapshotHolding records in the query.
+func (q portfolioSnapshotHoldingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count portfolio_snapshot_holding rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q portfolioSnapshotHoldingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if portfolio_snapshot_holding exists")
+	}
+
+	return count > 0, nil
+}
+
+// Snapshot pointed to by the foreign key.
+func (o *PortfolioSnapshotHolding) Snapshot(mods ...qm.QueryMod) portfolioSnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SnapshotID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := PortfolioSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"portfolio_snapshot\"")
+
+	return query
+}
+
+// LoadSnapshot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (portfolioSnapshotHoldingL) LoadSnapshot(ctx context.Context, e boil.ContextExecutor, singular bool, maybePortfolioSnapshotHolding interface{}, mods queries.Applicator) error {
+	var slice []*PortfolioSnapshotHolding
+	var object *PortfolioSnapshotHolding
+
+	if singular {
+		object = maybePortfolioSnapshotHolding.(*PortfolioSnapshotHolding)
+	} else {
+		slice = *maybePortfolioSnapshotHolding.(*[]*PortfolioSnapshotHolding)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &portfolioSnapshotHoldingR{}
+		}
+		args = append(args, object.SnapshotID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &portfolioSnapshotHoldingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SnapshotID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SnapshotID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`portfolio_snapshot`), qm.WhereIn(`portfolio_snapshot.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PortfolioSnapshot")
+	}
+
+	var resultSlice []*PortfolioSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PortfolioSnapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for portfolio_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for portfolio_snapshot")
+	}
+
+	if len(portfolioSnapshotHoldingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Snapshot = foreign
+		if foreign.R == nil {
+			foreign.R = &portfolioSnapshotR{}
+		}
+		foreign.R.SnapshotPortfolioSnapshotHoldings = append(foreign.R.SnapshotPortfolioSnapshotHoldings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SnapshotID == foreign.ID {
+				local.R.Snapshot = foreign
+				if foreign.R == nil {
+					foreign.R = &portfolioSnapshotR{}
+				}
+				foreign.R.SnapshotPortfolioSnapshotHoldings = append(foreign.R.SnapshotPortfolioSnapshotHoldings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSnapshot of the portfolioSnapshotHolding to the related item.
+// Sets o.R.Snapshot to related.
+// Adds o to related.R.SnapshotPortfolioSnapshotHoldings.
+func (o *PortfolioSnapshotHolding) SetSnapshot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PortfolioSnapshot) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"portfolio_snapshot_holding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"snapshot_id"}),
+		strmangle.WhereClause("\"", "\"", 0, portfolioSnapshotHoldingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SnapshotID = related.ID
+	if o.R == nil {
+		o.R = &portfolioSnapshotHoldingR{
+			Snapshot: related,
+		}
+	} else {
+		o.R.Snapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &portfolioSnapshotR{
+			SnapshotPortfolioSnapshotHoldings: PortfolioSnapshotHoldingSlice{o},
+		}
+	} else {
+		related.R.SnapshotPortfolioSnapshotHoldings = append(related.R.SnapshotPortfolioSnapshotHoldings, o)
+	}
+
+	return nil
+}
+
+// PortfolioSnapshotHoldings retrieves all the records using an executor.
+func PortfolioSnapshotHoldings(mods ...qm.QueryMod) portfolioSnapshotHoldingQuery {
+	mods = append(mods, qm.From("\"portfolio_snapshot_holding\""))
+	return portfolioSnapshotHoldingQuery{NewQuery(mods...)}
+}
+
+// FindPortfolioSnapshotHolding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPortfolioSnapshotHolding(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PortfolioSnapshotHolding, error) {
+	portfolioSnapshotHoldingObj := &PortfolioSnapshotHolding{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"portfolio_snapshot_holding\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, portfolioSnapshotHoldingObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from portfolio_snapshot_holding")
+	}
+
+	return portfolioSnapshotHoldingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PortfolioSnapshotHolding) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no portfolio_snapshot_holding provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfolioSnapshotHoldingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	portfolioSnapshotHoldingInsertCacheMut.RLock()
+	cache, cached := portfolioSnapshotHoldingInsertCache[key]
+	portfolioSnapshotHoldingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			portfolioSnapshotHoldingAllColumns,
+			portfolioSnapshotHoldingColumnsWithDefault,
+			portfolioSnapshotHoldingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(portfolioSnapshotHoldingType, portfolioSnapshotHoldingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(portfolioSnapshotHoldingType, portfolioSnapshotHoldingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"portfolio_snapshot_holding\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"portfolio_snapshot_holding\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"portfolio_snapshot_holding\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, portfolioSnapshotHoldingPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into portfolio_snapshot_holding")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for portfolio_snapshot_holding")
+	}
+
+CacheNoHooks:
+	if !cached {
+		portfolioSnapshotHoldingInsertCacheMut.Lock()
+		portfolioSnapshotHoldingInsertCache[key] = cache
+		portfolioSnapshotHoldingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PortfolioSnapshotHolding.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PortfolioSnapshotHolding) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	portfolioSnapshotHoldingUpdateCacheMut.RLock()
+	cache, cached := portfolioSnapshotHoldingUpdateCache[key]
+	portfolioSnapshotHoldingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			portfolioSnapshotHoldingAllColumns,
+			portfolioSnapshotHoldingPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update portfolio_snapshot_holding, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"portfolio_snapshot_holding\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, portfolioSnapshotHoldingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(portfolioSnapshotHoldingType, portfolioSnapshotHoldingMapping, append(wl, portfolioSnapshotHoldingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update portfolio_snapshot_holding row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for portfolio_snapshot_holding")
+	}
+
+	if !cached {
+		portfolioSnapshotHoldingUpdateCacheMut.Lock()
+		portfolioSnapshotHoldingUpdateCache[key] = cache
+		portfolioSnapshotHoldingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q portfolioSnapshotHoldingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for portfolio_snapshot_holding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for portfolio_snapshot_holding")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PortfolioSnapshotHoldingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotHoldingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"portfolio_snapshot_holding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfolioSnapshotHoldingPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in portfolioSnapshotHolding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all portfolioSnapshotHolding")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single PortfolioSnapshotHolding record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PortfolioSnapshotHolding) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no PortfolioSnapshotHolding provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), portfolioSnapshotHoldingPrimaryKeyMapping)
+	sql := "DELETE FROM \"portfolio_snapshot_holding\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from portfolio_snapshot_holding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for portfolio_snapshot_holding")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q portfolioSnapshotHoldingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no portfolioSnapshotHoldingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from portfolio_snapshot_holding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for portfolio_snapshot_holding")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PortfolioSnapshotHoldingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(portfolioSnapshotHoldingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotHoldingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"portfolio_snapshot_holding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfolioSnapshotHoldingPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from portfolioSnapshotHolding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for portfolio_snapshot_holding")
+	}
+
+	if len(portfolioSnapshotHoldingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PortfolioSnapshotHolding) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPortfolioSnapshotHolding(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PortfolioSnapshotHoldingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PortfolioSnapshotHoldingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfolioSnapshotHoldingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"portfolio_snapshot_holding\".* FROM \"portfolio_snapshot_holding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfolioSnapshotHoldingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in PortfolioSnapshotHoldingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PortfolioSnapshotHoldingExists checks if the PortfolioSnapshotHolding row exists.
+func PortfolioSnapshotHoldingExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"portfolio_snapshot_holding\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if portfolio_snapshot_holding exists")
+	}
+
+	return exists, nil
+}
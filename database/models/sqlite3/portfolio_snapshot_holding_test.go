@@ -0,0 +1,793 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPortfolioSnapshotHoldings(t *testing.T) {
+	t.Parallel()
+
+	query := PortfolioSnapshotHoldings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPortfolioSnapshotHoldingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotHoldingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PortfolioSnapshotHoldings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotHoldingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfolioSnapshotHoldingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfolioSnapshotHoldingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PortfolioSnapshotHoldingExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PortfolioSnapshotHolding exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PortfolioSnapshotHoldingExists to return true, but got false.")
+	}
+}
+
+func testPortfolioSnapshotHoldingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	portfolioSnapshotHoldingFound, err := FindPortfolioSnapshotHolding(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if portfolioSnapshotHoldingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPortfolioSnapshotHoldingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PortfolioSnapshotHoldings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotHoldingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PortfolioSnapshotHoldings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPortfolioSnapshotHoldingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	portfolioSnapshotHoldingOne := &PortfolioSnapshotHolding{}
+	portfolioSnapshotHoldingTwo := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, portfolioSnapshotHoldingOne, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfolioSnapshotHoldingTwo, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfolioSnapshotHoldingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfolioSnapshotHoldingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PortfolioSnapshotHoldings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPortfolioSnapshotHoldingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	portfolioSnapshotHoldingOne := &PortfolioSnapshotHolding{}
+	portfolioSnapshotHoldingTwo := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, portfolioSnapshotHoldingOne, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfolioSnapshotHoldingTwo, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfolioSnapshotHoldingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfolioSnapshotHoldingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func portfolioSnapshotHoldingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func portfolioSnapshotHoldingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PortfolioSnapshotHolding) error {
+	*o = PortfolioSnapshotHolding{}
+	return nil
+}
+
+func testPortfolioSnapshotHoldingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PortfolioSnapshotHolding{}
+	o := &PortfolioSnapshotHolding{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding object: %s", err)
+	}
+
+	AddPortfolioSnapshotHoldingHook(boil.BeforeInsertHook, portfolioSnapshotHoldingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingBeforeInsertHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.AfterInsertHook, portfolioSnapshotHoldingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingAfterInsertHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.AfterSelectHook, portfolioSnapshotHoldingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingAfterSelectHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.BeforeUpdateHook, portfolioSnapshotHoldingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingBeforeUpdateHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.AfterUpdateHook, portfolioSnapshotHoldingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingAfterUpdateHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.BeforeDeleteHook, portfolioSnapshotHoldingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingBeforeDeleteHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.AfterDeleteHook, portfolioSnapshotHoldingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingAfterDeleteHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.BeforeUpsertHook, portfolioSnapshotHoldingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingBeforeUpsertHooks = []PortfolioSnapshotHoldingHook{}
+
+	AddPortfolioSnapshotHoldingHook(boil.AfterUpsertHook, portfolioSnapshotHoldingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfolioSnapshotHoldingAfterUpsertHooks = []PortfolioSnapshotHoldingHook{}
+}
+
+func testPortfolioSnapshotHoldingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfolioSnapshotHoldingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(portfolioSnapshotHoldingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfolioSnapshotHoldingToOnePortfolioSnapshotUsingSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PortfolioSnapshotHolding
+	var foreign PortfolioSnapshot
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, portfolioSnapshotHoldingDBTypes, false, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, portfolioSnapshotDBTypes, false, portfolioSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshot struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SnapshotID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Snapshot().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PortfolioSnapshotHoldingSlice{&local}
+	if err = local.L.LoadSnapshot(ctx, tx, false, (*[]*PortfolioSnapshotHolding)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Snapshot == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Snapshot = nil
+	if err = local.L.LoadSnapshot(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Snapshot == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPortfolioSnapshotHoldingToOneSetOpPortfolioSnapshotUsingSnapshot(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PortfolioSnapshotHolding
+	var b, c PortfolioSnapshot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfolioSnapshotHoldingDBTypes, false, strmangle.SetComplement(portfolioSnapshotHoldingPrimaryKeyColumns, portfolioSnapshotHoldingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, portfolioSnapshotDBTypes, false, strmangle.SetComplement(portfolioSnapshotPrimaryKeyColumns, portfolioSnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, portfolioSnapshotDBTypes, false, strmangle.SetComplement(portfolioSnapshotPrimaryKeyColumns, portfolioSnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PortfolioSnapshot{&b, &c} {
+		err = a.SetSnapshot(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Snapshot != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SnapshotPortfolioSnapshotHoldings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SnapshotID != x.ID {
+			t.Error("foreign key was wrong value", a.SnapshotID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SnapshotID))
+		reflect.Indirect(reflect.ValueOf(&a.SnapshotID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SnapshotID != x.ID {
+			t.Error("foreign key was wrong value", a.SnapshotID, x.ID)
+		}
+	}
+}
+
+func testPortfolioSnapshotHoldingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotHoldingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfolioSnapshotHoldingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfolioSnapshotHoldingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PortfolioSnapshotHoldings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	portfolioSnapshotHoldingDBTypes = map[string]string{`ID`: `TEXT`, `SnapshotID`: `TEXT`, `Source`: `TEXT`, `Address`: `TEXT`, `Currency`: `TEXT`, `Amount`: `REAL`, `Price`: `REAL`, `Value`: `REAL`}
+	_                               = bytes.MinRead
+)
+
+func testPortfolioSnapshotHoldingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(portfolioSnapshotHoldingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(portfolioSnapshotHoldingAllColumns) == len(portfolioSnapshotHoldingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPortfolioSnapshotHoldingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(portfolioSnapshotHoldingAllColumns) == len(portfolioSnapshotHoldingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PortfolioSnapshotHolding{}
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PortfolioSnapshotHoldings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfolioSnapshotHoldingDBTypes, true, portfolioSnapshotHoldingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PortfolioSnapshotHolding struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(portfolioSnapshotHoldingAllColumns, portfolioSnapshotHoldingPrimaryKeyColumns) {
+		fields = portfolioSnapshotHoldingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			portfolioSnapshotHoldingAllColumns,
+			portfolioSnapshotHoldingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PortfolioSnapshotHoldingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
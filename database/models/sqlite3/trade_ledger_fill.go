@@ -0,0 +1,820 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// TradeLedgerFill is an object representing the database table.
+type TradeLedgerFill struct {
+	ID          string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange    string  `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Account     string  `boil:"account" json:"account" toml:"account" yaml:"account"`
+	OrderID     string  `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	TradeID     string  `boil:"trade_id" json:"trade_id" toml:"trade_id" yaml:"trade_id"`
+	Base        string  `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote       string  `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Side        string  `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Price       float64 `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount      float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee         float64 `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	FeeCurrency string  `boil:"fee_currency" json:"fee_currency" toml:"fee_currency" yaml:"fee_currency"`
+	Timestamp   string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *tradeLedgerFillR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tradeLedgerFillL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TradeLedgerFillColumns = struct {
+	ID          string
+	Exchange    string
+	Account     string
+	OrderID     string
+	TradeID     string
+	Base        string
+	Quote       string
+	Side        string
+	Price       string
+	Amount      string
+	Fee         string
+	FeeCurrency string
+	Timestamp   string
+}{
+	ID:          "id",
+	Exchange:    "exchange",
+	Account:     "account",
+	OrderID:     "order_id",
+	TradeID:     "trade_id",
+	Base:        "base",
+	Quote:       "quote",
+	Side:        "side",
+	Price:       "price",
+	Amount:      "amount",
+	Fee:         "fee",
+	FeeCurrency: "fee_currency",
+	Timestamp:   "timestamp",
+}
+
+// Generated where
+
+var TradeLedgerFillWhere = struct {
+	ID          whereHelperstring
+	Exchange    whereHelperstring
+	Account     whereHelperstring
+	OrderID     whereHelperstring
+	TradeID     whereHelperstring
+	Base        whereHelperstring
+	Quote       whereHelperstring
+	Side        whereHelperstring
+	Price       whereHelperfloat64
+	Amount      whereHelperfloat64
+	Fee         whereHelperfloat64
+	FeeCurrency whereHelperstring
+	Timestamp   whereHelperstring
+}{
+	ID:          whereHelperstring{field: "\"trade_ledger_fill\".\"id\""},
+	Exchange:    whereHelperstring{field: "\"trade_ledger_fill\".\"exchange\""},
+	Account:     whereHelperstring{field: "\"trade_ledger_fill\".\"account\""},
+	OrderID:     whereHelperstring{field: "\"trade_ledger_fill\".\"order_id\""},
+	TradeID:     whereHelperstring{field: "\"trade_ledger_fill\".\"trade_id\""},
+	Base:        whereHelperstring{field: "\"trade_ledger_fill\".\"base\""},
+	Quote:       whereHelperstring{field: "\"trade_ledger_fill\".\"quote\""},
+	Side:        whereHelperstring{field: "\"trade_ledger_fill\".\"side\""},
+	Price:       whereHelperfloat64{field: "\"trade_ledger_fill\".\"price\""},
+	Amount:      whereHelperfloat64{field: "\"trade_ledger_fill\".\"amount\""},
+	Fee:         whereHelperfloat64{field: "\"trade_ledger_fill\".\"fee\""},
+	FeeCurrency: whereHelperstring{field: "\"trade_ledger_fill\".\"fee_currency\""},
+	Timestamp:   whereHelperstring{field: "\"trade_ledger_fill\".\"timestamp\""},
+}
+
+// TradeLedgerFillRels is where relationship names are stored.
+var TradeLedgerFillRels = struct {
+}{}
+
+// tradeLedgerFillR is where relationships are stored.
+type tradeLedgerFillR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tradeLedgerFillR) NewStruct() *tradeLedgerFillR {
+	return &tradeLedgerFillR{}
+}
+
+// tradeLedgerFillL is where Load methods for each relationship are stored.
+type tradeLedgerFillL struct{}
+
+var (
+	tradeLedgerFillAllColumns            = []string{"id", "exchange", "account", "order_id", "trade_id", "base", "quote", "side", "price", "amount", "fee", "fee_currency", "timestamp"}
+	tradeLedgerFillColumnsWithoutDefault = []string{"id", "exchange", "account", "order_id", "trade_id", "base", "quote", "side", "price", "amount", "fee", "fee_currency", "timestamp"}
+	tradeLedgerFillColumnsWithDefault    = []string{}
+	tradeLedgerFillPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TradeLedgerFillSlice is an alias for a slice of pointers to TradeLedgerFill.
+	// This should generally be used opposed to []TradeLedgerFill.
+	TradeLedgerFillSlice []*TradeLedgerFill
+	// TradeLedgerFillHook is the signature for custom TradeLedgerFill hook methods
+	TradeLedgerFillHook func(context.Context, boil.ContextExecutor, *TradeLedgerFill) error
+
+	tradeLedgerFillQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tradeLedgerFillType                 = reflect.TypeOf(&TradeLedgerFill{})
+	tradeLedgerFillMapping              = queries.MakeStructMapping(tradeLedgerFillType)
+	tradeLedgerFillPrimaryKeyMapping, _ = queries.BindMapping(tradeLedgerFillType, tradeLedgerFillMapping, tradeLedgerFillPrimaryKeyColumns)
+	tradeLedgerFillInsertCacheMut       sync.RWMutex
+	tradeLedgerFillInsertCache          = make(map[string]insertCache)
+	tradeLedgerFillUpdateCacheMut       sync.RWMutex
+	tradeLedgerFillUpdateCache          = make(map[string]updateCache)
+	tradeLedgerFillUpsertCacheMut       sync.RWMutex
+	tradeLedgerFillUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tradeLedgerFillBeforeInsertHooks []TradeLedgerFillHook
+var tradeLedgerFillBeforeUpdateHooks []TradeLedgerFillHook
+var tradeLedgerFillBeforeDeleteHooks []TradeLedgerFillHook
+var tradeLedgerFillBeforeUpsertHooks []TradeLedgerFillHook
+
+var tradeLedgerFillAfterInsertHooks []TradeLedgerFillHook
+var tradeLedgerFillAfterSelectHooks []TradeLedgerFillHook
+var tradeLedgerFillAfterUpdateHooks []TradeLedgerFillHook
+var tradeLedgerFillAfterDeleteHooks []TradeLedgerFillHook
+var tradeLedgerFillAfterUpsertHooks []TradeLedgerFillHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TradeLedgerFill) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TradeLedgerFill) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TradeLedgerFill) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TradeLedgerFill) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TradeLedgerFill) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TradeLedgerFill) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TradeLedgerFill) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TradeLedgerFill) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TradeLedgerFill) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tradeLedgerFillAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTradeLedgerFillHook registers your hook function for all future operations.
+func AddTradeLedgerFillHook(hookPoint boil.HookPoint, tradeLedgerFillHook TradeLedgerFillHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		tradeLedgerFillBeforeInsertHooks = append(tradeLedgerFillBeforeInsertHooks, tradeLedgerFillHook)
+	case boil.BeforeUpdateHook:
+		tradeLedgerFillBeforeUpdateHooks = append(tradeLedgerFillBeforeUpdateHooks, tradeLedgerFillHook)
+	case boil.BeforeDeleteHook:
+		tradeLedgerFillBeforeDeleteHooks = append(tradeLedgerFillBeforeDeleteHooks, tradeLedgerFillHook)
+	case boil.BeforeUpsertHook:
+		tradeLedgerFillBeforeUpsertHooks = append(tradeLedgerFillBeforeUpsertHooks, tradeLedgerFillHook)
+	case boil.AfterInsertHook:
+		tradeLedgerFillAfterInsertHooks = append(tradeLedgerFillAfterInsertHooks, tradeLedgerFillHook)
+	case boil.AfterSelectHook:
+		tradeLedgerFillAfterSelectHooks = append(tradeLedgerFillAfterSelectHooks, tradeLedgerFillHook)
+	case boil.AfterUpdateHook:
+		tradeLedgerFillAfterUpdateHooks = append(tradeLedgerFillAfterUpdateHooks, tradeLedgerFillHook)
+	case boil.AfterDeleteHook:
+		tradeLedgerFillAfterDeleteHooks = append(tradeLedgerFillAfterDeleteHooks, tradeLedgerFillHook)
+	case boil.AfterUpsertHook:
+		tradeLedgerFillAfterUpsertHooks = append(tradeLedgerFillAfterUpsertHooks, tradeLedgerFillHook)
+	}
+}
+
+// One returns a single tradeLedgerFill record from the query.
+func (q tradeLedgerFillQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TradeLedgerFill, error) {
+	o := &TradeLedgerFill{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for trade_ledger_fill")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TradeLedgerFill records from the query.
+func (q tradeLedgerFillQuery) All(ctx context.Context, exec boil.ContextExecutor) (TradeLedgerFillSlice, error) {
+	var o []*TradeLedgerFill
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to TradeLedgerFill slice")
+	}
+
+	if len(tradeLedgerFillAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TradeLedgerFill records in the query.
+func (q tradeLedgerFillQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count trade_ledger_fill rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tradeLedgerFillQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if trade_ledger_fill exists")
+	}
+
+	return count > 0, nil
+}
+
+// TradeLedgerFills retrieves all the records using an executor.
+func TradeLedgerFills(mods ...qm.QueryMod) tradeLedgerFillQuery {
+	mods = append(mods, qm.From("\"trade_ledger_fill\""))
+	return tradeLedgerFillQuery{NewQuery(mods...)}
+}
+
+// FindTradeLedgerFill retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTradeLedgerFill(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*TradeLedgerFill, error) {
+	tradeLedgerFillObj := &TradeLedgerFill{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"trade_ledger_fill\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tradeLedgerFillObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from trade_ledger_fill")
+	}
+
+	return tradeLedgerFillObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TradeLedgerFill) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no trade_ledger_fill provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tradeLedgerFillColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tradeLedgerFillInsertCacheMut.RLock()
+	cache, cached := tradeLedgerFillInsertCache[key]
+	tradeLedgerFillInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tradeLedgerFillAllColumns,
+			tradeLedgerFillColumnsWithDefault,
+			tradeLedgerFillColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tradeLedgerFillType, tradeLedgerFillMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tradeLedgerFillType, tradeLedgerFillMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"trade_ledger_fill\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"trade_ledger_fill\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"trade_ledger_fill\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, tradeLedgerFillPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into trade_ledger_fill")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for trade_ledger_fill")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tradeLedgerFillInsertCacheMut.Lock()
+		tradeLedgerFillInsertCache[key] = cache
+		tradeLedgerFillInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TradeLedgerFill.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TradeLedgerFill) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tradeLedgerFillUpdateCacheMut.RLock()
+	cache, cached := tradeLedgerFillUpdateCache[key]
+	tradeLedgerFillUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tradeLedgerFillAllColumns,
+			tradeLedgerFillPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update trade_ledger_fill, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"trade_ledger_fill\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, tradeLedgerFillPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tradeLedgerFillType, tradeLedgerFillMapping, append(wl, tradeLedgerFillPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update trade_ledger_fill row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for trade_ledger_fill")
+	}
+
+	if !cached {
+		tradeLedgerFillUpdateCacheMut.Lock()
+		tradeLedgerFillUpdateCache[key] = cache
+		tradeLedgerFillUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tradeLedgerFillQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for trade_ledger_fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for trade_ledger_fill")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TradeLedgerFillSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tradeLedgerFillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"trade_ledger_fill\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tradeLedgerFillPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in tradeLedgerFill slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all tradeLedgerFill")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single TradeLedgerFill record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TradeLedgerFill) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no TradeLedgerFill provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tradeLedgerFillPrimaryKeyMapping)
+	sql := "DELETE FROM \"trade_ledger_fill\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from trade_ledger_fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for trade_ledger_fill")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tradeLedgerFillQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no tradeLedgerFillQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from trade_ledger_fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for trade_ledger_fill")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TradeLedgerFillSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tradeLedgerFillBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tradeLedgerFillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"trade_ledger_fill\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tradeLedgerFillPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from tradeLedgerFill slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for trade_ledger_fill")
+	}
+
+	if len(tradeLedgerFillAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TradeLedgerFill) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTradeLedgerFill(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TradeLedgerFillSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TradeLedgerFillSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tradeLedgerFillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"trade_ledger_fill\".* FROM \"trade_ledger_fill\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tradeLedgerFillPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in TradeLedgerFillSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TradeLedgerFillExists checks if the TradeLedgerFill row exists.
+func TradeLedgerFillExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"trade_ledger_fill\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if trade_ledger_fill exists")
+	}
+
+	return exists, nil
+}
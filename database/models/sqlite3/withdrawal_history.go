@@ -33,6 +33,7 @@ type WithdrawalHistory struct {
 	WithdrawType   int64       `boil:"withdraw_type" json:"withdraw_type" toml:"withdraw_type" yaml:"withdraw_type"`
 	CreatedAt      string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	TxID           null.String `boil:"tx_id" json:"tx_id,omitempty" toml:"tx_id" yaml:"tx_id,omitempty"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +50,7 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType   string
 	CreatedAt      string
 	UpdatedAt      string
+	TxID           string
 }{
 	ID:             "id",
 	ExchangeNameID: "exchange_name_id",
@@ -60,6 +62,7 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType:   "withdraw_type",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	TxID:           "tx_id",
 }
 
 // Generated where
@@ -75,6 +78,7 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType   whereHelperint64
 	CreatedAt      whereHelperstring
 	UpdatedAt      whereHelperstring
+	TxID           whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
@@ -86,6 +90,7 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType:   whereHelperint64{field: "\"withdrawal_history\".\"withdraw_type\""},
 	CreatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"updated_at\""},
+	TxID:           whereHelpernull_String{field: "\"withdrawal_history\".\"tx_id\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -115,8 +120,8 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at"}
-	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "tx_id"}
+	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "tx_id"}
 	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
@@ -0,0 +1,276 @@
+package carrysnapshot
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves carry snapshots to the database, a snapshot of a contract
+// already stored for the same timestamp is ignored
+func (db *DBService) Insert(snapshots ...Snapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, snapshots...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, snapshots...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns the carry snapshots of an underlying between the dates
+// ordered by time. All exchanges are returned when the exchange name is empty
+func (db *DBService) GetInRange(exchangeName, underlyingBase, underlyingQuote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(exchangeName, underlyingBase, underlyingQuote, startDate, endDate)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(exchangeName, underlyingBase, underlyingQuote, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	exchangeIDs := make(map[string]string)
+	for i := range snapshots {
+		s := &snapshots[i]
+		name := strings.ToLower(s.ExchangeName)
+		exchangeID, ok := exchangeIDs[name]
+		if !ok {
+			exch, err := sqlite3.Exchanges(qm.Where("name = ?", name)).One(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("could not retrieve exchange '%v', %w", s.ExchangeName, err)
+			}
+			exchangeID = exch.ID
+			exchangeIDs[name] = exchangeID
+		}
+		if s.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			s.ID = freshUUID.String()
+		}
+		tempSnapshot := sqlite3.CarrySnapshot{
+			ID:                    s.ID,
+			ExchangeNameID:        exchangeID,
+			Asset:                 strings.ToLower(s.Asset),
+			Base:                  strings.ToUpper(s.Base),
+			Quote:                 strings.ToUpper(s.Quote),
+			UnderlyingBase:        strings.ToUpper(s.UnderlyingBase),
+			UnderlyingQuote:       strings.ToUpper(s.UnderlyingQuote),
+			ContractType:          s.ContractType,
+			Expiry:                null.NewString(s.Expiry.UTC().Format(time.RFC3339), !s.Expiry.IsZero()),
+			SpotPrice:             s.SpotPrice,
+			ContractPrice:         s.ContractPrice,
+			Basis:                 s.Basis,
+			AnnualisedBasis:       s.AnnualisedBasis,
+			FundingRate:           s.FundingRate,
+			AnnualisedFundingRate: s.AnnualisedFundingRate,
+			Timestamp:             s.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if err := tempSnapshot.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	exchangeIDs := make(map[string]string)
+	for i := range snapshots {
+		s := &snapshots[i]
+		name := strings.ToLower(s.ExchangeName)
+		exchangeID, ok := exchangeIDs[name]
+		if !ok {
+			exch, err := postgres.Exchanges(qm.Where("name = ?", name)).One(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("could not retrieve exchange '%v', %w", s.ExchangeName, err)
+			}
+			exchangeID = exch.ID
+			exchangeIDs[name] = exchangeID
+		}
+		if s.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			s.ID = freshUUID.String()
+		}
+		tempSnapshot := postgres.CarrySnapshot{
+			ID:                    s.ID,
+			ExchangeNameID:        exchangeID,
+			Asset:                 strings.ToLower(s.Asset),
+			Base:                  strings.ToUpper(s.Base),
+			Quote:                 strings.ToUpper(s.Quote),
+			UnderlyingBase:        strings.ToUpper(s.UnderlyingBase),
+			UnderlyingQuote:       strings.ToUpper(s.UnderlyingQuote),
+			ContractType:          s.ContractType,
+			Expiry:                null.NewTime(s.Expiry.UTC(), !s.Expiry.IsZero()),
+			SpotPrice:             s.SpotPrice,
+			ContractPrice:         s.ContractPrice,
+			Basis:                 s.Basis,
+			AnnualisedBasis:       s.AnnualisedBasis,
+			FundingRate:           s.FundingRate,
+			AnnualisedFundingRate: s.AnnualisedFundingRate,
+			Timestamp:             s.Timestamp.UTC(),
+		}
+		if err := tempSnapshot.Upsert(ctx, tx, false, []string{"exchange_name_id", "asset", "base", "quote", "timestamp"}, boil.Infer(), boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, underlyingBase, underlyingQuote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	mods := []qm.QueryMod{
+		qm.Load(sqlite3.CarrySnapshotRels.ExchangeName),
+		qm.Where("underlying_base = ? AND underlying_quote = ? AND timestamp BETWEEN ? AND ?",
+			strings.ToUpper(underlyingBase),
+			strings.ToUpper(underlyingQuote),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	}
+	if exchangeName != "" {
+		exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		mods = append(mods, qm.Where("exchange_name_id = ?", exch.ID))
+	}
+	results, err := sqlite3.CarrySnapshots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i, r := range results {
+		resp[i] = Snapshot{
+			ID:                    r.ID,
+			ExchangeName:          r.R.ExchangeName.Name,
+			Asset:                 r.Asset,
+			Base:                  r.Base,
+			Quote:                 r.Quote,
+			UnderlyingBase:        r.UnderlyingBase,
+			UnderlyingQuote:       r.UnderlyingQuote,
+			ContractType:          r.ContractType,
+			SpotPrice:             r.SpotPrice,
+			ContractPrice:         r.ContractPrice,
+			Basis:                 r.Basis,
+			AnnualisedBasis:       r.AnnualisedBasis,
+			FundingRate:           r.FundingRate,
+			AnnualisedFundingRate: r.AnnualisedFundingRate,
+		}
+		if resp[i].Timestamp, err = time.Parse(time.RFC3339, r.Timestamp); err != nil {
+			return nil, err
+		}
+		if r.Expiry.Valid {
+			if resp[i].Expiry, err = time.Parse(time.RFC3339, r.Expiry.String); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(exchangeName, underlyingBase, underlyingQuote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	mods := []qm.QueryMod{
+		qm.Load(postgres.CarrySnapshotRels.ExchangeName),
+		qm.Where("underlying_base = ? AND underlying_quote = ? AND timestamp BETWEEN ? AND ?",
+			strings.ToUpper(underlyingBase),
+			strings.ToUpper(underlyingQuote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp"),
+	}
+	if exchangeName != "" {
+		exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		mods = append(mods, qm.Where("exchange_name_id = ?", exch.ID))
+	}
+	results, err := postgres.CarrySnapshots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i, r := range results {
+		resp[i] = Snapshot{
+			ID:                    r.ID,
+			ExchangeName:          r.R.ExchangeName.Name,
+			Asset:                 r.Asset,
+			Base:                  r.Base,
+			Quote:                 r.Quote,
+			UnderlyingBase:        r.UnderlyingBase,
+			UnderlyingQuote:       r.UnderlyingQuote,
+			ContractType:          r.ContractType,
+			SpotPrice:             r.SpotPrice,
+			ContractPrice:         r.ContractPrice,
+			Basis:                 r.Basis,
+			AnnualisedBasis:       r.AnnualisedBasis,
+			FundingRate:           r.FundingRate,
+			AnnualisedFundingRate: r.AnnualisedFundingRate,
+			Timestamp:             r.Timestamp,
+		}
+		if r.Expiry.Valid {
+			resp[i].Expiry = r.Expiry.Time
+		}
+	}
+	return resp, nil
+}
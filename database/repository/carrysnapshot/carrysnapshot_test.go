@@ -0,0 +1,173 @@
+package carrysnapshot
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestCarrySnapshot(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			expiry := time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC)
+			snapshots := []Snapshot{
+				{
+					ExchangeName:          "one",
+					Asset:                 "perpetualswap",
+					Base:                  "btc",
+					Quote:                 "usdt",
+					UnderlyingBase:        "btc",
+					UnderlyingQuote:       "usdt",
+					ContractType:          "perpetual",
+					SpotPrice:             42000,
+					ContractPrice:         42042,
+					Basis:                 0.001,
+					FundingRate:           0.0001,
+					AnnualisedFundingRate: 0.1095,
+					Timestamp:             start,
+				},
+				{
+					ExchangeName:    "two",
+					Asset:           "futures",
+					Base:            "btc",
+					Quote:           "usdt240329",
+					UnderlyingBase:  "btc",
+					UnderlyingQuote: "usdt",
+					ContractType:    "quarterly",
+					Expiry:          expiry,
+					SpotPrice:       42000,
+					ContractPrice:   43000,
+					Basis:           0.0238,
+					AnnualisedBasis: 0.0988,
+					Timestamp:       start.Add(time.Minute),
+				},
+				{
+					ExchangeName:    "one",
+					Asset:           "spot",
+					Base:            "eth",
+					Quote:           "usdt",
+					UnderlyingBase:  "eth",
+					UnderlyingQuote: "usdt",
+					ContractType:    "perpetual",
+					Timestamp:       start,
+				},
+			}
+			require.NoError(t, db.Insert(snapshots...), "Insert must not error")
+			assert.NotEmpty(t, snapshots[0].ID, "Insert should set the snapshot ID")
+
+			got, err := db.GetInRange("", "BTC", "USDT", start, start.Add(time.Hour))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 2, "GetInRange must return the snapshots of the underlying")
+			assert.Equal(t, "one", got[0].ExchangeName, "ExchangeName should be correct")
+			assert.Equal(t, "BTC", got[0].Base, "Base should be correct")
+			assert.Equal(t, 0.1095, got[0].AnnualisedFundingRate, "AnnualisedFundingRate should be correct")
+			assert.True(t, got[0].Expiry.IsZero(), "Expiry should be empty for a perpetual")
+			assert.True(t, got[0].Timestamp.Equal(start), "Timestamp should be correct")
+			assert.Equal(t, "two", got[1].ExchangeName, "Snapshots should be ordered by time")
+			assert.True(t, got[1].Expiry.Equal(expiry), "Expiry should be correct")
+
+			got, err = db.GetInRange("two", "btc", "usdt", start, start.Add(time.Hour))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 1, "GetInRange must only return the exchange's snapshots")
+			assert.Equal(t, 0.0988, got[0].AnnualisedBasis, "AnnualisedBasis should be correct")
+
+			got, err = db.GetInRange("", "btc", "usdt", start.Add(time.Hour), start.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			assert.Empty(t, got, "GetInRange should not return snapshots outside the range")
+
+			_, err = db.GetInRange("bad", "btc", "usdt", start, start.Add(time.Hour))
+			assert.Error(t, err, "GetInRange should error for an unknown exchange")
+
+			err = db.Insert(Snapshot{ExchangeName: "bad", Timestamp: start})
+			assert.Error(t, err, "Insert should error for an unknown exchange")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package carrysnapshot
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Snapshot is a DTO for the funding rate and basis of a futures contract
+// against its underlying at a point in time
+type Snapshot struct {
+	ID                    string
+	ExchangeName          string
+	Asset                 string
+	Base                  string
+	Quote                 string
+	UnderlyingBase        string
+	UnderlyingQuote       string
+	ContractType          string
+	Expiry                time.Time
+	SpotPrice             float64
+	ContractPrice         float64
+	Basis                 float64
+	AnnualisedBasis       float64
+	FundingRate           float64
+	AnnualisedFundingRate float64
+	Timestamp             time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the carry snapshot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(snapshots ...Snapshot) error
+	GetInRange(exchangeName, underlyingBase, underlyingQuote string, startDate, endDate time.Time) ([]Snapshot, error)
+}
@@ -0,0 +1,552 @@
+package futuresposition
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates positions in the database. A position's orders,
+// PNL history and funding payments are replaced with the ones provided
+func (db *DBService) Upsert(positions ...*Position) error {
+	if len(positions) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, positions...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, positions...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAll returns every stored position with its orders, PNL history and
+// funding payments
+func (db *DBService) GetAll() ([]Position, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getAllSQLite()
+	case database.DBPostgreSQL:
+		return db.getAllPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// sameOpeningTime allows for the precision lost when a timestamp is stored
+func sameOpeningTime(a, b time.Time) bool {
+	diff := a.Sub(b)
+	return diff < time.Microsecond && diff > -time.Microsecond
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, positions ...*Position) error {
+	for i := range positions {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(positions[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", positions[i].ExchangeName, err)
+		}
+		existing, err := sqlite3.FuturesPositions(
+			qm.Where("exchange_name_id = ? AND account = ? AND asset = ? AND base = ? AND quote = ?",
+				exch.ID,
+				positions[i].Account,
+				strings.ToLower(positions[i].Asset),
+				strings.ToUpper(positions[i].Base),
+				strings.ToUpper(positions[i].Quote))).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		positions[i].ID = ""
+		for j := range existing {
+			openingTime, err := time.Parse(time.RFC3339, existing[j].OpeningTime)
+			if err != nil {
+				return err
+			}
+			if sameOpeningTime(openingTime, positions[i].OpeningDate) {
+				positions[i].ID = existing[j].ID
+				break
+			}
+		}
+		isNew := positions[i].ID == ""
+		if isNew {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			positions[i].ID = freshUUID.String()
+		}
+		tempPosition := sqlite3.FuturesPosition{
+			ID:                 positions[i].ID,
+			ExchangeNameID:     exch.ID,
+			Account:            positions[i].Account,
+			Asset:              strings.ToLower(positions[i].Asset),
+			Base:               strings.ToUpper(positions[i].Base),
+			Quote:              strings.ToUpper(positions[i].Quote),
+			Underlying:         strings.ToUpper(positions[i].Underlying),
+			CollateralCurrency: strings.ToUpper(positions[i].CollateralCurrency),
+			Status:             positions[i].Status,
+			OpeningDirection:   positions[i].OpeningDirection,
+			OpeningPrice:       positions[i].OpeningPrice,
+			OpeningSize:        positions[i].OpeningSize,
+			OpeningTime:        positions[i].OpeningDate.UTC().Format(time.RFC3339Nano),
+			LatestDirection:    positions[i].LatestDirection,
+			LatestPrice:        positions[i].LatestPrice,
+			LatestSize:         positions[i].LatestSize,
+			RealisedPNL:        positions[i].RealisedPNL,
+			UnrealisedPNL:      positions[i].UnrealisedPNL,
+			ClosingPrice:       positions[i].ClosingPrice,
+			ClosingTime:        null.NewString(positions[i].ClosingDate.UTC().Format(time.RFC3339Nano), !positions[i].ClosingDate.IsZero()),
+			LastUpdated:        positions[i].LastUpdated.UTC().Format(time.RFC3339Nano),
+		}
+		if isNew {
+			err = tempPosition.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempPosition.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = sqlite3.FuturesPositionOrders(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if _, err = sqlite3.FuturesPositionPNLEntries(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if _, err = sqlite3.FuturesPositionFundings(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		for j := range positions[i].Orders {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			o := &positions[i].Orders[j]
+			tempOrder := sqlite3.FuturesPositionOrder{
+				ID:             freshUUID.String(),
+				PositionID:     positions[i].ID,
+				OrderID:        o.OrderID,
+				Side:           o.Side,
+				Type:           o.Type,
+				Status:         o.Status,
+				Price:          o.Price,
+				Amount:         o.Amount,
+				ExecutedAmount: o.ExecutedAmount,
+				Fee:            o.Fee,
+				Leverage:       o.Leverage,
+				Date:           o.Date.UTC().Format(time.RFC3339Nano),
+			}
+			if err = tempOrder.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		for j := range positions[i].PNLHistory {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			p := &positions[i].PNLHistory[j]
+			var isLiquidated, isOrder int64
+			if p.IsLiquidated {
+				isLiquidated = 1
+			}
+			if p.IsOrder {
+				isOrder = 1
+			}
+			tempEntry := sqlite3.FuturesPositionPNLEntry{
+				ID:                    freshUUID.String(),
+				PositionID:            positions[i].ID,
+				Timestamp:             p.Time.UTC().Format(time.RFC3339Nano),
+				Status:                p.Status,
+				UnrealisedPNL:         p.UnrealisedPNL,
+				RealisedPNLBeforeFees: p.RealisedPNLBeforeFees,
+				RealisedPNL:           p.RealisedPNL,
+				Price:                 p.Price,
+				Exposure:              p.Exposure,
+				Direction:             p.Direction,
+				Fee:                   p.Fee,
+				IsLiquidated:          isLiquidated,
+				IsOrder:               isOrder,
+			}
+			if err = tempEntry.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		for j := range positions[i].FundingPayments {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			tempFunding := sqlite3.FuturesPositionFunding{
+				ID:         freshUUID.String(),
+				PositionID: positions[i].ID,
+				Timestamp:  positions[i].FundingPayments[j].Time.UTC().Format(time.RFC3339Nano),
+				Rate:       positions[i].FundingPayments[j].Rate,
+				Payment:    positions[i].FundingPayments[j].Payment,
+			}
+			if err = tempFunding.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, positions ...*Position) error {
+	for i := range positions {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(positions[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", positions[i].ExchangeName, err)
+		}
+		existing, err := postgres.FuturesPositions(
+			qm.Where("exchange_name_id = ? AND account = ? AND asset = ? AND base = ? AND quote = ?",
+				exch.ID,
+				positions[i].Account,
+				strings.ToLower(positions[i].Asset),
+				strings.ToUpper(positions[i].Base),
+				strings.ToUpper(positions[i].Quote))).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		positions[i].ID = ""
+		for j := range existing {
+			if sameOpeningTime(existing[j].OpeningTime, positions[i].OpeningDate) {
+				positions[i].ID = existing[j].ID
+				break
+			}
+		}
+		isNew := positions[i].ID == ""
+		if isNew {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			positions[i].ID = freshUUID.String()
+		}
+		tempPosition := postgres.FuturesPosition{
+			ID:                 positions[i].ID,
+			ExchangeNameID:     exch.ID,
+			Account:            positions[i].Account,
+			Asset:              strings.ToLower(positions[i].Asset),
+			Base:               strings.ToUpper(positions[i].Base),
+			Quote:              strings.ToUpper(positions[i].Quote),
+			Underlying:         strings.ToUpper(positions[i].Underlying),
+			CollateralCurrency: strings.ToUpper(positions[i].CollateralCurrency),
+			Status:             positions[i].Status,
+			OpeningDirection:   positions[i].OpeningDirection,
+			OpeningPrice:       positions[i].OpeningPrice,
+			OpeningSize:        positions[i].OpeningSize,
+			OpeningTime:        positions[i].OpeningDate.UTC(),
+			LatestDirection:    positions[i].LatestDirection,
+			LatestPrice:        positions[i].LatestPrice,
+			LatestSize:         positions[i].LatestSize,
+			RealisedPNL:        positions[i].RealisedPNL,
+			UnrealisedPNL:      positions[i].UnrealisedPNL,
+			ClosingPrice:       positions[i].ClosingPrice,
+			ClosingTime:        null.NewTime(positions[i].ClosingDate.UTC(), !positions[i].ClosingDate.IsZero()),
+			LastUpdated:        positions[i].LastUpdated.UTC(),
+		}
+		if isNew {
+			err = tempPosition.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempPosition.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = postgres.FuturesPositionOrders(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if _, err = postgres.FuturesPositionPNLEntries(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if _, err = postgres.FuturesPositionFundings(qm.Where("position_id = ?", positions[i].ID)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		for j := range positions[i].Orders {
+			o := &positions[i].Orders[j]
+			tempOrder := postgres.FuturesPositionOrder{
+				PositionID:     positions[i].ID,
+				OrderID:        o.OrderID,
+				Side:           o.Side,
+				Type:           o.Type,
+				Status:         o.Status,
+				Price:          o.Price,
+				Amount:         o.Amount,
+				ExecutedAmount: o.ExecutedAmount,
+				Fee:            o.Fee,
+				Leverage:       o.Leverage,
+				Date:           o.Date.UTC(),
+			}
+			if err = tempOrder.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		for j := range positions[i].PNLHistory {
+			p := &positions[i].PNLHistory[j]
+			tempEntry := postgres.FuturesPositionPNLEntry{
+				PositionID:            positions[i].ID,
+				Timestamp:             p.Time.UTC(),
+				Status:                p.Status,
+				UnrealisedPNL:         p.UnrealisedPNL,
+				RealisedPNLBeforeFees: p.RealisedPNLBeforeFees,
+				RealisedPNL:           p.RealisedPNL,
+				Price:                 p.Price,
+				Exposure:              p.Exposure,
+				Direction:             p.Direction,
+				Fee:                   p.Fee,
+				IsLiquidated:          p.IsLiquidated,
+				IsOrder:               p.IsOrder,
+			}
+			if err = tempEntry.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		for j := range positions[i].FundingPayments {
+			tempFunding := postgres.FuturesPositionFunding{
+				PositionID: positions[i].ID,
+				Timestamp:  positions[i].FundingPayments[j].Time.UTC(),
+				Rate:       positions[i].FundingPayments[j].Rate,
+				Payment:    positions[i].FundingPayments[j].Payment,
+			}
+			if err = tempFunding.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getAllSQLite() ([]Position, error) {
+	results, err := sqlite3.FuturesPositions(
+		qm.Load(sqlite3.FuturesPositionRels.ExchangeName),
+		qm.Load(sqlite3.FuturesPositionRels.PositionFuturesPositionOrders),
+		qm.Load(sqlite3.FuturesPositionRels.PositionFuturesPositionPNLEntries),
+		qm.Load(sqlite3.FuturesPositionRels.PositionFuturesPositionFundings)).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Position, len(results))
+	for i := range results {
+		r := results[i]
+		p := &resp[i]
+		*p = Position{
+			ID:                 r.ID,
+			ExchangeName:       r.R.ExchangeName.Name,
+			Account:            r.Account,
+			Asset:              r.Asset,
+			Base:               r.Base,
+			Quote:              r.Quote,
+			Underlying:         r.Underlying,
+			CollateralCurrency: r.CollateralCurrency,
+			Status:             r.Status,
+			OpeningDirection:   r.OpeningDirection,
+			OpeningPrice:       r.OpeningPrice,
+			OpeningSize:        r.OpeningSize,
+			LatestDirection:    r.LatestDirection,
+			LatestPrice:        r.LatestPrice,
+			LatestSize:         r.LatestSize,
+			RealisedPNL:        r.RealisedPNL,
+			UnrealisedPNL:      r.UnrealisedPNL,
+			ClosingPrice:       r.ClosingPrice,
+		}
+		if p.OpeningDate, err = time.Parse(time.RFC3339, r.OpeningTime); err != nil {
+			return nil, err
+		}
+		if p.LastUpdated, err = time.Parse(time.RFC3339, r.LastUpdated); err != nil {
+			return nil, err
+		}
+		if r.ClosingTime.Valid {
+			if p.ClosingDate, err = time.Parse(time.RFC3339, r.ClosingTime.String); err != nil {
+				return nil, err
+			}
+		}
+		p.Orders = make([]Order, len(r.R.PositionFuturesPositionOrders))
+		for j, o := range r.R.PositionFuturesPositionOrders {
+			p.Orders[j] = Order{
+				OrderID:        o.OrderID,
+				Side:           o.Side,
+				Type:           o.Type,
+				Status:         o.Status,
+				Price:          o.Price,
+				Amount:         o.Amount,
+				ExecutedAmount: o.ExecutedAmount,
+				Fee:            o.Fee,
+				Leverage:       o.Leverage,
+			}
+			if p.Orders[j].Date, err = time.Parse(time.RFC3339, o.Date); err != nil {
+				return nil, err
+			}
+		}
+		p.PNLHistory = make([]PNLEntry, len(r.R.PositionFuturesPositionPNLEntries))
+		for j, e := range r.R.PositionFuturesPositionPNLEntries {
+			p.PNLHistory[j] = PNLEntry{
+				Status:                e.Status,
+				UnrealisedPNL:         e.UnrealisedPNL,
+				RealisedPNLBeforeFees: e.RealisedPNLBeforeFees,
+				RealisedPNL:           e.RealisedPNL,
+				Price:                 e.Price,
+				Exposure:              e.Exposure,
+				Direction:             e.Direction,
+				Fee:                   e.Fee,
+				IsLiquidated:          e.IsLiquidated == 1,
+				IsOrder:               e.IsOrder == 1,
+			}
+			if p.PNLHistory[j].Time, err = time.Parse(time.RFC3339, e.Timestamp); err != nil {
+				return nil, err
+			}
+		}
+		p.FundingPayments = make([]FundingPayment, len(r.R.PositionFuturesPositionFundings))
+		for j, f := range r.R.PositionFuturesPositionFundings {
+			p.FundingPayments[j] = FundingPayment{
+				Rate:    f.Rate,
+				Payment: f.Payment,
+			}
+			if p.FundingPayments[j].Time, err = time.Parse(time.RFC3339, f.Timestamp); err != nil {
+				return nil, err
+			}
+		}
+		// RFC3339Nano strings do not sort lexically, so order once parsed
+		slices.SortFunc(p.Orders, func(a, b Order) int { return a.Date.Compare(b.Date) })
+		slices.SortFunc(p.PNLHistory, func(a, b PNLEntry) int { return a.Time.Compare(b.Time) })
+		slices.SortFunc(p.FundingPayments, func(a, b FundingPayment) int { return a.Time.Compare(b.Time) })
+	}
+	slices.SortFunc(resp, func(a, b Position) int { return a.OpeningDate.Compare(b.OpeningDate) })
+	return resp, nil
+}
+
+func (db *DBService) getAllPostgres() ([]Position, error) {
+	results, err := postgres.FuturesPositions(
+		qm.Load(postgres.FuturesPositionRels.ExchangeName),
+		qm.Load(postgres.FuturesPositionRels.PositionFuturesPositionOrders, qm.OrderBy("date")),
+		qm.Load(postgres.FuturesPositionRels.PositionFuturesPositionPNLEntries, qm.OrderBy("timestamp")),
+		qm.Load(postgres.FuturesPositionRels.PositionFuturesPositionFundings, qm.OrderBy("timestamp")),
+		qm.OrderBy("opening_time")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Position, len(results))
+	for i := range results {
+		r := results[i]
+		resp[i] = Position{
+			ID:                 r.ID,
+			ExchangeName:       r.R.ExchangeName.Name,
+			Account:            r.Account,
+			Asset:              r.Asset,
+			Base:               r.Base,
+			Quote:              r.Quote,
+			Underlying:         r.Underlying,
+			CollateralCurrency: r.CollateralCurrency,
+			Status:             r.Status,
+			OpeningDirection:   r.OpeningDirection,
+			OpeningPrice:       r.OpeningPrice,
+			OpeningSize:        r.OpeningSize,
+			OpeningDate:        r.OpeningTime,
+			LatestDirection:    r.LatestDirection,
+			LatestPrice:        r.LatestPrice,
+			LatestSize:         r.LatestSize,
+			RealisedPNL:        r.RealisedPNL,
+			UnrealisedPNL:      r.UnrealisedPNL,
+			ClosingPrice:       r.ClosingPrice,
+			LastUpdated:        r.LastUpdated,
+			Orders:             make([]Order, len(r.R.PositionFuturesPositionOrders)),
+			PNLHistory:         make([]PNLEntry, len(r.R.PositionFuturesPositionPNLEntries)),
+			FundingPayments:    make([]FundingPayment, len(r.R.PositionFuturesPositionFundings)),
+		}
+		if r.ClosingTime.Valid {
+			resp[i].ClosingDate = r.ClosingTime.Time
+		}
+		for j, o := range r.R.PositionFuturesPositionOrders {
+			resp[i].Orders[j] = Order{
+				OrderID:        o.OrderID,
+				Side:           o.Side,
+				Type:           o.Type,
+				Status:         o.Status,
+				Price:          o.Price,
+				Amount:         o.Amount,
+				ExecutedAmount: o.ExecutedAmount,
+				Fee:            o.Fee,
+				Leverage:       o.Leverage,
+				Date:           o.Date,
+			}
+		}
+		for j, e := range r.R.PositionFuturesPositionPNLEntries {
+			resp[i].PNLHistory[j] = PNLEntry{
+				Time:                  e.Timestamp,
+				Status:                e.Status,
+				UnrealisedPNL:         e.UnrealisedPNL,
+				RealisedPNLBeforeFees: e.RealisedPNLBeforeFees,
+				RealisedPNL:           e.RealisedPNL,
+				Price:                 e.Price,
+				Exposure:              e.Exposure,
+				Direction:             e.Direction,
+				Fee:                   e.Fee,
+				IsLiquidated:          e.IsLiquidated,
+				IsOrder:               e.IsOrder,
+			}
+		}
+		for j, f := range r.R.PositionFuturesPositionFundings {
+			resp[i].FundingPayments[j] = FundingPayment{
+				Time:    f.Timestamp,
+				Rate:    f.Rate,
+				Payment: f.Payment,
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,160 @@
+package futuresposition
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestFuturesPosition(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			opened := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			pos := &Position{
+				ExchangeName:     "one",
+				Account:          "main",
+				Asset:            "futures",
+				Base:             "btc",
+				Quote:            "usdt",
+				Underlying:       "btc",
+				Status:           "OPEN",
+				OpeningDirection: "LONG",
+				OpeningPrice:     1337,
+				OpeningSize:      1,
+				OpeningDate:      opened,
+				LatestDirection:  "LONG",
+				LatestPrice:      1338,
+				LatestSize:       1,
+				UnrealisedPNL:    1,
+				LastUpdated:      opened.Add(time.Hour),
+				Orders: []Order{
+					{OrderID: "1", Side: "LONG", Type: "MARKET", Status: "FILLED", Price: 1337, Amount: 1, ExecutedAmount: 1, Date: opened},
+				},
+				PNLHistory: []PNLEntry{
+					{Time: opened, Status: "OPEN", Price: 1337, Exposure: 1, Direction: "LONG", IsOrder: true},
+					{Time: opened.Add(time.Millisecond), Status: "OPEN", Price: 1337, Exposure: 1, Direction: "LONG"},
+				},
+				FundingPayments: []FundingPayment{
+					{Time: opened.Add(time.Hour), Rate: 0.0001, Payment: -0.1337},
+				},
+			}
+			require.NoError(t, db.Upsert(pos), "Upsert must not error")
+			require.NotEmpty(t, pos.ID, "Upsert must set the position ID")
+			id := pos.ID
+
+			pos.Status = "CLOSED"
+			pos.ClosingDate = opened.Add(time.Hour * 2)
+			pos.ClosingPrice = 1339
+			pos.Orders = append(pos.Orders, Order{OrderID: "2", Side: "SHORT", Type: "MARKET", Status: "FILLED", Price: 1339, Amount: 1, ExecutedAmount: 1, Date: pos.ClosingDate})
+			require.NoError(t, db.Upsert(pos), "Upsert must not error when updating a position")
+			assert.Equal(t, id, pos.ID, "Upsert should update the existing position")
+
+			positions, err := db.GetAll()
+			require.NoError(t, err, "GetAll must not error")
+			require.Len(t, positions, 1, "GetAll must return the stored position")
+			got := positions[0]
+			assert.Equal(t, "one", got.ExchangeName, "ExchangeName should be correct")
+			assert.Equal(t, "main", got.Account, "Account should be correct")
+			assert.Equal(t, "BTC", got.Base, "Base should be correct")
+			assert.Equal(t, "CLOSED", got.Status, "Status should be updated")
+			assert.True(t, got.ClosingDate.Equal(pos.ClosingDate), "ClosingDate should be updated")
+			require.Len(t, got.Orders, 2, "Orders must be replaced")
+			assert.Equal(t, "2", got.Orders[1].OrderID, "Orders should be sorted by date")
+			require.Len(t, got.PNLHistory, 2, "PNLHistory must be stored")
+			assert.True(t, got.PNLHistory[0].IsOrder, "IsOrder should be stored")
+			assert.True(t, got.PNLHistory[0].Time.Before(got.PNLHistory[1].Time), "PNLHistory should be sorted by time")
+			require.Len(t, got.FundingPayments, 1, "FundingPayments must be stored")
+			assert.Equal(t, -0.1337, got.FundingPayments[0].Payment, "Payment should be correct")
+
+			err = db.Upsert(&Position{ExchangeName: "bad", OpeningDate: opened})
+			assert.Error(t, err, "Upsert should error for an unknown exchange")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
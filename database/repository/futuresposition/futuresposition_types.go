@@ -0,0 +1,85 @@
+package futuresposition
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Position is a DTO for a tracked futures position and its history
+type Position struct {
+	ID                 string
+	ExchangeName       string
+	Account            string
+	Asset              string
+	Base               string
+	Quote              string
+	Underlying         string
+	CollateralCurrency string
+	Status             string
+	OpeningDirection   string
+	OpeningPrice       float64
+	OpeningSize        float64
+	OpeningDate        time.Time
+	LatestDirection    string
+	LatestPrice        float64
+	LatestSize         float64
+	RealisedPNL        float64
+	UnrealisedPNL      float64
+	ClosingPrice       float64
+	ClosingDate        time.Time
+	LastUpdated        time.Time
+	Orders             []Order
+	PNLHistory         []PNLEntry
+	FundingPayments    []FundingPayment
+}
+
+// Order is a DTO for an order which makes up a futures position
+type Order struct {
+	OrderID        string
+	Side           string
+	Type           string
+	Status         string
+	Price          float64
+	Amount         float64
+	ExecutedAmount float64
+	Fee            float64
+	Leverage       float64
+	Date           time.Time
+}
+
+// PNLEntry is a DTO for a point in a futures position's PNL history
+type PNLEntry struct {
+	Time                  time.Time
+	Status                string
+	UnrealisedPNL         float64
+	RealisedPNLBeforeFees float64
+	RealisedPNL           float64
+	Price                 float64
+	Exposure              float64
+	Direction             string
+	Fee                   float64
+	IsLiquidated          bool
+	IsOrder               bool
+}
+
+// FundingPayment is a DTO for a funding rate applied to a futures position
+type FundingPayment struct {
+	Time    time.Time
+	Rate    float64
+	Payment float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the futures position database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(positions ...*Position) error
+	GetAll() ([]Position, error)
+}
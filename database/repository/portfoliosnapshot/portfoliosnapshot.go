@@ -0,0 +1,240 @@
+package portfoliosnapshot
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves portfolio snapshots and their holdings to the database
+func (db *DBService) Insert(snapshots ...Snapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, snapshots...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, snapshots...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns the portfolio snapshots valued in a reporting currency
+// between the dates ordered by time
+func (db *DBService) GetInRange(reportingCurrency string, startDate, endDate time.Time) ([]Snapshot, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(reportingCurrency, startDate, endDate)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(reportingCurrency, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		s := &snapshots[i]
+		if s.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			s.ID = freshUUID.String()
+		}
+		tempSnapshot := sqlite3.PortfolioSnapshot{
+			ID:                s.ID,
+			ReportingCurrency: strings.ToUpper(s.ReportingCurrency),
+			TotalValue:        s.TotalValue,
+			Timestamp:         s.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if err := tempSnapshot.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		for j := range s.Holdings {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			h := &s.Holdings[j]
+			tempHolding := sqlite3.PortfolioSnapshotHolding{
+				ID:         freshUUID.String(),
+				SnapshotID: s.ID,
+				Source:     h.Source,
+				Address:    h.Address,
+				Currency:   strings.ToUpper(h.Currency),
+				Amount:     h.Amount,
+				Price:      h.Price,
+				Value:      h.Value,
+			}
+			if err := tempHolding.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		s := &snapshots[i]
+		if s.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			s.ID = freshUUID.String()
+		}
+		tempSnapshot := postgres.PortfolioSnapshot{
+			ID:                s.ID,
+			ReportingCurrency: strings.ToUpper(s.ReportingCurrency),
+			TotalValue:        s.TotalValue,
+			Timestamp:         s.Timestamp.UTC(),
+		}
+		if err := tempSnapshot.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		for j := range s.Holdings {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			h := &s.Holdings[j]
+			tempHolding := postgres.PortfolioSnapshotHolding{
+				ID:         freshUUID.String(),
+				SnapshotID: s.ID,
+				Source:     h.Source,
+				Address:    h.Address,
+				Currency:   strings.ToUpper(h.Currency),
+				Amount:     h.Amount,
+				Price:      h.Price,
+				Value:      h.Value,
+			}
+			if err := tempHolding.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(reportingCurrency string, startDate, endDate time.Time) ([]Snapshot, error) {
+	results, err := sqlite3.PortfolioSnapshots(
+		qm.Load(sqlite3.PortfolioSnapshotRels.SnapshotPortfolioSnapshotHoldings),
+		qm.Where("reporting_currency = ? AND timestamp BETWEEN ? AND ?",
+			strings.ToUpper(reportingCurrency),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i, r := range results {
+		resp[i] = Snapshot{
+			ID:                r.ID,
+			ReportingCurrency: r.ReportingCurrency,
+			TotalValue:        r.TotalValue,
+		}
+		if resp[i].Timestamp, err = time.Parse(time.RFC3339, r.Timestamp); err != nil {
+			return nil, err
+		}
+		resp[i].Holdings = make([]Holding, len(r.R.SnapshotPortfolioSnapshotHoldings))
+		for j, h := range r.R.SnapshotPortfolioSnapshotHoldings {
+			resp[i].Holdings[j] = Holding{
+				Source:   h.Source,
+				Address:  h.Address,
+				Currency: h.Currency,
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(reportingCurrency string, startDate, endDate time.Time) ([]Snapshot, error) {
+	results, err := postgres.PortfolioSnapshots(
+		qm.Load(postgres.PortfolioSnapshotRels.SnapshotPortfolioSnapshotHoldings),
+		qm.Where("reporting_currency = ? AND timestamp BETWEEN ? AND ?",
+			strings.ToUpper(reportingCurrency),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i, r := range results {
+		resp[i] = Snapshot{
+			ID:                r.ID,
+			ReportingCurrency: r.ReportingCurrency,
+			TotalValue:        r.TotalValue,
+			Timestamp:         r.Timestamp,
+		}
+		resp[i].Holdings = make([]Holding, len(r.R.SnapshotPortfolioSnapshotHoldings))
+		for j, h := range r.R.SnapshotPortfolioSnapshotHoldings {
+			resp[i].Holdings[j] = Holding{
+				Source:   h.Source,
+				Address:  h.Address,
+				Currency: h.Currency,
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp, nil
+}
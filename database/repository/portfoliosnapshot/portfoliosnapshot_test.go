@@ -0,0 +1,125 @@
+package portfoliosnapshot
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestPortfolioSnapshot(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			snapshots := []Snapshot{
+				{
+					ReportingCurrency: "usd",
+					TotalValue:        52000,
+					Timestamp:         start,
+					Holdings: []Holding{
+						{Source: "Binance", Currency: "btc", Amount: 1, Price: 42000, Value: 42000},
+						{Source: "Personal", Address: "bc1q", Currency: "eth", Amount: 4, Price: 2500, Value: 10000},
+					},
+				},
+				{
+					ReportingCurrency: "USD",
+					TotalValue:        53000,
+					Timestamp:         start.Add(time.Hour),
+					Holdings: []Holding{
+						{Source: "Binance", Currency: "BTC", Amount: 1, Price: 43000, Value: 43000},
+					},
+				},
+				{
+					ReportingCurrency: "AUD",
+					TotalValue:        80000,
+					Timestamp:         start,
+				},
+			}
+			require.NoError(t, db.Insert(snapshots...), "Insert must not error")
+			assert.NotEmpty(t, snapshots[0].ID, "Insert should set the snapshot ID")
+
+			got, err := db.GetInRange("usd", start, start.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 2, "GetInRange must return the snapshots of the reporting currency")
+			assert.Equal(t, "USD", got[0].ReportingCurrency, "ReportingCurrency should be correct")
+			assert.Equal(t, 52000.0, got[0].TotalValue, "TotalValue should be correct")
+			assert.True(t, got[0].Timestamp.Equal(start), "Timestamp should be correct")
+			require.Len(t, got[0].Holdings, 2, "Holdings must be loaded")
+			assert.Equal(t, 53000.0, got[1].TotalValue, "Snapshots should be ordered by time")
+			require.Len(t, got[1].Holdings, 1, "Holdings must be loaded")
+			assert.Equal(t, "BTC", got[1].Holdings[0].Currency, "Currency should be correct")
+			assert.Equal(t, 43000.0, got[1].Holdings[0].Value, "Value should be correct")
+
+			got, err = db.GetInRange("USD", start.Add(time.Hour*2), start.Add(time.Hour*3))
+			require.NoError(t, err, "GetInRange must not error")
+			assert.Empty(t, got, "GetInRange should not return snapshots outside the range")
+
+			err = db.Insert(Snapshot{ReportingCurrency: "USD", Timestamp: start})
+			assert.Error(t, err, "Insert should error for a duplicate snapshot")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package portfoliosnapshot
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Snapshot is a DTO for the value of the portfolio's holdings in a reporting
+// currency at a point in time
+type Snapshot struct {
+	ID                string
+	ReportingCurrency string
+	TotalValue        float64
+	Timestamp         time.Time
+	Holdings          []Holding
+}
+
+// Holding is a DTO for the value of a currency held on an exchange or at a
+// cold storage address
+type Holding struct {
+	// Source is the exchange name, or the portfolio address description for
+	// cold storage addresses
+	Source   string
+	Address  string
+	Currency string
+	Amount   float64
+	Price    float64
+	Value    float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the portfolio snapshot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(snapshots ...Snapshot) error
+	GetInRange(reportingCurrency string, startDate, endDate time.Time) ([]Snapshot, error)
+}
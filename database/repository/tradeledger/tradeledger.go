@@ -0,0 +1,294 @@
+package tradeledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+const (
+	fillWhere      = "exchange = ? AND account = ? AND order_id = ? AND trade_id = ?"
+	orderFillWhere = "exchange = ? AND account = ? AND order_id = ? AND trade_id != ''"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert saves fills to the database. Fills already stored have their fee
+// updated when a non-zero fee is supplied, as websocket fills may arrive
+// before the fee is known, while order level fills are also updated with the
+// latest executed price and amount. A trade level fill replaces the order
+// level fill of its order, and order level fills are ignored once the trades
+// of the order are stored
+func (db *DBService) Upsert(fills ...Fill) error {
+	if len(fills) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, fills...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, fills...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns the fills executed between the dates ordered by time
+func (db *DBService) GetInRange(startDate, endDate time.Time) ([]Fill, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(startDate, endDate)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, fills ...Fill) error {
+	for i := range fills {
+		f := &fills[i]
+		existing, err := sqlite3.TradeLedgerFills(qm.Where(fillWhere, f.Exchange, f.Account, f.OrderID, f.TradeID)).One(ctx, tx)
+		switch {
+		case err == nil:
+			f.ID = existing.ID
+			var cols []string
+			if f.Fee != 0 {
+				existing.Fee = f.Fee
+				existing.FeeCurrency = strings.ToUpper(f.FeeCurrency)
+				cols = append(cols, sqlite3.TradeLedgerFillColumns.Fee, sqlite3.TradeLedgerFillColumns.FeeCurrency)
+			}
+			if f.TradeID == "" {
+				existing.Price = f.Price
+				existing.Amount = f.Amount
+				existing.Timestamp = f.Timestamp.UTC().Format(time.RFC3339)
+				cols = append(cols, sqlite3.TradeLedgerFillColumns.Price, sqlite3.TradeLedgerFillColumns.Amount, sqlite3.TradeLedgerFillColumns.Timestamp)
+			}
+			if len(cols) == 0 {
+				continue
+			}
+			if _, err = existing.Update(ctx, tx, boil.Whitelist(cols...)); err != nil {
+				return err
+			}
+			continue
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+		if f.TradeID == "" {
+			tradesStored, err := sqlite3.TradeLedgerFills(qm.Where(orderFillWhere, f.Exchange, f.Account, f.OrderID)).Exists(ctx, tx)
+			if err != nil {
+				return err
+			}
+			if tradesStored {
+				continue
+			}
+		} else if _, err := sqlite3.TradeLedgerFills(qm.Where(fillWhere, f.Exchange, f.Account, f.OrderID, "")).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if f.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			f.ID = freshUUID.String()
+		}
+		tempFill := sqlite3.TradeLedgerFill{
+			ID:          f.ID,
+			Exchange:    f.Exchange,
+			Account:     f.Account,
+			OrderID:     f.OrderID,
+			TradeID:     f.TradeID,
+			Base:        strings.ToUpper(f.Base),
+			Quote:       strings.ToUpper(f.Quote),
+			Side:        strings.ToUpper(f.Side),
+			Price:       f.Price,
+			Amount:      f.Amount,
+			Fee:         f.Fee,
+			FeeCurrency: strings.ToUpper(f.FeeCurrency),
+			Timestamp:   f.Timestamp.UTC().Format(time.RFC3339),
+		}
+		if err := tempFill.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, fills ...Fill) error {
+	for i := range fills {
+		f := &fills[i]
+		existing, err := postgres.TradeLedgerFills(qm.Where(fillWhere, f.Exchange, f.Account, f.OrderID, f.TradeID)).One(ctx, tx)
+		switch {
+		case err == nil:
+			f.ID = existing.ID
+			var cols []string
+			if f.Fee != 0 {
+				existing.Fee = f.Fee
+				existing.FeeCurrency = strings.ToUpper(f.FeeCurrency)
+				cols = append(cols, postgres.TradeLedgerFillColumns.Fee, postgres.TradeLedgerFillColumns.FeeCurrency)
+			}
+			if f.TradeID == "" {
+				existing.Price = f.Price
+				existing.Amount = f.Amount
+				existing.Timestamp = f.Timestamp.UTC()
+				cols = append(cols, postgres.TradeLedgerFillColumns.Price, postgres.TradeLedgerFillColumns.Amount, postgres.TradeLedgerFillColumns.Timestamp)
+			}
+			if len(cols) == 0 {
+				continue
+			}
+			if _, err = existing.Update(ctx, tx, boil.Whitelist(cols...)); err != nil {
+				return err
+			}
+			continue
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+		if f.TradeID == "" {
+			tradesStored, err := postgres.TradeLedgerFills(qm.Where(orderFillWhere, f.Exchange, f.Account, f.OrderID)).Exists(ctx, tx)
+			if err != nil {
+				return err
+			}
+			if tradesStored {
+				continue
+			}
+		} else if _, err := postgres.TradeLedgerFills(qm.Where(fillWhere, f.Exchange, f.Account, f.OrderID, "")).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+		if f.ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			f.ID = freshUUID.String()
+		}
+		tempFill := postgres.TradeLedgerFill{
+			ID:          f.ID,
+			Exchange:    f.Exchange,
+			Account:     f.Account,
+			OrderID:     f.OrderID,
+			TradeID:     f.TradeID,
+			Base:        strings.ToUpper(f.Base),
+			Quote:       strings.ToUpper(f.Quote),
+			Side:        strings.ToUpper(f.Side),
+			Price:       f.Price,
+			Amount:      f.Amount,
+			Fee:         f.Fee,
+			FeeCurrency: strings.ToUpper(f.FeeCurrency),
+			Timestamp:   f.Timestamp.UTC(),
+		}
+		if err := tempFill.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(startDate, endDate time.Time) ([]Fill, error) {
+	results, err := sqlite3.TradeLedgerFills(
+		qm.Where("timestamp BETWEEN ? AND ?",
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp, id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(results))
+	for i, r := range results {
+		resp[i] = Fill{
+			ID:          r.ID,
+			Exchange:    r.Exchange,
+			Account:     r.Account,
+			OrderID:     r.OrderID,
+			TradeID:     r.TradeID,
+			Base:        r.Base,
+			Quote:       r.Quote,
+			Side:        r.Side,
+			Price:       r.Price,
+			Amount:      r.Amount,
+			Fee:         r.Fee,
+			FeeCurrency: r.FeeCurrency,
+		}
+		if resp[i].Timestamp, err = time.Parse(time.RFC3339, r.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(startDate, endDate time.Time) ([]Fill, error) {
+	results, err := postgres.TradeLedgerFills(
+		qm.Where("timestamp BETWEEN ? AND ?",
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp, id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(results))
+	for i, r := range results {
+		resp[i] = Fill{
+			ID:          r.ID,
+			Exchange:    r.Exchange,
+			Account:     r.Account,
+			OrderID:     r.OrderID,
+			TradeID:     r.TradeID,
+			Base:        r.Base,
+			Quote:       r.Quote,
+			Side:        r.Side,
+			Price:       r.Price,
+			Amount:      r.Amount,
+			Fee:         r.Fee,
+			FeeCurrency: r.FeeCurrency,
+			Timestamp:   r.Timestamp,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,120 @@
+package tradeledger
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestTradeLedger(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			fills := []Fill{
+				{Exchange: "Binance", OrderID: "1", Base: "btc", Quote: "usdt", Side: "buy", Price: 40000, Amount: 0.5, Timestamp: start},
+				{Exchange: "Binance", OrderID: "2", TradeID: "21", Base: "BTC", Quote: "USDT", Side: "SELL", Price: 45000, Amount: 0.25, Timestamp: start.Add(time.Hour)},
+			}
+			require.NoError(t, db.Upsert(fills...), "Upsert must not error")
+			assert.NotEmpty(t, fills[0].ID, "Upsert should set the fill ID")
+
+			got, err := db.GetInRange(start, start.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 2, "GetInRange must return the fills")
+			assert.Equal(t, "BTC", got[0].Base, "Base should be correct")
+			assert.Equal(t, "USDT", got[0].Quote, "Quote should be correct")
+			assert.True(t, got[0].Timestamp.Equal(start), "Timestamp should be correct")
+			assert.Equal(t, "21", got[1].TradeID, "Fills should be ordered by time")
+
+			require.NoError(t, db.Upsert(
+				Fill{Exchange: "Binance", OrderID: "1", Base: "BTC", Quote: "USDT", Side: "BUY", Price: 41000, Amount: 1, Fee: 0.001, FeeCurrency: "btc", Timestamp: start},
+				Fill{Exchange: "Binance", OrderID: "2", TradeID: "21", Base: "BTC", Quote: "USDT", Side: "SELL", Price: 45000, Amount: 0.25, Fee: 11.25, FeeCurrency: "usdt", Timestamp: start.Add(time.Hour)},
+				Fill{Exchange: "Binance", OrderID: "2", TradeID: "21", Base: "BTC", Quote: "USDT", Side: "SELL", Price: 45000, Amount: 0.25, Timestamp: start.Add(time.Hour)},
+			), "Upsert must not error")
+			got, err = db.GetInRange(start, start.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 2, "Upsert must not duplicate stored fills")
+			assert.Equal(t, 1.0, got[0].Amount, "Upsert should update the executed amount of an order level fill")
+			assert.Equal(t, 41000.0, got[0].Price, "Upsert should update the price of an order level fill")
+			assert.Equal(t, "BTC", got[0].FeeCurrency, "Upsert should update the fee currency")
+			assert.Equal(t, 11.25, got[1].Fee, "Upsert should not clear a stored fee")
+
+			require.NoError(t, db.Upsert(
+				Fill{Exchange: "Binance", OrderID: "1", TradeID: "11", Base: "BTC", Quote: "USDT", Side: "BUY", Price: 41000, Amount: 1, Timestamp: start},
+				Fill{Exchange: "Binance", OrderID: "1", Base: "BTC", Quote: "USDT", Side: "BUY", Price: 41000, Amount: 1, Timestamp: start},
+			), "Upsert must not error")
+			got, err = db.GetInRange(start, start.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, got, 2, "trade level fills must replace the order level fill")
+			assert.Equal(t, "11", got[0].TradeID, "TradeID should be correct")
+
+			got, err = db.GetInRange(start.Add(time.Hour*2), start.Add(time.Hour*3))
+			require.NoError(t, err, "GetInRange must not error")
+			assert.Empty(t, got, "GetInRange should not return fills outside the range")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
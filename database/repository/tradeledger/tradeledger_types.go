@@ -0,0 +1,40 @@
+package tradeledger
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Fill is a DTO for an executed trade imported into the trade ledger. An
+// empty TradeID denotes an order level fill aggregating the order's trades,
+// used when an exchange does not return the individual trades of an order
+type Fill struct {
+	ID          string
+	Exchange    string
+	Account     string
+	OrderID     string
+	TradeID     string
+	Base        string
+	Quote       string
+	Side        string
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeCurrency string
+	Timestamp   time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the trade ledger database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(fills ...Fill) error
+	GetInRange(startDate, endDate time.Time) ([]Fill, error)
+}
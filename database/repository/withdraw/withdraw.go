@@ -76,6 +76,9 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 	if res.RequestDetails.Description != "" {
 		tempEvent.Description.SetValid(res.RequestDetails.Description)
 	}
+	if res.Exchange.TxID != "" {
+		tempEvent.TxID.SetValid(res.Exchange.TxID)
+	}
 
 	err = tempEvent.Insert(ctx, tx, boil.Infer())
 	if err != nil {
@@ -157,6 +160,9 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 	if res.RequestDetails.Description != "" {
 		tempEvent.Description.SetValid(res.RequestDetails.Description)
 	}
+	if res.Exchange.TxID != "" {
+		tempEvent.TxID.SetValid(res.Exchange.TxID)
+	}
 
 	err = tempEvent.Insert(ctx, tx, boil.Infer())
 	if err != nil {
@@ -215,6 +221,40 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 	return nil
 }
 
+// UpdateEvent updates the exchange status and transaction ID of a stored
+// withdrawal, an empty transaction ID leaves the stored one unchanged
+func UpdateEvent(id, status, txID string) error {
+	sqlDB, err := database.DB.GetSQL()
+	if err != nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		v, err := modelSQLite.FindWithdrawalHistory(ctx, sqlDB, id)
+		if err != nil {
+			return err
+		}
+		v.Status = status
+		if txID != "" {
+			v.TxID.SetValid(txID)
+		}
+		v.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+		_, err = v.Update(ctx, sqlDB, boil.Infer())
+		return err
+	}
+	v, err := modelPSQL.FindWithdrawalHistory(ctx, sqlDB, id)
+	if err != nil {
+		return err
+	}
+	v.Status = status
+	if txID != "" {
+		v.TxID.SetValid(txID)
+	}
+	_, err = v.Update(ctx, sqlDB, boil.Infer())
+	return err
+}
+
 // GetEventByUUID return requested withdraw information by ID
 func GetEventByUUID(id string) (*withdraw.Response, error) {
 	resp, err := getByColumns(generateWhereQuery([]string{"id"}, []string{id}, 1))
@@ -307,6 +347,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 			tempResp.ID = newUUID
 			tempResp.Exchange.ID = v[x].ExchangeID
 			tempResp.Exchange.Status = v[x].Status
+			tempResp.Exchange.TxID = v[x].TxID.String
 			tempResp.RequestDetails = withdraw.Request{
 				Currency:    currency.NewCode(v[x].Currency),
 				Description: v[x].Description.String,
@@ -376,6 +417,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 			tempResp.ID = newUUID
 			tempResp.Exchange.ID = v[x].ExchangeID
 			tempResp.Exchange.Status = v[x].Status
+			tempResp.Exchange.TxID = v[x].TxID.String
 			tempResp.RequestDetails = withdraw.Request{
 				Currency:    currency.NewCode(v[x].Currency),
 				Description: v[x].Description.String,
@@ -187,4 +187,16 @@ func withdrawHelper(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	require.NotEmpty(t, v, "GetEventsByExchange must return events")
+	require.NoError(t, UpdateEvent(v[0].ID.String(), "completed", "0xdeadbeef"), "UpdateEvent must not error")
+	updated, err := GetEventByUUID(v[0].ID.String())
+	require.NoError(t, err, "GetEventByUUID must not error")
+	assert.Equal(t, "completed", updated.Exchange.Status, "UpdateEvent should update the status")
+	assert.Equal(t, "0xdeadbeef", updated.Exchange.TxID, "UpdateEvent should update the transaction ID")
+	require.NoError(t, UpdateEvent(v[0].ID.String(), "confirmed", ""), "UpdateEvent must not error")
+	updated, err = GetEventByUUID(v[0].ID.String())
+	require.NoError(t, err, "GetEventByUUID must not error")
+	assert.Equal(t, "0xdeadbeef", updated.Exchange.TxID, "UpdateEvent should keep the transaction ID when none is provided")
+	assert.Error(t, UpdateEvent("not-found", "completed", ""), "UpdateEvent should error for an unknown withdrawal")
 }
@@ -0,0 +1,469 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/carrysnapshot"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// carryYear is the period rates are annualised over
+const carryYear = 365 * 24 * time.Hour
+
+var (
+	errInvalidCarryCheckInterval = errors.New("carry monitor check interval must be positive")
+	errNoCarryUnderlyings        = errors.New("carry monitor has no underlyings configured")
+	errCarryHistoryUnavailable   = errors.New("carry history requires a database connection")
+	errNoCarryTickerPrice        = errors.New("ticker has no last or mid price")
+)
+
+// SetupCarryMonitor applies configuration parameters before running. Snapshots
+// are only stored when the database connection manager is connected
+func SetupCarryMonitor(em iExchangeManager, comms iCommsManager, dcm iDatabaseConnectionManager, cfg *config.CarryMonitorConfig) (*CarryMonitor, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w CarryMonitor", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidCarryCheckInterval
+	}
+	if len(cfg.Underlyings) == 0 {
+		return nil, errNoCarryUnderlyings
+	}
+	mux := dispatch.GetNewMux(nil)
+	muxID, err := mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	m := &CarryMonitor{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		comms:           comms,
+		cfg:             *cfg,
+		mux:             mux,
+		muxID:           muxID,
+		snapshots:       make(map[carryKey]*carryState),
+	}
+	if dcm != nil {
+		db, err := carrysnapshot.Setup(dcm.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db != nil {
+			m.db = db
+		}
+	}
+	return m, nil
+}
+
+// Start runs the subsystem
+func (m *CarryMonitor) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CarryMonitorName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", CarryMonitorName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Carry monitor %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Carry monitor %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *CarryMonitor) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CarryMonitorName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", CarryMonitorName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Carry monitor %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Carry monitor %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *CarryMonitor) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetSnapshots returns the latest snapshot of each monitored contract,
+// optionally filtered by exchange and underlying, ordered by the annualised
+// carry with the largest first
+func (m *CarryMonitor) GetSnapshots(exchangeName string, underlying currency.Pair, opportunitiesOnly bool) ([]CarrySnapshot, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CarryMonitorName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	resp := make([]CarrySnapshot, 0, len(m.snapshots))
+	for _, s := range m.snapshots {
+		if exchangeName != "" && !strings.EqualFold(s.Exchange, exchangeName) {
+			continue
+		}
+		if !underlying.IsEmpty() && !s.Underlying.Equal(underlying) {
+			continue
+		}
+		if opportunitiesOnly && !s.Opportunity {
+			continue
+		}
+		resp = append(resp, s.CarrySnapshot)
+	}
+	sortCarrySnapshots(resp)
+	return resp, nil
+}
+
+// GetHistory returns the stored snapshots of an underlying between the dates,
+// optionally filtered by exchange
+func (m *CarryMonitor) GetHistory(exchangeName string, underlying currency.Pair, start, end time.Time) ([]carrysnapshot.Snapshot, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CarryMonitorName, ErrSubSystemNotStarted)
+	}
+	if m.db == nil {
+		return nil, errCarryHistoryUnavailable
+	}
+	if underlying.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	return m.db.GetInRange(exchangeName, underlying.Base.String(), underlying.Quote.String(), start, end)
+}
+
+// Subscribe returns a pipe which receives the snapshots, as []CarrySnapshot,
+// collected by each check
+func (m *CarryMonitor) Subscribe() (dispatch.Pipe, error) {
+	if m == nil {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", CarryMonitorName, ErrNilSubsystem)
+	}
+	return m.mux.Subscribe(m.muxID)
+}
+
+func (m *CarryMonitor) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll collects the snapshots of each exchange concurrently, then stores
+// and publishes them
+func (m *CarryMonitor) checkAll(ctx context.Context) {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Carry monitor failed to get exchanges: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.CheckInterval)
+	defer cancel()
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		snapshots []CarrySnapshot
+	)
+	for _, exch := range exchs {
+		underlyings := m.underlyingsFor(exch.GetName())
+		if len(underlyings) == 0 {
+			continue
+		}
+		wg.Go(func() {
+			s := m.collect(ctx, exch, underlyings, time.Now())
+			mu.Lock()
+			snapshots = append(snapshots, s...)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	if len(snapshots) == 0 {
+		return
+	}
+	m.update(snapshots)
+	if m.db != nil {
+		if err := m.db.Insert(carrySnapshotsToDB(snapshots)...); err != nil {
+			log.Errorf(log.ExchangeSys, "Carry monitor failed to store snapshots: %v", err)
+		}
+	}
+	sortCarrySnapshots(snapshots)
+	if err := m.mux.Publish(snapshots, m.muxID); err != nil {
+		log.Errorf(log.ExchangeSys, "Carry monitor failed to publish snapshots: %v", err)
+	}
+}
+
+// underlyingsFor returns the configured underlyings monitored on an exchange
+func (m *CarryMonitor) underlyingsFor(exchangeName string) currency.Pairs {
+	var resp currency.Pairs
+	for i := range m.cfg.Underlyings {
+		u := &m.cfg.Underlyings[i]
+		if len(u.Exchanges) > 0 && !slices.ContainsFunc(u.Exchanges, func(e string) bool { return strings.EqualFold(e, exchangeName) }) {
+			continue
+		}
+		resp = append(resp, u.Pair)
+	}
+	return resp
+}
+
+// collect returns a snapshot of each active futures contract of the
+// underlyings on an exchange
+func (m *CarryMonitor) collect(ctx context.Context, exch exchange.IBotExchange, underlyings currency.Pairs, now time.Time) []CarrySnapshot {
+	name := exch.GetName()
+	spot := make(map[currency.Pair]float64, len(underlyings))
+	if avail, err := exch.GetAvailablePairs(asset.Spot); err == nil {
+		for _, u := range underlyings {
+			idx := slices.IndexFunc(avail, u.Equal)
+			if idx == -1 {
+				continue
+			}
+			price, err := carryTickerPrice(ctx, exch, avail[idx], asset.Spot)
+			if err != nil {
+				m.logVerbose("Carry monitor %s %s spot price unavailable: %v", name, u, err)
+				continue
+			}
+			spot[u] = price
+		}
+	}
+
+	var resp []CarrySnapshot
+	for _, a := range exch.GetAssetTypes(true) {
+		if !a.IsFutures() {
+			continue
+		}
+		contracts, err := exch.GetFuturesContractDetails(ctx, a)
+		if err != nil {
+			if !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+				m.logVerbose("Carry monitor %s %s contracts unavailable: %v", name, a, err)
+			}
+			continue
+		}
+		for i := range contracts {
+			c := &contracts[i]
+			if !c.IsActive || (!c.EndDate.IsZero() && !c.EndDate.After(now)) {
+				continue
+			}
+			idx := slices.IndexFunc(underlyings, func(u currency.Pair) bool { return carryUnderlyingMatch(c, u) })
+			if idx == -1 {
+				continue
+			}
+			s, err := m.snapshot(ctx, exch, c, underlyings[idx], spot[underlyings[idx]], now)
+			if err != nil {
+				m.logVerbose("Carry monitor %s %s %s snapshot failed: %v", name, a, c.Name, err)
+				continue
+			}
+			resp = append(resp, s)
+		}
+	}
+	return resp
+}
+
+// snapshot returns the funding rate and basis of a contract against the spot
+// price of its underlying, the basis is not set when the spot price is unknown
+func (m *CarryMonitor) snapshot(ctx context.Context, exch exchange.IBotExchange, c *futures.Contract, underlying currency.Pair, spotPrice float64, now time.Time) (CarrySnapshot, error) {
+	s := CarrySnapshot{
+		Exchange:     exch.GetName(),
+		Asset:        c.Asset,
+		Pair:         c.Name,
+		Underlying:   underlying,
+		ContractType: c.Type,
+		Expiry:       c.EndDate,
+		SpotPrice:    spotPrice,
+		Time:         now,
+	}
+	price, err := carryTickerPrice(ctx, exch, c.Name, c.Asset)
+	if err != nil {
+		return s, err
+	}
+	s.ContractPrice = price
+	if spotPrice > 0 {
+		s.Basis = (price - spotPrice) / spotPrice
+	}
+
+	perpetual := c.Type == futures.Perpetual
+	if !perpetual && c.EndDate.IsZero() {
+		perpetual, _ = exch.IsPerpetualFutureCurrency(c.Asset, c.Name)
+	}
+	if !perpetual {
+		// Annualising the basis of a contract about to expire overstates it
+		if toExpiry := c.EndDate.Sub(now); toExpiry >= 24*time.Hour {
+			s.AnnualisedBasis = s.Basis * float64(carryYear) / float64(toExpiry)
+		}
+	} else {
+		s.ContractType = futures.Perpetual
+		rates, err := exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{Asset: c.Asset, Pair: c.Name})
+		switch {
+		case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented):
+		case err != nil:
+			m.logVerbose("Carry monitor %s %s %s funding rate unavailable: %v", s.Exchange, c.Asset, c.Name, err)
+		case len(rates) > 0:
+			s.FundingRate = rates[0].LatestRate.Rate.InexactFloat64()
+			s.FundingInterval = m.cfg.FundingInterval
+			if interval := rates[0].TimeOfNextRate.Sub(rates[0].LatestRate.Time); !rates[0].LatestRate.Time.IsZero() && interval > 0 && interval <= 24*time.Hour {
+				s.FundingInterval = interval
+			}
+			s.AnnualisedFundingRate = s.FundingRate * float64(carryYear) / float64(s.FundingInterval)
+		}
+	}
+	s.Opportunity = math.Abs(s.AnnualisedFundingRate) >= m.cfg.FundingRateThreshold || math.Abs(s.AnnualisedBasis) >= m.cfg.BasisThreshold
+	return s, nil
+}
+
+// update stores the latest snapshots and notifies each contract's funding or
+// basis carry once when it moves beyond its threshold
+func (m *CarryMonitor) update(snapshots []CarrySnapshot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range snapshots {
+		s := &snapshots[i]
+		k := carryKey{exchange: strings.ToLower(s.Exchange), asset: s.Asset, pair: s.Pair.String()}
+		st, ok := m.snapshots[k]
+		if !ok {
+			st = &carryState{notified: make(map[string]bool)}
+			m.snapshots[k] = st
+		}
+		st.CarrySnapshot = *s
+		if m.cfg.Verbose {
+			log.Debugf(log.ExchangeSys, "Carry monitor %s %s %s basis %.4f%% annualised basis %.2f%% annualised funding %.2f%%",
+				s.Exchange, s.Asset, s.Pair, s.Basis*100, s.AnnualisedBasis*100, s.AnnualisedFundingRate*100)
+		}
+		m.checkOpportunity(st, carryKindFunding, s.AnnualisedFundingRate, m.cfg.FundingRateThreshold)
+		m.checkOpportunity(st, carryKindBasis, s.AnnualisedBasis, m.cfg.BasisThreshold)
+	}
+}
+
+func (m *CarryMonitor) checkOpportunity(st *carryState, kind string, annualised, threshold float64) {
+	if math.Abs(annualised) < threshold {
+		st.notified[kind] = false
+		return
+	}
+	if st.notified[kind] {
+		return
+	}
+	st.notified[kind] = true
+	p := &base.CarryOpportunity{
+		Exchange:   st.Exchange,
+		Asset:      st.Asset.String(),
+		Pair:       st.Pair.String(),
+		Underlying: st.Underlying.String(),
+		Kind:       kind,
+		Annualised: annualised,
+		Threshold:  threshold,
+	}
+	evt := base.NewEvent(p, base.SeverityInfo)
+	evt.Key = evt.Type + "|" + p.Exchange + "|" + p.Asset + "|" + p.Pair + "|" + kind
+	m.comms.PushEvent(evt)
+}
+
+func (m *CarryMonitor) logVerbose(format string, args ...any) {
+	if m.cfg.Verbose {
+		log.Warnf(log.ExchangeSys, format, args...)
+	}
+}
+
+// carryUnderlyingMatch returns whether a contract is of an underlying. Some
+// exchanges do not return contract underlyings, so the contract name is used
+// with the quote allowing an expiry suffix e.g. BTC-USDT240329
+func carryUnderlyingMatch(c *futures.Contract, underlying currency.Pair) bool {
+	if !c.Underlying.IsEmpty() {
+		return c.Underlying.Equal(underlying)
+	}
+	return c.Name.Base.Equal(underlying.Base) &&
+		strings.HasPrefix(c.Name.Quote.Upper().String(), underlying.Quote.Upper().String())
+}
+
+// carryTickerPrice returns the last price of a pair, or the mid price when the
+// exchange does not return a last price
+func carryTickerPrice(ctx context.Context, exch exchange.IBotExchange, p currency.Pair, a asset.Item) (float64, error) {
+	t, err := exch.UpdateTicker(ctx, p, a)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case t.Last > 0:
+		return t.Last, nil
+	case t.Bid > 0 && t.Ask > 0:
+		return (t.Bid + t.Ask) / 2, nil
+	default:
+		return 0, fmt.Errorf("%w for %s %s", errNoCarryTickerPrice, a, p)
+	}
+}
+
+// sortCarrySnapshots orders snapshots by their largest annualised carry
+func sortCarrySnapshots(s []CarrySnapshot) {
+	carry := func(c *CarrySnapshot) float64 {
+		return max(math.Abs(c.AnnualisedFundingRate), math.Abs(c.AnnualisedBasis))
+	}
+	slices.SortStableFunc(s, func(a, b CarrySnapshot) int {
+		ca, cb := carry(&a), carry(&b)
+		switch {
+		case ca > cb:
+			return -1
+		case ca < cb:
+			return 1
+		}
+		return strings.Compare(a.Exchange+a.Pair.String(), b.Exchange+b.Pair.String())
+	})
+}
+
+func carrySnapshotsToDB(snapshots []CarrySnapshot) []carrysnapshot.Snapshot {
+	resp := make([]carrysnapshot.Snapshot, len(snapshots))
+	for i := range snapshots {
+		s := &snapshots[i]
+		resp[i] = carrysnapshot.Snapshot{
+			ExchangeName:          s.Exchange,
+			Asset:                 s.Asset.String(),
+			Base:                  s.Pair.Base.String(),
+			Quote:                 s.Pair.Quote.String(),
+			UnderlyingBase:        s.Underlying.Base.String(),
+			UnderlyingQuote:       s.Underlying.Quote.String(),
+			ContractType:          s.ContractType.String(),
+			Expiry:                s.Expiry,
+			SpotPrice:             s.SpotPrice,
+			ContractPrice:         s.ContractPrice,
+			Basis:                 s.Basis,
+			AnnualisedBasis:       s.AnnualisedBasis,
+			FundingRate:           s.FundingRate,
+			AnnualisedFundingRate: s.AnnualisedFundingRate,
+			Timestamp:             s.Time,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	carryPerp      = currency.NewPair(currency.BTC, currency.USDT)
+	carryDated     = currency.NewPair(currency.BTC, currency.NewCode("USDT240329"))
+	carryExpiring  = currency.NewPair(currency.BTC, currency.NewCode("USDT240105"))
+	carryETHPerp   = currency.NewPair(currency.ETH, currency.USDT)
+	testCarryStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var testCarryConfig = config.CarryMonitorConfig{
+	CheckInterval:        time.Minute,
+	FundingRateThreshold: 0.2,
+	BasisThreshold:       0.1,
+	FundingInterval:      8 * time.Hour,
+	Underlyings:          []config.CarryUnderlying{{Pair: currency.NewBTCUSDT()}},
+}
+
+type carryExchange struct {
+	exchange.IBotExchange
+	prices  map[asset.Item]map[string]float64
+	funding float64
+	// now is the time contract expiries are relative to
+	now time.Time
+}
+
+func (c *carryExchange) GetName() string { return "carry" }
+
+func (c *carryExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot, asset.USDTMarginedFutures}
+}
+
+func (c *carryExchange) GetAvailablePairs(a asset.Item) (currency.Pairs, error) {
+	if a != asset.Spot {
+		return nil, asset.ErrNotSupported
+	}
+	return currency.Pairs{currency.NewPair(currency.USDT, currency.BTC), currency.NewBTCUSDT()}, nil
+}
+
+func (c *carryExchange) GetFuturesContractDetails(_ context.Context, a asset.Item) ([]futures.Contract, error) {
+	if a != asset.USDTMarginedFutures {
+		return nil, futures.ErrNotFuturesAsset
+	}
+	return []futures.Contract{
+		{Name: carryPerp, Underlying: currency.NewBTCUSDT(), Asset: a, IsActive: true, Type: futures.Perpetual},
+		{Name: carryDated, Asset: a, IsActive: true, Type: futures.Quarterly, EndDate: c.now.Add(87 * 24 * time.Hour)},
+		{Name: carryExpiring, Asset: a, IsActive: true, Type: futures.Weekly, EndDate: c.now.Add(time.Hour)},
+		{Name: currency.NewPair(currency.BTC, currency.NewCode("USDT231229")), Asset: a, IsActive: true, EndDate: c.now.Add(-time.Hour)},
+		{Name: carryETHPerp, Underlying: carryETHPerp, Asset: a, IsActive: true, Type: futures.Perpetual},
+		{Name: currency.NewPair(currency.BTC, currency.NewCode("USDT240628")), Asset: a},
+	}, nil
+}
+
+func (c *carryExchange) UpdateTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	price, ok := c.prices[a][p.String()]
+	if !ok {
+		return nil, ticker.ErrTickerNotFound
+	}
+	return &ticker.Price{Last: price, Pair: p, AssetType: a}, nil
+}
+
+func (c *carryExchange) GetLatestFundingRates(_ context.Context, r *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	return []fundingrate.LatestRateResponse{{
+		Exchange:       c.GetName(),
+		Asset:          r.Asset,
+		Pair:           r.Pair,
+		LatestRate:     fundingrate.Rate{Time: testCarryStart, Rate: decimal.NewFromFloat(c.funding)},
+		TimeOfNextRate: testCarryStart.Add(4 * time.Hour),
+	}}, nil
+}
+
+func (c *carryExchange) IsPerpetualFutureCurrency(asset.Item, currency.Pair) (bool, error) {
+	return false, common.ErrNotYetImplemented
+}
+
+type carryExchangeManager struct {
+	exchanges []exchange.IBotExchange
+}
+
+func (c *carryExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	return c.exchanges, nil
+}
+
+func (c *carryExchangeManager) GetExchangeByName(string) (exchange.IBotExchange, error) {
+	return nil, ErrExchangeNotFound
+}
+
+func newCarryExchange(now time.Time) *carryExchange {
+	return &carryExchange{
+		prices: map[asset.Item]map[string]float64{
+			asset.Spot: {carryPerp.String(): 40000},
+			asset.USDTMarginedFutures: {
+				carryPerp.String():     40040,
+				carryDated.String():    40800,
+				carryExpiring.String(): 40400,
+			},
+		},
+		funding: 0.0001,
+		now:     now,
+	}
+}
+
+func TestSetupCarryMonitor(t *testing.T) {
+	t.Parallel()
+	_, err := SetupCarryMonitor(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupCarryMonitor(&ExchangeManager{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupCarryMonitor(&ExchangeManager{}, &testCommsManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupCarryMonitor(&ExchangeManager{}, &testCommsManager{}, nil, &config.CarryMonitorConfig{})
+	assert.ErrorIs(t, err, errInvalidCarryCheckInterval)
+	_, err = SetupCarryMonitor(&ExchangeManager{}, &testCommsManager{}, nil, &config.CarryMonitorConfig{CheckInterval: time.Minute})
+	assert.ErrorIs(t, err, errNoCarryUnderlyings)
+	m, err := SetupCarryMonitor(&ExchangeManager{}, &testCommsManager{}, (*DatabaseConnectionManager)(nil), &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	assert.NotNil(t, m.snapshots, "SetupCarryMonitor should initialise the snapshots map")
+	assert.Nil(t, m.db, "SetupCarryMonitor should not store snapshots without a database connection")
+}
+
+func TestCarryMonitorStartStop(t *testing.T) {
+	t.Parallel()
+	var m *CarryMonitor
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil monitor")
+	_, err := m.Subscribe()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = SetupCarryMonitor(&carryExchangeManager{}, &testCommsManager{}, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetSnapshots("", currency.EMPTYPAIR, false)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestCarryMonitorCollect(t *testing.T) {
+	t.Parallel()
+	m, err := SetupCarryMonitor(&carryExchangeManager{}, &testCommsManager{}, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+
+	snapshots := m.collect(t.Context(), newCarryExchange(testCarryStart), m.underlyingsFor("carry"), testCarryStart)
+	require.Len(t, snapshots, 3, "collect must only return active unexpired contracts of the underlying")
+
+	perp := snapshots[0]
+	assert.Equal(t, carryPerp, perp.Pair, "Pair should be correct")
+	assert.Equal(t, currency.NewBTCUSDT(), perp.Underlying, "Underlying should be correct")
+	assert.Equal(t, futures.Perpetual, perp.ContractType, "ContractType should be correct")
+	assert.Equal(t, 40000.0, perp.SpotPrice, "SpotPrice should not match a reciprocal pair")
+	assert.InDelta(t, 0.001, perp.Basis, 1e-9, "Basis should be the premium over spot")
+	assert.Zero(t, perp.AnnualisedBasis, "AnnualisedBasis should not be set for a perpetual")
+	assert.Equal(t, 4*time.Hour, perp.FundingInterval, "FundingInterval should be worked out from the time of the next rate")
+	assert.InDelta(t, 0.0001*6*365, perp.AnnualisedFundingRate, 1e-9, "AnnualisedFundingRate should be correct")
+	assert.True(t, perp.Opportunity, "Opportunity should be set beyond the funding threshold")
+
+	dated := snapshots[1]
+	assert.Equal(t, carryDated, dated.Pair, "collect should match contracts without an underlying by name")
+	assert.InDelta(t, 0.02, dated.Basis, 1e-9, "Basis should be the premium over spot")
+	assert.InDelta(t, 0.02*365/87, dated.AnnualisedBasis, 1e-9, "AnnualisedBasis should be annualised over the time to expiry")
+	assert.Zero(t, dated.FundingRate, "FundingRate should not be set for a dated contract")
+	assert.False(t, dated.Opportunity, "Opportunity should not be set below the thresholds")
+
+	expiring := snapshots[2]
+	assert.InDelta(t, 0.01, expiring.Basis, 1e-9, "Basis should be the premium over spot")
+	assert.Zero(t, expiring.AnnualisedBasis, "AnnualisedBasis should not be set for a contract expiring within a day")
+
+	m.cfg.Underlyings = []config.CarryUnderlying{{Pair: currency.NewBTCUSDT(), Exchanges: []string{"other"}}}
+	assert.Empty(t, m.underlyingsFor("CARRY"), "underlyingsFor should exclude underlyings limited to other exchanges")
+}
+
+func TestCarryMonitorUpdate(t *testing.T) {
+	t.Parallel()
+	comms := &testCommsManager{}
+	m, err := SetupCarryMonitor(&carryExchangeManager{}, comms, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	m.started.Store(true)
+	exch := newCarryExchange(testCarryStart)
+
+	m.update(m.collect(t.Context(), exch, m.underlyingsFor("carry"), testCarryStart))
+	require.Len(t, comms.events, 1, "update must notify the funding opportunity")
+	assert.Equal(t, base.CarryOpportunityEventType, comms.events[0].Type, "update should notify a carry opportunity")
+	p, ok := comms.events[0].Data.(*base.CarryOpportunity)
+	require.True(t, ok, "event data must be a carry opportunity")
+	assert.Equal(t, carryKindFunding, p.Kind, "Kind should be correct")
+	assert.Equal(t, carryPerp.String(), p.Pair, "Pair should be correct")
+
+	m.update(m.collect(t.Context(), exch, m.underlyingsFor("carry"), testCarryStart))
+	assert.Len(t, comms.events, 1, "update should not notify an unchanged opportunity")
+
+	exch.funding = 0.00001
+	exch.prices[asset.USDTMarginedFutures][carryDated.String()] = 42500
+	m.update(m.collect(t.Context(), exch, m.underlyingsFor("carry"), testCarryStart))
+	require.Len(t, comms.events, 2, "update must notify the basis opportunity")
+	p, ok = comms.events[1].Data.(*base.CarryOpportunity)
+	require.True(t, ok, "event data must be a carry opportunity")
+	assert.Equal(t, carryKindBasis, p.Kind, "Kind should be correct")
+
+	exch.funding = 0.0001
+	m.update(m.collect(t.Context(), exch, m.underlyingsFor("carry"), testCarryStart))
+	assert.Len(t, comms.events, 3, "update should notify an opportunity again once it has fallen below its threshold")
+
+	s, err := m.GetSnapshots("CARRY", currency.NewBTCUSDT(), true)
+	require.NoError(t, err, "GetSnapshots must not error")
+	require.Len(t, s, 2, "GetSnapshots must only return opportunities")
+	assert.Equal(t, carryDated, s[0].Pair, "GetSnapshots should order snapshots by the largest annualised carry")
+	s, err = m.GetSnapshots("other", currency.EMPTYPAIR, false)
+	require.NoError(t, err, "GetSnapshots must not error")
+	assert.Empty(t, s, "GetSnapshots should filter by exchange")
+
+	_, err = m.GetHistory("", currency.NewBTCUSDT(), testCarryStart, testCarryStart.Add(time.Hour))
+	assert.ErrorIs(t, err, errCarryHistoryUnavailable)
+}
+
+func TestCarryMonitorCheckAll(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	em := &carryExchangeManager{exchanges: []exchange.IBotExchange{newCarryExchange(time.Now())}}
+	m, err := SetupCarryMonitor(em, &testCommsManager{}, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	pipe, err := m.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	m.checkAll(t.Context())
+	select {
+	case data := <-pipe.Channel():
+		s, ok := data.([]CarrySnapshot)
+		require.True(t, ok, "published data must be carry snapshots")
+		assert.Len(t, s, 3, "checkAll should publish the collected snapshots")
+	case <-time.After(time.Second):
+		require.Fail(t, "checkAll must publish the collected snapshots")
+	}
+}
+
+func TestCarryUnderlyingMatch(t *testing.T) {
+	t.Parallel()
+	btcusdt := currency.NewBTCUSDT()
+	assert.True(t, carryUnderlyingMatch(&futures.Contract{Name: carryETHPerp, Underlying: btcusdt}, btcusdt), "should match the contract underlying")
+	assert.False(t, carryUnderlyingMatch(&futures.Contract{Name: carryPerp, Underlying: carryETHPerp}, btcusdt), "should not match another underlying")
+	assert.True(t, carryUnderlyingMatch(&futures.Contract{Name: carryDated}, btcusdt), "should match a name with an expiry suffix")
+	assert.False(t, carryUnderlyingMatch(&futures.Contract{Name: currency.NewPair(currency.BTC, currency.USD)}, btcusdt), "should not match another quote")
+}
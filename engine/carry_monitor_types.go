@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/carrysnapshot"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+)
+
+// CarryMonitorName is an exported subsystem name
+const CarryMonitorName = "carry_monitor"
+
+// Carry opportunity kinds
+const (
+	carryKindFunding = "funding"
+	carryKindBasis   = "basis"
+)
+
+// CarrySnapshot is the funding rate and basis of a futures contract against
+// the spot price of its underlying at a point in time. Rates are fractions,
+// 0.1 being 10%
+type CarrySnapshot struct {
+	Exchange      string
+	Asset         asset.Item
+	Pair          currency.Pair
+	Underlying    currency.Pair
+	ContractType  futures.ContractType
+	Expiry        time.Time
+	SpotPrice     float64
+	ContractPrice float64
+	// Basis is the contract price premium over the spot price
+	Basis float64
+	// AnnualisedBasis is only set for dated contracts expiring after a day
+	AnnualisedBasis float64
+	// FundingRate is only set for perpetual contracts
+	FundingRate           float64
+	FundingInterval       time.Duration
+	AnnualisedFundingRate float64
+	// Opportunity is set when the annualised funding rate or basis is
+	// beyond its configured threshold
+	Opportunity bool
+	Time        time.Time
+}
+
+// CarryMonitor continuously collects the funding rates and spot basis of the
+// perpetual and dated futures contracts of configured underlyings, storing
+// snapshots in the database when connected and notifying carry opportunities
+type CarryMonitor struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager iExchangeManager
+	comms           iCommsManager
+	db              carrysnapshot.IDBService
+	cfg             config.CarryMonitorConfig
+
+	mux   *dispatch.Mux
+	muxID uuid.UUID
+
+	mu        sync.RWMutex
+	snapshots map[carryKey]*carryState
+}
+
+// carryKey identifies a monitored contract
+type carryKey struct {
+	exchange string
+	asset    asset.Item
+	pair     string
+}
+
+// carryState is the latest snapshot of a contract and the opportunity kinds
+// already notified
+type carryState struct {
+	CarrySnapshot
+	notified map[string]bool
+}
@@ -0,0 +1,296 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// AuditCommsCommand is the audit event type recorded for chat commands
+const AuditCommsCommand = "comms_command"
+
+var errCommandArgs = errors.New("invalid command arguments")
+
+// setupCommsCommands registers the chat commands with the communications
+// manager relayers
+func (bot *Engine) setupCommsCommands() error {
+	c := base.NewCommands(func(relayer, user, command, outcome string) {
+		log.Infof(log.CommunicationMgr, "%s user %s ran command %q: %s", relayer, user, command, outcome)
+		audit.Event(relayer+":"+user, AuditCommsCommand, command+": "+outcome)
+	})
+	for _, cmd := range []*base.Command{
+		{Name: "balances", Usage: "<exchange> [asset]", Description: "Displays the account balances for an exchange", Func: bot.commsBalances},
+		{Name: "orders", Usage: "[exchange]", Description: "Displays the open orders tracked by the order manager", Func: bot.commsOrders},
+		{Name: "positions", Description: "Displays the open futures positions", Func: bot.commsPositions},
+		{Name: "pnl", Description: "Displays the PNL of the open futures positions", Func: bot.commsPNL},
+		{Name: "pause", Description: "Stops the order manager", Destructive: true, Func: bot.commsSetOrderManager(false)},
+		{Name: "resume", Description: "Starts the order manager", Func: bot.commsSetOrderManager(true)},
+		{Name: "cancelall", Usage: "[exchange]", Description: "Cancels all open orders tracked by the order manager", Destructive: true, Func: bot.commsCancelAll},
+		{Name: "alerts", Description: "Displays the unacknowledged alerts", Func: bot.commsAlerts},
+		{Name: "ack", Usage: "<id|all>", Description: "Acknowledges an alert", Func: bot.commsAcknowledge},
+		{Name: "withdrawals", Description: "Displays the withdrawals held by the withdrawal policy", Func: bot.commsWithdrawals},
+		{Name: "approvewithdrawal", Usage: "<id>", Description: "Approves a withdrawal pending approval", Destructive: true, Func: bot.commsApproveWithdrawal},
+		{Name: "rejectwithdrawal", Usage: "<id> [reason]", Description: "Rejects a withdrawal held by the withdrawal policy", Func: bot.commsRejectWithdrawal},
+	} {
+		if err := c.Register(cmd); err != nil {
+			return err
+		}
+	}
+	return bot.CommunicationsManager.SetCommands(c)
+}
+
+func (bot *Engine) commsBalances(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return "", fmt.Errorf("%w: balances <exchange> [asset]", errCommandArgs)
+	}
+	a := asset.Spot
+	if len(args) == 2 {
+		var err error
+		if a, err = asset.New(args[1]); err != nil {
+			return "", err
+		}
+	}
+	e, err := bot.GetExchangeByName(args[0])
+	if err != nil {
+		return "", err
+	}
+	subs, err := e.GetCachedSubAccounts(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s balances:", e.GetName(), a)
+	for _, sub := range subs {
+		codes := make([]string, 0, len(sub.Balances))
+		lines := make(map[string]string, len(sub.Balances))
+		for code, bal := range sub.Balances {
+			if bal.Total == 0 {
+				continue
+			}
+			codes = append(codes, code.String())
+			lines[code.String()] = fmt.Sprintf("\n%s %s: %v (free %v, hold %v)", sub.ID, code, bal.Total, bal.Free, bal.Hold)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			sb.WriteString(lines[code])
+		}
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsOrders(_ context.Context, args []string) (string, error) {
+	if len(args) > 1 {
+		return "", fmt.Errorf("%w: orders [exchange]", errCommandArgs)
+	}
+	var f *order.Filter
+	if len(args) == 1 {
+		f = &order.Filter{Exchange: args[0]}
+	}
+	orders, err := bot.OrderManager.GetOrdersActive(f)
+	if err != nil {
+		return "", err
+	}
+	if len(orders) == 0 {
+		return "No open orders", nil
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Date.Before(orders[j].Date)
+	})
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d open orders:", len(orders))
+	for i := range orders {
+		fmt.Fprintf(&sb, "\n%s %s %s %s %s %v@%v %s %s",
+			orders[i].Exchange, orders[i].AssetType, orders[i].Pair, orders[i].Side, orders[i].Type,
+			orders[i].Amount, orders[i].Price, orders[i].Status, orders[i].OrderID)
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsPositions(_ context.Context, _ []string) (string, error) {
+	positions, err := bot.OrderManager.GetAllOpenFuturesPositions()
+	if errors.Is(err, futures.ErrNoPositionsFound) {
+		return "No open positions", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].OpeningDate.Before(positions[j].OpeningDate)
+	})
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d open positions:", len(positions))
+	for i := range positions {
+		fmt.Fprintf(&sb, "\n%s %s %s %s %s opened at %s, unrealised PNL %s %s",
+			positions[i].Exchange, positions[i].Asset, positions[i].Pair, positions[i].LatestDirection,
+			positions[i].LatestSize, positions[i].OpeningPrice, positions[i].UnrealisedPNL, positions[i].CollateralCurrency)
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsPNL(_ context.Context, _ []string) (string, error) {
+	positions, err := bot.OrderManager.GetAllOpenFuturesPositions()
+	if errors.Is(err, futures.ErrNoPositionsFound) {
+		return "No open positions", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	type pnl struct{ realised, unrealised decimal.Decimal }
+	totals := make(map[string]*pnl)
+	for i := range positions {
+		k := positions[i].Exchange + " " + positions[i].CollateralCurrency.String()
+		t, ok := totals[k]
+		if !ok {
+			t = &pnl{}
+			totals[k] = t
+		}
+		t.realised = t.realised.Add(positions[i].RealisedPNL)
+		t.unrealised = t.unrealised.Add(positions[i].UnrealisedPNL)
+	}
+	keys := make([]string, 0, len(totals))
+	for k := range totals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	sb.WriteString("Open position PNL:")
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\n%s realised %s unrealised %s", k, totals[k].realised, totals[k].unrealised)
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsSetOrderManager(enable bool) base.CommandFunc {
+	return func(context.Context, []string) (string, error) {
+		if err := bot.SetSubsystem(OrderManagerName, enable); err != nil {
+			return "", err
+		}
+		if enable {
+			return "Order manager resumed", nil
+		}
+		return "Order manager paused", nil
+	}
+}
+
+func (bot *Engine) commsCancelAll(ctx context.Context, args []string) (string, error) {
+	if len(args) > 1 {
+		return "", fmt.Errorf("%w: cancelall [exchange]", errCommandArgs)
+	}
+	exchanges := bot.GetExchanges()
+	var f *order.Filter
+	if len(args) == 1 {
+		e, err := bot.GetExchangeByName(args[0])
+		if err != nil {
+			return "", err
+		}
+		exchanges = []exchange.IBotExchange{e}
+		f = &order.Filter{Exchange: e.GetName()}
+	}
+	orders, err := bot.OrderManager.GetOrdersActive(f)
+	if err != nil {
+		return "", err
+	}
+	bot.OrderManager.CancelAllOrders(ctx, exchanges)
+	remaining, err := bot.OrderManager.GetOrdersActive(f)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Cancelled %d of %d open orders", len(orders)-len(remaining), len(orders)), nil
+}
+
+func (bot *Engine) commsAlerts(_ context.Context, _ []string) (string, error) {
+	alerts := bot.CommunicationsManager.Alerts()
+	if len(alerts) == 0 {
+		return "No unacknowledged alerts", nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d unacknowledged alerts:", len(alerts))
+	for i := range alerts {
+		fmt.Fprintf(&sb, "\n%d %s %s %s: %s", alerts[i].ID, alerts[i].Time.UTC().Format("2006-01-02 15:04:05"), alerts[i].Event.Severity, alerts[i].Event.Type, alerts[i].Event.Message)
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsAcknowledge(_ context.Context, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%w: ack <id|all>", errCommandArgs)
+	}
+	if strings.EqualFold(args[0], "all") {
+		return fmt.Sprintf("Acknowledged %d alerts", bot.CommunicationsManager.AcknowledgeAllAlerts()), nil
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: alert id %q", errCommandArgs, args[0])
+	}
+	if err := bot.CommunicationsManager.AcknowledgeAlert(id); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Acknowledged alert %d", id), nil
+}
+
+func (bot *Engine) commsWithdrawals(_ context.Context, _ []string) (string, error) {
+	withdrawals, err := bot.withdrawalPolicyManager.GetWithdrawals("")
+	if err != nil {
+		return "", err
+	}
+	if len(withdrawals) == 0 {
+		return "No queued withdrawals", nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d queued withdrawals:", len(withdrawals))
+	for i := range withdrawals {
+		fmt.Fprintf(&sb, "\n%s %s %v %s from %s to %s %s (%d/%d approvals)",
+			withdrawals[i].ID, withdrawals[i].Requested.UTC().Format("2006-01-02 15:04:05"), withdrawals[i].Request.Amount,
+			withdrawals[i].Request.Currency, withdrawals[i].Request.Exchange, withdrawals[i].Request.Crypto.Address,
+			withdrawals[i].Status, len(withdrawals[i].Approvers), withdrawals[i].RequiredApprovals)
+	}
+	return sb.String(), nil
+}
+
+func (bot *Engine) commsApproveWithdrawal(ctx context.Context, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%w: approvewithdrawal <id>", errCommandArgs)
+	}
+	id, err := uuid.FromString(args[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: withdrawal id %q", errCommandArgs, args[0])
+	}
+	_, user, _ := base.CommandUser(ctx)
+	w, err := bot.withdrawalPolicyManager.Approve(ctx, id, user)
+	if err != nil {
+		return "", err
+	}
+	if w.Error != "" {
+		return fmt.Sprintf("Withdrawal %s %s: %s", w.ID, w.Status, w.Error), nil
+	}
+	return fmt.Sprintf("Withdrawal %s %s with %d/%d approvals", w.ID, w.Status, len(w.Approvers), w.RequiredApprovals), nil
+}
+
+func (bot *Engine) commsRejectWithdrawal(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("%w: rejectwithdrawal <id> [reason]", errCommandArgs)
+	}
+	id, err := uuid.FromString(args[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: withdrawal id %q", errCommandArgs, args[0])
+	}
+	_, user, _ := base.CommandUser(ctx)
+	w, err := bot.withdrawalPolicyManager.Reject(id, user, strings.Join(args[1:], " "))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Withdrawal %s rejected", w.ID), nil
+}
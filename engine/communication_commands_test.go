@@ -0,0 +1,173 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/telegram"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func commsCommandsTestSetup(t *testing.T) *Engine {
+	t.Helper()
+	var wg sync.WaitGroup
+	em := NewExchangeManager()
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{ActivelyTrackFuturesPositions: true, FuturesTrackingSeekDuration: time.Hour})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	require.NoError(t, om.Start(t.Context()), "OrderManager Start must not error")
+	return &Engine{
+		Config:                &config.Config{},
+		ExchangeManager:       em,
+		OrderManager:          om,
+		CommunicationsManager: &CommunicationManager{comms: &communications.Communications{}},
+	}
+}
+
+func TestSetupCommsCommands(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{}
+	assert.ErrorIs(t, bot.setupCommsCommands(), ErrNilSubsystem)
+
+	tg := &telegram.Telegram{}
+	bot.CommunicationsManager = &CommunicationManager{comms: &communications.Communications{IComm: base.IComm{tg}}}
+	require.NoError(t, bot.setupCommsCommands(), "setupCommsCommands must not error")
+	require.NotNil(t, tg.Commands, "setupCommsCommands must set the relayer commands")
+	assert.True(t, tg.Commands.IsCommand("/cancelall"), "setupCommsCommands should register cancelall")
+}
+
+func TestCommsCommands(t *testing.T) {
+	t.Parallel()
+	bot := commsCommandsTestSetup(t)
+	ctx := t.Context()
+
+	_, err := bot.commsBalances(ctx, nil)
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsBalances(ctx, []string{"binance", "nope"})
+	assert.Error(t, err, "commsBalances should error on an invalid asset")
+	_, err = bot.commsBalances(ctx, []string{"binance"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = bot.commsOrders(ctx, []string{"a", "b"})
+	assert.ErrorIs(t, err, errCommandArgs)
+	reply, err := bot.commsOrders(ctx, nil)
+	require.NoError(t, err, "commsOrders must not error")
+	assert.Equal(t, "No open orders", reply, "commsOrders should report no orders")
+
+	reply, err = bot.commsPositions(ctx, nil)
+	require.NoError(t, err, "commsPositions must not error")
+	assert.Equal(t, "No open positions", reply, "commsPositions should report no positions")
+	reply, err = bot.commsPNL(ctx, nil)
+	require.NoError(t, err, "commsPNL must not error")
+	assert.Equal(t, "No open positions", reply, "commsPNL should report no positions")
+
+	_, err = bot.commsCancelAll(ctx, []string{"a", "b"})
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsCancelAll(ctx, []string{"binance"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	reply, err = bot.commsCancelAll(ctx, nil)
+	require.NoError(t, err, "commsCancelAll must not error")
+	assert.Equal(t, "Cancelled 0 of 0 open orders", reply, "commsCancelAll should report the cancelled orders")
+
+	reply, err = bot.commsSetOrderManager(false)(ctx, nil)
+	require.NoError(t, err, "pause must not error")
+	assert.Equal(t, "Order manager paused", reply, "pause should report the order manager paused")
+	assert.False(t, bot.OrderManager.IsRunning(), "pause should stop the order manager")
+	_, err = bot.commsOrders(ctx, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	reply, err = bot.commsSetOrderManager(true)(ctx, nil)
+	require.NoError(t, err, "resume must not error")
+	assert.Equal(t, "Order manager resumed", reply, "resume should report the order manager resumed")
+	assert.True(t, bot.OrderManager.IsRunning(), "resume should start the order manager")
+	require.NoError(t, bot.OrderManager.Stop(), "OrderManager Stop must not error")
+}
+
+func TestCommsAlerts(t *testing.T) {
+	t.Parallel()
+	bot := commsCommandsTestSetup(t)
+	ctx := t.Context()
+	m := bot.CommunicationsManager
+
+	reply, err := bot.commsAlerts(ctx, nil)
+	require.NoError(t, err, "commsAlerts must not error")
+	assert.Equal(t, "No unacknowledged alerts", reply, "commsAlerts should report no alerts")
+
+	for range maxUnacknowledgedAlerts + 2 {
+		m.addAlert(base.Event{Type: "test", Message: "alert"})
+	}
+	alerts := m.Alerts()
+	require.Len(t, alerts, maxUnacknowledgedAlerts, "addAlert must keep at most the max alerts")
+	assert.Equal(t, uint64(3), alerts[0].ID, "addAlert should drop the oldest alerts")
+
+	reply, err = bot.commsAlerts(ctx, nil)
+	require.NoError(t, err, "commsAlerts must not error")
+	assert.Contains(t, reply, "100 unacknowledged alerts:\n3 ", "commsAlerts should list the alerts")
+
+	_, err = bot.commsAcknowledge(ctx, nil)
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsAcknowledge(ctx, []string{"x"})
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsAcknowledge(ctx, []string{"1"})
+	assert.ErrorIs(t, err, errAlertNotFound)
+	reply, err = bot.commsAcknowledge(ctx, []string{"3"})
+	require.NoError(t, err, "commsAcknowledge must not error")
+	assert.Equal(t, "Acknowledged alert 3", reply, "commsAcknowledge should acknowledge the alert")
+	assert.Len(t, m.Alerts(), maxUnacknowledgedAlerts-1, "commsAcknowledge should remove the alert")
+	reply, err = bot.commsAcknowledge(ctx, []string{"ALL"})
+	require.NoError(t, err, "commsAcknowledge must not error")
+	assert.Equal(t, "Acknowledged 99 alerts", reply, "commsAcknowledge should acknowledge all alerts")
+	assert.Empty(t, m.Alerts(), "commsAcknowledge should remove all alerts")
+}
+
+func TestCommsWithdrawals(t *testing.T) {
+	t.Parallel()
+	bot := commsCommandsTestSetup(t)
+	ctx := t.Context()
+
+	_, err := bot.commsWithdrawals(ctx, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	var wm *policyWithdrawer
+	bot.withdrawalPolicyManager, wm, _, _ = newTestWithdrawalPolicy(t, &testWithdrawalPolicyConfig)
+	reply, err := bot.commsWithdrawals(ctx, nil)
+	require.NoError(t, err, "commsWithdrawals must not error")
+	assert.Equal(t, "No queued withdrawals", reply, "commsWithdrawals should report no withdrawals")
+
+	approved, err := bot.withdrawalPolicyManager.Request(ctx, newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	rejected, err := bot.withdrawalPolicyManager.Request(ctx, newTestPolicyRequest(currency.ETH, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	reply, err = bot.commsWithdrawals(ctx, nil)
+	require.NoError(t, err, "commsWithdrawals must not error")
+	assert.Contains(t, reply, "2 queued withdrawals:\n"+rejected.ID.String(), "commsWithdrawals should list the newest withdrawal first")
+	assert.Contains(t, reply, "1 BTC from alpha to trusted pending approval (0/2 approvals)", "commsWithdrawals should list the withdrawals")
+
+	_, err = bot.commsApproveWithdrawal(ctx, nil)
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsApproveWithdrawal(ctx, []string{"x"})
+	assert.ErrorIs(t, err, errCommandArgs)
+	_, err = bot.commsApproveWithdrawal(ctx, []string{approved.ID.String()})
+	assert.ErrorIs(t, err, errNotWithdrawalApprover, "commsApproveWithdrawal should require a command user")
+	_, err = bot.commsRejectWithdrawal(ctx, nil)
+	assert.ErrorIs(t, err, errCommandArgs)
+
+	c := base.NewCommands(nil)
+	require.NoError(t, c.Register(&base.Command{Name: "approvewithdrawal", Func: bot.commsApproveWithdrawal}), "Register must not error")
+	require.NoError(t, c.Register(&base.Command{Name: "rejectwithdrawal", Func: bot.commsRejectWithdrawal}), "Register must not error")
+	handle := func(user, text string) string {
+		return c.Handle(ctx, &base.CommandRequest{Relayer: "telegram", User: user, Text: text, AllowedUsers: []string{"alice", "bob", "mallory"}})
+	}
+	assert.Contains(t, handle("mallory", "/approvewithdrawal "+approved.ID.String()), errNotWithdrawalApprover.Error())
+	assert.Equal(t, "Withdrawal "+approved.ID.String()+" pending approval with 1/2 approvals", handle("alice", "/approvewithdrawal "+approved.ID.String()))
+	assert.Equal(t, "Withdrawal "+approved.ID.String()+" submitted with 2/2 approvals", handle("bob", "/approvewithdrawal "+approved.ID.String()))
+	assert.Len(t, wm.requests, 1, "approvewithdrawal should submit the approved withdrawal")
+	assert.Equal(t, "Withdrawal "+rejected.ID.String()+" rejected", handle("alice", "/rejectwithdrawal "+rejected.ID.String()+" wrong address"))
+	w, err := bot.withdrawalPolicyManager.GetWithdrawals(PolicyRejected)
+	require.NoError(t, err, "GetWithdrawals must not error")
+	require.Len(t, w, 1, "rejectwithdrawal must reject the withdrawal")
+	assert.Equal(t, "wrong address", w[0].Reason)
+}
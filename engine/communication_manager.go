@@ -1,8 +1,12 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
@@ -12,12 +16,31 @@ import (
 // CommunicationsManagerName is an exported subsystem name
 const CommunicationsManagerName = "communications"
 
+// maxUnacknowledgedAlerts is the number of alerts kept before the oldest are
+// dropped
+const maxUnacknowledgedAlerts = 100
+
+var errAlertNotFound = errors.New("alert not found")
+
 // CommunicationManager ensures operations of communications
 type CommunicationManager struct {
 	started  atomic.Bool
 	shutdown chan struct{}
+	wg       sync.WaitGroup
 	relayMsg chan base.Event
 	comms    *communications.Communications
+
+	alertsMtx   sync.Mutex
+	alerts      []Alert
+	lastAlertID uint64
+}
+
+// Alert is an event relayed by the communications manager which has not been
+// acknowledged
+type Alert struct {
+	ID    uint64
+	Time  time.Time
+	Event base.Event
 }
 
 // SetupCommunicationManager creates a communications manager
@@ -55,6 +78,9 @@ func (m *CommunicationManager) Start() error {
 	}
 	log.Debugf(log.CommunicationMgr, "Communications manager %s", MsgSubSystemStarting)
 	m.shutdown = make(chan struct{})
+	// Relayers shut down by a previous Stop are connected again
+	m.comms.Setup()
+	m.wg.Add(1)
 	go m.run()
 	return nil
 }
@@ -67,6 +93,54 @@ func (m *CommunicationManager) GetStatus() (map[string]base.CommsStatus, error)
 	return m.comms.GetStatus(), nil
 }
 
+// SetCommands sets the chat commands for the relayers which accept commands
+func (m *CommunicationManager) SetCommands(c *base.Commands) error {
+	if m == nil || m.comms == nil {
+		return fmt.Errorf("communications manager %w", ErrNilSubsystem)
+	}
+	m.comms.SetCommands(c)
+	return nil
+}
+
+// Alerts returns the unacknowledged alerts, oldest first
+func (m *CommunicationManager) Alerts() []Alert {
+	m.alertsMtx.Lock()
+	defer m.alertsMtx.Unlock()
+	return slices.Clone(m.alerts)
+}
+
+// AcknowledgeAlert removes an alert by its ID
+func (m *CommunicationManager) AcknowledgeAlert(id uint64) error {
+	m.alertsMtx.Lock()
+	defer m.alertsMtx.Unlock()
+	i := slices.IndexFunc(m.alerts, func(a Alert) bool { return a.ID == id })
+	if i == -1 {
+		return fmt.Errorf("%w: %d", errAlertNotFound, id)
+	}
+	m.alerts = slices.Delete(m.alerts, i, i+1)
+	return nil
+}
+
+// AcknowledgeAllAlerts removes all alerts and returns how many were removed
+func (m *CommunicationManager) AcknowledgeAllAlerts() int {
+	m.alertsMtx.Lock()
+	defer m.alertsMtx.Unlock()
+	n := len(m.alerts)
+	m.alerts = nil
+	return n
+}
+
+// addAlert stores a relayed event until it is acknowledged
+func (m *CommunicationManager) addAlert(evt base.Event) {
+	m.alertsMtx.Lock()
+	defer m.alertsMtx.Unlock()
+	m.lastAlertID++
+	m.alerts = append(m.alerts, Alert{ID: m.lastAlertID, Time: time.Now(), Event: evt})
+	if len(m.alerts) > maxUnacknowledgedAlerts {
+		m.alerts = slices.Delete(m.alerts, 0, len(m.alerts)-maxUnacknowledgedAlerts)
+	}
+}
+
 // Stop attempts to shutdown the subsystem
 func (m *CommunicationManager) Stop() error {
 	if m == nil {
@@ -80,6 +154,7 @@ func (m *CommunicationManager) Stop() error {
 	}()
 	close(m.shutdown)
 	log.Debugf(log.CommunicationMgr, "Communications manager %s", MsgSubSystemShuttingDown)
+	m.wg.Wait()
 	return nil
 }
 
@@ -101,13 +176,26 @@ func (m *CommunicationManager) run() {
 	defer func() {
 		// TO-DO shutdown comms connections for connected services (Slack etc)
 		log.Debugf(log.CommunicationMgr, "Communications manager %s", MsgSubSystemShutdown)
+		m.wg.Done()
 	}()
 
+	var digest <-chan time.Time
+	if interval := m.comms.DigestInterval(); interval > 0 {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		digest = t.C
+	}
+
 	for {
 		select {
 		case msg := <-m.relayMsg:
+			m.addAlert(msg)
 			m.comms.PushEvent(msg)
+		case <-digest:
+			m.comms.FlushDigest()
 		case <-m.shutdown:
+			m.comms.FlushDigest()
+			m.comms.Shutdown()
 			return
 		}
 	}
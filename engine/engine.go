@@ -14,10 +14,12 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -48,6 +50,17 @@ type Engine struct {
 	WithdrawManager          *WithdrawManager
 	dataHistoryManager       *DataHistoryManager
 	currencyStateManager     *CurrencyStateManager
+	exchangeHealthManager    *ExchangeHealthManager
+	carryMonitor             *CarryMonitor
+	marginMonitor            *MarginMonitor
+	rebalancer               *Rebalancer
+	withdrawalReconciler     *WithdrawalReconciler
+	withdrawalPolicyManager  *WithdrawalPolicyManager
+	portfolioValuer          *PortfolioValuer
+	tradeLedger              *TradeLedger
+	exchangeHealthRecorder   *restHealthRecorder
+	tracingProvider          *tracing.Provider
+	secretsProvider          secrets.Provider
 	Settings                 Settings
 	uptime                   time.Time
 	GRPCShutdownSignal       chan struct{}
@@ -179,7 +192,16 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
+	flagSet.WithBool("exchangehealthmanager", &b.Settings.EnableExchangeHealthManager, b.Config.ExchangeHealth.Enabled)
+	flagSet.WithBool("carrymonitor", &b.Settings.EnableCarryMonitor, b.Config.CarryMonitor.Enabled)
+	flagSet.WithBool("marginmonitor", &b.Settings.EnableMarginMonitor, b.Config.MarginMonitor.Enabled)
+	flagSet.WithBool("rebalancer", &b.Settings.EnableRebalancer, b.Config.Rebalancer.Enabled)
+	flagSet.WithBool("withdrawalreconciler", &b.Settings.EnableWithdrawalReconciler, b.Config.WithdrawalReconciler.Enabled)
+	flagSet.WithBool("withdrawalpolicy", &b.Settings.EnableWithdrawalPolicy, b.Config.WithdrawalPolicy.Enabled)
+	flagSet.WithBool("portfoliovaluer", &b.Settings.EnablePortfolioValuer, b.Config.PortfolioValuation.Enabled)
+	flagSet.WithBool("tradeledger", &b.Settings.EnableTradeLedger, b.Config.TradeLedger.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
+	flagSet.WithBool("tracing", &b.Settings.EnableTracing, b.Config.Tracing.Enabled)
 
 	flagSet.WithBool("tickersync", &b.Settings.EnableTickerSyncing, b.Config.SyncManagerConfig.SynchronizeTicker)
 	flagSet.WithBool("orderbooksync", &b.Settings.EnableOrderbookSyncing, b.Config.SyncManagerConfig.SynchronizeOrderbook)
@@ -320,6 +342,15 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableTracing {
+		if p, err := tracing.Setup(runtimeCtx, &bot.Config.Tracing); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracing unable to setup: %v", err)
+		} else {
+			bot.tracingProvider = p
+			gctlog.Debugf(gctlog.Global, "Tracing enabled using %s exporter.\n", bot.Config.Tracing.Exporter)
+		}
+	}
+
 	if bot.Settings.EnableDatabaseManager {
 		if d, err := SetupDatabaseConnectionManager(&bot.Config.Database); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to setup: %v", err)
@@ -392,16 +423,40 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	if bot.Config.Secrets.Enabled {
+		if p, err := secrets.NewProvider(&bot.Config.Secrets); err != nil {
+			gctlog.Errorf(gctlog.Global, "Secrets provider unable to setup: %v", err)
+		} else {
+			bot.secretsProvider = p
+			gctlog.Debugf(gctlog.Global, "Loading exchange API credentials using %s secrets provider.\n", bot.Config.Secrets.Provider)
+		}
+	}
+
+	if bot.Settings.EnableExchangeHealthManager {
+		// Requesters take the global reporter when created, so REST request
+		// outcomes are only recorded for exchanges loaded after this point
+		bot.exchangeHealthRecorder = newRESTHealthRecorder()
+		request.SetupGlobalReporter(bot.exchangeHealthRecorder)
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
 	}
 
+	if bot.secretsProvider != nil && bot.Config.Secrets.RefreshInterval > 0 {
+		bot.ServicesWG.Add(1)
+		go bot.refreshExchangeCredentials(runtimeCtx, bot.Config.Secrets.RefreshInterval)
+	}
+
 	if bot.Settings.EnableCommsRelayer {
 		if c, err := SetupCommunicationManager(&bot.Config.Communications); err != nil {
 			gctlog.Errorf(gctlog.Global, "Communications manager unable to setup: %s", err)
 		} else {
 			bot.CommunicationsManager = c
+			if err := bot.setupCommsCommands(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Communications manager unable to setup commands: %s", err)
+			}
 			if err := bot.CommunicationsManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Communications manager unable to start: %s", err)
 			}
@@ -459,6 +514,25 @@ func (bot *Engine) Start() error {
 		bot.WithdrawManager = w
 	}
 
+	if bot.Settings.EnableWithdrawalPolicy {
+		p, err := SetupWithdrawalPolicyManager(
+			bot.WithdrawManager,
+			bot.portfolioManager,
+			bot.CommunicationsManager,
+			&bot.Config.WithdrawalPolicy,
+		)
+		if err != nil {
+			return fmt.Errorf("%s unable to setup: %w", WithdrawalPolicyManagerName, err)
+		}
+		bot.withdrawalPolicyManager = p
+		if err := bot.WithdrawManager.SetPolicy(p); err != nil {
+			return err
+		}
+		if err := p.Start(runtimeCtx); err != nil {
+			return fmt.Errorf("%s unable to start: %w", WithdrawalPolicyManagerName, err)
+		}
+	}
+
 	if bot.Settings.EnableDepositAddressManager {
 		bot.DepositAddressManager = SetupDepositAddressManager()
 		go func() {
@@ -477,6 +551,11 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.Config.OrderManager.PersistFuturesPositions {
+				if err = bot.OrderManager.SetupFuturesPositionPersistence(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to persist futures positions: %s", err)
+				}
+			}
 			if err = bot.OrderManager.Start(runtimeCtx); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
@@ -573,6 +652,121 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableExchangeHealthManager {
+		if h, err := SetupExchangeHealthManager(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			bot.currencyPairSyncer,
+			bot.exchangeHealthRecorder,
+			&bot.Config.ExchangeHealth,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", ExchangeHealthManagerName, err)
+		} else {
+			bot.exchangeHealthManager = h
+			if err := bot.exchangeHealthManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", ExchangeHealthManagerName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableCarryMonitor {
+		if c, err := SetupCarryMonitor(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			bot.DatabaseManager,
+			&bot.Config.CarryMonitor,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", CarryMonitorName, err)
+		} else {
+			bot.carryMonitor = c
+			if err := bot.carryMonitor.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", CarryMonitorName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableMarginMonitor {
+		if m, err := SetupMarginMonitor(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			&bot.Config.MarginMonitor,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", MarginMonitorName, err)
+		} else {
+			bot.marginMonitor = m
+			if err := bot.marginMonitor.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", MarginMonitorName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableRebalancer {
+		if r, err := SetupRebalancer(
+			bot.ExchangeManager,
+			bot.portfolioManager,
+			bot.WithdrawManager,
+			bot.CommunicationsManager,
+			&bot.Config.Rebalancer,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", RebalancerName, err)
+		} else {
+			bot.rebalancer = r
+			if err := bot.rebalancer.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", RebalancerName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableWithdrawalReconciler {
+		if w, err := SetupWithdrawalReconciler(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			bot.DatabaseManager,
+			&bot.Config.WithdrawalReconciler,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", WithdrawalReconcilerName, err)
+		} else {
+			bot.withdrawalReconciler = w
+			if err := bot.withdrawalReconciler.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", WithdrawalReconcilerName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnablePortfolioValuer {
+		if p, err := SetupPortfolioValuer(
+			bot.ExchangeManager,
+			bot.portfolioManager,
+			bot.DatabaseManager,
+			&bot.Config.PortfolioValuation,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", PortfolioValuerName, err)
+		} else {
+			bot.portfolioValuer = p
+			if err := bot.portfolioValuer.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", PortfolioValuerName, err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableTradeLedger {
+		if l, err := SetupTradeLedger(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.WebsocketRoutineManager,
+			bot.DatabaseManager,
+			&bot.Config.TradeLedger,
+		); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", TradeLedgerName, err)
+		} else {
+			bot.tradeLedger = l
+			if err := bot.tradeLedger.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "%s unable to start: %s", TradeLedgerName, err)
+			}
+		}
+	}
+
 	startSuccessful = true
 	return nil
 }
@@ -751,6 +945,54 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	if bot.exchangeHealthManager.IsRunning() {
+		if err := bot.exchangeHealthManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Exchange health manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.carryMonitor.IsRunning() {
+		if err := bot.carryMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Carry monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.marginMonitor.IsRunning() {
+		if err := bot.marginMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Margin monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.rebalancer.IsRunning() {
+		if err := bot.rebalancer.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Rebalancer unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.withdrawalReconciler.IsRunning() {
+		if err := bot.withdrawalReconciler.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal reconciler unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.withdrawalPolicyManager.IsRunning() {
+		if err := bot.withdrawalPolicyManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal policy manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.portfolioValuer.IsRunning() {
+		if err := bot.portfolioValuer.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio valuer unable to stop. Error: %v", err)
+		}
+	}
+
+	if bot.tradeLedger.IsRunning() {
+		if err := bot.tradeLedger.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade ledger unable to stop. Error: %v", err)
+		}
+	}
+
 	err := bot.ExchangeManager.Shutdown(bot.Settings.ExchangeShutdownTimeout)
 	if err != nil {
 		gctlog.Errorf(gctlog.Global, "Exchange manager unable to stop. Error: %v", err)
@@ -761,6 +1003,15 @@ func (bot *Engine) Stop() {
 		gctlog.Errorf(gctlog.Global, "Currency Converter unable to stop. Error: %v", err)
 	}
 
+	if bot.tracingProvider != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		if err := bot.tracingProvider.Shutdown(shutdownCtx); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracing unable to flush and stop. Error: %v", err)
+		}
+		cancel()
+		bot.tracingProvider = nil
+	}
+
 	if !bot.Settings.EnableDryRun {
 		err = bot.Config.SaveConfigToFile(bot.Settings.ConfigFile)
 		if err != nil {
@@ -890,6 +1141,12 @@ func (bot *Engine) LoadExchange(name string) error {
 	ctx := bot.getRuntimeContext()
 
 	b := exch.GetBase()
+	if bot.secretsProvider != nil {
+		b.SetSecretsProvider(bot.secretsProvider)
+		if err := b.RefreshCredentials(ctx); err != nil {
+			gctlog.Errorf(gctlog.ExchangeSys, "%s unable to load API credentials from secrets provider: %v", exch.GetName(), err)
+		}
+	}
 	if b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport {
 		enabledAssets := b.CurrencyPairs.GetAssetTypes(true)
 		var preferredAsset asset.Item
@@ -933,6 +1190,26 @@ func (bot *Engine) dryRunParamInteraction(param string) {
 	}
 }
 
+// refreshExchangeCredentials periodically reloads exchange API credentials
+// from the secrets provider so rotated keys are used without a restart
+func (bot *Engine) refreshExchangeCredentials(ctx context.Context, interval time.Duration) {
+	defer bot.ServicesWG.Done()
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			for _, exch := range bot.GetExchanges() {
+				if err := exch.GetBase().RefreshCredentials(ctx); err != nil {
+					gctlog.Errorf(gctlog.ExchangeSys, "%s unable to refresh API credentials from secrets provider: %v", exch.GetName(), err)
+				}
+			}
+		}
+	}
+}
+
 // SetupExchanges sets up the exchanges used by the Bot
 func (bot *Engine) SetupExchanges() error {
 	configs := bot.Config.GetAllExchangeConfigs()
@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
@@ -391,6 +393,28 @@ func TestUnloadExchange(t *testing.T) {
 	assert.ErrorIs(t, err, ErrExchangeNotFound)
 }
 
+func TestRefreshExchangeCredentials(t *testing.T) {
+	t.Setenv("GCTENGINETEST_"+strings.ToUpper(testExchange)+"_KEY", "rotated")
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().SetCredentials(&accounts.Credentials{Key: "config"})
+	exch.GetBase().SetSecretsProvider(secrets.NewEnvironmentProvider("GCTENGINETEST"))
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	e := &Engine{ExchangeManager: em}
+	ctx, cancel := context.WithCancel(t.Context())
+	e.ServicesWG.Add(1)
+	go e.refreshExchangeCredentials(ctx, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return exch.GetBase().GetDefaultCredentials().Key == "rotated"
+	}, time.Second, time.Millisecond, "credentials should be reloaded from the secrets provider")
+	cancel()
+	e.ServicesWG.Wait()
+}
+
 func TestDryRunParamInteraction(t *testing.T) {
 	t.Parallel()
 	bot := &Engine{
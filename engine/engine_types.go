@@ -52,8 +52,17 @@ type CoreSettings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EnableCurrencyStateManager  bool
+	EnableExchangeHealthManager bool
+	EnableCarryMonitor          bool
+	EnableMarginMonitor         bool
+	EnableRebalancer            bool
+	EnableWithdrawalReconciler  bool
+	EnableWithdrawalPolicy      bool
+	EnablePortfolioValuer       bool
+	EnableTradeLedger           bool
 	EventManagerDelay           time.Duration
 	EnableFuturesTracking       bool
+	EnableTracing               bool
 	Verbose                     bool
 	EnableDispatcher            bool
 	DispatchMaxWorkerAmount     int
@@ -122,6 +131,8 @@ const (
 	MsgStatusError string = "error"
 	grpcName       string = "grpc"
 	grpcProxyName  string = "grpc_proxy"
+
+	tracingShutdownTimeout = 5 * time.Second
 )
 
 // newConfigMutex only locks and unlocks on engine creation functions
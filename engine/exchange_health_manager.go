@@ -0,0 +1,386 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errExchangeHealthNotFound     = errors.New("exchange health not found")
+	errInvalidHealthCheckInterval = errors.New("exchange health check interval must be positive")
+)
+
+// SetupExchangeHealthManager applies configuration parameters before running.
+// REST request outcomes are only rated when a recorder is supplied and was set
+// as the global request reporter before the exchanges were loaded
+func SetupExchangeHealthManager(em iExchangeManager, comms iCommsManager, syncer iOrderbookSyncer, rest *restHealthRecorder, cfg *config.ExchangeHealthConfig) (*ExchangeHealthManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w ExchangeHealth", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidHealthCheckInterval
+	}
+	return &ExchangeHealthManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		comms:           comms,
+		syncer:          syncer,
+		rest:            rest,
+		cfg:             *cfg,
+		health:          make(map[string]*exchangeHealthState),
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *ExchangeHealthManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ExchangeHealthManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", ExchangeHealthManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Exchange health manager %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Exchange health manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *ExchangeHealthManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ExchangeHealthManagerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", ExchangeHealthManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Exchange health manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Exchange health manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ExchangeHealthManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetHealth returns the health of an exchange, or of all checked exchanges
+// when the exchange name is empty, ordered by exchange name
+func (m *ExchangeHealthManager) GetHealth(exchangeName string) ([]ExchangeHealth, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", ExchangeHealthManagerName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if exchangeName != "" {
+		s, ok := m.health[strings.ToLower(exchangeName)]
+		if !ok {
+			return nil, fmt.Errorf("%w for %s", errExchangeHealthNotFound, exchangeName)
+		}
+		return []ExchangeHealth{s.snapshot()}, nil
+	}
+	resp := make([]ExchangeHealth, 0, len(m.health))
+	for _, s := range m.health {
+		resp = append(resp, s.snapshot())
+	}
+	slices.SortFunc(resp, func(a, b ExchangeHealth) int {
+		return strings.Compare(a.Exchange, b.Exchange)
+	})
+	return resp, nil
+}
+
+func (m *ExchangeHealthManager) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll checks each exchange concurrently
+func (m *ExchangeHealthManager) checkAll(ctx context.Context) {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Exchange health manager failed to get exchanges: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.CheckInterval)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, exch := range exchs {
+		wg.Go(func() { m.check(ctx, exch, time.Now()) })
+	}
+	wg.Wait()
+}
+
+// check rates the health of an exchange and notifies any incident or recovery
+func (m *ExchangeHealthManager) check(ctx context.Context, exch exchange.IBotExchange, now time.Time) {
+	name := exch.GetName()
+	m.mu.RLock()
+	s, ok := m.health[strings.ToLower(name)]
+	serverTimeUnsupported := ok && s.serverTimeUnsupported
+	m.mu.RUnlock()
+
+	h := ExchangeHealth{Exchange: name, LastChecked: now}
+	rest := m.rest.drain(name)
+	h.RESTRequests, h.RESTErrors = rest.requests, rest.errors
+	if rest.requests > 0 {
+		h.RESTErrorRate = float64(rest.errors) / float64(rest.requests)
+	}
+	if rest.latencies > 0 {
+		h.AverageLatency = rest.latency / time.Duration(rest.latencies)
+	}
+
+	if ws, err := exch.GetWebsocket(); err == nil && ws.IsEnabled() {
+		h.WebsocketEnabled = true
+		h.WebsocketConnected = ws.IsConnected()
+		h.WebsocketConnecting = ws.IsConnecting()
+	}
+
+	if m.syncer != nil {
+		if updated, ok := m.syncer.OldestOrderbookUpdate(name); ok {
+			h.OrderbookStaleness = now.Sub(updated)
+		}
+	}
+
+	if !serverTimeUnsupported {
+		drift, err := serverTimeDrift(ctx, exch)
+		switch {
+		case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented):
+			serverTimeUnsupported = true
+		case err != nil:
+			if m.cfg.Verbose {
+				log.Warnf(log.ExchangeSys, "Exchange health manager %s server time check failed: %v", name, err)
+			}
+		default:
+			h.TimeDrift = drift
+		}
+	}
+
+	m.update(&h, m.rate(&h), serverTimeUnsupported)
+}
+
+// rate returns the status of the checked exchange health and sets the reasons
+// for any status worse than healthy
+func (m *ExchangeHealthManager) rate(h *ExchangeHealth) ExchangeHealthStatus {
+	status := HealthHealthy
+	add := func(st ExchangeHealthStatus, reason string, args ...any) {
+		status = max(status, st)
+		h.Reasons = append(h.Reasons, fmt.Sprintf(reason, args...))
+	}
+	if h.RESTRequests >= m.cfg.MinRequests {
+		switch {
+		case h.RESTErrorRate >= m.cfg.DownErrorRate:
+			add(HealthDown, "REST error rate %.0f%%", h.RESTErrorRate*100)
+		case h.RESTErrorRate >= m.cfg.DegradedErrorRate:
+			add(HealthDegraded, "REST error rate %.0f%%", h.RESTErrorRate*100)
+		}
+	}
+	if h.AverageLatency > m.cfg.MaxLatency {
+		add(HealthDegraded, "REST latency %s", h.AverageLatency.Round(time.Millisecond))
+	}
+	if h.WebsocketEnabled && !h.WebsocketConnected {
+		if h.WebsocketConnecting {
+			add(HealthDegraded, "websocket reconnecting")
+		} else {
+			add(HealthDown, "websocket disconnected")
+		}
+	}
+	if h.OrderbookStaleness > m.cfg.MaxOrderbookStaleness {
+		add(HealthDegraded, "orderbook stale for %s", h.OrderbookStaleness.Round(time.Second))
+	}
+	if h.TimeDrift.Abs() > m.cfg.MaxTimeDrift {
+		add(HealthDegraded, "server time drift %s", h.TimeDrift.Round(time.Millisecond))
+	}
+	return status
+}
+
+// update stores the checked health, an exchange only recovers after the
+// configured number of consecutive healthy checks
+func (m *ExchangeHealthManager) update(h *ExchangeHealth, status ExchangeHealthStatus, serverTimeUnsupported bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := strings.ToLower(h.Exchange)
+	s, ok := m.health[k]
+	if !ok {
+		s = &exchangeHealthState{ExchangeHealth: ExchangeHealth{Since: h.LastChecked}}
+		m.health[k] = s
+	}
+	s.serverTimeUnsupported = serverTimeUnsupported
+	prev := s.Status
+	if status == HealthHealthy {
+		s.healthyChecks++
+		if prev > HealthHealthy && s.healthyChecks < m.cfg.RecoveryChecks {
+			status = prev
+			h.Reasons = []string{fmt.Sprintf("recovering, %d of %d healthy checks", s.healthyChecks, m.cfg.RecoveryChecks)}
+		}
+	} else {
+		s.healthyChecks = 0
+	}
+	h.Status, h.Since = status, s.Since
+	if status != prev {
+		h.Since = h.LastChecked
+	}
+	s.ExchangeHealth = *h
+	if m.cfg.Verbose || status != prev {
+		log.Debugf(log.ExchangeSys, "Exchange health manager %s is %s %v", h.Exchange, status, h.Reasons)
+	}
+
+	switch {
+	case status > HealthHealthy && status != s.notified:
+		severity := base.SeverityWarning
+		if status == HealthDown {
+			severity = base.SeverityCritical
+		}
+		m.notify(&base.ExchangeHealth{
+			Exchange: h.Exchange,
+			Status:   status.String(),
+			Previous: max(s.notified, HealthHealthy).String(),
+			Reasons:  h.Reasons,
+		}, severity)
+		s.notified = status
+	case status == HealthHealthy && s.notified > HealthHealthy:
+		m.notify(&base.ExchangeHealth{
+			Exchange:  h.Exchange,
+			Status:    status.String(),
+			Previous:  s.notified.String(),
+			Recovered: true,
+		}, base.SeverityInfo)
+		s.notified = HealthHealthy
+	}
+}
+
+func (m *ExchangeHealthManager) notify(p *base.ExchangeHealth, severity base.Severity) {
+	evt := base.NewEvent(p, severity)
+	evt.Key = evt.Type + "|" + p.Exchange + "|" + p.Status
+	m.comms.PushEvent(evt)
+}
+
+// snapshot returns a copy of the exchange health
+func (s *exchangeHealthState) snapshot() ExchangeHealth {
+	h := s.ExchangeHealth
+	h.Reasons = append([]string(nil), s.Reasons...)
+	return h
+}
+
+// serverTimeDrift returns the exchange server time less the local time at the
+// midpoint of the request
+func serverTimeDrift(ctx context.Context, exch exchange.IBotExchange) (time.Duration, error) {
+	assets := exch.GetAssetTypes(true)
+	if len(assets) == 0 {
+		return 0, common.ErrFunctionNotSupported
+	}
+	start := time.Now()
+	serverTime, err := exch.GetServerTime(ctx, assets[0])
+	if err != nil {
+		return 0, err
+	}
+	if serverTime.IsZero() {
+		return 0, common.ErrFunctionNotSupported
+	}
+	end := time.Now()
+	return serverTime.Sub(start.Add(end.Sub(start) / 2)), nil
+}
+
+// String implements the stringer interface
+func (s ExchangeHealthStatus) String() string {
+	switch s {
+	case HealthHealthy:
+		return "healthy"
+	case HealthDegraded:
+		return "degraded"
+	case HealthDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// newRESTHealthRecorder returns a recorder to be set as the global request
+// reporter
+func newRESTHealthRecorder() *restHealthRecorder {
+	return &restHealthRecorder{stats: make(map[string]*restHealthStats)}
+}
+
+// Latency records a REST request which received a response
+func (r *restHealthRecorder) Latency(name, _, _ string, t time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.get(name)
+	s.requests++
+	s.latencies++
+	s.latency += t
+}
+
+// RequestError records a REST request which failed to send, was rate limited
+// or returned a server error. Responses have already been counted as requests
+// by Latency
+func (r *restHealthRecorder) RequestError(name, _, _ string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.get(name)
+	if !errors.Is(err, request.ErrBadStatus) {
+		s.requests++
+	}
+	s.errors++
+}
+
+// drain returns and resets the REST request outcomes of an exchange
+func (r *restHealthRecorder) drain(name string) restHealthStats {
+	if r == nil {
+		return restHealthStats{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := strings.ToLower(name)
+	s, ok := r.stats[k]
+	if !ok {
+		return restHealthStats{}
+	}
+	delete(r.stats, k)
+	return *s
+}
+
+func (r *restHealthRecorder) get(name string) *restHealthStats {
+	k := strings.ToLower(name)
+	s, ok := r.stats[k]
+	if !ok {
+		s = &restHealthStats{}
+		r.stats[k] = s
+	}
+	return s
+}
@@ -0,0 +1,204 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+var testHealthConfig = config.ExchangeHealthConfig{
+	CheckInterval:         time.Minute,
+	MinRequests:           10,
+	DegradedErrorRate:     0.1,
+	DownErrorRate:         0.5,
+	MaxLatency:            time.Second,
+	MaxOrderbookStaleness: time.Minute,
+	MaxTimeDrift:          time.Second,
+	RecoveryChecks:        2,
+}
+
+type healthExchange struct {
+	exchange.IBotExchange
+	serverTime    time.Time
+	serverTimeErr error
+	timeRequests  int
+}
+
+func (h *healthExchange) GetName() string { return "healthy" }
+
+func (h *healthExchange) GetAssetTypes(bool) asset.Items { return asset.Items{asset.Spot} }
+
+func (h *healthExchange) GetWebsocket() (*websocket.Manager, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (h *healthExchange) GetServerTime(context.Context, asset.Item) (time.Time, error) {
+	h.timeRequests++
+	return h.serverTime, h.serverTimeErr
+}
+
+type healthSyncer struct {
+	updated time.Time
+}
+
+func (h *healthSyncer) OldestOrderbookUpdate(string) (time.Time, bool) {
+	return h.updated, !h.updated.IsZero()
+}
+
+func TestSetupExchangeHealthManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupExchangeHealthManager(nil, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupExchangeHealthManager(&ExchangeManager{}, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupExchangeHealthManager(&ExchangeManager{}, &testCommsManager{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupExchangeHealthManager(&ExchangeManager{}, &testCommsManager{}, nil, nil, &config.ExchangeHealthConfig{})
+	assert.ErrorIs(t, err, errInvalidHealthCheckInterval)
+	m, err := SetupExchangeHealthManager(&ExchangeManager{}, &testCommsManager{}, nil, nil, &testHealthConfig)
+	require.NoError(t, err, "SetupExchangeHealthManager must not error")
+	assert.NotNil(t, m.health, "SetupExchangeHealthManager should initialise the health map")
+}
+
+func TestExchangeHealthManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ExchangeHealthManager
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil manager")
+
+	m, err := SetupExchangeHealthManager(NewExchangeManager(), &testCommsManager{}, nil, nil, &testHealthConfig)
+	require.NoError(t, err, "SetupExchangeHealthManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestRESTHealthRecorder(t *testing.T) {
+	t.Parallel()
+	var r *restHealthRecorder
+	assert.Zero(t, r.drain("test"), "drain should return no outcomes for a nil recorder")
+
+	r = newRESTHealthRecorder()
+	r.Latency("Test", "GET", "/", time.Second)
+	r.Latency("test", "GET", "/", 3*time.Second)
+	r.RequestError("test", "GET", "/", request.ErrBadStatus)
+	r.RequestError("TEST", "GET", "/", errors.New("connection refused"))
+	assert.Equal(t, restHealthStats{requests: 3, errors: 2, latencies: 2, latency: 4 * time.Second}, r.drain("test"),
+		"drain should return the outcomes without counting error responses twice")
+	assert.Zero(t, r.drain("test"), "drain should reset the outcomes")
+}
+
+func TestExchangeHealthRate(t *testing.T) {
+	t.Parallel()
+	m := &ExchangeHealthManager{cfg: testHealthConfig}
+	for _, tc := range []struct {
+		health  ExchangeHealth
+		status  ExchangeHealthStatus
+		reasons []string
+	}{
+		{ExchangeHealth{RESTRequests: 9, RESTErrorRate: 1}, HealthHealthy, nil},
+		{ExchangeHealth{RESTRequests: 10, RESTErrorRate: 0.2}, HealthDegraded, []string{"REST error rate 20%"}},
+		{ExchangeHealth{RESTRequests: 10, RESTErrorRate: 0.5}, HealthDown, []string{"REST error rate 50%"}},
+		{ExchangeHealth{AverageLatency: 1500 * time.Millisecond}, HealthDegraded, []string{"REST latency 1.5s"}},
+		{ExchangeHealth{WebsocketEnabled: true, WebsocketConnected: true}, HealthHealthy, nil},
+		{ExchangeHealth{WebsocketEnabled: true, WebsocketConnecting: true}, HealthDegraded, []string{"websocket reconnecting"}},
+		{ExchangeHealth{WebsocketEnabled: true}, HealthDown, []string{"websocket disconnected"}},
+		{ExchangeHealth{OrderbookStaleness: 2 * time.Minute}, HealthDegraded, []string{"orderbook stale for 2m0s"}},
+		{ExchangeHealth{TimeDrift: -2 * time.Second}, HealthDegraded, []string{"server time drift -2s"}},
+		{ExchangeHealth{WebsocketEnabled: true, TimeDrift: 2 * time.Second}, HealthDown, []string{"websocket disconnected", "server time drift 2s"}},
+	} {
+		assert.Equal(t, tc.status, m.rate(&tc.health), "rate should return the worst status")
+		assert.Equal(t, tc.reasons, tc.health.Reasons, "rate should set the reasons")
+	}
+}
+
+func TestExchangeHealthCheck(t *testing.T) {
+	t.Parallel()
+	comms := &testCommsManager{}
+	rest := newRESTHealthRecorder()
+	syncer := &healthSyncer{}
+	m, err := SetupExchangeHealthManager(NewExchangeManager(), comms, syncer, rest, &testHealthConfig)
+	require.NoError(t, err, "SetupExchangeHealthManager must not error")
+	m.started.Store(true)
+	exch := &healthExchange{}
+
+	tn := time.Now()
+	exch.serverTime = tn
+	syncer.updated = tn
+	m.check(t.Context(), exch, tn)
+	h, err := m.GetHealth("HEALTHY")
+	require.NoError(t, err, "GetHealth must not error")
+	require.Len(t, h, 1, "GetHealth must return the exchange health")
+	assert.Equal(t, HealthHealthy, h[0].Status, "check should rate a new exchange healthy")
+	assert.Empty(t, comms.events, "check should not notify a healthy exchange")
+
+	for range 10 {
+		rest.RequestError("healthy", "GET", "/", errors.New("timeout"))
+	}
+	exch.serverTime = time.Now().Add(5 * time.Second)
+	m.check(t.Context(), exch, tn.Add(time.Minute))
+	h, err = m.GetHealth("")
+	require.NoError(t, err, "GetHealth must not error")
+	require.Len(t, h, 1, "GetHealth must return all exchange health")
+	assert.Equal(t, HealthDown, h[0].Status, "check should rate failing REST requests as down")
+	assert.Equal(t, 10, h[0].RESTErrors, "check should record the REST errors")
+	assert.Equal(t, tn.Add(time.Minute), h[0].Since, "check should set when the status changed")
+	assert.Greater(t, h[0].TimeDrift, 4*time.Second, "check should record the server time drift")
+	assert.Equal(t, time.Minute, h[0].OrderbookStaleness, "check should record the orderbook staleness")
+	require.Len(t, comms.events, 1, "check must notify an incident")
+	assert.Equal(t, base.ExchangeIncidentEventType, comms.events[0].Type, "check should notify an incident")
+	assert.Equal(t, base.SeverityCritical, comms.events[0].Severity, "check should notify a down exchange as critical")
+	incident, ok := comms.events[0].Data.(*base.ExchangeHealth)
+	require.True(t, ok, "event data must be exchange health")
+	assert.Equal(t, "healthy", incident.Previous, "check should notify the previous status")
+
+	for range 10 {
+		rest.RequestError("healthy", "GET", "/", errors.New("timeout"))
+	}
+	m.check(t.Context(), exch, tn.Add(90*time.Second))
+	assert.Len(t, comms.events, 1, "check should not notify an unchanged incident")
+
+	m.check(t.Context(), exch, tn.Add(2*time.Minute))
+	require.Len(t, comms.events, 2, "check must notify a changed incident")
+	assert.Equal(t, base.SeverityWarning, comms.events[1].Severity, "check should notify a degraded exchange as a warning")
+	assert.Contains(t, comms.events[1].Message, "Exchange healthy is degraded: orderbook stale for 2m0s, server time drift", "check should notify the incident reasons")
+
+	exch.serverTime, syncer.updated = time.Time{}, time.Time{}
+	exch.serverTimeErr = common.ErrNotYetImplemented
+	m.check(t.Context(), exch, tn.Add(3*time.Minute))
+	h, err = m.GetHealth("healthy")
+	require.NoError(t, err, "GetHealth must not error")
+	assert.Equal(t, HealthDegraded, h[0].Status, "check should hold the status until the exchange recovers")
+	assert.Equal(t, []string{"recovering, 1 of 2 healthy checks"}, h[0].Reasons, "check should report the recovery progress")
+	assert.Len(t, comms.events, 2, "check should not notify a recovery in progress")
+
+	m.check(t.Context(), exch, tn.Add(4*time.Minute))
+	h, err = m.GetHealth("healthy")
+	require.NoError(t, err, "GetHealth must not error")
+	assert.Equal(t, HealthHealthy, h[0].Status, "check should recover after the recovery checks")
+	require.Len(t, comms.events, 3, "check must notify the recovery")
+	assert.Equal(t, base.ExchangeRecoveredEventType, comms.events[2].Type, "check should notify the recovery")
+	assert.Equal(t, "Exchange healthy recovered from degraded", comms.events[2].Message, "check should notify the recovered status")
+	assert.Equal(t, 5, exch.timeRequests, "check should stop requesting unsupported server time")
+
+	_, err = m.GetHealth("unknown")
+	assert.ErrorIs(t, err, errExchangeHealthNotFound)
+	m.started.Store(false)
+	_, err = m.GetHealth("")
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
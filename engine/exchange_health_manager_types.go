@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// ExchangeHealthManagerName is an exported subsystem name
+const ExchangeHealthManagerName = "exchange_health_manager"
+
+// Exchange health statuses, ordered from best to worst
+const (
+	HealthUnknown ExchangeHealthStatus = iota
+	HealthHealthy
+	HealthDegraded
+	HealthDown
+)
+
+// ExchangeHealthStatus is the rated health of an exchange
+type ExchangeHealthStatus uint8
+
+// ExchangeHealth is the health of an exchange as of its last check
+type ExchangeHealth struct {
+	Exchange string
+	Status   ExchangeHealthStatus
+	Reasons  []string
+	// Since is when the exchange entered its current status
+	Since              time.Time
+	LastChecked        time.Time
+	RESTRequests       int
+	RESTErrors         int
+	RESTErrorRate      float64
+	AverageLatency     time.Duration
+	WebsocketEnabled   bool
+	WebsocketConnected bool
+	// WebsocketConnecting is set while a disconnected websocket reconnects
+	WebsocketConnecting bool
+	// OrderbookStaleness is the age of the least recently synced orderbook,
+	// zero when orderbooks are not synced
+	OrderbookStaleness time.Duration
+	// TimeDrift is the exchange server time less the local time, zero when
+	// the exchange does not support server time requests
+	TimeDrift time.Duration
+}
+
+// ExchangeHealthManager rates the health of each exchange from its REST
+// request outcomes, websocket connection state, orderbook staleness and server
+// time drift, notifying incidents and recoveries
+type ExchangeHealthManager struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager iExchangeManager
+	comms           iCommsManager
+	syncer          iOrderbookSyncer
+	rest            *restHealthRecorder
+	cfg             config.ExchangeHealthConfig
+
+	mu     sync.RWMutex
+	health map[string]*exchangeHealthState
+}
+
+// iOrderbookSyncer limits exposure of the sync manager to the orderbook update
+// times needed to rate orderbook staleness
+type iOrderbookSyncer interface {
+	OldestOrderbookUpdate(exchangeName string) (time.Time, bool)
+}
+
+// exchangeHealthState is the tracked health of an exchange
+type exchangeHealthState struct {
+	ExchangeHealth
+	notified              ExchangeHealthStatus
+	healthyChecks         int
+	serverTimeUnsupported bool
+}
+
+// restHealthRecorder implements request.Reporter and request.ErrorReporter to
+// collect each exchange's REST request outcomes between health checks
+type restHealthRecorder struct {
+	mu    sync.Mutex
+	stats map[string]*restHealthStats
+}
+
+// restHealthStats are the REST request outcomes of an exchange
+type restHealthStats struct {
+	requests  int
+	errors    int
+	latencies int
+	latency   time.Duration
+}
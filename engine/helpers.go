@@ -89,6 +89,14 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		ExchangeHealthManagerName:     bot.exchangeHealthManager.IsRunning(),
+		CarryMonitorName:              bot.carryMonitor.IsRunning(),
+		MarginMonitorName:             bot.marginMonitor.IsRunning(),
+		RebalancerName:                bot.rebalancer.IsRunning(),
+		WithdrawalReconcilerName:      bot.withdrawalReconciler.IsRunning(),
+		WithdrawalPolicyManagerName:   bot.withdrawalPolicyManager.IsRunning(),
+		PortfolioValuerName:           bot.portfolioValuer.IsRunning(),
+		TradeLedgerName:               bot.tradeLedger.IsRunning(),
 	}
 }
 
@@ -281,6 +289,132 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.currencyStateManager.Start(runtimeCtx)
 		}
 		return bot.currencyStateManager.Stop()
+	case ExchangeHealthManagerName:
+		if enable {
+			if bot.exchangeHealthManager == nil {
+				bot.exchangeHealthManager, err = SetupExchangeHealthManager(
+					bot.ExchangeManager,
+					bot.CommunicationsManager,
+					bot.currencyPairSyncer,
+					bot.exchangeHealthRecorder,
+					&bot.Config.ExchangeHealth)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.exchangeHealthManager.Start(runtimeCtx)
+		}
+		return bot.exchangeHealthManager.Stop()
+	case CarryMonitorName:
+		if enable {
+			if bot.carryMonitor == nil {
+				bot.carryMonitor, err = SetupCarryMonitor(
+					bot.ExchangeManager,
+					bot.CommunicationsManager,
+					bot.DatabaseManager,
+					&bot.Config.CarryMonitor)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.carryMonitor.Start(runtimeCtx)
+		}
+		return bot.carryMonitor.Stop()
+	case MarginMonitorName:
+		if enable {
+			if bot.marginMonitor == nil {
+				bot.marginMonitor, err = SetupMarginMonitor(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					&bot.Config.MarginMonitor)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.marginMonitor.Start(runtimeCtx)
+		}
+		return bot.marginMonitor.Stop()
+	case RebalancerName:
+		if enable {
+			if bot.rebalancer == nil {
+				bot.rebalancer, err = SetupRebalancer(
+					bot.ExchangeManager,
+					bot.portfolioManager,
+					bot.WithdrawManager,
+					bot.CommunicationsManager,
+					&bot.Config.Rebalancer)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.rebalancer.Start(runtimeCtx)
+		}
+		return bot.rebalancer.Stop()
+	case WithdrawalReconcilerName:
+		if enable {
+			if bot.withdrawalReconciler == nil {
+				bot.withdrawalReconciler, err = SetupWithdrawalReconciler(
+					bot.ExchangeManager,
+					bot.CommunicationsManager,
+					bot.DatabaseManager,
+					&bot.Config.WithdrawalReconciler)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.withdrawalReconciler.Start(runtimeCtx)
+		}
+		return bot.withdrawalReconciler.Stop()
+	case WithdrawalPolicyManagerName:
+		if enable {
+			if bot.withdrawalPolicyManager == nil {
+				bot.withdrawalPolicyManager, err = SetupWithdrawalPolicyManager(
+					bot.WithdrawManager,
+					bot.portfolioManager,
+					bot.CommunicationsManager,
+					&bot.Config.WithdrawalPolicy)
+				if err != nil {
+					return err
+				}
+				if err = bot.WithdrawManager.SetPolicy(bot.withdrawalPolicyManager); err != nil {
+					return err
+				}
+			}
+			return bot.withdrawalPolicyManager.Start(runtimeCtx)
+		}
+		return bot.withdrawalPolicyManager.Stop()
+	case PortfolioValuerName:
+		if enable {
+			if bot.portfolioValuer == nil {
+				bot.portfolioValuer, err = SetupPortfolioValuer(
+					bot.ExchangeManager,
+					bot.portfolioManager,
+					bot.DatabaseManager,
+					&bot.Config.PortfolioValuation)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.portfolioValuer.Start(runtimeCtx)
+		}
+		return bot.portfolioValuer.Stop()
+	case TradeLedgerName:
+		if enable {
+			if bot.tradeLedger == nil {
+				bot.tradeLedger, err = SetupTradeLedger(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.WebsocketRoutineManager,
+					bot.DatabaseManager,
+					&bot.Config.TradeLedger)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.tradeLedger.Start(runtimeCtx)
+		}
+		return bot.tradeLedger.Stop()
 	}
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
@@ -338,6 +472,12 @@ func (bot *Engine) GetAuthAPISupportedExchanges() []string {
 	return exchangeNames
 }
 
+// exchangeAccounts returns the accounts of an exchange to process, the default
+// credentials as "" followed by every credential profile
+func exchangeAccounts(exch exchange.IBotExchange) []string {
+	return append([]string{""}, exch.GetCredentialProfiles()...)
+}
+
 // IsOnline returns whether or not the engine has Internet connectivity
 func (bot *Engine) IsOnline() bool {
 	return bot.connectionManager.IsOnline()
@@ -100,7 +100,7 @@ func CreateTestBot(tb testing.TB) *Engine {
 }
 
 func TestGetSubsystemsStatus(t *testing.T) {
-	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 13, "GetSubsystemStatus should return the correct number of subsystems")
+	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 21, "GetSubsystemStatus should return the correct number of subsystems")
 }
 
 func TestGetRPCEndpoints(t *testing.T) {
@@ -188,6 +188,54 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    ExchangeHealthManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidHealthCheckInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    CarryMonitorName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidCarryCheckInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MarginMonitorName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidMarginCheckInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    RebalancerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidRebalanceCheckInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    WithdrawalReconcilerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidWithdrawalCheckInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    WithdrawalPolicyManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidWithdrawalPolicyInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    PortfolioValuerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidValuationInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    TradeLedgerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidTradeLedgerInterval,
+			DisableError: ErrNilSubsystem,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -304,6 +352,12 @@ func TestGetAuthAPISupportedExchanges(t *testing.T) {
 	}
 }
 
+func TestExchangeAccounts(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{""}, exchangeAccounts(&ledgerExchange{}), "the default credentials should be returned without profiles")
+	assert.Equal(t, []string{"", "hedge", "main"}, exchangeAccounts(&ledgerExchange{profiles: []string{"hedge", "main"}}), "the default credentials should be returned before every profile")
+}
+
 func TestIsOnline(t *testing.T) {
 	t.Parallel()
 	e := CreateTestBot(t)
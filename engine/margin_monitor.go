@@ -0,0 +1,537 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errInvalidMarginCheckInterval = errors.New("margin monitor check interval must be positive")
+	errNoMarginLevels             = errors.New("margin monitor has no margin levels configured")
+	errNoMarginPrice              = errors.New("no mid, mark or latest price available")
+)
+
+// SetupMarginMonitor applies configuration parameters before running
+func SetupMarginMonitor(em iExchangeManager, om iMarginOrderManager, comms iCommsManager, cfg *config.MarginMonitorConfig) (*MarginMonitor, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w MarginMonitor", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidMarginCheckInterval
+	}
+	if len(cfg.Levels) == 0 {
+		return nil, errNoMarginLevels
+	}
+	return &MarginMonitor{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		orderManager:    om,
+		comms:           comms,
+		cfg:             *cfg,
+		positions:       make(map[marginKey]*marginState),
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *MarginMonitor) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarginMonitorName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", MarginMonitorName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Margin monitor %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Margin monitor %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *MarginMonitor) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarginMonitorName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", MarginMonitorName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Margin monitor %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Margin monitor %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MarginMonitor) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetMarginHealth returns the latest margin health of each open position,
+// optionally filtered by exchange, ordered by the least healthy first
+func (m *MarginMonitor) GetMarginHealth(exchangeName string) ([]PositionMarginHealth, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", MarginMonitorName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	states := make([]*marginState, 0, len(m.positions))
+	for _, s := range m.positions {
+		if exchangeName != "" && !strings.EqualFold(s.Exchange, exchangeName) {
+			continue
+		}
+		states = append(states, s)
+	}
+	slices.SortFunc(states, func(a, b *marginState) int {
+		if a.level != b.level {
+			return b.level - a.level
+		}
+		if c := strings.Compare(a.Exchange, b.Exchange); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Pair.String(), b.Pair.String())
+	})
+	resp := make([]PositionMarginHealth, len(states))
+	for i := range states {
+		resp[i] = states[i].PositionMarginHealth
+		resp[i].Reasons = slices.Clone(states[i].Reasons)
+	}
+	return resp, nil
+}
+
+func (m *MarginMonitor) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll assesses each open position concurrently, then rates them against
+// the margin levels
+func (m *MarginMonitor) checkAll(ctx context.Context) {
+	positions, err := m.orderManager.GetAllOpenFuturesPositions()
+	if err != nil && !errors.Is(err, futures.ErrNoPositionsFound) && !errors.Is(err, errFuturesTrackingDisabled) {
+		log.Errorf(log.ExchangeSys, "Margin monitor failed to get open futures positions: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.CheckInterval)
+	defer cancel()
+	if now := time.Now(); now.Sub(m.reportedAt) >= m.cfg.PositionRefreshInterval {
+		m.reported = m.exchangePositions(ctx)
+		m.reportedAt = now
+	}
+	positions = mergeMarginPositions(positions, m.reported)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		healths []PositionMarginHealth
+	)
+	now := time.Now()
+	for i := range positions {
+		p := &positions[i]
+		if p.LatestSize.IsZero() {
+			continue
+		}
+		exch, err := m.exchangeManager.GetExchangeByName(p.Exchange)
+		if err != nil {
+			m.logVerbose("Margin monitor %s position skipped: %v", p.Exchange, err)
+			continue
+		}
+		wg.Go(func() {
+			h, err := m.assess(ctx, exch, p, now)
+			if err != nil {
+				m.logVerbose("Margin monitor %s %s %s position assessment failed: %v", p.Exchange, p.Asset, p.Pair, err)
+				return
+			}
+			mu.Lock()
+			healths = append(healths, h)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	m.update(ctx, healths)
+}
+
+// exchangePositions returns the open positions reported by each
+// authenticated exchange account for its enabled futures pairs, so positions
+// the order manager is not tracking are still monitored. The positions are
+// rebuilt from a limited order history, so only the position summary size is
+// used once assessed
+func (m *MarginMonitor) exchangePositions(ctx context.Context) []futures.Position {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Margin monitor failed to get exchanges: %v", err)
+		return nil
+	}
+	var positions []futures.Position
+	for _, exch := range exchs {
+		if !exch.IsRESTAuthenticationSupported() {
+			continue
+		}
+		for _, a := range exch.GetAssetTypes(true) {
+			if !a.IsFutures() {
+				continue
+			}
+			pairs, err := exch.GetEnabledPairs(a)
+			if err != nil || len(pairs) == 0 {
+				continue
+			}
+			for _, account := range exchangeAccounts(exch) {
+				details, err := exch.GetFuturesPositions(accounts.DeployAccountToContext(ctx, account), &futures.PositionsRequest{
+					Asset:                     a,
+					Pairs:                     pairs,
+					StartDate:                 time.Now().Add(-marginPositionSeekDuration),
+					RespectOrderHistoryLimits: true,
+				})
+				if err != nil {
+					if !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+						m.logVerbose("Margin monitor %s %s account %q positions unavailable: %v", exch.GetName(), a, account, err)
+					}
+					continue
+				}
+				open, err := openPositions(exch.GetName(), account, details)
+				if err != nil {
+					m.logVerbose("Margin monitor %s %s account %q positions skipped: %v", exch.GetName(), a, account, err)
+					continue
+				}
+				positions = append(positions, open...)
+			}
+		}
+	}
+	return positions
+}
+
+// openPositions rebuilds the open positions of an account from the orders of
+// its exchange position details
+func openPositions(exchName, account string, details []futures.PositionDetails) ([]futures.Position, error) {
+	pc := futures.SetupPositionController()
+	for i := range details {
+		slices.SortFunc(details[i].Orders, func(a, b order.Detail) int {
+			return a.Date.Compare(b.Date)
+		})
+		for j := range details[i].Orders {
+			od := &details[i].Orders[j]
+			if od.Exchange == "" {
+				od.Exchange = exchName
+			}
+			od.Account = account
+			if err := pc.TrackNewOrder(od); err != nil {
+				return nil, err
+			}
+		}
+	}
+	open, err := pc.GetAllOpenPositions()
+	if err != nil {
+		if errors.Is(err, futures.ErrNoPositionsFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range open {
+		open[i].Account = account
+	}
+	return open, nil
+}
+
+// mergeMarginPositions appends the exchange reported positions not already
+// tracked by the order manager
+func mergeMarginPositions(tracked, reported []futures.Position) []futures.Position {
+	seen := make(map[marginKey]struct{}, len(tracked))
+	for i := range tracked {
+		seen[positionMarginKey(&tracked[i])] = struct{}{}
+	}
+	for i := range reported {
+		k := positionMarginKey(&reported[i])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		tracked = append(tracked, reported[i])
+	}
+	return tracked
+}
+
+func positionMarginKey(p *futures.Position) marginKey {
+	return marginKey{
+		exchange: strings.ToLower(p.Exchange),
+		account:  p.Account,
+		asset:    p.Asset,
+		pair:     p.Pair.String(),
+	}
+}
+
+// assess returns the liquidation distance and margin ratio of a position from
+// the exchange position summary and current orderbook mid price, preferring
+// the summary size over the tracked position size
+func (m *MarginMonitor) assess(ctx context.Context, exch exchange.IBotExchange, p *futures.Position, now time.Time) (PositionMarginHealth, error) {
+	h := PositionMarginHealth{
+		Exchange: exch.GetName(),
+		Account:  p.Account,
+		Asset:    p.Asset,
+		Pair:     p.Pair,
+		Side:     p.LatestDirection,
+		Size:     p.LatestSize.Abs().InexactFloat64(),
+		Time:     now,
+	}
+	summary, err := exch.GetFuturesPositionSummary(accounts.DeployAccountToContext(ctx, p.Account), &futures.PositionSummaryRequest{
+		Asset: p.Asset,
+		Pair:  p.Pair,
+	})
+	if err != nil && !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+		return h, err
+	}
+	if summary == nil {
+		summary = &futures.PositionSummary{}
+	}
+	h.MarginType = summary.MarginType
+	if !summary.CurrentSize.IsZero() {
+		h.Size = summary.CurrentSize.Abs().InexactFloat64()
+	}
+	h.LiquidationPrice = summary.EstimatedLiquidationPrice.InexactFloat64()
+	h.MaintenanceMarginRequirement = summary.MaintenanceMarginRequirement.InexactFloat64()
+	h.MarginBalance = marginBalance(summary).InexactFloat64()
+	h.UnrealisedPNL = summary.UnrealisedPNL.InexactFloat64()
+	if h.UnrealisedPNL == 0 {
+		h.UnrealisedPNL = p.UnrealisedPNL.InexactFloat64()
+	}
+	if h.MarginBalance > 0 {
+		h.MarginRatio = h.MaintenanceMarginRequirement / h.MarginBalance
+	}
+
+	if depth, err := orderbook.GetDepth(h.Exchange, p.Pair, p.Asset); err == nil {
+		h.MidPrice, _ = depth.GetMidPrice()
+	}
+	if h.MidPrice <= 0 {
+		h.MidPrice = summary.MarkPrice.InexactFloat64()
+	}
+	if h.MidPrice <= 0 {
+		h.MidPrice = p.LatestPrice.InexactFloat64()
+	}
+	if h.MidPrice <= 0 {
+		return h, errNoMarginPrice
+	}
+	if h.LiquidationPrice > 0 {
+		if h.Side.IsShort() {
+			h.LiquidationDistance = (h.LiquidationPrice - h.MidPrice) / h.MidPrice
+		} else {
+			h.LiquidationDistance = (h.MidPrice - h.LiquidationPrice) / h.MidPrice
+		}
+	}
+	return h, nil
+}
+
+// marginBalance returns the margin backing a position, preferring the margin
+// balance over isolated equity and total collateral
+func marginBalance(s *futures.PositionSummary) decimal.Decimal {
+	switch {
+	case s.MarginBalance.IsPositive():
+		return s.MarginBalance
+	case s.IsolatedEquity.IsPositive():
+		return s.IsolatedEquity
+	default:
+		return s.TotalCollateral
+	}
+}
+
+// rate returns the index of the most severe margin level a position has
+// crossed and the reasons it crossed it
+func (m *MarginMonitor) rate(h *PositionMarginHealth) (int, []string) {
+	for i := len(m.cfg.Levels) - 1; i >= 0; i-- {
+		l := &m.cfg.Levels[i]
+		var reasons []string
+		if l.MarginRatio > 0 && h.MarginRatio >= l.MarginRatio {
+			reasons = append(reasons, fmt.Sprintf("margin ratio %.2f%%", h.MarginRatio*100))
+		}
+		if l.LiquidationDistance > 0 && h.LiquidationPrice > 0 && h.LiquidationDistance <= l.LiquidationDistance {
+			reasons = append(reasons, fmt.Sprintf("%.2f%% from liquidation price %v", h.LiquidationDistance*100, h.LiquidationPrice))
+		}
+		if len(reasons) > 0 {
+			return i, reasons
+		}
+	}
+	return marginHealthy, nil
+}
+
+// update stores the assessed positions, removing closed positions, and
+// notifies and de-risks positions entering a more severe margin level. De-risking
+// orders are submitted once the lock is released
+func (m *MarginMonitor) update(ctx context.Context, healths []PositionMarginHealth) {
+	var actions []marginDeRisk
+	m.mu.Lock()
+	seen := make(map[marginKey]struct{}, len(healths))
+	for i := range healths {
+		h := &healths[i]
+		k := marginKey{
+			exchange: strings.ToLower(h.Exchange),
+			account:  h.Account,
+			asset:    h.Asset,
+			pair:     h.Pair.String(),
+		}
+		seen[k] = struct{}{}
+		level, reasons := m.rate(h)
+		if level != marginHealthy {
+			h.Level = m.cfg.Levels[level].Name
+			h.Reasons = reasons
+		}
+		s, ok := m.positions[k]
+		if !ok {
+			s = &marginState{level: marginHealthy, deRisked: marginHealthy}
+			m.positions[k] = s
+		}
+		previous := s.level
+		h.Reduced = s.Reduced
+		s.PositionMarginHealth = *h
+		s.level = level
+		switch {
+		case level == marginHealthy && previous != marginHealthy:
+			s.deRisked = marginHealthy
+			s.Reduced = 0
+			m.notify(&base.MarginHealth{
+				Exchange:  h.Exchange,
+				Account:   h.Account,
+				Asset:     h.Asset.String(),
+				Pair:      h.Pair.String(),
+				Side:      h.Side.String(),
+				Previous:  m.cfg.Levels[previous].Name,
+				Recovered: true,
+			}, base.SeverityInfo)
+		case level > previous:
+			p := &base.MarginHealth{
+				Exchange: h.Exchange,
+				Account:  h.Account,
+				Asset:    h.Asset.String(),
+				Pair:     h.Pair.String(),
+				Side:     h.Side.String(),
+				Level:    h.Level,
+				Reasons:  reasons,
+			}
+			if previous != marginHealthy {
+				p.Previous = m.cfg.Levels[previous].Name
+			}
+			severity := base.SeverityWarning
+			if level > 0 {
+				severity = base.SeverityCritical
+			}
+			m.notify(p, severity)
+		}
+		// deRisked is only raised once a de-risking order is submitted, so a
+		// failed order is retried on the next check
+		if m.cfg.AutoDeRisk && level != marginHealthy && level > s.deRisked && m.cfg.Levels[level].ReduceFraction > 0 {
+			actions = append(actions, marginDeRisk{key: k, health: s.PositionMarginHealth, level: level})
+		}
+		m.logVerbose("Margin monitor %s %s %s %s position mid price %v liquidation price %v distance %.4f margin ratio %.4f level %q",
+			h.Exchange, h.Asset, h.Pair, h.Side, h.MidPrice, h.LiquidationPrice, h.LiquidationDistance, h.MarginRatio, h.Level)
+	}
+	for k := range m.positions {
+		if _, ok := seen[k]; !ok {
+			delete(m.positions, k)
+		}
+	}
+	m.mu.Unlock()
+	for i := range actions {
+		m.deRisk(ctx, &actions[i])
+	}
+}
+
+// deRisk reduces a position by the reduce fraction of its current level with
+// a reduce only market order, marking the level as de-risked once submitted
+func (m *MarginMonitor) deRisk(ctx context.Context, d *marginDeRisk) {
+	s := &d.health
+	l := &m.cfg.Levels[d.level]
+	amount := s.Size * l.ReduceFraction
+	side := order.Sell
+	if s.Side.IsShort() {
+		side = order.Buy
+	}
+	p := &base.MarginDeRisk{
+		Exchange: s.Exchange,
+		Account:  s.Account,
+		Asset:    s.Asset.String(),
+		Pair:     s.Pair.String(),
+		Side:     s.Side.String(),
+		Level:    l.Name,
+		Amount:   amount,
+	}
+	resp, err := m.orderManager.Submit(accounts.DeployAccountToContext(ctx, s.Account), &order.Submit{
+		Exchange:   s.Exchange,
+		Type:       order.Market,
+		Side:       side,
+		Pair:       s.Pair,
+		AssetType:  s.Asset,
+		Amount:     amount,
+		ReduceOnly: true,
+		MarginType: s.MarginType,
+	})
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Margin monitor failed to reduce %s %s %s position by %v: %v", s.Exchange, s.Asset, s.Pair, amount, err)
+		p.Error = err.Error()
+		m.notify(p, base.SeverityCritical)
+		return
+	}
+	m.mu.Lock()
+	if state, ok := m.positions[d.key]; ok {
+		state.Reduced += amount
+		state.deRisked = max(state.deRisked, d.level)
+	}
+	m.mu.Unlock()
+	if resp != nil && resp.Detail != nil {
+		p.OrderID = resp.OrderID
+	}
+	m.notify(p, base.SeverityWarning)
+}
+
+func (m *MarginMonitor) notify(p base.Payload, severity base.Severity) {
+	evt := base.NewEvent(p, severity)
+	switch v := p.(type) {
+	case *base.MarginHealth:
+		evt.Key = evt.Type + "|" + v.Exchange + "|" + v.Account + "|" + v.Asset + "|" + v.Pair + "|" + v.Level
+	case *base.MarginDeRisk:
+		evt.Key = evt.Type + "|" + v.Exchange + "|" + v.Account + "|" + v.Asset + "|" + v.Pair + "|" + v.Level
+	}
+	m.comms.PushEvent(evt)
+}
+
+func (m *MarginMonitor) logVerbose(format string, args ...any) {
+	if m.cfg.Verbose {
+		log.Debugf(log.ExchangeSys, format, args...)
+	}
+}
@@ -0,0 +1,402 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	marginBTC = currency.NewBTCUSDT()
+	marginETH = currency.NewPair(currency.ETH, currency.USDT)
+)
+
+var testMarginConfig = config.MarginMonitorConfig{
+	CheckInterval: time.Minute,
+	AutoDeRisk:    true,
+	Levels: []config.MarginLevel{
+		{Name: "warning", MarginRatio: 0.5, LiquidationDistance: 0.1},
+		{Name: "critical", MarginRatio: 0.8, LiquidationDistance: 0.05, ReduceFraction: 0.25},
+	},
+}
+
+type marginExchange struct {
+	exchange.IBotExchange
+	summaries map[string]*futures.PositionSummary
+	positions map[string][]futures.PositionDetails
+	// positionRequests counts the exchange position requests of each account
+	positionRequests int
+	mu               sync.Mutex
+	accounts         []string
+}
+
+func (e *marginExchange) GetName() string { return "margin" }
+
+func (e *marginExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (e *marginExchange) GetCredentialProfiles() []string { return []string{"sub"} }
+
+func (e *marginExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot, asset.USDTMarginedFutures}
+}
+
+func (e *marginExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{marginBTC, marginETH}, nil
+}
+
+func (e *marginExchange) GetFuturesPositions(ctx context.Context, _ *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	if e.positions == nil {
+		return nil, common.ErrFunctionNotSupported
+	}
+	e.positionRequests++
+	return e.positions[accounts.AccountFromContext(ctx)], nil
+}
+
+func (e *marginExchange) GetFuturesPositionSummary(ctx context.Context, r *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	e.mu.Lock()
+	e.accounts = append(e.accounts, accounts.AccountFromContext(ctx))
+	e.mu.Unlock()
+	s, ok := e.summaries[r.Pair.String()]
+	if !ok {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return s, nil
+}
+
+type marginExchangeManager struct {
+	exch exchange.IBotExchange
+}
+
+func (m *marginExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	return []exchange.IBotExchange{m.exch}, nil
+}
+
+func (m *marginExchangeManager) GetExchangeByName(name string) (exchange.IBotExchange, error) {
+	if name != m.exch.GetName() {
+		return nil, ErrExchangeNotFound
+	}
+	return m.exch, nil
+}
+
+type marginOrderManager struct {
+	positions []futures.Position
+	err       error
+	submitted []*order.Submit
+	submitErr error
+	onSubmit  func()
+}
+
+func (m *marginOrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error) {
+	return m.positions, m.err
+}
+
+func (m *marginOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	if m.onSubmit != nil {
+		m.onSubmit()
+	}
+	if m.submitErr != nil {
+		return nil, m.submitErr
+	}
+	m.submitted = append(m.submitted, s)
+	return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "1337"}}, nil
+}
+
+func TestSetupMarginMonitor(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMarginMonitor(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupMarginMonitor(&ExchangeManager{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+	_, err = SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{}, &testCommsManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{}, &testCommsManager{}, &config.MarginMonitorConfig{})
+	assert.ErrorIs(t, err, errInvalidMarginCheckInterval)
+	_, err = SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{}, &testCommsManager{}, &config.MarginMonitorConfig{CheckInterval: time.Minute})
+	assert.ErrorIs(t, err, errNoMarginLevels)
+	m, err := SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{}, &testCommsManager{}, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	assert.NotNil(t, m.positions, "SetupMarginMonitor should initialise the positions map")
+}
+
+func TestMarginMonitorStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MarginMonitor
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil monitor")
+
+	m, err := SetupMarginMonitor(&ExchangeManager{}, &marginOrderManager{err: errFuturesTrackingDisabled}, &testCommsManager{}, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetMarginHealth("")
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestMarginMonitorAssess(t *testing.T) {
+	t.Parallel()
+	exch := &marginExchange{summaries: map[string]*futures.PositionSummary{
+		marginBTC.String(): {
+			EstimatedLiquidationPrice:    decimal.NewFromInt(38000),
+			MaintenanceMarginRequirement: decimal.NewFromInt(300),
+			IsolatedEquity:               decimal.NewFromInt(1000),
+			MarkPrice:                    decimal.NewFromInt(40000),
+		},
+	}}
+	m := &MarginMonitor{cfg: testMarginConfig}
+	now := time.Now()
+
+	h, err := m.assess(t.Context(), exch, &futures.Position{
+		Account:         "main",
+		Asset:           asset.USDTMarginedFutures,
+		Pair:            marginBTC,
+		LatestDirection: order.Long,
+		LatestSize:      decimal.NewFromInt(-2),
+	}, now)
+	require.NoError(t, err, "assess must not error")
+	assert.Equal(t, []string{"main"}, exch.accounts, "assess should request the summary for the position account")
+	assert.Equal(t, 2.0, h.Size, "assess should use the absolute position size")
+	assert.Equal(t, 40000.0, h.MidPrice, "assess should fall back to the mark price")
+	assert.InDelta(t, 0.05, h.LiquidationDistance, 1e-9, "assess should return the long liquidation distance")
+	assert.InDelta(t, 0.3, h.MarginRatio, 1e-9, "assess should use the isolated equity as the margin balance")
+
+	exch.summaries[marginBTC.String()].CurrentSize = decimal.NewFromInt(-5)
+	h, err = m.assess(t.Context(), exch, &futures.Position{Asset: asset.USDTMarginedFutures, Pair: marginBTC, LatestDirection: order.Long, LatestSize: decimal.NewFromInt(2)}, now)
+	require.NoError(t, err, "assess must not error")
+	assert.Equal(t, 5.0, h.Size, "assess should prefer the position summary size")
+	exch.summaries[marginBTC.String()].CurrentSize = decimal.Zero
+
+	exch.summaries[marginBTC.String()].EstimatedLiquidationPrice = decimal.NewFromInt(42000)
+	h, err = m.assess(t.Context(), exch, &futures.Position{Asset: asset.USDTMarginedFutures, Pair: marginBTC, LatestDirection: order.Short, LatestSize: decimal.NewFromInt(1)}, now)
+	require.NoError(t, err, "assess must not error")
+	assert.InDelta(t, 0.05, h.LiquidationDistance, 1e-9, "assess should return the short liquidation distance")
+
+	h, err = m.assess(t.Context(), exch, &futures.Position{Asset: asset.USDTMarginedFutures, Pair: marginETH, LatestSize: decimal.NewFromInt(1), LatestPrice: decimal.NewFromInt(2000)}, now)
+	require.NoError(t, err, "assess must not error when the summary is unsupported")
+	assert.Equal(t, 2000.0, h.MidPrice, "assess should fall back to the latest position price")
+	assert.Zero(t, h.LiquidationDistance, "assess should not set a liquidation distance without a liquidation price")
+
+	_, err = m.assess(t.Context(), exch, &futures.Position{Asset: asset.USDTMarginedFutures, Pair: marginETH, LatestSize: decimal.NewFromInt(1)}, now)
+	assert.ErrorIs(t, err, errNoMarginPrice)
+}
+
+func TestMarginMonitorRate(t *testing.T) {
+	t.Parallel()
+	m := &MarginMonitor{cfg: testMarginConfig}
+	for _, tc := range []struct {
+		health  PositionMarginHealth
+		level   int
+		reasons int
+	}{
+		{health: PositionMarginHealth{MarginRatio: 0.1, LiquidationPrice: 30000, LiquidationDistance: 0.25}, level: marginHealthy},
+		{health: PositionMarginHealth{MarginRatio: 0.6}, level: 0, reasons: 1},
+		{health: PositionMarginHealth{MarginRatio: 0.6, LiquidationPrice: 38000, LiquidationDistance: 0.05}, level: 1, reasons: 1},
+		{health: PositionMarginHealth{MarginRatio: 0.9, LiquidationPrice: 38000, LiquidationDistance: 0.01}, level: 1, reasons: 2},
+		{health: PositionMarginHealth{LiquidationDistance: 0.01}, level: marginHealthy},
+	} {
+		level, reasons := m.rate(&tc.health)
+		assert.Equal(t, tc.level, level, "rate should return the correct level")
+		assert.Len(t, reasons, tc.reasons, "rate should return the correct amount of reasons")
+	}
+}
+
+func TestMarginMonitorUpdate(t *testing.T) {
+	t.Parallel()
+	comms := &testCommsManager{}
+	om := &marginOrderManager{}
+	m, err := SetupMarginMonitor(&ExchangeManager{}, om, comms, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	m.started.Store(true)
+
+	long := PositionMarginHealth{Exchange: "margin", Account: "main", Asset: asset.USDTMarginedFutures, Pair: marginBTC, Side: order.Long, Size: 2, MarginRatio: 0.6}
+	m.update(t.Context(), []PositionMarginHealth{long})
+	require.Len(t, comms.events, 1, "update must notify a position entering a margin level")
+	assert.Equal(t, base.MarginAlertEventType, comms.events[0].Type)
+	assert.Equal(t, base.SeverityWarning, comms.events[0].Severity)
+	assert.Empty(t, om.submitted, "update should not de-risk a level without a reduce fraction")
+
+	m.update(t.Context(), []PositionMarginHealth{long})
+	assert.Len(t, comms.events, 1, "update should not notify a position remaining at its level")
+
+	long.MarginRatio = 0.85
+	m.update(t.Context(), []PositionMarginHealth{long})
+	require.Len(t, comms.events, 3, "update must notify the alert and de-risking")
+	assert.Equal(t, base.SeverityCritical, comms.events[1].Severity)
+	assert.Equal(t, base.MarginDeRiskEventType, comms.events[2].Type)
+	require.Len(t, om.submitted, 1, "update must submit a reduce only order")
+	s := om.submitted[0]
+	assert.True(t, s.ReduceOnly, "de-risking orders should be reduce only")
+	assert.Equal(t, order.Sell, s.Side, "de-risking a long position should sell")
+	assert.Equal(t, order.Market, s.Type)
+	assert.Equal(t, 0.5, s.Amount, "de-risking should reduce by the level reduce fraction")
+
+	health, err := m.GetMarginHealth("MARGIN")
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, health, 1)
+	assert.Equal(t, "critical", health[0].Level)
+	assert.Equal(t, 0.5, health[0].Reduced)
+
+	long.MarginRatio = 0.6
+	m.update(t.Context(), []PositionMarginHealth{long})
+	long.MarginRatio = 0.85
+	m.update(t.Context(), []PositionMarginHealth{long})
+	assert.Len(t, om.submitted, 1, "update should not de-risk a level again until the position is healthy")
+
+	long.MarginRatio = 0.1
+	m.update(t.Context(), []PositionMarginHealth{long})
+	last := comms.events[len(comms.events)-1]
+	assert.Equal(t, base.MarginRecoveredEventType, last.Type)
+	assert.Equal(t, base.SeverityInfo, last.Severity)
+
+	short := PositionMarginHealth{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: marginETH, Side: order.Short, Size: 4, LiquidationPrice: 2100, LiquidationDistance: 0.01}
+	om.submitErr = errors.New("exchange unavailable")
+	m.update(t.Context(), []PositionMarginHealth{short})
+	last = comms.events[len(comms.events)-1]
+	assert.Equal(t, base.MarginDeRiskEventType, last.Type)
+	assert.Equal(t, base.SeverityCritical, last.Severity, "a failed de-risk should be critical")
+	submitted := len(om.submitted)
+	om.submitErr = nil
+	m.update(t.Context(), []PositionMarginHealth{short})
+	require.Len(t, om.submitted, submitted+1, "update must retry a failed de-risk at the same level")
+	assert.Equal(t, order.Buy, om.submitted[submitted].Side, "de-risking a short position should buy")
+	m.update(t.Context(), []PositionMarginHealth{short})
+	assert.Len(t, om.submitted, submitted+1, "update should not de-risk a level again once submitted")
+
+	health, err = m.GetMarginHealth("")
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, health, 1, "update should remove closed positions")
+	assert.Equal(t, marginETH, health[0].Pair)
+}
+
+func TestMarginMonitorCheckAll(t *testing.T) {
+	t.Parallel()
+	exch := &marginExchange{summaries: map[string]*futures.PositionSummary{
+		marginBTC.String(): {
+			EstimatedLiquidationPrice: decimal.NewFromInt(39000),
+			MarkPrice:                 decimal.NewFromInt(40000),
+		},
+	}}
+	comms := &testCommsManager{}
+	om := &marginOrderManager{positions: []futures.Position{
+		{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: marginBTC, LatestDirection: order.Long, LatestSize: decimal.NewFromInt(1)},
+		{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: marginETH, LatestSize: decimal.Zero},
+		{Exchange: "unknown", Asset: asset.USDTMarginedFutures, Pair: marginBTC, LatestSize: decimal.NewFromInt(1)},
+	}}
+	m, err := SetupMarginMonitor(&marginExchangeManager{exch: exch}, om, comms, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	m.started.Store(true)
+	m.checkAll(t.Context())
+	health, err := m.GetMarginHealth("")
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, health, 1, "checkAll should only assess open positions on loaded exchanges")
+	assert.Equal(t, "critical", health[0].Level)
+	assert.InDelta(t, 0.025, health[0].LiquidationDistance, 1e-9)
+	assert.Len(t, om.submitted, 1, "checkAll should de-risk the critical position")
+}
+
+func TestMarginMonitorDeRiskOutsideLock(t *testing.T) {
+	t.Parallel()
+	om := &marginOrderManager{}
+	m, err := SetupMarginMonitor(&ExchangeManager{}, om, &testCommsManager{}, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	m.started.Store(true)
+	om.onSubmit = func() {
+		health, err := m.GetMarginHealth("")
+		assert.NoError(t, err, "GetMarginHealth should not error while de-risking")
+		assert.Len(t, health, 1, "GetMarginHealth should return the position being de-risked")
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.update(t.Context(), []PositionMarginHealth{{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: marginBTC, Side: order.Long, Size: 2, MarginRatio: 0.9}})
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "update must not hold the monitor lock while submitting de-risking orders")
+	}
+	require.Len(t, om.submitted, 1, "update must submit a reduce only order")
+	health, err := m.GetMarginHealth("")
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, health, 1)
+	assert.Equal(t, 0.5, health[0].Reduced, "deRisk should record the reduced amount once submitted")
+}
+
+func TestMarginMonitorExchangePositions(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	exch := &marginExchange{
+		summaries: map[string]*futures.PositionSummary{
+			marginBTC.String(): {MarkPrice: decimal.NewFromInt(40000)},
+			marginETH.String(): {EstimatedLiquidationPrice: decimal.NewFromInt(1950), MarkPrice: decimal.NewFromInt(2000)},
+		},
+		positions: map[string][]futures.PositionDetails{
+			"": {{Asset: asset.USDTMarginedFutures, Pair: marginBTC, Orders: []order.Detail{
+				{AssetType: asset.USDTMarginedFutures, Pair: marginBTC, OrderID: "1", Side: order.Long, Status: order.Filled, Price: 40000, Amount: 3, Date: now},
+			}}},
+			"sub": {{Asset: asset.USDTMarginedFutures, Pair: marginETH, Orders: []order.Detail{
+				{AssetType: asset.USDTMarginedFutures, Pair: marginETH, OrderID: "2", Side: order.Short, Status: order.Filled, Price: 2000, Amount: 4, Date: now},
+			}}},
+		},
+	}
+	om := &marginOrderManager{positions: []futures.Position{
+		{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: marginBTC, LatestDirection: order.Long, LatestSize: decimal.NewFromInt(1)},
+	}}
+	m, err := SetupMarginMonitor(&marginExchangeManager{exch: exch}, om, &testCommsManager{}, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+
+	positions := m.exchangePositions(t.Context())
+	require.Len(t, positions, 2, "exchangePositions must return the open positions of each account")
+	for i := range positions {
+		switch positions[i].Account {
+		case "":
+			assert.Equal(t, marginBTC, positions[i].Pair)
+		case "sub":
+			assert.Equal(t, marginETH, positions[i].Pair)
+			assert.Equal(t, order.Short, positions[i].LatestDirection)
+		default:
+			assert.Failf(t, "unexpected account", "exchangePositions returned account %q", positions[i].Account)
+		}
+	}
+
+	merged := mergeMarginPositions(om.positions, positions)
+	require.Len(t, merged, 2, "mergeMarginPositions must skip positions tracked by the order manager")
+	assert.True(t, merged[0].LatestSize.Equal(decimal.NewFromInt(1)), "mergeMarginPositions should prefer the order manager position")
+
+	m.started.Store(true)
+	m.cfg.PositionRefreshInterval = time.Hour
+	om.err = errFuturesTrackingDisabled
+	om.positions = nil
+	exch.positionRequests = 0
+	m.checkAll(t.Context())
+	m.checkAll(t.Context())
+	assert.Equal(t, 2, exch.positionRequests, "checkAll should request exchange positions once each refresh interval for each account")
+	health, err := m.GetMarginHealth("")
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, health, 2, "checkAll should assess exchange reported positions when the order manager is not tracking them")
+	assert.Equal(t, "sub", health[0].Account, "checkAll should rate the exchange reported position")
+	assert.Equal(t, "critical", health[0].Level)
+	require.Len(t, om.submitted, 1, "checkAll should de-risk the critical exchange reported position")
+	assert.Equal(t, order.Buy, om.submitted[0].Side, "de-risking a short position should buy")
+}
@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MarginMonitorName is an exported subsystem name
+const MarginMonitorName = "margin_monitor"
+
+// marginPositionSeekDuration is how far back exchange position orders are
+// requested when rebuilding the positions reported by an exchange
+const marginPositionSeekDuration = time.Hour * 24 * 365
+
+// marginHealthy is the level index of a position which has not crossed any
+// configured margin level
+const marginHealthy = -1
+
+// PositionMarginHealth is the margin health of an open futures position at a
+// point in time. Ratios and distances are fractions, 0.1 being 10%
+type PositionMarginHealth struct {
+	Exchange   string
+	Account    string
+	Asset      asset.Item
+	Pair       currency.Pair
+	Side       order.Side
+	Size       float64
+	MidPrice   float64
+	MarginType margin.Type
+	// LiquidationPrice is zero when the exchange does not provide it
+	LiquidationPrice float64
+	// LiquidationDistance is the distance from the mid price to the
+	// liquidation price as a fraction of the mid price
+	LiquidationDistance float64
+	// MarginRatio is the maintenance margin requirement as a fraction of the
+	// margin balance, zero when the exchange does not provide either
+	MarginRatio                  float64
+	MaintenanceMarginRequirement float64
+	MarginBalance                float64
+	UnrealisedPNL                float64
+	// Level is the name of the most severe margin level crossed, empty when
+	// healthy
+	Level   string
+	Reasons []string
+	// Reduced is the position size closed by de-risking since the position
+	// last became healthy
+	Reduced float64
+	Time    time.Time
+}
+
+// iMarginOrderManager limits exposure of accessible functions to the order
+// manager
+type iMarginOrderManager interface {
+	GetAllOpenFuturesPositions() ([]futures.Position, error)
+	Submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error)
+}
+
+// MarginMonitor continuously rates the margin health of the open futures
+// positions tracked by the order manager or reported by exchanges against configured margin levels,
+// notifying level changes and optionally de-risking positions with reduce
+// only orders
+type MarginMonitor struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager iExchangeManager
+	orderManager    iMarginOrderManager
+	comms           iCommsManager
+	cfg             config.MarginMonitorConfig
+
+	mu        sync.RWMutex
+	positions map[marginKey]*marginState
+
+	// reported caches the positions reported by exchanges, refreshed each
+	// position refresh interval
+	reported   []futures.Position
+	reportedAt time.Time
+}
+
+// marginKey identifies a monitored position
+type marginKey struct {
+	exchange string
+	account  string
+	asset    asset.Item
+	pair     string
+}
+
+// marginState is the latest margin health of a position and its level index
+type marginState struct {
+	PositionMarginHealth
+	level int
+	// deRisked is the most severe level index de-risking was attempted at
+	deRisked int
+}
+
+// marginDeRisk is a de-risking order to submit for a position entering a
+// margin level
+type marginDeRisk struct {
+	key    marginKey
+	health PositionMarginHealth
+	level  int
+}
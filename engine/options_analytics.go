@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// optionTicker returns the cached ticker of an option contract, fetching it
+// when the ticker has not been synced yet
+func optionTicker(ctx context.Context, exch exchange.IBotExchange, a asset.Item, pair currency.Pair) (*ticker.Price, error) {
+	if t, err := exch.GetCachedTicker(pair, a); err == nil {
+		return t, nil
+	}
+	return exch.UpdateTicker(ctx, pair, a)
+}
+
+// getOptionAnalytics works out the implied volatility and Greeks of an
+// option contract from its ticker. When underlyingPrice is not set the index
+// price of the ticker is used
+func getOptionAnalytics(ctx context.Context, exch exchange.IBotExchange, a asset.Item, pair currency.Pair, underlyingPrice float64, params *options.Parameters) (*options.Analytics, error) {
+	if err := common.NilGuard(exch, params); err != nil {
+		return nil, err
+	}
+	if !a.IsOptions() {
+		return nil, fmt.Errorf("%w: %v", asset.ErrNotSupported, a)
+	}
+	t, err := optionTicker(ctx, exch, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	q, err := options.QuoteFromTicker(t, underlyingPrice)
+	if err != nil {
+		return nil, err
+	}
+	analytics, err := options.Analyse(q, params)
+	if err != nil {
+		return nil, err
+	}
+	analytics.Exchange, analytics.Asset = exch.GetName(), a
+	return analytics, nil
+}
+
+// getVolatilitySurface analyses every enabled option contract of an
+// underlying and builds its volatility surface. Contracts which cannot be
+// analysed, such as those without a two sided market or trading below their
+// intrinsic value, are left out of the surface
+func getVolatilitySurface(ctx context.Context, exch exchange.IBotExchange, a asset.Item, underlying currency.Code, underlyingPrice float64, params *options.Parameters) (*options.Surface, []options.Analytics, error) {
+	if err := common.NilGuard(exch, params); err != nil {
+		return nil, nil, err
+	}
+	if !a.IsOptions() {
+		return nil, nil, fmt.Errorf("%w: %v", asset.ErrNotSupported, a)
+	}
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exch.SupportsRESTTickerBatchUpdates() {
+		if err := exch.UpdateTickers(ctx, a); err != nil {
+			log.Warnf(log.Global, "%s %s ticker batch update failed: %v", exch.GetName(), a, err)
+		}
+	}
+	var analytics []options.Analytics
+	for _, pair := range pairs {
+		c, err := options.ParseContract(pair)
+		if err != nil || !c.Underlying.Equal(underlying) {
+			continue
+		}
+		result, err := getOptionAnalytics(ctx, exch, a, pair, underlyingPrice, params)
+		if err != nil {
+			log.Debugf(log.Global, "%s %s %s excluded from volatility surface: %v", exch.GetName(), a, pair, err)
+			continue
+		}
+		analytics = append(analytics, *result)
+	}
+	surface, err := options.BuildSurface(underlying, analytics)
+	if err != nil {
+		return nil, nil, err
+	}
+	return surface, analytics, nil
+}
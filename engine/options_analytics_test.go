@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const optionsTestUnderlyingPrice = 100000
+
+var errOptionTickerUnavailable = errors.New("option ticker unavailable")
+
+// optionsExchange prevents option tickers missing from the cache being
+// fetched over the network
+type optionsExchange struct {
+	exchange.IBotExchange
+}
+
+func (optionsExchange) UpdateTicker(context.Context, currency.Pair, asset.Item) (*ticker.Price, error) {
+	return nil, errOptionTickerUnavailable
+}
+
+// setupOptionsExchange loads an exchange with option tickers priced at the
+// supplied volatility, the last enabled pair has no ticker
+func setupOptionsExchange(t *testing.T, name string, vol float64) (*ExchangeManager, exchange.IBotExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("deribit")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = name
+	b.Enabled = true
+
+	pairs, err := currency.NewPairsFromStrings([]string{
+		"BTC-27DEC30-90000-P",
+		"BTC-27DEC30-100000-C",
+		"BTC-27DEC30-110000-C",
+		"BTC-26DEC31-100000-C",
+		"ETH-27DEC30-4000-C",
+		"BTC-27DEC30-120000-C",
+	})
+	require.NoError(t, err, "NewPairsFromStrings must not error")
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Options, pairs, false), "StorePairs must not error")
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Options, pairs, true), "StorePairs must not error")
+	require.NoError(t, b.CurrencyPairs.SetAssetEnabled(asset.Options, true), "SetAssetEnabled must not error")
+
+	now := time.Now()
+	for _, p := range pairs[:len(pairs)-1] {
+		c, err := options.ParseContract(p)
+		require.NoError(t, err, "ParseContract must not error")
+		price, err := options.Price(&options.Inputs{
+			Model:           options.Black76,
+			Type:            c.Type,
+			UnderlyingPrice: optionsTestUnderlyingPrice,
+			Strike:          c.Strike,
+			TimeToExpiry:    c.TimeToExpiry(now),
+			Volatility:      vol,
+		})
+		require.NoError(t, err, "Price must not error")
+		require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+			ExchangeName: name,
+			Pair:         p,
+			AssetType:    asset.Options,
+			MarkPrice:    price / optionsTestUnderlyingPrice,
+			IndexPrice:   optionsTestUnderlyingPrice,
+			LastUpdated:  now,
+		}), "ProcessTicker must not error")
+	}
+	fake := optionsExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fake), "Add must not error")
+	return em, fake
+}
+
+func TestGetOptionAnalytics(t *testing.T) {
+	t.Parallel()
+	_, exch := setupOptionsExchange(t, "optionsAnalytics", 0.55)
+	_, err := getOptionAnalytics(t.Context(), nil, asset.Options, currency.EMPTYPAIR, 0, &options.Parameters{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pair, err := currency.NewPairFromString("BTC-27DEC30-110000-C")
+	require.NoError(t, err, "NewPairFromString must not error")
+	_, err = getOptionAnalytics(t.Context(), exch, asset.Spot, pair, 0, &options.Parameters{})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	a, err := getOptionAnalytics(t.Context(), exch, asset.Options, pair, 0, &options.Parameters{})
+	require.NoError(t, err, "getOptionAnalytics must not error")
+	assert.Equal(t, "optionsAnalytics", a.Exchange, "Exchange should be set")
+	assert.Equal(t, asset.Options, a.Asset, "Asset should be set")
+	assert.InDelta(t, 0.55, a.ImpliedVolatility, 1e-6, "ImpliedVolatility should be recovered")
+	assert.Equal(t, float64(optionsTestUnderlyingPrice), a.UnderlyingPrice, "UnderlyingPrice should default to the index price")
+
+	pair, err = currency.NewPairFromString("BTC-27DEC30-120000-C")
+	require.NoError(t, err, "NewPairFromString must not error")
+	_, err = getOptionAnalytics(t.Context(), exch, asset.Options, pair, 0, &options.Parameters{})
+	assert.ErrorIs(t, err, errOptionTickerUnavailable)
+}
+
+func TestGetVolatilitySurface(t *testing.T) {
+	t.Parallel()
+	_, exch := setupOptionsExchange(t, "optionsSurface", 0.6)
+	_, _, err := getVolatilitySurface(t.Context(), exch, asset.Spot, currency.BTC, 0, &options.Parameters{})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	s, analytics, err := getVolatilitySurface(t.Context(), exch, asset.Options, currency.BTC, 0, &options.Parameters{})
+	require.NoError(t, err, "getVolatilitySurface must not error")
+	assert.Len(t, analytics, 4, "contracts without a ticker or of another underlying should be excluded")
+	require.Len(t, s.Smiles, 2, "getVolatilitySurface must build a smile per expiry")
+	assert.Len(t, s.Smiles[0].Points, 3, "smile should contain a point per strike")
+	assert.InDelta(t, 0.6, s.Smiles[1].ATMVolatility, 1e-6, "ATMVolatility should be recovered")
+
+	_, _, err = getVolatilitySurface(t.Context(), exch, asset.Options, currency.SOL, 0, &options.Parameters{})
+	assert.ErrorIs(t, err, options.ErrNoQuotes)
+}
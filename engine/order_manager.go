@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -12,16 +14,21 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SetupOrderManager will boot up the OrderManager
@@ -42,6 +49,17 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 		return nil, errInvalidFuturesTrackingSeekDuration
 	}
 
+	notifier, err := setupOrderNotifier(communicationsManager, &cfg.Notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	fillMux := dispatch.GetNewMux(nil)
+	fillID, err := fillMux.GetID()
+	if err != nil {
+		return nil, err
+	}
+
 	om := &OrderManager{
 		shutdown:                      make(chan struct{}),
 		activelyTrackFuturesPositions: cfg.ActivelyTrackFuturesPositions,
@@ -53,6 +71,9 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			commsManager:              communicationsManager,
 			wg:                        wg,
 			futuresPositionController: futures.SetupPositionController(),
+			fillMux:                   fillMux,
+			fillID:                    fillID,
+			notifier:                  notifier,
 		},
 		verbose: cfg.Verbose,
 		cfg: orderManagerConfig{
@@ -91,6 +112,7 @@ func (m *OrderManager) Stop() error {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 	log.Debugln(log.OrderMgr, "Order manager shutting down...")
+	m.persistFuturesPositions()
 	close(m.shutdown)
 	m.started.CompareAndSwap(true, false)
 	return nil
@@ -126,6 +148,7 @@ func (m *OrderManager) gracefulShutdown(ctx context.Context) {
 // run will periodically process orders
 func (m *OrderManager) run(ctx context.Context) {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.restoreFuturesPositions(ctx)
 	m.processOrders(ctx)
 	for {
 		select {
@@ -193,11 +216,16 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 	var err error
+	// Order operations are served ahead of market data and account requests when rate limited
+	ctx = request.WithPriority(ctx, request.TradingPriority)
+	ctx, span := tracing.Start(ctx, "OrderManager.Cancel", cancelAttributes(cancel)...)
 	defer func() {
+		tracing.End(span, err)
 		if err != nil {
 			m.orderStore.commsManager.PushEvent(base.Event{
-				Type:    "order",
-				Message: err.Error(),
+				Type:     "order",
+				Message:  err.Error(),
+				Severity: base.SeverityWarning,
 			})
 		}
 	}()
@@ -227,7 +255,11 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 	log.Debugf(log.OrderMgr, "Cancelling order ID %v [%+v]",
 		cancel.OrderID, cancel)
 
-	err = exch.CancelOrder(ctx, cancel)
+	ctx = m.orderStore.withOrderAccount(ctx, cancel.Exchange, cancel.OrderID)
+
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.CancelOrder", tracing.ExchangeKey.String(cancel.Exchange))
+	err = exch.CancelOrder(exchCtx, cancel)
+	tracing.End(exchSpan, err)
 	if err != nil {
 		err = fmt.Errorf("%v - Failed to cancel order: %w", cancel.Exchange, err)
 		return err
@@ -252,9 +284,78 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 	return nil
 }
 
+// CancelAll sends a cancel all orders request to the exchange and marks the
+// matching active orders of the request's account as cancelled, except those
+// the exchange reports as failing to cancel
+func (m *OrderManager) CancelAll(ctx context.Context, cancel *order.Cancel) (_ order.CancelAllResponse, err error) {
+	if m == nil {
+		return order.CancelAllResponse{}, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return order.CancelAllResponse{}, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if cancel == nil {
+		return order.CancelAllResponse{}, order.ErrCancelOrderIsNil
+	}
+	ctx = request.WithPriority(ctx, request.TradingPriority)
+	ctx, span := tracing.Start(ctx, "OrderManager.CancelAll", cancelAttributes(cancel)...)
+	defer func() { tracing.End(span, err) }()
+
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(cancel.Exchange)
+	if err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.CancelAllOrders", tracing.ExchangeKey.String(cancel.Exchange))
+	resp, err := exch.CancelAllOrders(exchCtx, cancel)
+	tracing.End(exchSpan, err)
+	if err != nil {
+		m.orderStore.commsManager.PushEvent(base.Event{
+			Type:     "order",
+			Message:  fmt.Sprintf("Exchange %s: failed to cancel all orders: %v", cancel.Exchange, err),
+			Severity: base.SeverityWarning,
+		})
+		return resp, err
+	}
+
+	account := accounts.AccountFromContext(ctx)
+	var cancelled int
+	for _, od := range m.orderStore.getActiveOrders(&order.Filter{Exchange: exch.GetName(), AssetType: cancel.AssetType, Pair: cancel.Pair, Side: cancel.Side}) {
+		if od.Account != account || !cancelAllSucceeded(resp.Status, od.OrderID) {
+			continue
+		}
+		od.Status = order.Cancelled
+		if err := m.orderStore.updateExisting(&od); err != nil {
+			log.Errorf(log.OrderMgr, "%v - Failed to update existing order %v when all orders cancelled: %v", cancel.Exchange, od.OrderID, err)
+			continue
+		}
+		cancelled++
+	}
+	msg := fmt.Sprintf("Exchange %s all orders cancelled, %d tracked orders updated.", cancel.Exchange, cancelled)
+	log.Debugln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	return resp, nil
+}
+
+// cancelAllSucceeded reports whether a cancel all response does not report an
+// order as failing to cancel. Exchanges list either the failed orders with
+// their error or the cancelled orders with a success status
+func cancelAllSucceeded(status map[string]string, orderID string) bool {
+	s, ok := status[orderID]
+	if !ok {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "", "success", "true", "cancelled", "canceled":
+		return true
+	default:
+		return false
+	}
+}
+
 // GetFuturesPositionsForExchange returns futures positions stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
+// the order manager's futures position tracker that match the provided params.
+// An empty account returns positions for the exchange's default credentials
+func (m *OrderManager) GetFuturesPositionsForExchange(account, exch string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -265,12 +366,20 @@ func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.It
 		return nil, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.GetPositionsForExchange(exch, item, pair)
+	positions, err := m.orderStore.positionController(account).GetPositionsForExchange(exch, item, pair)
+	if err != nil {
+		return nil, err
+	}
+	for i := range positions {
+		positions[i].Account = account
+	}
+	return positions, nil
 }
 
 // GetOpenFuturesPosition returns an open futures position stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
+// the order manager's futures position tracker that match the provided params.
+// An empty account returns the position for the exchange's default credentials
+func (m *OrderManager) GetOpenFuturesPosition(account, exch string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -283,11 +392,16 @@ func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair
 	if !m.activelyTrackFuturesPositions {
 		return nil, errFuturesTrackingDisabled
 	}
-	return m.orderStore.futuresPositionController.GetOpenPosition(exch, item, pair)
+	position, err := m.orderStore.positionController(account).GetOpenPosition(exch, item, pair)
+	if err != nil {
+		return nil, err
+	}
+	position.Account = account
+	return position, nil
 }
 
 // GetAllOpenFuturesPositions returns all open futures positions stored within
-// the order manager's futures position tracker that match the provided params
+// the order manager's futures position tracker across all accounts
 func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
@@ -298,12 +412,29 @@ func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error)
 	if !m.activelyTrackFuturesPositions {
 		return nil, errFuturesTrackingDisabled
 	}
-	return m.orderStore.futuresPositionController.GetAllOpenPositions()
+	var positions []futures.Position
+	for account, pc := range m.orderStore.positionControllers() {
+		open, err := pc.GetAllOpenPositions()
+		if err != nil {
+			if errors.Is(err, futures.ErrNoPositionsFound) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range open {
+			open[i].Account = account
+		}
+		positions = append(positions, open...)
+	}
+	if len(positions) == 0 {
+		return nil, futures.ErrNoPositionsFound
+	}
+	return positions, nil
 }
 
-// ClearFuturesTracking will clear existing futures positions for a given exchange,
-// asset, pair for the event that positions have not been tracked accurately
-func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair currency.Pair) error {
+// ClearFuturesTracking will clear existing futures positions for a given account,
+// exchange, asset, pair for the event that positions have not been tracked accurately
+func (m *OrderManager) ClearFuturesTracking(account, exch string, item asset.Item, pair currency.Pair) error {
 	if m == nil {
 		return fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -314,13 +445,13 @@ func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair c
 		return fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.ClearPositionsForExchange(exch, item, pair)
+	return m.orderStore.positionController(account).ClearPositionsForExchange(exch, item, pair)
 }
 
 // UpdateOpenPositionUnrealisedPNL finds an open position from
-// an exchange asset pair, then calculates the unrealisedPNL
+// an account exchange asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
-func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
+func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(account, e string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
 	if m == nil {
 		return decimal.Zero, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -331,7 +462,7 @@ func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item
 		return decimal.Zero, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.UpdateOpenPositionUnrealisedPNL(e, item, pair, last, updated)
+	return m.orderStore.positionController(account).UpdateOpenPositionUnrealisedPNL(e, item, pair, last, updated)
 }
 
 // GetOrderInfo calls the exchange's wrapper GetOrderInfo function
@@ -402,13 +533,18 @@ func (m *OrderManager) validate(exch exchange.IBotExchange, newOrder *order.Subm
 
 // Modify depends on the order.Modify.ID and order.Modify.Exchange fields to uniquely
 // identify an order to modify.
-func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (_ *order.ModifyResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
 	if !m.started.Load() {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
+	ctx = request.WithPriority(ctx, request.TradingPriority)
+	ctx, span := tracing.Start(ctx, "OrderManager.Modify",
+		tracing.ExchangeKey.String(mod.Exchange),
+		tracing.OrderIDKey.String(mod.OrderID))
+	defer func() { tracing.End(span, err) }()
 
 	// Fetch details from locally managed order store.
 	det, err := m.orderStore.getByExchangeAndID(mod.Exchange, mod.OrderID)
@@ -421,6 +557,7 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	mod.Pair = det.Pair
 	mod.Side = det.Side
 	mod.TimeInForce = det.TimeInForce
+	ctx = m.orderStore.withOrderAccount(ctx, mod.Exchange, mod.OrderID)
 
 	// Following is just a precaution to not modify orders by mistake if exchange
 	// implementations do not check fields of the Modify struct for zero values.
@@ -436,7 +573,9 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if err != nil {
 		return nil, err
 	}
-	res, err := exch.ModifyOrder(ctx, mod)
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.ModifyOrder", tracing.ExchangeKey.String(mod.Exchange))
+	res, err := exch.ModifyOrder(exchCtx, mod)
+	tracing.End(exchSpan, err)
 	if err != nil {
 		message := fmt.Sprintf(
 			"Exchange %s order ID=%v: failed to modify",
@@ -444,8 +583,9 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 			mod.OrderID,
 		)
 		m.orderStore.commsManager.PushEvent(base.Event{
-			Type:    "order",
-			Message: message,
+			Type:     "order",
+			Message:  message,
+			Severity: base.SeverityWarning,
 		})
 		return nil, err
 	}
@@ -472,7 +612,7 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 
 // Submit will take in an order struct, send it to the exchange and
 // populate it in the OrderManager if successful
-func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (_ *OrderSubmitResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -482,6 +622,9 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if newOrder == nil {
 		return nil, errNilOrder
 	}
+	ctx = request.WithPriority(ctx, request.TradingPriority)
+	ctx, span := tracing.Start(ctx, "OrderManager.Submit", submitAttributes(newOrder)...)
+	defer func() { tracing.End(span, err) }()
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
 	if err != nil {
 		return nil, err
@@ -513,12 +656,40 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 			err)
 	}
 
-	result, err := exch.SubmitOrder(ctx, newOrder)
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.SubmitOrder", tracing.ExchangeKey.String(newOrder.Exchange))
+	result, err := exch.SubmitOrder(exchCtx, newOrder)
+	tracing.End(exchSpan, err)
 	if err != nil {
 		return nil, err
 	}
 
-	return m.processSubmittedOrder(result)
+	return m.processSubmittedOrder(result, accounts.AccountFromContext(ctx))
+}
+
+// submitAttributes returns the span attributes which describe an order
+// submission
+func submitAttributes(s *order.Submit) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.ExchangeKey.String(s.Exchange),
+		tracing.AssetKey.String(s.AssetType.String()),
+		tracing.PairKey.String(s.Pair.String()),
+		tracing.OrderSideKey.String(s.Side.String()),
+		tracing.OrderTypeKey.String(s.Type.String()),
+	}
+}
+
+// cancelAttributes returns the span attributes which describe an order
+// cancellation
+func cancelAttributes(c *order.Cancel) []attribute.KeyValue {
+	if c == nil {
+		return nil
+	}
+	return []attribute.KeyValue{
+		tracing.ExchangeKey.String(c.Exchange),
+		tracing.AssetKey.String(c.AssetType.String()),
+		tracing.PairKey.String(c.Pair.String()),
+		tracing.OrderIDKey.String(c.OrderID),
+	}
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -555,7 +726,16 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 				err)
 		}
 	}
-	return m.processSubmittedOrder(resultingOrder)
+	return m.processSubmittedOrder(resultingOrder, "")
+}
+
+// SubscribeOrderFills returns a pipe which receives a copy of an order,
+// as *order.Detail, each time the order store records a fill for it
+func (m *OrderManager) SubscribeOrderFills() (dispatch.Pipe, error) {
+	if m == nil {
+		return dispatch.Pipe{}, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return m.orderStore.fillMux.Subscribe(m.orderStore.fillID)
 }
 
 // GetOrdersSnapshot returns a snapshot of all orders in the orderstore. It optionally filters any orders that do not match the status
@@ -602,8 +782,9 @@ func (m *OrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error)
 	return m.orderStore.getActiveOrders(f), nil
 }
 
-// processSubmittedOrder adds a new order to the manager
-func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse) (*OrderSubmitResponse, error) {
+// processSubmittedOrder adds a new order placed with the account's credentials
+// to the manager
+func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse, account string) (*OrderSubmitResponse, error) {
 	if newOrderResp == nil {
 		return nil, order.ErrOrderDetailIsNil
 	}
@@ -617,6 +798,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	if err != nil {
 		return nil, err
 	}
+	detail.Account = account
 
 	if err := m.orderStore.add(detail.CopyToPointer()); errors.Is(err, ErrOrdersAlreadyExists) {
 		// Streamed by ws before we got here. Details from ws supersede since they are more recent.
@@ -663,114 +845,125 @@ func (m *OrderManager) processOrders(ctx context.Context) {
 		if !exchanges[x].IsRESTAuthenticationSupported() {
 			continue
 		}
-		if m.verbose {
-			log.Debugf(log.OrderMgr,
-				"Processing orders for exchange %v",
-				exchanges[x].GetName())
-		}
-		enabledAssets := exchanges[x].GetAssetTypes(true)
-		for y := range enabledAssets {
-			var pairs currency.Pairs
-			pairs, err = exchanges[x].GetEnabledPairs(enabledAssets[y])
-			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get enabled pairs for %s and asset type %s: %s",
+		for _, account := range exchangeAccounts(exchanges[x]) {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"Processing orders for exchange %v account %q",
 					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
-				continue
+					account)
 			}
+			m.processAccountOrders(accounts.DeployAccountToContext(ctx, account), exchanges[x], account, &wg)
+		}
+	}
+	wg.Wait()
+	m.persistFuturesPositions()
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Finished processing orders")
+	}
+}
 
-			if len(pairs) == 0 {
-				if m.verbose {
-					log.Debugf(log.OrderMgr,
-						"No pairs enabled for %s and asset type %s, skipping...",
-						exchanges[x].GetName(),
-						enabledAssets[y])
-				}
-				continue
+// processAccountOrders fetches active orders and futures positions for an
+// exchange account and adds them to the internal order store
+func (m *OrderManager) processAccountOrders(ctx context.Context, exch exchange.IBotExchange, account string, wg *sync.WaitGroup) {
+	enabledAssets := exch.GetAssetTypes(true)
+	for y := range enabledAssets {
+		pairs, err := exch.GetEnabledPairs(enabledAssets[y])
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Unable to get enabled pairs for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+
+		if len(pairs) == 0 {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"No pairs enabled for %s and asset type %s, skipping...",
+					exch.GetName(),
+					enabledAssets[y])
 			}
+			continue
+		}
 
-			filter := &order.Filter{Exchange: exchanges[x].GetName()}
-			orders := m.orderStore.getActiveOrders(filter)
-			order.FilterOrdersByPairs(&orders, pairs)
-			var result []order.Detail
-			result, err = exchanges[x].GetActiveOrders(ctx, &order.MultiOrderRequest{
-				Side:      order.AnySide,
-				Type:      order.AnyType,
-				Pairs:     pairs,
-				AssetType: enabledAssets[y],
-			})
+		filter := &order.Filter{Exchange: exch.GetName(), Account: account}
+		orders := m.orderStore.getActiveOrders(filter)
+		orders = slices.DeleteFunc(orders, func(o order.Detail) bool { return o.Account != account })
+		order.FilterOrdersByPairs(&orders, pairs)
+		var result []order.Detail
+		result, err = exch.GetActiveOrders(ctx, &order.MultiOrderRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: enabledAssets[y],
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Unable to get active orders for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+		for z := range result {
+			result[z].Account = account
+			var upsertResponse *OrderUpsertResponse
+			upsertResponse, err = m.UpsertOrder(&result[z])
 			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get active orders for %s and asset type %s: %s",
-					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
+				log.Errorln(log.OrderMgr, err)
 				continue
 			}
-			for z := range result {
-				var upsertResponse *OrderUpsertResponse
-				upsertResponse, err = m.UpsertOrder(&result[z])
-				if err != nil {
-					log.Errorln(log.OrderMgr, err)
+			for i := range orders {
+				if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
 					continue
 				}
-				for i := range orders {
-					if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
-						continue
-					}
-					orders[i] = orders[len(orders)-1]
-					orders = orders[:len(orders)-1]
-					break
-				}
+				orders[i] = orders[len(orders)-1]
+				orders = orders[:len(orders)-1]
+				break
 			}
+		}
 
-			if exchanges[x].GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
-				wg.Add(1)
-				go m.processMatchingOrders(ctx, exchanges[x], orders, &wg)
-			}
+		if exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
+			wg.Add(1)
+			go m.processMatchingOrders(ctx, exch, orders, wg)
+		}
 
-			supportedFeatures := exchanges[x].GetSupportedFeatures()
-			if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
-				var positions []futures.PositionResponse
-				var sd time.Time
-				sd, err = m.orderStore.futuresPositionController.LastUpdated()
-				if err != nil {
+		supportedFeatures := exch.GetSupportedFeatures()
+		if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
+			var positions []futures.PositionResponse
+			var sd time.Time
+			sd, err = m.orderStore.positionController(account).LastUpdated()
+			if err != nil {
+				log.Errorln(log.OrderMgr, err)
+				return
+			}
+			if sd.IsZero() {
+				sd = time.Now().Add(-m.futuresPositionSeekDuration)
+			}
+			positions, err = exch.GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
+				Asset:                     enabledAssets[y],
+				Pairs:                     pairs,
+				StartDate:                 sd,
+				RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+			})
+			if err != nil {
+				if !errors.Is(err, common.ErrNotYetImplemented) {
 					log.Errorln(log.OrderMgr, err)
-					return
 				}
-				if sd.IsZero() {
-					sd = time.Now().Add(-m.futuresPositionSeekDuration)
+				return
+			}
+			for z := range positions {
+				if len(positions[z].Orders) == 0 {
+					continue
 				}
-				positions, err = exchanges[x].GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
-					Asset:                     enabledAssets[y],
-					Pairs:                     pairs,
-					StartDate:                 sd,
-					RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
-				})
+				err = m.processFuturesPositions(ctx, exch, &positions[z])
 				if err != nil {
-					if !errors.Is(err, common.ErrNotYetImplemented) {
-						log.Errorln(log.OrderMgr, err)
-					}
-					return
-				}
-				for z := range positions {
-					if len(positions[z].Orders) == 0 {
-						continue
-					}
-					err = m.processFuturesPositions(ctx, exchanges[x], &positions[z])
-					if err != nil {
-						log.Errorf(log.OrderMgr, "unable to process future positions for %v %v %v. err: %v", exchanges[x].GetName(), positions[z].Asset, positions[z].Pair, err)
-					}
+					log.Errorf(log.OrderMgr, "unable to process future positions for %v %v %v. err: %v", exch.GetName(), positions[z].Asset, positions[z].Pair, err)
 				}
 			}
 		}
 	}
-	wg.Wait()
-	if m.verbose {
-		log.Debugf(log.OrderMgr, "Finished processing orders")
-	}
 }
 
 // processFuturesPositions ensures any open position found is kept up to date in the order manager
@@ -791,14 +984,17 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 		return position.Orders[i].Date.Before(position.Orders[j].Date)
 	})
 	feat := exch.GetSupportedFeatures()
+	account := accounts.AccountFromContext(ctx)
+	pc := m.orderStore.positionController(account)
 	var err error
 	for i := range position.Orders {
-		err = m.orderStore.futuresPositionController.TrackNewOrder(&position.Orders[i])
+		position.Orders[i].Account = account
+		err = pc.TrackNewOrder(&position.Orders[i])
 		if err != nil {
 			return err
 		}
 	}
-	_, err = m.orderStore.futuresPositionController.GetOpenPosition(exch.GetName(), position.Asset, position.Pair)
+	_, err = pc.GetOpenPosition(exch.GetName(), position.Asset, position.Pair)
 	if err != nil {
 		if errors.Is(err, futures.ErrPositionNotFound) {
 			return nil
@@ -809,7 +1005,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 	if err != nil {
 		return fmt.Errorf("%w when fetching ticker data for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
-	_, err = m.UpdateOpenPositionUnrealisedPNL(exch.GetName(), position.Asset, position.Pair, tick.Last, tick.LastUpdated)
+	_, err = m.UpdateOpenPositionUnrealisedPNL(account, exch.GetName(), position.Asset, position.Pair, tick.Last, tick.LastUpdated)
 	if err != nil {
 		return fmt.Errorf("%w when updating unrealised PNL for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
@@ -835,7 +1031,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 		return err
 	}
 
-	return m.orderStore.futuresPositionController.TrackFundingDetails(frp)
+	return pc.TrackFundingDetails(frp)
 }
 
 func (m *OrderManager) processMatchingOrders(ctx context.Context, exch exchange.IBotExchange, orders []order.Detail, wg *sync.WaitGroup) {
@@ -858,11 +1054,15 @@ func (m *OrderManager) FetchAndUpdateExchangeOrder(ctx context.Context, exch exc
 	if ord == nil {
 		return errors.New("order manager: Order is nil")
 	}
+	if ord.Account != "" {
+		ctx = accounts.DeployAccountToContext(ctx, ord.Account)
+	}
 	fetchedOrder, err := exch.GetOrderInfo(ctx, ord.OrderID, ord.Pair, assetType)
 	if err != nil {
 		ord.Status = order.UnknownStatus
 		return err
 	}
+	fetchedOrder.Account = ord.Account
 	fetchedOrder.LastUpdated = time.Now()
 	_, err = m.UpsertOrder(fetchedOrder)
 	return err
@@ -982,6 +1182,19 @@ func (s *store) getByExchangeAndID(exch, id string) (*order.Detail, error) {
 	return nil, ErrOrderNotFound
 }
 
+// withOrderAccount selects the credential profile a stored order was placed
+// with, unless an account has already been selected in the context
+func (s *store) withOrderAccount(ctx context.Context, exch, id string) context.Context {
+	if accounts.AccountFromContext(ctx) != "" {
+		return ctx
+	}
+	od, err := s.getByExchangeAndID(exch, id)
+	if err != nil || od.Account == "" {
+		return ctx
+	}
+	return accounts.DeployAccountToContext(ctx, od.Account)
+}
+
 // updateExisting checks if an order exists in the orderstore
 // and then updates it
 func (s *store) updateExisting(od *order.Detail) error {
@@ -998,14 +1211,17 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if r[x].OrderID != od.OrderID {
 			continue
 		}
+		prev := *r[x]
 		err := r[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return err
 		}
+		s.publishFill(&prev, r[x])
+		s.notifier.orderChanged(&prev, r[x])
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
-		err = s.futuresPositionController.TrackNewOrder(r[x])
+		err = s.trackFuturesOrder(r[x])
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return err
 		}
@@ -1031,7 +1247,7 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
-		err := s.futuresPositionController.TrackNewOrder(r[x])
+		err := s.trackFuturesOrder(r[x])
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return err
 		}
@@ -1054,7 +1270,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	s.m.Lock()
 	defer s.m.Unlock()
 	if od.AssetType.IsFutures() {
-		err = s.futuresPositionController.TrackNewOrder(od)
+		err = s.trackFuturesOrder(od)
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return nil, err
 		}
@@ -1066,10 +1282,13 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 		if exchangeOrders[x].OrderID != od.OrderID {
 			continue
 		}
+		prev := *exchangeOrders[x]
 		err := exchangeOrders[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return nil, err
 		}
+		s.publishFill(&prev, exchangeOrders[x])
+		s.notifier.orderChanged(&prev, exchangeOrders[x])
 		return &OrderUpsertResponse{
 			OrderDetails: exchangeOrders[x].Copy(),
 			IsNewOrder:   false,
@@ -1078,6 +1297,8 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	// Untracked websocket orders will not have internalIDs yet
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
+	s.publishFill(nil, od)
+	s.notifier.orderChanged(nil, od)
 	return &OrderUpsertResponse{OrderDetails: od.Copy(), IsNewOrder: true}, nil
 }
 
@@ -1127,10 +1348,74 @@ func (s *store) add(det *order.Detail) error {
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
 	s.Orders[name] = append(s.Orders[name], det)
+	s.publishFill(nil, det)
+	s.notifier.orderChanged(nil, det)
 	if !det.AssetType.IsFutures() {
 		return nil
 	}
-	return s.futuresPositionController.TrackNewOrder(det)
+	return s.trackFuturesOrder(det)
+}
+
+// publishFill notifies fill subscribers when an order has executed further
+// since its previous state, a nil previous state is a newly tracked order
+func (s *store) publishFill(prev, cur *order.Detail) {
+	if s.fillMux == nil {
+		return
+	}
+	filled := cur.Status == order.Filled || cur.Status == order.PartiallyFilled
+	if prev == nil && cur.ExecutedAmount <= 0 && !filled {
+		return
+	}
+	if prev != nil && cur.ExecutedAmount <= prev.ExecutedAmount && (!filled || cur.Status == prev.Status) {
+		return
+	}
+	if err := s.fillMux.Publish(cur.CopyToPointer(), s.fillID); err != nil {
+		log.Errorf(log.OrderMgr, "Cannot publish %s order %s fill: %v", cur.Exchange, cur.OrderID, err)
+	}
+}
+
+// trackFuturesOrder tracks a futures order with the position controller of its
+// account and notifies when the order closes the open position
+func (s *store) trackFuturesOrder(od *order.Detail) error {
+	pc := s.positionController(od.Account)
+	var open *futures.Position
+	if s.notifier.enabled {
+		open, _ = pc.GetOpenPosition(od.Exchange, od.AssetType, od.Pair)
+	}
+	err := pc.TrackNewOrder(od)
+	s.notifier.positionClosed(pc, open)
+	return err
+}
+
+// positionController returns the futures position controller for an account,
+// where an empty account is the exchange's default credentials
+func (s *store) positionController(account string) *futures.PositionController {
+	if account == "" {
+		return &s.futuresPositionController
+	}
+	s.positionsMu.Lock()
+	defer s.positionsMu.Unlock()
+	pc, ok := s.accountPositionControllers[account]
+	if !ok {
+		c := futures.SetupPositionController()
+		pc = &c
+		if s.accountPositionControllers == nil {
+			s.accountPositionControllers = make(map[string]*futures.PositionController)
+		}
+		s.accountPositionControllers[account] = pc
+	}
+	return pc
+}
+
+// positionControllers returns the futures position controllers for every
+// account keyed by account name
+func (s *store) positionControllers() map[string]*futures.PositionController {
+	s.positionsMu.Lock()
+	defer s.positionsMu.Unlock()
+	pcs := make(map[string]*futures.PositionController, len(s.accountPositionControllers)+1)
+	maps.Copy(pcs, s.accountPositionControllers)
+	pcs[""] = &s.futuresPositionController
+	return pcs
 }
 
 // getFilteredOrders returns a filtered copy of the orders
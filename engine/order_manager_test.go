@@ -15,6 +15,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
@@ -53,6 +55,12 @@ func (f omfExchange) CancelOrder(_ context.Context, _ *order.Cancel) error {
 	return nil
 }
 
+// CancelAllOrders overrides testExchange's cancel all orders function, order
+// ID "2" fails to cancel
+func (f omfExchange) CancelAllOrders(context.Context, *order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{Status: map[string]string{"2": "order is filled"}}, nil
+}
+
 func (f omfExchange) GetCachedTicker(p currency.Pair, a asset.Item) (*ticker.Price, error) {
 	return &ticker.Price{
 		Last:                  1337,
@@ -486,6 +494,46 @@ func TestCancelOrder(t *testing.T) {
 	}
 }
 
+func TestCancelAll(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.CancelAll(t.Context(), &order.Cancel{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	_, err = m.CancelAll(t.Context(), nil)
+	assert.ErrorIs(t, err, order.ErrCancelOrderIsNil)
+	_, err = m.CancelAll(t.Context(), &order.Cancel{Exchange: "hello world"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	for _, o := range []*order.Detail{
+		{Exchange: testExchange, OrderID: "1", AssetType: asset.Spot, Pair: btcusdPair, Side: order.Buy, Amount: 1, Status: order.New},
+		{Exchange: testExchange, OrderID: "2", AssetType: asset.Spot, Pair: btcusdPair, Side: order.Buy, Amount: 1, Status: order.New},
+		{Exchange: testExchange, OrderID: "3", AssetType: asset.Spot, Pair: btcusdPair, Side: order.Buy, Amount: 1, Status: order.New, Account: "hedge"},
+		{Exchange: testExchange, OrderID: "4", AssetType: asset.Spot, Pair: currency.NewPair(currency.ETH, currency.USD), Side: order.Buy, Amount: 1, Status: order.New},
+	} {
+		require.NoError(t, m.orderStore.add(o), "add must not error")
+	}
+	resp, err := m.CancelAll(t.Context(), &order.Cancel{Exchange: testExchange, AssetType: asset.Spot, Pair: btcusdPair})
+	require.NoError(t, err, "CancelAll must not error")
+	assert.Len(t, resp.Status, 1)
+	for id, status := range map[string]order.Status{"1": order.Cancelled, "2": order.New, "3": order.New, "4": order.New} {
+		od, err := m.orderStore.getByExchangeAndID(testExchange, id)
+		require.NoError(t, err, "getByExchangeAndID must not error")
+		assert.Equalf(t, status, od.Status, "order %s should only be cancelled when the exchange, account asset and pair match and it did not fail to cancel", id)
+	}
+}
+
+func TestCancelAllSucceeded(t *testing.T) {
+	t.Parallel()
+	status := map[string]string{"1": "success", "2": "true", "3": "", "4": "Cancellation Failed"}
+	assert.True(t, cancelAllSucceeded(status, "1"))
+	assert.True(t, cancelAllSucceeded(status, "2"))
+	assert.True(t, cancelAllSucceeded(status, "3"))
+	assert.False(t, cancelAllSucceeded(status, "4"), "an order listed with an error should not be cancelled")
+	assert.True(t, cancelAllSucceeded(status, "5"), "an order which is not listed should be cancelled")
+}
+
 func TestGetOrderInfo(t *testing.T) {
 	m := OrdersSetup(t)
 	_, err := m.GetOrderInfo(t.Context(), "", "", currency.EMPTYPAIR, asset.Empty)
@@ -1248,19 +1296,34 @@ func TestGetActiveOrders(t *testing.T) {
 	}
 }
 
+func TestStoreWithOrderAccount(t *testing.T) {
+	t.Parallel()
+	s := &store{Orders: map[string][]*order.Detail{
+		strings.ToLower(testExchange): {
+			{Exchange: testExchange, OrderID: "default"},
+			{Exchange: testExchange, OrderID: "hedging", Account: "hedging"},
+		},
+	}}
+	assert.Empty(t, accounts.AccountFromContext(s.withOrderAccount(t.Context(), testExchange, "default")), "default account orders should not select a profile")
+	assert.Empty(t, accounts.AccountFromContext(s.withOrderAccount(t.Context(), testExchange, "missing")), "unknown orders should not select a profile")
+	assert.Equal(t, "hedging", accounts.AccountFromContext(s.withOrderAccount(t.Context(), testExchange, "hedging")), "the order's profile should be selected")
+	ctx := accounts.DeployAccountToContext(t.Context(), "arbitrage")
+	assert.Equal(t, "arbitrage", accounts.AccountFromContext(s.withOrderAccount(ctx, testExchange, "hedging")), "an explicitly selected profile should not be replaced")
+}
+
 func TestGetFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err := o.GetFuturesPositionsForExchange("", "test", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err = o.GetFuturesPositionsForExchange("", "test", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("", "test", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1275,7 +1338,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("", "test", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 1 {
@@ -1283,7 +1346,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("", "test", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1291,15 +1354,15 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	err := o.ClearFuturesTracking("test", asset.Spot, cp)
+	err := o.ClearFuturesTracking("", "test", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	err = o.ClearFuturesTracking("test", asset.Spot, cp)
+	err = o.ClearFuturesTracking("", "test", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("", "test", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1314,10 +1377,10 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("", "test", asset.Futures, cp)
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("", "test", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 0 {
@@ -1325,7 +1388,7 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("", "test", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1333,15 +1396,15 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err := o.UpdateOpenPositionUnrealisedPNL("", "test", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("", "test", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("", "test", asset.Futures, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1356,7 +1419,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 2, time.Now())
+	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("", "test", asset.Futures, cp, 2, time.Now())
 	assert.NoError(t, err)
 
 	if !unrealised.Equal(decimal.NewFromInt(1)) {
@@ -1364,7 +1427,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("", "test", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1523,6 +1586,33 @@ func TestGetAllOpenFuturesPositions(t *testing.T) {
 	_, err = o.GetAllOpenFuturesPositions()
 	assert.ErrorIs(t, err, futures.ErrNoPositionsFound)
 
+	cp := currency.NewBTCUSDT()
+	for _, account := range []string{"", "hedging"} {
+		err = o.orderStore.positionController(account).TrackNewOrder(&order.Detail{
+			OrderID:   "test" + account,
+			Date:      time.Now(),
+			Exchange:  "test",
+			AssetType: asset.Futures,
+			Pair:      cp,
+			Side:      order.Buy,
+			Amount:    1,
+			Price:     1,
+			Account:   account,
+		})
+		require.NoError(t, err, "TrackNewOrder must not error")
+	}
+	positions, err := o.GetAllOpenFuturesPositions()
+	require.NoError(t, err, "GetAllOpenFuturesPositions must not error")
+	require.Len(t, positions, 2, "positions must be aggregated across accounts")
+	accs := []string{positions[0].Account, positions[1].Account}
+	assert.ElementsMatch(t, []string{"", "hedging"}, accs, "positions should be labelled with their account")
+
+	position, err := o.GetOpenFuturesPosition("hedging", "test", asset.Futures, cp)
+	require.NoError(t, err, "GetOpenFuturesPosition must not error")
+	assert.Equal(t, "hedging", position.Account)
+	_, err = o.GetOpenFuturesPosition("arbitrage", "test", asset.Futures, cp)
+	assert.ErrorIs(t, err, futures.ErrPositionNotFound, "positions should not be shared between accounts")
+
 	o = nil
 	_, err = o.GetAllOpenFuturesPositions()
 	assert.ErrorIs(t, err, ErrNilSubsystem)
@@ -1536,11 +1626,11 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(false)
 	cp := currency.NewPair(currency.BTC, currency.PERP)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
 	em := NewExchangeManager()
@@ -1583,10 +1673,10 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(true)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1601,11 +1691,11 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Futures, cp)
 	assert.NoError(t, err)
 
 	o = nil
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition("", testExchange, asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1731,3 +1821,45 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+func TestSubscribeOrderFills(t *testing.T) {
+	t.Parallel()
+	_, err := (*OrderManager)(nil).SubscribeOrderFills()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	m, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	pipe, err := m.SubscribeOrderFills()
+	require.NoError(t, err, "SubscribeOrderFills must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	open := &order.Detail{Exchange: testExchange, OrderID: "1", Status: order.New, Amount: 2}
+	m.orderStore.publishFill(nil, open)
+	m.orderStore.publishFill(open, open)
+	partial := open.Copy()
+	partial.Status, partial.ExecutedAmount = order.PartiallyFilled, 1
+	m.orderStore.publishFill(open, &partial)
+	m.orderStore.publishFill(&partial, &partial)
+	filled := partial.Copy()
+	filled.Status, filled.ExecutedAmount = order.Filled, 2
+	m.orderStore.publishFill(&partial, &filled)
+
+	executed := make([]float64, 0, 2)
+	for range 2 {
+		select {
+		case data := <-pipe.Channel():
+			d, ok := data.(*order.Detail)
+			require.True(t, ok, "Fill must be an *order.Detail")
+			executed = append(executed, d.ExecutedAmount)
+		case <-time.After(time.Second):
+			require.Fail(t, "Fill must be published")
+		}
+	}
+	assert.ElementsMatch(t, []float64{1, 2}, executed, "Only executions should be published")
+	select {
+	case data := <-pipe.Channel():
+		assert.Failf(t, "Unexpected fill published", "%+v", data)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
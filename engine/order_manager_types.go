@@ -6,7 +6,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/futuresposition"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -52,6 +55,11 @@ type OrderManager struct {
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	// positionDB persists futures positions when set, persistedPositions
+	// holds the last update of each position stored
+	positionDB         futuresposition.IDBService
+	persistedPositions map[storedPositionKey]time.Time
+	persistMu          sync.Mutex
 }
 
 // store holds all orders by exchange
@@ -62,6 +70,16 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	// accountPositionControllers track futures positions for orders placed
+	// with credential profiles, keyed by profile name
+	accountPositionControllers map[string]*futures.PositionController
+	positionsMu                sync.Mutex
+	// fillMux publishes a copy of an order to fillID subscribers whenever
+	// the order store records a new fill
+	fillMux *dispatch.Mux
+	fillID  uuid.UUID
+	// notifier pushes order lifecycle changes to the communications manager
+	notifier orderNotifier
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errInvalidOrderNotificationEvent = errors.New("invalid order notification event")
+
+	orderNotificationEvents = []string{
+		base.OrderPartiallyFilledEventType,
+		base.OrderFilledEventType,
+		base.OrderRejectedEventType,
+		base.OrderExpiredEventType,
+		base.PositionClosedEventType,
+	}
+)
+
+// orderNotifier pushes order lifecycle changes to the communications manager
+type orderNotifier struct {
+	enabled bool
+	comms   iCommsManager
+	rules   []orderNotificationRule
+}
+
+// orderNotificationRule is a parsed config.OrderNotificationRule
+type orderNotificationRule struct {
+	exchange        string
+	asset           asset.Item
+	disabled        bool
+	events          []string
+	minFillAmount   float64
+	minFillNotional float64
+}
+
+// setupOrderNotifier validates the order notification rules
+func setupOrderNotifier(comms iCommsManager, cfg *config.OrderNotifications) (orderNotifier, error) {
+	n := orderNotifier{enabled: cfg.Enabled, comms: comms}
+	for i := range cfg.Rules {
+		r := orderNotificationRule{
+			exchange:        cfg.Rules[i].Exchange,
+			disabled:        cfg.Rules[i].Disabled,
+			minFillAmount:   cfg.Rules[i].MinFillAmount,
+			minFillNotional: cfg.Rules[i].MinFillNotional,
+		}
+		if cfg.Rules[i].Asset != "" {
+			a, err := asset.New(cfg.Rules[i].Asset)
+			if err != nil {
+				return orderNotifier{}, err
+			}
+			r.asset = a
+		}
+		for _, e := range cfg.Rules[i].Events {
+			e = strings.ToLower(e)
+			if !slices.Contains(orderNotificationEvents, e) {
+				return orderNotifier{}, fmt.Errorf("%w: %q", errInvalidOrderNotificationEvent, e)
+			}
+			r.events = append(r.events, e)
+		}
+		n.rules = append(n.rules, r)
+	}
+	return n, nil
+}
+
+// rule returns the most specific rule for the exchange and asset, an exchange
+// match takes precedence over an asset match
+func (n *orderNotifier) rule(exch string, a asset.Item) *orderNotificationRule {
+	if len(n.rules) == 0 {
+		return &orderNotificationRule{}
+	}
+	var best *orderNotificationRule
+	bestScore := -1
+	for i := range n.rules {
+		r := &n.rules[i]
+		if r.exchange != "" && !strings.EqualFold(r.exchange, exch) {
+			continue
+		}
+		if r.asset != asset.Empty && r.asset != a {
+			continue
+		}
+		var score int
+		if r.exchange != "" {
+			score += 2
+		}
+		if r.asset != asset.Empty {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = r, score
+		}
+	}
+	return best
+}
+
+// orderChanged notifies the fill, rejection or expiry of an order since its
+// previous state, a nil previous state is a newly tracked order
+func (n *orderNotifier) orderChanged(prev, cur *order.Detail) {
+	if !n.enabled || cur == nil {
+		return
+	}
+	var prevExecuted float64
+	prevStatus := order.UnknownStatus
+	if prev != nil {
+		prevExecuted, prevStatus = prev.ExecutedAmount, prev.Status
+	}
+	if cur.Status == prevStatus && cur.ExecutedAmount <= prevExecuted {
+		return
+	}
+	price := cur.AverageExecutedPrice
+	if price == 0 {
+		price = cur.Price
+	}
+	fill := &base.OrderFilled{
+		Exchange: cur.Exchange,
+		Asset:    cur.AssetType.String(),
+		Pair:     cur.Pair.String(),
+		Side:     cur.Side.String(),
+		OrderID:  cur.OrderID,
+		Price:    price,
+		Amount:   cur.ExecutedAmount - prevExecuted,
+		Executed: cur.ExecutedAmount,
+		Total:    cur.Amount,
+	}
+	switch {
+	case cur.Status == order.Filled && prevStatus != order.Filled:
+		if cur.ExecutedAmount == 0 {
+			fill.Executed = cur.Amount
+			fill.Amount = cur.Amount - prevExecuted
+		}
+		n.push(cur.Exchange, cur.AssetType, base.NewEvent(fill, base.SeverityInfo), fill.Amount, price)
+	case cur.Status != order.Filled && cur.ExecutedAmount > prevExecuted:
+		fill.Partial = true
+		n.push(cur.Exchange, cur.AssetType, base.NewEvent(fill, base.SeverityInfo), fill.Amount, price)
+	case cur.Status == order.Rejected, cur.Status == order.Expired:
+		severity := base.SeverityInfo
+		if cur.Status == order.Rejected {
+			severity = base.SeverityWarning
+		}
+		n.push(cur.Exchange, cur.AssetType, base.NewEvent(&base.OrderStatus{
+			Exchange: cur.Exchange,
+			Asset:    cur.AssetType.String(),
+			Pair:     cur.Pair.String(),
+			Side:     cur.Side.String(),
+			OrderID:  cur.OrderID,
+			Status:   cur.Status.String(),
+		}, severity), 0, 0)
+	}
+}
+
+// positionClosed notifies the realised PNL of a position which was open before
+// an order was tracked and has since closed
+func (n *orderNotifier) positionClosed(pc *futures.PositionController, open *futures.Position) {
+	if !n.enabled || open == nil {
+		return
+	}
+	positions, err := pc.GetPositionsForExchange(open.Exchange, open.Asset, open.Pair)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Cannot check %s %s %s position closure: %v", open.Exchange, open.Asset, open.Pair, err)
+		return
+	}
+	for i := range positions {
+		if !positions[i].OpeningDate.Equal(open.OpeningDate) || !positions[i].Status.IsInactive() {
+			continue
+		}
+		pnl, _ := positions[i].RealisedPNL.Float64()
+		n.push(open.Exchange, open.Asset, base.NewEvent(&base.PositionClosed{
+			Exchange:    positions[i].Exchange,
+			Asset:       positions[i].Asset.String(),
+			Pair:        positions[i].Pair.String(),
+			Direction:   positions[i].OpeningDirection.String(),
+			RealisedPNL: pnl,
+			Currency:    positions[i].CollateralCurrency.String(),
+		}, base.SeverityInfo), 0, 0)
+		return
+	}
+}
+
+// push sends the event when the rule for the exchange and asset allows it
+func (n *orderNotifier) push(exch string, a asset.Item, evt base.Event, fillAmount, price float64) {
+	r := n.rule(exch, a)
+	if r == nil || r.disabled || n.comms == nil {
+		return
+	}
+	if len(r.events) != 0 && !slices.Contains(r.events, evt.Type) {
+		return
+	}
+	if fillAmount > 0 && (fillAmount < r.minFillAmount || fillAmount*price < r.minFillNotional) {
+		return
+	}
+	n.comms.PushEvent(evt)
+}
@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestSetupOrderNotifier(t *testing.T) {
+	t.Parallel()
+	_, err := setupOrderNotifier(nil, &config.OrderNotifications{Rules: []config.OrderNotificationRule{{Asset: "nope"}}})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = setupOrderNotifier(nil, &config.OrderNotifications{Rules: []config.OrderNotificationRule{{Events: []string{"order_cancelled"}}}})
+	assert.ErrorIs(t, err, errInvalidOrderNotificationEvent)
+
+	n, err := setupOrderNotifier(nil, &config.OrderNotifications{Enabled: true, Rules: []config.OrderNotificationRule{
+		{MinFillAmount: 1},
+		{Asset: "spot", Events: []string{"ORDER_FILLED"}},
+		{Exchange: "Binance"},
+		{Exchange: "binance", Asset: "spot", Disabled: true},
+	}})
+	require.NoError(t, err, "setupOrderNotifier must not error")
+	assert.True(t, n.enabled, "setupOrderNotifier should set enabled")
+	assert.Equal(t, []string{base.OrderFilledEventType}, n.rules[1].events, "setupOrderNotifier should lower case the events")
+	assert.Equal(t, &n.rules[3], n.rule("BINANCE", asset.Spot), "rule should match the exchange and asset rule")
+	assert.Equal(t, &n.rules[2], n.rule("Binance", asset.Futures), "rule should match the exchange rule")
+	assert.Equal(t, &n.rules[1], n.rule("Kraken", asset.Spot), "rule should match the asset rule")
+	assert.Equal(t, &n.rules[0], n.rule("Kraken", asset.Futures), "rule should match the default rule")
+
+	n.rules = n.rules[1:2]
+	assert.Nil(t, n.rule("Kraken", asset.Futures), "rule should return nil when no rule matches")
+	n.rules = nil
+	assert.NotNil(t, n.rule("Kraken", asset.Futures), "rule should match all without rules")
+}
+
+func TestOrderChanged(t *testing.T) {
+	t.Parallel()
+	comms := &testCommsManager{}
+	n, err := setupOrderNotifier(comms, &config.OrderNotifications{Enabled: true, Rules: []config.OrderNotificationRule{
+		{MinFillAmount: 0.1, MinFillNotional: 10},
+		{Exchange: "Kraken", Events: []string{base.OrderRejectedEventType}},
+	}})
+	require.NoError(t, err, "setupOrderNotifier must not error")
+	cp := currency.NewBTCUSDT()
+	od := &order.Detail{Exchange: "Binance", AssetType: asset.Spot, Pair: cp, Side: order.Buy, OrderID: "1", Amount: 2, Price: 100, Status: order.New}
+
+	n.orderChanged(nil, od)
+	assert.Empty(t, comms.events, "orderChanged should not notify a new order")
+
+	prev := *od
+	od.Status, od.ExecutedAmount = order.PartiallyFilled, 0.05
+	n.orderChanged(&prev, od)
+	assert.Empty(t, comms.events, "orderChanged should suppress fills below the minimum amount")
+
+	prev = *od
+	od.ExecutedAmount, od.AverageExecutedPrice = 0.5, 101
+	n.orderChanged(&prev, od)
+	require.Len(t, comms.events, 1, "orderChanged must notify a partial fill")
+	assert.Equal(t, base.OrderPartiallyFilledEventType, comms.events[0].Type, "orderChanged should notify a partial fill")
+	fill, ok := comms.events[0].Data.(*base.OrderFilled)
+	require.True(t, ok, "event data must be an order fill")
+	assert.InDelta(t, 0.45, fill.Amount, 1e-9, "orderChanged should notify the amount filled since the last update")
+	assert.Equal(t, 101.0, fill.Price, "orderChanged should use the average executed price")
+
+	n.orderChanged(od, od)
+	assert.Len(t, comms.events, 1, "orderChanged should not notify an unchanged order")
+
+	prev = *od
+	od.Status, od.ExecutedAmount = order.Filled, 0
+	n.orderChanged(&prev, od)
+	require.Len(t, comms.events, 2, "orderChanged must notify a full fill")
+	assert.Equal(t, base.OrderFilledEventType, comms.events[1].Type, "orderChanged should notify a full fill")
+	fill, ok = comms.events[1].Data.(*base.OrderFilled)
+	require.True(t, ok, "event data must be an order fill")
+	assert.Equal(t, 1.5, fill.Amount, "orderChanged should use the order amount when the executed amount is not set")
+
+	rejected := &order.Detail{Exchange: "Kraken", AssetType: asset.Spot, Pair: cp, OrderID: "2", Status: order.Rejected}
+	n.orderChanged(nil, rejected)
+	require.Len(t, comms.events, 3, "orderChanged must notify a rejection")
+	assert.Equal(t, base.OrderRejectedEventType, comms.events[2].Type, "orderChanged should notify a rejection")
+	assert.Equal(t, base.SeverityWarning, comms.events[2].Severity, "orderChanged should notify a rejection as a warning")
+
+	expired := &order.Detail{Exchange: "Kraken", AssetType: asset.Spot, Pair: cp, OrderID: "3", Status: order.Expired}
+	n.orderChanged(nil, expired)
+	assert.Len(t, comms.events, 3, "orderChanged should not notify events excluded by the rule")
+
+	n.enabled = false
+	n.orderChanged(nil, rejected)
+	assert.Len(t, comms.events, 3, "orderChanged should not notify when disabled")
+}
+
+func TestTrackFuturesOrderPositionClosed(t *testing.T) {
+	t.Parallel()
+	comms := &testCommsManager{}
+	n, err := setupOrderNotifier(comms, &config.OrderNotifications{Enabled: true})
+	require.NoError(t, err, "setupOrderNotifier must not error")
+	s := &store{futuresPositionController: futures.SetupPositionController(), notifier: n}
+	cp := currency.NewBTCUSDT()
+	tn := time.Now()
+
+	require.NoError(t, s.trackFuturesOrder(&order.Detail{
+		OrderID: "1", Date: tn, Exchange: "test", AssetType: asset.Futures, Pair: cp,
+		Side: order.Long, Amount: 1, Price: 100, Status: order.Filled,
+	}), "trackFuturesOrder must not error")
+	assert.Empty(t, comms.events, "trackFuturesOrder should not notify an opened position")
+
+	require.NoError(t, s.trackFuturesOrder(&order.Detail{
+		OrderID: "2", Date: tn.Add(time.Second), Exchange: "test", AssetType: asset.Futures, Pair: cp,
+		Side: order.Short, Amount: 1, Price: 110, Status: order.Filled,
+	}), "trackFuturesOrder must not error")
+	require.Len(t, comms.events, 1, "trackFuturesOrder must notify a closed position")
+	assert.Equal(t, base.PositionClosedEventType, comms.events[0].Type, "trackFuturesOrder should notify a closed position")
+	closed, ok := comms.events[0].Data.(*base.PositionClosed)
+	require.True(t, ok, "event data must be a closed position")
+	assert.Equal(t, 10.0, closed.RealisedPNL, "trackFuturesOrder should notify the realised PNL")
+}
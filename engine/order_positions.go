@@ -0,0 +1,365 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/futuresposition"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// storedPositionKey identifies a persisted futures position
+type storedPositionKey struct {
+	account string
+	key.ExchangeAssetPair
+	opened int64
+}
+
+func newStoredPositionKey(account string, p *futures.Position) storedPositionKey {
+	return storedPositionKey{
+		account:           account,
+		ExchangeAssetPair: key.NewExchangeAssetPair(strings.ToLower(p.Exchange), p.Asset, p.Pair),
+		opened:            p.OpeningDate.UnixNano(),
+	}
+}
+
+// SetupFuturesPositionPersistence stores tracked futures positions, their PNL
+// history and funding payments in the database so the position controllers
+// are rebuilt when the order manager next starts. It must be called before
+// the order manager is started
+func (m *OrderManager) SetupFuturesPositionPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if m.started.Load() {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	db, err := futuresposition.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	if db == nil {
+		return fmt.Errorf("futures position persistence %w", database.ErrDatabaseNotConnected)
+	}
+	m.positionDB = db
+	m.persistedPositions = make(map[storedPositionKey]time.Time)
+	return nil
+}
+
+// restoreFuturesPositions rebuilds the position controllers from the database
+// and reconciles the restored open positions against the exchanges
+func (m *OrderManager) restoreFuturesPositions(ctx context.Context) {
+	if m.positionDB == nil {
+		return
+	}
+	stored, err := m.positionDB.GetAll()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager cannot load futures positions: %v", err)
+		return
+	}
+	m.persistMu.Lock()
+	byAccount := make(map[string][]futures.Position)
+	for i := range stored {
+		pos, err := positionFromDB(&stored[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager cannot restore %v %v %v-%v futures position: %v",
+				stored[i].ExchangeName, stored[i].Asset, stored[i].Base, stored[i].Quote, err)
+			continue
+		}
+		byAccount[pos.Account] = append(byAccount[pos.Account], *pos)
+		m.persistedPositions[newStoredPositionKey(pos.Account, pos)] = pos.LastUpdated
+	}
+	m.persistMu.Unlock()
+	for account, positions := range byAccount {
+		if err := m.orderStore.positionController(account).RestorePositions(positions); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager cannot restore futures positions for account %q: %v", account, err)
+			delete(byAccount, account)
+		}
+	}
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Order manager restored %d futures positions", len(stored))
+	}
+	m.reconcileFuturesPositions(ctx, byAccount)
+}
+
+// reconcileFuturesPositions fetches the positions held on each exchange for
+// restored open positions, tracking any orders placed while offline and
+// warning about open positions the exchange no longer reports
+func (m *OrderManager) reconcileFuturesPositions(ctx context.Context, restored map[string][]futures.Position) {
+	type request struct {
+		account  string
+		exchange string
+		asset    asset.Item
+	}
+	requests := make(map[request][]*futures.Position)
+	for account, positions := range restored {
+		for i := range positions {
+			if positions[i].Status.IsInactive() {
+				continue
+			}
+			r := request{account: account, exchange: positions[i].Exchange, asset: positions[i].Asset}
+			requests[r] = append(requests[r], &positions[i])
+		}
+	}
+	for r, open := range requests {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(r.exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager cannot reconcile %v futures positions: %v", r.exchange, err)
+			continue
+		}
+		req := &futures.PositionsRequest{
+			Asset:                     r.asset,
+			StartDate:                 open[0].OpeningDate,
+			RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+		}
+		for _, pos := range open {
+			req.Pairs = append(req.Pairs, pos.Pair)
+			if pos.OpeningDate.Before(req.StartDate) {
+				req.StartDate = pos.OpeningDate
+			}
+		}
+		details, err := exch.GetFuturesPositions(accounts.DeployAccountToContext(ctx, r.account), req)
+		if err != nil {
+			if !errors.Is(err, common.ErrNotYetImplemented) && !errors.Is(err, common.ErrFunctionNotSupported) {
+				log.Errorf(log.OrderMgr, "Order manager cannot reconcile %v %v futures positions: %v", r.exchange, r.asset, err)
+			}
+			continue
+		}
+		pc := m.orderStore.positionController(r.account)
+		reported := make(map[key.PairAsset]bool, len(details))
+		for i := range details {
+			if len(details[i].Orders) == 0 {
+				continue
+			}
+			reported[key.PairAsset{Base: details[i].Pair.Base.Item, Quote: details[i].Pair.Quote.Item, Asset: details[i].Asset}] = true
+			for j := range details[i].Orders {
+				od := &details[i].Orders[j]
+				od.Account = r.account
+				if od.Exchange == "" {
+					od.Exchange = exch.GetName()
+				}
+				if err := pc.TrackNewOrder(od); err != nil {
+					log.Errorf(log.OrderMgr, "Order manager cannot reconcile %v %v %v order %v: %v", r.exchange, od.AssetType, od.Pair, od.OrderID, err)
+				}
+			}
+		}
+		for _, pos := range open {
+			if reported[key.PairAsset{Base: pos.Pair.Base.Item, Quote: pos.Pair.Quote.Item, Asset: pos.Asset}] {
+				continue
+			}
+			log.Warnf(log.OrderMgr, "Restored open %v %v %v position opened %v is not reported by the exchange, it may have been closed while offline",
+				r.exchange, pos.Asset, pos.Pair, pos.OpeningDate)
+		}
+	}
+}
+
+// persistFuturesPositions stores the futures positions which have changed
+// since they were last stored
+func (m *OrderManager) persistFuturesPositions() {
+	if m.positionDB == nil {
+		return
+	}
+	m.persistMu.Lock()
+	defer m.persistMu.Unlock()
+	var changed []*futuresposition.Position
+	updates := make(map[storedPositionKey]time.Time)
+	for account, pc := range m.orderStore.positionControllers() {
+		positions, err := pc.GetAllPositions()
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager cannot get futures positions for account %q: %v", account, err)
+			continue
+		}
+		for i := range positions {
+			k := newStoredPositionKey(account, &positions[i])
+			if last, ok := m.persistedPositions[k]; ok && !positions[i].LastUpdated.After(last) {
+				continue
+			}
+			positions[i].Account = account
+			changed = append(changed, positionToDB(&positions[i]))
+			updates[k] = positions[i].LastUpdated
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	if err := m.positionDB.Upsert(changed...); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager cannot store futures positions: %v", err)
+		return
+	}
+	maps.Copy(m.persistedPositions, updates)
+}
+
+// positionToDB converts a futures position to its database representation
+func positionToDB(p *futures.Position) *futuresposition.Position {
+	resp := &futuresposition.Position{
+		ExchangeName:       p.Exchange,
+		Account:            p.Account,
+		Asset:              p.Asset.String(),
+		Base:               p.Pair.Base.String(),
+		Quote:              p.Pair.Quote.String(),
+		Underlying:         p.Underlying.String(),
+		CollateralCurrency: p.CollateralCurrency.String(),
+		Status:             p.Status.String(),
+		OpeningDirection:   p.OpeningDirection.String(),
+		OpeningPrice:       p.OpeningPrice.InexactFloat64(),
+		OpeningSize:        p.OpeningSize.InexactFloat64(),
+		OpeningDate:        p.OpeningDate,
+		LatestDirection:    p.LatestDirection.String(),
+		LatestPrice:        p.LatestPrice.InexactFloat64(),
+		LatestSize:         p.LatestSize.InexactFloat64(),
+		RealisedPNL:        p.RealisedPNL.InexactFloat64(),
+		UnrealisedPNL:      p.UnrealisedPNL.InexactFloat64(),
+		ClosingPrice:       p.ClosingPrice.InexactFloat64(),
+		ClosingDate:        p.CloseDate,
+		LastUpdated:        p.LastUpdated,
+		Orders:             make([]futuresposition.Order, len(p.Orders)),
+		PNLHistory:         make([]futuresposition.PNLEntry, len(p.PNLHistory)),
+		FundingPayments:    make([]futuresposition.FundingPayment, len(p.FundingRates.FundingRates)),
+	}
+	for i := range p.Orders {
+		resp.Orders[i] = futuresposition.Order{
+			OrderID:        p.Orders[i].OrderID,
+			Side:           p.Orders[i].Side.String(),
+			Type:           p.Orders[i].Type.String(),
+			Status:         p.Orders[i].Status.String(),
+			Price:          p.Orders[i].Price,
+			Amount:         p.Orders[i].Amount,
+			ExecutedAmount: p.Orders[i].ExecutedAmount,
+			Fee:            p.Orders[i].Fee,
+			Leverage:       p.Orders[i].Leverage,
+			Date:           p.Orders[i].Date,
+		}
+	}
+	for i := range p.PNLHistory {
+		resp.PNLHistory[i] = futuresposition.PNLEntry{
+			Time:                  p.PNLHistory[i].Time,
+			Status:                p.PNLHistory[i].Status.String(),
+			UnrealisedPNL:         p.PNLHistory[i].UnrealisedPNL.InexactFloat64(),
+			RealisedPNLBeforeFees: p.PNLHistory[i].RealisedPNLBeforeFees.InexactFloat64(),
+			RealisedPNL:           p.PNLHistory[i].RealisedPNL.InexactFloat64(),
+			Price:                 p.PNLHistory[i].Price.InexactFloat64(),
+			Exposure:              p.PNLHistory[i].Exposure.InexactFloat64(),
+			Direction:             p.PNLHistory[i].Direction.String(),
+			Fee:                   p.PNLHistory[i].Fee.InexactFloat64(),
+			IsLiquidated:          p.PNLHistory[i].IsLiquidated,
+			IsOrder:               p.PNLHistory[i].IsOrder,
+		}
+	}
+	for i, r := range p.FundingRates.FundingRates {
+		resp.FundingPayments[i] = futuresposition.FundingPayment{
+			Time:    r.Time,
+			Rate:    r.Rate.InexactFloat64(),
+			Payment: r.Payment.InexactFloat64(),
+		}
+	}
+	return resp
+}
+
+// positionFromDB converts a stored futures position back to a position the
+// position controller can restore. Unrecognised order sides, types and
+// statuses were stored as unknown and are restored as such
+func positionFromDB(p *futuresposition.Position) (*futures.Position, error) {
+	a, err := asset.New(p.Asset)
+	if err != nil {
+		return nil, err
+	}
+	status, _ := order.StringToOrderStatus(p.Status)
+	openingDirection, _ := order.StringToOrderSide(p.OpeningDirection)
+	latestDirection, _ := order.StringToOrderSide(p.LatestDirection)
+	resp := &futures.Position{
+		Exchange:           p.ExchangeName,
+		Account:            p.Account,
+		Asset:              a,
+		Pair:               currency.NewPair(currency.NewCode(p.Base), currency.NewCode(p.Quote)),
+		Underlying:         currency.NewCode(p.Underlying),
+		CollateralCurrency: currency.NewCode(p.CollateralCurrency),
+		RealisedPNL:        decimal.NewFromFloat(p.RealisedPNL),
+		UnrealisedPNL:      decimal.NewFromFloat(p.UnrealisedPNL),
+		Status:             status,
+		OpeningDate:        p.OpeningDate,
+		OpeningPrice:       decimal.NewFromFloat(p.OpeningPrice),
+		OpeningSize:        decimal.NewFromFloat(p.OpeningSize),
+		OpeningDirection:   openingDirection,
+		LatestPrice:        decimal.NewFromFloat(p.LatestPrice),
+		LatestSize:         decimal.NewFromFloat(p.LatestSize),
+		LatestDirection:    latestDirection,
+		LastUpdated:        p.LastUpdated,
+		ClosingPrice:       decimal.NewFromFloat(p.ClosingPrice),
+		CloseDate:          p.ClosingDate,
+		Orders:             make([]order.Detail, len(p.Orders)),
+		PNLHistory:         make([]futures.PNLResult, len(p.PNLHistory)),
+	}
+	for i := range p.Orders {
+		side, _ := order.StringToOrderSide(p.Orders[i].Side)
+		oType, _ := order.StringToOrderType(p.Orders[i].Type)
+		oStatus, _ := order.StringToOrderStatus(p.Orders[i].Status)
+		resp.Orders[i] = order.Detail{
+			Exchange:       p.ExchangeName,
+			Account:        p.Account,
+			AssetType:      a,
+			Pair:           resp.Pair,
+			OrderID:        p.Orders[i].OrderID,
+			Side:           side,
+			Type:           oType,
+			Status:         oStatus,
+			Price:          p.Orders[i].Price,
+			Amount:         p.Orders[i].Amount,
+			ExecutedAmount: p.Orders[i].ExecutedAmount,
+			Fee:            p.Orders[i].Fee,
+			Leverage:       p.Orders[i].Leverage,
+			Date:           p.Orders[i].Date,
+		}
+	}
+	for i := range p.PNLHistory {
+		pnlStatus, _ := order.StringToOrderStatus(p.PNLHistory[i].Status)
+		direction, _ := order.StringToOrderSide(p.PNLHistory[i].Direction)
+		resp.PNLHistory[i] = futures.PNLResult{
+			Status:                pnlStatus,
+			Time:                  p.PNLHistory[i].Time,
+			UnrealisedPNL:         decimal.NewFromFloat(p.PNLHistory[i].UnrealisedPNL),
+			RealisedPNLBeforeFees: decimal.NewFromFloat(p.PNLHistory[i].RealisedPNLBeforeFees),
+			RealisedPNL:           decimal.NewFromFloat(p.PNLHistory[i].RealisedPNL),
+			Price:                 decimal.NewFromFloat(p.PNLHistory[i].Price),
+			Exposure:              decimal.NewFromFloat(p.PNLHistory[i].Exposure),
+			Direction:             direction,
+			Fee:                   decimal.NewFromFloat(p.PNLHistory[i].Fee),
+			IsLiquidated:          p.PNLHistory[i].IsLiquidated,
+			IsOrder:               p.PNLHistory[i].IsOrder,
+		}
+	}
+	if len(p.FundingPayments) > 0 {
+		resp.FundingRates = fundingrate.HistoricalRates{
+			Exchange:     p.ExchangeName,
+			Asset:        a,
+			Pair:         resp.Pair,
+			FundingRates: make([]fundingrate.Rate, len(p.FundingPayments)),
+		}
+		for i := range p.FundingPayments {
+			payment := decimal.NewFromFloat(p.FundingPayments[i].Payment)
+			resp.FundingRates.FundingRates[i] = fundingrate.Rate{
+				Time:    p.FundingPayments[i].Time,
+				Rate:    decimal.NewFromFloat(p.FundingPayments[i].Rate),
+				Payment: payment,
+			}
+			resp.FundingRates.PaymentSum = resp.FundingRates.PaymentSum.Add(payment)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/futuresposition"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// futuresPositionService is an in memory futuresposition.IDBService
+type futuresPositionService struct {
+	m         sync.Mutex
+	positions map[string]futuresposition.Position
+	upserts   int
+	err       error
+}
+
+func (f *futuresPositionService) Upsert(positions ...*futuresposition.Position) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	if f.positions == nil {
+		f.positions = make(map[string]futuresposition.Position)
+	}
+	for _, p := range positions {
+		f.positions[p.Account+p.ExchangeName+p.Asset+p.Base+p.Quote+p.OpeningDate.String()] = *p
+	}
+	f.upserts++
+	return nil
+}
+
+func (f *futuresPositionService) GetAll() ([]futuresposition.Position, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := make([]futuresposition.Position, 0, len(f.positions))
+	for _, p := range f.positions {
+		resp = append(resp, p)
+	}
+	return resp, nil
+}
+
+// positionsSetup returns an order manager persisting futures positions to db
+func positionsSetup(t *testing.T, db futuresposition.IDBService) *OrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}), "Add must not error")
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.positionDB = db
+	m.persistedPositions = make(map[storedPositionKey]time.Time)
+	m.started.Store(true)
+	return m
+}
+
+func TestSetupFuturesPositionPersistence(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.SetupFuturesPositionPersistence(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	var wg sync.WaitGroup
+	m, err = SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+
+	err = m.SetupFuturesPositionPersistence(nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	err = m.SetupFuturesPositionPersistence(&DatabaseConnectionManager{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+
+	dbInst := &database.Instance{}
+	require.NoError(t, dbInst.SetConfig(&database.Config{Enabled: true, Driver: database.DBSQLite3}), "SetConfig must not error")
+	dbInst.SetConnected(true)
+	require.NoError(t, dbInst.SetSQLiteConnection(&sql.DB{}), "SetSQLiteConnection must not error")
+	dbCM := &DatabaseConnectionManager{dbConn: dbInst}
+	dbCM.started.Store(true)
+
+	m.started.Store(true)
+	err = m.SetupFuturesPositionPersistence(dbCM)
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+
+	m.started.Store(false)
+	err = m.SetupFuturesPositionPersistence(dbCM)
+	require.NoError(t, err, "SetupFuturesPositionPersistence must not error")
+	assert.NotNil(t, m.positionDB, "positionDB should be set")
+}
+
+func TestPersistAndRestoreFuturesPositions(t *testing.T) {
+	t.Parallel()
+	db := &futuresPositionService{}
+	m := positionsSetup(t, db)
+
+	cp := currency.NewPair(currency.BTC, currency.PERP)
+	tn := time.Now().Truncate(time.Second)
+	for i, side := range []order.Side{order.Long, order.Short, order.Short} {
+		err := m.orderStore.positionController("alt").TrackNewOrder(&order.Detail{
+			Exchange:  testExchange,
+			AssetType: asset.Futures,
+			Pair:      cp,
+			OrderID:   string(rune('a' + i)),
+			Side:      side,
+			Type:      order.Market,
+			Status:    order.Filled,
+			Price:     1337,
+			Amount:    1,
+			Date:      tn.Add(time.Duration(i) * time.Second),
+		})
+		require.NoError(t, err, "TrackNewOrder must not error")
+	}
+	_, err := m.UpdateOpenPositionUnrealisedPNL("alt", testExchange, asset.Futures, cp, 1330, tn.Add(time.Minute))
+	require.NoError(t, err, "UpdateOpenPositionUnrealisedPNL must not error")
+	err = m.orderStore.positionController("alt").TrackFundingDetails(&fundingrate.HistoricalRates{
+		Exchange:     testExchange,
+		Asset:        asset.Futures,
+		Pair:         cp,
+		StartDate:    tn,
+		EndDate:      tn.Add(time.Hour),
+		FundingRates: []fundingrate.Rate{{Time: tn.Add(time.Hour), Rate: decimal.NewFromFloat(0.01), Payment: decimal.NewFromFloat(-1.5)}},
+	})
+	require.NoError(t, err, "TrackFundingDetails must not error")
+
+	m.persistFuturesPositions()
+	require.Len(t, db.positions, 2, "persistFuturesPositions must store both positions")
+	assert.Equal(t, 1, db.upserts, "persistFuturesPositions should store positions once")
+
+	m.persistFuturesPositions()
+	assert.Equal(t, 1, db.upserts, "persistFuturesPositions should not store unchanged positions")
+
+	restored := positionsSetup(t, db)
+	restored.restoreFuturesPositions(t.Context())
+
+	positions, err := restored.GetFuturesPositionsForExchange("alt", testExchange, asset.Futures, cp)
+	require.NoError(t, err, "GetFuturesPositionsForExchange must not error")
+	require.Len(t, positions, 2, "restoreFuturesPositions must restore both positions")
+	assert.Equal(t, order.Closed, positions[0].Status, "Status should be restored")
+	assert.Equal(t, "alt", positions[1].Account, "Account should be restored")
+	require.Len(t, positions[1].FundingRates.FundingRates, 1, "FundingRates must be restored")
+	assert.True(t, positions[1].FundingRates.PaymentSum.Equal(decimal.NewFromFloat(-1.5)), "PaymentSum should be restored")
+	assert.NotEmpty(t, positions[1].PNLHistory, "PNLHistory should be restored")
+	require.Len(t, positions[1].Orders, 2, "reconciliation must track orders reported by the exchange")
+	assert.True(t, positions[1].Orders[0].OrderID == "1337" || positions[1].Orders[1].OrderID == "1337", "reconciliation should track the exchange's order")
+
+	restored.persistFuturesPositions()
+	assert.Equal(t, 2, db.upserts, "persistFuturesPositions should store reconciled positions")
+
+	db.err = errors.New("such database failure")
+	_, err = restored.UpdateOpenPositionUnrealisedPNL("alt", testExchange, asset.Futures, cp, 1331, time.Now())
+	require.NoError(t, err, "UpdateOpenPositionUnrealisedPNL must not error")
+	restored.persistFuturesPositions()
+	assert.Equal(t, 2, db.upserts, "persistFuturesPositions should not record a failed upsert")
+
+	failed := positionsSetup(t, db)
+	failed.restoreFuturesPositions(t.Context())
+	_, err = failed.GetFuturesPositionsForExchange("alt", testExchange, asset.Futures, cp)
+	assert.ErrorIs(t, err, futures.ErrPositionNotFound, "restoreFuturesPositions should not restore positions when loading fails")
+}
+
+func TestPositionFromDB(t *testing.T) {
+	t.Parallel()
+	_, err := positionFromDB(&futuresposition.Position{Asset: "bad"})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	p, err := positionFromDB(&futuresposition.Position{
+		ExchangeName: testExchange,
+		Asset:        "futures",
+		Base:         "BTC",
+		Quote:        "PERP",
+		Status:       "bad",
+		Orders:       []futuresposition.Order{{OrderID: "1", Side: "SHORT", Type: "bad"}},
+	})
+	require.NoError(t, err, "positionFromDB must not error for unrecognised order values")
+	assert.Equal(t, order.UnknownStatus, p.Status, "Status should be unknown")
+	assert.Equal(t, order.Short, p.Orders[0].Side, "Side should be restored")
+	assert.Equal(t, order.UnknownType, p.Orders[0].Type, "Type should be unknown")
+}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
@@ -45,6 +47,7 @@ func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Durati
 	if cfg == nil {
 		cfg = &portfolio.Base{Addresses: []portfolio.Address{}}
 	}
+	cfg.SetWhiteListedTimes(time.Now())
 	m := &portfolioManager{
 		portfolioManagerDelay: portfolioManagerDelay,
 		exchangeManager:       e,
@@ -137,9 +140,39 @@ func (m *portfolioManager) processPortfolio(ctx context.Context) {
 
 		log.Debugf(log.PortfolioMgr, "Portfolio manager: Successfully updated address balance for %s address(es) %s", key, value)
 	}
+
+	if err := m.valueOptionPositions(ctx); err != nil {
+		log.Errorf(log.PortfolioMgr, "Portfolio valueOptionPositions error: %v", err)
+	}
 	m.processing.CompareAndSwap(true, false)
 }
 
+// valueOptionPositions values the option positions held in the portfolio
+// using Black-76 without discounting, which matches how crypto option venues
+// quote their Greeks
+func (m *portfolioManager) valueOptionPositions(ctx context.Context) error {
+	if err := common.NilGuard(m); err != nil {
+		return err
+	}
+	var errs error
+	for _, p := range m.base.GetOptionPositions() {
+		exch, err := m.exchangeManager.GetExchangeByName(p.Exchange)
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		analytics, err := getOptionAnalytics(ctx, exch, p.Asset, p.Pair, 0, &options.Parameters{Model: options.Black76})
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s %s: %w", p.Exchange, p.Asset, p.Pair, err))
+			continue
+		}
+		if err := m.base.UpdateOptionValuation(p.Exchange, p.Asset, p.Pair, analytics); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
+
 // updateExchangeBalances calls UpdateAccountBalance on each exchange, and transfers the account balances into portfolio
 func (m *portfolioManager) updateExchangeBalances(ctx context.Context) error {
 	if err := common.NilGuard(m); err != nil {
@@ -164,9 +197,13 @@ func (m *portfolioManager) updateExchangeBalances(ctx context.Context) error {
 			assetTypes = e.GetAssetTypes(true)
 		}
 
-		for _, a := range assetTypes {
-			if _, err := e.UpdateAccountBalances(ctx, a); err != nil {
-				errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), a, err))
+		// Balances are stored per set of credentials and collated across every account below
+		for _, account := range exchangeAccounts(e) {
+			accountCtx := accounts.DeployAccountToContext(ctx, account)
+			for _, a := range assetTypes {
+				if _, err := e.UpdateAccountBalances(accountCtx, a); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), a, err))
+				}
 			}
 		}
 		if err := m.updateExchangeAddressBalances(e); err != nil {
@@ -277,6 +314,14 @@ func (m *portfolioManager) IsWhiteListed(address string) bool {
 	return m.base.IsWhiteListed(address)
 }
 
+// GetWhiteListedTime returns when a whitelisted address was first loaded
+func (m *portfolioManager) GetWhiteListedTime(address string) (time.Time, bool) {
+	if m == nil || !m.IsRunning() {
+		return time.Time{}, false
+	}
+	return m.base.GetWhiteListedTime(address)
+}
+
 // IsExchangeSupported checks if an exchange is supported
 func (m *portfolioManager) IsExchangeSupported(exch, address string) bool {
 	if m == nil || !m.IsRunning() {
@@ -121,6 +121,11 @@ func TestUpdateExchangeBalances(t *testing.T) {
 
 	e.authSupported = true
 	assert.ErrorIs(t, m.updateExchangeBalances(t.Context()), e.err, "error should contain the UpdateAccountBalances error message")
+
+	e.profiles = []string{"hedging"}
+	e.updated = nil
+	assert.ErrorIs(t, m.updateExchangeBalances(t.Context()), e.err, "error should contain the UpdateAccountBalances error message")
+	assert.Equal(t, []string{"", "", "hedging", "hedging"}, e.updated, "balances should be updated for every asset of every account")
 }
 
 func TestUpdateExchangeAddressBalances(t *testing.T) {
@@ -163,6 +168,8 @@ type mockExchange struct {
 	authSupported bool
 	err           error
 	accounts      *accounts.Accounts
+	profiles      []string
+	updated       []string
 }
 
 func (m *mockExchange) GetName() string {
@@ -185,10 +192,15 @@ func (m *mockExchange) GetAssetTypes(bool) asset.Items {
 	return asset.Items{asset.Spot, asset.Futures}
 }
 
-func (m *mockExchange) UpdateAccountBalances(context.Context, asset.Item) (accounts.SubAccounts, error) {
+func (m *mockExchange) UpdateAccountBalances(ctx context.Context, _ asset.Item) (accounts.SubAccounts, error) {
+	m.updated = append(m.updated, accounts.AccountFromContext(ctx))
 	return nil, m.err
 }
 
+func (m *mockExchange) GetCredentialProfiles() []string {
+	return m.profiles
+}
+
 func (m *mockExchange) GetBase() *exchange.Base {
 	return &exchange.Base{Name: "mocky", Accounts: m.accounts}
 }
@@ -196,3 +208,25 @@ func (m *mockExchange) GetBase() *exchange.Base {
 func (m *mockExchange) GetCredentials(context.Context) (*accounts.Credentials, error) {
 	return &accounts.Credentials{Key: m.GetName()}, nil
 }
+
+func TestValueOptionPositions(t *testing.T) {
+	t.Parallel()
+	assert.ErrorContains(t, (*portfolioManager)(nil).valueOptionPositions(t.Context()), "nil pointer: *engine.portfolioManager")
+
+	em, _ := setupOptionsExchange(t, "optionsPortfolio", 0.5)
+	m, err := setupPortfolioManager(em, 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	assert.NoError(t, m.valueOptionPositions(t.Context()), "valueOptionPositions should not error without positions")
+
+	pair, err := currency.NewPairFromString("BTC-27DEC30-100000-C")
+	require.NoError(t, err, "NewPairFromString must not error")
+	require.NoError(t, m.base.AddOptionPosition("optionsPortfolio", asset.Options, pair, -2), "AddOptionPosition must not error")
+	require.NoError(t, m.base.AddOptionPosition("unknown", asset.Options, pair, 1), "AddOptionPosition must not error")
+	assert.ErrorIs(t, m.valueOptionPositions(t.Context()), ErrExchangeNotFound, "valueOptionPositions should error for an unknown exchange")
+
+	positions := m.base.GetOptionPositions()
+	require.NotNil(t, positions[0].Valuation, "valueOptionPositions must value positions on known exchanges")
+	assert.InDelta(t, 0.5, positions[0].Valuation.ImpliedVolatility, 1e-6, "ImpliedVolatility should be recovered")
+	assert.Negative(t, positions[0].Valuation.Delta, "Delta should be negative for a short call")
+	assert.Nil(t, positions[1].Valuation, "positions on unknown exchanges should not be valued")
+}
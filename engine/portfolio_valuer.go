@@ -0,0 +1,438 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+var (
+	errInvalidValuationInterval    = errors.New("portfolio valuation check interval must be positive")
+	errInvalidReportingCurrency    = errors.New("portfolio valuation reporting currency must be a fiat currency")
+	errNoPortfolioHoldings         = errors.New("portfolio has no holdings")
+	errNoPortfolioValuation        = errors.New("portfolio has not been valued yet")
+	errPortfolioHistoryUnavailable = errors.New("portfolio valuation history requires a database connection")
+	errNoFiatRate                  = errors.New("no fiat conversion rate")
+	errNoPortfolioPrice            = errors.New("no spot market price")
+	errPortfolioHoldingsUnpriced   = errors.New("previously priced portfolio holdings cannot be priced")
+)
+
+// SetupPortfolioValuer applies configuration parameters before running.
+// Valuations are only stored when the database connection manager is
+// connected
+func SetupPortfolioValuer(em iExchangeManager, pm iPortfolioManager, dcm iDatabaseConnectionManager, cfg *config.PortfolioValuation) (*PortfolioValuer, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if pm == nil {
+		return nil, errNilPortfolioManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w PortfolioValuer", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidValuationInterval
+	}
+	if !cfg.ReportingCurrency.IsFiatCurrency() {
+		return nil, fmt.Errorf("%w: %q", errInvalidReportingCurrency, cfg.ReportingCurrency)
+	}
+	m := &PortfolioValuer{
+		shutdown:         make(chan struct{}),
+		exchangeManager:  em,
+		portfolioManager: pm,
+		cfg:              *cfg,
+		fxRate: func(from, to currency.Code) (float64, error) {
+			return currency.GetForeignExchangeRate(currency.NewPair(from, to))
+		},
+	}
+	if dcm != nil {
+		db, err := portfoliosnapshot.Setup(dcm.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db != nil {
+			m.db = db
+		}
+	}
+	return m, nil
+}
+
+// Start runs the subsystem
+func (m *PortfolioValuer) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioValuerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", PortfolioValuerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio valuer %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.PortfolioMgr, "Portfolio valuer %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *PortfolioValuer) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioValuerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", PortfolioValuerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio valuer %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.PortfolioMgr, "Portfolio valuer %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *PortfolioValuer) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetLatest returns the most recent valuation of the portfolio
+func (m *PortfolioValuer) GetLatest() (*portfolio.Valuation, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", PortfolioValuerName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.latest == nil {
+		return nil, errNoPortfolioValuation
+	}
+	v := *m.latest
+	v.Holdings = slices.Clone(v.Holdings)
+	return &v, nil
+}
+
+// GetHistory returns the stored valuations between the dates ordered by time
+func (m *PortfolioValuer) GetHistory(start, end time.Time) ([]portfolio.Valuation, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", PortfolioValuerName, ErrSubSystemNotStarted)
+	}
+	if m.db == nil {
+		return nil, errPortfolioHistoryUnavailable
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	snapshots, err := m.db.GetInRange(m.cfg.ReportingCurrency.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	return portfolioSnapshotsFromDB(snapshots), nil
+}
+
+// GetEquityCurve returns the total value of the portfolio at each stored
+// valuation between the dates
+func (m *PortfolioValuer) GetEquityCurve(start, end time.Time) ([]portfolio.EquityPoint, error) {
+	v, err := m.GetHistory(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return portfolio.EquityCurve(v), nil
+}
+
+// GetDailyPNL returns the change in the portfolio's value over each UTC day
+// between the dates
+func (m *PortfolioValuer) GetDailyPNL(start, end time.Time) ([]portfolio.DailyPNL, error) {
+	v, err := m.GetHistory(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return portfolio.DailyPNLs(v), nil
+}
+
+// GetAttribution returns the change in value of each exchange or currency
+// between the first and last stored valuations between the dates
+func (m *PortfolioValuer) GetAttribution(start, end time.Time, group portfolio.AttributionGroup) ([]portfolio.Attribution, error) {
+	v, err := m.GetHistory(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return portfolio.Attribute(v, group)
+}
+
+func (m *PortfolioValuer) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.valueAndStore(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// valueAndStore values the portfolio, keeping the latest valuation and
+// storing it in the database when connected
+func (m *PortfolioValuer) valueAndStore(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.CheckInterval)
+	defer cancel()
+	v, err := m.value(ctx, time.Now())
+	if err != nil {
+		if !errors.Is(err, errNoPortfolioHoldings) {
+			log.Errorf(log.PortfolioMgr, "Portfolio valuer failed to value portfolio: %v", err)
+		}
+		return
+	}
+	m.mu.Lock()
+	m.latest = v
+	m.mu.Unlock()
+	if m.cfg.Verbose {
+		log.Debugf(log.PortfolioMgr, "Portfolio valuer valued %d holdings at %f %s", len(v.Holdings), v.Total, v.ReportingCurrency)
+	}
+	if m.db != nil {
+		if err := m.db.Insert(portfolioValuationToDB(v)); err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio valuer failed to store valuation: %v", err)
+		}
+	}
+}
+
+// value prices each exchange and personal address holding of the portfolio
+// summary in the reporting currency. Holdings which have never been priced are
+// excluded from the valuation, while a holding priced in the latest valuation
+// which cannot be priced fails the valuation so its value does not drop out
+// of the stored history
+func (m *PortfolioValuer) value(ctx context.Context, now time.Time) (*portfolio.Valuation, error) {
+	summary := m.portfolioManager.GetPortfolioSummary()
+	var holdings []portfolio.ValuedHolding
+	for exch, coins := range summary.OnlineSummary {
+		for c, s := range coins {
+			if s.Balance != 0 {
+				holdings = append(holdings, portfolio.ValuedHolding{Source: exch, Currency: c, Amount: s.Balance})
+			}
+		}
+	}
+	for c, addresses := range summary.OfflineSummary {
+		for i := range addresses {
+			if addresses[i].Balance != 0 {
+				holdings = append(holdings, portfolio.ValuedHolding{Source: portfolio.PersonalAddress, Address: addresses[i].Address, Currency: c, Amount: addresses[i].Balance})
+			}
+		}
+	}
+	if len(holdings) == 0 {
+		return nil, errNoPortfolioHoldings
+	}
+	slices.SortFunc(holdings, func(a, b portfolio.ValuedHolding) int {
+		return strings.Compare(a.Source+a.Address+a.Currency.Upper().String(), b.Source+b.Address+b.Currency.Upper().String())
+	})
+
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	priced := m.pricedCurrencies()
+	v := &portfolio.Valuation{ReportingCurrency: m.cfg.ReportingCurrency, Time: now}
+	prices := make(map[string]float64)
+	var unpriced error
+	for i := range holdings {
+		h := &holdings[i]
+		key := h.Currency.Upper().String()
+		price, ok := prices[key]
+		if !ok {
+			price, err = m.price(ctx, h.Currency, h.Source, exchs)
+			switch {
+			case err != nil && priced[key]:
+				unpriced = common.AppendError(unpriced, fmt.Errorf("%s: %w", h.Currency, err))
+			case err != nil && m.cfg.Verbose:
+				log.Warnf(log.PortfolioMgr, "Portfolio valuer cannot price %s, excluding it from the valuation: %v", h.Currency, err)
+			}
+			prices[key] = price
+		}
+		if price == 0 {
+			continue
+		}
+		h.Price = price
+		h.Value = h.Amount * price
+		v.Total += h.Value
+		v.Holdings = append(v.Holdings, *h)
+	}
+	if unpriced != nil {
+		return nil, fmt.Errorf("%w: %w", errPortfolioHoldingsUnpriced, unpriced)
+	}
+	return v, nil
+}
+
+// pricedCurrencies returns the currencies priced in the latest valuation
+func (m *PortfolioValuer) pricedCurrencies() map[string]bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	priced := make(map[string]bool)
+	if m.latest != nil {
+		for i := range m.latest.Holdings {
+			priced[m.latest.Holdings[i].Currency.Upper().String()] = true
+		}
+	}
+	return priced
+}
+
+// price returns the price of a currency in the reporting currency. Fiat
+// currencies and USD stablecoins are converted with foreign exchange rates,
+// other currencies with the first spot market found against the configured
+// quote currencies, preferring the exchange holding the currency
+func (m *PortfolioValuer) price(ctx context.Context, c currency.Code, source string, exchs []exchange.IBotExchange) (float64, error) {
+	rate, err := m.fiatRate(c, m.cfg.ReportingCurrency)
+	if err == nil {
+		return rate, nil
+	}
+	exchs = slices.Clone(exchs)
+	slices.SortStableFunc(exchs, func(a, b exchange.IBotExchange) int {
+		switch {
+		case strings.EqualFold(a.GetName(), source):
+			return -1
+		case strings.EqualFold(b.GetName(), source):
+			return 1
+		}
+		return 0
+	})
+	var errs error
+	rates := make([]float64, len(m.cfg.QuoteCurrencies))
+	for i, q := range m.cfg.QuoteCurrencies {
+		if rates[i], err = m.fiatRate(q, m.cfg.ReportingCurrency); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	for _, exch := range exchs {
+		avail, err := exch.GetAvailablePairs(asset.Spot)
+		if err != nil {
+			continue
+		}
+		for i, q := range m.cfg.QuoteCurrencies {
+			if rates[i] == 0 {
+				continue
+			}
+			p := currency.NewPair(c, q)
+			idx := slices.IndexFunc(avail, p.Equal)
+			if idx == -1 {
+				continue
+			}
+			price, err := m.tickerPrice(ctx, exch, avail[idx])
+			if err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s: %w", exch.GetName(), p, err))
+				continue
+			}
+			return price * rates[i], nil
+		}
+	}
+	if errs != nil {
+		return 0, errs
+	}
+	return 0, fmt.Errorf("%w for %s", errNoPortfolioPrice, c)
+}
+
+// fiatRate returns the rate converting between fiat currencies, with USD
+// stablecoins at par with USD
+func (m *PortfolioValuer) fiatRate(from, to currency.Code) (float64, error) {
+	return fiatConversionRate(m.fxRate, from, to)
+}
+
+// fiatConversionRate returns the rate converting between fiat currencies using
+// the foreign exchange rate function, with USD stablecoins at par with USD
+func fiatConversionRate(fxRate func(from, to currency.Code) (float64, error), from, to currency.Code) (float64, error) {
+	if usdStablecoins.Contains(from) {
+		from = currency.USD
+	}
+	if usdStablecoins.Contains(to) {
+		to = currency.USD
+	}
+	if from.Equal(to) {
+		return 1, nil
+	}
+	if !from.IsFiatCurrency() || !to.IsFiatCurrency() {
+		return 0, fmt.Errorf("%w from %s to %s", errNoFiatRate, from, to)
+	}
+	rate, err := fxRate(from, to)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("%w from %s to %s", errNoFiatRate, from, to)
+	}
+	return rate, nil
+}
+
+// tickerPrice returns the cached spot price of a pair when updated within the
+// check interval, otherwise it fetches the ticker
+func (m *PortfolioValuer) tickerPrice(ctx context.Context, exch exchange.IBotExchange, p currency.Pair) (float64, error) {
+	if t, err := exch.GetCachedTicker(p, asset.Spot); err == nil && time.Since(t.LastUpdated) < m.cfg.CheckInterval {
+		switch {
+		case t.Last > 0:
+			return t.Last, nil
+		case t.Bid > 0 && t.Ask > 0:
+			return (t.Bid + t.Ask) / 2, nil
+		}
+	}
+	return carryTickerPrice(ctx, exch, p, asset.Spot)
+}
+
+func portfolioValuationToDB(v *portfolio.Valuation) portfoliosnapshot.Snapshot {
+	s := portfoliosnapshot.Snapshot{
+		ReportingCurrency: v.ReportingCurrency.String(),
+		TotalValue:        v.Total,
+		Timestamp:         v.Time,
+		Holdings:          make([]portfoliosnapshot.Holding, len(v.Holdings)),
+	}
+	for i := range v.Holdings {
+		h := &v.Holdings[i]
+		s.Holdings[i] = portfoliosnapshot.Holding{
+			Source:   h.Source,
+			Address:  h.Address,
+			Currency: h.Currency.String(),
+			Amount:   h.Amount,
+			Price:    h.Price,
+			Value:    h.Value,
+		}
+	}
+	return s
+}
+
+func portfolioSnapshotsFromDB(snapshots []portfoliosnapshot.Snapshot) []portfolio.Valuation {
+	resp := make([]portfolio.Valuation, len(snapshots))
+	for i := range snapshots {
+		s := &snapshots[i]
+		resp[i] = portfolio.Valuation{
+			ReportingCurrency: currency.NewCode(s.ReportingCurrency),
+			Total:             s.TotalValue,
+			Time:              s.Timestamp,
+			Holdings:          make([]portfolio.ValuedHolding, len(s.Holdings)),
+		}
+		for j := range s.Holdings {
+			h := &s.Holdings[j]
+			resp[i].Holdings[j] = portfolio.ValuedHolding{
+				Source:   h.Source,
+				Address:  h.Address,
+				Currency: currency.NewCode(h.Currency),
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp
+}
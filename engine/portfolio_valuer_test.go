@@ -0,0 +1,255 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+var testValuationConfig = config.PortfolioValuation{
+	CheckInterval:     time.Hour,
+	ReportingCurrency: currency.EUR,
+	QuoteCurrencies:   currency.Currencies{currency.USD, currency.USDT},
+}
+
+type valuationExchange struct {
+	exchange.IBotExchange
+	name   string
+	prices map[string]float64
+}
+
+func (v *valuationExchange) GetName() string { return v.name }
+
+func (v *valuationExchange) GetAvailablePairs(asset.Item) (currency.Pairs, error) {
+	pairs := make(currency.Pairs, 0, len(v.prices))
+	for p := range v.prices {
+		pair, err := currency.NewPairFromString(p)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+func (v *valuationExchange) GetCachedTicker(currency.Pair, asset.Item) (*ticker.Price, error) {
+	return nil, ticker.ErrTickerNotFound
+}
+
+func (v *valuationExchange) UpdateTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	price, ok := v.prices[p.String()]
+	if !ok {
+		return nil, ticker.ErrTickerNotFound
+	}
+	return &ticker.Price{Last: price, Pair: p, AssetType: a}, nil
+}
+
+type valuationPortfolio struct {
+	rebalancePortfolio
+	summary portfolio.Summary
+}
+
+func (v *valuationPortfolio) GetPortfolioSummary() portfolio.Summary { return v.summary }
+
+type valuationDB struct {
+	snapshots []portfoliosnapshot.Snapshot
+}
+
+func (v *valuationDB) Insert(snapshots ...portfoliosnapshot.Snapshot) error {
+	v.snapshots = append(v.snapshots, snapshots...)
+	return nil
+}
+
+func (v *valuationDB) GetInRange(reportingCurrency string, start, end time.Time) ([]portfoliosnapshot.Snapshot, error) {
+	var resp []portfoliosnapshot.Snapshot
+	for i := range v.snapshots {
+		if v.snapshots[i].ReportingCurrency == reportingCurrency && !v.snapshots[i].Timestamp.Before(start) && !v.snapshots[i].Timestamp.After(end) {
+			resp = append(resp, v.snapshots[i])
+		}
+	}
+	return resp, nil
+}
+
+func newTestPortfolioValuer(t *testing.T) *PortfolioValuer {
+	t.Helper()
+	em := rebalanceExchangeManager{
+		"Binance": &valuationExchange{name: "Binance", prices: map[string]float64{"BTC-USDT": 50000, "ETH-USDT": 2000}},
+		"Kraken":  &valuationExchange{name: "Kraken", prices: map[string]float64{"BTC-USD": 49000}},
+	}
+	pm := &valuationPortfolio{summary: portfolio.Summary{
+		OnlineSummary: map[string]map[currency.Code]portfolio.OnlineCoinSummary{
+			"Binance": {currency.BTC: {Balance: 1}, currency.USDT: {Balance: 1000}, currency.XRP: {Balance: 10}},
+			"Kraken":  {currency.BTC: {Balance: 0.5}, currency.EUR: {Balance: 100}, currency.ETH: {}},
+		},
+		OfflineSummary: map[currency.Code][]portfolio.OfflineCoinSummary{
+			currency.ETH: {{Address: "0xdead", Balance: 2}},
+		},
+	}}
+	m, err := SetupPortfolioValuer(em, pm, nil, &testValuationConfig)
+	require.NoError(t, err, "SetupPortfolioValuer must not error")
+	m.fxRate = func(from, to currency.Code) (float64, error) {
+		if from.Equal(currency.USD) && to.Equal(currency.EUR) {
+			return 0.5, nil
+		}
+		return 0, errors.New("no rate")
+	}
+	return m
+}
+
+func TestSetupPortfolioValuer(t *testing.T) {
+	t.Parallel()
+	_, err := SetupPortfolioValuer(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupPortfolioValuer(&ExchangeManager{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilPortfolioManager)
+	_, err = SetupPortfolioValuer(&ExchangeManager{}, &rebalancePortfolio{}, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupPortfolioValuer(&ExchangeManager{}, &rebalancePortfolio{}, nil, &config.PortfolioValuation{})
+	assert.ErrorIs(t, err, errInvalidValuationInterval)
+	_, err = SetupPortfolioValuer(&ExchangeManager{}, &rebalancePortfolio{}, nil, &config.PortfolioValuation{CheckInterval: time.Hour, ReportingCurrency: currency.USDT})
+	assert.ErrorIs(t, err, errInvalidReportingCurrency)
+	m, err := SetupPortfolioValuer(&ExchangeManager{}, &rebalancePortfolio{}, (*DatabaseConnectionManager)(nil), &testValuationConfig)
+	require.NoError(t, err, "SetupPortfolioValuer must not error")
+	assert.Nil(t, m.db, "SetupPortfolioValuer should not store valuations without a database connection")
+}
+
+func TestPortfolioValuerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *PortfolioValuer
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupPortfolioValuer(&ExchangeManager{}, &rebalancePortfolio{}, nil, &testValuationConfig)
+	require.NoError(t, err, "SetupPortfolioValuer must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning())
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestPortfolioValuerValue(t *testing.T) {
+	t.Parallel()
+	m := newTestPortfolioValuer(t)
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	v, err := m.value(t.Context(), now)
+	require.NoError(t, err, "value must not error")
+	assert.Equal(t, currency.EUR, v.ReportingCurrency)
+	assert.Equal(t, now, v.Time)
+	require.Len(t, v.Holdings, 5, "zero balances and unpriced XRP must be excluded")
+
+	byKey := make(map[string]portfolio.ValuedHolding)
+	for _, h := range v.Holdings {
+		byKey[h.Source+h.Currency.String()] = h
+	}
+	assert.Equal(t, 25000.0, byKey["BinanceBTC"].Value, "BTC should be priced on the holding exchange against USDT at par")
+	assert.Equal(t, 25000.0, byKey["KrakenBTC"].Price, "the BTC price should be reused across exchanges")
+	assert.Equal(t, 500.0, byKey["BinanceUSDT"].Value, "USDT should be valued at par with USD")
+	assert.Equal(t, 100.0, byKey["KrakenEUR"].Value, "the reporting currency should be valued at par")
+	assert.Equal(t, "0xdead", byKey[portfolio.PersonalAddress+"ETH"].Address)
+	assert.Equal(t, 2000.0, byKey[portfolio.PersonalAddress+"ETH"].Value, "personal address holdings should be priced on any exchange")
+	assert.Equal(t, 40100.0, v.Total)
+
+	m.latest = v
+	delete(m.exchangeManager.(rebalanceExchangeManager)["Binance"].(*valuationExchange).prices, "ETH-USDT")
+	_, err = m.value(t.Context(), now)
+	assert.ErrorIs(t, err, errPortfolioHoldingsUnpriced, "value should error when a previously priced holding cannot be priced")
+
+	m.portfolioManager = &rebalancePortfolio{}
+	_, err = m.value(t.Context(), now)
+	assert.ErrorIs(t, err, errNoPortfolioHoldings)
+}
+
+func TestPortfolioValuerFiatRate(t *testing.T) {
+	t.Parallel()
+	m := newTestPortfolioValuer(t)
+	r, err := m.fiatRate(currency.USDC, currency.USDT)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, r)
+	r, err = m.fiatRate(currency.USDT, currency.EUR)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, r)
+	_, err = m.fiatRate(currency.BTC, currency.EUR)
+	assert.ErrorIs(t, err, errNoFiatRate)
+	m.fxRate = func(currency.Code, currency.Code) (float64, error) { return 0, nil }
+	_, err = m.fiatRate(currency.GBP, currency.EUR)
+	assert.ErrorIs(t, err, errNoFiatRate)
+}
+
+func TestPortfolioValuerHistory(t *testing.T) {
+	t.Parallel()
+	m := newTestPortfolioValuer(t)
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	_, err := m.GetLatest()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetHistory(start, start.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	_, err = m.GetLatest()
+	assert.ErrorIs(t, err, errNoPortfolioValuation)
+	_, err = m.GetHistory(start, start.Add(time.Hour))
+	assert.ErrorIs(t, err, errPortfolioHistoryUnavailable)
+
+	db := &valuationDB{}
+	m.db = db
+	_, err = m.GetHistory(start.Add(time.Hour), start)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	for i, total := range []float64{1000, 1200, 1100} {
+		require.NoError(t, db.Insert(portfoliosnapshot.Snapshot{
+			ReportingCurrency: "EUR",
+			TotalValue:        total,
+			Timestamp:         start.Add(time.Duration(i) * 12 * time.Hour),
+			Holdings:          []portfoliosnapshot.Holding{{Source: "Binance", Currency: "BTC", Value: total}},
+		}))
+	}
+	require.NoError(t, db.Insert(portfoliosnapshot.Snapshot{ReportingCurrency: "USD", TotalValue: 1, Timestamp: start}))
+
+	end := start.Add(48 * time.Hour)
+	curve, err := m.GetEquityCurve(start, end)
+	require.NoError(t, err, "GetEquityCurve must not error")
+	require.Len(t, curve, 3, "only valuations in the reporting currency must be returned")
+	assert.Equal(t, 1200.0, curve[1].Value)
+
+	pnl, err := m.GetDailyPNL(start, end)
+	require.NoError(t, err, "GetDailyPNL must not error")
+	require.Len(t, pnl, 2)
+	assert.Equal(t, 200.0, pnl[0].PNL)
+	assert.Equal(t, -100.0, pnl[1].PNL)
+
+	attr, err := m.GetAttribution(start, end, portfolio.AttributeByExchange)
+	require.NoError(t, err, "GetAttribution must not error")
+	require.Len(t, attr, 1)
+	assert.Equal(t, portfolio.Attribution{Key: "Binance", StartValue: 1000, EndValue: 1100, Change: 100, Contribution: 1}, attr[0])
+	_, err = m.GetAttribution(start, end, "chain")
+	assert.ErrorIs(t, err, portfolio.ErrInvalidAttributionGroup)
+
+	m.valueAndStore(t.Context())
+	latest, err := m.GetLatest()
+	require.NoError(t, err, "GetLatest must not error")
+	assert.Equal(t, 40100.0, latest.Total)
+	require.Len(t, db.snapshots, 5, "valueAndStore must store the valuation")
+	assert.Len(t, db.snapshots[4].Holdings, 5)
+
+	delete(m.exchangeManager.(rebalanceExchangeManager)["Binance"].(*valuationExchange).prices, "ETH-USDT")
+	m.valueAndStore(t.Context())
+	assert.Len(t, db.snapshots, 5, "valueAndStore should not store a valuation missing previously priced holdings")
+	latest, err = m.GetLatest()
+	require.NoError(t, err, "GetLatest must not error")
+	assert.Equal(t, 40100.0, latest.Total, "the latest valuation should be kept")
+}
@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// PortfolioValuerName is an exported subsystem name
+const PortfolioValuerName = "portfolio_valuer"
+
+// usdStablecoins are valued at par with USD, as currency.ConversionRates does
+// for USDT
+var usdStablecoins = currency.Currencies{currency.USDT, currency.USDC, currency.BUSD, currency.DAI, currency.TUSD, currency.USDP}
+
+// PortfolioValuer periodically values the portfolio's exchange and personal
+// address holdings in a reporting currency, storing the valuations in the
+// database when connected for equity curve, daily PNL and attribution queries
+type PortfolioValuer struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager  iExchangeManager
+	portfolioManager iPortfolioManager
+	db               portfoliosnapshot.IDBService
+	cfg              config.PortfolioValuation
+	// fxRate returns the rate converting between fiat currencies
+	fxRate func(from, to currency.Code) (float64, error)
+
+	mu     sync.RWMutex
+	latest *portfolio.Valuation
+}
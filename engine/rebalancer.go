@@ -0,0 +1,624 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errInvalidRebalanceCheckInterval = errors.New("rebalancer check interval must be positive")
+	errNoRebalanceTargets            = errors.New("rebalancer has no targets configured")
+	errRebalanceTransferNotFound     = errors.New("rebalance transfer not found")
+	errRebalanceTransferNotPending   = errors.New("rebalance transfer is not pending approval")
+	errNoRebalanceChain              = errors.New("no transfer chain supported by both exchanges")
+)
+
+// SetupRebalancer applies configuration parameters before running. Crypto
+// withdrawals are submitted through the withdraw manager, destination addresses
+// must be whitelisted in the portfolio
+func SetupRebalancer(em iExchangeManager, pm iPortfolioManager, wm iWithdrawManager, comms iCommsManager, cfg *config.RebalancerConfig) (*Rebalancer, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if pm == nil {
+		return nil, errNilPortfolioManager
+	}
+	if wm == nil {
+		return nil, errNilWithdrawManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w Rebalancer", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidRebalanceCheckInterval
+	}
+	if len(cfg.Targets) == 0 {
+		return nil, errNoRebalanceTargets
+	}
+	return &Rebalancer{
+		shutdown:         make(chan struct{}),
+		exchangeManager:  em,
+		portfolioManager: pm,
+		withdrawManager:  wm,
+		comms:            comms,
+		cfg:              *cfg,
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *Rebalancer) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", RebalancerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", RebalancerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Rebalancer %s", MsgSubSystemStarting)
+	if m.cfg.DryRun {
+		log.Warnln(log.ExchangeSys, "Rebalancer dry run enabled, transfers will be planned without submitting withdrawals")
+	}
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Rebalancer %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *Rebalancer) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", RebalancerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", RebalancerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Rebalancer %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Rebalancer %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *Rebalancer) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetTransfers returns the transfers planned within the retention period,
+// optionally filtered by status, newest first
+func (m *Rebalancer) GetTransfers(status string) ([]RebalanceTransfer, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", RebalancerName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	resp := make([]RebalanceTransfer, 0, len(m.transfers))
+	for i := len(m.transfers) - 1; i >= 0; i-- {
+		if status != "" && !strings.EqualFold(m.transfers[i].Status, status) {
+			continue
+		}
+		resp = append(resp, *m.transfers[i])
+	}
+	return resp, nil
+}
+
+// ApproveTransfer submits a transfer pending approval
+func (m *Rebalancer) ApproveTransfer(ctx context.Context, id uuid.UUID) (RebalanceTransfer, error) {
+	if !m.IsRunning() {
+		return RebalanceTransfer{}, fmt.Errorf("%s %w", RebalancerName, ErrSubSystemNotStarted)
+	}
+	t, err := m.takePending(id, RebalanceSubmitted, time.Now())
+	if err != nil {
+		return RebalanceTransfer{}, err
+	}
+	m.execute(ctx, t)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return *t, nil
+}
+
+// RejectTransfer rejects a transfer pending approval and notifies the
+// rejection
+func (m *Rebalancer) RejectTransfer(id uuid.UUID) (RebalanceTransfer, error) {
+	if !m.IsRunning() {
+		return RebalanceTransfer{}, fmt.Errorf("%s %w", RebalancerName, ErrSubSystemNotStarted)
+	}
+	t, err := m.takePending(id, RebalanceRejected, time.Now())
+	if err != nil {
+		return RebalanceTransfer{}, err
+	}
+	m.notify(t)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return *t, nil
+}
+
+// takePending moves a transfer pending approval to a new status so it cannot
+// be approved or rejected twice
+func (m *Rebalancer) takePending(id uuid.UUID, status string, now time.Time) (*RebalanceTransfer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	idx := slices.IndexFunc(m.transfers, func(t *RebalanceTransfer) bool { return t.ID == id })
+	if idx == -1 {
+		return nil, fmt.Errorf("%w: %s", errRebalanceTransferNotFound, id)
+	}
+	t := m.transfers[idx]
+	if t.Status != RebalancePendingApproval {
+		return nil, fmt.Errorf("%w: %s is %s", errRebalanceTransferNotPending, id, t.Status)
+	}
+	t.Status = status
+	t.Updated = now
+	return t, nil
+}
+
+func (m *Rebalancer) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll expires stale approvals, then plans and routes the transfers of
+// each target which is not waiting on a previous transfer
+func (m *Rebalancer) checkAll(ctx context.Context) {
+	now := time.Now()
+	m.prune(now)
+	for i := range m.cfg.Targets {
+		target := &m.cfg.Targets[i]
+		if m.inFlight(target.Currency, now) {
+			m.logVerbose("Rebalancer %s is waiting on a previous transfer", target.Currency)
+			continue
+		}
+		holdings, total, err := m.holdings(ctx, target)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "Rebalancer failed to get %s holdings: %v", target.Currency, err)
+			continue
+		}
+		remaining := math.Inf(1)
+		if target.DailyLimit > 0 {
+			remaining = target.DailyLimit - m.transferredSince(target.Currency, now.Add(-24*time.Hour))
+		}
+		for _, t := range planRebalance(holdings, total, target, remaining) {
+			t.ID, err = uuid.NewV4()
+			if err != nil {
+				log.Errorf(log.ExchangeSys, "Rebalancer failed to create transfer ID: %v", err)
+				return
+			}
+			t.Currency = target.Currency
+			t.Created = now
+			t.Updated = now
+			if i := slices.IndexFunc(holdings, func(h rebalanceHolding) bool { return h.exchange == t.To }); i != -1 {
+				t.destinationTotal = holdings[i].total - holdings[i].inTransit
+			}
+			if err := m.route(ctx, target, t); err != nil {
+				t.Status = RebalanceFailed
+				t.Error = err.Error()
+			} else if m.cfg.RequireApproval {
+				t.Status = RebalancePendingApproval
+			} else {
+				t.Status = RebalanceSubmitted
+			}
+			m.mu.Lock()
+			m.transfers = append(m.transfers, t)
+			m.mu.Unlock()
+			switch t.Status {
+			case RebalanceSubmitted:
+				m.execute(ctx, t)
+			case RebalanceFailed:
+				log.Errorf(log.ExchangeSys, "Rebalancer failed to route %v %s from %s to %s: %s", t.Amount, t.Currency, t.From, t.To, t.Error)
+				m.notify(t)
+			default:
+				m.notify(t)
+			}
+		}
+	}
+}
+
+// holdings returns the total and free balances of a target currency on each
+// allocated exchange, with the deviation from its target allocation. Submitted
+// transfers which have not arrived are added to their destination total
+func (m *Rebalancer) holdings(ctx context.Context, target *config.RebalanceTarget) ([]rebalanceHolding, float64, error) {
+	var total, weights float64
+	holdings := make([]rebalanceHolding, len(target.Allocations))
+	for i := range target.Allocations {
+		exch, err := m.exchangeManager.GetExchangeByName(target.Allocations[i].Exchange)
+		if err != nil {
+			return nil, 0, err
+		}
+		balances, err := exch.GetCachedCurrencyBalances(ctx, asset.Spot)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", exch.GetName(), err)
+		}
+		holdings[i].exchange = exch.GetName()
+		for code, b := range balances {
+			if code.Equal(target.Currency) {
+				holdings[i].total = b.Total
+				holdings[i].free = b.Free
+				break
+			}
+		}
+		weights += target.Allocations[i].Weight
+	}
+	m.addInTransit(target.Currency, holdings, time.Now())
+	for i := range holdings {
+		total += holdings[i].total
+	}
+	for i := range holdings {
+		holdings[i].deviation = holdings[i].total - total*target.Allocations[i].Weight/weights
+	}
+	return holdings, total, nil
+}
+
+// addInTransit adds the submitted transfers of a currency to their destination
+// holding until the destination balance reflects them or the withdrawal
+// reconciler reports the withdrawal as final
+func (m *Rebalancer) addInTransit(c currency.Code, holdings []rebalanceHolding, now time.Time) {
+	m.mu.RLock()
+	states := make(map[*RebalanceTransfer]string)
+	for _, t := range m.transfers {
+		if t.Status == RebalanceSubmitted && t.Currency.Equal(c) {
+			states[t] = t.WithdrawalID
+		}
+	}
+	m.mu.RUnlock()
+	for t, id := range states {
+		states[t] = m.withdrawalState(id)
+	}
+	var finished []*RebalanceTransfer
+	m.mu.Lock()
+	for _, t := range m.transfers {
+		state, ok := states[t]
+		if !ok || t.Status != RebalanceSubmitted {
+			continue
+		}
+		i := slices.IndexFunc(holdings, func(h rebalanceHolding) bool { return strings.EqualFold(h.exchange, t.To) })
+		if i == -1 {
+			continue
+		}
+		switch {
+		case state == withdrawalFailed:
+			t.Status = RebalanceFailed
+			t.Error = "withdrawal failed"
+		case state == withdrawalCompleted,
+			holdings[i].total-holdings[i].inTransit-t.destinationTotal >= t.Amount*rebalanceArrivalFraction:
+			t.Status = RebalanceCompleted
+		default:
+			holdings[i].total += t.Amount
+			holdings[i].inTransit += t.Amount
+			continue
+		}
+		t.Updated = now
+		finished = append(finished, t)
+	}
+	m.mu.Unlock()
+	for _, t := range finished {
+		m.notify(t)
+	}
+}
+
+// withdrawalState returns the state of a withdrawal as stored by the
+// withdrawal reconciler, pending when it is not stored
+func (m *Rebalancer) withdrawalState(id string) string {
+	if id == "" {
+		return withdrawalPending
+	}
+	resp, err := m.withdrawManager.WithdrawalEventByID(id)
+	if err != nil || resp == nil {
+		return withdrawalPending
+	}
+	return storedWithdrawalState(resp.Exchange.Status)
+}
+
+// planRebalance matches the exchanges holding a surplus with those in deficit,
+// largest first, when any exchange deviates from its allocation beyond the
+// tolerance. Each pair of exchanges receives at most one transfer, limited to
+// the free balance of the source and the remaining daily limit
+func planRebalance(holdings []rebalanceHolding, total float64, target *config.RebalanceTarget, remaining float64) []*RebalanceTransfer {
+	if total <= 0 || !slices.ContainsFunc(holdings, func(h rebalanceHolding) bool {
+		return math.Abs(h.deviation) > total*target.Tolerance
+	}) {
+		return nil
+	}
+	type side struct {
+		exchange string
+		amount   float64
+	}
+	var surplus, deficit []side
+	for i := range holdings {
+		switch h := &holdings[i]; {
+		case h.deviation > 0:
+			if available := min(h.deviation, h.free); available > 0 {
+				surplus = append(surplus, side{h.exchange, available})
+			}
+		case h.deviation < 0:
+			deficit = append(deficit, side{h.exchange, -h.deviation})
+		}
+	}
+	byAmount := func(a, b side) int { return cmp.Compare(b.amount, a.amount) }
+	slices.SortFunc(surplus, byAmount)
+	slices.SortFunc(deficit, byAmount)
+
+	var resp []*RebalanceTransfer
+	for s, d := 0, 0; s < len(surplus) && d < len(deficit) && remaining > 0; {
+		amount := min(surplus[s].amount, deficit[d].amount, remaining)
+		if target.MaxTransfer > 0 {
+			amount = min(amount, target.MaxTransfer)
+		}
+		if amount >= target.MinTransfer {
+			resp = append(resp, &RebalanceTransfer{From: surplus[s].exchange, To: deficit[d].exchange, Amount: amount})
+			surplus[s].amount -= amount
+			deficit[d].amount -= amount
+			remaining -= amount
+		} else if amount == remaining {
+			break
+		}
+		if surplus[s].amount <= deficit[d].amount {
+			s++
+		} else {
+			d++
+		}
+	}
+	return resp
+}
+
+// route selects the chain and destination deposit address of a transfer,
+// checking both exchanges allow the transfer and the address is whitelisted
+func (m *Rebalancer) route(ctx context.Context, target *config.RebalanceTarget, t *RebalanceTransfer) error {
+	from, err := m.exchangeManager.GetExchangeByName(t.From)
+	if err != nil {
+		return err
+	}
+	to, err := m.exchangeManager.GetExchangeByName(t.To)
+	if err != nil {
+		return err
+	}
+	if err := from.CanWithdraw(t.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return fmt.Errorf("%s: %w", t.From, err)
+	}
+	if err := to.CanDeposit(t.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return fmt.Errorf("%s: %w", t.To, err)
+	}
+	chains, err := rebalanceChains(ctx, from, to, t.Currency, target.Chains)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, chain := range chains {
+		addr, err := to.GetDepositAddress(ctx, t.Currency, "", chain)
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s deposit address: %w", t.To, chain, err))
+			continue
+		}
+		if !m.portfolioManager.IsWhiteListed(addr.Address) {
+			errs = common.AppendError(errs, fmt.Errorf("%s %w", addr.Address, withdraw.ErrStrAddressNotWhiteListed))
+			continue
+		}
+		if !m.portfolioManager.IsExchangeSupported(t.From, addr.Address) {
+			errs = common.AppendError(errs, fmt.Errorf("%s %w %s", addr.Address, withdraw.ErrStrExchangeNotSupportedByAddress, t.From))
+			continue
+		}
+		t.Chain = chain
+		if addr.Chain != "" {
+			t.Chain = addr.Chain
+		}
+		t.Address = addr.Address
+		t.AddressTag = addr.Tag
+		return nil
+	}
+	return errs
+}
+
+// rebalanceChains returns the chains a currency can be transferred on between
+// two exchanges in order of preference. An exchange which does not list its
+// chains is assumed to support any chain, and an empty chain is returned when
+// neither does
+func rebalanceChains(ctx context.Context, from, to exchange.IBotExchange, c currency.Code, preferred []string) ([]string, error) {
+	fromChains, err := transferChains(ctx, from, c)
+	if err != nil {
+		return nil, err
+	}
+	toChains, err := transferChains(ctx, to, c)
+	if err != nil {
+		return nil, err
+	}
+	supported := func(chains []string, chain string) bool {
+		return len(chains) == 0 || common.StringSliceContainsInsensitive(chains, chain)
+	}
+	candidates := preferred
+	if len(candidates) == 0 {
+		candidates = fromChains
+		if len(candidates) == 0 {
+			candidates = toChains
+		}
+		if len(candidates) == 0 {
+			return []string{""}, nil
+		}
+	}
+	var resp []string
+	for _, chain := range candidates {
+		if supported(fromChains, chain) && supported(toChains, chain) {
+			resp = append(resp, chain)
+		}
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w %s and %s for %s", errNoRebalanceChain, from.GetName(), to.GetName(), c)
+	}
+	return resp, nil
+}
+
+func transferChains(ctx context.Context, exch exchange.IBotExchange, c currency.Code) ([]string, error) {
+	chains, err := exch.GetAvailableTransferChains(ctx, c)
+	if err != nil {
+		if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s transfer chains: %w", exch.GetName(), err)
+	}
+	return chains, nil
+}
+
+// execute submits the withdrawal of a routed transfer, or records it as a dry
+// run
+func (m *Rebalancer) execute(ctx context.Context, t *RebalanceTransfer) {
+	m.mu.RLock()
+	req := &withdraw.Request{
+		Exchange:    t.From,
+		Currency:    t.Currency,
+		Description: "rebalance to " + t.To,
+		Amount:      t.Amount,
+		Type:        withdraw.Crypto,
+		Crypto: withdraw.CryptoRequest{
+			Address:    t.Address,
+			AddressTag: t.AddressTag,
+			Chain:      t.Chain,
+		},
+	}
+	m.mu.RUnlock()
+	var (
+		resp *withdraw.Response
+		err  error
+	)
+	if !m.cfg.DryRun {
+		resp, err = m.withdrawManager.SubmitWithdrawal(ctx, req)
+	}
+	m.mu.Lock()
+	t.Updated = time.Now()
+	switch {
+	case err != nil:
+		t.Status = RebalanceFailed
+		t.Error = err.Error()
+	case m.cfg.DryRun:
+		t.Status = RebalanceDryRun
+	default:
+		t.Status = RebalanceSubmitted
+		if resp != nil {
+			t.WithdrawalID = resp.ID.String()
+		}
+	}
+	m.mu.Unlock()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Rebalancer failed to withdraw %v %s from %s to %s: %v", t.Amount, t.Currency, t.From, t.To, err)
+	}
+	m.notify(t)
+}
+
+// inFlight returns whether a currency has a transfer pending approval or one
+// made within the cooldown
+func (m *Rebalancer) inFlight(c currency.Code, now time.Time) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.ContainsFunc(m.transfers, func(t *RebalanceTransfer) bool {
+		if !t.Currency.Equal(c) {
+			return false
+		}
+		switch t.Status {
+		case RebalancePendingApproval:
+			return true
+		case RebalanceSubmitted, RebalanceCompleted, RebalanceDryRun, RebalanceFailed:
+			return now.Sub(t.Updated) < m.cfg.Cooldown
+		}
+		return false
+	})
+}
+
+// transferredSince returns the amount of a currency pending approval or
+// transferred since a time
+func (m *Rebalancer) transferredSince(c currency.Code, since time.Time) float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var amount float64
+	for _, t := range m.transfers {
+		if !t.Currency.Equal(c) || t.Created.Before(since) {
+			continue
+		}
+		switch t.Status {
+		case RebalancePendingApproval, RebalanceSubmitted, RebalanceCompleted, RebalanceDryRun:
+			amount += t.Amount
+		}
+	}
+	return amount
+}
+
+// prune expires transfers not approved within the approval timeout and removes
+// transfers older than the retention period
+func (m *Rebalancer) prune(now time.Time) {
+	m.mu.Lock()
+	var expired []RebalanceTransfer
+	m.transfers = slices.DeleteFunc(m.transfers, func(t *RebalanceTransfer) bool {
+		if t.Status == RebalancePendingApproval && now.Sub(t.Created) >= m.cfg.ApprovalTimeout {
+			t.Status = RebalanceExpired
+			t.Updated = now
+			expired = append(expired, *t)
+		}
+		return t.Status != RebalancePendingApproval && now.Sub(t.Updated) > rebalanceRetention
+	})
+	m.mu.Unlock()
+	for i := range expired {
+		m.notify(&expired[i])
+	}
+}
+
+func (m *Rebalancer) notify(t *RebalanceTransfer) {
+	m.mu.RLock()
+	p := &base.RebalanceTransfer{
+		ID:       t.ID.String(),
+		Currency: t.Currency.String(),
+		From:     t.From,
+		To:       t.To,
+		Chain:    t.Chain,
+		Amount:   t.Amount,
+		Status:   t.Status,
+		Error:    t.Error,
+	}
+	m.mu.RUnlock()
+	severity := base.SeverityInfo
+	switch p.Status {
+	case RebalancePendingApproval, RebalanceExpired:
+		severity = base.SeverityWarning
+	case RebalanceFailed:
+		severity = base.SeverityCritical
+	}
+	evt := base.NewEvent(p, severity)
+	evt.Key = evt.Type + "|" + p.ID + "|" + p.Status
+	m.comms.PushEvent(evt)
+}
+
+func (m *Rebalancer) logVerbose(format string, args ...any) {
+	if m.cfg.Verbose {
+		log.Debugf(log.ExchangeSys, format, args...)
+	}
+}
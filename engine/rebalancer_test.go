@@ -0,0 +1,390 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var testRebalancerConfig = config.RebalancerConfig{
+	CheckInterval:   time.Minute,
+	ApprovalTimeout: time.Hour,
+	Cooldown:        time.Hour,
+	Targets: []config.RebalanceTarget{{
+		Currency:    currency.BTC,
+		Tolerance:   0.05,
+		MinTransfer: 0.01,
+		Allocations: []config.RebalanceAllocation{{Exchange: "alpha", Weight: 1}, {Exchange: "beta", Weight: 1}},
+	}},
+}
+
+type rebalanceExchange struct {
+	exchange.IBotExchange
+	name        string
+	total, free float64
+	chains      []string
+	depositErr  error
+}
+
+func (r *rebalanceExchange) GetName() string { return r.name }
+
+func (r *rebalanceExchange) GetCachedCurrencyBalances(context.Context, asset.Item) (accounts.CurrencyBalances, error) {
+	return accounts.CurrencyBalances{currency.BTC: {Currency: currency.BTC, Total: r.total, Free: r.free}}, nil
+}
+
+func (r *rebalanceExchange) GetAvailableTransferChains(context.Context, currency.Code) ([]string, error) {
+	if r.chains == nil {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return r.chains, nil
+}
+
+func (r *rebalanceExchange) CanWithdraw(currency.Code, asset.Item) error { return nil }
+
+func (r *rebalanceExchange) CanDeposit(currency.Code, asset.Item) error { return r.depositErr }
+
+func (r *rebalanceExchange) GetDepositAddress(_ context.Context, _ currency.Code, _, chain string) (*deposit.Address, error) {
+	return &deposit.Address{Address: r.name + "-" + chain}, nil
+}
+
+type rebalanceExchangeManager map[string]exchange.IBotExchange
+
+func (r rebalanceExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	resp := make([]exchange.IBotExchange, 0, len(r))
+	for _, e := range r {
+		resp = append(resp, e)
+	}
+	return resp, nil
+}
+
+func (r rebalanceExchangeManager) GetExchangeByName(name string) (exchange.IBotExchange, error) {
+	if e, ok := r[name]; ok {
+		return e, nil
+	}
+	return nil, ErrExchangeNotFound
+}
+
+type rebalancePortfolio struct {
+	whitelisted map[string]bool
+}
+
+func (r *rebalancePortfolio) GetPortfolioSummary() portfolio.Summary { return portfolio.Summary{} }
+
+func (r *rebalancePortfolio) IsWhiteListed(address string) bool { return r.whitelisted[address] }
+
+func (r *rebalancePortfolio) IsExchangeSupported(string, string) bool { return true }
+
+type rebalanceWithdrawer struct {
+	requests []*withdraw.Request
+	err      error
+	// statuses are the stored statuses of withdrawals by ID
+	statuses map[string]string
+}
+
+func (r *rebalanceWithdrawer) WithdrawalEventByID(id string) (*withdraw.Response, error) {
+	status, ok := r.statuses[id]
+	if !ok {
+		return nil, ErrWithdrawRequestNotFound
+	}
+	return &withdraw.Response{Exchange: withdraw.ExchangeResponse{Status: status}}, nil
+}
+
+func (r *rebalanceWithdrawer) SubmitWithdrawal(_ context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.requests = append(r.requests, req)
+	return &withdraw.Response{ID: uuid.Must(uuid.NewV4()), RequestDetails: *req}, nil
+}
+
+func newTestRebalancer(t *testing.T, cfg *config.RebalancerConfig) (*Rebalancer, *rebalanceWithdrawer, *testCommsManager) {
+	t.Helper()
+	em := rebalanceExchangeManager{
+		"alpha": &rebalanceExchange{name: "alpha", total: 3, free: 2.5, chains: []string{"BTC", "LIGHTNING"}},
+		"beta":  &rebalanceExchange{name: "beta", total: 1, free: 1, chains: []string{"BTC"}},
+	}
+	wm := &rebalanceWithdrawer{}
+	comms := &testCommsManager{}
+	m, err := SetupRebalancer(em, &rebalancePortfolio{whitelisted: map[string]bool{"beta-BTC": true}}, wm, comms, cfg)
+	require.NoError(t, err, "SetupRebalancer must not error")
+	m.started.Store(true)
+	return m, wm, comms
+}
+
+func TestSetupRebalancer(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRebalancer(nil, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilPortfolioManager)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilWithdrawManager)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, &testCommsManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, &testCommsManager{}, &config.RebalancerConfig{})
+	assert.ErrorIs(t, err, errInvalidRebalanceCheckInterval)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, &testCommsManager{}, &config.RebalancerConfig{CheckInterval: time.Minute})
+	assert.ErrorIs(t, err, errNoRebalanceTargets)
+	_, err = SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, &testCommsManager{}, &testRebalancerConfig)
+	assert.NoError(t, err, "SetupRebalancer should not error")
+}
+
+func TestRebalancerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *Rebalancer
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil rebalancer")
+
+	cfg := testRebalancerConfig
+	cfg.DryRun = true
+	m, err := SetupRebalancer(rebalanceExchangeManager{}, &rebalancePortfolio{}, &rebalanceWithdrawer{}, &testCommsManager{}, &cfg)
+	require.NoError(t, err, "SetupRebalancer must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetTransfers("")
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestPlanRebalance(t *testing.T) {
+	t.Parallel()
+	target := &config.RebalanceTarget{Tolerance: 0.05, MinTransfer: 0.1}
+	holdings := []rebalanceHolding{
+		{exchange: "a", total: 6, free: 6, deviation: 3},
+		{exchange: "b", total: 2, free: 2, deviation: -1},
+		{exchange: "c", total: 1, free: 1, deviation: -2},
+		{exchange: "d", total: 3, free: 3, deviation: 0},
+	}
+	resp := planRebalance(holdings, 12, target, 10)
+	require.Len(t, resp, 2, "planRebalance must match the surplus with each deficit")
+	assert.Equal(t, RebalanceTransfer{From: "a", To: "c", Amount: 2}, *resp[0], "planRebalance should fill the largest deficit first")
+	assert.Equal(t, RebalanceTransfer{From: "a", To: "b", Amount: 1}, *resp[1])
+
+	assert.Empty(t, planRebalance(holdings, 100, target, 10), "planRebalance should not plan transfers within tolerance")
+	assert.Empty(t, planRebalance(nil, 0, target, 10), "planRebalance should not plan transfers without holdings")
+
+	resp = planRebalance(holdings, 12, target, 2.5)
+	require.Len(t, resp, 2, "planRebalance must limit transfers to the daily limit")
+	assert.Equal(t, 0.5, resp[1].Amount)
+	assert.Empty(t, planRebalance(holdings, 12, target, 0.05), "planRebalance should not plan transfers below the minimum")
+
+	capped := &config.RebalanceTarget{Tolerance: 0.05, MaxTransfer: 1.5}
+	resp = planRebalance(holdings, 12, capped, 10)
+	require.Len(t, resp, 2, "planRebalance must plan one transfer per pair")
+	assert.Equal(t, 1.5, resp[0].Amount, "planRebalance should limit a transfer to the maximum")
+	assert.Equal(t, 1.0, resp[1].Amount)
+
+	holdings[0].free = 0.5
+	resp = planRebalance(holdings, 12, target, 10)
+	require.Len(t, resp, 1, "planRebalance must limit transfers to the free balance")
+	assert.Equal(t, 0.5, resp[0].Amount)
+}
+
+func TestRebalanceChains(t *testing.T) {
+	t.Parallel()
+	from := &rebalanceExchange{name: "alpha", chains: []string{"ERC20", "TRC20", "SOL"}}
+	to := &rebalanceExchange{name: "beta", chains: []string{"SOL", "erc20"}}
+	chains, err := rebalanceChains(t.Context(), from, to, currency.USDT, nil)
+	require.NoError(t, err, "rebalanceChains must not error")
+	assert.Equal(t, []string{"ERC20", "SOL"}, chains, "rebalanceChains should return the chains supported by both in source order")
+
+	chains, err = rebalanceChains(t.Context(), from, to, currency.USDT, []string{"SOL", "TRC20"})
+	require.NoError(t, err, "rebalanceChains must not error")
+	assert.Equal(t, []string{"SOL"}, chains, "rebalanceChains should only return preferred chains")
+
+	chains, err = rebalanceChains(t.Context(), &rebalanceExchange{}, to, currency.USDT, nil)
+	require.NoError(t, err, "rebalanceChains must not error")
+	assert.Equal(t, []string{"SOL", "erc20"}, chains, "rebalanceChains should use the destination chains when the source does not list them")
+
+	chains, err = rebalanceChains(t.Context(), &rebalanceExchange{}, &rebalanceExchange{}, currency.USDT, nil)
+	require.NoError(t, err, "rebalanceChains must not error")
+	assert.Equal(t, []string{""}, chains, "rebalanceChains should return the default chain when neither exchange lists them")
+
+	_, err = rebalanceChains(t.Context(), from, to, currency.USDT, []string{"TRC20"})
+	assert.ErrorIs(t, err, errNoRebalanceChain)
+}
+
+func TestRebalancerCheckAll(t *testing.T) {
+	t.Parallel()
+	m, wm, comms := newTestRebalancer(t, &testRebalancerConfig)
+	m.checkAll(t.Context())
+	require.Len(t, wm.requests, 1, "checkAll must submit a withdrawal")
+	req := wm.requests[0]
+	assert.Equal(t, "alpha", req.Exchange)
+	assert.Equal(t, 1.0, req.Amount, "checkAll should move the surplus to the target allocation")
+	assert.Equal(t, withdraw.Crypto, req.Type)
+	assert.Equal(t, "beta-BTC", req.Crypto.Address)
+	assert.Equal(t, "BTC", req.Crypto.Chain)
+
+	transfers, err := m.GetTransfers(RebalanceSubmitted)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	assert.NotEmpty(t, transfers[0].WithdrawalID, "a submitted transfer should store its withdrawal ID")
+	require.Len(t, comms.events, 1)
+	assert.Equal(t, base.RebalanceTransferEventType, comms.events[0].Type)
+
+	m.checkAll(t.Context())
+	assert.Len(t, wm.requests, 1, "checkAll should not rebalance a currency within the cooldown")
+
+	m, wm, comms = newTestRebalancer(t, &testRebalancerConfig)
+	m.portfolioManager = &rebalancePortfolio{}
+	m.checkAll(t.Context())
+	assert.Empty(t, wm.requests, "checkAll should not withdraw to an address which is not whitelisted")
+	transfers, err = m.GetTransfers(RebalanceFailed)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	assert.Contains(t, transfers[0].Error, withdraw.ErrStrAddressNotWhiteListed.Error())
+	assert.Equal(t, base.SeverityCritical, comms.events[0].Severity)
+
+	cfg := testRebalancerConfig
+	cfg.DryRun = true
+	m, wm, _ = newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	assert.Empty(t, wm.requests, "checkAll should not submit withdrawals during a dry run")
+	transfers, err = m.GetTransfers(RebalanceDryRun)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Len(t, transfers, 1, "checkAll should record dry run transfers")
+
+	m, wm, _ = newTestRebalancer(t, &testRebalancerConfig)
+	wm.err = errors.New("insufficient funds")
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers(RebalanceFailed)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "checkAll should record failed withdrawals")
+	assert.Equal(t, "insufficient funds", transfers[0].Error)
+}
+
+func TestRebalancerInTransit(t *testing.T) {
+	t.Parallel()
+	cfg := testRebalancerConfig
+	cfg.Cooldown = time.Nanosecond
+	m, wm, comms := newTestRebalancer(t, &cfg)
+	em, ok := m.exchangeManager.(rebalanceExchangeManager)
+	require.True(t, ok, "exchange manager must be a rebalanceExchangeManager")
+	alpha, beta := em["alpha"].(*rebalanceExchange), em["beta"].(*rebalanceExchange)
+	m.checkAll(t.Context())
+	require.Len(t, wm.requests, 1, "checkAll must submit a withdrawal")
+
+	alpha.total, alpha.free = 2, 1.5
+	m.checkAll(t.Context())
+	assert.Len(t, wm.requests, 1, "checkAll should count a transfer which has not arrived towards its destination")
+	transfers, err := m.GetTransfers(RebalanceSubmitted)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "a transfer should stay submitted until it arrives")
+
+	beta.total, beta.free = 1.999, 1.999
+	m.checkAll(t.Context())
+	assert.Len(t, wm.requests, 1, "checkAll should not rebalance once the transfer has arrived")
+	transfers, err = m.GetTransfers(RebalanceCompleted)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "a transfer should complete once the destination balance reflects it")
+	last := comms.events[len(comms.events)-1]
+	assert.Equal(t, base.RebalanceTransferEventType, last.Type)
+	assert.Contains(t, last.Message, RebalanceCompleted, "a completed transfer should be notified")
+
+	m, wm, _ = newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers(RebalanceSubmitted)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	wm.statuses = map[string]string{transfers[0].WithdrawalID: reconciledWithdrawalStatus(withdrawalFailed, "Failure")}
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers(RebalanceFailed)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "a transfer should fail once the reconciler reports its withdrawal failed")
+	assert.Equal(t, "withdrawal failed", transfers[0].Error)
+
+	m, wm, _ = newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers(RebalanceSubmitted)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	wm.statuses = map[string]string{transfers[0].WithdrawalID: reconciledWithdrawalStatus(withdrawalCompleted, "Success")}
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers(RebalanceCompleted)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Len(t, transfers, 1, "a transfer should complete once the reconciler reports its withdrawal completed")
+}
+
+func TestRebalancerApproval(t *testing.T) {
+	t.Parallel()
+	cfg := testRebalancerConfig
+	cfg.RequireApproval = true
+	m, wm, comms := newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	assert.Empty(t, wm.requests, "checkAll should not submit withdrawals requiring approval")
+	transfers, err := m.GetTransfers(RebalancePendingApproval)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	assert.Equal(t, base.SeverityWarning, comms.events[0].Severity)
+
+	_, err = m.ApproveTransfer(t.Context(), uuid.Must(uuid.NewV4()))
+	assert.ErrorIs(t, err, errRebalanceTransferNotFound)
+
+	resp, err := m.ApproveTransfer(t.Context(), transfers[0].ID)
+	require.NoError(t, err, "ApproveTransfer must not error")
+	assert.Equal(t, RebalanceSubmitted, resp.Status)
+	assert.Len(t, wm.requests, 1, "ApproveTransfer should submit the withdrawal")
+	_, err = m.ApproveTransfer(t.Context(), transfers[0].ID)
+	assert.ErrorIs(t, err, errRebalanceTransferNotPending)
+	_, err = m.RejectTransfer(transfers[0].ID)
+	assert.ErrorIs(t, err, errRebalanceTransferNotPending)
+
+	m, _, comms = newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	transfers, err = m.GetTransfers("")
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1)
+	resp, err = m.RejectTransfer(transfers[0].ID)
+	require.NoError(t, err, "RejectTransfer must not error")
+	assert.Equal(t, RebalanceRejected, resp.Status)
+	require.Len(t, comms.events, 2, "RejectTransfer must notify the rejection")
+	assert.Equal(t, base.RebalanceTransferEventType, comms.events[1].Type)
+	assert.Equal(t, base.SeverityInfo, comms.events[1].Severity)
+	assert.Contains(t, comms.events[1].Message, RebalanceRejected, "RejectTransfer should notify the rejected status")
+
+	m, _, comms = newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	m.prune(time.Now().Add(cfg.ApprovalTimeout))
+	transfers, err = m.GetTransfers(RebalanceExpired)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Len(t, transfers, 1, "prune should expire transfers which are not approved in time")
+	assert.Len(t, comms.events, 2, "prune should notify expired transfers")
+	m.prune(time.Now().Add(rebalanceRetention + cfg.ApprovalTimeout + time.Minute))
+	transfers, err = m.GetTransfers("")
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Empty(t, transfers, "prune should remove transfers older than the retention period")
+}
+
+func TestRebalancerDailyLimit(t *testing.T) {
+	t.Parallel()
+	cfg := testRebalancerConfig
+	cfg.Targets = []config.RebalanceTarget{cfg.Targets[0]}
+	cfg.Targets[0].DailyLimit = 0.4
+	m, wm, _ := newTestRebalancer(t, &cfg)
+	m.checkAll(t.Context())
+	require.Len(t, wm.requests, 1, "checkAll must submit a withdrawal")
+	assert.Equal(t, 0.4, wm.requests[0].Amount, "checkAll should limit transfers to the daily limit")
+	assert.Equal(t, 0.4, m.transferredSince(currency.BTC, time.Now().Add(-time.Hour)))
+}
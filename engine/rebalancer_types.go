@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// RebalancerName is an exported subsystem name
+const RebalancerName = "rebalancer"
+
+// Rebalance transfer statuses
+const (
+	RebalancePendingApproval = "pending approval"
+	RebalanceSubmitted       = "submitted"
+	RebalanceCompleted       = "completed"
+	RebalanceDryRun          = "dry run"
+	RebalanceRejected        = "rejected"
+	RebalanceExpired         = "expired"
+	RebalanceFailed          = "failed"
+)
+
+// rebalanceRetention is how long finished transfers are kept
+const rebalanceRetention = 7 * 24 * time.Hour
+
+// rebalanceArrivalFraction is the fraction of a transfer the destination
+// balance must increase by for the transfer to have arrived, allowing for
+// withdrawal fees
+const rebalanceArrivalFraction = 0.9
+
+// RebalanceTransfer is a withdrawal between exchanges planned to move a
+// currency's holdings towards its target allocations
+type RebalanceTransfer struct {
+	ID         uuid.UUID
+	Currency   currency.Code
+	From       string
+	To         string
+	Chain      string
+	Address    string
+	AddressTag string
+	Amount     float64
+	Status     string
+	// WithdrawalID is the withdraw manager ID of a submitted transfer
+	WithdrawalID string
+	Error        string
+	Created      time.Time
+	Updated      time.Time
+
+	// destinationTotal is the destination balance when the transfer was
+	// planned, a submitted transfer is in transit until it increases
+	destinationTotal float64
+}
+
+// iWithdrawManager limits exposure of accessible functions to the withdraw
+// manager
+type iWithdrawManager interface {
+	SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error)
+	WithdrawalEventByID(id string) (*withdraw.Response, error)
+}
+
+// Rebalancer periodically compares the holdings of configured currencies
+// across exchanges against their target allocations and moves the surplus
+// with withdrawals, subject to dry run, approval and limit controls
+type Rebalancer struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager  iExchangeManager
+	portfolioManager iPortfolioManager
+	withdrawManager  iWithdrawManager
+	comms            iCommsManager
+	cfg              config.RebalancerConfig
+
+	mu        sync.RWMutex
+	transfers []*RebalanceTransfer
+}
+
+// rebalanceHolding is a currency's holdings on an exchange against its target
+type rebalanceHolding struct {
+	exchange string
+	total    float64
+	free     float64
+	// inTransit is the amount of submitted transfers which have not yet
+	// arrived, included in the total
+	inTransit float64
+	// deviation is the holdings above the target, negative when below
+	deviation float64
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -53,6 +54,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
@@ -79,8 +81,14 @@ var (
 	errGRPCShutdownSignalIsNil = errors.New("cannot shutdown, gRPC shutdown channel is nil")
 	errInvalidStrategy         = errors.New("invalid strategy")
 	errSpecificPairNotEnabled  = errors.New("specified pair is not enabled")
+	errRPCUserNotAuthenticated = errors.New("gRPC user not authenticated")
+	errApproverMismatch        = errors.New("approver does not match the authenticated user")
 )
 
+// rpcUserKey is the context key of the username authenticated by the gRPC
+// server
+type rpcUserKey struct{}
+
 // RPCServer struct
 type RPCServer struct {
 	gctrpc.UnimplementedGoCryptoTraderServiceServer
@@ -111,10 +119,10 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 	username := cred[0]
 	password := cred[1]
 
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
+	if !s.Config.RemoteControl.Authenticate(username, password) {
 		return ctx, errors.New("username/password mismatch")
 	}
+	ctx = context.WithValue(ctx, rpcUserKey{}, username)
 	ctx, err = accounts.ParseCredentialsMetadata(ctx, md)
 	if err != nil {
 		return ctx, err
@@ -126,6 +134,12 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 	return ctx, nil
 }
 
+// rpcUser returns the username authenticated by the gRPC server
+func rpcUser(ctx context.Context) (string, bool) {
+	user, ok := ctx.Value(rpcUserKey{}).(string)
+	return user, ok
+}
+
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(ctx context.Context, engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
@@ -155,6 +169,7 @@ func StartRPCServer(ctx context.Context, engine *Engine) {
 	s := RPCServer{Engine: engine}
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
 		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
 	}
@@ -209,6 +224,7 @@ func (s *RPCServer) startRPCRESTProxy(ctx context.Context) {
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithPerRPCCredentials(auth.BasicAuth{
 			Username: s.Config.RemoteControl.Username,
 			Password: s.Config.RemoteControl.Password,
@@ -787,6 +803,18 @@ func (s *RPCServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfoli
 			Coins: o,
 		}
 	}
+	resp.OptionsExposure = make([]*gctrpc.OptionExposure, len(result.Options))
+	for i := range result.Options {
+		resp.OptionsExposure[i] = &gctrpc.OptionExposure{
+			Underlying: result.Options[i].Underlying.String(),
+			Positions:  int64(result.Options[i].Positions),
+			Value:      result.Options[i].Value,
+			Delta:      result.Options[i].Delta,
+			Gamma:      result.Options[i].Gamma,
+			Vega:       result.Options[i].Vega,
+			Theta:      result.Options[i].Theta,
+		}
+	}
 
 	return &resp, nil
 }
@@ -987,7 +1015,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 
 // GetManagedOrders returns all orders from the Order Manager for the provided exchange,
 // asset type and currency pair
-func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+func (s *RPCServer) GetManagedOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
 	if r == nil {
 		return nil, errInvalidArguments
 	}
@@ -1018,6 +1046,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 	var resp []order.Detail
 	filter := order.Filter{
 		Exchange:  exch.GetName(),
+		Account:   accounts.AccountFromContext(ctx),
 		Pair:      cp,
 		AssetType: a,
 	}
@@ -1061,6 +1090,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 			Fee:           resp[x].Fee,
 			Cost:          resp[x].Cost,
 			Trades:        trades,
+			Account:       resp[x].Account,
 		}
 		if !resp[x].Date.IsZero() {
 			o.CreationTime = resp[x].Date.Format(common.SimpleTimeFormatWithTimezone)
@@ -2577,6 +2607,10 @@ func (s *RPCServer) GCTScriptExecute(_ context.Context, r *gctrpc.GCTScriptExecu
 		r.Script.Path = gctscript.ScriptPath
 	}
 
+	if r.Simulation != nil {
+		return s.gctScriptSimulate(filepath.Join(r.Script.Path, r.Script.Name), r.Simulation)
+	}
+
 	gctVM := s.gctScriptManager.New()
 	if gctVM == nil {
 		return &gctrpc.GenericResponse{Status: MsgStatusError, Data: "unable to create VM instance"}, nil
@@ -2598,6 +2632,61 @@ func (s *RPCServer) GCTScriptExecute(_ context.Context, r *gctrpc.GCTScriptExecu
 	}, nil
 }
 
+// gctScriptSimulate dry runs a script against recorded market data and
+// returns the orders, withdrawals and balances captured by the simulation
+func (s *RPCServer) gctScriptSimulate(script string, r *gctrpc.GCTScriptSimulation) (*gctrpc.GenericResponse, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &gctscript.SimulationConfig{
+		Exchange: r.Exchange,
+		Pair:     currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		Asset:    a,
+		Interval: kline.Interval(r.TimeInterval),
+		Trace:    r.Trace,
+	}
+	if r.DataFile != "" {
+		cfg.DataFile = filepath.Join(gctscript.ScriptPath, r.DataFile)
+		if !strings.HasPrefix(cfg.DataFile, filepath.Clean(gctscript.ScriptPath)+string(os.PathSeparator)) {
+			return nil, fmt.Errorf("%s: invalid file path", cfg.DataFile)
+		}
+	}
+	if r.Start != "" {
+		if cfg.Start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.Start); err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.End != "" {
+		if cfg.End, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.End); err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	if len(r.Balances) > 0 {
+		cfg.Balances = make(map[currency.Code]float64, len(r.Balances))
+		for code, amount := range r.Balances {
+			cfg.Balances[currency.NewCode(code)] = amount
+		}
+	}
+
+	result, err := s.gctScriptManager.Simulate(script, cfg)
+	if err != nil {
+		return &gctrpc.GenericResponse{Status: MsgStatusError, Data: err.Error()}, nil //nolint:nilerr // error is returned in the generic response
+	}
+	data, err := json.MarshalIndent(result, "", " ")
+	if err != nil {
+		return nil, err
+	}
+	status := MsgStatusOK
+	if result.Error != "" {
+		status = MsgStatusError
+	}
+	return &gctrpc.GenericResponse{Status: status, Data: string(data)}, nil
+}
+
 // GCTScriptStop terminate a running script
 func (s *RPCServer) GCTScriptStop(_ context.Context, r *gctrpc.GCTScriptStopRequest) (*gctrpc.GenericResponse, error) {
 	if !s.gctScriptManager.IsRunning() {
@@ -3627,6 +3716,7 @@ func parseMultipleEvents(ret []*withdraw.Response) *gctrpc.WithdrawalEventsByExc
 				Address:    ret[x].RequestDetails.Crypto.Address,
 				AddressTag: ret[x].RequestDetails.Crypto.AddressTag,
 				Fee:        ret[x].RequestDetails.Crypto.FeeAmount,
+				TxId:       ret[x].Exchange.TxID,
 			}
 		case withdraw.Fiat:
 			tempEvent.Request.Fiat = new(gctrpc.FiatWithdrawalEvent)
@@ -3712,6 +3802,7 @@ func parseSingleEvents(ret *withdraw.Response) *gctrpc.WithdrawalEventsByExchang
 			Address:    ret.RequestDetails.Crypto.Address,
 			AddressTag: ret.RequestDetails.Crypto.AddressTag,
 			Fee:        ret.RequestDetails.Crypto.FeeAmount,
+			TxId:       ret.Exchange.TxID,
 		}
 	case withdraw.Fiat:
 		if ret.RequestDetails.Fiat != (withdraw.FiatRequest{}) {
@@ -4152,6 +4243,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 		UnrealisedPnl:    position.UnrealisedPNL.String(),
 		RealisedPnl:      position.RealisedPNL.String(),
 		OrderCount:       int64(len(position.Orders)),
+		Account:          position.Account,
 	}
 	if getFundingPayments {
 		var sum decimal.Decimal
@@ -4194,6 +4286,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 				OpenVolume:    position.Orders[i].RemainingAmount,
 				Fee:           position.Orders[i].Fee,
 				Cost:          position.Orders[i].Cost,
+				Account:       position.Orders[i].Account,
 			}
 			if !position.Orders[i].LastUpdated.IsZero() {
 				od.UpdateTime = position.Orders[i].LastUpdated.Format(common.SimpleTimeFormatWithTimezone)
@@ -4218,7 +4311,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 }
 
 // GetManagedPosition returns an open positions from the order manager, no calling any API endpoints to return this information
-func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
+func (s *RPCServer) GetManagedPosition(ctx context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("%w GetManagedPositionRequest", common.ErrNilPointer)
 	}
@@ -4260,7 +4353,7 @@ func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPo
 	if err != nil {
 		return nil, err
 	}
-	position, err := s.OrderManager.GetOpenFuturesPosition(r.Exchange, ai, cp)
+	position, err := s.OrderManager.GetOpenFuturesPosition(accounts.AccountFromContext(ctx), r.Exchange, ai, cp)
 	if err != nil {
 		return nil, err
 	}
@@ -5911,3 +6004,830 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// GetRateLimitState returns the rate limiter state and the latest exchange
+// reported quotas for an exchange
+func (s *RPCServer) GetRateLimitState(_ context.Context, r *gctrpc.GetRateLimitStateRequest) (*gctrpc.GetRateLimitStateResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetRateLimitStateRequest", common.ErrNilPointer)
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	status, err := exch.GetBase().GetRateLimitStatus()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRateLimitStateResponse{
+		Exchange: exch.GetName(),
+		Enabled:  status.Enabled,
+		Quotas:   make([]*gctrpc.RateLimitQuota, len(status.Quotas)),
+	}
+	for i := range status.Quotas {
+		q := &status.Quotas[i]
+		resp.Quotas[i] = &gctrpc.RateLimitQuota{
+			Endpoint:  uint32(q.Endpoint),
+			Scope:     q.Scope.String(),
+			Account:   q.Account,
+			Limit:     q.Limit,
+			Used:      q.Used,
+			Remaining: q.Remaining,
+			Window:    q.Window.String(),
+			ResetAt:   timestamppb.New(q.Reset),
+			UpdatedAt: timestamppb.New(q.Updated),
+			Rate:      q.Rate,
+			Exhausted: q.Exhausted,
+		}
+	}
+	return resp, nil
+}
+
+// GetExchangeHealth returns the rated health of an exchange, or of all checked
+// exchanges when no exchange is specified
+func (s *RPCServer) GetExchangeHealth(_ context.Context, r *gctrpc.GetExchangeHealthRequest) (*gctrpc.GetExchangeHealthResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetExchangeHealthRequest", common.ErrNilPointer)
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	health, err := s.exchangeHealthManager.GetHealth(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetExchangeHealthResponse{
+		Exchanges: make([]*gctrpc.ExchangeHealth, len(health)),
+	}
+	for i := range health {
+		h := &health[i]
+		resp.Exchanges[i] = &gctrpc.ExchangeHealth{
+			Exchange:           h.Exchange,
+			Status:             h.Status.String(),
+			Reasons:            h.Reasons,
+			Since:              timestamppb.New(h.Since),
+			LastChecked:        timestamppb.New(h.LastChecked),
+			RestRequests:       int64(h.RESTRequests),
+			RestErrors:         int64(h.RESTErrors),
+			RestErrorRate:      h.RESTErrorRate,
+			AverageLatency:     h.AverageLatency.String(),
+			WebsocketEnabled:   h.WebsocketEnabled,
+			WebsocketConnected: h.WebsocketConnected,
+			OrderbookStaleness: h.OrderbookStaleness.String(),
+			TimeDrift:          h.TimeDrift.String(),
+		}
+	}
+	return resp, nil
+}
+
+// GetOptionAnalytics returns the implied volatility and Greeks of an option
+// contract worked out from its latest ticker
+func (s *RPCServer) GetOptionAnalytics(ctx context.Context, r *gctrpc.GetOptionAnalyticsRequest) (*gctrpc.GetOptionAnalyticsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetOptionAnalyticsRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, fmt.Errorf("%w CurrencyPair", common.ErrNilPointer)
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	pair := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	if err := checkParams(r.Exchange, exch, a, pair); err != nil {
+		return nil, err
+	}
+	params, err := optionParameters(r.Model, r.RiskFreeRate, r.DividendYield)
+	if err != nil {
+		return nil, err
+	}
+	analytics, err := getOptionAnalytics(ctx, exch, a, pair, r.UnderlyingPrice, params)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetOptionAnalyticsResponse{Analytics: optionAnalyticsToRPC(analytics)}, nil
+}
+
+// GetVolatilitySurface returns the volatility smile of every expiry of an
+// underlying built from the enabled option contracts of an exchange
+func (s *RPCServer) GetVolatilitySurface(ctx context.Context, r *gctrpc.GetVolatilitySurfaceRequest) (*gctrpc.GetVolatilitySurfaceResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetVolatilitySurfaceRequest", common.ErrNilPointer)
+	}
+	if r.Underlying == "" {
+		return nil, currency.ErrCurrencyCodeEmpty
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkParams(r.Exchange, exch, a, currency.EMPTYPAIR); err != nil {
+		return nil, err
+	}
+	params, err := optionParameters(r.Model, r.RiskFreeRate, r.DividendYield)
+	if err != nil {
+		return nil, err
+	}
+	surface, analytics, err := getVolatilitySurface(ctx, exch, a, currency.NewCode(r.Underlying).Upper(), r.UnderlyingPrice, params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetVolatilitySurfaceResponse{
+		Exchange:   surface.Exchange,
+		Asset:      surface.Asset.String(),
+		Underlying: surface.Underlying.String(),
+		Timestamp:  timestamppb.New(surface.Timestamp),
+		Smiles:     make([]*gctrpc.VolatilitySmile, len(surface.Smiles)),
+	}
+	for i := range surface.Smiles {
+		smile := &surface.Smiles[i]
+		points := make([]*gctrpc.VolatilitySmilePoint, len(smile.Points))
+		for j := range smile.Points {
+			points[j] = &gctrpc.VolatilitySmilePoint{
+				Strike:            smile.Points[j].Strike,
+				Moneyness:         smile.Points[j].Moneyness,
+				ImpliedVolatility: smile.Points[j].ImpliedVolatility,
+				Delta:             smile.Points[j].Delta,
+				Type:              smile.Points[j].Type.String(),
+				Pair:              smile.Points[j].Pair.String(),
+			}
+		}
+		resp.Smiles[i] = &gctrpc.VolatilitySmile{
+			Expiry:          timestamppb.New(smile.Expiry),
+			TimeToExpiry:    smile.TimeToExpiry,
+			UnderlyingPrice: smile.UnderlyingPrice,
+			AtmVolatility:   smile.ATMVolatility,
+			Points:          points,
+		}
+	}
+	if r.IncludeContracts {
+		resp.Contracts = make([]*gctrpc.OptionAnalytics, len(analytics))
+		for i := range analytics {
+			resp.Contracts[i] = optionAnalyticsToRPC(&analytics[i])
+		}
+	}
+	return resp, nil
+}
+
+func optionParameters(model string, riskFreeRate, dividendYield float64) (*options.Parameters, error) {
+	m, err := options.StringToModel(model)
+	if err != nil {
+		return nil, err
+	}
+	return &options.Parameters{Model: m, RiskFreeRate: riskFreeRate, DividendYield: dividendYield}, nil
+}
+
+func optionAnalyticsToRPC(a *options.Analytics) *gctrpc.OptionAnalytics {
+	return &gctrpc.OptionAnalytics{
+		Exchange:          a.Exchange,
+		Asset:             a.Asset.String(),
+		Pair:              a.Contract.Pair.String(),
+		Underlying:        a.Contract.Underlying.String(),
+		Settlement:        a.Contract.Settlement.String(),
+		Type:              a.Contract.Type.String(),
+		Strike:            a.Contract.Strike,
+		Expiry:            timestamppb.New(a.Contract.Expiry),
+		Premium:           a.Premium,
+		Price:             a.Price,
+		UnderlyingPrice:   a.UnderlyingPrice,
+		TimeToExpiry:      a.TimeToExpiry,
+		ImpliedVolatility: a.ImpliedVolatility,
+		Greeks: &gctrpc.OptionGreeks{
+			Delta: a.Greeks.Delta,
+			Gamma: a.Greeks.Gamma,
+			Vega:  a.Greeks.Vega,
+			Theta: a.Greeks.Theta,
+			Rho:   a.Greeks.Rho,
+		},
+		Timestamp: timestamppb.New(a.Timestamp),
+	}
+}
+
+// GetCarrySnapshots returns the latest funding rate and basis of the futures
+// contracts monitored by the carry monitor
+func (s *RPCServer) GetCarrySnapshots(_ context.Context, r *gctrpc.GetCarrySnapshotsRequest) (*gctrpc.GetCarrySnapshotsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetCarrySnapshotsRequest", common.ErrNilPointer)
+	}
+	underlying, err := s.carryRequestParams(r.Exchange, r.Underlying)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.carryMonitor.GetSnapshots(r.Exchange, underlying, r.OpportunitiesOnly)
+	if err != nil {
+		return nil, err
+	}
+	return carrySnapshotsToRPC(snapshots, r.Exchange, underlying, r.OpportunitiesOnly), nil
+}
+
+// GetCarrySnapshotStream streams the funding rate and basis of the futures
+// contracts monitored by the carry monitor after each check
+func (s *RPCServer) GetCarrySnapshotStream(r *gctrpc.GetCarrySnapshotsRequest, stream gctrpc.GoCryptoTraderService_GetCarrySnapshotStreamServer) error {
+	if r == nil {
+		return fmt.Errorf("%w GetCarrySnapshotsRequest", common.ErrNilPointer)
+	}
+	underlying, err := s.carryRequestParams(r.Exchange, r.Underlying)
+	if err != nil {
+		return err
+	}
+	if !s.carryMonitor.IsRunning() {
+		return fmt.Errorf("%s %w", CarryMonitorName, ErrSubSystemNotStarted)
+	}
+	pipe, err := s.carryMonitor.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			snapshots, ok := data.([]CarrySnapshot)
+			if !ok {
+				return common.GetTypeAssertError("[]CarrySnapshot", data)
+			}
+			resp := carrySnapshotsToRPC(snapshots, r.Exchange, underlying, r.OpportunitiesOnly)
+			if len(resp.Snapshots) == 0 {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetCarryHistory returns the stored carry snapshots of an underlying between
+// the dates
+func (s *RPCServer) GetCarryHistory(_ context.Context, r *gctrpc.GetCarryHistoryRequest) (*gctrpc.GetCarryHistoryResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetCarryHistoryRequest", common.ErrNilPointer)
+	}
+	if r.Underlying == nil {
+		return nil, fmt.Errorf("%w CurrencyPair", common.ErrNilPointer)
+	}
+	underlying, err := s.carryRequestParams(r.Exchange, r.Underlying)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	history, err := s.carryMonitor.GetHistory(r.Exchange, underlying, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetCarryHistoryResponse{
+		Snapshots: make([]*gctrpc.CarrySnapshot, len(history)),
+	}
+	for i := range history {
+		h := &history[i]
+		resp.Snapshots[i] = &gctrpc.CarrySnapshot{
+			Exchange:              h.ExchangeName,
+			Asset:                 h.Asset,
+			Pair:                  &gctrpc.CurrencyPair{Base: h.Base, Quote: h.Quote},
+			Underlying:            &gctrpc.CurrencyPair{Base: h.UnderlyingBase, Quote: h.UnderlyingQuote},
+			ContractType:          h.ContractType,
+			SpotPrice:             h.SpotPrice,
+			ContractPrice:         h.ContractPrice,
+			Basis:                 h.Basis,
+			AnnualisedBasis:       h.AnnualisedBasis,
+			FundingRate:           h.FundingRate,
+			AnnualisedFundingRate: h.AnnualisedFundingRate,
+			Timestamp:             timestamppb.New(h.Timestamp),
+		}
+		if !h.Expiry.IsZero() {
+			resp.Snapshots[i].Expiry = timestamppb.New(h.Expiry)
+		}
+	}
+	return resp, nil
+}
+
+// carryRequestParams validates the optional exchange and underlying of a carry
+// request
+func (s *RPCServer) carryRequestParams(exchangeName string, p *gctrpc.CurrencyPair) (currency.Pair, error) {
+	if exchangeName != "" {
+		if _, err := s.GetExchangeByName(exchangeName); err != nil {
+			return currency.EMPTYPAIR, err
+		}
+	}
+	if p == nil || (p.Base == "" && p.Quote == "") {
+		return currency.EMPTYPAIR, nil
+	}
+	return currency.NewPairFromStrings(p.Base, p.Quote)
+}
+
+func carrySnapshotsToRPC(snapshots []CarrySnapshot, exchangeName string, underlying currency.Pair, opportunitiesOnly bool) *gctrpc.GetCarrySnapshotsResponse {
+	resp := &gctrpc.GetCarrySnapshotsResponse{
+		Snapshots: make([]*gctrpc.CarrySnapshot, 0, len(snapshots)),
+	}
+	for i := range snapshots {
+		c := &snapshots[i]
+		if exchangeName != "" && !strings.EqualFold(c.Exchange, exchangeName) {
+			continue
+		}
+		if !underlying.IsEmpty() && !c.Underlying.Equal(underlying) {
+			continue
+		}
+		if opportunitiesOnly && !c.Opportunity {
+			continue
+		}
+		snapshot := &gctrpc.CarrySnapshot{
+			Exchange: c.Exchange,
+			Asset:    c.Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: c.Pair.Delimiter,
+				Base:      c.Pair.Base.String(),
+				Quote:     c.Pair.Quote.String(),
+			},
+			Underlying: &gctrpc.CurrencyPair{
+				Delimiter: c.Underlying.Delimiter,
+				Base:      c.Underlying.Base.String(),
+				Quote:     c.Underlying.Quote.String(),
+			},
+			ContractType:          c.ContractType.String(),
+			SpotPrice:             c.SpotPrice,
+			ContractPrice:         c.ContractPrice,
+			Basis:                 c.Basis,
+			AnnualisedBasis:       c.AnnualisedBasis,
+			FundingRate:           c.FundingRate,
+			AnnualisedFundingRate: c.AnnualisedFundingRate,
+			Opportunity:           c.Opportunity,
+			Timestamp:             timestamppb.New(c.Time),
+		}
+		if c.FundingInterval > 0 {
+			snapshot.FundingInterval = c.FundingInterval.String()
+		}
+		if !c.Expiry.IsZero() {
+			snapshot.Expiry = timestamppb.New(c.Expiry)
+		}
+		resp.Snapshots = append(resp.Snapshots, snapshot)
+	}
+	return resp
+}
+
+// GetMarginHealth returns the liquidation distance and margin ratio of the open
+// futures positions monitored by the margin monitor, least healthy first
+func (s *RPCServer) GetMarginHealth(_ context.Context, r *gctrpc.GetMarginHealthRequest) (*gctrpc.GetMarginHealthResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetMarginHealthRequest", common.ErrNilPointer)
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	positions, err := s.marginMonitor.GetMarginHealth(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarginHealthResponse{
+		Positions: make([]*gctrpc.PositionMarginHealth, len(positions)),
+	}
+	for i := range positions {
+		p := &positions[i]
+		resp.Positions[i] = &gctrpc.PositionMarginHealth{
+			Exchange: p.Exchange,
+			Account:  p.Account,
+			Asset:    p.Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Pair.Delimiter,
+				Base:      p.Pair.Base.String(),
+				Quote:     p.Pair.Quote.String(),
+			},
+			Side:                         p.Side.String(),
+			Size:                         p.Size,
+			MidPrice:                     p.MidPrice,
+			MarginType:                   p.MarginType.String(),
+			LiquidationPrice:             p.LiquidationPrice,
+			LiquidationDistance:          p.LiquidationDistance,
+			MarginRatio:                  p.MarginRatio,
+			MaintenanceMarginRequirement: p.MaintenanceMarginRequirement,
+			MarginBalance:                p.MarginBalance,
+			UnrealisedPnl:                p.UnrealisedPNL,
+			Level:                        p.Level,
+			Reasons:                      p.Reasons,
+			Reduced:                      p.Reduced,
+			Timestamp:                    timestamppb.New(p.Time),
+		}
+	}
+	return resp, nil
+}
+
+// GetRebalanceTransfers returns the transfers planned by the rebalancer,
+// optionally filtered by status, newest first
+func (s *RPCServer) GetRebalanceTransfers(_ context.Context, r *gctrpc.GetRebalanceTransfersRequest) (*gctrpc.GetRebalanceTransfersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetRebalanceTransfersRequest", common.ErrNilPointer)
+	}
+	transfers, err := s.rebalancer.GetTransfers(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRebalanceTransfersResponse{
+		Transfers: make([]*gctrpc.RebalanceTransfer, len(transfers)),
+	}
+	for i := range transfers {
+		resp.Transfers[i] = rebalanceTransferToRPC(&transfers[i])
+	}
+	return resp, nil
+}
+
+// ApproveRebalanceTransfer submits the withdrawal of a rebalance transfer
+// pending approval
+func (s *RPCServer) ApproveRebalanceTransfer(ctx context.Context, r *gctrpc.RebalanceTransferRequest) (*gctrpc.RebalanceTransfer, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RebalanceTransferRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.rebalancer.ApproveTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rebalanceTransferToRPC(&t), nil
+}
+
+// RejectRebalanceTransfer rejects a rebalance transfer pending approval
+func (s *RPCServer) RejectRebalanceTransfer(_ context.Context, r *gctrpc.RebalanceTransferRequest) (*gctrpc.RebalanceTransfer, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RebalanceTransferRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.rebalancer.RejectTransfer(id)
+	if err != nil {
+		return nil, err
+	}
+	return rebalanceTransferToRPC(&t), nil
+}
+
+func rebalanceTransferToRPC(t *RebalanceTransfer) *gctrpc.RebalanceTransfer {
+	return &gctrpc.RebalanceTransfer{
+		Id:           t.ID.String(),
+		Currency:     t.Currency.String(),
+		From:         t.From,
+		To:           t.To,
+		Chain:        t.Chain,
+		Address:      t.Address,
+		AddressTag:   t.AddressTag,
+		Amount:       t.Amount,
+		Status:       t.Status,
+		WithdrawalId: t.WithdrawalID,
+		Error:        t.Error,
+		Created:      timestamppb.New(t.Created),
+		Updated:      timestamppb.New(t.Updated),
+	}
+}
+
+// GetQueuedWithdrawals returns the withdrawals held by the withdrawal policy,
+// optionally filtered by status
+func (s *RPCServer) GetQueuedWithdrawals(_ context.Context, r *gctrpc.GetQueuedWithdrawalsRequest) (*gctrpc.GetQueuedWithdrawalsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetQueuedWithdrawalsRequest", common.ErrNilPointer)
+	}
+	withdrawals, err := s.withdrawalPolicyManager.GetWithdrawals(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetQueuedWithdrawalsResponse{
+		Withdrawals: make([]*gctrpc.QueuedWithdrawal, len(withdrawals)),
+	}
+	for i := range withdrawals {
+		resp.Withdrawals[i] = queuedWithdrawalToRPC(&withdrawals[i])
+	}
+	return resp, nil
+}
+
+// ApproveQueuedWithdrawal records the approval of a queued withdrawal by the
+// authenticated user, submitting it once it has its required approvals
+func (s *RPCServer) ApproveQueuedWithdrawal(ctx context.Context, r *gctrpc.QueuedWithdrawalRequest) (*gctrpc.QueuedWithdrawal, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w QueuedWithdrawalRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	approver, err := queuedWithdrawalApprover(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.withdrawalPolicyManager.Approve(ctx, id, approver)
+	if err != nil {
+		return nil, err
+	}
+	return queuedWithdrawalToRPC(&w), nil
+}
+
+// RejectQueuedWithdrawal rejects a queued withdrawal which has not been
+// submitted as the authenticated user
+func (s *RPCServer) RejectQueuedWithdrawal(ctx context.Context, r *gctrpc.QueuedWithdrawalRequest) (*gctrpc.QueuedWithdrawal, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w QueuedWithdrawalRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	approver, err := queuedWithdrawalApprover(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.withdrawalPolicyManager.Reject(id, approver, r.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return queuedWithdrawalToRPC(&w), nil
+}
+
+// queuedWithdrawalApprover returns the authenticated user acting on a queued
+// withdrawal, an approver named in the request must be the same user
+func queuedWithdrawalApprover(ctx context.Context, r *gctrpc.QueuedWithdrawalRequest) (string, error) {
+	user, ok := rpcUser(ctx)
+	if !ok {
+		return "", errRPCUserNotAuthenticated
+	}
+	if r.Approver != "" && !strings.EqualFold(strings.TrimSpace(r.Approver), user) {
+		return "", fmt.Errorf("%w: %q is authenticated as %q", errApproverMismatch, r.Approver, user)
+	}
+	return user, nil
+}
+
+func queuedWithdrawalToRPC(w *QueuedWithdrawal) *gctrpc.QueuedWithdrawal {
+	return &gctrpc.QueuedWithdrawal{
+		Id:                w.ID.String(),
+		Exchange:          w.Request.Exchange,
+		Currency:          w.Request.Currency.String(),
+		Amount:            w.Request.Amount,
+		Address:           w.Request.Crypto.Address,
+		AddressTag:        w.Request.Crypto.AddressTag,
+		Chain:             w.Request.Crypto.Chain,
+		Description:       w.Request.Description,
+		Status:            w.Status,
+		Approvers:         w.Approvers,
+		RequiredApprovals: int64(w.RequiredApprovals),
+		RejectedBy:        w.RejectedBy,
+		Reason:            w.Reason,
+		WithdrawalId:      w.WithdrawalID,
+		ExchangeId:        w.ExchangeID,
+		Error:             w.Error,
+		Requested:         timestamppb.New(w.Requested),
+		Updated:           timestamppb.New(w.Updated),
+		ExecuteAfter:      timestamppb.New(w.ExecuteAfter),
+	}
+}
+
+// GetPortfolioValuation returns the most recent valuation of the portfolio's
+// holdings in the reporting currency
+func (s *RPCServer) GetPortfolioValuation(_ context.Context, r *gctrpc.GetPortfolioValuationRequest) (*gctrpc.PortfolioValuation, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetPortfolioValuationRequest", common.ErrNilPointer)
+	}
+	v, err := s.portfolioValuer.GetLatest()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.PortfolioValuation{
+		ReportingCurrency: v.ReportingCurrency.String(),
+		Total:             v.Total,
+		Holdings:          make([]*gctrpc.PortfolioValuationHolding, len(v.Holdings)),
+		Timestamp:         timestamppb.New(v.Time),
+	}
+	for i := range v.Holdings {
+		h := &v.Holdings[i]
+		resp.Holdings[i] = &gctrpc.PortfolioValuationHolding{
+			Source:   h.Source,
+			Address:  h.Address,
+			Currency: h.Currency.String(),
+			Amount:   h.Amount,
+			Price:    h.Price,
+			Value:    h.Value,
+		}
+	}
+	return resp, nil
+}
+
+// GetPortfolioEquityCurve returns the total value of the portfolio at each
+// stored valuation between the dates
+func (s *RPCServer) GetPortfolioEquityCurve(_ context.Context, r *gctrpc.PortfolioValuationHistoryRequest) (*gctrpc.GetPortfolioEquityCurveResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w PortfolioValuationHistoryRequest", common.ErrNilPointer)
+	}
+	start, end, err := parsePortfolioValuationRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	curve, err := s.portfolioValuer.GetEquityCurve(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioEquityCurveResponse{
+		ReportingCurrency: s.portfolioValuer.cfg.ReportingCurrency.String(),
+		Points:            make([]*gctrpc.PortfolioEquityPoint, len(curve)),
+	}
+	for i := range curve {
+		resp.Points[i] = &gctrpc.PortfolioEquityPoint{
+			Value:     curve[i].Value,
+			Timestamp: timestamppb.New(curve[i].Time),
+		}
+	}
+	return resp, nil
+}
+
+// GetPortfolioDailyPNL returns the change in the portfolio's value over each
+// UTC day between the dates
+func (s *RPCServer) GetPortfolioDailyPNL(_ context.Context, r *gctrpc.PortfolioValuationHistoryRequest) (*gctrpc.GetPortfolioDailyPNLResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w PortfolioValuationHistoryRequest", common.ErrNilPointer)
+	}
+	start, end, err := parsePortfolioValuationRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.portfolioValuer.GetDailyPNL(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioDailyPNLResponse{
+		ReportingCurrency: s.portfolioValuer.cfg.ReportingCurrency.String(),
+		Days:              make([]*gctrpc.PortfolioDailyPNL, len(days)),
+	}
+	for i := range days {
+		resp.Days[i] = &gctrpc.PortfolioDailyPNL{
+			Date:       days[i].Date.Format(time.DateOnly),
+			Open:       days[i].Open,
+			Close:      days[i].Close,
+			Pnl:        days[i].PNL,
+			PnlPercent: days[i].PNLPercent,
+		}
+	}
+	return resp, nil
+}
+
+// GetPortfolioAttribution returns the change in value of each exchange or
+// currency between the first and last stored valuations between the dates
+func (s *RPCServer) GetPortfolioAttribution(_ context.Context, r *gctrpc.GetPortfolioAttributionRequest) (*gctrpc.GetPortfolioAttributionResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetPortfolioAttributionRequest", common.ErrNilPointer)
+	}
+	start, end, err := parsePortfolioValuationRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	group := portfolio.AttributionGroup(strings.ToLower(r.GroupBy))
+	attributions, err := s.portfolioValuer.GetAttribution(start, end, group)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioAttributionResponse{
+		ReportingCurrency: s.portfolioValuer.cfg.ReportingCurrency.String(),
+		GroupBy:           string(group),
+		Attributions:      make([]*gctrpc.PortfolioAttribution, len(attributions)),
+	}
+	for i := range attributions {
+		a := &attributions[i]
+		resp.Attributions[i] = &gctrpc.PortfolioAttribution{
+			Key:          a.Key,
+			StartValue:   a.StartValue,
+			EndValue:     a.EndValue,
+			Change:       a.Change,
+			Contribution: a.Contribution,
+		}
+	}
+	return resp, nil
+}
+
+// GetTradeLedgerFills returns the spot fills stored by the trade ledger
+// executed between the dates
+func (s *RPCServer) GetTradeLedgerFills(_ context.Context, r *gctrpc.GetTradeLedgerFillsRequest) (*gctrpc.GetTradeLedgerFillsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetTradeLedgerFillsRequest", common.ErrNilPointer)
+	}
+	start, end, err := parsePortfolioValuationRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	fills, err := s.tradeLedger.GetFills(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetTradeLedgerFillsResponse{Fills: make([]*gctrpc.TradeLedgerFill, len(fills))}
+	for i := range fills {
+		f := &fills[i]
+		resp.Fills[i] = &gctrpc.TradeLedgerFill{
+			Exchange:    f.Exchange,
+			Account:     f.Account,
+			OrderId:     f.OrderID,
+			TradeId:     f.TradeID,
+			Pair:        f.Pair.String(),
+			Side:        f.Side.String(),
+			Price:       f.Price,
+			Amount:      f.Amount,
+			Fee:         f.Fee,
+			FeeCurrency: f.FeeCurrency.String(),
+			Timestamp:   timestamppb.New(f.Time),
+		}
+	}
+	return resp, nil
+}
+
+// GetTradeLedgerLots returns the open lots of the trade ledger with their
+// unrealised gains at current prices
+func (s *RPCServer) GetTradeLedgerLots(ctx context.Context, r *gctrpc.GetTradeLedgerLotsRequest) (*gctrpc.GetTradeLedgerLotsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetTradeLedgerLotsRequest", common.ErrNilPointer)
+	}
+	lots, err := s.tradeLedger.GetUnrealised(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetTradeLedgerLotsResponse{
+		ReportingCurrency: s.tradeLedger.cfg.ReportingCurrency.String(),
+		Method:            string(s.tradeLedger.cfg.Method),
+		Lots:              make([]*gctrpc.TradeLedgerLot, len(lots)),
+	}
+	for i := range lots {
+		l := &lots[i]
+		resp.Lots[i] = &gctrpc.TradeLedgerLot{
+			Currency:       l.Currency.String(),
+			Exchange:       l.Exchange,
+			OrderId:        l.OrderID,
+			Acquired:       timestamppb.New(l.Acquired),
+			Amount:         l.Amount,
+			Remaining:      l.Remaining,
+			UnitCost:       l.UnitCost(),
+			CostBasis:      l.CostBasis,
+			Price:          l.Price,
+			Value:          l.Value,
+			UnrealisedGain: l.Gain,
+		}
+		resp.TotalCostBasis += l.CostBasis
+		resp.TotalValue += l.Value
+		resp.TotalUnrealisedGain += l.Gain
+	}
+	return resp, nil
+}
+
+// GetTaxReport returns the realised gains of a tax year and their CSV report
+func (s *RPCServer) GetTaxReport(ctx context.Context, r *gctrpc.GetTaxReportRequest) (*gctrpc.GetTaxReportResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetTaxReportRequest", common.ErrNilPointer)
+	}
+	report, err := s.tradeLedger.GetTaxReport(ctx, int(r.Year))
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetTaxReportResponse{
+		Year:              int64(report.Year),
+		Method:            string(report.Method),
+		ReportingCurrency: s.tradeLedger.cfg.ReportingCurrency.String(),
+		Start:             timestamppb.New(report.Summary.Start),
+		End:               timestamppb.New(report.Summary.End),
+		Disposals:         int64(report.Summary.Disposals),
+		Proceeds:          report.Summary.Proceeds,
+		CostBasis:         report.Summary.CostBasis,
+		Gain:              report.Summary.Gain,
+		Csv:               string(report.CSV),
+	}, nil
+}
+
+func parsePortfolioValuationRange(start, end string) (s, e time.Time, err error) {
+	s, err = time.Parse(common.SimpleTimeFormatWithTimezone, start)
+	if err != nil {
+		return s, e, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormatWithTimezone, end)
+	if err != nil {
+		return s, e, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	return s, e, nil
+}
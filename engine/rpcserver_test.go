@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -32,7 +33,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -46,9 +49,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
@@ -3938,3 +3943,507 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestGetRateLimitState(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err = s.GetRateLimitState(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetRateLimitState(t.Context(), &gctrpc.GetRateLimitStateRequest{})
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "250")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(serv.Close)
+	err = b.SendPayload(t.Context(), request.Unset, func() (*request.Item, error) {
+		return &request.Item{Method: http.MethodGet, Path: serv.URL}, nil
+	}, request.UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+
+	resp, err := s.GetRateLimitState(t.Context(), &gctrpc.GetRateLimitStateRequest{Exchange: fakeExchangeName})
+	require.NoError(t, err, "GetRateLimitState must not error")
+	assert.True(t, resp.Enabled, "rate limiter should be enabled")
+	require.Len(t, resp.Quotas, 1, "must contain the header reported quota")
+	assert.Equal(t, "ip", resp.Quotas[0].Scope)
+	assert.Equal(t, int64(250), resp.Quotas[0].Used)
+	assert.Equal(t, int64(5750), resp.Quotas[0].Remaining)
+	assert.Equal(t, "1m0s", resp.Quotas[0].Window)
+}
+
+func TestGetExchangeHealth(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().Name = fakeExchangeName
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err = s.GetExchangeHealth(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetExchangeHealth(t.Context(), &gctrpc.GetExchangeHealthRequest{Exchange: "unknown"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = s.GetExchangeHealth(t.Context(), &gctrpc.GetExchangeHealthRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	s.exchangeHealthManager, err = SetupExchangeHealthManager(em, &testCommsManager{}, nil, nil, &testHealthConfig)
+	require.NoError(t, err, "SetupExchangeHealthManager must not error")
+	s.exchangeHealthManager.started.Store(true)
+	tn := time.Now()
+	s.exchangeHealthManager.check(t.Context(), &healthExchange{serverTime: tn}, tn)
+
+	resp, err := s.GetExchangeHealth(t.Context(), &gctrpc.GetExchangeHealthRequest{})
+	require.NoError(t, err, "GetExchangeHealth must not error")
+	require.Len(t, resp.Exchanges, 1, "GetExchangeHealth must return the checked exchange")
+	assert.Equal(t, "healthy", resp.Exchanges[0].Exchange)
+	assert.Equal(t, "healthy", resp.Exchanges[0].Status)
+	assert.Equal(t, "0s", resp.Exchanges[0].AverageLatency)
+	assert.Equal(t, tn.Unix(), resp.Exchanges[0].LastChecked.AsTime().Unix())
+
+	_, err = s.GetExchangeHealth(t.Context(), &gctrpc.GetExchangeHealthRequest{Exchange: fakeExchangeName})
+	assert.ErrorIs(t, err, errExchangeHealthNotFound)
+}
+
+func TestGetOptionAnalyticsRPC(t *testing.T) {
+	t.Parallel()
+	em, _ := setupOptionsExchange(t, "optionsRPC", 0.7)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err := s.GetOptionAnalytics(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetOptionAnalytics(t.Context(), &gctrpc.GetOptionAnalyticsRequest{Exchange: "optionsRPC", Asset: "options"})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.GetOptionAnalyticsRequest{
+		Exchange: "optionsRPC",
+		Asset:    "options",
+		Pair:     &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "27DEC30-90000-P"},
+		Model:    "binomial",
+	}
+	_, err = s.GetOptionAnalytics(t.Context(), req)
+	assert.ErrorContains(t, err, "unknown pricing model")
+
+	req.Model = "black76"
+	resp, err := s.GetOptionAnalytics(t.Context(), req)
+	require.NoError(t, err, "GetOptionAnalytics must not error")
+	assert.Equal(t, "put", resp.Analytics.Type, "Type should be set")
+	assert.Equal(t, 90000.0, resp.Analytics.Strike, "Strike should be set")
+	assert.InDelta(t, 0.7, resp.Analytics.ImpliedVolatility, 1e-6, "ImpliedVolatility should be recovered")
+	assert.Negative(t, resp.Analytics.Greeks.Delta, "Delta should be negative for a put")
+}
+
+func TestGetVolatilitySurfaceRPC(t *testing.T) {
+	t.Parallel()
+	em, _ := setupOptionsExchange(t, "optionsSurfaceRPC", 0.45)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err := s.GetVolatilitySurface(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetVolatilitySurface(t.Context(), &gctrpc.GetVolatilitySurfaceRequest{Exchange: "optionsSurfaceRPC", Asset: "options"})
+	assert.ErrorIs(t, err, currency.ErrCurrencyCodeEmpty)
+
+	req := &gctrpc.GetVolatilitySurfaceRequest{Exchange: "optionsSurfaceRPC", Asset: "options", Underlying: "btc"}
+	resp, err := s.GetVolatilitySurface(t.Context(), req)
+	require.NoError(t, err, "GetVolatilitySurface must not error")
+	assert.Equal(t, "BTC", resp.Underlying, "Underlying should be set")
+	require.Len(t, resp.Smiles, 2, "GetVolatilitySurface must return a smile per expiry")
+	assert.InDelta(t, 0.45, resp.Smiles[0].AtmVolatility, 1e-6, "AtmVolatility should be recovered")
+	assert.Empty(t, resp.Contracts, "Contracts should only be returned when requested")
+
+	req.IncludeContracts = true
+	resp, err = s.GetVolatilitySurface(t.Context(), req)
+	require.NoError(t, err, "GetVolatilitySurface must not error")
+	assert.Len(t, resp.Contracts, 4, "Contracts should be returned when requested")
+}
+
+type carrySnapshotStream struct {
+	gctrpc.GoCryptoTraderService_GetCarrySnapshotStreamServer
+	ctx  context.Context
+	sent chan *gctrpc.GetCarrySnapshotsResponse
+}
+
+func (c *carrySnapshotStream) Context() context.Context { return c.ctx }
+
+func (c *carrySnapshotStream) Send(resp *gctrpc.GetCarrySnapshotsResponse) error {
+	c.sent <- resp
+	return nil
+}
+
+func TestGetCarrySnapshots(t *testing.T) {
+	t.Parallel()
+	em := &carryExchangeManager{}
+	s := RPCServer{Engine: &Engine{ExchangeManager: NewExchangeManager()}}
+	_, err := s.GetCarrySnapshots(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetCarrySnapshots(t.Context(), &gctrpc.GetCarrySnapshotsRequest{Exchange: "unknown"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = s.GetCarrySnapshots(t.Context(), &gctrpc.GetCarrySnapshotsRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	s.carryMonitor, err = SetupCarryMonitor(em, &testCommsManager{}, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	s.carryMonitor.started.Store(true)
+	s.carryMonitor.update(s.carryMonitor.collect(t.Context(), newCarryExchange(testCarryStart), s.carryMonitor.underlyingsFor("carry"), testCarryStart))
+
+	resp, err := s.GetCarrySnapshots(t.Context(), &gctrpc.GetCarrySnapshotsRequest{
+		Underlying:        &gctrpc.CurrencyPair{Base: "btc", Quote: "usdt"},
+		OpportunitiesOnly: true,
+	})
+	require.NoError(t, err, "GetCarrySnapshots must not error")
+	require.Len(t, resp.Snapshots, 1, "GetCarrySnapshots must only return opportunities")
+	assert.Equal(t, "carry", resp.Snapshots[0].Exchange)
+	assert.Equal(t, "perpetual", resp.Snapshots[0].ContractType)
+	assert.Equal(t, "4h0m0s", resp.Snapshots[0].FundingInterval)
+	assert.Nil(t, resp.Snapshots[0].Expiry, "Expiry should not be set for a perpetual")
+
+	resp, err = s.GetCarrySnapshots(t.Context(), &gctrpc.GetCarrySnapshotsRequest{})
+	require.NoError(t, err, "GetCarrySnapshots must not error")
+	assert.Len(t, resp.Snapshots, 3, "GetCarrySnapshots should return all snapshots")
+
+	_, err = s.GetCarryHistory(t.Context(), &gctrpc.GetCarryHistoryRequest{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetCarryHistory(t.Context(), &gctrpc.GetCarryHistoryRequest{
+		Underlying: &gctrpc.CurrencyPair{Base: "btc", Quote: "usdt"},
+		Start:      "bad",
+	})
+	assert.ErrorIs(t, err, errInvalidTimes)
+	_, err = s.GetCarryHistory(t.Context(), &gctrpc.GetCarryHistoryRequest{
+		Underlying: &gctrpc.CurrencyPair{Base: "btc", Quote: "usdt"},
+		Start:      testCarryStart.Format(common.SimpleTimeFormatWithTimezone),
+		End:        testCarryStart.Add(time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	})
+	assert.ErrorIs(t, err, errCarryHistoryUnavailable)
+}
+
+func TestGetCarrySnapshotStream(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	em := &carryExchangeManager{exchanges: []exchange.IBotExchange{newCarryExchange(time.Now())}}
+	s := RPCServer{Engine: &Engine{ExchangeManager: NewExchangeManager()}}
+	assert.ErrorIs(t, s.GetCarrySnapshotStream(nil, nil), common.ErrNilPointer)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &carrySnapshotStream{ctx: ctx, sent: make(chan *gctrpc.GetCarrySnapshotsResponse, 1)}
+	assert.ErrorIs(t, s.GetCarrySnapshotStream(&gctrpc.GetCarrySnapshotsRequest{}, stream), ErrSubSystemNotStarted)
+
+	var err error
+	s.carryMonitor, err = SetupCarryMonitor(em, &testCommsManager{}, nil, &testCarryConfig)
+	require.NoError(t, err, "SetupCarryMonitor must not error")
+	s.carryMonitor.started.Store(true)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetCarrySnapshotStream(&gctrpc.GetCarrySnapshotsRequest{OpportunitiesOnly: true}, stream)
+	}()
+	assert.Eventually(t, func() bool {
+		s.carryMonitor.checkAll(t.Context())
+		select {
+		case resp := <-stream.sent:
+			return assert.Len(t, resp.Snapshots, 1, "GetCarrySnapshotStream should only send opportunities")
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond, "GetCarrySnapshotStream should send the checked snapshots")
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestGetMarginHealth(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{ExchangeManager: NewExchangeManager()}}
+	_, err := s.GetMarginHealth(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetMarginHealth(t.Context(), &gctrpc.GetMarginHealthRequest{Exchange: "unknown"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = s.GetMarginHealth(t.Context(), &gctrpc.GetMarginHealthRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	s.marginMonitor, err = SetupMarginMonitor(s.ExchangeManager, &marginOrderManager{}, &testCommsManager{}, &testMarginConfig)
+	require.NoError(t, err, "SetupMarginMonitor must not error")
+	s.marginMonitor.started.Store(true)
+	s.marginMonitor.update(t.Context(), []PositionMarginHealth{
+		{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: currency.NewBTCUSDT(), Side: order.Long, Size: 1, MidPrice: 40000, LiquidationPrice: 38000, LiquidationDistance: 0.05, Time: time.Now()},
+		{Exchange: "margin", Asset: asset.USDTMarginedFutures, Pair: currency.NewPair(currency.ETH, currency.USDT), Side: order.Short, Size: 1, MidPrice: 2000, MarginRatio: 0.1, Time: time.Now()},
+	})
+
+	resp, err := s.GetMarginHealth(t.Context(), &gctrpc.GetMarginHealthRequest{})
+	require.NoError(t, err, "GetMarginHealth must not error")
+	require.Len(t, resp.Positions, 2, "GetMarginHealth must return all positions")
+	assert.Equal(t, "critical", resp.Positions[0].Level, "GetMarginHealth should return the least healthy position first")
+	assert.Equal(t, "BTC", resp.Positions[0].Pair.Base)
+	assert.NotEmpty(t, resp.Positions[0].Reasons)
+	assert.Empty(t, resp.Positions[1].Level)
+}
+
+func TestRebalanceTransfers(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetRebalanceTransfers(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetRebalanceTransfers(t.Context(), &gctrpc.GetRebalanceTransfersRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.ApproveRebalanceTransfer(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RejectRebalanceTransfer(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.ApproveRebalanceTransfer(t.Context(), &gctrpc.RebalanceTransferRequest{Id: "bad"})
+	assert.Error(t, err, "ApproveRebalanceTransfer should error on an invalid ID")
+
+	cfg := testRebalancerConfig
+	cfg.RequireApproval = true
+	var wm *rebalanceWithdrawer
+	s.rebalancer, wm, _ = newTestRebalancer(t, &cfg)
+	s.rebalancer.checkAll(t.Context())
+
+	resp, err := s.GetRebalanceTransfers(t.Context(), &gctrpc.GetRebalanceTransfersRequest{Status: RebalancePendingApproval})
+	require.NoError(t, err, "GetRebalanceTransfers must not error")
+	require.Len(t, resp.Transfers, 1, "GetRebalanceTransfers must return the pending transfer")
+	assert.Equal(t, "BTC", resp.Transfers[0].Currency)
+	assert.Equal(t, "beta-BTC", resp.Transfers[0].Address)
+
+	_, err = s.RejectRebalanceTransfer(t.Context(), &gctrpc.RebalanceTransferRequest{Id: uuid.Must(uuid.NewV4()).String()})
+	assert.ErrorIs(t, err, errRebalanceTransferNotFound)
+
+	approved, err := s.ApproveRebalanceTransfer(t.Context(), &gctrpc.RebalanceTransferRequest{Id: resp.Transfers[0].Id})
+	require.NoError(t, err, "ApproveRebalanceTransfer must not error")
+	assert.Equal(t, RebalanceSubmitted, approved.Status)
+	assert.NotEmpty(t, approved.WithdrawalId)
+	assert.Len(t, wm.requests, 1)
+}
+
+func TestQueuedWithdrawals(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{RemoteControl: config.RemoteControlConfig{
+		Username: "admin",
+		Password: "password",
+		Operators: []config.RemoteControlOperator{
+			{Username: "mallory", Password: "password"},
+			{Username: "alice", Password: "password"},
+			{Username: "bob", Password: "password"},
+		},
+	}}}}
+	_, err := s.GetQueuedWithdrawals(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetQueuedWithdrawals(t.Context(), &gctrpc.GetQueuedWithdrawalsRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.ApproveQueuedWithdrawal(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RejectQueuedWithdrawal(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.ApproveQueuedWithdrawal(t.Context(), &gctrpc.QueuedWithdrawalRequest{Id: "bad"})
+	assert.Error(t, err, "ApproveQueuedWithdrawal should error on an invalid ID")
+
+	var wm *policyWithdrawer
+	s.withdrawalPolicyManager, wm, _, _ = newTestWithdrawalPolicy(t, &testWithdrawalPolicyConfig)
+	_, err = s.withdrawalPolicyManager.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	rejected, err := s.withdrawalPolicyManager.Request(t.Context(), newTestPolicyRequest(currency.ETH, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+
+	resp, err := s.GetQueuedWithdrawals(t.Context(), &gctrpc.GetQueuedWithdrawalsRequest{Status: PolicyPendingApproval})
+	require.NoError(t, err, "GetQueuedWithdrawals must not error")
+	require.Len(t, resp.Withdrawals, 2, "GetQueuedWithdrawals must return the pending withdrawals")
+	assert.Equal(t, "ETH", resp.Withdrawals[0].Currency, "GetQueuedWithdrawals should return the newest withdrawal first")
+	assert.Equal(t, "trusted", resp.Withdrawals[1].Address)
+	assert.Equal(t, int64(2), resp.Withdrawals[1].RequiredApprovals)
+
+	id := resp.Withdrawals[1].Id
+	_, err = s.ApproveQueuedWithdrawal(t.Context(), &gctrpc.QueuedWithdrawalRequest{Id: id, Approver: "alice"})
+	assert.ErrorIs(t, err, errRPCUserNotAuthenticated, "ApproveQueuedWithdrawal should not trust the approver of the request")
+	mallory := authenticatedContext(t, &s, "mallory")
+	_, err = s.ApproveQueuedWithdrawal(mallory, &gctrpc.QueuedWithdrawalRequest{Id: id})
+	assert.ErrorIs(t, err, errNotWithdrawalApprover)
+	alice := authenticatedContext(t, &s, "alice")
+	_, err = s.RejectQueuedWithdrawal(alice, &gctrpc.QueuedWithdrawalRequest{Id: uuid.Must(uuid.NewV4()).String()})
+	assert.ErrorIs(t, err, errQueuedWithdrawalNotFound)
+	r, err := s.RejectQueuedWithdrawal(alice, &gctrpc.QueuedWithdrawalRequest{Id: rejected.ID.String(), Reason: "unexpected"})
+	require.NoError(t, err, "RejectQueuedWithdrawal must not error")
+	assert.Equal(t, PolicyRejected, r.Status)
+	assert.Equal(t, "alice", r.RejectedBy)
+	assert.Equal(t, "unexpected", r.Reason)
+
+	_, err = s.ApproveQueuedWithdrawal(alice, &gctrpc.QueuedWithdrawalRequest{Id: id, Approver: "bob"})
+	assert.ErrorIs(t, err, errApproverMismatch)
+	_, err = s.ApproveQueuedWithdrawal(alice, &gctrpc.QueuedWithdrawalRequest{Id: id})
+	require.NoError(t, err, "ApproveQueuedWithdrawal must not error")
+	_, err = s.ApproveQueuedWithdrawal(alice, &gctrpc.QueuedWithdrawalRequest{Id: id, Approver: "alice"})
+	assert.ErrorIs(t, err, errWithdrawalAlreadyApproved, "an authenticated user must only approve once")
+	assert.Empty(t, wm.requests)
+	approved, err := s.ApproveQueuedWithdrawal(authenticatedContext(t, &s, "bob"), &gctrpc.QueuedWithdrawalRequest{Id: id})
+	require.NoError(t, err, "ApproveQueuedWithdrawal must not error")
+	assert.Equal(t, PolicySubmitted, approved.Status)
+	assert.Equal(t, []string{"alice", "bob"}, approved.Approvers)
+	assert.NotEmpty(t, approved.WithdrawalId)
+	assert.Len(t, wm.requests, 1)
+}
+
+// authenticatedContext returns a context authenticated by the gRPC server as
+// the user
+func authenticatedContext(t *testing.T, s *RPCServer, user string) context.Context {
+	t.Helper()
+	md := metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user+":password")))
+	ctx, err := s.authenticateClient(metadata.NewIncomingContext(t.Context(), md))
+	require.NoError(t, err, "authenticateClient must not error")
+	return ctx
+}
+
+func TestAuthenticateClientOperators(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{RemoteControl: config.RemoteControlConfig{
+		Username:  "admin",
+		Password:  "password",
+		Operators: []config.RemoteControlOperator{{Username: "alice", Password: "secret"}},
+	}}}}
+	authenticate := func(user, password string) (context.Context, error) {
+		md := metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user+":"+password)))
+		return s.authenticateClient(metadata.NewIncomingContext(t.Context(), md))
+	}
+	for user, password := range map[string]string{"admin": "password", "alice": "secret"} {
+		ctx, err := authenticate(user, password)
+		require.NoErrorf(t, err, "authenticateClient must authenticate %s", user)
+		authenticated, ok := rpcUser(ctx)
+		assert.True(t, ok, "authenticateClient should set the user")
+		assert.Equal(t, user, authenticated, "authenticateClient should authenticate each operator as their own user")
+	}
+	_, err := authenticate("alice", "password")
+	assert.Error(t, err, "authenticateClient should not accept another user's password")
+}
+
+func TestPortfolioValuationRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetPortfolioValuation(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetPortfolioValuation(t.Context(), &gctrpc.GetPortfolioValuationRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.GetPortfolioEquityCurve(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetPortfolioDailyPNL(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetPortfolioAttribution(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetPortfolioEquityCurve(t.Context(), &gctrpc.PortfolioValuationHistoryRequest{Start: "bad"})
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	history := &gctrpc.PortfolioValuationHistoryRequest{
+		Start: start.Format(common.SimpleTimeFormatWithTimezone),
+		End:   start.Add(48 * time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	_, err = s.GetPortfolioDailyPNL(t.Context(), history)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	s.portfolioValuer = newTestPortfolioValuer(t)
+	s.portfolioValuer.started.Store(true)
+	_, err = s.GetPortfolioEquityCurve(t.Context(), history)
+	assert.ErrorIs(t, err, errPortfolioHistoryUnavailable)
+
+	db := &valuationDB{}
+	s.portfolioValuer.db = db
+	s.portfolioValuer.valueAndStore(t.Context())
+	v, err := s.GetPortfolioValuation(t.Context(), &gctrpc.GetPortfolioValuationRequest{})
+	require.NoError(t, err, "GetPortfolioValuation must not error")
+	assert.Equal(t, "EUR", v.ReportingCurrency)
+	assert.Equal(t, 40100.0, v.Total)
+	assert.Len(t, v.Holdings, 5)
+
+	for i, total := range []float64{1000, 1200, 1100} {
+		require.NoError(t, db.Insert(portfoliosnapshot.Snapshot{
+			ReportingCurrency: "EUR",
+			TotalValue:        total,
+			Timestamp:         start.Add(time.Duration(i) * 12 * time.Hour),
+			Holdings:          []portfoliosnapshot.Holding{{Source: "Binance", Currency: "BTC", Value: total}},
+		}))
+	}
+	curve, err := s.GetPortfolioEquityCurve(t.Context(), history)
+	require.NoError(t, err, "GetPortfolioEquityCurve must not error")
+	assert.Equal(t, "EUR", curve.ReportingCurrency)
+	require.Len(t, curve.Points, 3)
+	assert.Equal(t, 1100.0, curve.Points[2].Value)
+
+	pnl, err := s.GetPortfolioDailyPNL(t.Context(), history)
+	require.NoError(t, err, "GetPortfolioDailyPNL must not error")
+	require.Len(t, pnl.Days, 2)
+	assert.Equal(t, "2026-10-01", pnl.Days[0].Date)
+	assert.Equal(t, 20.0, pnl.Days[0].PnlPercent)
+
+	_, err = s.GetPortfolioAttribution(t.Context(), &gctrpc.GetPortfolioAttributionRequest{Start: history.Start, End: history.End, GroupBy: "chain"})
+	assert.ErrorIs(t, err, portfolio.ErrInvalidAttributionGroup)
+	attr, err := s.GetPortfolioAttribution(t.Context(), &gctrpc.GetPortfolioAttributionRequest{Start: history.Start, End: history.End, GroupBy: "Currency"})
+	require.NoError(t, err, "GetPortfolioAttribution must not error")
+	assert.Equal(t, "currency", attr.GroupBy)
+	require.Len(t, attr.Attributions, 1)
+	assert.Equal(t, "BTC", attr.Attributions[0].Key)
+	assert.Equal(t, 100.0, attr.Attributions[0].Change)
+}
+
+func TestTradeLedgerRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetTradeLedgerFills(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetTradeLedgerLots(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetTaxReport(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetTradeLedgerFills(t.Context(), &gctrpc.GetTradeLedgerFillsRequest{Start: "bad"})
+	assert.ErrorIs(t, err, errInvalidTimes)
+	_, err = s.GetTradeLedgerLots(t.Context(), &gctrpc.GetTradeLedgerLotsRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.GetTaxReport(t.Context(), &gctrpc.GetTaxReportRequest{Year: 2026})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	s.tradeLedger, _ = newTestTradeLedger(t)
+	s.tradeLedger.importOrderHistory(t.Context(), time.Now())
+	s.tradeLedger.started.Store(true)
+
+	fills, err := s.GetTradeLedgerFills(t.Context(), &gctrpc.GetTradeLedgerFillsRequest{
+		Start: testLedgerTime.Add(-time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+		End:   testLedgerTime.Add(72 * time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	})
+	require.NoError(t, err, "GetTradeLedgerFills must not error")
+	require.Len(t, fills.Fills, 4)
+	assert.Equal(t, "11", fills.Fills[0].TradeId)
+	assert.Equal(t, "USDT", fills.Fills[0].FeeCurrency)
+
+	lots, err := s.GetTradeLedgerLots(t.Context(), &gctrpc.GetTradeLedgerLotsRequest{})
+	require.NoError(t, err, "GetTradeLedgerLots must not error")
+	assert.Equal(t, "fifo", lots.Method)
+	assert.Len(t, lots.Lots, 2)
+	assert.InDelta(t, 9495.5, lots.TotalUnrealisedGain, 1e-9)
+
+	report, err := s.GetTaxReport(t.Context(), &gctrpc.GetTaxReportRequest{Year: 2026})
+	require.NoError(t, err, "GetTaxReport must not error")
+	assert.Equal(t, int64(3), report.Disposals)
+	assert.InDelta(t, 5484.5, report.Gain, 1e-9)
+	assert.NotEmpty(t, report.Csv)
+}
+
+func TestGCTScriptSimulateDataFile(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.gctScriptSimulate("script", &gctrpc.GCTScriptSimulation{
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"},
+		AssetType: "spot",
+		DataFile:  filepath.Join("..", "..", "etc", "passwd"),
+	})
+	assert.ErrorContains(t, err, "invalid file path", "data files outside the script path should not be read")
+}
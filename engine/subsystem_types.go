@@ -36,6 +36,9 @@ var (
 	errNilWaitGroup                 = errors.New("nil wait group received")
 	errNilExchangeManager           = errors.New("cannot start with nil exchange manager")
 	errNilDatabaseConnectionManager = errors.New("cannot start with nil database connection manager")
+	errNilOrderManager              = errors.New("cannot start with nil order manager")
+	errNilPortfolioManager          = errors.New("cannot start with nil portfolio manager")
+	errNilWithdrawManager           = errors.New("cannot start with nil withdraw manager")
 	errNilConfig                    = errors.New("received nil config")
 )
 
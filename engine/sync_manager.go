@@ -17,6 +17,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -405,6 +406,28 @@ func (m *SyncManager) WebsocketUpdate(exchangeName string, p currency.Pair, a as
 	return m.update(c, syncType, err)
 }
 
+// OldestOrderbookUpdate returns the least recent orderbook update of an
+// exchange's synced pairs, false when no orderbook has been synced yet
+func (m *SyncManager) OldestOrderbookUpdate(exchangeName string) (time.Time, bool) {
+	if m == nil || !m.started.Load() {
+		return time.Time{}, false
+	}
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	var oldest time.Time
+	for k, c := range m.currencyPairs {
+		if !strings.EqualFold(k.Exchange, exchangeName) {
+			continue
+		}
+		c.locks[SyncItemOrderbook].Lock()
+		if s := c.trackers[SyncItemOrderbook]; s != nil && s.HaveData && (oldest.IsZero() || s.LastUpdated.Before(oldest)) {
+			oldest = s.LastUpdated
+		}
+		c.locks[SyncItemOrderbook].Unlock()
+	}
+	return oldest, !oldest.IsZero()
+}
+
 // update notifies the SyncManager to change the last updated time for a exchange asset pair
 func (m *SyncManager) update(c *currencyPairSyncAgent, syncType syncItemType, err error) error {
 	if syncType < SyncItemTicker || syncType > SyncItemTrade {
@@ -442,6 +465,8 @@ func (m *SyncManager) worker(ctx context.Context) {
 	}
 	defer cleanup()
 
+	// Syncing must not delay order operations sharing the same rate limits
+	ctx = request.WithPriority(ctx, request.MarketDataPriority)
 	interval := min(greatestCommonDivisor(m.config.TimeoutWebsocket, m.config.TimeoutREST), minSyncInterval)
 	t := time.NewTicker(interval)
 
@@ -710,7 +735,7 @@ func (m *SyncManager) PrintTickerSummary(result *ticker.Price, protocol string,
 		return
 	}
 	if err != nil {
-		if err == common.ErrNotYetImplemented {
+		if err == common.ErrNotYetImplemented || errors.Is(err, request.ErrRequestShed) {
 			log.Warnf(log.SyncMgr, "Failed to get %s ticker. Error: %s",
 				protocol,
 				err)
@@ -797,6 +822,12 @@ func (m *SyncManager) PrintOrderbookSummary(result *orderbook.Book, protocol str
 		return
 	}
 	if err != nil {
+		if errors.Is(err, request.ErrRequestShed) {
+			log.Warnf(log.OrderBook, "Failed to get %s orderbook. Error: %s",
+				protocol,
+				err)
+			return
+		}
 		if result == nil {
 			log.Errorf(log.OrderBook, "Failed to get %s orderbook. Error: %s",
 				protocol,
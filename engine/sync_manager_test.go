@@ -16,6 +16,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
@@ -236,6 +237,8 @@ func TestPrintTickerSummary(t *testing.T) {
 	m.PrintTickerSummary(&ticker.Price{
 		Pair: currency.NewPair(currency.AUD, currency.USD),
 	}, "REST", common.ErrNotYetImplemented)
+
+	m.PrintTickerSummary(nil, "REST", request.ErrRequestShed)
 }
 
 func TestPrintOrderbookSummary(t *testing.T) {
@@ -279,6 +282,8 @@ func TestPrintOrderbookSummary(t *testing.T) {
 	}, "REST", errors.New("test"))
 
 	m.PrintOrderbookSummary(nil, "REST", errors.New("test"))
+
+	m.PrintOrderbookSummary(nil, "REST", request.ErrRequestShed)
 }
 
 func TestWaitForInitialSync(t *testing.T) {
@@ -346,3 +351,29 @@ func TestSyncManagerWebsocketUpdate(t *testing.T) {
 	err = m.WebsocketUpdate("", currency.EMPTYPAIR, asset.Spot, SyncItemTrade, errors.New("test"))
 	require.NoError(t, err)
 }
+
+func TestSyncManagerOldestOrderbookUpdate(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	_, ok := m.OldestOrderbookUpdate("test")
+	assert.False(t, ok, "OldestOrderbookUpdate should not return an update for a nil manager")
+
+	m = &SyncManager{config: config.SyncManagerConfig{SynchronizeOrderbook: true}}
+	m.started.Store(true)
+	m.initSyncCompleted.Store(true)
+	_, ok = m.OldestOrderbookUpdate("test")
+	assert.False(t, ok, "OldestOrderbookUpdate should not return an update without synced pairs")
+
+	tn := time.Now()
+	btc := m.add(key.NewExchangeAssetPair("test", asset.Spot, currency.NewBTCUSDT()), syncBase{})
+	eth := m.add(key.NewExchangeAssetPair("test", asset.Spot, currency.NewPair(currency.ETH, currency.USDT)), syncBase{})
+	m.add(key.NewExchangeAssetPair("other", asset.Spot, currency.NewBTCUSDT()), syncBase{HaveData: true, LastUpdated: tn.Add(-time.Hour)})
+	_, ok = m.OldestOrderbookUpdate("test")
+	assert.False(t, ok, "OldestOrderbookUpdate should ignore orderbooks without data")
+
+	btc.trackers[SyncItemOrderbook].HaveData, btc.trackers[SyncItemOrderbook].LastUpdated = true, tn
+	eth.trackers[SyncItemOrderbook].HaveData, eth.trackers[SyncItemOrderbook].LastUpdated = true, tn.Add(-time.Minute)
+	oldest, ok := m.OldestOrderbookUpdate("TEST")
+	require.True(t, ok, "OldestOrderbookUpdate must return an update")
+	assert.Equal(t, tn.Add(-time.Minute), oldest, "OldestOrderbookUpdate should return the least recent update of the exchange")
+}
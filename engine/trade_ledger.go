@@ -0,0 +1,581 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradeledger"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+var (
+	errInvalidTradeLedgerInterval  = errors.New("trade ledger check interval must be positive")
+	errInvalidTradeLedgerLookback  = errors.New("trade ledger lookback must be positive")
+	errInvalidTradeLedgerCurrency  = errors.New("trade ledger reporting currency must be a fiat currency")
+	errTradeLedgerDatabaseRequired = errors.New("trade ledger requires a database connection")
+	errNoTradeLedgerPrice          = errors.New("no historical spot market price")
+)
+
+// SetupTradeLedger applies configuration parameters before running. The
+// order manager and websocket routine manager fill subscriptions are optional
+func SetupTradeLedger(em iExchangeManager, om iOrderFillSubscriber, wrm iWebsocketFillSubscriber, dcm iDatabaseConnectionManager, cfg *config.TradeLedger) (*TradeLedger, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w TradeLedger", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidTradeLedgerInterval
+	}
+	if cfg.Lookback <= 0 {
+		return nil, errInvalidTradeLedgerLookback
+	}
+	if !cfg.ReportingCurrency.IsFiatCurrency() {
+		return nil, fmt.Errorf("%w: %q", errInvalidTradeLedgerCurrency, cfg.ReportingCurrency)
+	}
+	if _, err := ledger.ParseMethod(string(cfg.Method)); err != nil {
+		return nil, err
+	}
+	if _, _, err := ledger.TaxYear(time.Now().Year(), cfg.TaxYearStartMonth, cfg.TaxYearStartDay); err != nil {
+		return nil, err
+	}
+	db, err := tradeledger.Setup(dcm.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, errTradeLedgerDatabaseRequired
+	}
+	return &TradeLedger{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		orderFills:      om,
+		websocketFills:  wrm,
+		db:              db,
+		cfg:             *cfg,
+		fxRate: func(from, to currency.Code) (float64, error) {
+			return currency.GetForeignExchangeRate(currency.NewPair(from, to))
+		},
+		prices: make(map[tradeLedgerPriceKey]float64),
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *TradeLedger) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", TradeLedgerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", TradeLedgerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Trade ledger %s", MsgSubSystemStarting)
+	if m.orderFills != nil {
+		m.subscribe(ctx, "order manager", m.orderFills.SubscribeOrderFills)
+	}
+	if m.websocketFills != nil {
+		m.subscribe(ctx, "websocket", m.websocketFills.SubscribeFills)
+	}
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.PortfolioMgr, "Trade ledger %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *TradeLedger) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", TradeLedgerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", TradeLedgerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Trade ledger %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.PortfolioMgr, "Trade ledger %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *TradeLedger) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// GetFills returns the stored fills executed between the dates ordered by
+// time
+func (m *TradeLedger) GetFills(start, end time.Time) ([]ledger.Fill, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TradeLedgerName, ErrSubSystemNotStarted)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	fills, err := m.db.GetInRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return ledgerFillsFromDB(fills)
+}
+
+// Calculate matches every stored fill against the lots acquired using the
+// configured cost basis method, valued in the reporting currency
+func (m *TradeLedger) Calculate(ctx context.Context) (*ledger.Ledger, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TradeLedgerName, ErrSubSystemNotStarted)
+	}
+	stored, err := m.db.GetInRange(time.Time{}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	fills, err := ledgerFillsFromDB(stored)
+	if err != nil {
+		return nil, err
+	}
+	return ledger.Calculate(fills, &ledger.Params{
+		Method: m.cfg.Method,
+		Value: func(c currency.Code, at time.Time) (float64, error) {
+			return m.value(ctx, c, at)
+		},
+		IsCash: m.isCash,
+	})
+}
+
+// GetUnrealised returns the open lots valued at current prices
+func (m *TradeLedger) GetUnrealised(ctx context.Context) ([]ledger.UnrealisedLot, error) {
+	l, err := m.Calculate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return l.Unrealised(func(c currency.Code, at time.Time) (float64, error) {
+		return m.value(ctx, c, at)
+	}, time.Now())
+}
+
+// GetTaxReport returns the realised gains of the tax year starting in the
+// calendar year, and their CSV report
+func (m *TradeLedger) GetTaxReport(ctx context.Context, year int) (*TaxReport, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TradeLedgerName, ErrSubSystemNotStarted)
+	}
+	start, end, err := ledger.TaxYear(year, m.cfg.TaxYearStartMonth, m.cfg.TaxYearStartDay)
+	if err != nil {
+		return nil, err
+	}
+	l, err := m.Calculate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	s, err := l.Report(&buf, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &TaxReport{Year: year, Method: l.Method, Summary: *s, CSV: buf.Bytes()}, nil
+}
+
+func (m *TradeLedger) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.importOrderHistory(ctx, time.Now())
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// subscribe saves the fills received from a fill subscription until the
+// subsystem stops
+func (m *TradeLedger) subscribe(ctx context.Context, source string, subscribe func() (dispatch.Pipe, error)) {
+	pipe, err := subscribe()
+	if err != nil {
+		log.Warnf(log.PortfolioMgr, "Trade ledger cannot subscribe to %s fills: %v", source, err)
+		return
+	}
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer func() {
+			if err := pipe.Release(); err != nil {
+				log.Errorln(log.DispatchMgr, err)
+			}
+		}()
+		for {
+			select {
+			case <-m.shutdown:
+				return
+			case <-ctx.Done():
+				return
+			case data, ok := <-pipe.Channel():
+				if !ok {
+					return
+				}
+				m.save(source, m.fillsFromUpdate(data))
+			}
+		}
+	}()
+}
+
+// fillsFromUpdate converts an order manager or websocket fill update to
+// trade ledger fills
+func (m *TradeLedger) fillsFromUpdate(data any) []tradeledger.Fill {
+	switch d := data.(type) {
+	case *order.Detail:
+		return tradeLedgerFillsFromOrder(d, d.Account)
+	case []fill.Data:
+		return m.resolveAccounts(tradeLedgerFillsFromWebsocket(d))
+	default:
+		log.Errorln(log.PortfolioMgr, common.GetTypeAssertError("*order.Detail or []fill.Data", data))
+		return nil
+	}
+}
+
+// resolveAccounts sets the account of websocket fills, which their updates do
+// not include, from the order manager's order. Fills of other orders are only
+// kept when the exchange has no credential profiles, otherwise they would be
+// stored twice under different accounts, and are imported from the order
+// history instead
+func (m *TradeLedger) resolveAccounts(fills []tradeledger.Fill) []tradeledger.Fill {
+	resolved := fills[:0]
+	for i := range fills {
+		if m.orderFills != nil {
+			if d, err := m.orderFills.GetByExchangeAndID(fills[i].Exchange, fills[i].OrderID); err == nil {
+				fills[i].Account = d.Account
+				resolved = append(resolved, fills[i])
+				continue
+			}
+		}
+		if exch, err := m.exchangeManager.GetExchangeByName(fills[i].Exchange); err == nil && len(exch.GetCredentialProfiles()) == 0 {
+			resolved = append(resolved, fills[i])
+			continue
+		}
+		if m.cfg.Verbose {
+			log.Debugf(log.PortfolioMgr, "Trade ledger cannot resolve the account of %s order %s, its websocket fill will be imported from the order history", fills[i].Exchange, fills[i].OrderID)
+		}
+	}
+	return resolved
+}
+
+// importOrderHistory saves the spot fills of each authenticated exchange
+// account's order history within the lookback period
+func (m *TradeLedger) importOrderHistory(ctx context.Context, now time.Time) {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Trade ledger failed to get exchanges: %v", err)
+		return
+	}
+	for _, exch := range exchs {
+		if !exch.IsRESTAuthenticationSupported() {
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(asset.Spot)
+		if err != nil || len(pairs) == 0 {
+			continue
+		}
+		for _, account := range exchangeAccounts(exch) {
+			if err := ctx.Err(); err != nil {
+				return
+			}
+			orders, err := exch.GetOrderHistory(accounts.DeployAccountToContext(ctx, account), &order.MultiOrderRequest{
+				Pairs:     pairs,
+				AssetType: asset.Spot,
+				Type:      order.AnyType,
+				Side:      order.AnySide,
+				StartTime: now.Add(-m.cfg.Lookback),
+				EndTime:   now,
+			})
+			switch {
+			case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented):
+				if m.cfg.Verbose {
+					log.Debugf(log.PortfolioMgr, "Trade ledger cannot get %s order history: %v", exch.GetName(), err)
+				}
+				continue
+			case err != nil:
+				log.Errorf(log.PortfolioMgr, "Trade ledger failed to get %s account %q order history: %v", exch.GetName(), account, err)
+				continue
+			}
+			var fills []tradeledger.Fill
+			for i := range orders {
+				fills = append(fills, tradeLedgerFillsFromOrder(&orders[i], account)...)
+			}
+			m.save(exch.GetName()+" order history", fills)
+		}
+	}
+}
+
+// save stores fills in the database
+func (m *TradeLedger) save(source string, fills []tradeledger.Fill) {
+	if len(fills) == 0 {
+		return
+	}
+	if err := m.db.Upsert(fills...); err != nil {
+		log.Errorf(log.PortfolioMgr, "Trade ledger failed to save %d %s fills: %v", len(fills), source, err)
+		return
+	}
+	if m.cfg.Verbose {
+		log.Debugf(log.PortfolioMgr, "Trade ledger saved %d %s fills", len(fills), source)
+	}
+}
+
+// isCash reports currencies which are not tracked as lots
+func (m *TradeLedger) isCash(c currency.Code) bool {
+	return c.IsFiatCurrency() || usdStablecoins.Contains(c)
+}
+
+// value returns the price of a currency in the reporting currency at a point
+// in time. Fiat currencies and USD stablecoins are converted with current
+// foreign exchange rates, other currencies with the hourly close of the first
+// spot market found against the configured quote currencies, or its ticker
+// within the last hour
+func (m *TradeLedger) value(ctx context.Context, c currency.Code, at time.Time) (float64, error) {
+	rate, err := fiatConversionRate(m.fxRate, c, m.cfg.ReportingCurrency)
+	if err == nil {
+		return rate, nil
+	}
+	hour := at.UTC().Truncate(time.Hour)
+	current := time.Since(at) < time.Hour
+	key := tradeLedgerPriceKey{currency: c.Item, hour: hour}
+	if !current {
+		m.mu.Lock()
+		price, ok := m.prices[key]
+		m.mu.Unlock()
+		if ok {
+			return price, nil
+		}
+	}
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return 0, err
+	}
+	exchs = slices.Clone(exchs)
+	slices.SortFunc(exchs, func(a, b exchange.IBotExchange) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	var errs error
+	rates := make([]float64, len(m.cfg.QuoteCurrencies))
+	for i, q := range m.cfg.QuoteCurrencies {
+		if rates[i], err = fiatConversionRate(m.fxRate, q, m.cfg.ReportingCurrency); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	for _, exch := range exchs {
+		avail, err := exch.GetAvailablePairs(asset.Spot)
+		if err != nil {
+			continue
+		}
+		for i, q := range m.cfg.QuoteCurrencies {
+			if rates[i] == 0 {
+				continue
+			}
+			p := currency.NewPair(c, q)
+			idx := slices.IndexFunc(avail, p.Equal)
+			if idx == -1 {
+				continue
+			}
+			var price float64
+			if current {
+				price, err = carryTickerPrice(ctx, exch, avail[idx], asset.Spot)
+			} else {
+				price, err = tradeLedgerCandlePrice(ctx, exch, avail[idx], hour)
+			}
+			if err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s: %w", exch.GetName(), p, err))
+				continue
+			}
+			price *= rates[i]
+			if !current {
+				m.mu.Lock()
+				m.prices[key] = price
+				m.mu.Unlock()
+			}
+			return price, nil
+		}
+	}
+	if errs != nil {
+		return 0, errs
+	}
+	return 0, fmt.Errorf("%w for %s at %s", errNoTradeLedgerPrice, c, hour)
+}
+
+// tradeLedgerCandlePrice returns the close of a pair's hourly candle
+func tradeLedgerCandlePrice(ctx context.Context, exch exchange.IBotExchange, p currency.Pair, hour time.Time) (float64, error) {
+	k, err := exch.GetHistoricCandles(ctx, p, asset.Spot, kline.OneHour, hour, hour.Add(time.Hour))
+	if err != nil {
+		return 0, err
+	}
+	for i := range k.Candles {
+		if k.Candles[i].Close > 0 {
+			return k.Candles[i].Close, nil
+		}
+	}
+	return 0, fmt.Errorf("%w at %s", errNoTradeLedgerPrice, hour)
+}
+
+// tradeLedgerFillsFromOrder returns the fills of a spot order, one per trade
+// when every trade is identified, otherwise one for the order's executed
+// amount
+func tradeLedgerFillsFromOrder(d *order.Detail, account string) []tradeledger.Fill {
+	if d == nil || d.AssetType != asset.Spot || d.Pair.IsEmpty() || d.OrderID == "" {
+		return nil
+	}
+	side, ok := tradeLedgerSide(d.Side)
+	if !ok {
+		return nil
+	}
+	base := tradeledger.Fill{
+		Exchange: d.Exchange,
+		Account:  account,
+		OrderID:  d.OrderID,
+		Base:     d.Pair.Base.String(),
+		Quote:    d.Pair.Quote.String(),
+		Side:     side,
+	}
+	if len(d.Trades) > 0 && !slices.ContainsFunc(d.Trades, func(t order.TradeHistory) bool { return t.TID == "" }) {
+		fills := make([]tradeledger.Fill, 0, len(d.Trades))
+		for i := range d.Trades {
+			t := &d.Trades[i]
+			if t.Price <= 0 || t.Amount <= 0 {
+				continue
+			}
+			f := base
+			if s, ok := tradeLedgerSide(t.Side); ok {
+				f.Side = s
+			}
+			f.TradeID = t.TID
+			f.Price = t.Price
+			f.Amount = t.Amount
+			f.Fee = t.Fee
+			f.FeeCurrency = t.FeeAsset
+			if f.FeeCurrency == "" {
+				f.FeeCurrency = d.FeeAsset.String()
+			}
+			f.Timestamp = t.Timestamp
+			if f.Timestamp.IsZero() {
+				f.Timestamp = tradeLedgerOrderTime(d)
+			}
+			fills = append(fills, f)
+		}
+		return fills
+	}
+	price := d.AverageExecutedPrice
+	if price <= 0 {
+		price = d.Price
+	}
+	if d.ExecutedAmount <= 0 || price <= 0 {
+		return nil
+	}
+	base.Price = price
+	base.Amount = d.ExecutedAmount
+	base.Fee = d.Fee
+	base.FeeCurrency = d.FeeAsset.String()
+	base.Timestamp = tradeLedgerOrderTime(d)
+	return []tradeledger.Fill{base}
+}
+
+// tradeLedgerFillsFromWebsocket returns the identified spot fills of a
+// websocket update. Websocket fills do not include fees, which are imported
+// from the order history
+func tradeLedgerFillsFromWebsocket(data []fill.Data) []tradeledger.Fill {
+	var fills []tradeledger.Fill
+	for i := range data {
+		d := &data[i]
+		side, ok := tradeLedgerSide(d.Side)
+		if !ok || d.AssetType != asset.Spot || d.CurrencyPair.IsEmpty() || d.OrderID == "" || d.TradeID == "" || d.Price <= 0 || d.Amount <= 0 {
+			continue
+		}
+		fills = append(fills, tradeledger.Fill{
+			Exchange:  d.Exchange,
+			OrderID:   d.OrderID,
+			TradeID:   d.TradeID,
+			Base:      d.CurrencyPair.Base.String(),
+			Quote:     d.CurrencyPair.Quote.String(),
+			Side:      side,
+			Price:     d.Price,
+			Amount:    d.Amount,
+			Timestamp: d.Timestamp,
+		})
+	}
+	return fills
+}
+
+// tradeLedgerSide returns the stored side of a buy or sell
+func tradeLedgerSide(s order.Side) (string, bool) {
+	switch {
+	case s.IsLong():
+		return order.Buy.String(), true
+	case s.IsShort():
+		return order.Sell.String(), true
+	default:
+		return "", false
+	}
+}
+
+// tradeLedgerOrderTime returns when an order was last filled
+func tradeLedgerOrderTime(d *order.Detail) time.Time {
+	switch {
+	case !d.CloseTime.IsZero():
+		return d.CloseTime
+	case !d.LastUpdated.IsZero():
+		return d.LastUpdated
+	default:
+		return d.Date
+	}
+}
+
+func ledgerFillsFromDB(fills []tradeledger.Fill) ([]ledger.Fill, error) {
+	resp := make([]ledger.Fill, len(fills))
+	for i := range fills {
+		f := &fills[i]
+		side, err := order.StringToOrderSide(f.Side)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = ledger.Fill{
+			Exchange: f.Exchange,
+			Account:  f.Account,
+			OrderID:  f.OrderID,
+			TradeID:  f.TradeID,
+			Pair:     currency.NewPair(currency.NewCode(f.Base), currency.NewCode(f.Quote)),
+			Side:     side,
+			Price:    f.Price,
+			Amount:   f.Amount,
+			Fee:      f.Fee,
+			Time:     f.Timestamp,
+		}
+		if f.FeeCurrency != "" {
+			resp[i].FeeCurrency = currency.NewCode(f.FeeCurrency)
+		}
+	}
+	return resp, nil
+}
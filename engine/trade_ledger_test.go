@@ -0,0 +1,357 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradeledger"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+var (
+	testTradeLedgerConfig = config.TradeLedger{
+		CheckInterval:     time.Hour,
+		Lookback:          30 * 24 * time.Hour,
+		Method:            ledger.FIFO,
+		ReportingCurrency: currency.USD,
+		QuoteCurrencies:   currency.Currencies{currency.USD, currency.USDT},
+		TaxYearStartMonth: time.January,
+		TaxYearStartDay:   1,
+	}
+	testLedgerTime = time.Date(2026, 9, 1, 12, 30, 0, 0, time.UTC)
+)
+
+type ledgerExchange struct {
+	exchange.IBotExchange
+	orders   []order.Detail
+	candles  map[string]float64
+	tickers  map[string]float64
+	profiles []string
+}
+
+func (l *ledgerExchange) GetName() string { return "Binance" }
+
+func (l *ledgerExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (l *ledgerExchange) GetCredentialProfiles() []string { return l.profiles }
+
+func (l *ledgerExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return l.GetAvailablePairs(asset.Spot)
+}
+
+func (l *ledgerExchange) GetAvailablePairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{currency.NewPair(currency.BTC, currency.USDT), currency.NewPair(currency.ETH, currency.USDT), currency.NewPair(currency.ETH, currency.BTC)}, nil
+}
+
+func (l *ledgerExchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req.AssetType != asset.Spot || len(req.Pairs) == 0 {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return l.orders, nil
+}
+
+func (l *ledgerExchange) GetHistoricCandles(_ context.Context, p currency.Pair, _ asset.Item, _ kline.Interval, start, _ time.Time) (*kline.Item, error) {
+	price, ok := l.candles[p.String()]
+	if !ok {
+		return nil, kline.ErrNoTimeSeriesDataToConvert
+	}
+	return &kline.Item{Pair: p, Candles: []kline.Candle{{Time: start, Close: price}}}, nil
+}
+
+func (l *ledgerExchange) UpdateTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	price, ok := l.tickers[p.String()]
+	if !ok {
+		return nil, ticker.ErrTickerNotFound
+	}
+	return &ticker.Price{Last: price, Pair: p, AssetType: a}, nil
+}
+
+// ledgerOrders maps the order IDs known to the order manager to their account
+type ledgerOrders map[string]string
+
+func (l ledgerOrders) SubscribeOrderFills() (dispatch.Pipe, error) {
+	return dispatch.Pipe{}, ErrNilSubsystem
+}
+
+func (l ledgerOrders) GetByExchangeAndID(exchangeName, id string) (*order.Detail, error) {
+	account, ok := l[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	return &order.Detail{Exchange: exchangeName, OrderID: id, Account: account}, nil
+}
+
+type ledgerDB struct {
+	mu    sync.Mutex
+	fills []tradeledger.Fill
+}
+
+func (l *ledgerDB) Upsert(fills ...tradeledger.Fill) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, f := range fills {
+		i := -1
+		for j := range l.fills {
+			if l.fills[j].Exchange == f.Exchange && l.fills[j].Account == f.Account && l.fills[j].OrderID == f.OrderID && l.fills[j].TradeID == f.TradeID {
+				i = j
+			}
+		}
+		if i == -1 {
+			l.fills = append(l.fills, f)
+		} else if f.Fee != 0 {
+			l.fills[i] = f
+		}
+	}
+	return nil
+}
+
+func (l *ledgerDB) GetInRange(start, end time.Time) ([]tradeledger.Fill, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var resp []tradeledger.Fill
+	for i := range l.fills {
+		if !l.fills[i].Timestamp.Before(start) && !l.fills[i].Timestamp.After(end) {
+			resp = append(resp, l.fills[i])
+		}
+	}
+	return resp, nil
+}
+
+func (l *ledgerDB) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.fills)
+}
+
+func newTestTradeLedger(t *testing.T) (*TradeLedger, *ledgerDB) {
+	t.Helper()
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	exch := &ledgerExchange{
+		orders: []order.Detail{
+			{Exchange: "Binance", OrderID: "1", AssetType: asset.Spot, Pair: btcusdt, Side: order.Buy, ExecutedAmount: 1, Date: testLedgerTime, Trades: []order.TradeHistory{
+				{TID: "11", Price: 10000, Amount: 0.4, Fee: 4, FeeAsset: "USDT", Timestamp: testLedgerTime},
+				{TID: "12", Price: 10000, Amount: 0.6, Fee: 6, FeeAsset: "USDT", Timestamp: testLedgerTime},
+			}},
+			{Exchange: "Binance", OrderID: "2", AssetType: asset.Spot, Pair: btcusdt, Side: order.Sell, AverageExecutedPrice: 20000, Price: 19000, ExecutedAmount: 0.5, Fee: 10, FeeAsset: currency.USDT, LastUpdated: testLedgerTime.Add(48 * time.Hour)},
+			{Exchange: "Binance", OrderID: "3", AssetType: asset.Spot, Pair: currency.NewPair(currency.ETH, currency.BTC), Side: order.Bid, Price: 0.05, ExecutedAmount: 1, CloseTime: testLedgerTime.Add(24 * time.Hour)},
+			{Exchange: "Binance", OrderID: "4", AssetType: asset.Spot, Pair: btcusdt, Side: order.Buy, Price: 10000, Amount: 1},
+			{Exchange: "Binance", OrderID: "5", AssetType: asset.Futures, Pair: btcusdt, Side: order.Long, Price: 10000, ExecutedAmount: 1},
+		},
+		candles: map[string]float64{"BTCUSDT": 20000},
+		tickers: map[string]float64{"BTCUSDT": 30000, "ETHUSDT": 1500},
+	}
+	db := &ledgerDB{}
+	m := &TradeLedger{
+		shutdown:        make(chan struct{}),
+		exchangeManager: rebalanceExchangeManager{"Binance": exch},
+		db:              db,
+		cfg:             testTradeLedgerConfig,
+		fxRate: func(currency.Code, currency.Code) (float64, error) {
+			return 0, errNoFiatRate
+		},
+		prices: make(map[tradeLedgerPriceKey]float64),
+	}
+	return m, db
+}
+
+func TestSetupTradeLedger(t *testing.T) {
+	t.Parallel()
+	_, err := SetupTradeLedger(nil, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+	dcm := (*DatabaseConnectionManager)(nil)
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &config.TradeLedger{})
+	assert.ErrorIs(t, err, errInvalidTradeLedgerInterval)
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &config.TradeLedger{CheckInterval: time.Hour})
+	assert.ErrorIs(t, err, errInvalidTradeLedgerLookback)
+	cfg := testTradeLedgerConfig
+	cfg.ReportingCurrency = currency.USDT
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &cfg)
+	assert.ErrorIs(t, err, errInvalidTradeLedgerCurrency)
+	cfg = testTradeLedgerConfig
+	cfg.Method = "average"
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &cfg)
+	assert.ErrorIs(t, err, ledger.ErrInvalidMethod)
+	cfg = testTradeLedgerConfig
+	cfg.TaxYearStartMonth = 0
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &cfg)
+	assert.Error(t, err, "SetupTradeLedger should error on an invalid tax year start")
+	_, err = SetupTradeLedger(&ExchangeManager{}, nil, nil, dcm, &testTradeLedgerConfig)
+	assert.ErrorIs(t, err, errTradeLedgerDatabaseRequired)
+}
+
+func TestTradeLedgerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *TradeLedger
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	wrm, err := setupWebsocketRoutineManager(NewExchangeManager(), &OrderManager{}, &SyncManager{}, &currency.Config{CurrencyPairFormat: &currency.PairFormat{}}, false)
+	require.NoError(t, err, "setupWebsocketRoutineManager must not error")
+	m, db := newTestTradeLedger(t)
+	m.orderFills = (*OrderManager)(nil)
+	m.websocketFills = wrm
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning())
+	require.Eventually(t, func() bool { return db.len() == 4 }, time.Second, 10*time.Millisecond, "order history must be imported on start")
+
+	require.NoError(t, wrm.websocketDataHandler("Binance", []fill.Data{{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: currency.NewPair(currency.BTC, currency.USDT), Side: order.Sell, OrderID: "6", TradeID: "61", Price: 25000, Amount: 0.1, Timestamp: testLedgerTime}}))
+	assert.Eventually(t, func() bool { return db.len() == 5 }, time.Second, 10*time.Millisecond, "websocket fills must be saved")
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestTradeLedgerFillsFromOrder(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, tradeLedgerFillsFromOrder(nil, ""))
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	d := &order.Detail{Exchange: "Binance", OrderID: "1", AssetType: asset.Spot, Pair: btcusdt, Side: order.Ask, ExecutedAmount: 2, Price: 100, Fee: 1, FeeAsset: currency.BNB, LastUpdated: testLedgerTime, Trades: []order.TradeHistory{
+		{TID: "11", Price: 100, Amount: 1},
+		{Price: 100, Amount: 1},
+	}}
+	fills := tradeLedgerFillsFromOrder(d, "main")
+	require.Len(t, fills, 1, "orders with unidentified trades must be imported at the order level")
+	assert.Equal(t, tradeledger.Fill{Exchange: "Binance", Account: "main", OrderID: "1", Base: "BTC", Quote: "USDT", Side: "SELL", Price: 100, Amount: 2, Fee: 1, FeeCurrency: "BNB", Timestamp: testLedgerTime}, fills[0])
+
+	d.Trades[1].TID = "12"
+	d.Trades[1].Fee = 0.1
+	fills = tradeLedgerFillsFromOrder(d, "")
+	require.Len(t, fills, 2, "orders with identified trades must be imported per trade")
+	assert.Equal(t, "12", fills[1].TradeID)
+	assert.Equal(t, "BNB", fills[1].FeeCurrency, "the order fee currency should be used when a trade has none")
+	assert.Equal(t, testLedgerTime, fills[1].Timestamp, "the order time should be used when a trade has none")
+
+	d.Side = order.AnySide
+	assert.Nil(t, tradeLedgerFillsFromOrder(d, ""), "orders without a buy or sell side must be ignored")
+
+	m, _ := newTestTradeLedger(t)
+	assert.Len(t, m.fillsFromUpdate([]fill.Data{
+		{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: btcusdt, Side: order.Buy, OrderID: "1", TradeID: "11", Price: 1, Amount: 1},
+		{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: btcusdt, Side: order.Buy, OrderID: "1", Price: 1, Amount: 1},
+	}), 1, "websocket fills without a trade ID must be ignored")
+	assert.Nil(t, m.fillsFromUpdate("bad"))
+}
+
+func TestTradeLedgerResolveAccounts(t *testing.T) {
+	t.Parallel()
+	m, db := newTestTradeLedger(t)
+	exch, err := m.exchangeManager.GetExchangeByName("Binance")
+	require.NoError(t, err, "GetExchangeByName must not error")
+	exch.(*ledgerExchange).profiles = []string{"main"}
+	m.orderFills = ledgerOrders{"1": "main"}
+
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	fills := m.fillsFromUpdate([]fill.Data{
+		{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: btcusdt, Side: order.Buy, OrderID: "1", TradeID: "11", Price: 10000, Amount: 0.4, Timestamp: testLedgerTime},
+		{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: btcusdt, Side: order.Buy, OrderID: "6", TradeID: "61", Price: 10000, Amount: 1, Timestamp: testLedgerTime},
+	})
+	require.Len(t, fills, 1, "fills of orders with an unknown account must be left to the order history import")
+	assert.Equal(t, "main", fills[0].Account, "the account should be resolved from the order manager")
+
+	require.NoError(t, db.Upsert(fills...), "Upsert must not error")
+	m.importOrderHistory(t.Context(), time.Now())
+	stored, err := db.GetInRange(time.Time{}, time.Now())
+	require.NoError(t, err, "GetInRange must not error")
+	var trade11 int
+	for i := range stored {
+		if stored[i].OrderID == "1" && stored[i].TradeID == "11" && stored[i].Account == "main" {
+			trade11++
+		}
+	}
+	assert.Equal(t, 1, trade11, "a websocket fill should not be duplicated by the order history import")
+
+	exch.(*ledgerExchange).profiles = nil
+	m.orderFills = nil
+	fills = m.fillsFromUpdate([]fill.Data{{Exchange: "Binance", AssetType: asset.Spot, CurrencyPair: btcusdt, Side: order.Buy, OrderID: "6", TradeID: "61", Price: 10000, Amount: 1}})
+	require.Len(t, fills, 1, "fills must be kept when the default account is the only account")
+	assert.Empty(t, fills[0].Account)
+}
+
+func TestTradeLedgerCalculate(t *testing.T) {
+	t.Parallel()
+	m, db := newTestTradeLedger(t)
+	_, err := m.GetFills(testLedgerTime, testLedgerTime)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.importOrderHistory(t.Context(), time.Now())
+	require.Equal(t, 4, db.len(), "the spot fills of executed orders must be saved")
+	m.started.Store(true)
+	_, err = m.GetFills(testLedgerTime.Add(time.Hour), testLedgerTime)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+	fills, err := m.GetFills(testLedgerTime, testLedgerTime.Add(time.Hour))
+	require.NoError(t, err, "GetFills must not error")
+	require.Len(t, fills, 2)
+	assert.Equal(t, order.Buy, fills[0].Side)
+	assert.True(t, fills[0].FeeCurrency.Equal(currency.USDT))
+
+	l, err := m.Calculate(t.Context())
+	require.NoError(t, err, "Calculate must not error")
+	require.Len(t, l.Disposals, 3)
+	assert.InDelta(t, 499.5, l.Disposals[0].Gain, 1e-9, "BTC spent on ETH should be valued at the hourly close")
+	assert.InDelta(t, 4985, l.Disposals[1].Gain+l.Disposals[2].Gain, 1e-9)
+
+	lots, err := m.GetUnrealised(t.Context())
+	require.NoError(t, err, "GetUnrealised must not error")
+	require.Len(t, lots, 2)
+	var gain float64
+	for i := range lots {
+		gain += lots[i].Gain
+	}
+	assert.InDelta(t, 8995.5+500, gain, 1e-9, "open lots should be valued at the current ticker price")
+
+	report, err := m.GetTaxReport(t.Context(), 2026)
+	require.NoError(t, err, "GetTaxReport must not error")
+	assert.Equal(t, ledger.FIFO, report.Method)
+	assert.Equal(t, 3, report.Summary.Disposals)
+	assert.InDelta(t, 5484.5, report.Summary.Gain, 1e-9)
+	records, err := csv.NewReader(bytes.NewReader(report.CSV)).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	assert.Len(t, records, 5)
+
+	report, err = m.GetTaxReport(t.Context(), 2025)
+	require.NoError(t, err, "GetTaxReport must not error")
+	assert.Zero(t, report.Summary.Disposals)
+}
+
+func TestTradeLedgerValue(t *testing.T) {
+	t.Parallel()
+	m, _ := newTestTradeLedger(t)
+	v, err := m.value(t.Context(), currency.USDT, testLedgerTime)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, v)
+	v, err = m.value(t.Context(), currency.BTC, testLedgerTime)
+	require.NoError(t, err)
+	assert.Equal(t, 20000.0, v)
+	assert.Len(t, m.prices, 1, "historical prices must be cached")
+	v, err = m.value(t.Context(), currency.BTC, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 30000.0, v)
+	_, err = m.value(t.Context(), currency.ETH, testLedgerTime)
+	assert.ErrorIs(t, err, kline.ErrNoTimeSeriesDataToConvert)
+	_, err = m.value(t.Context(), currency.XRP, testLedgerTime)
+	assert.ErrorIs(t, err, errNoTradeLedgerPrice)
+}
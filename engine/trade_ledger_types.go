@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradeledger"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+// TradeLedgerName is an exported subsystem name
+const TradeLedgerName = "trade_ledger"
+
+// TradeLedger imports spot fills from the order history of exchanges and from
+// websocket and order manager fill updates, saving them to the database. Cost
+// basis and realised and unrealised gains are calculated per lot from the
+// stored fills
+type TradeLedger struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager iExchangeManager
+	orderFills      iOrderFillSubscriber
+	websocketFills  iWebsocketFillSubscriber
+	db              tradeledger.IDBService
+	cfg             config.TradeLedger
+	// fxRate returns the rate converting between fiat currencies
+	fxRate func(from, to currency.Code) (float64, error)
+
+	mu     sync.Mutex
+	prices map[tradeLedgerPriceKey]float64
+}
+
+// iOrderFillSubscriber limits exposure of the order manager to its fills and
+// the orders they belong to
+type iOrderFillSubscriber interface {
+	SubscribeOrderFills() (dispatch.Pipe, error)
+	GetByExchangeAndID(exchangeName, id string) (*order.Detail, error)
+}
+
+// iWebsocketFillSubscriber limits exposure of the websocket routine manager
+// to its fills
+type iWebsocketFillSubscriber interface {
+	SubscribeFills() (dispatch.Pipe, error)
+}
+
+// tradeLedgerPriceKey caches the historical price of a currency for an hour
+type tradeLedgerPriceKey struct {
+	currency *currency.Item
+	hour     time.Time
+}
+
+// TaxReport is the realised gains of a tax year and their CSV report
+type TaxReport struct {
+	Year    int
+	Method  ledger.Method
+	Summary ledger.Summary
+	CSV     []byte
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
@@ -33,12 +34,26 @@ func setupWebsocketRoutineManager(exchangeManager iExchangeManager, orderManager
 	if cfg.CurrencyPairFormat == nil {
 		return nil, errNilCurrencyPairFormat
 	}
+	tradeMux := dispatch.GetNewMux(nil)
+	tradeID, err := tradeMux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	fillMux := dispatch.GetNewMux(nil)
+	fillID, err := fillMux.GetID()
+	if err != nil {
+		return nil, err
+	}
 	man := &WebsocketRoutineManager{
 		verbose:         verbose,
 		exchangeManager: exchangeManager,
 		orderManager:    orderManager,
 		syncer:          syncer,
 		currencyConfig:  cfg,
+		tradeMux:        tradeMux,
+		tradeID:         tradeID,
+		fillMux:         fillMux,
+		fillID:          fillID,
 	}
 	return man, man.registerWebsocketDataHandler(man.websocketDataHandler, false)
 }
@@ -361,14 +376,21 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data any
 		if m.verbose {
 			log.Debugf(log.WebsocketMgr, "%s %+v", exchName, d)
 		}
-	case []trade.Data, trade.Data:
+	case []trade.Data:
+		if m.verbose {
+			log.Infof(log.Trade, "%+v", d)
+		}
+		m.publishTrades(d)
+	case trade.Data:
 		if m.verbose {
 			log.Infof(log.Trade, "%+v", d)
 		}
+		m.publishTrades([]trade.Data{d})
 	case []fill.Data:
 		if m.verbose {
 			log.Infof(log.Fill, "%+v", d)
 		}
+		m.publishFills(d)
 	default:
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr, "%s websocket Unknown type: %+v", exchName, d)
@@ -377,6 +399,44 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data any
 	return nil
 }
 
+// publishTrades relays websocket trades to trade subscribers
+func (m *WebsocketRoutineManager) publishTrades(trades []trade.Data) {
+	if m.tradeMux == nil || len(trades) == 0 {
+		return
+	}
+	if err := m.tradeMux.Publish(trades, m.tradeID); err != nil {
+		log.Errorf(log.WebsocketMgr, "Cannot publish trades: %v", err)
+	}
+}
+
+// SubscribeTrades returns a pipe which receives the trades, as []trade.Data,
+// processed from all exchange websocket connections
+func (m *WebsocketRoutineManager) SubscribeTrades() (dispatch.Pipe, error) {
+	if m == nil {
+		return dispatch.Pipe{}, fmt.Errorf("websocket routine manager %w", ErrNilSubsystem)
+	}
+	return m.tradeMux.Subscribe(m.tradeID)
+}
+
+// publishFills relays websocket fills to fill subscribers
+func (m *WebsocketRoutineManager) publishFills(fills []fill.Data) {
+	if m.fillMux == nil || len(fills) == 0 {
+		return
+	}
+	if err := m.fillMux.Publish(fills, m.fillID); err != nil {
+		log.Errorf(log.WebsocketMgr, "Cannot publish fills: %v", err)
+	}
+}
+
+// SubscribeFills returns a pipe which receives the fills, as []fill.Data,
+// processed from all exchange websocket connections
+func (m *WebsocketRoutineManager) SubscribeFills() (dispatch.Pipe, error) {
+	if m == nil {
+		return dispatch.Pipe{}, fmt.Errorf("websocket routine manager %w", ErrNilSubsystem)
+	}
+	return m.fillMux.Subscribe(m.fillID)
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func (m *WebsocketRoutineManager) FormatCurrency(p currency.Pair) currency.Pair {
@@ -10,12 +10,15 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 func TestWebsocketRoutineManagerSetup(t *testing.T) {
@@ -337,3 +340,51 @@ func TestSetWebsocketDataHandler(t *testing.T) {
 		t.Fatal("unexpected data handler count")
 	}
 }
+
+func TestSubscribeTrades(t *testing.T) {
+	t.Parallel()
+	_, err := (*WebsocketRoutineManager)(nil).SubscribeTrades()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	m, err := setupWebsocketRoutineManager(NewExchangeManager(), &OrderManager{}, &SyncManager{}, &currency.Config{CurrencyPairFormat: &currency.PairFormat{}}, false)
+	require.NoError(t, err, "setupWebsocketRoutineManager must not error")
+	pipe, err := m.SubscribeTrades()
+	require.NoError(t, err, "SubscribeTrades must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	require.NoError(t, m.websocketDataHandler("test", trade.Data{Exchange: "test", Price: 1}), "websocketDataHandler must not error")
+	select {
+	case data := <-pipe.Channel():
+		trades, ok := data.([]trade.Data)
+		require.True(t, ok, "Trades must be []trade.Data")
+		require.Len(t, trades, 1, "Trades must contain the published trade")
+		assert.Equal(t, 1.0, trades[0].Price, "Trade price should match")
+	case <-time.After(time.Second):
+		require.Fail(t, "Trades must be published")
+	}
+}
+
+func TestSubscribeFills(t *testing.T) {
+	t.Parallel()
+	_, err := (*WebsocketRoutineManager)(nil).SubscribeFills()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	m, err := setupWebsocketRoutineManager(NewExchangeManager(), &OrderManager{}, &SyncManager{}, &currency.Config{CurrencyPairFormat: &currency.PairFormat{}}, false)
+	require.NoError(t, err, "setupWebsocketRoutineManager must not error")
+	pipe, err := m.SubscribeFills()
+	require.NoError(t, err, "SubscribeFills must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	require.NoError(t, m.websocketDataHandler("test", []fill.Data{{Exchange: "test", Price: 1}}), "websocketDataHandler must not error")
+	select {
+	case data := <-pipe.Channel():
+		fills, ok := data.([]fill.Data)
+		require.True(t, ok, "Fills must be []fill.Data")
+		require.Len(t, fills, 1, "Fills must contain the published fill")
+		assert.Equal(t, 1.0, fills[0].Price, "Fill price should match")
+	case <-time.After(time.Second):
+		require.Fail(t, "Fills must be published")
+	}
+}
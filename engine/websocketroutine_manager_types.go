@@ -6,7 +6,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 )
 
 var (
@@ -36,6 +38,10 @@ type WebsocketRoutineManager struct {
 	shutdown         chan struct{}
 	connectionCancel context.CancelFunc
 	dataHandlers     []WebsocketDataHandler
+	tradeMux         *dispatch.Mux
+	tradeID          uuid.UUID
+	fillMux          *dispatch.Mux
+	fillID           uuid.UUID
 	wg               sync.WaitGroup
 	mu               sync.RWMutex
 }
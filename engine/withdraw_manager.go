@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -27,7 +28,8 @@ func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bo
 }
 
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. When a withdrawal policy is set, requests are passed to it instead
+// and only submitted once it allows them
 func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
 	if m == nil {
 		return nil, ErrNilSubsystem
@@ -35,12 +37,62 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	if req == nil {
 		return nil, withdraw.ErrRequestCannotBeNil
 	}
+	if p := m.policy.Load(); p != nil && !m.isDryRun {
+		if _, err := m.validate(req); err != nil {
+			return nil, err
+		}
+		return p.Request(ctx, req)
+	}
+	return m.submit(ctx, req)
+}
+
+// SetPolicy sets the withdrawal policy which requests are passed to. Once set
+// withdrawals are refused while the policy is not running
+func (m *WithdrawManager) SetPolicy(p *WithdrawalPolicyManager) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if p == nil {
+		return fmt.Errorf("%w WithdrawalPolicyManager", common.ErrNilPointer)
+	}
+	m.policy.Store(p)
+	return nil
+}
 
+// validate returns the exchange of a withdrawal request after checking it
+// can withdraw the currency and, unless in dry run, that a crypto destination
+// is whitelisted and supports the exchange
+func (m *WithdrawManager) validate(req *withdraw.Request) (exchange.IBotExchange, error) {
 	exch, err := m.exchangeManager.GetExchangeByName(req.Exchange)
 	if err != nil {
 		return nil, err
 	}
 
+	// Determines if the currency can be withdrawn from the exchange
+	errF := exch.CanWithdraw(req.Currency, asset.Spot)
+	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
+		return nil, errF
+	}
+
+	if !m.isDryRun && req.Type == withdraw.Crypto {
+		if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
+			return nil, withdraw.ErrStrAddressNotWhiteListed
+		}
+		if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
+			return nil, withdraw.ErrStrExchangeNotSupportedByAddress
+		}
+	}
+	return exch, nil
+}
+
+// submit validates and submits a withdrawal request to its exchange, storing
+// the response
+func (m *WithdrawManager) submit(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	exch, err := m.validate(req)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &withdraw.Response{
 		Exchange: withdraw.ExchangeResponse{
 			Name: req.Exchange,
@@ -48,12 +100,6 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		RequestDetails: *req,
 	}
 
-	// Determines if the currency can be withdrawn from the exchange
-	errF := exch.CanWithdraw(req.Currency, asset.Spot)
-	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
-		return nil, errF
-	}
-
 	if m.isDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
@@ -61,14 +107,6 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
 		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
-			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
-				return nil, withdraw.ErrStrAddressNotWhiteListed
-			}
-			if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
-				return nil, withdraw.ErrStrExchangeNotSupportedByAddress
-			}
-		}
 		switch req.Type {
 		case withdraw.Fiat:
 			ret, err = exch.WithdrawFiatFunds(ctx, req)
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"sync/atomic"
 )
 
 // ErrWithdrawRequestNotFound message to display when no record is found
@@ -13,4 +14,5 @@ type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
 	isDryRun         bool
+	policy           atomic.Pointer[WithdrawalPolicyManager]
 }
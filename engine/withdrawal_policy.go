@@ -0,0 +1,501 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errInvalidWithdrawalPolicyInterval = errors.New("withdrawal policy check interval must be positive")
+	errInvalidWithdrawalApprovalTime   = errors.New("withdrawal policy approval timeout must be positive")
+	errWithdrawalDailyLimit            = errors.New("withdrawal exceeds the daily limit")
+	errWithdrawalAddressCooldown       = errors.New("withdrawal address is in cooldown")
+	errNotWithdrawalApprover           = errors.New("not a withdrawal approver")
+	errQueuedWithdrawalNotFound        = errors.New("queued withdrawal not found")
+	errQueuedWithdrawalNotPending      = errors.New("queued withdrawal is not pending")
+	errWithdrawalAlreadyApproved       = errors.New("withdrawal already approved by approver")
+	errWithdrawalApproverIsRequester   = errors.New("withdrawal cannot be approved by its requester")
+)
+
+// SetupWithdrawalPolicyManager applies configuration parameters before
+// running. It must be set as the policy of the withdraw manager for
+// withdrawals to be held by it
+func SetupWithdrawalPolicyManager(wm iPolicyWithdrawer, pm iWhiteListedTimer, comms iCommsManager, cfg *config.WithdrawalPolicy) (*WithdrawalPolicyManager, error) {
+	if wm == nil {
+		return nil, errNilWithdrawManager
+	}
+	if pm == nil {
+		return nil, errNilPortfolioManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w WithdrawalPolicy", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidWithdrawalPolicyInterval
+	}
+	if cfg.ApprovalTimeout <= 0 {
+		return nil, errInvalidWithdrawalApprovalTime
+	}
+	return &WithdrawalPolicyManager{
+		shutdown:   make(chan struct{}),
+		withdrawer: wm,
+		portfolio:  pm,
+		comms:      comms,
+		cfg:        *cfg,
+		audit:      audit.Event,
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *WithdrawalPolicyManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Withdrawal policy manager %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Withdrawal policy manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem. Withdrawals are refused by the withdraw manager
+// while its policy is stopped
+func (m *WithdrawalPolicyManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Withdrawal policy manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Withdrawal policy manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *WithdrawalPolicyManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Request applies the policy to a validated withdrawal request. Withdrawals
+// beyond the daily limit of their currency or to an address in cooldown are
+// denied. Withdrawals requiring approval or behind a time lock are queued and
+// a response with the queued status is returned, otherwise the withdrawal is
+// submitted
+func (m *WithdrawalPolicyManager) Request(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemNotStarted)
+	}
+	if req == nil {
+		return nil, withdraw.ErrRequestCannotBeNil
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	w := &QueuedWithdrawal{
+		ID:                id,
+		Request:           *req,
+		RequiredApprovals: m.requiredApprovals(req.Currency),
+		Requester:         withdrawalRequester(ctx),
+		Requested:         now,
+		Updated:           now,
+		ExecuteAfter:      now.Add(m.cfg.TimeLock),
+	}
+	m.mu.Lock()
+	if err := m.check(req, now); err != nil {
+		m.mu.Unlock()
+		w.Status = policyDenied
+		w.Reason = err.Error()
+		m.record(w, "denied: "+err.Error())
+		m.notify(w)
+		return nil, err
+	}
+	switch {
+	case w.RequiredApprovals > 0:
+		w.Status = PolicyPendingApproval
+	case m.cfg.TimeLock > 0:
+		w.Status = PolicyTimeLocked
+	default:
+		w.Status = PolicySubmitted
+	}
+	status := w.Status
+	m.withdrawals = append(m.withdrawals, w)
+	m.mu.Unlock()
+
+	action := "requested"
+	if w.Requester != "" {
+		action += " by " + w.Requester
+	}
+	m.record(w, action)
+	if status == PolicySubmitted {
+		return m.execute(ctx, w)
+	}
+	m.notify(w)
+	return &withdraw.Response{
+		ID: w.ID,
+		Exchange: withdraw.ExchangeResponse{
+			Name:   req.Exchange,
+			Status: status,
+		},
+		RequestDetails: *req,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// GetWithdrawals returns the withdrawals held by the policy, optionally
+// filtered by status, newest first
+func (m *WithdrawalPolicyManager) GetWithdrawals(status string) ([]QueuedWithdrawal, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemNotStarted)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	resp := make([]QueuedWithdrawal, 0, len(m.withdrawals))
+	for i := len(m.withdrawals) - 1; i >= 0; i-- {
+		if status != "" && !strings.EqualFold(m.withdrawals[i].Status, status) {
+			continue
+		}
+		resp = append(resp, m.withdrawals[i].clone())
+	}
+	return resp, nil
+}
+
+// Approve records the approval of a queued withdrawal by an approver. Once
+// the withdrawal has its required approvals it is submitted, or held until
+// its time lock elapses
+func (m *WithdrawalPolicyManager) Approve(ctx context.Context, id uuid.UUID, approver string) (QueuedWithdrawal, error) {
+	if !m.IsRunning() {
+		return QueuedWithdrawal{}, fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemNotStarted)
+	}
+	approver, err := m.approver(approver)
+	if err != nil {
+		return QueuedWithdrawal{}, err
+	}
+	now := time.Now()
+	m.mu.Lock()
+	w, err := m.pending(id)
+	if err != nil {
+		m.mu.Unlock()
+		return QueuedWithdrawal{}, err
+	}
+	if w.Status != PolicyPendingApproval {
+		m.mu.Unlock()
+		return QueuedWithdrawal{}, fmt.Errorf("%w: %s is %s", errQueuedWithdrawalNotPending, id, w.Status)
+	}
+	if common.StringSliceContainsInsensitive(w.Approvers, approver) {
+		m.mu.Unlock()
+		return QueuedWithdrawal{}, fmt.Errorf("%w: %s", errWithdrawalAlreadyApproved, approver)
+	}
+	if strings.EqualFold(w.Requester, approver) {
+		m.mu.Unlock()
+		return QueuedWithdrawal{}, fmt.Errorf("%w: %s", errWithdrawalApproverIsRequester, approver)
+	}
+	w.Approvers = append(w.Approvers, approver)
+	w.Updated = now
+	if len(w.Approvers) >= w.RequiredApprovals {
+		w.Status = PolicyTimeLocked
+		if !now.Before(w.ExecuteAfter) {
+			w.Status = PolicySubmitted
+		}
+	}
+	status := w.Status
+	approvals := len(w.Approvers)
+	m.mu.Unlock()
+
+	m.record(w, fmt.Sprintf("approved by %s (%d/%d)", approver, approvals, w.RequiredApprovals))
+	switch status {
+	case PolicySubmitted:
+		// Errors are recorded on the withdrawal
+		_, _ = m.execute(ctx, w)
+	case PolicyTimeLocked:
+		m.notify(w)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return w.clone(), nil
+}
+
+// Reject rejects a queued withdrawal which has not been submitted
+func (m *WithdrawalPolicyManager) Reject(id uuid.UUID, approver, reason string) (QueuedWithdrawal, error) {
+	if !m.IsRunning() {
+		return QueuedWithdrawal{}, fmt.Errorf("%s %w", WithdrawalPolicyManagerName, ErrSubSystemNotStarted)
+	}
+	approver, err := m.approver(approver)
+	if err != nil {
+		return QueuedWithdrawal{}, err
+	}
+	m.mu.Lock()
+	w, err := m.pending(id)
+	if err != nil {
+		m.mu.Unlock()
+		return QueuedWithdrawal{}, err
+	}
+	w.Status = PolicyRejected
+	w.RejectedBy = approver
+	w.Reason = reason
+	w.Updated = time.Now()
+	resp := w.clone()
+	m.mu.Unlock()
+
+	action := "rejected by " + approver
+	if reason != "" {
+		action += ": " + reason
+	}
+	m.record(w, action)
+	m.notify(w)
+	return resp, nil
+}
+
+// approver returns the configured approver matching the name
+func (m *WithdrawalPolicyManager) approver(name string) (string, error) {
+	idx := slices.IndexFunc(m.cfg.Approvers, func(a string) bool { return strings.EqualFold(a, strings.TrimSpace(name)) })
+	if idx == -1 {
+		return "", fmt.Errorf("%w: %q", errNotWithdrawalApprover, name)
+	}
+	return m.cfg.Approvers[idx], nil
+}
+
+// withdrawalRequester returns the authenticated gRPC user or chat command
+// user requesting a withdrawal, if any
+func withdrawalRequester(ctx context.Context) string {
+	if user, ok := rpcUser(ctx); ok {
+		return user
+	}
+	_, user, _ := base.CommandUser(ctx)
+	return user
+}
+
+// pending returns a withdrawal awaiting approval or its time lock, the lock
+// must be held
+func (m *WithdrawalPolicyManager) pending(id uuid.UUID) (*QueuedWithdrawal, error) {
+	idx := slices.IndexFunc(m.withdrawals, func(w *QueuedWithdrawal) bool { return w.ID == id })
+	if idx == -1 {
+		return nil, fmt.Errorf("%w: %s", errQueuedWithdrawalNotFound, id)
+	}
+	w := m.withdrawals[idx]
+	if w.Status != PolicyPendingApproval && w.Status != PolicyTimeLocked {
+		return nil, fmt.Errorf("%w: %s is %s", errQueuedWithdrawalNotPending, id, w.Status)
+	}
+	return w, nil
+}
+
+// check returns why a withdrawal request is denied, the lock must be held
+func (m *WithdrawalPolicyManager) check(req *withdraw.Request, now time.Time) error {
+	if p := m.currencyPolicy(req.Currency); p != nil && p.DailyLimit > 0 {
+		requested := req.Amount + m.requestedSince(req.Currency, now.Add(-24*time.Hour))
+		if requested > p.DailyLimit {
+			return fmt.Errorf("%w: %v %s requested within a day, limit %v", errWithdrawalDailyLimit, requested, req.Currency, p.DailyLimit)
+		}
+	}
+	if req.Type != withdraw.Crypto || m.cfg.AddressCooldown <= 0 {
+		return nil
+	}
+	whitelisted, ok := m.portfolio.GetWhiteListedTime(req.Crypto.Address)
+	if !ok {
+		return withdraw.ErrStrAddressNotWhiteListed
+	}
+	if until := whitelisted.Add(m.cfg.AddressCooldown); whitelisted.IsZero() || now.Before(until) {
+		return fmt.Errorf("%w until %s", errWithdrawalAddressCooldown, until.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// requestedSince returns the amount of a currency requested since the time
+// which has not been rejected or expired, the lock must be held
+func (m *WithdrawalPolicyManager) requestedSince(c currency.Code, since time.Time) float64 {
+	var amount float64
+	for _, w := range m.withdrawals {
+		if !w.Request.Currency.Equal(c) || w.Requested.Before(since) {
+			continue
+		}
+		switch w.Status {
+		case PolicyPendingApproval, PolicyTimeLocked, PolicySubmitted:
+			amount += w.Request.Amount
+		}
+	}
+	return amount
+}
+
+// currencyPolicy returns the first policy of a currency or nil
+func (m *WithdrawalPolicyManager) currencyPolicy(c currency.Code) *config.WithdrawalCurrencyPolicy {
+	for i := range m.cfg.Currencies {
+		if m.cfg.Currencies[i].Currency.Equal(c) {
+			return &m.cfg.Currencies[i]
+		}
+	}
+	return nil
+}
+
+// requiredApprovals returns the number of approvals withdrawals of a currency
+// require
+func (m *WithdrawalPolicyManager) requiredApprovals(c currency.Code) int {
+	if p := m.currencyPolicy(c); p != nil && p.RequiredApprovals > 0 {
+		return p.RequiredApprovals
+	}
+	return m.cfg.RequiredApprovals
+}
+
+func (m *WithdrawalPolicyManager) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(m.cfg.CheckInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll expires withdrawals not approved in time, submits approved
+// withdrawals once their time lock elapses and forgets finished withdrawals
+// past retention
+func (m *WithdrawalPolicyManager) checkAll(ctx context.Context) {
+	now := time.Now()
+	var expired, due []*QueuedWithdrawal
+	m.mu.Lock()
+	for _, w := range m.withdrawals {
+		switch {
+		case w.Status == PolicyPendingApproval && now.Sub(w.Requested) > m.cfg.ApprovalTimeout:
+			w.Status = PolicyExpired
+			w.Updated = now
+			expired = append(expired, w)
+		case w.Status == PolicyTimeLocked && !now.Before(w.ExecuteAfter):
+			w.Status = PolicySubmitted
+			w.Updated = now
+			due = append(due, w)
+		}
+	}
+	m.withdrawals = slices.DeleteFunc(m.withdrawals, func(w *QueuedWithdrawal) bool {
+		switch w.Status {
+		case PolicyPendingApproval, PolicyTimeLocked:
+			return false
+		}
+		return now.Sub(w.Updated) > policyRetention
+	})
+	m.mu.Unlock()
+
+	for _, w := range expired {
+		m.record(w, "expired")
+		m.notify(w)
+	}
+	for _, w := range due {
+		// Errors are recorded on the withdrawal
+		_, _ = m.execute(ctx, w)
+	}
+}
+
+// execute submits a withdrawal through the withdraw manager, recording the
+// stored withdrawal or the error
+func (m *WithdrawalPolicyManager) execute(ctx context.Context, w *QueuedWithdrawal) (*withdraw.Response, error) {
+	resp, err := m.withdrawer.submit(ctx, &w.Request)
+	m.mu.Lock()
+	w.Updated = time.Now()
+	if resp != nil {
+		w.WithdrawalID = resp.ID.String()
+		w.ExchangeID = resp.Exchange.ID
+	}
+	if err != nil {
+		w.Status = PolicyFailed
+		w.Error = err.Error()
+	}
+	m.mu.Unlock()
+
+	if err != nil {
+		m.record(w, "failed: "+err.Error())
+	} else {
+		m.record(w, "submitted as withdrawal "+w.WithdrawalID)
+	}
+	m.notify(w)
+	return resp, err
+}
+
+// record adds the action on a withdrawal to the audit trail
+func (m *WithdrawalPolicyManager) record(w *QueuedWithdrawal, action string) {
+	m.mu.RLock()
+	msg := fmt.Sprintf("%s withdrawal of %v %s from %s", action, w.Request.Amount, w.Request.Currency, w.Request.Exchange)
+	if w.Request.Crypto.Address != "" {
+		msg += " to " + w.Request.Crypto.Address
+	}
+	id := w.ID.String()
+	m.mu.RUnlock()
+	m.logVerbose("Withdrawal policy %s %s", id, msg)
+	m.audit(id, AuditWithdrawalPolicy, msg)
+}
+
+// notify pushes the status of a withdrawal through the communications manager
+func (m *WithdrawalPolicyManager) notify(w *QueuedWithdrawal) {
+	m.mu.RLock()
+	p := &base.WithdrawalApproval{
+		ID:        w.ID.String(),
+		Exchange:  w.Request.Exchange,
+		Currency:  w.Request.Currency.String(),
+		Amount:    w.Request.Amount,
+		Address:   w.Request.Crypto.Address,
+		Status:    w.Status,
+		Approvals: len(w.Approvers),
+		Required:  w.RequiredApprovals,
+		Reason:    w.Reason,
+	}
+	if w.Error != "" {
+		p.Reason = w.Error
+	}
+	m.mu.RUnlock()
+	severity := base.SeverityInfo
+	switch p.Status {
+	case PolicyPendingApproval, PolicyRejected, PolicyExpired:
+		severity = base.SeverityWarning
+	case PolicyFailed, policyDenied:
+		severity = base.SeverityCritical
+	}
+	evt := base.NewEvent(p, severity)
+	evt.Key = evt.Type + "|" + p.ID + "|" + p.Status
+	m.comms.PushEvent(evt)
+}
+
+// clone returns a copy of the withdrawal which does not share its approvers
+func (w *QueuedWithdrawal) clone() QueuedWithdrawal {
+	c := *w
+	c.Approvers = slices.Clone(w.Approvers)
+	return c
+}
+
+func (m *WithdrawalPolicyManager) logVerbose(format string, args ...any) {
+	if m.cfg.Verbose {
+		log.Debugf(log.ExchangeSys, format, args...)
+	}
+}
@@ -0,0 +1,284 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var testWithdrawalPolicyConfig = config.WithdrawalPolicy{
+	CheckInterval:     time.Minute,
+	Approvers:         []string{"alice", "bob", "carol"},
+	RequiredApprovals: 2,
+	ApprovalTimeout:   time.Hour,
+	AddressCooldown:   time.Hour,
+	Currencies: []config.WithdrawalCurrencyPolicy{
+		{Currency: currency.BTC, DailyLimit: 2},
+		{Currency: currency.USDT, RequiredApprovals: 1},
+	},
+}
+
+type policyWithdrawer struct {
+	requests []*withdraw.Request
+	err      error
+}
+
+func (p *policyWithdrawer) submit(_ context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	p.requests = append(p.requests, req)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &withdraw.Response{ID: uuid.Must(uuid.NewV4()), Exchange: withdraw.ExchangeResponse{ID: "exch-1"}, RequestDetails: *req}, nil
+}
+
+type policyPortfolio map[string]time.Time
+
+func (p policyPortfolio) GetWhiteListedTime(address string) (time.Time, bool) {
+	t, ok := p[address]
+	return t, ok
+}
+
+func newTestWithdrawalPolicy(t *testing.T, cfg *config.WithdrawalPolicy) (*WithdrawalPolicyManager, *policyWithdrawer, *testCommsManager, *[]string) {
+	t.Helper()
+	wm := &policyWithdrawer{}
+	comms := &testCommsManager{}
+	pm := policyPortfolio{
+		"trusted": time.Now().Add(-2 * time.Hour),
+		"new":     time.Now(),
+	}
+	m, err := SetupWithdrawalPolicyManager(wm, pm, comms, cfg)
+	require.NoError(t, err, "SetupWithdrawalPolicyManager must not error")
+	audits := new([]string)
+	m.audit = func(id, msgType, message string) {
+		assert.Equal(t, AuditWithdrawalPolicy, msgType)
+		*audits = append(*audits, message)
+	}
+	m.started.Store(true)
+	return m, wm, comms, audits
+}
+
+func newTestPolicyRequest(c currency.Code, amount float64, address string) *withdraw.Request {
+	return &withdraw.Request{
+		Exchange: "alpha",
+		Currency: c,
+		Amount:   amount,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: address},
+	}
+}
+
+func TestSetupWithdrawalPolicyManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupWithdrawalPolicyManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilWithdrawManager)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilPortfolioManager)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, &testCommsManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, &testCommsManager{}, &config.WithdrawalPolicy{})
+	assert.ErrorIs(t, err, errInvalidWithdrawalPolicyInterval)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, &testCommsManager{}, &config.WithdrawalPolicy{CheckInterval: time.Minute})
+	assert.ErrorIs(t, err, errInvalidWithdrawalApprovalTime)
+	_, err = SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, &testCommsManager{}, &testWithdrawalPolicyConfig)
+	assert.NoError(t, err, "SetupWithdrawalPolicyManager should not error")
+}
+
+func TestWithdrawalPolicyManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawalPolicyManager
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil policy manager")
+
+	m, err := SetupWithdrawalPolicyManager(&policyWithdrawer{}, policyPortfolio{}, &testCommsManager{}, &testWithdrawalPolicyConfig)
+	require.NoError(t, err, "SetupWithdrawalPolicyManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Request should refuse withdrawals while stopped")
+	_, err = m.GetWithdrawals("")
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestWithdrawalPolicyRequestDenied(t *testing.T) {
+	t.Parallel()
+	m, wm, comms, audits := newTestWithdrawalPolicy(t, &testWithdrawalPolicyConfig)
+
+	_, err := m.Request(t.Context(), nil)
+	assert.ErrorIs(t, err, withdraw.ErrRequestCannotBeNil)
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "new"))
+	assert.ErrorIs(t, err, errWithdrawalAddressCooldown, "Request should deny withdrawals to newly whitelisted addresses")
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "unknown"))
+	assert.ErrorIs(t, err, withdraw.ErrStrAddressNotWhiteListed)
+
+	resp, err := m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1.5, "trusted"))
+	require.NoError(t, err, "Request must not error within the daily limit")
+	assert.Equal(t, PolicyPendingApproval, resp.Exchange.Status)
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	assert.ErrorIs(t, err, errWithdrawalDailyLimit, "Request should count queued withdrawals towards the daily limit")
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.ETH, 100, "trusted"))
+	assert.NoError(t, err, "Request should not limit currencies without a daily limit")
+
+	assert.Empty(t, wm.requests, "Request should not submit denied or queued withdrawals")
+	withdrawals, err := m.GetWithdrawals("")
+	require.NoError(t, err, "GetWithdrawals must not error")
+	assert.Len(t, withdrawals, 2, "denied withdrawals should not be queued")
+	require.Len(t, *audits, 5, "every decision must be audited")
+	assert.Contains(t, (*audits)[0], "denied: "+errWithdrawalAddressCooldown.Error())
+	assert.Equal(t, "requested withdrawal of 1.5 BTC from alpha to trusted", (*audits)[2])
+	require.NotEmpty(t, comms.events)
+	assert.Equal(t, base.WithdrawalApprovalEventType, comms.events[0].Type)
+	assert.Equal(t, base.SeverityCritical, comms.events[0].Severity, "denied withdrawals should be notified as critical")
+}
+
+func TestWithdrawalPolicyApprovals(t *testing.T) {
+	t.Parallel()
+	m, wm, comms, audits := newTestWithdrawalPolicy(t, &testWithdrawalPolicyConfig)
+	resp, err := m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+
+	_, err = m.Approve(t.Context(), resp.ID, "mallory")
+	assert.ErrorIs(t, err, errNotWithdrawalApprover)
+	_, err = m.Approve(t.Context(), uuid.Must(uuid.NewV4()), "alice")
+	assert.ErrorIs(t, err, errQueuedWithdrawalNotFound)
+	w, err := m.Approve(t.Context(), resp.ID, "ALICE")
+	require.NoError(t, err, "Approve must not error")
+	assert.Equal(t, PolicyPendingApproval, w.Status, "a withdrawal should remain pending until it has its required approvals")
+	assert.Equal(t, []string{"alice"}, w.Approvers, "approvers should be recorded by their configured name")
+	_, err = m.Approve(t.Context(), resp.ID, "alice")
+	assert.ErrorIs(t, err, errWithdrawalAlreadyApproved)
+	assert.Empty(t, wm.requests, "Approve should not submit before the required approvals")
+
+	w, err = m.Approve(t.Context(), resp.ID, "bob")
+	require.NoError(t, err, "Approve must not error")
+	assert.Equal(t, PolicySubmitted, w.Status, "a withdrawal should be submitted once approved")
+	assert.NotEmpty(t, w.WithdrawalID, "a submitted withdrawal should record the stored withdrawal")
+	assert.Equal(t, "exch-1", w.ExchangeID)
+	require.Len(t, wm.requests, 1, "Approve must submit the withdrawal")
+	_, err = m.Approve(t.Context(), resp.ID, "carol")
+	assert.ErrorIs(t, err, errQueuedWithdrawalNotPending)
+	_, err = m.Reject(resp.ID, "carol", "")
+	assert.ErrorIs(t, err, errQueuedWithdrawalNotPending)
+
+	assert.Equal(t, []string{
+		"requested withdrawal of 1 BTC from alpha to trusted",
+		"approved by alice (1/2) withdrawal of 1 BTC from alpha to trusted",
+		"approved by bob (2/2) withdrawal of 1 BTC from alpha to trusted",
+		"submitted as withdrawal " + w.WithdrawalID + " withdrawal of 1 BTC from alpha to trusted",
+	}, *audits, "every approval must be audited")
+	require.Len(t, comms.events, 2)
+	assert.Equal(t, base.SeverityWarning, comms.events[0].Severity, "a withdrawal awaiting approval should be notified as a warning")
+	assert.Equal(t, base.SeverityInfo, comms.events[1].Severity, "a submitted withdrawal should be notified as info")
+
+	resp, err = m.Request(context.WithValue(t.Context(), rpcUserKey{}, "carol"), newTestPolicyRequest(currency.USDT, 10, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	assert.Contains(t, (*audits)[len(*audits)-1], "requested by carol")
+	_, err = m.Approve(t.Context(), resp.ID, "Carol")
+	assert.ErrorIs(t, err, errWithdrawalApproverIsRequester)
+	wm.err = errors.New("insufficient balance")
+	w, err = m.Approve(t.Context(), resp.ID, "alice")
+	require.NoError(t, err, "Approve must not error when the submission fails")
+	assert.Equal(t, PolicyFailed, w.Status, "a currency approval override should submit with a single approval")
+	assert.Equal(t, "insufficient balance", w.Error)
+}
+
+func TestWithdrawalPolicyRejectAndExpire(t *testing.T) {
+	t.Parallel()
+	m, wm, _, audits := newTestWithdrawalPolicy(t, &testWithdrawalPolicyConfig)
+	rejected, err := m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	expires, err := m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+
+	_, err = m.Reject(rejected.ID, "mallory", "")
+	assert.ErrorIs(t, err, errNotWithdrawalApprover)
+	w, err := m.Reject(rejected.ID, "carol", "unexpected")
+	require.NoError(t, err, "Reject must not error")
+	assert.Equal(t, PolicyRejected, w.Status)
+	assert.Equal(t, "carol", w.RejectedBy)
+	assert.Contains(t, (*audits)[len(*audits)-1], "rejected by carol: unexpected")
+
+	_, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	assert.NoError(t, err, "rejected withdrawals should not count towards the daily limit")
+
+	m.mu.Lock()
+	m.withdrawals[1].Requested = time.Now().Add(-2 * time.Hour)
+	m.withdrawals[0].Updated = time.Now().Add(-policyRetention - time.Hour)
+	m.mu.Unlock()
+	m.checkAll(t.Context())
+	withdrawals, err := m.GetWithdrawals(PolicyExpired)
+	require.NoError(t, err, "GetWithdrawals must not error")
+	require.Len(t, withdrawals, 1, "checkAll must expire withdrawals not approved in time")
+	assert.Equal(t, expires.ID, withdrawals[0].ID)
+	withdrawals, err = m.GetWithdrawals("")
+	require.NoError(t, err, "GetWithdrawals must not error")
+	assert.Len(t, withdrawals, 2, "checkAll should forget finished withdrawals past retention")
+	assert.Empty(t, wm.requests, "rejected and expired withdrawals must not be submitted")
+}
+
+func TestWithdrawalPolicyTimeLock(t *testing.T) {
+	t.Parallel()
+	cfg := testWithdrawalPolicyConfig
+	cfg.RequiredApprovals = 0
+	cfg.TimeLock = time.Hour
+	m, wm, _, _ := newTestWithdrawalPolicy(t, &cfg)
+	resp, err := m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	assert.Equal(t, PolicyTimeLocked, resp.Exchange.Status, "a withdrawal without approvals should be held by the time lock")
+	m.checkAll(t.Context())
+	assert.Empty(t, wm.requests, "checkAll should not submit a withdrawal before its time lock elapses")
+
+	m.mu.Lock()
+	m.withdrawals[0].ExecuteAfter = time.Now().Add(-time.Second)
+	m.mu.Unlock()
+	m.checkAll(t.Context())
+	require.Len(t, wm.requests, 1, "checkAll must submit a withdrawal once its time lock elapses")
+	withdrawals, err := m.GetWithdrawals(PolicySubmitted)
+	require.NoError(t, err, "GetWithdrawals must not error")
+	assert.Len(t, withdrawals, 1)
+
+	cfg.TimeLock = 0
+	m, wm, _, _ = newTestWithdrawalPolicy(t, &cfg)
+	resp, err = m.Request(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "Request must not error")
+	require.Len(t, wm.requests, 1, "Request must submit withdrawals which need no approval or time lock")
+	assert.Equal(t, "exch-1", resp.Exchange.ID)
+}
+
+func TestWithdrawManagerPolicy(t *testing.T) {
+	t.Parallel()
+	em := rebalanceExchangeManager{"alpha": &rebalanceExchange{name: "alpha"}}
+	wm, err := SetupWithdrawManager(em, &rebalancePortfolio{whitelisted: map[string]bool{"trusted": true}}, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	assert.ErrorIs(t, wm.SetPolicy(nil), common.ErrNilPointer)
+	p, err := SetupWithdrawalPolicyManager(wm, policyPortfolio{"trusted": time.Now().Add(-2 * time.Hour)}, &testCommsManager{}, &testWithdrawalPolicyConfig)
+	require.NoError(t, err, "SetupWithdrawalPolicyManager must not error")
+	p.audit = func(string, string, string) {}
+	require.NoError(t, wm.SetPolicy(p), "SetPolicy must not error")
+
+	_, err = wm.SubmitWithdrawal(t.Context(), newTestPolicyRequest(currency.BTC, 1, "untrusted"))
+	assert.ErrorIs(t, err, withdraw.ErrStrAddressNotWhiteListed, "SubmitWithdrawal should validate requests before the policy")
+	_, err = wm.SubmitWithdrawal(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "SubmitWithdrawal should refuse withdrawals while the policy is stopped")
+
+	p.started.Store(true)
+	resp, err := wm.SubmitWithdrawal(t.Context(), newTestPolicyRequest(currency.BTC, 1, "trusted"))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, PolicyPendingApproval, resp.Exchange.Status, "SubmitWithdrawal should queue withdrawals requiring approval")
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// WithdrawalPolicyManagerName is an exported subsystem name
+const WithdrawalPolicyManagerName = "withdrawal_policy"
+
+// AuditWithdrawalPolicy is the audit event type recorded for withdrawal
+// policy decisions
+const AuditWithdrawalPolicy = "withdrawal_policy"
+
+// Statuses of withdrawals held by the withdrawal policy
+const (
+	PolicyPendingApproval = "pending approval"
+	PolicyTimeLocked      = "time locked"
+	PolicySubmitted       = "submitted"
+	PolicyRejected        = "rejected"
+	PolicyExpired         = "expired"
+	PolicyFailed          = "failed"
+	// policyDenied withdrawals are refused when requested and not held
+	policyDenied = "denied"
+)
+
+// policyRetention is how long finished withdrawals are kept for listing
+const policyRetention = 7 * 24 * time.Hour
+
+// QueuedWithdrawal is a withdrawal request held by the withdrawal policy
+// until it is approved and its time lock elapses
+type QueuedWithdrawal struct {
+	ID                uuid.UUID
+	Request           withdraw.Request
+	Status            string
+	Approvers         []string
+	RequiredApprovals int
+	// Requester is the gRPC or chat command user who requested the
+	// withdrawal, they cannot approve it
+	Requester  string
+	RejectedBy string
+	Reason     string
+	// WithdrawalID is the ID of the stored withdrawal once submitted
+	WithdrawalID string
+	ExchangeID   string
+	Error        string
+	Requested    time.Time
+	Updated      time.Time
+	// ExecuteAfter is when the time lock of the withdrawal elapses
+	ExecuteAfter time.Time
+}
+
+// WithdrawalPolicyManager holds withdrawals requested through the withdraw
+// manager to per-currency daily limits and whitelisted address cooldowns,
+// queueing them until enough operators approve them and their time lock
+// elapses. Every decision is recorded in the audit repository
+type WithdrawalPolicyManager struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	withdrawer iPolicyWithdrawer
+	portfolio  iWhiteListedTimer
+	comms      iCommsManager
+	cfg        config.WithdrawalPolicy
+	audit      func(id, msgType, message string)
+
+	mu          sync.RWMutex
+	withdrawals []*QueuedWithdrawal
+}
+
+// iPolicyWithdrawer submits withdrawals allowed by the withdrawal policy
+type iPolicyWithdrawer interface {
+	submit(context.Context, *withdraw.Request) (*withdraw.Response, error)
+}
+
+// iWhiteListedTimer returns when whitelisted addresses were first loaded
+type iWhiteListedTimer interface {
+	GetWhiteListedTime(string) (time.Time, bool)
+}
@@ -0,0 +1,405 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errInvalidWithdrawalCheckInterval = errors.New("withdrawal reconciler check interval must be positive")
+	errInvalidWithdrawalLookback      = errors.New("withdrawal reconciler lookback must be positive")
+)
+
+// Words of exchange withdrawal statuses which are final, matched against
+// whole words of a status. Statuses matching neither are treated as pending
+var (
+	withdrawalCompletedWords = []string{"complete", "completed", "success", "successful", "succeeded", "confirmed", "done", "finished", "credited", "settled"}
+	withdrawalFailedWords    = []string{"fail", "failed", "failure", "reject", "rejected", "cancel", "canceled", "cancelled", "error", "refund", "refunded", "expired", "invalid", "interrupted", "repealed"}
+)
+
+// withdrawalStatusCodes are the final states of exchanges returning numeric
+// withdrawal statuses, codes not listed are pending
+var withdrawalStatusCodes = map[string]map[string]string{
+	"binance":   {"1": withdrawalFailed, "3": withdrawalFailed, "5": withdrawalFailed, "6": withdrawalCompleted},
+	"binanceus": {"1": withdrawalFailed, "3": withdrawalFailed, "5": withdrawalFailed, "6": withdrawalCompleted},
+	"bitstamp":  {"2": withdrawalCompleted, "3": withdrawalFailed, "4": withdrawalFailed},
+	"lbank":     {"2": withdrawalFailed, "3": withdrawalFailed, "4": withdrawalCompleted},
+	"okx":       {"-2": withdrawalFailed, "-1": withdrawalFailed, "2": withdrawalCompleted},
+}
+
+// SetupWithdrawalReconciler applies configuration parameters before running.
+// Withdrawals are only reconciled while the database is connected
+func SetupWithdrawalReconciler(em iExchangeManager, comms iCommsManager, dcm iDatabaseConnectionManager, cfg *config.WithdrawalReconciler) (*WithdrawalReconciler, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w WithdrawalReconciler", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidWithdrawalCheckInterval
+	}
+	if cfg.Lookback <= 0 {
+		return nil, errInvalidWithdrawalLookback
+	}
+	return &WithdrawalReconciler{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		comms:           comms,
+		dcm:             dcm,
+		store:           withdrawalRepository{},
+		cfg:             *cfg,
+		stuck:           make(map[uuid.UUID]bool),
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *WithdrawalReconciler) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", WithdrawalReconcilerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", WithdrawalReconcilerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Withdrawal reconciler %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.monitor(ctx)
+	log.Debugf(log.ExchangeSys, "Withdrawal reconciler %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *WithdrawalReconciler) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", WithdrawalReconcilerName, ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("%s %w", WithdrawalReconcilerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Withdrawal reconciler %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	m.started.Store(false)
+	log.Debugf(log.ExchangeSys, "Withdrawal reconciler %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *WithdrawalReconciler) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+func (m *WithdrawalReconciler) monitor(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkAll(ctx)
+			timer.Reset(m.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkAll reconciles the pending withdrawals submitted within the lookback
+// period against the withdrawal history of their exchanges
+func (m *WithdrawalReconciler) checkAll(ctx context.Context) {
+	if db := m.dcm.GetInstance(); db == nil || !db.IsConnected() {
+		m.logVerbose("Withdrawal reconciler is waiting on a database connection")
+		return
+	}
+	now := time.Now()
+	events, err := m.store.GetEventsByDate("", now.Add(-m.cfg.Lookback), now, 0)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Withdrawal reconciler failed to get stored withdrawals: %v", err)
+		return
+	}
+	groups := groupWithdrawals(events)
+	for i := range groups {
+		if err := ctx.Err(); err != nil {
+			return
+		}
+		m.reconcile(ctx, &groups[i], now)
+	}
+	m.prune(events)
+}
+
+// groupWithdrawals groups the withdrawals not yet reconciled as final by
+// exchange and currency. Dry runs and withdrawals which were not accepted by
+// their exchange are skipped
+func groupWithdrawals(events []*withdraw.Response) []withdrawalGroup {
+	var groups []withdrawalGroup
+	index := make(map[string]int)
+	for _, w := range events {
+		if w == nil || w.Exchange.ID == "" || w.Exchange.ID == withdraw.DryRunID.String() || w.Exchange.Name == "" {
+			continue
+		}
+		if storedWithdrawalState(w.Exchange.Status) != withdrawalPending {
+			continue
+		}
+		key := strings.ToLower(w.Exchange.Name) + "|" + w.RequestDetails.Currency.Lower().String()
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, withdrawalGroup{
+				exchange: w.Exchange.Name,
+				currency: w.RequestDetails.Currency,
+			})
+		}
+		groups[i].withdrawals = append(groups[i].withdrawals, w)
+	}
+	return groups
+}
+
+// reconcile matches the withdrawals of a group to the withdrawal history of
+// their exchange, updating those which have progressed and notifying those
+// which are stuck
+func (m *WithdrawalReconciler) reconcile(ctx context.Context, g *withdrawalGroup, now time.Time) {
+	var history []exchange.WithdrawalHistory
+	exch, err := m.exchangeManager.GetExchangeByName(g.exchange)
+	if err != nil {
+		m.logVerbose("Withdrawal reconciler cannot get exchange %s: %v", g.exchange, err)
+	} else {
+		history, err = exch.GetWithdrawalsHistory(ctx, g.currency, asset.Spot)
+		switch {
+		case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented):
+			m.logVerbose("Withdrawal reconciler cannot get %s %s withdrawal history: %v", g.exchange, g.currency, err)
+		case err != nil:
+			log.Errorf(log.ExchangeSys, "Withdrawal reconciler failed to get %s %s withdrawal history: %v", g.exchange, g.currency, err)
+		}
+	}
+	matched := make([]bool, len(history))
+	for _, w := range g.withdrawals {
+		if i := matchWithdrawal(w, history, matched); i >= 0 {
+			matched[i] = true
+			m.update(w, &history[i])
+		}
+		m.checkStuck(w, now)
+	}
+}
+
+// matchWithdrawal returns the index of the history entry of a withdrawal by
+// its exchange ID, falling back to its destination address and amount, or -1
+func matchWithdrawal(w *withdraw.Response, history []exchange.WithdrawalHistory, matched []bool) int {
+	for i := range history {
+		if !matched[i] && history[i].TransferID != "" && history[i].TransferID == w.Exchange.ID {
+			return i
+		}
+	}
+	address := w.RequestDetails.Crypto.Address
+	if address == "" {
+		return -1
+	}
+	for i := range history {
+		h := &history[i]
+		if matched[i] || !strings.EqualFold(h.CryptoToAddress, address) {
+			continue
+		}
+		if withdrawalAmountsEqual(h.Amount, w.RequestDetails.Amount) || withdrawalAmountsEqual(h.Amount+h.Fee, w.RequestDetails.Amount) {
+			return i
+		}
+	}
+	return -1
+}
+
+// withdrawalAmountsEqual allows for rounding of amounts by exchanges
+func withdrawalAmountsEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-8*math.Max(1, math.Abs(b))
+}
+
+// update stores the status and transaction hash of a withdrawal when either
+// changed, notifying the withdrawal once it completes or fails
+func (m *WithdrawalReconciler) update(w *withdraw.Response, h *exchange.WithdrawalHistory) {
+	status := w.Exchange.Status
+	if h.Status != "" {
+		status = reconciledWithdrawalStatus(classifyWithdrawalStatus(w.Exchange.Name, h.Status), h.Status)
+	}
+	txID := h.CryptoTxID
+	if status == w.Exchange.Status && (txID == "" || txID == w.Exchange.TxID) {
+		return
+	}
+	if err := m.store.UpdateEvent(w.ID.String(), status, txID); err != nil {
+		log.Errorf(log.ExchangeSys, "Withdrawal reconciler failed to update withdrawal %s: %v", w.ID, err)
+		return
+	}
+	m.logVerbose("Withdrawal reconciler %s withdrawal %s status %q -> %q tx %q", w.Exchange.Name, w.ID, w.Exchange.Status, status, txID)
+	w.Exchange.Status = status
+	if txID != "" {
+		w.Exchange.TxID = txID
+	}
+	// Withdrawals are cached by the withdraw manager under both their ID and
+	// its string, drop both so the next lookup reads the update
+	withdraw.Cache.Remove(w.ID)
+	withdraw.Cache.Remove(w.ID.String())
+	switch storedWithdrawalState(status) {
+	case withdrawalCompleted:
+		m.notify(w, base.SeverityInfo, 0)
+	case withdrawalFailed:
+		m.notify(w, base.SeverityCritical, 0)
+	}
+}
+
+// checkStuck notifies a pending withdrawal once when it has not completed
+// within the configured time of its submission
+func (m *WithdrawalReconciler) checkStuck(w *withdraw.Response, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if storedWithdrawalState(w.Exchange.Status) != withdrawalPending {
+		delete(m.stuck, w.ID)
+		return
+	}
+	if m.cfg.StuckAfter <= 0 || w.CreatedAt.IsZero() || m.stuck[w.ID] {
+		return
+	}
+	age := now.Sub(w.CreatedAt)
+	if age < m.cfg.StuckAfter {
+		return
+	}
+	m.stuck[w.ID] = true
+	m.notify(w, base.SeverityWarning, age.Truncate(time.Minute))
+}
+
+// prune forgets stuck withdrawals which are no longer within the lookback
+// period
+func (m *WithdrawalReconciler) prune(events []*withdraw.Response) {
+	current := make(map[uuid.UUID]bool, len(events))
+	for _, w := range events {
+		if w != nil {
+			current[w.ID] = true
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id := range m.stuck {
+		if !current[id] {
+			delete(m.stuck, id)
+		}
+	}
+}
+
+// classifyWithdrawalStatus returns whether an exchange withdrawal status is
+// pending, completed or failed, mapping the numeric statuses of an exchange
+// before matching the words of the status
+func classifyWithdrawalStatus(exchName, status string) string {
+	if codes, ok := withdrawalStatusCodes[strings.ToLower(exchName)]; ok {
+		if state, ok := codes[strings.TrimSpace(status)]; ok {
+			return state
+		}
+		return withdrawalPending
+	}
+	words := withdrawalStatusWords(status)
+	for _, k := range withdrawalFailedWords {
+		if slices.Contains(words, k) {
+			return withdrawalFailed
+		}
+	}
+	for _, k := range withdrawalCompletedWords {
+		if slices.Contains(words, k) {
+			return withdrawalCompleted
+		}
+	}
+	return withdrawalPending
+}
+
+// withdrawalStatusWords returns the lower cased words of a status, splitting
+// on separators and camel case
+func withdrawalStatusWords(status string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	for _, r := range status {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(word) > 0 && unicode.IsLower(word[len(word)-1]):
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// reconciledWithdrawalStatus returns the status stored for a withdrawal from
+// its exchange history. Final statuses are prefixed with their state so the
+// status returned when the withdrawal was submitted is never read as final
+func reconciledWithdrawalStatus(state, status string) string {
+	if state == withdrawalPending {
+		return status
+	}
+	return state + " (" + status + ")"
+}
+
+// storedWithdrawalState returns the state of a stored withdrawal status,
+// which is only final once reconciled with the exchange history
+func storedWithdrawalState(status string) string {
+	for _, state := range []string{withdrawalCompleted, withdrawalFailed} {
+		if strings.HasPrefix(status, state+" (") {
+			return state
+		}
+	}
+	return withdrawalPending
+}
+
+// notify pushes a withdrawal status event, a non-zero age marks the
+// withdrawal as stuck
+func (m *WithdrawalReconciler) notify(w *withdraw.Response, severity base.Severity, age time.Duration) {
+	p := &base.WithdrawalStatus{
+		Exchange: w.Exchange.Name,
+		ID:       w.ID.String(),
+		Currency: w.RequestDetails.Currency.String(),
+		Amount:   w.RequestDetails.Amount,
+		Status:   w.Exchange.Status,
+		TxID:     w.Exchange.TxID,
+		Stuck:    age > 0,
+		Age:      age,
+	}
+	evt := base.NewEvent(p, severity)
+	evt.Key = evt.Type + "|" + p.ID + "|" + p.Status
+	m.comms.PushEvent(evt)
+}
+
+func (m *WithdrawalReconciler) logVerbose(format string, args ...any) {
+	if m.cfg.Verbose {
+		log.Debugf(log.ExchangeSys, format, args...)
+	}
+}
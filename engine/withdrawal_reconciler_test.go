@@ -0,0 +1,268 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var testWithdrawalReconcilerConfig = config.WithdrawalReconciler{
+	CheckInterval: time.Minute,
+	Lookback:      24 * time.Hour,
+	StuckAfter:    time.Hour,
+}
+
+type reconcilerDatabase struct {
+	connected bool
+}
+
+func (r *reconcilerDatabase) GetInstance() database.IDatabase { return r }
+
+func (r *reconcilerDatabase) IsConnected() bool { return r.connected }
+
+func (r *reconcilerDatabase) GetSQL() (*sql.DB, error) { return nil, common.ErrNotYetImplemented }
+
+func (r *reconcilerDatabase) GetConfig() *database.Config { return nil }
+
+type reconcilerStore struct {
+	events  []*withdraw.Response
+	updates []string
+	err     error
+}
+
+func (r *reconcilerStore) GetEventsByDate(string, time.Time, time.Time, int) ([]*withdraw.Response, error) {
+	return r.events, r.err
+}
+
+func (r *reconcilerStore) UpdateEvent(id, status, txID string) error {
+	r.updates = append(r.updates, id+"|"+status+"|"+txID)
+	return nil
+}
+
+type reconcilerExchange struct {
+	exchange.IBotExchange
+	history []exchange.WithdrawalHistory
+	err     error
+}
+
+func (r *reconcilerExchange) GetWithdrawalsHistory(context.Context, currency.Code, asset.Item) ([]exchange.WithdrawalHistory, error) {
+	return r.history, r.err
+}
+
+func newTestWithdrawal(t *testing.T, exchangeID, status, address string, amount float64, created time.Time) *withdraw.Response {
+	t.Helper()
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	return &withdraw.Response{
+		ID:       id,
+		Exchange: withdraw.ExchangeResponse{Name: "alpha", ID: exchangeID, Status: status},
+		RequestDetails: withdraw.Request{
+			Currency: currency.BTC,
+			Amount:   amount,
+			Type:     withdraw.Crypto,
+			Crypto:   withdraw.CryptoRequest{Address: address},
+		},
+		CreatedAt: created,
+	}
+}
+
+func newTestWithdrawalReconciler(t *testing.T, exch *reconcilerExchange, store *reconcilerStore) (*WithdrawalReconciler, *testCommsManager) {
+	t.Helper()
+	comms := &testCommsManager{}
+	m, err := SetupWithdrawalReconciler(rebalanceExchangeManager{"alpha": exch}, comms, &reconcilerDatabase{connected: true}, &testWithdrawalReconcilerConfig)
+	require.NoError(t, err, "SetupWithdrawalReconciler must not error")
+	m.store = store
+	return m, comms
+}
+
+func TestSetupWithdrawalReconciler(t *testing.T) {
+	t.Parallel()
+	_, err := SetupWithdrawalReconciler(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, &reconcilerDatabase{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, &reconcilerDatabase{}, &config.WithdrawalReconciler{})
+	assert.ErrorIs(t, err, errInvalidWithdrawalCheckInterval)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, &reconcilerDatabase{}, &config.WithdrawalReconciler{CheckInterval: time.Minute})
+	assert.ErrorIs(t, err, errInvalidWithdrawalLookback)
+	_, err = SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, &reconcilerDatabase{}, &testWithdrawalReconcilerConfig)
+	assert.NoError(t, err, "SetupWithdrawalReconciler should not error")
+}
+
+func TestWithdrawalReconcilerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawalReconciler
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil reconciler")
+
+	m, err := SetupWithdrawalReconciler(rebalanceExchangeManager{}, &testCommsManager{}, &reconcilerDatabase{}, &testWithdrawalReconcilerConfig)
+	require.NoError(t, err, "SetupWithdrawalReconciler must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestClassifyWithdrawalStatus(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		exchange string
+		status   string
+		state    string
+	}{
+		{status: "", state: withdrawalPending},
+		{status: "Pending", state: withdrawalPending},
+		{status: "6", state: withdrawalPending},
+		{status: "unconfirmed", state: withdrawalPending},
+		{status: "Success", state: withdrawalCompleted},
+		{status: "COMPLETED", state: withdrawalCompleted},
+		{status: "Settled", state: withdrawalCompleted},
+		{status: "BlockchainConfirmed", state: withdrawalCompleted},
+		{status: "Failure", state: withdrawalFailed},
+		{status: "cancelled", state: withdrawalFailed},
+		{status: "CancelByUser", state: withdrawalFailed},
+		{status: "confirm-error", state: withdrawalFailed},
+		{status: "Rejected by risk", state: withdrawalFailed},
+		{exchange: "Binance", status: "6", state: withdrawalCompleted},
+		{exchange: "Binance", status: "4", state: withdrawalPending},
+		{exchange: "Binance", status: "5", state: withdrawalFailed},
+		{exchange: "Okx", status: "2", state: withdrawalCompleted},
+		{exchange: "Okx", status: "-1", state: withdrawalFailed},
+		{exchange: "Okx", status: "10", state: withdrawalPending},
+		{exchange: "Bitstamp", status: "2", state: withdrawalCompleted},
+	} {
+		assert.Equalf(t, tc.state, classifyWithdrawalStatus(tc.exchange, tc.status), "classifyWithdrawalStatus should classify %s %q", tc.exchange, tc.status)
+	}
+}
+
+func TestStoredWithdrawalState(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, withdrawalPending, storedWithdrawalState("SUCCESS"), "storedWithdrawalState should not read a submission status as final")
+	assert.Equal(t, withdrawalPending, storedWithdrawalState(reconciledWithdrawalStatus(withdrawalPending, "4")))
+	assert.Equal(t, withdrawalCompleted, storedWithdrawalState(reconciledWithdrawalStatus(withdrawalCompleted, "6")))
+	assert.Equal(t, withdrawalFailed, storedWithdrawalState(reconciledWithdrawalStatus(withdrawalFailed, "Failure")))
+}
+
+func TestGroupWithdrawals(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	dryRun := newTestWithdrawal(t, withdraw.DryRunID.String(), "dryrun", "addr", 1, now)
+	rejected := newTestWithdrawal(t, "", "insufficient balance", "addr", 1, now)
+	completed := newTestWithdrawal(t, "1", "completed (Success)", "addr", 1, now)
+	submitted := newTestWithdrawal(t, "5", "SUCCESS", "addr", 3, now)
+	btc := newTestWithdrawal(t, "2", "Pending", "addr", 1, now)
+	btc2 := newTestWithdrawal(t, "3", "", "addr", 2, now)
+	eth := newTestWithdrawal(t, "4", "Pending", "addr", 1, now)
+	eth.RequestDetails.Currency = currency.ETH
+	groups := groupWithdrawals([]*withdraw.Response{nil, dryRun, rejected, completed, submitted, btc, btc2, eth})
+	require.Len(t, groups, 2, "groupWithdrawals must group pending withdrawals by exchange and currency")
+	assert.Equal(t, currency.BTC, groups[0].currency)
+	assert.Equal(t, []*withdraw.Response{submitted, btc, btc2}, groups[0].withdrawals, "groupWithdrawals should reconcile withdrawals with a final submission status")
+	assert.Equal(t, currency.ETH, groups[1].currency)
+	assert.Equal(t, []*withdraw.Response{eth}, groups[1].withdrawals)
+}
+
+func TestMatchWithdrawal(t *testing.T) {
+	t.Parallel()
+	history := []exchange.WithdrawalHistory{
+		{TransferID: "other", CryptoToAddress: "ADDR", Amount: 0.999, Fee: 0.001},
+		{TransferID: "1", CryptoToAddress: "elsewhere", Amount: 5},
+	}
+	matched := make([]bool, len(history))
+	w := newTestWithdrawal(t, "1", "Pending", "addr", 1, time.Now())
+	assert.Equal(t, 1, matchWithdrawal(w, history, matched), "matchWithdrawal should match by exchange ID first")
+
+	w = newTestWithdrawal(t, "2", "Pending", "addr", 1, time.Now())
+	assert.Equal(t, 0, matchWithdrawal(w, history, matched), "matchWithdrawal should fall back to the address and amount including fees")
+	matched[0] = true
+	assert.Equal(t, -1, matchWithdrawal(w, history, matched), "matchWithdrawal should not match an entry twice")
+
+	w = newTestWithdrawal(t, "2", "Pending", "", 1, time.Now())
+	assert.Equal(t, -1, matchWithdrawal(w, history, make([]bool, len(history))), "matchWithdrawal should not fall back without an address")
+}
+
+func TestWithdrawalReconcilerCheckAll(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	completes := newTestWithdrawal(t, "1", "Pending", "a", 1, now)
+	broadcast := newTestWithdrawal(t, "2", "Pending", "b", 2, now)
+	fails := newTestWithdrawal(t, "3", "Pending", "c", 3, now)
+	stuck := newTestWithdrawal(t, "4", "Pending", "d", 4, now.Add(-2*time.Hour))
+	unchanged := newTestWithdrawal(t, "5", "Pending", "e", 5, now)
+	exch := &reconcilerExchange{history: []exchange.WithdrawalHistory{
+		{TransferID: "1", Status: "Success", CryptoTxID: "0x1"},
+		{TransferID: "2", Status: "Pending", CryptoTxID: "0x2"},
+		{TransferID: "3", Status: "Failure"},
+		{TransferID: "4", Status: "Pending"},
+		{TransferID: "5", Status: "Pending"},
+	}}
+	store := &reconcilerStore{events: []*withdraw.Response{completes, broadcast, fails, stuck, unchanged}}
+	m, comms := newTestWithdrawalReconciler(t, exch, store)
+	m.checkAll(t.Context())
+
+	assert.ElementsMatch(t, []string{
+		completes.ID.String() + "|completed (Success)|0x1",
+		broadcast.ID.String() + "|Pending|0x2",
+		fails.ID.String() + "|failed (Failure)|",
+	}, store.updates, "checkAll should only update withdrawals which progressed")
+	assert.Equal(t, "0x2", broadcast.Exchange.TxID, "checkAll should set the transaction hash once broadcast")
+
+	require.Len(t, comms.events, 3, "checkAll must notify completed, failed and stuck withdrawals")
+	assert.Equal(t, base.WithdrawalStatusEventType, comms.events[0].Type)
+	assert.Equal(t, base.SeverityInfo, comms.events[0].Severity, "a completed withdrawal should be notified as info")
+	assert.Equal(t, base.SeverityCritical, comms.events[1].Severity, "a failed withdrawal should be notified as critical")
+	assert.Equal(t, base.WithdrawalStuckEventType, comms.events[2].Type)
+	assert.Equal(t, base.SeverityWarning, comms.events[2].Severity, "a stuck withdrawal should be notified as a warning")
+
+	store.events = []*withdraw.Response{stuck}
+	store.updates = nil
+	m.checkAll(t.Context())
+	assert.Empty(t, store.updates, "checkAll should not update withdrawals which did not progress")
+	assert.Len(t, comms.events, 3, "checkAll should only notify a stuck withdrawal once")
+
+	store.events = nil
+	m.checkAll(t.Context())
+	assert.Empty(t, m.stuck, "checkAll should forget stuck withdrawals outside of the lookback period")
+}
+
+func TestWithdrawalReconcilerCheckAllErrors(t *testing.T) {
+	t.Parallel()
+	stuck := newTestWithdrawal(t, "1", "Pending", "a", 1, time.Now().Add(-2*time.Hour))
+	store := &reconcilerStore{events: []*withdraw.Response{stuck}}
+	m, comms := newTestWithdrawalReconciler(t, &reconcilerExchange{err: common.ErrFunctionNotSupported}, store)
+	m.dcm = &reconcilerDatabase{}
+	m.checkAll(t.Context())
+	assert.Empty(t, comms.events, "checkAll should wait on a database connection")
+
+	m.dcm = &reconcilerDatabase{connected: true}
+	store.err = errors.New("no rows")
+	m.checkAll(t.Context())
+	assert.Empty(t, comms.events, "checkAll should not notify when stored withdrawals cannot be read")
+
+	store.err = nil
+	m.checkAll(t.Context())
+	assert.Empty(t, store.updates, "checkAll should not update without withdrawal history")
+	require.Len(t, comms.events, 1, "checkAll must still notify stuck withdrawals without withdrawal history")
+	assert.Equal(t, base.WithdrawalStuckEventType, comms.events[0].Type)
+}
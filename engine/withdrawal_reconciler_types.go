@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// WithdrawalReconcilerName is an exported subsystem name
+const WithdrawalReconcilerName = "withdrawal_reconciler"
+
+// Withdrawal states classified from exchange statuses
+const (
+	withdrawalPending   = "pending"
+	withdrawalCompleted = "completed"
+	withdrawalFailed    = "failed"
+)
+
+// WithdrawalReconciler polls the withdrawal history of exchanges, updating
+// the status and transaction hash of stored withdrawals as they progress and
+// notifying completed, failed and stuck withdrawals
+type WithdrawalReconciler struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	exchangeManager iExchangeManager
+	comms           iCommsManager
+	dcm             iDatabaseConnectionManager
+	store           iWithdrawalStore
+	cfg             config.WithdrawalReconciler
+
+	mu    sync.Mutex
+	stuck map[uuid.UUID]bool
+}
+
+// iWithdrawalStore limits exposure of the withdrawal repository
+type iWithdrawalStore interface {
+	GetEventsByDate(exchange string, start, end time.Time, limit int) ([]*withdraw.Response, error)
+	UpdateEvent(id, status, txID string) error
+}
+
+// withdrawalRepository stores withdrawals in the connected database
+type withdrawalRepository struct{}
+
+// GetEventsByDate returns stored withdrawals submitted within the range
+func (withdrawalRepository) GetEventsByDate(exchange string, start, end time.Time, limit int) ([]*withdraw.Response, error) {
+	return dbwithdraw.GetEventsByDate(exchange, start, end, limit)
+}
+
+// UpdateEvent updates the status and transaction hash of a stored withdrawal
+func (withdrawalRepository) UpdateEvent(id, status, txID string) error {
+	return dbwithdraw.UpdateEvent(id, status, txID)
+}
+
+// withdrawalGroup is the stored withdrawals of a currency on an exchange,
+// reconciled against a single withdrawal history request
+type withdrawalGroup struct {
+	exchange    string
+	currency    currency.Code
+	withdrawals []*withdraw.Response
+}
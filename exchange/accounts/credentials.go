@@ -21,6 +21,9 @@ const (
 	// context, when the default config credentials sub account needs to be
 	// changed while the same keys can be used.
 	ContextSubAccountFlag contextCredential = "subaccountoverride"
+	// ContextAccountFlag used for selecting a named credential profile
+	// configured for an exchange, via context or gRPC metadata.
+	ContextAccountFlag contextCredential = "account"
 
 	apiKeyDisplaySize = 16
 )
@@ -39,6 +42,7 @@ var (
 	errMetaDataIsNil                   = errors.New("meta data is nil")
 	errInvalidCredentialMetaDataLength = errors.New("invalid meta data to process credentials")
 	errMissingInfo                     = errors.New("cannot parse meta data missing information in key value pair")
+	errInvalidAccountMetaDataLength    = errors.New("invalid meta data to process account")
 )
 
 // Credentials define parameters that allow for an authenticated request.
@@ -152,6 +156,13 @@ func ParseCredentialsMetadata(ctx context.Context, md metadata.MD) (context.Cont
 		return ctx, errMetaDataIsNil
 	}
 
+	if accountMD := md[string(ContextAccountFlag)]; len(accountMD) != 0 {
+		if len(accountMD) != 1 {
+			return ctx, errInvalidAccountMetaDataLength
+		}
+		ctx = DeployAccountToContext(ctx, accountMD[0])
+	}
+
 	credMD, ok := md[string(ContextCredentialsFlag)]
 	if !ok || len(credMD) == 0 {
 		return ctx, nil
@@ -210,3 +221,17 @@ func DeployCredentialsToContext(ctx context.Context, creds *Credentials) context
 func DeploySubAccountOverrideToContext(ctx context.Context, subAccount string) context.Context {
 	return context.WithValue(ctx, ContextSubAccountFlag, subAccount)
 }
+
+// DeployAccountToContext selects a named credential profile for requests made
+// with the returned context. Credentials deployed to the context take
+// precedence over the selected profile.
+func DeployAccountToContext(ctx context.Context, account string) context.Context {
+	return context.WithValue(ctx, ContextAccountFlag, account)
+}
+
+// AccountFromContext returns the credential profile name selected in the
+// context, or an empty string for the default credentials
+func AccountFromContext(ctx context.Context) string {
+	account, _ := ctx.Value(ContextAccountFlag).(string)
+	return account
+}
@@ -3,6 +3,7 @@ package accounts
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
@@ -98,6 +99,20 @@ func TestParseCredentialsMetadata(t *testing.T) {
 	if sa != "supersub" {
 		t.Fatal("unexpected value")
 	}
+
+	// account selection
+	ctx, err = ParseCredentialsMetadata(t.Context(), metadata.Pairs(string(ContextAccountFlag), "hedging"))
+	require.NoError(t, err)
+	assert.Equal(t, "hedging", AccountFromContext(ctx), "account should be selected")
+
+	_, err = ParseCredentialsMetadata(t.Context(), metadata.Pairs(string(ContextAccountFlag), "hedging", string(ContextAccountFlag), "other"))
+	require.ErrorIs(t, err, errInvalidAccountMetaDataLength)
+}
+
+func TestDeployAccountToContext(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, AccountFromContext(t.Context()), "no account should be selected by default")
+	assert.Equal(t, "hedging", AccountFromContext(DeployAccountToContext(t.Context(), "hedging")))
 }
 
 func TestGetInternal(t *testing.T) {
@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// EnvironmentProvider loads credentials from environment variables named
+// <PREFIX>_<EXCHANGE>[_<ACCOUNT>]_<FIELD>, for example GCT_BINANCE_KEY or
+// GCT_BINANCE_HEDGING_SECRET. Variables are read on every request
+type EnvironmentProvider struct {
+	prefix string
+}
+
+// environmentFields maps the variable suffix to the credential field it sets
+var environmentFields = []struct {
+	suffix string
+	set    func(c *Credentials, v string)
+}{
+	{"KEY", func(c *Credentials, v string) { c.Key = v }},
+	{"SECRET", func(c *Credentials, v string) { c.Secret = v }},
+	{"CLIENTID", func(c *Credentials, v string) { c.ClientID = v }},
+	{"SUBACCOUNT", func(c *Credentials, v string) { c.Subaccount = v }},
+	{"PEMKEY", func(c *Credentials, v string) { c.PEMKey = v }},
+	{"OTPSECRET", func(c *Credentials, v string) { c.OTPSecret = v }},
+}
+
+// NewEnvironmentProvider returns a provider which reads credentials from
+// environment variables, an empty prefix uses DefaultEnvironmentPrefix
+func NewEnvironmentProvider(prefix string) *EnvironmentProvider {
+	if prefix == "" {
+		prefix = DefaultEnvironmentPrefix
+	}
+	return &EnvironmentProvider{prefix: environmentName(prefix)}
+}
+
+// GetCredentials returns the credentials for an exchange and account
+func (e *EnvironmentProvider) GetCredentials(_ context.Context, exchange, account string) (*accounts.Credentials, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	base := e.prefix + "_" + environmentName(exchange) + "_"
+	if account != "" {
+		base += environmentName(account) + "_"
+	}
+	var c Credentials
+	for _, f := range environmentFields {
+		if v, ok := os.LookupEnv(base + f.suffix); ok {
+			f.set(&c, v)
+		}
+	}
+	if c.IsEmpty() {
+		return nil, fmt.Errorf("%w: %s %q", ErrSecretNotFound, exchange, account)
+	}
+	return c.AccountCredentials(), nil
+}
+
+// environmentName upper cases a name and replaces any character which is not
+// valid in an environment variable name with an underscore
+func environmentName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		default:
+			return '_'
+		}
+	}, s)
+}
@@ -0,0 +1,41 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentProviderGetCredentials(t *testing.T) {
+	t.Setenv("GCTTEST_BINANCE_KEY", "key")
+	t.Setenv("GCTTEST_BINANCE_SECRET", "secret")
+	t.Setenv("GCTTEST_BINANCE_HEDGING_DESK_KEY", "hedgingKey")
+	t.Setenv("GCTTEST_BINANCE_HEDGING_DESK_OTPSECRET", "otp")
+
+	p := NewEnvironmentProvider("gcttest")
+	_, err := p.GetCredentials(t.Context(), "", "")
+	require.ErrorIs(t, err, errExchangeNameUnset)
+
+	creds, err := p.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "key", creds.Key)
+	assert.Equal(t, "secret", creds.Secret)
+
+	creds, err = p.GetCredentials(t.Context(), "Binance", "hedging-desk")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hedgingKey", creds.Key)
+	assert.Empty(t, creds.Secret)
+	assert.Equal(t, "otp", creds.OneTimePassword)
+
+	_, err = p.GetCredentials(t.Context(), "Bitstamp", "")
+	assert.ErrorIs(t, err, ErrSecretNotFound)
+
+	assert.Equal(t, DefaultEnvironmentPrefix, NewEnvironmentProvider("").prefix)
+}
+
+func TestEnvironmentName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "GATEIO_SUB_1", environmentName("gateio sub.1"))
+	assert.Equal(t, "COINBASEPRO", environmentName("CoinbasePro"))
+}
@@ -0,0 +1,182 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"golang.org/x/crypto/scrypt"
+)
+
+const keystoreSaltLength = 16
+
+// keystorePrefix marks a keystore file as encrypted
+var keystorePrefix = []byte("GCT-KEYSTORE")
+
+// KeystoreProvider loads credentials from a keystore file holding entries per
+// exchange and account. The file is reloaded whenever its modification time
+// changes, so credentials can be rotated by replacing the file
+type KeystoreProvider struct {
+	path      string
+	keyEnvVar string
+
+	mu       sync.Mutex
+	modTime  time.Time
+	keystore *Keystore
+}
+
+// NewKeystoreProvider returns a provider which reads credentials from the
+// keystore file set in the config
+func NewKeystoreProvider(cfg *KeystoreConfig) (*KeystoreProvider, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Path == "" {
+		return nil, errKeystorePathUnset
+	}
+	return &KeystoreProvider{path: cfg.Path, keyEnvVar: cfg.KeyEnvVar}, nil
+}
+
+// GetCredentials returns the credentials for an exchange and account
+func (k *KeystoreProvider) GetCredentials(_ context.Context, exchange, account string) (*accounts.Credentials, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	ks, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	e, ok := ks.Exchanges[strings.ToLower(exchange)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %q", ErrSecretNotFound, exchange, account)
+	}
+	creds := e.Credentials
+	if account != "" {
+		if p, ok := e.Profiles[account]; ok {
+			creds = &p
+		} else {
+			creds = nil
+		}
+	}
+	if creds == nil || creds.IsEmpty() {
+		return nil, fmt.Errorf("%w: %s %q", ErrSecretNotFound, exchange, account)
+	}
+	return creds.AccountCredentials(), nil
+}
+
+// load returns the keystore, reading the file again if it has been modified
+func (k *KeystoreProvider) load() (*Keystore, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return nil, err
+	}
+	if k.keystore != nil && info.ModTime().Equal(k.modTime) {
+		return k.keystore, nil
+	}
+	data, err := os.ReadFile(k.path)
+	if err != nil {
+		return nil, err
+	}
+	if IsKeystoreEncrypted(data) {
+		key := os.Getenv(k.keyEnvVar)
+		if k.keyEnvVar == "" || key == "" {
+			return nil, fmt.Errorf("%s: %w", k.path, errKeystoreKeyUnset)
+		}
+		if data, err = DecryptKeystore(data, []byte(key)); err != nil {
+			return nil, fmt.Errorf("%s: %w", k.path, err)
+		}
+	}
+	var ks Keystore
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.path, err)
+	}
+	k.keystore, k.modTime = &ks, info.ModTime()
+	return k.keystore, nil
+}
+
+// WriteKeystore writes the keystore to a file, encrypting it when a key is
+// supplied. Exchange names are stored in lower case
+func WriteKeystore(path string, ks *Keystore, key []byte) error {
+	if ks == nil {
+		return errNilKeystore
+	}
+	normalised := Keystore{Exchanges: make(map[string]KeystoreExchange, len(ks.Exchanges))}
+	for name, e := range ks.Exchanges {
+		normalised.Exchanges[strings.ToLower(name)] = e
+	}
+	data, err := json.MarshalIndent(normalised, "", " ")
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		if data, err = EncryptKeystore(data, key); err != nil {
+			return err
+		}
+	}
+	return file.Write(path, data)
+}
+
+// IsKeystoreEncrypted returns whether the data is an encrypted keystore
+func IsKeystoreEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, keystorePrefix)
+}
+
+// EncryptKeystore encrypts keystore data with a key using scrypt key
+// derivation and AES-GCM
+func EncryptKeystore(data, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeystoreKeyEmpty
+	}
+	salt := make([]byte, keystoreSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := keystoreAEAD(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(keystorePrefix)+len(salt)+aead.Overhead()+len(data))
+	out = append(out, keystorePrefix...)
+	out = append(out, salt...)
+	return aead.Seal(out, nil, data, nil), nil
+}
+
+// DecryptKeystore decrypts keystore data encrypted by EncryptKeystore
+func DecryptKeystore(data, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeystoreKeyEmpty
+	}
+	data = bytes.TrimPrefix(data, keystorePrefix)
+	if len(data) < keystoreSaltLength {
+		return nil, errKeystoreDataTooShort
+	}
+	aead, err := keystoreAEAD(key, data[:keystoreSaltLength])
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nil, data[keystoreSaltLength:], nil)
+}
+
+func keystoreAEAD(key, salt []byte) (cipher.AEAD, error) {
+	dk, err := scrypt.Key(key, salt, 32768, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCMWithRandomNonce(block)
+}
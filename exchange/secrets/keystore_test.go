@@ -0,0 +1,103 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecryptKeystore(t *testing.T) {
+	t.Parallel()
+	_, err := EncryptKeystore([]byte("{}"), nil)
+	require.ErrorIs(t, err, errKeystoreKeyEmpty)
+
+	enc, err := EncryptKeystore([]byte("{}"), []byte("key"))
+	require.NoError(t, err, "EncryptKeystore must not error")
+	assert.True(t, IsKeystoreEncrypted(enc), "IsKeystoreEncrypted should return true")
+	assert.False(t, IsKeystoreEncrypted([]byte("{}")), "IsKeystoreEncrypted should return false")
+
+	_, err = DecryptKeystore(enc, nil)
+	require.ErrorIs(t, err, errKeystoreKeyEmpty)
+
+	_, err = DecryptKeystore(keystorePrefix, []byte("key"))
+	require.ErrorIs(t, err, errKeystoreDataTooShort)
+
+	_, err = DecryptKeystore(enc, []byte("wrong"))
+	require.Error(t, err, "DecryptKeystore must error with the wrong key")
+
+	dec, err := DecryptKeystore(enc, []byte("key"))
+	require.NoError(t, err, "DecryptKeystore must not error")
+	assert.Equal(t, []byte("{}"), dec)
+}
+
+func TestKeystoreProviderGetCredentials(t *testing.T) {
+	_, err := NewKeystoreProvider(nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	path := filepath.Join(t.TempDir(), DefaultKeystoreFileName)
+	const keyEnvVar = "GCTTEST_KEYSTORE_KEY"
+	t.Setenv(keyEnvVar, "")
+
+	p, err := NewKeystoreProvider(&KeystoreConfig{Path: path, KeyEnvVar: keyEnvVar})
+	require.NoError(t, err, "NewKeystoreProvider must not error")
+
+	_, err = p.GetCredentials(t.Context(), "", "")
+	require.ErrorIs(t, err, errExchangeNameUnset)
+
+	_, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.ErrorIs(t, WriteKeystore(path, nil, nil), errNilKeystore)
+
+	ks := &Keystore{Exchanges: map[string]KeystoreExchange{
+		"Binance": {
+			Credentials: &Credentials{Key: "key", Secret: "secret"},
+			Profiles:    map[string]Credentials{"hedging": {Key: "hedgingKey", Secret: "hedgingSecret"}},
+		},
+		"Bitstamp": {
+			Profiles: map[string]Credentials{"hedging": {Key: "bitstampKey"}},
+		},
+	}}
+	require.NoError(t, WriteKeystore(path, ks, []byte("key")), "WriteKeystore must not error")
+
+	_, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.ErrorIs(t, err, errKeystoreKeyUnset)
+
+	t.Setenv(keyEnvVar, "key")
+	creds, err := p.GetCredentials(t.Context(), "binance", "")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "key", creds.Key)
+	assert.Equal(t, "secret", creds.Secret)
+
+	creds, err = p.GetCredentials(t.Context(), "Binance", "hedging")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hedgingKey", creds.Key)
+
+	_, err = p.GetCredentials(t.Context(), "Binance", "unknown")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = p.GetCredentials(t.Context(), "Bitstamp", "")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = p.GetCredentials(t.Context(), "Kraken", "")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	// Rotate the keys in an unencrypted keystore
+	ks.Exchanges["Binance"] = KeystoreExchange{Credentials: &Credentials{Key: "rotatedKey", Secret: "rotatedSecret"}}
+	require.NoError(t, WriteKeystore(path, ks, nil), "WriteKeystore must not error")
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)), "Chtimes must not error")
+
+	creds, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "rotatedKey", creds.Key)
+	assert.Equal(t, "rotatedSecret", creds.Secret)
+
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0o600), "WriteFile must not error")
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Hour)), "Chtimes must not error")
+	_, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.Error(t, err, "GetCredentials must error on an invalid keystore")
+}
@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// NewProvider returns the secrets provider selected by the config
+func NewProvider(cfg *Config) (Provider, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	switch strings.ToLower(cfg.Provider) {
+	case ProviderEnvironment:
+		return NewEnvironmentProvider(cfg.Environment.Prefix), nil
+	case ProviderKeystore:
+		return NewKeystoreProvider(&cfg.Keystore)
+	case ProviderVault:
+		return NewVaultProvider(&cfg.Vault)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedProvider, cfg.Provider)
+	}
+}
+
+// IsEmpty returns whether no credential values are set
+func (c *Credentials) IsEmpty() bool {
+	return *c == Credentials{}
+}
+
+// AccountCredentials converts the stored values to exchange credentials
+func (c *Credentials) AccountCredentials() *accounts.Credentials {
+	return &accounts.Credentials{
+		Key:             c.Key,
+		Secret:          c.Secret,
+		ClientID:        c.ClientID,
+		SubAccount:      c.Subaccount,
+		PEMKey:          c.PEMKey,
+		OneTimePassword: c.OTPSecret,
+	}
+}
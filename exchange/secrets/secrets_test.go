@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+	_, err := NewProvider(nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = NewProvider(&Config{Provider: "carrier pigeon"})
+	require.ErrorIs(t, err, errUnsupportedProvider)
+
+	p, err := NewProvider(&Config{Provider: ProviderEnvironment})
+	require.NoError(t, err, "NewProvider must not error")
+	assert.IsType(t, (*EnvironmentProvider)(nil), p)
+
+	_, err = NewProvider(&Config{Provider: ProviderKeystore})
+	require.ErrorIs(t, err, errKeystorePathUnset)
+
+	p, err = NewProvider(&Config{Provider: "KeyStore", Keystore: KeystoreConfig{Path: "keystore.json"}})
+	require.NoError(t, err, "NewProvider must not error")
+	assert.IsType(t, (*KeystoreProvider)(nil), p)
+
+	_, err = NewProvider(&Config{Provider: ProviderVault})
+	require.ErrorIs(t, err, errVaultAddressUnset)
+
+	p, err = NewProvider(&Config{Provider: ProviderVault, Vault: VaultConfig{Address: DefaultVaultAddress}})
+	require.NoError(t, err, "NewProvider must not error")
+	assert.IsType(t, (*VaultProvider)(nil), p)
+}
+
+func TestCredentials(t *testing.T) {
+	t.Parallel()
+	c := &Credentials{}
+	assert.True(t, c.IsEmpty(), "IsEmpty should return true")
+
+	c = &Credentials{Key: "k", Secret: "s", ClientID: "c", Subaccount: "sa", PEMKey: "p", OTPSecret: "o"}
+	assert.False(t, c.IsEmpty(), "IsEmpty should return false")
+	assert.Equal(t, &accounts.Credentials{
+		Key:             "k",
+		Secret:          "s",
+		ClientID:        "c",
+		SubAccount:      "sa",
+		PEMKey:          "p",
+		OneTimePassword: "o",
+	}, c.AccountCredentials())
+}
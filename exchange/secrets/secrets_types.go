@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// Provider types supported by NewProvider
+const (
+	ProviderEnvironment = "environment"
+	ProviderKeystore    = "keystore"
+	ProviderVault       = "vault"
+)
+
+// Default values applied when config fields are unset
+const (
+	DefaultEnvironmentPrefix = "GCT"
+	DefaultKeystoreFileName  = "keystore.json"
+	DefaultKeystoreKeyEnvVar = "GCT_KEYSTORE_KEY"
+	DefaultVaultAddress      = "http://127.0.0.1:8200"
+	DefaultVaultMountPath    = "secret"
+	DefaultVaultSecretPath   = "gocryptotrader"
+	DefaultVaultTokenEnvVar  = "VAULT_TOKEN"
+	DefaultVaultTimeout      = time.Second * 10
+	DefaultRefreshInterval   = time.Minute * 5
+)
+
+// Public errors
+var (
+	// ErrSecretNotFound is returned when a provider holds no credentials for
+	// the requested exchange and account, callers should fall back to the
+	// credentials stored in config
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+var (
+	errNilConfig            = errors.New("secrets config is nil")
+	errUnsupportedProvider  = errors.New("unsupported secrets provider")
+	errExchangeNameUnset    = errors.New("exchange name not set")
+	errKeystorePathUnset    = errors.New("keystore path not set")
+	errKeystoreKeyUnset     = errors.New("keystore is encrypted but no key is set")
+	errKeystoreKeyEmpty     = errors.New("keystore key is empty")
+	errKeystoreDataTooShort = errors.New("keystore data is too short to decrypt")
+	errNilKeystore          = errors.New("keystore is nil")
+	errVaultAddressUnset    = errors.New("vault address not set")
+	errVaultTokenUnset      = errors.New("vault token not set")
+	errVaultRequestFailed   = errors.New("vault request failed")
+)
+
+// Provider returns API credentials for an exchange from a secrets backend.
+// An empty account requests the default credentials for the exchange, any
+// other value the credentials for the named credential profile
+type Provider interface {
+	GetCredentials(ctx context.Context, exchange, account string) (*accounts.Credentials, error)
+}
+
+// Config defines the secrets backend used to load exchange API credentials
+// in place of the credentials stored in the config file
+type Config struct {
+	Enabled  bool   `json:"enabled"`
+	Provider string `json:"provider"`
+	// RefreshInterval is how often credentials are reloaded from the
+	// provider so that rotated keys are picked up, a negative value disables
+	// reloading
+	RefreshInterval time.Duration     `json:"refreshInterval"`
+	Environment     EnvironmentConfig `json:"environment"`
+	Keystore        KeystoreConfig    `json:"keystore"`
+	Vault           VaultConfig       `json:"vault"`
+}
+
+// EnvironmentConfig defines the environment variable provider settings
+type EnvironmentConfig struct {
+	Prefix string `json:"prefix"`
+}
+
+// KeystoreConfig defines the keystore file provider settings
+type KeystoreConfig struct {
+	Path string `json:"path"`
+	// KeyEnvVar names the environment variable holding the keystore
+	// encryption key, which is only required for encrypted keystores
+	KeyEnvVar string `json:"keyEnvVar"`
+}
+
+// VaultConfig defines the HashiCorp Vault KV version 2 provider settings
+type VaultConfig struct {
+	Address    string `json:"address"`
+	MountPath  string `json:"mountPath"`
+	SecretPath string `json:"secretPath"`
+	Namespace  string `json:"namespace,omitempty"`
+	// TokenEnvVar names the environment variable holding the Vault token,
+	// the token is read on each request so it can be renewed externally
+	TokenEnvVar string        `json:"tokenEnvVar"`
+	Timeout     time.Duration `json:"timeout"`
+}
+
+// Credentials holds the API credential values stored by a secrets backend
+type Credentials struct {
+	Key        string `json:"key,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	ClientID   string `json:"clientID,omitempty"`
+	Subaccount string `json:"subaccount,omitempty"`
+	PEMKey     string `json:"pemKey,omitempty"`
+	OTPSecret  string `json:"otpSecret,omitempty"`
+}
+
+// Keystore holds the API credentials for each exchange, keyed by lower case
+// exchange name
+type Keystore struct {
+	Exchanges map[string]KeystoreExchange `json:"exchanges"`
+}
+
+// KeystoreExchange holds the default and named profile credentials for an
+// exchange
+type KeystoreExchange struct {
+	Credentials *Credentials           `json:"credentials,omitempty"`
+	Profiles    map[string]Credentials `json:"profiles,omitempty"`
+}
@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// maxVaultResponseSize limits the size of a Vault response body read
+const maxVaultResponseSize = 1 << 20
+
+// VaultProvider loads credentials from a HashiCorp Vault compatible KV
+// version 2 secrets engine. Default exchange credentials are read from
+// <mount>/data/<secretPath>/<exchange> and named profiles from
+// <mount>/data/<secretPath>/<exchange>/<account>
+type VaultProvider struct {
+	address     string
+	mountPath   string
+	secretPath  string
+	namespace   string
+	tokenEnvVar string
+	client      *http.Client
+}
+
+// vaultResponse is the KV version 2 read response
+type vaultResponse struct {
+	Data struct {
+		Data Credentials `json:"data"`
+	} `json:"data"`
+}
+
+// NewVaultProvider returns a provider which reads credentials from Vault
+func NewVaultProvider(cfg *VaultConfig) (*VaultProvider, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Address == "" {
+		return nil, errVaultAddressUnset
+	}
+	if _, err := url.ParseRequestURI(cfg.Address); err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultVaultTimeout
+	}
+	return &VaultProvider{
+		address:     strings.TrimSuffix(cfg.Address, "/"),
+		mountPath:   strings.Trim(cfg.MountPath, "/"),
+		secretPath:  strings.Trim(cfg.SecretPath, "/"),
+		namespace:   cfg.Namespace,
+		tokenEnvVar: cfg.TokenEnvVar,
+		client:      common.NewHTTPClientWithTimeout(timeout),
+	}, nil
+}
+
+// GetCredentials returns the credentials for an exchange and account
+func (v *VaultProvider) GetCredentials(ctx context.Context, exchange, account string) (*accounts.Credentials, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	token := os.Getenv(v.tokenEnvVar)
+	if v.tokenEnvVar == "" || token == "" {
+		return nil, errVaultTokenUnset
+	}
+
+	path := make([]string, 0, 4)
+	if v.secretPath != "" {
+		path = append(path, v.secretPath)
+	}
+	path = append(path, url.PathEscape(strings.ToLower(exchange)))
+	if account != "" {
+		path = append(path, url.PathEscape(account))
+	}
+	reqURL := v.address + "/v1/" + v.mountPath + "/data/" + strings.Join(path, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxVaultResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s %q", ErrSecretNotFound, exchange, account)
+	default:
+		return nil, fmt.Errorf("%w: %s %s", errVaultRequestFailed, resp.Status, body)
+	}
+
+	var r vaultResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, err
+	}
+	if r.Data.Data.IsEmpty() {
+		return nil, fmt.Errorf("%w: %s %q", ErrSecretNotFound, exchange, account)
+	}
+	return r.Data.Data.AccountCredentials(), nil
+}
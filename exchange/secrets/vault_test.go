@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVaultStub(t *testing.T, token string, secrets map[string]string) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		body, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestVaultProviderGetCredentials(t *testing.T) {
+	_, err := NewVaultProvider(nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = NewVaultProvider(&VaultConfig{Address: "not a url"})
+	require.Error(t, err, "NewVaultProvider must error on an invalid address")
+
+	s := newVaultStub(t, "token", map[string]string{
+		"/v1/secret/data/gocryptotrader/binance":         `{"data":{"data":{"key":"key","secret":"secret"},"metadata":{"version":2}}}`,
+		"/v1/secret/data/gocryptotrader/binance/hedging": `{"data":{"data":{"key":"hedgingKey","subaccount":"desk"}}}`,
+		"/v1/secret/data/gocryptotrader/bitstamp":        `{"data":{"data":{}}}`,
+		"/v1/secret/data/gocryptotrader/kraken":          `not json`,
+	})
+
+	const tokenEnvVar = "GCTTEST_VAULT_TOKEN"
+	t.Setenv(tokenEnvVar, "")
+	p, err := NewVaultProvider(&VaultConfig{
+		Address:     s.URL + "/",
+		MountPath:   "/secret/",
+		SecretPath:  DefaultVaultSecretPath,
+		Namespace:   "gct",
+		TokenEnvVar: tokenEnvVar,
+	})
+	require.NoError(t, err, "NewVaultProvider must not error")
+
+	_, err = p.GetCredentials(t.Context(), "", "")
+	require.ErrorIs(t, err, errExchangeNameUnset)
+
+	_, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.ErrorIs(t, err, errVaultTokenUnset)
+
+	t.Setenv(tokenEnvVar, "wrong")
+	_, err = p.GetCredentials(t.Context(), "Binance", "")
+	require.ErrorIs(t, err, errVaultRequestFailed)
+
+	t.Setenv(tokenEnvVar, "token")
+	creds, err := p.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "key", creds.Key)
+	assert.Equal(t, "secret", creds.Secret)
+
+	creds, err = p.GetCredentials(t.Context(), "Binance", "hedging")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hedgingKey", creds.Key)
+	assert.Equal(t, "desk", creds.SubAccount)
+
+	_, err = p.GetCredentials(t.Context(), "Binance", "unknown")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = p.GetCredentials(t.Context(), "Bitstamp", "")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = p.GetCredentials(t.Context(), "Kraken", "")
+	require.Error(t, err, "GetCredentials must error on an invalid response")
+}
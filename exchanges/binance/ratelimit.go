@@ -1,6 +1,11 @@
 package binance
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -26,6 +31,15 @@ const (
 	uFuturesOrderRequestRate = 300
 )
 
+// Binance reports usage in response headers suffixed with the interval, e.g.
+// X-MBX-USED-WEIGHT-1M and X-MBX-ORDER-COUNT-10S
+const (
+	usedWeightHeaderPrefix = "X-MBX-USED-WEIGHT-"
+	orderCountHeaderPrefix = "X-MBX-ORDER-COUNT-"
+)
+
+var errInvalidRateLimitInterval = errors.New("invalid rate limit interval")
+
 // Binance Spot rate limits
 const (
 	spotDefaultRate request.EndpointLimit = iota
@@ -193,3 +207,94 @@ func orderbookLimit(depth uint64) request.EndpointLimit {
 
 	return spotOrderbookDepth5000Rate
 }
+
+// quotaIntervals are the limiter definitions which Binance reports usage for
+// and the interval each is defined over. Usage reported for other intervals is
+// ignored as the definitions cannot be used to derive the limit.
+var quotaIntervals = map[request.EndpointLimit]time.Duration{
+	spotDefaultRate:           spotInterval,
+	spotOrderRate:             spotOrderInterval,
+	uFuturesDefaultRate:       uFuturesInterval,
+	uFuturesOrdersDefaultRate: uFuturesOrderInterval,
+	cFuturesDefaultRate:       cFuturesInterval,
+	cFuturesOrdersDefaultRate: cFuturesOrderInterval,
+}
+
+// parseRateLimitHeaders converts used weight and order count response headers
+// into quotas. Used weight is enforced per IP address and order counts per
+// account.
+func parseRateLimitHeaders(ep request.EndpointLimit, h http.Header) ([]request.Quota, error) {
+	weightEPL, orderEPL := quotaLimits(ep)
+	now := time.Now()
+	var quotas []request.Quota
+	for k, v := range h {
+		if len(v) == 0 {
+			continue
+		}
+		k = strings.ToUpper(k)
+		q := request.Quota{}
+		var interval string
+		switch {
+		case strings.HasPrefix(k, usedWeightHeaderPrefix):
+			q.Endpoint, q.Scope, interval = weightEPL, request.IPQuota, k[len(usedWeightHeaderPrefix):]
+		case strings.HasPrefix(k, orderCountHeaderPrefix):
+			q.Endpoint, q.Scope, interval = orderEPL, request.AccountQuota, k[len(orderCountHeaderPrefix):]
+		default:
+			continue
+		}
+		window, err := parseQuotaInterval(interval)
+		if err != nil {
+			return nil, err
+		}
+		if quotaIntervals[q.Endpoint] != window {
+			continue
+		}
+		if q.Used, err = strconv.ParseInt(v[0], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s header value %q: %w", k, v[0], err)
+		}
+		// Binance windows are aligned to the clock rather than rolling
+		q.Window, q.Reset = window, now.Truncate(window).Add(window)
+		quotas = append(quotas, q)
+	}
+	return quotas, nil
+}
+
+// quotaLimits returns the used weight and order count limiter definitions for
+// the API an endpoint limit belongs to
+func quotaLimits(ep request.EndpointLimit) (weight, orders request.EndpointLimit) {
+	switch {
+	case ep >= uFuturesDefaultRate && ep <= uFuturesGetAllOpenOrdersRate,
+		ep == uFuturesMultiAssetMarginRate,
+		ep == uFuturesSetMultiAssetMarginRate:
+		return uFuturesDefaultRate, uFuturesOrdersDefaultRate
+	case ep >= cFuturesDefaultRate && ep <= cFuturesOrdersDefaultRate:
+		return cFuturesDefaultRate, cFuturesOrdersDefaultRate
+	default:
+		return spotDefaultRate, spotOrderRate
+	}
+}
+
+// parseQuotaInterval parses Binance interval suffixes such as 10S, 1M and 1D
+func parseQuotaInterval(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("%w %q", errInvalidRateLimitInterval, interval)
+	}
+	n, err := strconv.ParseInt(interval[:len(interval)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", errInvalidRateLimitInterval, interval, err)
+	}
+	var unit time.Duration
+	switch interval[len(interval)-1] {
+	case 'S':
+		unit = time.Second
+	case 'M':
+		unit = time.Minute
+	case 'H':
+		unit = time.Hour
+	case 'D':
+		unit = 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("%w %q", errInvalidRateLimitInterval, interval)
+	}
+	return time.Duration(n) * unit, nil
+}
@@ -1,12 +1,16 @@
 package binance
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"net/http"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -79,3 +83,41 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+	h := http.Header{}
+	h.Set("X-MBX-USED-WEIGHT-1M", "120")
+	h.Set("X-MBX-ORDER-COUNT-10S", "7")
+	h.Set("X-MBX-ORDER-COUNT-1D", "300")
+	h.Set("Content-Type", "application/json")
+
+	quotas, err := parseRateLimitHeaders(spotOrderRate, h)
+	require.NoError(t, err, "parseRateLimitHeaders must not error")
+	require.Len(t, quotas, 2, "must ignore intervals without a matching definition")
+	slices.SortFunc(quotas, func(a, b request.Quota) int { return cmp.Compare(a.Endpoint, b.Endpoint) })
+	assert.Equal(t, spotDefaultRate, quotas[0].Endpoint)
+	assert.Equal(t, request.IPQuota, quotas[0].Scope)
+	assert.Equal(t, int64(120), quotas[0].Used)
+	assert.Equal(t, time.Minute, quotas[0].Window)
+	assert.WithinDuration(t, time.Now(), quotas[0].Reset, time.Minute, "reset should be within one window")
+	assert.Equal(t, spotOrderRate, quotas[1].Endpoint)
+	assert.Equal(t, request.AccountQuota, quotas[1].Scope)
+	assert.Equal(t, int64(7), quotas[1].Used)
+
+	h = http.Header{}
+	h.Set("X-MBX-USED-WEIGHT-1M", "5")
+	quotas, err = parseRateLimitHeaders(uFuturesBatchOrdersRate, h)
+	require.NoError(t, err, "parseRateLimitHeaders must not error")
+	require.Len(t, quotas, 1)
+	assert.Equal(t, uFuturesDefaultRate, quotas[0].Endpoint, "futures usage should apply to the futures limiter")
+
+	h.Set("X-MBX-USED-WEIGHT-1M", "lots")
+	_, err = parseRateLimitHeaders(cFuturesDefaultRate, h)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	h = http.Header{}
+	h.Set("X-MBX-USED-WEIGHT-1W", "5")
+	_, err = parseRateLimitHeaders(spotDefaultRate, h)
+	assert.ErrorIs(t, err, errInvalidRateLimitInterval)
+}
@@ -74,7 +74,8 @@ func (e *Exchange) SetDefaults() {
 
 	e.Requester, err = request.New(e.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(GetRateLimit()))
+		request.WithLimiter(GetRateLimit()),
+		request.WithMarketDataShedding(exchange.DefaultMarketDataShedThreshold))
 	if err != nil {
 		log.Errorln(log.ExchangeSys, err)
 	}
@@ -108,7 +108,7 @@ func (e *Exchange) SetDefaults() {
 		Subscriptions:       defaultSubscriptions.Clone(),
 		TradingRequirements: protocol.TradingRequirements{},
 	}
-	if e.Requester, err = request.New(e.Name, common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout), request.WithLimiter(rateLimits)); err != nil {
+	if e.Requester, err = request.New(e.Name, common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout), request.WithLimiter(rateLimits), request.WithMarketDataShedding(exchange.DefaultMarketDataShedThreshold)); err != nil {
 		log.Errorln(log.ExchangeSys, err)
 	}
 	e.API.Endpoints = e.NewEndpoints()
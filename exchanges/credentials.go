@@ -5,11 +5,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -28,6 +31,13 @@ var (
 	errRequiresAPIPEMKey   = errors.New("requires API PEM key but default/empty one set")
 	errRequiresAPIClientID = errors.New("requires API Client ID but default/empty one set")
 	errBase64DecodeFailure = errors.New("base64 decode has failed")
+
+	// ErrCredentialProfileNotFound is returned when an account is selected
+	// which has no credential profile configured for the exchange
+	ErrCredentialProfileNotFound = errors.New("credential profile not found")
+
+	errCredentialProfileNameUnset = errors.New("credential profile name not set")
+	errSecretsProviderUnset       = errors.New("secrets provider not set")
 )
 
 // CheckCredentials checks to see if the required fields have been set before
@@ -90,10 +100,18 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 		return creds, nil
 	}
 
-	// Fallback to exchange loaded credentials
+	// Fallback to the selected credential profile or exchange loaded
+	// credentials
+	account := accounts.AccountFromContext(ctx)
 	b.API.credMu.RLock()
-	creds := b.API.credentials
+	creds, ok := b.API.credentials, true
+	if account != "" {
+		creds, ok = b.API.credentialProfiles[account]
+	}
 	b.API.credMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%s %w: %q", b.Name, ErrCredentialProfileNotFound, account)
+	}
 	if err := b.CheckCredentials(&creds, false); err != nil {
 		return nil, fmt.Errorf("error checking credentials: %w", err)
 	}
@@ -105,6 +123,16 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 	return &creds, nil
 }
 
+// rateLimitAccount identifies the API key a request will be sent with so that
+// exchange reported account quotas are tracked separately for each key
+func (b *Base) rateLimitAccount(ctx context.Context) string {
+	creds, err := b.GetCredentials(ctx)
+	if err != nil {
+		return ""
+	}
+	return creds.Key
+}
+
 // VerifyAPICredentials verifies the exchanges API credentials
 func (b *Base) VerifyAPICredentials(creds *accounts.Credentials) error {
 	b.API.credMu.RLock()
@@ -172,6 +200,87 @@ func (b *Base) SetCredentials(creds *accounts.Credentials) {
 	}
 }
 
+// SetCredentialProfile sets the API credentials for a named account, which is
+// selected for requests with accounts.DeployAccountToContext. Nil credentials
+// remove the profile.
+func (b *Base) SetCredentialProfile(name string, creds *accounts.Credentials) error {
+	if name == "" {
+		return fmt.Errorf("%s %w", b.Name, errCredentialProfileNameUnset)
+	}
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	if creds == nil {
+		delete(b.API.credentialProfiles, name)
+		return nil
+	}
+	profile := *creds
+	if b.API.CredentialsValidator.RequiresBase64DecodeSecret && !profile.SecretBase64Decoded {
+		result, err := base64.StdEncoding.DecodeString(profile.Secret)
+		if err != nil {
+			return fmt.Errorf("%s credential profile %q API secret %w: %w", b.Name, name, errBase64DecodeFailure, err)
+		}
+		profile.Secret = string(result)
+		profile.SecretBase64Decoded = true
+	}
+	if b.API.credentialProfiles == nil {
+		b.API.credentialProfiles = make(map[string]accounts.Credentials)
+	}
+	b.API.credentialProfiles[name] = profile
+	return nil
+}
+
+// GetCredentialProfiles returns the sorted names of the accounts with
+// credential profiles set, excluding the default credentials
+func (b *Base) GetCredentialProfiles() []string {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
+	names := slices.Collect(maps.Keys(b.API.credentialProfiles))
+	slices.Sort(names)
+	return names
+}
+
+// SetSecretsProvider sets the secrets backend used by RefreshCredentials
+func (b *Base) SetSecretsProvider(p secrets.Provider) {
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	b.API.secretsProvider = p
+}
+
+// RefreshCredentials loads the default and credential profile API credentials
+// from the secrets provider, replacing the credentials currently set. Any
+// credentials not held by the provider are left unchanged so the config
+// values remain in use
+func (b *Base) RefreshCredentials(ctx context.Context) error {
+	b.API.credMu.RLock()
+	p := b.API.secretsProvider
+	b.API.credMu.RUnlock()
+	if p == nil {
+		return fmt.Errorf("%s %w", b.Name, errSecretsProviderUnset)
+	}
+
+	creds, err := p.GetCredentials(ctx, b.Name, "")
+	switch {
+	case err == nil:
+		b.SetCredentials(creds)
+	case !errors.Is(err, secrets.ErrSecretNotFound):
+		return fmt.Errorf("%s %w", b.Name, err)
+	}
+
+	for _, name := range b.GetCredentialProfiles() {
+		creds, err := p.GetCredentials(ctx, b.Name, name)
+		if err != nil {
+			if errors.Is(err, secrets.ErrSecretNotFound) {
+				continue
+			}
+			return fmt.Errorf("%s %w", b.Name, err)
+		}
+		if err := b.SetCredentialProfile(name, creds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetAPICredentialDefaults sets the API Credential validator defaults
 func (b *Base) SetAPICredentialDefaults() {
 	b.API.credMu.Lock()
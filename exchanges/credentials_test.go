@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 )
 
 func TestGetCredentials(t *testing.T) {
@@ -349,6 +351,108 @@ func TestSetCredentials(t *testing.T) {
 	require.ErrorIs(t, b.VerifyAPICredentials(b.GetDefaultCredentials()), errBase64DecodeFailure, "invalid secret must fail verification")
 }
 
+func TestCredentialProfiles(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME", LoadedByConfig: true, API: API{AuthenticatedSupport: true}}
+	b.API.CredentialsValidator.RequiresKey = true
+	b.SetCredentials(&accounts.Credentials{Key: "default"})
+
+	require.ErrorIs(t, b.SetCredentialProfile("", &accounts.Credentials{Key: "hedging"}), errCredentialProfileNameUnset)
+	require.NoError(t, b.SetCredentialProfile("hedging", &accounts.Credentials{Key: "hedging"}), "SetCredentialProfile must not error")
+	require.NoError(t, b.SetCredentialProfile("arbitrage", &accounts.Credentials{Key: "arbitrage"}), "SetCredentialProfile must not error")
+	assert.Equal(t, []string{"arbitrage", "hedging"}, b.GetCredentialProfiles())
+
+	creds, err := b.GetCredentials(t.Context())
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "default", creds.Key, "default credentials should be used when no account is selected")
+
+	ctx := accounts.DeployAccountToContext(t.Context(), "hedging")
+	creds, err = b.GetCredentials(ctx)
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hedging", creds.Key, "selected profile credentials should be used")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(ctx, "sub"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hedging", creds.Key, "selected profile credentials should be used")
+	assert.Equal(t, "sub", creds.SubAccount, "sub account override should apply to the selected profile")
+
+	creds, err = b.GetCredentials(accounts.DeployCredentialsToContext(ctx, &accounts.Credentials{Key: "context"}))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "context", creds.Key, "context credentials should take precedence over the selected profile")
+
+	_, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "missing"))
+	assert.ErrorIs(t, err, ErrCredentialProfileNotFound)
+
+	require.NoError(t, b.SetCredentialProfile("hedging", nil), "SetCredentialProfile must not error")
+	assert.Equal(t, []string{"arbitrage"}, b.GetCredentialProfiles(), "nil credentials should remove the profile")
+
+	b.API.CredentialsValidator.RequiresBase64DecodeSecret = true
+	require.ErrorIs(t, b.SetCredentialProfile("hedging", &accounts.Credentials{Key: "hedging", Secret: "%%"}), errBase64DecodeFailure)
+	require.NoError(t, b.SetCredentialProfile("hedging", &accounts.Credentials{Key: "hedging", Secret: "aGVsbG8gd29ybGQ="}), "SetCredentialProfile must not error")
+	creds, err = b.GetCredentials(ctx)
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "hello world", creds.Secret, "profile secret should be decoded")
+}
+
+type fakeSecretsProvider struct {
+	creds map[string]*accounts.Credentials
+	err   error
+}
+
+func (f *fakeSecretsProvider) GetCredentials(_ context.Context, _, account string) (*accounts.Credentials, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	c, ok := f.creds[account]
+	if !ok {
+		return nil, secrets.ErrSecretNotFound
+	}
+	return c, nil
+}
+
+func TestRefreshCredentials(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME", API: API{AuthenticatedSupport: true}}
+	require.ErrorIs(t, b.RefreshCredentials(t.Context()), errSecretsProviderUnset)
+
+	b.SetCredentials(&accounts.Credentials{Key: "config"})
+	require.NoError(t, b.SetCredentialProfile("hedging", &accounts.Credentials{Key: "configHedging"}), "SetCredentialProfile must not error")
+	require.NoError(t, b.SetCredentialProfile("arbitrage", &accounts.Credentials{Key: "configArbitrage"}), "SetCredentialProfile must not error")
+
+	errVaultDown := errors.New("vault down")
+	p := &fakeSecretsProvider{err: errVaultDown}
+	b.SetSecretsProvider(p)
+	require.ErrorIs(t, b.RefreshCredentials(t.Context()), errVaultDown)
+
+	p.err = nil
+	p.creds = map[string]*accounts.Credentials{
+		"":        {Key: "secret", Secret: "c2VjcmV0"},
+		"hedging": {Key: "secretHedging"},
+	}
+	b.API.CredentialsValidator.RequiresBase64DecodeSecret = true
+	require.NoError(t, b.RefreshCredentials(t.Context()), "RefreshCredentials must not error")
+
+	creds, err := b.GetCredentials(t.Context())
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "secret", creds.Key, "provider credentials should replace the config credentials")
+	assert.Equal(t, "secret", creds.Secret, "provider secret should be decoded")
+
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedging"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "secretHedging", creds.Key, "provider credentials should replace the profile credentials")
+
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "arbitrage"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "configArbitrage", creds.Key, "profile credentials not held by the provider should be kept")
+
+	p.creds[""] = &accounts.Credentials{Key: "rotated", Secret: "cm90YXRlZA=="}
+	require.NoError(t, b.RefreshCredentials(t.Context()), "RefreshCredentials must not error")
+	creds, err = b.GetCredentials(t.Context())
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "rotated", creds.Key, "rotated credentials should be loaded")
+	assert.Equal(t, "rotated", creds.Secret, "rotated secret should be decoded")
+}
+
 func TestGetDefaultCredentials(t *testing.T) {
 	var b Base
 	if b.GetDefaultCredentials() != nil {
@@ -133,6 +133,7 @@ func (e *Exchange) SetDefaults() {
 	e.Requester, err = request.New(e.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
 		request.WithLimiter(GetRateLimits()),
+		request.WithMarketDataShedding(exchange.DefaultMarketDataShedThreshold),
 	)
 	if err != nil {
 		log.Errorln(log.ExchangeSys, err)
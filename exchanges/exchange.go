@@ -51,6 +51,9 @@ const (
 	DefaultWebsocketResponseMaxLimit = time.Second * 7
 	// DefaultWebsocketOrderbookBufferLimit is the maximum number of orderbook updates that get stored before being applied
 	DefaultWebsocketOrderbookBufferLimit = 5
+	// DefaultMarketDataShedThreshold is the number of requests waiting on a rate limiter at which market data requests
+	// are shed so they do not delay order operations
+	DefaultMarketDataShedThreshold = 10
 )
 
 // Public Errors
@@ -544,6 +547,19 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 			PEMKey:          exch.API.Credentials.PEMKey,
 			OneTimePassword: exch.API.Credentials.OTPSecret,
 		})
+		for i := range exch.API.CredentialProfiles {
+			p := &exch.API.CredentialProfiles[i]
+			if err := b.SetCredentialProfile(p.Name, &accounts.Credentials{
+				Key:             p.Credentials.Key,
+				Secret:          p.Credentials.Secret,
+				ClientID:        p.Credentials.ClientID,
+				SubAccount:      p.Credentials.Subaccount,
+				PEMKey:          p.Credentials.PEMKey,
+				OneTimePassword: p.Credentials.OTPSecret,
+			}); err != nil {
+				return err
+			}
+		}
 	}
 
 	if exch.HTTPTimeout <= time.Duration(0) {
@@ -570,6 +586,10 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 		return err
 	}
 
+	if err := b.SetAccountIdentifier(b.rateLimitAccount); err != nil {
+		return err
+	}
+
 	if err := b.SetCurrencyPairFormat(); err != nil {
 		return err
 	}
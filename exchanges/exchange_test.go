@@ -781,6 +781,9 @@ func TestSetupDefaults(t *testing.T) {
 		HTTPTimeout: time.Duration(-1),
 		API: config.APIConfig{
 			AuthenticatedSupport: true,
+			CredentialProfiles: []config.APICredentialProfileConfig{
+				{Name: "hedging", Credentials: config.APICredentialsConfig{Key: "hedging", Secret: "secret"}},
+			},
 		},
 		ConnectionMonitorDelay: time.Second * 5,
 	}
@@ -792,6 +795,7 @@ func TestSetupDefaults(t *testing.T) {
 	require.Same(t, accountsStore, accounts.GetStore(), "Global accounts Store must not change during SetupDefaults")
 
 	assert.Equal(t, 15*time.Second, cfg.HTTPTimeout, "config.HTTPTimeout should default correctly")
+	assert.Equal(t, []string{"hedging"}, b.GetCredentialProfiles(), "credential profiles should be loaded from config")
 
 	cfg.HTTPTimeout = time.Second * 30
 	require.NoError(t, b.SetupDefaults(&cfg))
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -222,8 +223,10 @@ type API struct {
 
 	Endpoints *Endpoints
 
-	credentials accounts.Credentials
-	credMu      sync.RWMutex
+	credentials        accounts.Credentials
+	credentialProfiles map[string]accounts.Credentials
+	secretsProvider    secrets.Provider
+	credMu             sync.RWMutex
 
 	CredentialsValidator config.APICredentialsValidatorConfig
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -201,6 +203,141 @@ func (c *PositionController) GetAllOpenPositions() ([]Position, error) {
 	return openPositions, nil
 }
 
+// GetAllPositions returns every open and closed position tracked by the
+// position controller
+func (c *PositionController) GetAllPositions() ([]Position, error) {
+	if c == nil {
+		return nil, fmt.Errorf("position controller %w", common.ErrNilPointer)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	var positions []Position
+	for _, multiPositionTracker := range c.multiPositionTrackers {
+		positions = append(positions, multiPositionTracker.GetPositions()...)
+	}
+	return positions, nil
+}
+
+// RestorePositions rebuilds position trackers from previously tracked
+// positions, such as those persisted before a restart. Trackers already held
+// for a restored exchange, asset and pair are replaced
+func (c *PositionController) RestorePositions(positions []Position) error {
+	if c == nil {
+		return fmt.Errorf("position controller %w", common.ErrNilPointer)
+	}
+	grouped := make(map[key.ExchangeAssetPair][]*Position)
+	for i := range positions {
+		var err error
+		positions[i].Exchange, err = checkTrackerPrerequisitesLowerExchange(positions[i].Exchange, positions[i].Asset, positions[i].Pair)
+		if err != nil {
+			return err
+		}
+		k := key.NewExchangeAssetPair(positions[i].Exchange, positions[i].Asset, positions[i].Pair)
+		grouped[k] = append(grouped[k], &positions[i])
+	}
+	trackers := make(map[key.ExchangeAssetPair]*MultiPositionTracker, len(grouped))
+	var updated time.Time
+	for k, group := range grouped {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].OpeningDate.Before(group[j].OpeningDate)
+		})
+		latest := group[len(group)-1]
+		underlying := latest.Underlying
+		if underlying.IsEmpty() {
+			underlying = latest.Pair.Base
+		}
+		tracker, err := SetupMultiPositionTracker(&MultiPositionTrackerSetup{
+			Exchange:           latest.Exchange,
+			Asset:              latest.Asset,
+			Pair:               latest.Pair,
+			Underlying:         underlying,
+			CollateralCurrency: latest.CollateralCurrency,
+		})
+		if err != nil {
+			return err
+		}
+		for i, pos := range group {
+			if !pos.Status.IsInactive() && i != len(group)-1 {
+				return fmt.Errorf("%w %v %v %v opened %v", errPositionDiscrepancy, pos.Exchange, pos.Asset, pos.Pair, pos.OpeningDate)
+			}
+			p, err := restorePositionTracker(pos, underlying)
+			if err != nil {
+				return err
+			}
+			tracker.positions = append(tracker.positions, p)
+			for j := range pos.Orders {
+				tracker.orderPositions[pos.Orders[j].OrderID] = p
+			}
+			if pos.LastUpdated.After(updated) {
+				updated = pos.LastUpdated
+			}
+		}
+		trackers[k] = tracker
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	maps.Copy(c.multiPositionTrackers, trackers)
+	if updated.After(c.updated) {
+		c.updated = updated
+	}
+	return nil
+}
+
+// restorePositionTracker creates a position tracker holding the state of a
+// previously tracked position
+func restorePositionTracker(pos *Position, underlying currency.Code) (*PositionTracker, error) {
+	p, err := SetupPositionTracker(&PositionTrackerSetup{
+		Exchange:   pos.Exchange,
+		Asset:      pos.Asset,
+		Pair:       pos.Pair,
+		EntryPrice: pos.OpeningPrice,
+		Underlying: underlying,
+		Side:       pos.OpeningDirection,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.collateralCurrency = pos.CollateralCurrency
+	p.openingSize = pos.OpeningSize
+	p.openingDate = pos.OpeningDate
+	p.latestDirection = pos.LatestDirection
+	p.latestPrice = pos.LatestPrice
+	p.exposure = pos.LatestSize
+	p.unrealisedPNL = pos.UnrealisedPNL
+	p.realisedPNL = pos.RealisedPNL
+	p.status = pos.Status
+	p.closingPrice = pos.ClosingPrice
+	p.closingDate = pos.CloseDate
+	p.lastUpdated = pos.LastUpdated
+	p.pnlHistory = slices.Clone(pos.PNLHistory)
+	for i := range pos.Orders {
+		od := pos.Orders[i].Copy()
+		od.Exchange = pos.Exchange
+		od.AssetType = pos.Asset
+		od.Pair = pos.Pair
+		if od.Side.IsShort() {
+			p.shortPositions = append(p.shortPositions, od)
+		} else {
+			p.longPositions = append(p.longPositions, od)
+		}
+	}
+	if len(pos.FundingRates.FundingRates) > 0 {
+		rates := slices.Clone(pos.FundingRates.FundingRates)
+		p.fundingRateDetails = &fundingrate.HistoricalRates{
+			Exchange:              pos.Exchange,
+			Asset:                 pos.Asset,
+			Pair:                  pos.Pair,
+			StartDate:             rates[0].Time,
+			EndDate:               rates[len(rates)-1].Time,
+			LatestRate:            pos.FundingRates.LatestRate,
+			PredictedUpcomingRate: pos.FundingRates.PredictedUpcomingRate,
+			FundingRates:          rates,
+			PaymentSum:            pos.FundingRates.PaymentSum,
+		}
+	}
+	return p, nil
+}
+
 // UpdateOpenPositionUnrealisedPNL finds an open position from
 // an exchange asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
@@ -511,24 +648,26 @@ func (p *PositionTracker) GetStats() *Position {
 	})
 
 	pos := &Position{
-		Exchange:         p.exchange,
-		Asset:            p.asset,
-		Pair:             p.contractPair,
-		Underlying:       p.underlying,
-		RealisedPNL:      p.realisedPNL,
-		UnrealisedPNL:    p.unrealisedPNL,
-		Status:           p.status,
-		OpeningDate:      p.openingDate,
-		OpeningPrice:     p.openingPrice,
-		OpeningSize:      p.openingSize,
-		OpeningDirection: p.openingDirection,
-		LatestPrice:      p.latestPrice,
-		LatestSize:       p.exposure,
-		LatestDirection:  p.latestDirection,
-		CloseDate:        p.closingDate,
-		Orders:           orders,
-		PNLHistory:       p.pnlHistory,
-		LastUpdated:      p.lastUpdated,
+		Exchange:           p.exchange,
+		Asset:              p.asset,
+		Pair:               p.contractPair,
+		Underlying:         p.underlying,
+		CollateralCurrency: p.collateralCurrency,
+		RealisedPNL:        p.realisedPNL,
+		UnrealisedPNL:      p.unrealisedPNL,
+		Status:             p.status,
+		OpeningDate:        p.openingDate,
+		OpeningPrice:       p.openingPrice,
+		OpeningSize:        p.openingSize,
+		OpeningDirection:   p.openingDirection,
+		LatestPrice:        p.latestPrice,
+		LatestSize:         p.exposure,
+		LatestDirection:    p.latestDirection,
+		ClosingPrice:       p.closingPrice,
+		CloseDate:          p.closingDate,
+		Orders:             orders,
+		PNLHistory:         p.pnlHistory,
+		LastUpdated:        p.lastUpdated,
 	}
 
 	if p.fundingRateDetails != nil {
@@ -801,6 +940,7 @@ func (p *PositionTracker) TrackNewOrder(d *order.Detail, isInitialOrder bool) er
 		} else {
 			p.longPositions = append(p.longPositions, d.Copy())
 		}
+		p.lastUpdated = time.Now()
 	}
 	var shortSideAmount, longSideAmount decimal.Decimal
 	for i := range p.shortPositions {
@@ -2,6 +2,7 @@ package futures
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -1041,6 +1042,108 @@ func TestGetAllOpenPositions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetAllPositions(t *testing.T) {
+	t.Parallel()
+	var nilPC *PositionController
+	_, err := nilPC.GetAllPositions()
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pc := SetupPositionController()
+	positions, err := pc.GetAllPositions()
+	require.NoError(t, err, "GetAllPositions must not error")
+	assert.Empty(t, positions, "GetAllPositions should return no positions")
+
+	cp := currency.NewPair(currency.BTC, currency.PERP)
+	tn := time.Now()
+	for i, side := range []order.Side{order.Long, order.Short, order.Long} {
+		err = pc.TrackNewOrder(&order.Detail{
+			Date:      tn.Add(time.Duration(i) * time.Second),
+			Exchange:  testExchange,
+			Pair:      cp,
+			AssetType: asset.Futures,
+			Side:      side,
+			OrderID:   strconv.Itoa(i),
+			Price:     1337,
+			Amount:    1,
+		})
+		require.NoError(t, err, "TrackNewOrder must not error")
+	}
+	positions, err = pc.GetAllPositions()
+	require.NoError(t, err, "GetAllPositions must not error")
+	assert.Len(t, positions, 2, "GetAllPositions should return closed and open positions")
+}
+
+func TestRestorePositions(t *testing.T) {
+	t.Parallel()
+	var nilPC *PositionController
+	err := nilPC.RestorePositions(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	cp := currency.NewPair(currency.BTC, currency.PERP)
+	tn := time.Now().Truncate(time.Second)
+	source := SetupPositionController()
+	for i, side := range []order.Side{order.Long, order.Short, order.Short} {
+		err = source.TrackNewOrder(&order.Detail{
+			Date:      tn.Add(time.Duration(i) * time.Second),
+			Exchange:  testExchange,
+			Pair:      cp,
+			AssetType: asset.Futures,
+			Side:      side,
+			OrderID:   strconv.Itoa(i),
+			Price:     1337 + float64(i),
+			Amount:    1,
+		})
+		require.NoError(t, err, "TrackNewOrder must not error")
+	}
+	_, err = source.UpdateOpenPositionUnrealisedPNL(testExchange, asset.Futures, cp, 1330, tn.Add(time.Minute))
+	require.NoError(t, err, "UpdateOpenPositionUnrealisedPNL must not error")
+	require.NoError(t, source.SetCollateralCurrency(testExchange, asset.Futures, cp, currency.USDT), "SetCollateralCurrency must not error")
+	stored, err := source.GetAllPositions()
+	require.NoError(t, err, "GetAllPositions must not error")
+	require.Len(t, stored, 2, "GetAllPositions must return both positions")
+
+	pc := SetupPositionController()
+	err = pc.RestorePositions([]Position{{Asset: asset.Futures, Pair: cp}})
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	bad := []Position{
+		{Exchange: testExchange, Asset: asset.Futures, Pair: cp, Status: order.Open, OpeningDate: tn},
+		{Exchange: testExchange, Asset: asset.Futures, Pair: cp, Status: order.Open, OpeningDate: tn.Add(time.Second)},
+	}
+	err = pc.RestorePositions(bad)
+	assert.ErrorIs(t, err, errPositionDiscrepancy)
+
+	err = pc.RestorePositions(stored)
+	require.NoError(t, err, "RestorePositions must not error")
+	restored, err := pc.GetPositionsForExchange(testExchange, asset.Futures, cp)
+	require.NoError(t, err, "GetPositionsForExchange must not error")
+	require.Len(t, restored, 2, "GetPositionsForExchange must return both restored positions")
+	assert.Equal(t, order.Closed, restored[0].Status, "Status should be restored")
+	assert.True(t, restored[0].ClosingPrice.Equal(decimal.NewFromInt(1338)), "ClosingPrice should be restored")
+	assert.Equal(t, currency.USDT, restored[1].CollateralCurrency, "CollateralCurrency should be restored")
+	assert.Equal(t, stored[1].PNLHistory, restored[1].PNLHistory, "PNLHistory should be restored")
+	assert.Len(t, restored[1].Orders, 1, "Orders should be restored")
+
+	updated, err := pc.LastUpdated()
+	require.NoError(t, err, "LastUpdated must not error")
+	assert.Equal(t, stored[1].LastUpdated, updated, "LastUpdated should be the latest restored position update")
+
+	err = pc.TrackNewOrder(&order.Detail{
+		Date:      tn.Add(time.Minute),
+		Exchange:  testExchange,
+		Pair:      cp,
+		AssetType: asset.Futures,
+		Side:      order.Short,
+		OrderID:   "2",
+		Price:     1339,
+		Amount:    1,
+	})
+	require.NoError(t, err, "TrackNewOrder must not error for a restored order")
+	open, err := pc.GetOpenPosition(testExchange, asset.Futures, cp)
+	require.NoError(t, err, "GetOpenPosition must not error")
+	assert.Len(t, open.Orders, 1, "a restored order should update its position rather than be appended")
+}
+
 func TestPCTrackFundingDetails(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
@@ -251,6 +251,7 @@ type PNLResult struct {
 // Position is a basic holder for position information
 type Position struct {
 	Exchange           string
+	Account            string
 	Asset              asset.Item
 	Pair               currency.Pair
 	Underlying         currency.Code
@@ -266,6 +267,7 @@ type Position struct {
 	LatestSize         decimal.Decimal
 	LatestDirection    order.Side
 	LastUpdated        time.Time
+	ClosingPrice       decimal.Decimal
 	CloseDate          time.Time
 	Orders             []order.Detail
 	PNLHistory         []PNLResult
@@ -155,6 +155,7 @@ func (e *Exchange) SetDefaults() {
 	e.Requester, err = request.New(e.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
 		request.WithLimiter(packageRateLimits),
+		request.WithMarketDataShedding(exchange.DefaultMarketDataShedThreshold),
 	)
 	if err != nil {
 		log.Errorln(log.ExchangeSys, err)
@@ -152,8 +152,8 @@ type AccountManagement interface {
 // credentials
 type CredentialsManagement interface {
 	// GetCredentials returns the credentials set within the context or, if
-	// absent, falls back to the exchange's default credentials loaded from
-	// config.json.
+	// absent, falls back to the credential profile selected within the context
+	// or the exchange's default credentials loaded from config.json.
 	GetCredentials(ctx context.Context) (*accounts.Credentials, error)
 	// SetCredentials sets the exchange's default API credentials. See
 	// exchanges/credentials.go Base method for implementation.
@@ -161,6 +161,13 @@ type CredentialsManagement interface {
 	// GetDefaultCredentials returns the exchange.Base API credentials loaded by
 	// config.json. See exchanges/credentials.go Base method for implementation.
 	GetDefaultCredentials() *accounts.Credentials
+	// SetCredentialProfile sets the API credentials for a named account. See
+	// exchanges/credentials.go Base method for implementation.
+	SetCredentialProfile(name string, creds *accounts.Credentials) error
+	// GetCredentialProfiles returns the names of the accounts with credential
+	// profiles set. See exchanges/credentials.go Base method for
+	// implementation.
+	GetCredentialProfiles() []string
 	// ValidateAPICredentials validates the API keys by sending an authenticated
 	// REST request. See exchange specific wrapper implementation.
 	ValidateAPICredentials(ctx context.Context, a asset.Item) error
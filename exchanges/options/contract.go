@@ -0,0 +1,123 @@
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// expiryLayouts are the date formats exchanges use in option instrument
+// names, eg 27DEC24 for Deribit and Bybit, 241227 for OKX and 20241227 for
+// GateIO
+var expiryLayouts = []string{"2Jan06", "060102", "20060102"}
+
+// String returns the string representation of an option type
+func (t Type) String() string {
+	switch t {
+	case Call:
+		return "call"
+	case Put:
+		return "put"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToType converts a case insensitive option type
+func StringToType(s string) (Type, error) {
+	switch strings.ToUpper(s) {
+	case "C", "CALL":
+		return Call, nil
+	case "P", "PUT":
+		return Put, nil
+	default:
+		return UnknownType, fmt.Errorf("%w: %q", errUnknownOptionType, s)
+	}
+}
+
+// String returns the string representation of a pricing model
+func (m Model) String() string {
+	switch m {
+	case Black76:
+		return "black76"
+	case BlackScholes:
+		return "blackscholes"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToModel converts a case insensitive pricing model, an empty string
+// returns Black76
+func StringToModel(s string) (Model, error) {
+	switch strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s)) {
+	case "", "black76":
+		return Black76, nil
+	case "blackscholes", "bs":
+		return BlackScholes, nil
+	default:
+		return Black76, fmt.Errorf("%w: %q", errUnknownModel, s)
+	}
+}
+
+// ParseContract works out the terms of an option contract from its pair.
+// Instrument names such as BTC-27DEC24-50000-C, BTC-USD-241227-50000-C,
+// SOL-4MAR26-85-C-USDT and XRP_USDT-20260313-1.5-C are supported, a D within
+// the strike is treated as a decimal point as used by Deribit
+func ParseContract(pair currency.Pair) (*Contract, error) {
+	if pair.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	tokens := strings.FieldsFunc(pair.Base.String()+"-"+pair.Quote.String(), func(r rune) bool {
+		return r == '-' || r == '_' || r == '/'
+	})
+	expiryIndex := -1
+	var expiry time.Time
+	for i := 1; i < len(tokens) && expiryIndex == -1; i++ {
+		for _, layout := range expiryLayouts {
+			if t, err := time.Parse(layout, tokens[i]); err == nil {
+				expiry, expiryIndex = t.Add(expiryHour*time.Hour), i
+				break
+			}
+		}
+	}
+	if expiryIndex == -1 || expiryIndex+2 >= len(tokens) {
+		return nil, fmt.Errorf("%w: %v", ErrNotOptionContract, pair)
+	}
+	strike, err := strconv.ParseFloat(strings.ReplaceAll(strings.ToUpper(tokens[expiryIndex+1]), "D", "."), 64)
+	if err != nil || strike <= 0 {
+		return nil, fmt.Errorf("%w: %w %q", ErrNotOptionContract, errInvalidStrike, tokens[expiryIndex+1])
+	}
+	optionType, err := StringToType(tokens[expiryIndex+2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotOptionContract, err)
+	}
+	c := &Contract{
+		Pair:       pair,
+		Underlying: currency.NewCode(tokens[0]),
+		Expiry:     expiry,
+		Strike:     strike,
+		Type:       optionType,
+	}
+	switch {
+	case expiryIndex > 1:
+		c.Settlement = currency.NewCode(tokens[1])
+	case expiryIndex+3 < len(tokens):
+		c.Settlement = currency.NewCode(tokens[expiryIndex+3])
+	}
+	return c, nil
+}
+
+// IsInverse returns whether the option premium is quoted in the underlying
+// currency rather than the quote currency
+func (c *Contract) IsInverse() bool {
+	return c.Settlement.IsEmpty() || c.Settlement.Equal(currency.USD)
+}
+
+// TimeToExpiry returns the time in years between t and the contract expiry
+func (c *Contract) TimeToExpiry(t time.Time) float64 {
+	return c.Expiry.Sub(t).Hours() / 24 / daysPerYear
+}
@@ -0,0 +1,304 @@
+package options
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const (
+	minVolatility        = 1e-6
+	maxVolatility        = 10
+	volatilityTolerance  = 1e-8
+	maxNewtonIterations  = 50
+	maxBisectIterations  = 200
+	minVegaForNewtonStep = 1e-10
+)
+
+// Price returns the value of an option in the quote currency of its
+// underlying
+func Price(in *Inputs) (float64, error) {
+	if err := in.validate(); err != nil {
+		return 0, err
+	}
+	d1, d2 := in.d1d2()
+	carry, discount := in.factors()
+	s, k := in.UnderlyingPrice, in.Strike
+	if in.Type == Call {
+		return s*carry*normCDF(d1) - k*discount*normCDF(d2), nil
+	}
+	return k*discount*normCDF(-d2) - s*carry*normCDF(-d1), nil
+}
+
+// CalculateGreeks returns the sensitivities of an option price to its inputs
+func CalculateGreeks(in *Inputs) (Greeks, error) {
+	price, err := Price(in)
+	if err != nil {
+		return Greeks{}, err
+	}
+	d1, d2 := in.d1d2()
+	carry, discount := in.factors()
+	s, k, t, r := in.UnderlyingPrice, in.Strike, in.TimeToExpiry, in.RiskFreeRate
+	b := in.costOfCarry()
+	sqrtT := math.Sqrt(t)
+	density := normPDF(d1)
+
+	g := Greeks{
+		Gamma: carry * density / (s * in.Volatility * sqrtT),
+		Vega:  s * carry * density * sqrtT / 100,
+	}
+	decay := -s * carry * density * in.Volatility / (2 * sqrtT)
+	if in.Type == Call {
+		g.Delta = carry * normCDF(d1)
+		g.Theta = decay - (b-r)*s*carry*normCDF(d1) - r*k*discount*normCDF(d2)
+		g.Rho = t * k * discount * normCDF(d2)
+	} else {
+		g.Delta = carry * (normCDF(d1) - 1)
+		g.Theta = decay + (b-r)*s*carry*normCDF(-d1) + r*k*discount*normCDF(-d2)
+		g.Rho = -t * k * discount * normCDF(-d2)
+	}
+	if in.Model == Black76 {
+		// The forward price is unaffected by the discount rate so only the
+		// discounting of the premium contributes to rho
+		g.Rho = -t * price
+	}
+	g.Theta /= daysPerYear
+	g.Rho /= 100
+	return g, nil
+}
+
+// ImpliedVolatility returns the volatility which prices the option at the
+// supplied price, the Volatility field of the inputs is ignored
+func ImpliedVolatility(price float64, in *Inputs) (float64, error) {
+	if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidOptionPrice, price)
+	}
+	trial := *in
+	trial.Volatility = minVolatility
+	lower, err := Price(&trial)
+	if err != nil {
+		return 0, err
+	}
+	trial.Volatility = maxVolatility
+	upper, err := Price(&trial)
+	if err != nil {
+		return 0, err
+	}
+	if price < lower || price > upper {
+		return 0, fmt.Errorf("%w: %v not within [%v, %v]", ErrPriceOutsideBounds, price, lower, upper)
+	}
+
+	// Brenner-Subrahmanyam approximation as the starting point for Newton's
+	// method, falling back to bisection when a step leaves the bracket
+	low, high := minVolatility, float64(maxVolatility)
+	vol := math.Sqrt(2*math.Pi/in.TimeToExpiry) * price / in.UnderlyingPrice
+	if vol <= low || vol >= high {
+		vol = 0.5
+	}
+	for range maxNewtonIterations {
+		trial.Volatility = vol
+		p, err := Price(&trial)
+		if err != nil {
+			return 0, err
+		}
+		diff := p - price
+		if math.Abs(diff) < volatilityTolerance*math.Max(1, price) {
+			return vol, nil
+		}
+		if diff > 0 {
+			high = vol
+		} else {
+			low = vol
+		}
+		g, err := CalculateGreeks(&trial)
+		if err != nil {
+			return 0, err
+		}
+		vega := g.Vega * 100
+		if vega < minVegaForNewtonStep {
+			break
+		}
+		next := vol - diff/vega
+		if next <= low || next >= high {
+			break
+		}
+		vol = next
+	}
+	for range maxBisectIterations {
+		vol = (low + high) / 2
+		trial.Volatility = vol
+		p, err := Price(&trial)
+		if err != nil {
+			return 0, err
+		}
+		diff := p - price
+		if math.Abs(diff) < volatilityTolerance*math.Max(1, price) || high-low < volatilityTolerance {
+			return vol, nil
+		}
+		if diff > 0 {
+			high = vol
+		} else {
+			low = vol
+		}
+	}
+	return 0, fmt.Errorf("%w for price %v", ErrNoConvergence, price)
+}
+
+// Analyse works out the implied volatility and Greeks of an option quote
+func Analyse(q *Quote, p *Parameters) (*Analytics, error) {
+	if err := common.NilGuard(q, p); err != nil {
+		return nil, err
+	}
+	if q.UnderlyingPrice <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidUnderlyingPrice, q.UnderlyingPrice)
+	}
+	ts := q.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	tte := q.Contract.TimeToExpiry(ts)
+	if tte <= 0 {
+		return nil, fmt.Errorf("%w: %v expired %v", ErrContractExpired, q.Contract.Pair, q.Contract.Expiry)
+	}
+	price := q.Price
+	if q.Contract.IsInverse() {
+		price *= q.UnderlyingPrice
+	}
+	in := &Inputs{
+		Model:           p.Model,
+		Type:            q.Contract.Type,
+		UnderlyingPrice: q.UnderlyingPrice,
+		Strike:          q.Contract.Strike,
+		TimeToExpiry:    tte,
+		RiskFreeRate:    p.RiskFreeRate,
+		DividendYield:   p.DividendYield,
+	}
+	vol, err := ImpliedVolatility(price, in)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", q.Contract.Pair, err)
+	}
+	in.Volatility = vol
+	greeks, err := CalculateGreeks(in)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", q.Contract.Pair, err)
+	}
+	return &Analytics{
+		Contract:          q.Contract,
+		Premium:           q.Price,
+		Price:             price,
+		UnderlyingPrice:   q.UnderlyingPrice,
+		TimeToExpiry:      tte,
+		ImpliedVolatility: vol,
+		Greeks:            greeks,
+		Timestamp:         ts,
+	}, nil
+}
+
+// QuoteFromTicker builds a quote from the mid price of an option ticker,
+// falling back to the mark and last price when either side of the book is
+// missing. When underlyingPrice is not set the ticker index price is used
+func QuoteFromTicker(t *ticker.Price, underlyingPrice float64) (*Quote, error) {
+	if err := common.NilGuard(t); err != nil {
+		return nil, err
+	}
+	c, err := ParseContract(t.Pair)
+	if err != nil {
+		return nil, err
+	}
+	var price float64
+	switch {
+	case t.Bid > 0 && t.Ask > 0:
+		price = (t.Bid + t.Ask) / 2
+	case t.MarkPrice > 0:
+		price = t.MarkPrice
+	default:
+		price = t.Last
+	}
+	if price <= 0 {
+		return nil, fmt.Errorf("%w: %v has no price", ErrInvalidOptionPrice, t.Pair)
+	}
+	if underlyingPrice <= 0 {
+		underlyingPrice = t.IndexPrice
+	}
+	return &Quote{
+		Contract:        *c,
+		Price:           price,
+		UnderlyingPrice: underlyingPrice,
+		Timestamp:       t.LastUpdated,
+	}, nil
+}
+
+// QuoteFromOrderbook builds a quote from the mid price of an option
+// orderbook
+func QuoteFromOrderbook(b *orderbook.Book, underlyingPrice float64) (*Quote, error) {
+	if err := common.NilGuard(b); err != nil {
+		return nil, err
+	}
+	c, err := ParseContract(b.Pair)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return nil, fmt.Errorf("%w: %v orderbook is one sided", ErrInvalidOptionPrice, b.Pair)
+	}
+	return &Quote{
+		Contract:        *c,
+		Price:           (b.Bids[0].Price + b.Asks[0].Price) / 2,
+		UnderlyingPrice: underlyingPrice,
+		Timestamp:       b.LastUpdated,
+	}, nil
+}
+
+func (in *Inputs) validate() error {
+	if err := common.NilGuard(in); err != nil {
+		return err
+	}
+	switch {
+	case in.Type != Call && in.Type != Put:
+		return errUnknownOptionType
+	case in.Model != Black76 && in.Model != BlackScholes:
+		return errUnknownModel
+	case in.UnderlyingPrice <= 0:
+		return fmt.Errorf("%w: %v", ErrInvalidUnderlyingPrice, in.UnderlyingPrice)
+	case in.Strike <= 0:
+		return fmt.Errorf("%w: %v", errInvalidStrike, in.Strike)
+	case in.TimeToExpiry <= 0:
+		return ErrContractExpired
+	case in.Volatility <= 0:
+		return fmt.Errorf("%w: %v", errInvalidVolatility, in.Volatility)
+	}
+	return nil
+}
+
+// costOfCarry returns the generalised Black-Scholes cost of carry, which is
+// zero for an option on a forward
+func (in *Inputs) costOfCarry() float64 {
+	if in.Model == Black76 {
+		return 0
+	}
+	return in.RiskFreeRate - in.DividendYield
+}
+
+// factors returns the carry and discount factors applied to the underlying
+// and strike legs of the option value
+func (in *Inputs) factors() (carry, discount float64) {
+	return math.Exp((in.costOfCarry() - in.RiskFreeRate) * in.TimeToExpiry), math.Exp(-in.RiskFreeRate * in.TimeToExpiry)
+}
+
+func (in *Inputs) d1d2() (d1, d2 float64) {
+	volSqrtT := in.Volatility * math.Sqrt(in.TimeToExpiry)
+	d1 = (math.Log(in.UnderlyingPrice/in.Strike) + (in.costOfCarry()+in.Volatility*in.Volatility/2)*in.TimeToExpiry) / volSqrtT
+	return d1, d1 - volSqrtT
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
@@ -0,0 +1,298 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestParseContract(t *testing.T) {
+	t.Parallel()
+	_, err := ParseContract(currency.EMPTYPAIR)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+
+	for _, tc := range []struct {
+		pair       string
+		underlying string
+		settlement string
+		expiry     time.Time
+		strike     float64
+		optionType Type
+		inverse    bool
+	}{
+		{"BTC-27DEC24-50000-C", "BTC", "", time.Date(2024, 12, 27, 8, 0, 0, 0, time.UTC), 50000, Call, true},
+		{"TRX-USDC-6MAR26-0D264-P", "TRX", "USDC", time.Date(2026, 3, 6, 8, 0, 0, 0, time.UTC), 0.264, Put, false},
+		{"BTC-USD-260304-58000-C", "BTC", "USD", time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC), 58000, Call, true},
+		{"SOL-4MAR26-85-P-USDT", "SOL", "USDT", time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC), 85, Put, false},
+		{"XRP_USDT-20260313-1.5-C", "XRP", "USDT", time.Date(2026, 3, 13, 8, 0, 0, 0, time.UTC), 1.5, Call, false},
+	} {
+		t.Run(tc.pair, func(t *testing.T) {
+			t.Parallel()
+			p, err := currency.NewPairFromString(tc.pair)
+			require.NoError(t, err, "NewPairFromString must not error")
+			c, err := ParseContract(p)
+			require.NoError(t, err, "ParseContract must not error")
+			assert.Equal(t, tc.underlying, c.Underlying.String(), "Underlying should be correct")
+			assert.Equal(t, tc.settlement, c.Settlement.String(), "Settlement should be correct")
+			assert.Equal(t, tc.expiry, c.Expiry, "Expiry should be correct")
+			assert.Equal(t, tc.strike, c.Strike, "Strike should be correct")
+			assert.Equal(t, tc.optionType, c.Type, "Type should be correct")
+			assert.Equal(t, tc.inverse, c.IsInverse(), "IsInverse should be correct")
+		})
+	}
+
+	for _, s := range []string{"BTC-USDT", "BTC-PERPETUAL", "BTC-27DEC24", "BTC-27DEC24-50000-X", "BTC-27DEC24-ABC-C"} {
+		p, err := currency.NewPairFromString(s)
+		require.NoError(t, err, "NewPairFromString must not error")
+		_, err = ParseContract(p)
+		assert.ErrorIsf(t, err, ErrNotOptionContract, "ParseContract should error for %s", s)
+	}
+}
+
+func TestStringToTypeAndModel(t *testing.T) {
+	t.Parallel()
+	typ, err := StringToType("CALL")
+	require.NoError(t, err, "StringToType must not error")
+	assert.Equal(t, Call, typ)
+	typ, err = StringToType("p")
+	require.NoError(t, err, "StringToType must not error")
+	assert.Equal(t, Put, typ)
+	_, err = StringToType("straddle")
+	assert.ErrorIs(t, err, errUnknownOptionType)
+	assert.Equal(t, "put", Put.String())
+	assert.Equal(t, "unknown", UnknownType.String())
+
+	m, err := StringToModel("")
+	require.NoError(t, err, "StringToModel must not error")
+	assert.Equal(t, Black76, m)
+	m, err = StringToModel("Black-Scholes")
+	require.NoError(t, err, "StringToModel must not error")
+	assert.Equal(t, BlackScholes, m)
+	_, err = StringToModel("binomial")
+	assert.ErrorIs(t, err, errUnknownModel)
+	assert.Equal(t, "black76", Black76.String())
+}
+
+func TestPrice(t *testing.T) {
+	t.Parallel()
+	_, err := Price(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	in := &Inputs{Model: BlackScholes, Type: Call, UnderlyingPrice: 100, Strike: 100, TimeToExpiry: 1, Volatility: 0.2, RiskFreeRate: 0.05}
+	p, err := Price(in)
+	require.NoError(t, err, "Price must not error")
+	assert.InDelta(t, 10.4506, p, 1e-4, "Black-Scholes call price should be correct")
+
+	in.Type = Put
+	p, err = Price(in)
+	require.NoError(t, err, "Price must not error")
+	assert.InDelta(t, 5.5735, p, 1e-4, "Black-Scholes put price should be correct")
+
+	in.Model, in.Type = Black76, Call
+	p, err = Price(in)
+	require.NoError(t, err, "Price must not error")
+	assert.InDelta(t, 7.5771, p, 1e-4, "Black-76 call price should be correct")
+
+	for _, bad := range []Inputs{
+		{Model: Black76, Type: UnknownType, UnderlyingPrice: 100, Strike: 100, TimeToExpiry: 1, Volatility: 0.2},
+		{Model: Black76, Type: Call, UnderlyingPrice: 0, Strike: 100, TimeToExpiry: 1, Volatility: 0.2},
+		{Model: Black76, Type: Call, UnderlyingPrice: 100, Strike: 0, TimeToExpiry: 1, Volatility: 0.2},
+		{Model: Black76, Type: Call, UnderlyingPrice: 100, Strike: 100, TimeToExpiry: 0, Volatility: 0.2},
+		{Model: Black76, Type: Call, UnderlyingPrice: 100, Strike: 100, TimeToExpiry: 1, Volatility: 0},
+	} {
+		_, err = Price(&bad)
+		assert.Error(t, err, "Price should error on invalid inputs")
+	}
+}
+
+func TestCalculateGreeks(t *testing.T) {
+	t.Parallel()
+	in := &Inputs{Model: BlackScholes, Type: Call, UnderlyingPrice: 100, Strike: 100, TimeToExpiry: 1, Volatility: 0.2, RiskFreeRate: 0.05}
+	g, err := CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, 0.6368, g.Delta, 1e-4, "Delta should be correct")
+	assert.InDelta(t, 0.018762, g.Gamma, 1e-6, "Gamma should be correct")
+	assert.InDelta(t, 0.37524, g.Vega, 1e-5, "Vega should be correct")
+	assert.InDelta(t, -6.4140/365, g.Theta, 1e-5, "Theta should be correct")
+	assert.InDelta(t, 0.53232, g.Rho, 1e-5, "Rho should be correct")
+
+	in.Type = Put
+	g, err = CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, -0.3632, g.Delta, 1e-4, "Delta should be correct")
+	assert.InDelta(t, -1.6579/365, g.Theta, 1e-5, "Theta should be correct")
+	assert.InDelta(t, -0.41890, g.Rho, 1e-5, "Rho should be correct")
+
+	in.Model, in.Type = Black76, Call
+	g, err = CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, 0.5135, g.Delta, 1e-4, "Black-76 Delta should be discounted")
+	assert.InDelta(t, -0.075771, g.Rho, 1e-5, "Black-76 Rho should only reflect discounting")
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	t.Parallel()
+	for _, vol := range []float64{0.05, 0.2, 0.8, 2.5} {
+		for _, strike := range []float64{50, 100, 180} {
+			in := &Inputs{Model: Black76, Type: Call, UnderlyingPrice: 100, Strike: strike, TimeToExpiry: 0.25, Volatility: vol, RiskFreeRate: 0.03}
+			p, err := Price(in)
+			require.NoError(t, err, "Price must not error")
+			g, err := CalculateGreeks(in)
+			require.NoError(t, err, "CalculateGreeks must not error")
+			if g.Vega < 1e-4 {
+				// Volatility cannot be recovered when the price does not respond to it
+				continue
+			}
+			iv, err := ImpliedVolatility(p, in)
+			require.NoErrorf(t, err, "ImpliedVolatility must not error for vol %v strike %v", vol, strike)
+			assert.InDeltaf(t, vol, iv, 1e-4, "ImpliedVolatility should recover vol %v strike %v", vol, strike)
+		}
+	}
+
+	in := &Inputs{Model: Black76, Type: Call, UnderlyingPrice: 100, Strike: 80, TimeToExpiry: 0.25}
+	_, err := ImpliedVolatility(0, in)
+	assert.ErrorIs(t, err, ErrInvalidOptionPrice)
+	_, err = ImpliedVolatility(10, in)
+	assert.ErrorIs(t, err, ErrPriceOutsideBounds, "a price below intrinsic value should error")
+	_, err = ImpliedVolatility(150, in)
+	assert.ErrorIs(t, err, ErrPriceOutsideBounds, "a price above the underlying should error")
+}
+
+func TestAnalyse(t *testing.T) {
+	t.Parallel()
+	_, err := Analyse(nil, &Parameters{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	now := time.Date(2024, 12, 27, 8, 0, 0, 0, time.UTC).Add(-time.Hour * 24 * 73)
+	c, err := ParseContract(currency.NewPair(currency.BTC, currency.NewCode("27DEC24-50000-C")))
+	require.NoError(t, err, "ParseContract must not error")
+	in := &Inputs{Model: Black76, Type: Call, UnderlyingPrice: 52000, Strike: 50000, TimeToExpiry: c.TimeToExpiry(now), Volatility: 0.6}
+	p, err := Price(in)
+	require.NoError(t, err, "Price must not error")
+
+	q := &Quote{Contract: *c, Price: p / 52000, UnderlyingPrice: 52000, Timestamp: now}
+	a, err := Analyse(q, &Parameters{})
+	require.NoError(t, err, "Analyse must not error")
+	assert.InDelta(t, 0.2, a.TimeToExpiry, 1e-9, "TimeToExpiry should be correct")
+	assert.InDelta(t, 0.6, a.ImpliedVolatility, 1e-6, "ImpliedVolatility should be recovered from an inverse premium")
+	assert.InDelta(t, p, a.Price, 1e-6, "Price should be converted to the quote currency")
+	assert.Equal(t, q.Price, a.Premium, "Premium should be the quoted price")
+	assert.Positive(t, a.Greeks.Delta, "Delta should be positive for a call")
+
+	q.UnderlyingPrice = 0
+	_, err = Analyse(q, &Parameters{})
+	assert.ErrorIs(t, err, ErrInvalidUnderlyingPrice)
+
+	q.UnderlyingPrice, q.Timestamp = 52000, c.Expiry.Add(time.Minute)
+	_, err = Analyse(q, &Parameters{})
+	assert.ErrorIs(t, err, ErrContractExpired)
+}
+
+func TestQuoteFromTicker(t *testing.T) {
+	t.Parallel()
+	_, err := QuoteFromTicker(nil, 0)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pair, err := currency.NewPairFromString("BTC-USD-260304-58000-C")
+	require.NoError(t, err, "NewPairFromString must not error")
+	tick := &ticker.Price{Pair: pair, Bid: 0.01, Ask: 0.03, MarkPrice: 0.05, IndexPrice: 60000}
+	q, err := QuoteFromTicker(tick, 0)
+	require.NoError(t, err, "QuoteFromTicker must not error")
+	assert.InDelta(t, 0.02, q.Price, 1e-12, "Price should be the mid price")
+	assert.Equal(t, 60000.0, q.UnderlyingPrice, "UnderlyingPrice should fall back to the index price")
+
+	tick.Bid = 0
+	q, err = QuoteFromTicker(tick, 61000)
+	require.NoError(t, err, "QuoteFromTicker must not error")
+	assert.Equal(t, 0.05, q.Price, "Price should fall back to the mark price")
+	assert.Equal(t, 61000.0, q.UnderlyingPrice, "UnderlyingPrice should be the supplied price")
+
+	tick.MarkPrice = 0
+	_, err = QuoteFromTicker(tick, 0)
+	assert.ErrorIs(t, err, ErrInvalidOptionPrice)
+
+	_, err = QuoteFromTicker(&ticker.Price{Pair: currency.NewBTCUSDT(), Last: 1}, 0)
+	assert.ErrorIs(t, err, ErrNotOptionContract)
+}
+
+func TestQuoteFromOrderbook(t *testing.T) {
+	t.Parallel()
+	_, err := QuoteFromOrderbook(nil, 0)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pair, err := currency.NewPairFromString("SOL-4MAR26-85-P-USDT")
+	require.NoError(t, err, "NewPairFromString must not error")
+	b := &orderbook.Book{Pair: pair, Bids: orderbook.Levels{{Price: 2}}}
+	_, err = QuoteFromOrderbook(b, 90)
+	assert.ErrorIs(t, err, ErrInvalidOptionPrice)
+
+	b.Asks = orderbook.Levels{{Price: 3}}
+	q, err := QuoteFromOrderbook(b, 90)
+	require.NoError(t, err, "QuoteFromOrderbook must not error")
+	assert.Equal(t, 2.5, q.Price, "Price should be the mid price")
+	assert.Equal(t, Put, q.Contract.Type, "Contract should be parsed")
+}
+
+func TestBuildSurface(t *testing.T) {
+	t.Parallel()
+	_, err := BuildSurface(currency.EMPTYCODE, nil)
+	assert.ErrorIs(t, err, currency.ErrCurrencyCodeEmpty)
+	_, err = BuildSurface(currency.BTC, nil)
+	assert.ErrorIs(t, err, ErrNoQuotes)
+
+	near := time.Date(2026, 3, 6, 8, 0, 0, 0, time.UTC)
+	far := near.AddDate(0, 1, 0)
+	analysed := func(expiry time.Time, tte, strike float64, typ Type, vol float64) Analytics {
+		return Analytics{
+			Exchange:          "Deribit",
+			Contract:          Contract{Underlying: currency.BTC, Expiry: expiry, Strike: strike, Type: typ},
+			UnderlyingPrice:   100,
+			TimeToExpiry:      tte,
+			ImpliedVolatility: vol,
+		}
+	}
+	s, err := BuildSurface(currency.BTC, []Analytics{
+		analysed(far, 0.2, 90, Put, 0.7),
+		analysed(near, 0.1, 110, Call, 0.55),
+		analysed(near, 0.1, 90, Put, 0.65),
+		analysed(near, 0.1, 90, Call, 0.9),
+		analysed(near, 0.1, 100, Call, 0.5),
+		analysed(far, 0.2, 110, Call, 0.6),
+		{Contract: Contract{Underlying: currency.ETH, Expiry: near, Strike: 100, Type: Call}, ImpliedVolatility: 1},
+	})
+	require.NoError(t, err, "BuildSurface must not error")
+	assert.Equal(t, "Deribit", s.Exchange, "Exchange should be set")
+	require.Len(t, s.Smiles, 2, "BuildSurface must group by expiry")
+	assert.Equal(t, near, s.Smiles[0].Expiry, "Smiles should be sorted by expiry")
+	require.Len(t, s.Smiles[0].Points, 3, "Smile must contain a point per strike")
+	assert.Equal(t, 0.65, s.Smiles[0].Points[0].ImpliedVolatility, "the out of the money put should be used below the underlying price")
+	assert.Equal(t, 0.5, s.Smiles[0].ATMVolatility, "ATMVolatility should be the at the money volatility")
+	assert.InDelta(t, 0.65, s.Smiles[1].ATMVolatility, 1e-12, "ATMVolatility should be interpolated between strikes")
+	assert.Less(t, s.Smiles[0].Points[0].Moneyness, 0.0, "Moneyness should be negative below the underlying price")
+
+	terms := s.TermStructure()
+	require.Len(t, terms, 2, "TermStructure must return a point per expiry")
+	assert.Equal(t, 0.5, terms[0].ATMVolatility, "TermStructure should use the ATM volatility")
+
+	v, err := s.Volatility(105, near)
+	require.NoError(t, err, "Volatility must not error")
+	assert.InDelta(t, 0.525, v, 1e-12, "Volatility should interpolate by strike")
+	v, err = s.Volatility(80, near)
+	require.NoError(t, err, "Volatility must not error")
+	assert.Equal(t, 0.65, v, "Volatility should be flat beyond the smile")
+
+	mid := near.Add(far.Sub(near) / 2)
+	v, err = s.Volatility(100, mid)
+	require.NoError(t, err, "Volatility must not error")
+	assert.Greater(t, v, 0.5, "Volatility should be interpolated between expiries")
+	assert.Less(t, v, 0.65, "Volatility should be interpolated between expiries")
+
+	_, err = s.Volatility(100, far.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrExpiryOutsideSurface)
+}
@@ -0,0 +1,169 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// var error definitions
+var (
+	ErrNotOptionContract      = errors.New("pair is not an option contract")
+	ErrContractExpired        = errors.New("option contract has expired")
+	ErrNoConvergence          = errors.New("implied volatility did not converge")
+	ErrPriceOutsideBounds     = errors.New("option price outside no-arbitrage bounds")
+	ErrInvalidUnderlyingPrice = errors.New("invalid underlying price")
+	ErrInvalidOptionPrice     = errors.New("invalid option price")
+	ErrNoQuotes               = errors.New("no option quotes")
+	ErrExpiryOutsideSurface   = errors.New("expiry outside volatility surface")
+
+	errInvalidStrike     = errors.New("invalid strike")
+	errInvalidVolatility = errors.New("invalid volatility")
+	errUnknownOptionType = errors.New("unknown option type")
+	errUnknownModel      = errors.New("unknown pricing model")
+)
+
+// Type is the right an option contract grants its holder
+type Type uint8
+
+// Type definitions
+const (
+	UnknownType Type = iota
+	Call
+	Put
+)
+
+// Model is the pricing model used to value an option contract
+type Model uint8
+
+// Model definitions
+const (
+	// Black76 prices an option on a forward or futures price, which suits
+	// crypto options settled against an index or futures price
+	Black76 Model = iota
+	// BlackScholes prices an option on a spot price with a cost of carry
+	BlackScholes
+)
+
+const (
+	// expiryHour is the UTC hour crypto option contracts settle on their
+	// expiry date
+	expiryHour = 8
+	// daysPerYear is used to annualise time to expiry and express theta
+	// per calendar day as crypto markets trade every day
+	daysPerYear = 365
+)
+
+// Contract holds the terms of an option contract parsed from its pair
+type Contract struct {
+	Pair       currency.Pair
+	Underlying currency.Code
+	// Settlement is the currency the option is margined and settled in when
+	// the instrument name carries one, an empty or USD settlement means the
+	// premium is quoted in the underlying currency
+	Settlement currency.Code
+	Expiry     time.Time
+	Strike     float64
+	Type       Type
+}
+
+// Parameters holds the market inputs which are not observed from the option
+// quote itself
+type Parameters struct {
+	Model Model
+	// RiskFreeRate is the annualised continuously compounded interest rate
+	RiskFreeRate float64
+	// DividendYield is the annualised carry yield of the underlying, it is
+	// only used by the BlackScholes model
+	DividendYield float64
+}
+
+// Inputs holds everything required to price an option
+type Inputs struct {
+	Model           Model
+	Type            Type
+	UnderlyingPrice float64
+	Strike          float64
+	// TimeToExpiry is measured in years
+	TimeToExpiry  float64
+	Volatility    float64
+	RiskFreeRate  float64
+	DividendYield float64
+}
+
+// Greeks holds the sensitivities of an option price. Vega and Rho are
+// expressed per one percentage point change and Theta per calendar day
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
+
+// Quote is an observed option price along with the underlying price it was
+// observed against
+type Quote struct {
+	Contract Contract
+	// Price is the option premium as quoted by the exchange
+	Price           float64
+	UnderlyingPrice float64
+	Timestamp       time.Time
+}
+
+// Analytics holds the implied volatility and Greeks worked out from a quote
+type Analytics struct {
+	Exchange string
+	Asset    asset.Item
+	Contract Contract
+	// Premium is the option price as quoted by the exchange and Price is the
+	// same value expressed in the quote currency of the underlying
+	Premium           float64
+	Price             float64
+	UnderlyingPrice   float64
+	TimeToExpiry      float64
+	ImpliedVolatility float64
+	Greeks            Greeks
+	Timestamp         time.Time
+}
+
+// SmilePoint is the implied volatility of a single strike on a smile
+type SmilePoint struct {
+	Strike float64
+	// Moneyness is the log of strike over the underlying price
+	Moneyness         float64
+	ImpliedVolatility float64
+	Delta             float64
+	Type              Type
+	Pair              currency.Pair
+}
+
+// Smile holds the implied volatilities of every strike of a single expiry,
+// sorted by strike
+type Smile struct {
+	Expiry          time.Time
+	TimeToExpiry    float64
+	UnderlyingPrice float64
+	// ATMVolatility is the implied volatility interpolated at the
+	// underlying price
+	ATMVolatility float64
+	Points        []SmilePoint
+}
+
+// TermPoint is the at the money implied volatility of a single expiry
+type TermPoint struct {
+	Expiry        time.Time
+	TimeToExpiry  float64
+	ATMVolatility float64
+}
+
+// Surface holds the volatility smiles of an underlying sorted by expiry
+type Surface struct {
+	Exchange   string
+	Asset      asset.Item
+	Underlying currency.Code
+	Timestamp  time.Time
+	Smiles     []Smile
+}
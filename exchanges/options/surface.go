@@ -0,0 +1,156 @@
+package options
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// BuildSurface groups analysed option contracts of an underlying into
+// volatility smiles per expiry. Where a call and a put share a strike the out
+// of the money contract is used as it is the more liquid of the two
+func BuildSurface(underlying currency.Code, analytics []Analytics) (*Surface, error) {
+	if underlying.IsEmpty() {
+		return nil, currency.ErrCurrencyCodeEmpty
+	}
+	s := &Surface{Underlying: underlying}
+	byExpiry := make(map[time.Time]*Smile)
+	for i := range analytics {
+		a := &analytics[i]
+		if !a.Contract.Underlying.Equal(underlying) || a.ImpliedVolatility <= 0 {
+			continue
+		}
+		if s.Exchange == "" {
+			s.Exchange, s.Asset = a.Exchange, a.Asset
+		}
+		if a.Timestamp.After(s.Timestamp) {
+			s.Timestamp = a.Timestamp
+		}
+		smile, ok := byExpiry[a.Contract.Expiry]
+		if !ok {
+			smile = &Smile{Expiry: a.Contract.Expiry}
+			byExpiry[a.Contract.Expiry] = smile
+		}
+		// Contracts of an expiry may be quoted at slightly different times
+		// so the most recent underlying price is used for the smile
+		if smile.UnderlyingPrice == 0 || a.TimeToExpiry < smile.TimeToExpiry {
+			smile.UnderlyingPrice, smile.TimeToExpiry = a.UnderlyingPrice, a.TimeToExpiry
+		}
+		point := SmilePoint{
+			Strike:            a.Contract.Strike,
+			ImpliedVolatility: a.ImpliedVolatility,
+			Delta:             a.Greeks.Delta,
+			Type:              a.Contract.Type,
+			Pair:              a.Contract.Pair,
+		}
+		idx := slices.IndexFunc(smile.Points, func(p SmilePoint) bool { return p.Strike == point.Strike })
+		switch {
+		case idx == -1:
+			smile.Points = append(smile.Points, point)
+		case isOutOfTheMoney(&point, a.UnderlyingPrice):
+			smile.Points[idx] = point
+		}
+	}
+	if len(byExpiry) == 0 {
+		return nil, fmt.Errorf("%w for %v", ErrNoQuotes, underlying)
+	}
+	for _, smile := range byExpiry {
+		slices.SortFunc(smile.Points, func(a, b SmilePoint) int {
+			switch {
+			case a.Strike < b.Strike:
+				return -1
+			case a.Strike > b.Strike:
+				return 1
+			}
+			return 0
+		})
+		for i := range smile.Points {
+			smile.Points[i].Moneyness = math.Log(smile.Points[i].Strike / smile.UnderlyingPrice)
+		}
+		smile.ATMVolatility = smile.Volatility(smile.UnderlyingPrice)
+		s.Smiles = append(s.Smiles, *smile)
+	}
+	slices.SortFunc(s.Smiles, func(a, b Smile) int { return a.Expiry.Compare(b.Expiry) })
+	return s, nil
+}
+
+// Volatility returns the implied volatility of a strike linearly
+// interpolated between the surrounding smile points, strikes outside the
+// smile take the volatility of the nearest point
+func (s *Smile) Volatility(strike float64) float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	if strike <= s.Points[0].Strike {
+		return s.Points[0].ImpliedVolatility
+	}
+	last := s.Points[len(s.Points)-1]
+	if strike >= last.Strike {
+		return last.ImpliedVolatility
+	}
+	i, _ := slices.BinarySearchFunc(s.Points, strike, func(p SmilePoint, k float64) int {
+		switch {
+		case p.Strike < k:
+			return -1
+		case p.Strike > k:
+			return 1
+		}
+		return 0
+	})
+	lower, upper := s.Points[i-1], s.Points[i]
+	if upper.Strike == strike {
+		return upper.ImpliedVolatility
+	}
+	weight := (strike - lower.Strike) / (upper.Strike - lower.Strike)
+	return lower.ImpliedVolatility + weight*(upper.ImpliedVolatility-lower.ImpliedVolatility)
+}
+
+// TermStructure returns the at the money implied volatility of every expiry
+func (s *Surface) TermStructure() []TermPoint {
+	terms := make([]TermPoint, len(s.Smiles))
+	for i := range s.Smiles {
+		terms[i] = TermPoint{
+			Expiry:        s.Smiles[i].Expiry,
+			TimeToExpiry:  s.Smiles[i].TimeToExpiry,
+			ATMVolatility: s.Smiles[i].ATMVolatility,
+		}
+	}
+	return terms
+}
+
+// Volatility returns the implied volatility of a strike and expiry. The
+// volatility is interpolated within each smile by strike and between smiles
+// linearly in total variance so that forward variance stays positive
+func (s *Surface) Volatility(strike float64, expiry time.Time) (float64, error) {
+	if len(s.Smiles) == 0 {
+		return 0, fmt.Errorf("%w for %v", ErrNoQuotes, s.Underlying)
+	}
+	first, last := s.Smiles[0], s.Smiles[len(s.Smiles)-1]
+	if expiry.Before(first.Expiry) || expiry.After(last.Expiry) {
+		return 0, fmt.Errorf("%w: %v not within [%v, %v]", ErrExpiryOutsideSurface, expiry, first.Expiry, last.Expiry)
+	}
+	i := slices.IndexFunc(s.Smiles, func(sm Smile) bool { return !sm.Expiry.Before(expiry) })
+	upper := &s.Smiles[i]
+	if upper.Expiry.Equal(expiry) {
+		return upper.Volatility(strike), nil
+	}
+	lower := &s.Smiles[i-1]
+	lowerVariance := lower.Volatility(strike) * lower.Volatility(strike) * lower.TimeToExpiry
+	upperVariance := upper.Volatility(strike) * upper.Volatility(strike) * upper.TimeToExpiry
+	weight := float64(expiry.Sub(lower.Expiry)) / float64(upper.Expiry.Sub(lower.Expiry))
+	tte := lower.TimeToExpiry + weight*(upper.TimeToExpiry-lower.TimeToExpiry)
+	if tte <= 0 {
+		return lower.Volatility(strike), nil
+	}
+	return math.Sqrt((lowerVariance + weight*(upperVariance-lowerVariance)) / tte), nil
+}
+
+func isOutOfTheMoney(p *SmilePoint, underlyingPrice float64) bool {
+	if p.Type == Call {
+		return p.Strike >= underlyingPrice
+	}
+	return p.Strike < underlyingPrice
+}
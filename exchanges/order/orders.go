@@ -214,6 +214,10 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) error {
 		d.AccountID = m.AccountID
 		updated = true
 	}
+	if m.Account != "" && m.Account != d.Account {
+		d.Account = m.Account
+		updated = true
+	}
 	if !m.Pair.IsEmpty() && !m.Pair.Equal(d.Pair) {
 		// TODO: Add a check to see if the original pair is empty as well, but
 		// error if it is changing from BTC-USD -> LTC-USD.
@@ -406,6 +410,8 @@ func (d *Detail) MatchFilter(f *Filter) bool {
 		return false
 	case f.AccountID != "" && d.AccountID != f.AccountID:
 		return false
+	case f.Account != "" && d.Account != f.Account:
+		return false
 	default:
 		return true
 	}
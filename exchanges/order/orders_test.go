@@ -1263,6 +1263,9 @@ func TestMatchFilter(t *testing.T) {
 		{"AccountID ✓", Filter{AccountID: "A"}, Detail{AccountID: "A"}, true},
 		{"AccountID 𐄂", Filter{AccountID: "A"}, Detail{AccountID: "B"}, false},
 		{"AccountID Empty", Filter{AccountID: "A"}, Detail{}, false},
+		{"Account ✓", Filter{Account: "A"}, Detail{Account: "A"}, true},
+		{"Account 𐄂", Filter{Account: "A"}, Detail{Account: "B"}, false},
+		{"Account Empty", Filter{Account: "A"}, Detail{}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -13,8 +13,11 @@ type verbosity string
 
 type headersKey struct{}
 
+type priorityKey struct{}
+
 func init() {
 	common.RegisterContextKey(headersKey{})
+	common.RegisterContextKey(priorityKey{})
 }
 
 // WithVerbose adds verbosity to a request context so that specific requests
@@ -69,3 +72,31 @@ func hasRetryNotAllowed(ctx context.Context) bool {
 	_, ok := ctx.Value(retryNotAllowedKey{}).(struct{})
 	return ok
 }
+
+// WithPriority sets the priority class of requests sent with the context. When
+// requests contend for the same rate limiter higher priorities are served first.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// withDefaultPriority sets the priority class from the request type if the
+// caller has not set one. Authenticated requests default to AccountPriority and
+// unauthenticated requests to MarketDataPriority.
+func withDefaultPriority(ctx context.Context, requestType AuthType) context.Context {
+	if _, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return ctx
+	}
+	if requestType == AuthenticatedRequest {
+		return WithPriority(ctx, AccountPriority)
+	}
+	return WithPriority(ctx, MarketDataPriority)
+}
+
+// priorityFromContext returns the priority class set on the context, or
+// AccountPriority if none has been set or the value is out of range
+func priorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok && p.valid() {
+		return p
+	}
+	return AccountPriority
+}
@@ -50,3 +50,15 @@ func TestWithRetryNotAllowed(t *testing.T) {
 	assert.False(t, hasRetryNotAllowed(t.Context()))
 	assert.False(t, hasRetryNotAllowed(WithDelayNotAllowed(WithVerbose(t.Context()))))
 }
+
+func TestWithPriority(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, AccountPriority, priorityFromContext(t.Context()), "should default to account priority")
+	assert.Equal(t, TradingPriority, priorityFromContext(WithPriority(t.Context(), TradingPriority)))
+	assert.Equal(t, AccountPriority, priorityFromContext(WithPriority(t.Context(), Priority(99))), "should default to account priority for invalid values")
+
+	assert.Equal(t, MarketDataPriority, priorityFromContext(withDefaultPriority(t.Context(), UnauthenticatedRequest)))
+	assert.Equal(t, AccountPriority, priorityFromContext(withDefaultPriority(t.Context(), AuthenticatedRequest)))
+	ctx := WithPriority(t.Context(), TradingPriority)
+	assert.Equal(t, TradingPriority, priorityFromContext(withDefaultPriority(ctx, AuthenticatedRequest)), "should not override a priority set by the caller")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -99,7 +100,10 @@ func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) erro
 	if err := common.NilGuard(r.limiter); err != nil {
 		return err
 	}
-	if err := r.limiter[e].RateLimit(ctx); err != nil {
+	if err := r.scheduleRateLimit(ctx, r.limiter[e]); err != nil {
+		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
+	}
+	if err := r.waitForQuota(ctx, r.limiter[e]); err != nil {
 		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
 	}
 	return nil
@@ -116,14 +120,30 @@ func (r *Requester) GetRateLimiterDefinitions() RateLimitDefinitions {
 // RateLimit throttles a request based on weight, delaying the request.
 // Errors if no delay is permitted via the context and a delay is required.
 func (r *RateLimiterWithWeight) RateLimit(ctx context.Context) error {
-	if err := common.NilGuard(r); err != nil {
+	finalDelay, err := r.reserve(ctx)
+	if err != nil || finalDelay == 0 {
 		return err
 	}
 
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(finalDelay):
+		return nil
+	}
+}
+
+// reserve reserves the weight of the request and returns the delay required before the request can be sent.
+// Reservations are cancelled if no delay is permitted via the context or the delay would exceed the context deadline.
+func (r *RateLimiterWithWeight) reserve(ctx context.Context) (time.Duration, error) {
+	if err := common.NilGuard(r); err != nil {
+		return 0, err
+	}
+
 	r.m.Lock()
+	defer r.m.Unlock()
 	if r.weight == 0 {
-		r.m.Unlock()
-		return errInvalidWeight
+		return 0, errInvalidWeight
 	}
 
 	tn := time.Now()
@@ -135,29 +155,32 @@ func (r *RateLimiterWithWeight) RateLimit(ctx context.Context) error {
 	finalDelay := reserved[len(reserved)-1].DelayFrom(tn)
 
 	if finalDelay == 0 {
-		r.m.Unlock()
-		return nil
+		return 0, nil
 	}
 
 	if hasDelayNotAllowed(ctx) {
 		cancelAll(reserved, tn)
-		r.m.Unlock()
-		return ErrDelayNotAllowed
+		return 0, ErrDelayNotAllowed
 	}
 
 	if dl, ok := ctx.Deadline(); ok && dl.Before(tn.Add(finalDelay)) {
 		cancelAll(reserved, tn)
-		r.m.Unlock()
-		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w", finalDelay, context.DeadlineExceeded)
+		return 0, fmt.Errorf("rate limit delay of %s will exceed deadline: %w", finalDelay, context.DeadlineExceeded)
 	}
-	r.m.Unlock()
+	return finalDelay, nil
+}
 
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-time.After(finalDelay):
-		return nil
+// delayUntilFree returns how long until the limiter has a token available without needing a reservation
+func (r *RateLimiterWithWeight) delayUntilFree(at time.Time) time.Duration {
+	limit := r.limiter.Limit()
+	if limit == rate.Inf || limit <= 0 {
+		return 0
 	}
+	tokens := r.limiter.TokensAt(at)
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - tokens) / float64(limit) * float64(time.Second)))
 }
 
 // cancelAll cancels all reservations at a specific time.
@@ -190,3 +213,8 @@ func (r *Requester) EnableRateLimiter() error {
 	}
 	return nil
 }
+
+// IsRateLimiterDisabled returns true if the rate limiting system has been disabled for the exchange.
+func (r *Requester) IsRateLimiterDisabled() bool {
+	return r != nil && atomic.LoadInt32(&r.disableRateLimiter) == 1
+}
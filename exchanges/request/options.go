@@ -14,6 +14,21 @@ func WithLimiter(def RateLimitDefinitions) RequesterOption {
 	}
 }
 
+// WithHeaderParser configures a parser for exchange reported rate limit quotas in response headers for a Requester.
+func WithHeaderParser(p HeaderParser) RequesterOption {
+	return func(r *Requester) {
+		r.headerParser = p
+	}
+}
+
+// WithMarketDataShedding configures a Requester to reject market data priority requests with ErrRequestShed when at
+// least the given number of requests are already waiting on the same rate limiter.
+func WithMarketDataShedding(queued int) RequesterOption {
+	return func(r *Requester) {
+		r.shedThreshold = queued
+	}
+}
+
 // WithRetryPolicy configures the retry policy for a Requester.
 func WithRetryPolicy(p RetryPolicy) RequesterOption {
 	return func(r *Requester) {
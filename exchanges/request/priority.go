@@ -0,0 +1,176 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"golang.org/x/time/rate"
+)
+
+// Priority classes for requests contending for the same rate limiter, higher
+// classes are served first.
+const (
+	// MarketDataPriority is the default for unauthenticated requests such as tickers, orderbooks and trades
+	MarketDataPriority Priority = iota + 1
+	// AccountPriority is the default for authenticated requests such as balances and order history
+	AccountPriority
+	// TradingPriority is for order submission, modification and cancellation
+	TradingPriority
+
+	priorityLevels = int(TradingPriority) + 1
+)
+
+// ErrRequestShed is returned when a market data request is dropped because too many requests are already waiting on
+// the rate limiter
+var ErrRequestShed = errors.New("market data request shed under rate limit pressure")
+
+// Priority defines the order in which requests waiting on a rate limiter are served
+type Priority uint8
+
+// scheduler orders requests waiting on a shared rate limiter by priority. Waiting requests do not hold reservations,
+// so a request of a higher priority which arrives later is sent as soon as the limiter is free.
+type scheduler struct {
+	m       sync.Mutex
+	waiting [priorityLevels]int
+	wake    chan struct{}
+}
+
+// String implements the stringer interface
+func (p Priority) String() string {
+	switch p {
+	case MarketDataPriority:
+		return "market data"
+	case AccountPriority:
+		return "account"
+	case TradingPriority:
+		return "trading"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(p))
+	}
+}
+
+func (p Priority) valid() bool {
+	return p >= MarketDataPriority && p <= TradingPriority
+}
+
+// getScheduler returns the scheduler for a rate limiter, which is shared by every endpoint using it
+func (r *Requester) getScheduler(l *rate.Limiter) *scheduler {
+	r.schedulerMu.Lock()
+	defer r.schedulerMu.Unlock()
+	s, ok := r.schedulers[l]
+	if !ok {
+		s = &scheduler{wake: make(chan struct{})}
+		if r.schedulers == nil {
+			r.schedulers = make(map[*rate.Limiter]*scheduler)
+		}
+		r.schedulers[l] = s
+	}
+	return s
+}
+
+// scheduleRateLimit waits for the request's turn on the rate limiter according to its priority, then for its weight
+// to be reserved
+func (r *Requester) scheduleRateLimit(ctx context.Context, rl *RateLimiterWithWeight) error {
+	if err := common.NilGuard(rl); err != nil {
+		return err
+	}
+	delay, err := r.getScheduler(rl.limiter).admit(ctx, rl, priorityFromContext(ctx), r.shedThreshold)
+	if err != nil || delay == 0 {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// admit blocks until no request of a higher priority is waiting and the rate limiter is free, then reserves the
+// request weight and returns any remaining delay. Market data requests are shed when shedThreshold is set and at least
+// that many requests are already waiting.
+func (s *scheduler) admit(ctx context.Context, rl *RateLimiterWithWeight, p Priority, shedThreshold int) (time.Duration, error) {
+	var queued bool
+	for {
+		s.m.Lock()
+		var free time.Duration
+		if !s.higherWaiting(p) {
+			now := time.Now()
+			if free = rl.delayUntilFree(now); free == 0 {
+				if queued {
+					s.leave(p)
+				}
+				delay, err := rl.reserve(ctx)
+				s.m.Unlock()
+				return delay, err
+			}
+			if dl, ok := ctx.Deadline(); ok && dl.Before(now.Add(free)) {
+				if queued {
+					s.leave(p)
+				}
+				s.m.Unlock()
+				return 0, fmt.Errorf("rate limit delay of %s will exceed deadline: %w", free, context.DeadlineExceeded)
+			}
+		}
+		if !queued {
+			if hasDelayNotAllowed(ctx) {
+				s.m.Unlock()
+				return 0, ErrDelayNotAllowed
+			}
+			if p == MarketDataPriority && shedThreshold > 0 && s.queued() >= shedThreshold {
+				s.m.Unlock()
+				return 0, ErrRequestShed
+			}
+			s.waiting[p]++
+			queued = true
+		}
+		wake := s.wake
+		s.m.Unlock()
+
+		// A nil timer channel blocks until a higher priority request leaves the queue
+		var timer <-chan time.Time
+		if free > 0 {
+			timer = time.After(free)
+		}
+		select {
+		case <-ctx.Done():
+			s.m.Lock()
+			s.leave(p)
+			s.m.Unlock()
+			return 0, ctx.Err()
+		case <-wake:
+		case <-timer:
+		}
+	}
+}
+
+// higherWaiting returns true if a request of a higher priority is waiting. Callers must hold the lock.
+func (s *scheduler) higherWaiting(p Priority) bool {
+	for i := int(p) + 1; i < priorityLevels; i++ {
+		if s.waiting[i] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// queued returns the number of waiting requests. Callers must hold the lock.
+func (s *scheduler) queued() int {
+	var n int
+	for _, w := range s.waiting {
+		n += w
+	}
+	return n
+}
+
+// leave removes a request from the queue and wakes any waiting requests so they can re-evaluate their turn. Callers
+// must hold the lock.
+func (s *scheduler) leave(p Priority) {
+	s.waiting[p]--
+	close(s.wake)
+	s.wake = make(chan struct{})
+}
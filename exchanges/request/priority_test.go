@@ -0,0 +1,128 @@
+package request
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+func TestPriorityString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "market data", MarketDataPriority.String())
+	assert.Equal(t, "account", AccountPriority.String())
+	assert.Equal(t, "trading", TradingPriority.String())
+	assert.Equal(t, "unknown(0)", Priority(0).String())
+}
+
+func TestInitiateRateLimitPriority(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(NewBasicRateLimit(100*time.Millisecond, 1, 1)))
+		require.NoError(t, err, "New must not error")
+
+		require.NoError(t, r.InitiateRateLimit(t.Context(), Unset), "first request must not error")
+
+		var mu sync.Mutex
+		var served []Priority
+		var wg sync.WaitGroup
+		send := func(p Priority) {
+			wg.Go(func() {
+				assert.NoError(t, r.InitiateRateLimit(WithPriority(t.Context(), p), Unset))
+				mu.Lock()
+				served = append(served, p)
+				mu.Unlock()
+			})
+		}
+
+		for range 20 {
+			send(MarketDataPriority)
+		}
+		synctest.Wait()
+		send(AccountPriority)
+		synctest.Wait()
+		start := time.Now()
+		send(TradingPriority)
+		synctest.Wait()
+		wg.Wait()
+
+		require.Len(t, served, 22, "all requests must be served")
+		assert.Equal(t, TradingPriority, served[0], "trading request should be served first despite arriving last")
+		assert.Equal(t, AccountPriority, served[1], "account request should be served before market data")
+		for _, p := range served[2:] {
+			assert.Equal(t, MarketDataPriority, p)
+		}
+		assert.Equal(t, 22*100*time.Millisecond, time.Since(start), "priority should not reduce throughput")
+	})
+}
+
+func TestOrderOperationsNotStarved(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(NewBasicRateLimit(time.Second, 10, 1)))
+		require.NoError(t, err, "New must not error")
+
+		ctx, cancel := context.WithCancel(t.Context())
+		var wg sync.WaitGroup
+		var marketData atomic.Int64
+		// Constant pressure from ticker syncing, with more requests queued than the limiter can serve
+		for range 50 {
+			wg.Go(func() {
+				for ctx.Err() == nil {
+					if r.InitiateRateLimit(WithPriority(ctx, MarketDataPriority), Unset) == nil {
+						marketData.Add(1)
+					}
+				}
+			})
+		}
+
+		time.Sleep(time.Second)
+		for range 5 {
+			start := time.Now()
+			require.NoError(t, r.InitiateRateLimit(WithPriority(t.Context(), TradingPriority), Unset), "order request must not error")
+			assert.LessOrEqual(t, time.Since(start), 100*time.Millisecond, "order request should wait no longer than one rate limit interval")
+			time.Sleep(time.Second)
+		}
+		cancel()
+		wg.Wait()
+		assert.Positive(t, marketData.Load(), "market data requests should continue to be served")
+	})
+}
+
+func TestMarketDataShedding(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(NewBasicRateLimit(time.Second, 1, 1)), WithMarketDataShedding(2))
+		require.NoError(t, err, "New must not error")
+		require.NoError(t, r.InitiateRateLimit(t.Context(), Unset), "first request must not error")
+
+		var wg sync.WaitGroup
+		for range 2 {
+			wg.Go(func() {
+				assert.NoError(t, r.InitiateRateLimit(WithPriority(t.Context(), MarketDataPriority), Unset))
+			})
+		}
+		synctest.Wait()
+
+		err = r.InitiateRateLimit(WithPriority(t.Context(), MarketDataPriority), Unset)
+		assert.ErrorIs(t, err, ErrRequestShed, "market data should be shed under pressure")
+
+		err = r.InitiateRateLimit(WithPriority(t.Context(), TradingPriority), Unset)
+		assert.NoError(t, err, "trading requests should never be shed")
+
+		err = r.InitiateRateLimit(WithDelayNotAllowed(t.Context()), Unset)
+		assert.ErrorIs(t, err, ErrDelayNotAllowed, "waiting requests should not be bypassed when no delay is allowed")
+
+		ctx, cancel := context.WithTimeout(WithPriority(t.Context(), TradingPriority), 10*time.Millisecond)
+		defer cancel()
+		err = r.InitiateRateLimit(ctx, Unset)
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "should error when the limiter will not be free before the deadline")
+		wg.Wait()
+	})
+}
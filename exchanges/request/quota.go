@@ -0,0 +1,328 @@
+package request
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
+)
+
+// Quota scopes define who an exchange reported quota is enforced against.
+const (
+	// IPQuota quotas are shared by every request sent from the same address regardless of the credentials used
+	IPQuota QuotaScope = iota
+	// AccountQuota quotas are tracked separately for each set of API credentials
+	AccountQuota
+)
+
+const accountDisplaySize = 8
+
+var (
+	errInvalidQuota          = errors.New("quota requires a window or reset time")
+	errQuotaEndpointNotFound = errors.New("quota endpoint has no rate limiter definition")
+	errQuotaLimitUnknown     = errors.New("quota limit cannot be derived from an unrestricted rate limiter")
+)
+
+// QuotaScope defines who an exchange reported quota applies to
+type QuotaScope uint8
+
+// Quota is rate limit usage reported by an exchange in response headers
+type Quota struct {
+	// Endpoint is used to look up the rate limiter the quota applies to. All endpoints sharing that rate limiter are
+	// subject to the quota.
+	Endpoint EndpointLimit
+	Scope    QuotaScope
+	// Used is the weight consumed within the current window
+	Used int64
+	// Limit is the weight the exchange allows within the window. If zero it is derived from the rate limiter
+	// definition. If set, the rate limiter is tightened to match when it is lower than the definition.
+	Limit int64
+	// Window is the duration the limit applies to
+	Window time.Duration
+	// Reset is when the exchange resets the window. If zero the window is assumed to reset one Window from receipt.
+	Reset time.Time
+}
+
+// HeaderParser extracts exchange reported quota usage from response headers. Parsers should return no quotas when the
+// relevant headers are absent.
+type HeaderParser func(ep EndpointLimit, h http.Header) ([]Quota, error)
+
+// AccountIdentifier returns an identifier for the credentials a request will be sent with, so that AccountQuota usage
+// is tracked per account
+type AccountIdentifier func(ctx context.Context) string
+
+// QuotaStatus is a snapshot of an exchange reported quota
+type QuotaStatus struct {
+	Endpoint  EndpointLimit
+	Scope     QuotaScope
+	Account   string
+	Limit     int64
+	Used      int64
+	Remaining int64
+	Window    time.Duration
+	Reset     time.Time
+	Updated   time.Time
+	// Rate is the number of requests per second currently permitted by the underlying rate limiter
+	Rate float64
+	// Exhausted is true when requests subject to the quota are held until Reset
+	Exhausted bool
+}
+
+// RateLimitStatus reports the state of the rate limiting system for a requester
+type RateLimitStatus struct {
+	Enabled bool
+	Quotas  []QuotaStatus
+}
+
+type quotaKey struct {
+	bucket  *rate.Limiter
+	scope   QuotaScope
+	account string
+	window  time.Duration
+}
+
+type quotaState struct {
+	m         sync.Mutex
+	endpoint  EndpointLimit
+	limit     int64
+	remaining int64
+	reset     time.Time
+	updated   time.Time
+}
+
+// String implements the stringer interface
+func (s QuotaScope) String() string {
+	switch s {
+	case IPQuota:
+		return "ip"
+	case AccountQuota:
+		return "account"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// SetAccountIdentifier sets the function used to identify which account a request belongs to for AccountQuota tracking
+func (r *Requester) SetAccountIdentifier(fn AccountIdentifier) error {
+	if r == nil {
+		return ErrRequestSystemIsNil
+	}
+	r.quotaMu.Lock()
+	r.accountIdentifier = fn
+	r.quotaMu.Unlock()
+	return nil
+}
+
+// GetRateLimitStatus returns whether rate limiting is enabled and the latest exchange reported quotas
+func (r *Requester) GetRateLimitStatus() (*RateLimitStatus, error) {
+	if r == nil {
+		return nil, ErrRequestSystemIsNil
+	}
+	status := &RateLimitStatus{Enabled: !r.IsRateLimiterDisabled()}
+	now := time.Now()
+	r.quotaMu.RLock()
+	status.Quotas = make([]QuotaStatus, 0, len(r.quotas))
+	for k, s := range r.quotas {
+		s.m.Lock()
+		qs := QuotaStatus{
+			Endpoint:  s.endpoint,
+			Scope:     k.scope,
+			Account:   obfuscateAccount(k.account),
+			Limit:     s.limit,
+			Remaining: max(s.remaining, 0),
+			Window:    k.window,
+			Reset:     s.reset,
+			Updated:   s.updated,
+			Rate:      float64(k.bucket.Limit()),
+			Exhausted: s.remaining <= 0 && now.Before(s.reset),
+		}
+		s.m.Unlock()
+		qs.Used = max(qs.Limit-qs.Remaining, 0)
+		status.Quotas = append(status.Quotas, qs)
+	}
+	r.quotaMu.RUnlock()
+	slices.SortFunc(status.Quotas, func(a, b QuotaStatus) int {
+		return cmp.Or(cmp.Compare(a.Endpoint, b.Endpoint),
+			cmp.Compare(a.Scope, b.Scope),
+			cmp.Compare(a.Account, b.Account),
+			cmp.Compare(a.Window, b.Window))
+	})
+	return status, nil
+}
+
+// updateQuotas parses response headers with the configured header parser and applies any reported quotas
+func (r *Requester) updateQuotas(ctx context.Context, ep EndpointLimit, h http.Header) {
+	if r.headerParser == nil {
+		return
+	}
+	quotas, err := r.headerParser(ep, h)
+	if err != nil {
+		log.Errorf(log.RequestSys, "%s failed to parse rate limit headers: %v", r.name, err)
+		return
+	}
+	now := time.Now()
+	for i := range quotas {
+		if err := r.applyQuota(ctx, &quotas[i], now); err != nil {
+			log.Errorf(log.RequestSys, "%s failed to apply rate limit quota for endpoint %d: %v", r.name, quotas[i].Endpoint, err)
+		}
+	}
+}
+
+// applyQuota records the quota against the rate limiter bucket it applies to and tightens the rate limiter if the
+// exchange reports a lower limit than the definition
+func (r *Requester) applyQuota(ctx context.Context, q *Quota, now time.Time) error {
+	if q.Window <= 0 && q.Reset.IsZero() {
+		return errInvalidQuota
+	}
+	rl, ok := r.limiter[q.Endpoint]
+	if !ok || rl == nil || rl.limiter == nil {
+		return errQuotaEndpointNotFound
+	}
+
+	reset, window := q.Reset, q.Window
+	if reset.IsZero() {
+		reset = now.Add(window)
+	}
+	if window <= 0 {
+		window = reset.Sub(now)
+	}
+
+	r.quotaMu.Lock()
+	defer r.quotaMu.Unlock()
+
+	baseRate, ok := r.baseRates[rl.limiter]
+	if !ok {
+		baseRate = rl.limiter.Limit()
+		if r.baseRates == nil {
+			r.baseRates = make(map[*rate.Limiter]rate.Limit)
+		}
+		r.baseRates[rl.limiter] = baseRate
+	}
+
+	limit := q.Limit
+	if limit > 0 {
+		if target := min(rate.Limit(float64(limit)/window.Seconds()), baseRate); rl.limiter.Limit() != target {
+			rl.limiter.SetLimitAt(now, target)
+		}
+	} else {
+		if baseRate == rate.Inf {
+			return errQuotaLimitUnknown
+		}
+		limit = int64(float64(baseRate) * window.Seconds())
+	}
+
+	key := quotaKey{bucket: rl.limiter, scope: q.Scope, window: q.Window}
+	if q.Scope == AccountQuota && r.accountIdentifier != nil {
+		key.account = r.accountIdentifier(ctx)
+	}
+	s, ok := r.quotas[key]
+	if !ok {
+		s = &quotaState{}
+		if r.quotas == nil {
+			r.quotas = make(map[quotaKey]*quotaState)
+		}
+		r.quotas[key] = s
+	}
+
+	s.m.Lock()
+	s.endpoint = q.Endpoint
+	s.limit = limit
+	s.remaining = limit - q.Used
+	s.reset = reset
+	s.updated = now
+	s.m.Unlock()
+	return nil
+}
+
+// waitForQuota holds a request until every exchange reported quota applicable to the rate limiter has capacity for
+// its weight
+func (r *Requester) waitForQuota(ctx context.Context, rl *RateLimiterWithWeight) error {
+	r.quotaMu.RLock()
+	if len(r.quotas) == 0 {
+		r.quotaMu.RUnlock()
+		return nil
+	}
+	var account string
+	var accountResolved bool
+	states := make([]*quotaState, 0, 2)
+	for k, s := range r.quotas {
+		if k.bucket != rl.limiter {
+			continue
+		}
+		if k.scope == AccountQuota {
+			if !accountResolved && r.accountIdentifier != nil {
+				account = r.accountIdentifier(ctx)
+			}
+			accountResolved = true
+			if k.account != account {
+				continue
+			}
+		}
+		states = append(states, s)
+	}
+	r.quotaMu.RUnlock()
+
+	for _, s := range states {
+		if err := s.acquire(ctx, int64(rl.weight)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// acquire deducts the weight from the remaining quota, waiting for the window to reset if the quota is exhausted
+func (s *quotaState) acquire(ctx context.Context, weight int64) error {
+	for {
+		s.m.Lock()
+		now := time.Now()
+		if !now.Before(s.reset) {
+			// The window has elapsed, so usage is unknown until the exchange reports it again
+			s.m.Unlock()
+			return nil
+		}
+		if s.remaining >= weight {
+			s.remaining -= weight
+			s.m.Unlock()
+			return nil
+		}
+		delay := s.reset.Sub(now)
+		s.m.Unlock()
+
+		if hasDelayNotAllowed(ctx) {
+			return ErrDelayNotAllowed
+		}
+		if dl, ok := ctx.Deadline(); ok && dl.Before(now.Add(delay)) {
+			return fmt.Errorf("exchange quota delay of %s will exceed deadline: %w", delay, context.DeadlineExceeded)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// resetIPQuotas removes quotas tied to the current address, used when requests are rerouted through a different proxy
+func (r *Requester) resetIPQuotas() {
+	r.quotaMu.Lock()
+	for k := range r.quotas {
+		if k.scope == IPQuota {
+			delete(r.quotas, k)
+		}
+	}
+	r.quotaMu.Unlock()
+}
+
+func obfuscateAccount(account string) string {
+	if len(account) > accountDisplaySize {
+		return account[:accountDisplaySize] + "..."
+	}
+	return account
+}
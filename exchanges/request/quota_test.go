@@ -0,0 +1,158 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"golang.org/x/time/rate"
+)
+
+func TestQuotaScopeString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "ip", IPQuota.String())
+	assert.Equal(t, "account", AccountQuota.String())
+	assert.Equal(t, "unknown(7)", QuotaScope(7).String())
+}
+
+func TestApplyQuota(t *testing.T) {
+	t.Parallel()
+	r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(RateLimitDefinitions{
+		Auth:   NewRateLimitWithWeight(time.Minute, 600, 1),
+		UnAuth: GetRateLimiterWithWeight(rate.NewLimiter(rate.Inf, 1), 1),
+	}))
+	require.NoError(t, err, "New must not error")
+
+	now := time.Now()
+	require.ErrorIs(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth}, now), errInvalidQuota)
+	require.ErrorIs(t, r.applyQuota(t.Context(), &Quota{Endpoint: Unset, Window: time.Minute}, now), errQuotaEndpointNotFound)
+	require.ErrorIs(t, r.applyQuota(t.Context(), &Quota{Endpoint: UnAuth, Window: time.Minute}, now), errQuotaLimitUnknown)
+
+	require.NoError(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth, Used: 100, Window: time.Minute}, now), "applyQuota must not error")
+	status, err := r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	require.Len(t, status.Quotas, 1, "must contain one quota")
+	q := status.Quotas[0]
+	assert.True(t, status.Enabled, "rate limiter should be enabled")
+	assert.Equal(t, int64(600), q.Limit, "limit should be derived from the definition")
+	assert.Equal(t, int64(100), q.Used)
+	assert.Equal(t, int64(500), q.Remaining)
+	assert.Equal(t, now.Add(time.Minute), q.Reset, "reset should default to one window")
+	assert.Equal(t, 10.0, q.Rate, "rate should be unchanged")
+	assert.False(t, q.Exhausted)
+
+	require.NoError(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth, Used: 600, Limit: 300, Window: time.Minute}, now), "applyQuota must not error")
+	status, err = r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	require.Len(t, status.Quotas, 1, "same bucket and window must update the existing quota")
+	q = status.Quotas[0]
+	assert.Equal(t, 5.0, q.Rate, "rate should be tightened to the reported limit")
+	assert.Zero(t, q.Remaining, "remaining should not be negative")
+	assert.Equal(t, int64(300), q.Used)
+	assert.True(t, q.Exhausted, "quota should be exhausted")
+
+	require.NoError(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth, Limit: 6000, Window: time.Minute}, now), "applyQuota must not error")
+	status, err = r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	assert.Equal(t, 10.0, status.Quotas[0].Rate, "rate should not exceed the definition")
+
+	_, err = (*Requester)(nil).GetRateLimitStatus()
+	assert.ErrorIs(t, err, ErrRequestSystemIsNil)
+}
+
+func TestWaitForQuota(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		rl := NewRateLimitWithWeight(time.Second, 1000, 2)
+		r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(RateLimitDefinitions{Auth: rl}))
+		require.NoError(t, err, "New must not error")
+
+		type accountKey struct{}
+		require.NoError(t, r.SetAccountIdentifier(func(ctx context.Context) string {
+			s, _ := ctx.Value(accountKey{}).(string)
+			return s
+		}), "SetAccountIdentifier must not error")
+		alice := context.WithValue(t.Context(), accountKey{}, "alice")
+		bob := context.WithValue(t.Context(), accountKey{}, "bob")
+
+		require.NoError(t, r.applyQuota(alice, &Quota{Endpoint: Auth, Scope: AccountQuota, Used: 9, Limit: 10, Window: time.Second}, time.Now()), "applyQuota must not error")
+		require.NoError(t, r.applyQuota(bob, &Quota{Endpoint: Auth, Scope: AccountQuota, Used: 0, Limit: 10, Window: time.Second}, time.Now()), "applyQuota must not error")
+
+		start := time.Now()
+		require.NoError(t, r.waitForQuota(bob, rl), "waitForQuota must not error")
+		assert.Zero(t, time.Since(start), "bob should not be held by alice's quota")
+
+		assert.ErrorIs(t, r.waitForQuota(WithDelayNotAllowed(alice), rl), ErrDelayNotAllowed)
+
+		ctx, cancel := context.WithTimeout(alice, 500*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, r.waitForQuota(ctx, rl), context.DeadlineExceeded)
+
+		start = time.Now()
+		require.NoError(t, r.waitForQuota(alice, rl), "waitForQuota must not error")
+		assert.Equal(t, time.Second, time.Since(start), "alice should be held until the window resets")
+
+		require.NoError(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth, Scope: IPQuota, Used: 10, Limit: 10, Window: time.Second}, time.Now()), "applyQuota must not error")
+		start = time.Now()
+		require.NoError(t, r.waitForQuota(bob, rl), "waitForQuota must not error")
+		assert.Equal(t, time.Second, time.Since(start), "address quota should hold every account")
+
+		require.NoError(t, r.applyQuota(t.Context(), &Quota{Endpoint: Auth, Scope: IPQuota, Used: 10, Limit: 10, Window: time.Second}, time.Now()), "applyQuota must not error")
+		proxy, err := url.Parse("http://localhost:1337")
+		require.NoError(t, err, "url.Parse must not error")
+		require.NoError(t, r.SetProxy(proxy), "SetProxy must not error")
+		start = time.Now()
+		require.NoError(t, r.waitForQuota(bob, rl), "waitForQuota must not error")
+		assert.Zero(t, time.Since(start), "address quota should be removed after a proxy change")
+	})
+}
+
+func TestSendPayloadHeaderParser(t *testing.T) {
+	t.Parallel()
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Used-Weight", "42")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(serv.Close)
+
+	parser := func(ep EndpointLimit, h http.Header) ([]Quota, error) {
+		used := h.Get("X-Used-Weight")
+		if used == "" {
+			return nil, nil
+		}
+		u, err := strconv.ParseInt(used, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return []Quota{{Endpoint: ep, Used: u, Limit: 100, Window: time.Minute}}, nil
+	}
+
+	r, err := New("test", common.NewHTTPClientWithTimeout(time.Second), WithLimiter(NewBasicRateLimit(time.Minute, 6000, 1)), WithHeaderParser(parser))
+	require.NoError(t, err, "New must not error")
+
+	err = r.SendPayload(t.Context(), UnAuth, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: serv.URL}, nil
+	}, UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+
+	status, err := r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	require.Len(t, status.Quotas, 1, "must contain one quota")
+	assert.Equal(t, UnAuth, status.Quotas[0].Endpoint)
+	assert.Equal(t, int64(42), status.Quotas[0].Used)
+	assert.Equal(t, int64(58), status.Quotas[0].Remaining, "remaining should account for the header reported usage")
+	assert.InDelta(t, 100.0/60, status.Quotas[0].Rate, 0.0001, "rate should be tightened to the reported limit")
+
+	require.NoError(t, r.DisableRateLimiter(), "DisableRateLimiter must not error")
+	status, err = r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	assert.False(t, status.Enabled, "rate limiter should be disabled")
+}
@@ -10,6 +10,13 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// ErrorReporter is an optional Reporter extension which is notified of HTTP
+// request attempts that fail to send, are rate limited or return a server
+// error
+type ErrorReporter interface {
+	RequestError(name, method, path string, err error)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
@@ -13,10 +13,13 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -92,10 +95,17 @@ func (r *Requester) SendPayload(ctx context.Context, ep EndpointLimit, newReques
 		return errRequestFunctionIsNil
 	}
 
+	ctx = withDefaultPriority(ctx, requestType)
+	ctx, span := tracing.Start(ctx, "request.SendPayload",
+		tracing.ExchangeKey.String(r.name),
+		tracing.EndpointKey.Int(int(ep)),
+		tracing.AuthenticatedKey.Bool(requestType == AuthenticatedRequest),
+		tracing.PriorityKey.String(priorityFromContext(ctx).String()))
 	err := r.doRequest(ctx, ep, newRequest)
 	if err != nil && requestType == AuthenticatedRequest {
 		err = common.AppendError(err, ErrAuthRequestFailed)
 	}
+	tracing.End(span, err)
 	return err
 }
 
@@ -156,10 +166,14 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		if r.limiter != nil {
 			// Initiate a rate limit reservation and sleep on requested endpoint
+			waitStart := time.Now()
 			err := r.InitiateRateLimit(ctx, endpoint)
 			if err != nil {
 				return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 			}
+			if wait := time.Since(waitStart); wait >= time.Millisecond {
+				tracing.AddEvent(ctx, tracing.EventRateLimitWait, tracing.RateLimitWaitKey.String(wait.String()))
+			}
 		}
 
 		p, err := newRequest()
@@ -173,7 +187,7 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		}
 
 		verbose := IsVerbose(ctx, p.Verbose)
-		retry, err := r.executeRequest(ctx, p, req, attempt, verbose)
+		retry, err := r.executeRequest(ctx, endpoint, p, req, attempt, verbose)
 		if err != nil {
 			return err
 		}
@@ -186,7 +200,7 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 // executeRequest performs one HTTP request attempt and reports whether the
 // caller should retry. Any response body is closed before this method returns.
-func (r *Requester) executeRequest(ctx context.Context, p *Item, req *http.Request, attempt int, verbose bool) (bool, error) {
+func (r *Requester) executeRequest(ctx context.Context, ep EndpointLimit, p *Item, req *http.Request, attempt int, verbose bool) (bool, error) {
 	if verbose {
 		log.Debugf(log.RequestSys, "%s attempt %d request path: %s", r.name, attempt, p.Path)
 		for k, d := range req.Header {
@@ -209,12 +223,22 @@ func (r *Requester) executeRequest(ctx context.Context, p *Item, req *http.Reque
 		}
 	}
 
+	_, span := tracing.Start(ctx, "HTTP "+p.Method,
+		tracing.ExchangeKey.String(r.name),
+		tracing.AttemptKey.Int(attempt),
+		attribute.String("http.request.method", p.Method),
+		attribute.String("url.path", req.URL.Path),
+		attribute.String("server.address", req.URL.Host))
 	start := time.Now()
 
 	resp, requestErr := r._HTTPClient.do(req)
+	endAttemptSpan(span, resp, requestErr)
+	if requestErr == nil {
+		r.updateQuotas(ctx, ep, resp.Header)
+	}
 
-	if r.reporter != nil && requestErr == nil {
-		r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+	if r.reporter != nil {
+		r.report(p, resp, requestErr, time.Since(start))
 	}
 
 	if retry, err := r.evaluateRetry(ctx, resp, requestErr, attempt, verbose); err != nil {
@@ -275,6 +299,24 @@ func (r *Requester) executeRequest(ctx context.Context, p *Item, req *http.Reque
 	return false, unmarshallError
 }
 
+// report sends the latency of a request attempt to the reporter and, when the
+// reporter implements ErrorReporter, the error of a failed attempt
+func (r *Requester) report(p *Item, resp *http.Response, requestErr error, latency time.Duration) {
+	if requestErr == nil {
+		r.reporter.Latency(r.name, p.Method, p.Path, latency)
+	}
+	er, ok := r.reporter.(ErrorReporter)
+	if !ok {
+		return
+	}
+	switch {
+	case requestErr != nil:
+		er.RequestError(r.name, p.Method, p.Path, requestErr)
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= http.StatusInternalServerError:
+		er.RequestError(r.name, p.Method, p.Path, fmt.Errorf("%w: %d", ErrBadStatus, resp.StatusCode))
+	}
+}
+
 // evaluateRetry checks whether a request should be retried based on the retry
 // policy and context. It propagates incoming request errors when retrying is
 // declined and drains and closes response bodies before retrying or returning
@@ -327,6 +369,8 @@ func (r *Requester) evaluateRetry(ctx context.Context, resp *http.Response, inco
 		}
 	}
 
+	tracing.AddEvent(ctx, tracing.EventRetry, tracing.AttemptKey.Int(attempt), tracing.RetryDelayKey.String(delay.String()))
+
 	if delay > 0 {
 		// Allow for context cancellation while delaying the retry.
 		select {
@@ -339,6 +383,18 @@ func (r *Requester) evaluateRetry(ctx context.Context, resp *http.Response, inco
 	return true, nil
 }
 
+// endAttemptSpan ends a single HTTP attempt span. Only the URL path is recorded
+// as query strings can carry signatures and API keys.
+func endAttemptSpan(span trace.Span, resp *http.Response, err error) {
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if err == nil && (resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent) {
+			err = fmt.Errorf("%w: %d", ErrBadStatus, resp.StatusCode)
+		}
+	}
+	tracing.End(span, err)
+}
+
 func (r *Requester) drainBody(body io.ReadCloser) {
 	if _, err := io.Copy(io.Discard, io.LimitReader(body, drainBodyLimit)); err != nil {
 		log.Errorf(log.RequestSys, "%s failed to drain request body %s", r.name, err)
@@ -360,7 +416,12 @@ func (r *Requester) SetProxy(p *url.URL) error {
 	if r == nil {
 		return ErrRequestSystemIsNil
 	}
-	return r._HTTPClient.setProxy(p)
+	if err := r._HTTPClient.setProxy(p); err != nil {
+		return err
+	}
+	// Quotas enforced per address no longer apply once requests are routed elsewhere
+	r.resetIPQuotas()
+	return nil
 }
 
 // SetHTTPClient sets exchanges HTTP client
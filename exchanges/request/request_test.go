@@ -23,7 +23,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const unexpected = "unexpected values"
@@ -58,6 +63,15 @@ func (t *trackingReporter) Latency(string, string, string, time.Duration) {
 	t.calls++
 }
 
+type trackingErrorReporter struct {
+	trackingReporter
+	errs []error
+}
+
+func (t *trackingErrorReporter) RequestError(_, _, _ string, err error) {
+	t.errs = append(t.errs, err)
+}
+
 func TestRoundTripFuncRoundTrip(t *testing.T) {
 	t.Parallel()
 	expectedErr := errors.New("transport failure")
@@ -135,6 +149,32 @@ func TestTrackingReporterLatency(t *testing.T) {
 	assert.Equal(t, 1, reporter.calls, "Latency should increment calls exactly once")
 }
 
+func TestReport(t *testing.T) {
+	t.Parallel()
+	rep := &trackingErrorReporter{}
+	r := &Requester{name: "test", reporter: rep}
+	p := &Item{Method: http.MethodGet, Path: "/path"}
+	transportErr := errors.New("connection reset")
+
+	r.report(p, &http.Response{StatusCode: http.StatusOK}, nil, time.Second)
+	r.report(p, &http.Response{StatusCode: http.StatusBadRequest}, nil, time.Second)
+	assert.Equal(t, 2, rep.calls, "report should report the latency of each response")
+	assert.Empty(t, rep.errs, "report should not report successful or client error responses")
+
+	r.report(p, &http.Response{StatusCode: http.StatusBadGateway}, nil, time.Second)
+	r.report(p, &http.Response{StatusCode: http.StatusTooManyRequests}, nil, time.Second)
+	r.report(p, nil, transportErr, time.Second)
+	assert.Equal(t, 4, rep.calls, "report should not report the latency of a failed request")
+	require.Len(t, rep.errs, 3, "report must report server errors, rate limiting and failed requests")
+	assert.ErrorIs(t, rep.errs[0], ErrBadStatus)
+	assert.ErrorIs(t, rep.errs[1], ErrBadStatus)
+	assert.ErrorIs(t, rep.errs[2], transportErr)
+
+	r.reporter = &rep.trackingReporter
+	r.report(p, nil, transportErr, time.Second)
+	assert.Equal(t, 4, rep.calls, "report should not require an ErrorReporter")
+}
+
 func TestMain(m *testing.M) {
 	serverLimitInterval := time.Millisecond * 500
 	serverLimit = NewWeightedRateLimitByDuration(serverLimitInterval)
@@ -573,7 +613,7 @@ func TestExecuteRequestClosesResponseBody(t *testing.T) {
 			if attempt == 0 {
 				attempt = 1
 			}
-			retry, err := r.executeRequest(t.Context(), &Item{
+			retry, err := r.executeRequest(t.Context(), Unset, &Item{
 				Method:        http.MethodGet,
 				Path:          "https://example.com",
 				HTTPRecording: tc.record,
@@ -628,7 +668,7 @@ func TestExecuteRequestRecordsResponse(t *testing.T) {
 
 	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://example.com/record", http.NoBody)
 	require.NoError(t, err, "http.NewRequestWithContext must not error")
-	retry, err := r.executeRequest(t.Context(), &Item{
+	retry, err := r.executeRequest(t.Context(), Unset, &Item{
 		Method:        http.MethodGet,
 		Path:          "https://example.com/record",
 		HTTPRecording: true,
@@ -780,7 +820,7 @@ func TestExecuteRequestProcessesResponse(t *testing.T) {
 			headerResponse := make(http.Header)
 			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://example.com", http.NoBody)
 			require.NoError(t, err, "http.NewRequestWithContext must not error")
-			retry, err := r.executeRequest(t.Context(), &Item{
+			retry, err := r.executeRequest(t.Context(), Unset, &Item{
 				Method:         http.MethodGet,
 				Path:           "https://example.com",
 				Result:         &result,
@@ -853,7 +893,7 @@ func TestExecuteRequestTransportError(t *testing.T) {
 
 			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://example.com", http.NoBody)
 			require.NoError(t, err, "http.NewRequestWithContext must not error")
-			retry, err := r.executeRequest(t.Context(), &Item{Method: http.MethodGet, Path: "https://example.com"}, req, 1, false)
+			retry, err := r.executeRequest(t.Context(), Unset, &Item{Method: http.MethodGet, Path: "https://example.com"}, req, 1, false)
 			require.Equal(t, tc.expectedRetry, retry, "executeRequest must return the correct retry decision")
 			if tc.expectedErr != nil {
 				require.ErrorIs(t, err, tc.expectedErr, "executeRequest must return the transport error")
@@ -896,7 +936,7 @@ func TestExecuteRequestRedirectError(t *testing.T) {
 
 	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://example.com", http.NoBody)
 	require.NoError(t, err, "http.NewRequestWithContext must not error")
-	retry, err := r.executeRequest(t.Context(), &Item{Method: http.MethodGet, Path: "https://example.com"}, req, 1, false)
+	retry, err := r.executeRequest(t.Context(), Unset, &Item{Method: http.MethodGet, Path: "https://example.com"}, req, 1, false)
 	require.ErrorIs(t, err, redirectErr, "executeRequest must return the redirect error")
 	require.False(t, retry, "executeRequest must not retry")
 	assert.Equal(t, 1, body.closeCalls, "executeRequest should close body exactly once")
@@ -984,7 +1024,7 @@ func TestExecuteRequestVerboseRequestBody(t *testing.T) {
 				}
 				return requestBody, nil
 			}
-			retry, err := r.executeRequest(t.Context(), &Item{
+			retry, err := r.executeRequest(t.Context(), Unset, &Item{
 				Method: http.MethodPost,
 				Path:   "https://example.com",
 			}, req, 1, true)
@@ -1141,6 +1181,57 @@ func TestDoRequest_Retries(t *testing.T) {
 	require.NoError(t, ec.Collect(), "Collect must return no errors")
 }
 
+func TestSendPayloadTracing(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	r, err := New("tracing-test", new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Millisecond*50, 1, 1)),
+		WithBackoff(func(int) time.Duration { return 0 }))
+	require.NoError(t, err, "New requester must not error")
+	r.maxRetries = 1
+
+	for range 2 {
+		err = r.SendPayload(t.Context(), Auth, func() (*Item, error) {
+			return &Item{Method: http.MethodGet, Path: testURL + "/?signature=secret"}, nil
+		}, AuthenticatedRequest)
+		require.NoError(t, err, "SendPayload must not error")
+	}
+	err = r.SendPayload(t.Context(), UnAuth, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL + "/always-retry"}, nil
+	}, UnauthenticatedRequest)
+	require.ErrorIs(t, err, errFailedToRetryRequest, "SendPayload must error when retries are exhausted")
+
+	var payloads, attempts []sdktrace.ReadOnlySpan
+	for _, s := range rec.Ended() {
+		switch s.Name() {
+		case "request.SendPayload":
+			payloads = append(payloads, s)
+		case "HTTP GET":
+			attempts = append(attempts, s)
+		}
+	}
+	require.Len(t, payloads, 3, "must record a span per SendPayload call")
+	require.Len(t, attempts, 4, "must record a span per HTTP attempt")
+
+	hasEvent := func(s sdktrace.ReadOnlySpan, name string) bool {
+		return slices.ContainsFunc(s.Events(), func(e sdktrace.Event) bool { return e.Name == name })
+	}
+	assert.True(t, hasEvent(payloads[1], tracing.EventRateLimitWait), "second request should record a rate limit wait")
+	assert.True(t, hasEvent(payloads[2], tracing.EventRetry), "retried request should record a retry event")
+	assert.Equal(t, codes.Error, payloads[2].Status().Code, "failed request span should have error status")
+	assert.Contains(t, payloads[0].Attributes(), tracing.ExchangeKey.String("tracing-test"), "span should carry the exchange name")
+
+	for _, a := range attempts {
+		assert.True(t, a.Parent().IsValid(), "attempt span must be a child of the payload span")
+		for _, kv := range a.Attributes() {
+			assert.NotContains(t, kv.Value.Emit(), "secret", "attempt span must not record query parameters")
+		}
+	}
+}
+
 func TestDoRequest_RetryNonRecoverable(t *testing.T) {
 	t.Parallel()
 
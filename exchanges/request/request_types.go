@@ -3,10 +3,12 @@ package request
 import (
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"golang.org/x/time/rate"
 )
 
 // Const vars for rate limiter
@@ -37,6 +39,14 @@ type Requester struct {
 	backoff            Backoff
 	retryPolicy        RetryPolicy
 	timedLock          *timedmutex.TimedMutex
+	headerParser       HeaderParser
+	accountIdentifier  AccountIdentifier
+	quotas             map[quotaKey]*quotaState
+	baseRates          map[*rate.Limiter]rate.Limit
+	quotaMu            sync.RWMutex
+	schedulers         map[*rate.Limiter]*scheduler
+	schedulerMu        sync.Mutex
+	shedThreshold      int
 }
 
 // Item is a temp item for requests
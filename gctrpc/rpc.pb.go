@@ -2506,6 +2506,7 @@ type GetPortfolioSummaryResponse struct {
 	CoinsOfflineSummary map[string]*OfflineCoins `protobuf:"bytes,3,rep,name=coins_offline_summary,json=coinsOfflineSummary,proto3" json:"coins_offline_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CoinsOnline         []*Coin                  `protobuf:"bytes,4,rep,name=coins_online,json=coinsOnline,proto3" json:"coins_online,omitempty"`
 	CoinsOnlineSummary  map[string]*OnlineCoins  `protobuf:"bytes,5,rep,name=coins_online_summary,json=coinsOnlineSummary,proto3" json:"coins_online_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OptionsExposure     []*OptionExposure        `protobuf:"bytes,6,rep,name=options_exposure,json=optionsExposure,proto3" json:"options_exposure,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -2575,6 +2576,105 @@ func (x *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*Online
 	return nil
 }
 
+func (x *GetPortfolioSummaryResponse) GetOptionsExposure() []*OptionExposure {
+	if x != nil {
+		return x.OptionsExposure
+	}
+	return nil
+}
+
+type OptionExposure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Underlying    string                 `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Positions     int64                  `protobuf:"varint,2,opt,name=positions,proto3" json:"positions,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Delta         float64                `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Gamma         float64                `protobuf:"fixed64,5,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Vega          float64                `protobuf:"fixed64,6,opt,name=vega,proto3" json:"vega,omitempty"`
+	Theta         float64                `protobuf:"fixed64,7,opt,name=theta,proto3" json:"theta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionExposure) Reset() {
+	*x = OptionExposure{}
+	mi := &file_rpc_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionExposure) ProtoMessage() {}
+
+func (x *OptionExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionExposure.ProtoReflect.Descriptor instead.
+func (*OptionExposure) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OptionExposure) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OptionExposure) GetPositions() int64 {
+	if x != nil {
+		return x.Positions
+	}
+	return 0
+}
+
+func (x *OptionExposure) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *OptionExposure) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *OptionExposure) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *OptionExposure) GetVega() float64 {
+	if x != nil {
+		return x.Vega
+	}
+	return 0
+}
+
+func (x *OptionExposure) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
 type AddPortfolioAddressRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2589,7 +2689,7 @@ type AddPortfolioAddressRequest struct {
 
 func (x *AddPortfolioAddressRequest) Reset() {
 	*x = AddPortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +2701,7 @@ func (x *AddPortfolioAddressRequest) String() string {
 func (*AddPortfolioAddressRequest) ProtoMessage() {}
 
 func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2714,7 @@ func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{48}
+	return file_rpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AddPortfolioAddressRequest) GetAddress() string {
@@ -2670,7 +2770,7 @@ type RemovePortfolioAddressRequest struct {
 
 func (x *RemovePortfolioAddressRequest) Reset() {
 	*x = RemovePortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2682,7 +2782,7 @@ func (x *RemovePortfolioAddressRequest) String() string {
 func (*RemovePortfolioAddressRequest) ProtoMessage() {}
 
 func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +2795,7 @@ func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{49}
+	return file_rpc_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RemovePortfolioAddressRequest) GetAddress() string {
@@ -2727,7 +2827,7 @@ type GetForexProvidersRequest struct {
 
 func (x *GetForexProvidersRequest) Reset() {
 	*x = GetForexProvidersRequest{}
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2739,7 +2839,7 @@ func (x *GetForexProvidersRequest) String() string {
 func (*GetForexProvidersRequest) ProtoMessage() {}
 
 func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,7 +2852,7 @@ func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersRequest.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{50}
+	return file_rpc_proto_rawDescGZIP(), []int{51}
 }
 
 type ForexProvider struct {
@@ -2770,7 +2870,7 @@ type ForexProvider struct {
 
 func (x *ForexProvider) Reset() {
 	*x = ForexProvider{}
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2782,7 +2882,7 @@ func (x *ForexProvider) String() string {
 func (*ForexProvider) ProtoMessage() {}
 
 func (x *ForexProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +2895,7 @@ func (x *ForexProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexProvider.ProtoReflect.Descriptor instead.
 func (*ForexProvider) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{51}
+	return file_rpc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ForexProvider) GetName() string {
@@ -2856,7 +2956,7 @@ type GetForexProvidersResponse struct {
 
 func (x *GetForexProvidersResponse) Reset() {
 	*x = GetForexProvidersResponse{}
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2868,7 +2968,7 @@ func (x *GetForexProvidersResponse) String() string {
 func (*GetForexProvidersResponse) ProtoMessage() {}
 
 func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,7 +2981,7 @@ func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersResponse.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{52}
+	return file_rpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetForexProvidersResponse) GetForexProviders() []*ForexProvider {
@@ -2899,7 +2999,7 @@ type GetForexRatesRequest struct {
 
 func (x *GetForexRatesRequest) Reset() {
 	*x = GetForexRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2911,7 +3011,7 @@ func (x *GetForexRatesRequest) String() string {
 func (*GetForexRatesRequest) ProtoMessage() {}
 
 func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +3024,7 @@ func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{53}
+	return file_rpc_proto_rawDescGZIP(), []int{54}
 }
 
 type ForexRatesConversion struct {
@@ -2939,7 +3039,7 @@ type ForexRatesConversion struct {
 
 func (x *ForexRatesConversion) Reset() {
 	*x = ForexRatesConversion{}
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2951,7 +3051,7 @@ func (x *ForexRatesConversion) String() string {
 func (*ForexRatesConversion) ProtoMessage() {}
 
 func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,7 +3064,7 @@ func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexRatesConversion.ProtoReflect.Descriptor instead.
 func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{54}
+	return file_rpc_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ForexRatesConversion) GetFrom() string {
@@ -3004,7 +3104,7 @@ type GetForexRatesResponse struct {
 
 func (x *GetForexRatesResponse) Reset() {
 	*x = GetForexRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3116,7 @@ func (x *GetForexRatesResponse) String() string {
 func (*GetForexRatesResponse) ProtoMessage() {}
 
 func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3129,7 @@ func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{55}
+	return file_rpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
@@ -3059,13 +3159,14 @@ type OrderDetails struct {
 	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
 	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
 	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	Account        string                 `protobuf:"bytes,19,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderDetails) Reset() {
 	*x = OrderDetails{}
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3077,7 +3178,7 @@ func (x *OrderDetails) String() string {
 func (*OrderDetails) ProtoMessage() {}
 
 func (x *OrderDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3090,7 +3191,7 @@ func (x *OrderDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
 func (*OrderDetails) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{56}
+	return file_rpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *OrderDetails) GetExchange() string {
@@ -3219,6 +3320,13 @@ func (x *OrderDetails) GetContractAmount() float64 {
 	return 0
 }
 
+func (x *OrderDetails) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
@@ -3236,7 +3344,7 @@ type TradeHistory struct {
 
 func (x *TradeHistory) Reset() {
 	*x = TradeHistory{}
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3248,7 +3356,7 @@ func (x *TradeHistory) String() string {
 func (*TradeHistory) ProtoMessage() {}
 
 func (x *TradeHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3261,7 +3369,7 @@ func (x *TradeHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradeHistory.ProtoReflect.Descriptor instead.
 func (*TradeHistory) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{57}
+	return file_rpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *TradeHistory) GetCreationTime() int64 {
@@ -3340,7 +3448,7 @@ type GetOrdersRequest struct {
 
 func (x *GetOrdersRequest) Reset() {
 	*x = GetOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3352,7 +3460,7 @@ func (x *GetOrdersRequest) String() string {
 func (*GetOrdersRequest) ProtoMessage() {}
 
 func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +3473,7 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
 func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{58}
+	return file_rpc_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetOrdersRequest) GetExchange() string {
@@ -3412,7 +3520,7 @@ type GetOrdersResponse struct {
 
 func (x *GetOrdersResponse) Reset() {
 	*x = GetOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3424,7 +3532,7 @@ func (x *GetOrdersResponse) String() string {
 func (*GetOrdersResponse) ProtoMessage() {}
 
 func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,7 +3545,7 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
 func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{59}
+	return file_rpc_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetOrdersResponse) GetOrders() []*OrderDetails {
@@ -3459,7 +3567,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3471,7 +3579,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +3592,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{60}
+	return file_rpc_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetOrderRequest) GetExchange() string {
@@ -3532,7 +3640,7 @@ type SubmitOrderRequest struct {
 
 func (x *SubmitOrderRequest) Reset() {
 	*x = SubmitOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3544,7 +3652,7 @@ func (x *SubmitOrderRequest) String() string {
 func (*SubmitOrderRequest) ProtoMessage() {}
 
 func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,7 +3665,7 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
 func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{61}
+	return file_rpc_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *SubmitOrderRequest) GetExchange() string {
@@ -3635,7 +3743,7 @@ type Trades struct {
 
 func (x *Trades) Reset() {
 	*x = Trades{}
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3647,7 +3755,7 @@ func (x *Trades) String() string {
 func (*Trades) ProtoMessage() {}
 
 func (x *Trades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3660,7 +3768,7 @@ func (x *Trades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trades.ProtoReflect.Descriptor instead.
 func (*Trades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{62}
+	return file_rpc_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Trades) GetAmount() float64 {
@@ -3702,7 +3810,7 @@ type SubmitOrderResponse struct {
 
 func (x *SubmitOrderResponse) Reset() {
 	*x = SubmitOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3714,7 +3822,7 @@ func (x *SubmitOrderResponse) String() string {
 func (*SubmitOrderResponse) ProtoMessage() {}
 
 func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,7 +3835,7 @@ func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
 func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{63}
+	return file_rpc_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SubmitOrderResponse) GetOrderPlaced() bool {
@@ -3764,7 +3872,7 @@ type SimulateOrderRequest struct {
 
 func (x *SimulateOrderRequest) Reset() {
 	*x = SimulateOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3776,7 +3884,7 @@ func (x *SimulateOrderRequest) String() string {
 func (*SimulateOrderRequest) ProtoMessage() {}
 
 func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,7 +3897,7 @@ func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderRequest.ProtoReflect.Descriptor instead.
 func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{64}
+	return file_rpc_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SimulateOrderRequest) GetExchange() string {
@@ -3841,7 +3949,7 @@ type SimulateOrderResponse struct {
 
 func (x *SimulateOrderResponse) Reset() {
 	*x = SimulateOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3853,7 +3961,7 @@ func (x *SimulateOrderResponse) String() string {
 func (*SimulateOrderResponse) ProtoMessage() {}
 
 func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3866,7 +3974,7 @@ func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderResponse.ProtoReflect.Descriptor instead.
 func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{65}
+	return file_rpc_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SimulateOrderResponse) GetOrders() []*OrderbookItem {
@@ -3924,7 +4032,7 @@ type WhaleBombRequest struct {
 
 func (x *WhaleBombRequest) Reset() {
 	*x = WhaleBombRequest{}
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3936,7 +4044,7 @@ func (x *WhaleBombRequest) String() string {
 func (*WhaleBombRequest) ProtoMessage() {}
 
 func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4057,7 @@ func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhaleBombRequest.ProtoReflect.Descriptor instead.
 func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{66}
+	return file_rpc_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *WhaleBombRequest) GetExchange() string {
@@ -4001,7 +4109,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +4121,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +4134,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{67}
+	return file_rpc_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CancelOrderRequest) GetExchange() string {
@@ -4085,7 +4193,7 @@ type CancelBatchOrdersRequest struct {
 
 func (x *CancelBatchOrdersRequest) Reset() {
 	*x = CancelBatchOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4097,7 +4205,7 @@ func (x *CancelBatchOrdersRequest) String() string {
 func (*CancelBatchOrdersRequest) ProtoMessage() {}
 
 func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,7 +4218,7 @@ func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{68}
+	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CancelBatchOrdersRequest) GetExchange() string {
@@ -4165,7 +4273,7 @@ type Orders struct {
 
 func (x *Orders) Reset() {
 	*x = Orders{}
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4177,7 +4285,7 @@ func (x *Orders) String() string {
 func (*Orders) ProtoMessage() {}
 
 func (x *Orders) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4190,7 +4298,7 @@ func (x *Orders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Orders.ProtoReflect.Descriptor instead.
 func (*Orders) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{69}
+	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Orders) GetExchange() string {
@@ -4216,7 +4324,7 @@ type CancelBatchOrdersResponse struct {
 
 func (x *CancelBatchOrdersResponse) Reset() {
 	*x = CancelBatchOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4228,7 +4336,7 @@ func (x *CancelBatchOrdersResponse) String() string {
 func (*CancelBatchOrdersResponse) ProtoMessage() {}
 
 func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4241,7 +4349,7 @@ func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{70}
+	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
@@ -4260,7 +4368,7 @@ type CancelAllOrdersRequest struct {
 
 func (x *CancelAllOrdersRequest) Reset() {
 	*x = CancelAllOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4272,7 +4380,7 @@ func (x *CancelAllOrdersRequest) String() string {
 func (*CancelAllOrdersRequest) ProtoMessage() {}
 
 func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4285,7 +4393,7 @@ func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{71}
+	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CancelAllOrdersRequest) GetExchange() string {
@@ -4305,7 +4413,7 @@ type CancelAllOrdersResponse struct {
 
 func (x *CancelAllOrdersResponse) Reset() {
 	*x = CancelAllOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4317,7 +4425,7 @@ func (x *CancelAllOrdersResponse) String() string {
 func (*CancelAllOrdersResponse) ProtoMessage() {}
 
 func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4330,7 +4438,7 @@ func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{72}
+	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CancelAllOrdersResponse) GetOrders() []*Orders {
@@ -4355,7 +4463,7 @@ type GetEventsRequest struct {
 
 func (x *GetEventsRequest) Reset() {
 	*x = GetEventsRequest{}
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4367,7 +4475,7 @@ func (x *GetEventsRequest) String() string {
 func (*GetEventsRequest) ProtoMessage() {}
 
 func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,7 +4488,7 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{73}
+	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
 type ConditionParams struct {
@@ -4396,7 +4504,7 @@ type ConditionParams struct {
 
 func (x *ConditionParams) Reset() {
 	*x = ConditionParams{}
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4408,7 +4516,7 @@ func (x *ConditionParams) String() string {
 func (*ConditionParams) ProtoMessage() {}
 
 func (x *ConditionParams) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4421,7 +4529,7 @@ func (x *ConditionParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionParams.ProtoReflect.Descriptor instead.
 func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{74}
+	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ConditionParams) GetCondition() string {
@@ -4474,7 +4582,7 @@ type GetEventsResponse struct {
 
 func (x *GetEventsResponse) Reset() {
 	*x = GetEventsResponse{}
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4486,7 +4594,7 @@ func (x *GetEventsResponse) String() string {
 func (*GetEventsResponse) ProtoMessage() {}
 
 func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4499,7 +4607,7 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetEventsResponse) GetId() int64 {
@@ -4565,7 +4673,7 @@ type AddEventRequest struct {
 
 func (x *AddEventRequest) Reset() {
 	*x = AddEventRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4577,7 +4685,7 @@ func (x *AddEventRequest) String() string {
 func (*AddEventRequest) ProtoMessage() {}
 
 func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4590,7 +4698,7 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
 func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AddEventRequest) GetExchange() string {
@@ -4644,7 +4752,7 @@ type AddEventResponse struct {
 
 func (x *AddEventResponse) Reset() {
 	*x = AddEventResponse{}
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4656,7 +4764,7 @@ func (x *AddEventResponse) String() string {
 func (*AddEventResponse) ProtoMessage() {}
 
 func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4669,7 +4777,7 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
 func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AddEventResponse) GetId() int64 {
@@ -4688,7 +4796,7 @@ type RemoveEventRequest struct {
 
 func (x *RemoveEventRequest) Reset() {
 	*x = RemoveEventRequest{}
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4700,7 +4808,7 @@ func (x *RemoveEventRequest) String() string {
 func (*RemoveEventRequest) ProtoMessage() {}
 
 func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4713,7 +4821,7 @@ func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
 func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RemoveEventRequest) GetId() int64 {
@@ -4732,7 +4840,7 @@ type GetCryptocurrencyDepositAddressesRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressesRequest{}
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4744,7 +4852,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
 func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,7 +4865,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
@@ -4778,7 +4886,7 @@ type DepositAddress struct {
 
 func (x *DepositAddress) Reset() {
 	*x = DepositAddress{}
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4790,7 +4898,7 @@ func (x *DepositAddress) String() string {
 func (*DepositAddress) ProtoMessage() {}
 
 func (x *DepositAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +4911,7 @@ func (x *DepositAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
 func (*DepositAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DepositAddress) GetAddress() string {
@@ -4836,7 +4944,7 @@ type DepositAddresses struct {
 
 func (x *DepositAddresses) Reset() {
 	*x = DepositAddresses{}
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +4956,7 @@ func (x *DepositAddresses) String() string {
 func (*DepositAddresses) ProtoMessage() {}
 
 func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +4969,7 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
 func (*DepositAddresses) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DepositAddresses) GetAddresses() []*DepositAddress {
@@ -4880,7 +4988,7 @@ type GetCryptocurrencyDepositAddressesResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressesResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4892,7 +5000,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
 func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +5013,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
@@ -4927,7 +5035,7 @@ type GetCryptocurrencyDepositAddressRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4939,7 +5047,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +5060,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
@@ -4993,7 +5101,7 @@ type GetCryptocurrencyDepositAddressResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5005,7 +5113,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5018,7 +5126,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
@@ -5045,7 +5153,7 @@ type GetAvailableTransferChainsRequest struct {
 
 func (x *GetAvailableTransferChainsRequest) Reset() {
 	*x = GetAvailableTransferChainsRequest{}
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5057,7 +5165,7 @@ func (x *GetAvailableTransferChainsRequest) String() string {
 func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,7 +5178,7 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetAvailableTransferChainsRequest) GetExchange() string {
@@ -5096,7 +5204,7 @@ type GetAvailableTransferChainsResponse struct {
 
 func (x *GetAvailableTransferChainsResponse) Reset() {
 	*x = GetAvailableTransferChainsResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5108,7 +5216,7 @@ func (x *GetAvailableTransferChainsResponse) String() string {
 func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,7 +5229,7 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetAvailableTransferChainsResponse) GetChains() []string {
@@ -5144,7 +5252,7 @@ type WithdrawFiatRequest struct {
 
 func (x *WithdrawFiatRequest) Reset() {
 	*x = WithdrawFiatRequest{}
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5156,7 +5264,7 @@ func (x *WithdrawFiatRequest) String() string {
 func (*WithdrawFiatRequest) ProtoMessage() {}
 
 func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,7 +5277,7 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *WithdrawFiatRequest) GetExchange() string {
@@ -5223,7 +5331,7 @@ type WithdrawCryptoRequest struct {
 
 func (x *WithdrawCryptoRequest) Reset() {
 	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5235,7 +5343,7 @@ func (x *WithdrawCryptoRequest) String() string {
 func (*WithdrawCryptoRequest) ProtoMessage() {}
 
 func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5248,7 +5356,7 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *WithdrawCryptoRequest) GetExchange() string {
@@ -5317,7 +5425,7 @@ type WithdrawResponse struct {
 
 func (x *WithdrawResponse) Reset() {
 	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5329,7 +5437,7 @@ func (x *WithdrawResponse) String() string {
 func (*WithdrawResponse) ProtoMessage() {}
 
 func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5342,7 +5450,7 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *WithdrawResponse) GetId() string {
@@ -5368,7 +5476,7 @@ type WithdrawalEventByIDRequest struct {
 
 func (x *WithdrawalEventByIDRequest) Reset() {
 	*x = WithdrawalEventByIDRequest{}
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5380,7 +5488,7 @@ func (x *WithdrawalEventByIDRequest) String() string {
 func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +5501,7 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *WithdrawalEventByIDRequest) GetId() string {
@@ -5412,7 +5520,7 @@ type WithdrawalEventByIDResponse struct {
 
 func (x *WithdrawalEventByIDResponse) Reset() {
 	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5424,7 +5532,7 @@ func (x *WithdrawalEventByIDResponse) String() string {
 func (*WithdrawalEventByIDResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,7 +5545,7 @@ func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
@@ -5460,7 +5568,7 @@ type WithdrawalEventsByExchangeRequest struct {
 
 func (x *WithdrawalEventsByExchangeRequest) Reset() {
 	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5472,7 +5580,7 @@ func (x *WithdrawalEventsByExchangeRequest) String() string {
 func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5485,7 +5593,7 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
@@ -5535,7 +5643,7 @@ type WithdrawalEventsByDateRequest struct {
 
 func (x *WithdrawalEventsByDateRequest) Reset() {
 	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5547,7 +5655,7 @@ func (x *WithdrawalEventsByDateRequest) String() string {
 func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5560,7 +5668,7 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *WithdrawalEventsByDateRequest) GetExchange() string {
@@ -5600,7 +5708,7 @@ type WithdrawalEventsByExchangeResponse struct {
 
 func (x *WithdrawalEventsByExchangeResponse) Reset() {
 	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5612,7 +5720,7 @@ func (x *WithdrawalEventsByExchangeResponse) String() string {
 func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,7 +5733,7 @@ func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
@@ -5648,7 +5756,7 @@ type WithdrawalEventResponse struct {
 
 func (x *WithdrawalEventResponse) Reset() {
 	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5660,7 +5768,7 @@ func (x *WithdrawalEventResponse) String() string {
 func (*WithdrawalEventResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,7 +5781,7 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *WithdrawalEventResponse) GetId() string {
@@ -5722,7 +5830,7 @@ type WithdrawalExchangeEvent struct {
 
 func (x *WithdrawalExchangeEvent) Reset() {
 	*x = WithdrawalExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5734,7 +5842,7 @@ func (x *WithdrawalExchangeEvent) String() string {
 func (*WithdrawalExchangeEvent) ProtoMessage() {}
 
 func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5747,7 +5855,7 @@ func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalExchangeEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *WithdrawalExchangeEvent) GetName() string {
@@ -5785,7 +5893,7 @@ type WithdrawalRequestEvent struct {
 
 func (x *WithdrawalRequestEvent) Reset() {
 	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5797,7 +5905,7 @@ func (x *WithdrawalRequestEvent) String() string {
 func (*WithdrawalRequestEvent) ProtoMessage() {}
 
 func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,7 +5918,7 @@ func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *WithdrawalRequestEvent) GetCurrency() string {
@@ -5869,7 +5977,7 @@ type FiatWithdrawalEvent struct {
 
 func (x *FiatWithdrawalEvent) Reset() {
 	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +5989,7 @@ func (x *FiatWithdrawalEvent) String() string {
 func (*FiatWithdrawalEvent) ProtoMessage() {}
 
 func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +6002,7 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *FiatWithdrawalEvent) GetBankName() string {
@@ -5951,7 +6059,7 @@ type CryptoWithdrawalEvent struct {
 
 func (x *CryptoWithdrawalEvent) Reset() {
 	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5963,7 +6071,7 @@ func (x *CryptoWithdrawalEvent) String() string {
 func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
 func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,7 +6084,7 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CryptoWithdrawalEvent) GetAddress() string {
@@ -6016,7 +6124,7 @@ type GetLoggerDetailsRequest struct {
 
 func (x *GetLoggerDetailsRequest) Reset() {
 	*x = GetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6028,7 +6136,7 @@ func (x *GetLoggerDetailsRequest) String() string {
 func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6041,7 +6149,7 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetLoggerDetailsRequest) GetLogger() string {
@@ -6063,7 +6171,7 @@ type GetLoggerDetailsResponse struct {
 
 func (x *GetLoggerDetailsResponse) Reset() {
 	*x = GetLoggerDetailsResponse{}
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6075,7 +6183,7 @@ func (x *GetLoggerDetailsResponse) String() string {
 func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
 func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6088,7 +6196,7 @@ func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetLoggerDetailsResponse) GetInfo() bool {
@@ -6129,7 +6237,7 @@ type SetLoggerDetailsRequest struct {
 
 func (x *SetLoggerDetailsRequest) Reset() {
 	*x = SetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6141,7 +6249,7 @@ func (x *SetLoggerDetailsRequest) String() string {
 func (*SetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6154,7 +6262,7 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{102}
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *SetLoggerDetailsRequest) GetLogger() string {
@@ -6181,7 +6289,7 @@ type GetExchangePairsRequest struct {
 
 func (x *GetExchangePairsRequest) Reset() {
 	*x = GetExchangePairsRequest{}
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6193,7 +6301,7 @@ func (x *GetExchangePairsRequest) String() string {
 func (*GetExchangePairsRequest) ProtoMessage() {}
 
 func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6206,7 +6314,7 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{103}
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetExchangePairsRequest) GetExchange() string {
@@ -6232,7 +6340,7 @@ type GetExchangePairsResponse struct {
 
 func (x *GetExchangePairsResponse) Reset() {
 	*x = GetExchangePairsResponse{}
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6244,7 +6352,7 @@ func (x *GetExchangePairsResponse) String() string {
 func (*GetExchangePairsResponse) ProtoMessage() {}
 
 func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6257,7 +6365,7 @@ func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{104}
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
@@ -6279,7 +6387,7 @@ type SetExchangePairRequest struct {
 
 func (x *SetExchangePairRequest) Reset() {
 	*x = SetExchangePairRequest{}
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6291,7 +6399,7 @@ func (x *SetExchangePairRequest) String() string {
 func (*SetExchangePairRequest) ProtoMessage() {}
 
 func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6304,7 +6412,7 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{105}
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *SetExchangePairRequest) GetExchange() string {
@@ -6346,7 +6454,7 @@ type GetOrderbookStreamRequest struct {
 
 func (x *GetOrderbookStreamRequest) Reset() {
 	*x = GetOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6358,7 +6466,7 @@ func (x *GetOrderbookStreamRequest) String() string {
 func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6371,7 +6479,7 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{106}
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetOrderbookStreamRequest) GetExchange() string {
@@ -6404,7 +6512,7 @@ type GetExchangeOrderbookStreamRequest struct {
 
 func (x *GetExchangeOrderbookStreamRequest) Reset() {
 	*x = GetExchangeOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6416,7 +6524,7 @@ func (x *GetExchangeOrderbookStreamRequest) String() string {
 func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6537,7 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{107}
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
@@ -6450,7 +6558,7 @@ type GetTickerStreamRequest struct {
 
 func (x *GetTickerStreamRequest) Reset() {
 	*x = GetTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6462,7 +6570,7 @@ func (x *GetTickerStreamRequest) String() string {
 func (*GetTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6475,7 +6583,7 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{108}
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetTickerStreamRequest) GetExchange() string {
@@ -6508,7 +6616,7 @@ type GetExchangeTickerStreamRequest struct {
 
 func (x *GetExchangeTickerStreamRequest) Reset() {
 	*x = GetExchangeTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6520,7 +6628,7 @@ func (x *GetExchangeTickerStreamRequest) String() string {
 func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6533,7 +6641,7 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{109}
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetExchangeTickerStreamRequest) GetExchange() string {
@@ -6556,7 +6664,7 @@ type GetAuditEventRequest struct {
 
 func (x *GetAuditEventRequest) Reset() {
 	*x = GetAuditEventRequest{}
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6568,7 +6676,7 @@ func (x *GetAuditEventRequest) String() string {
 func (*GetAuditEventRequest) ProtoMessage() {}
 
 func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,7 +6689,7 @@ func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{110}
+	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetAuditEventRequest) GetStartDate() string {
@@ -6628,7 +6736,7 @@ type GetAuditEventResponse struct {
 
 func (x *GetAuditEventResponse) Reset() {
 	*x = GetAuditEventResponse{}
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6640,7 +6748,7 @@ func (x *GetAuditEventResponse) String() string {
 func (*GetAuditEventResponse) ProtoMessage() {}
 
 func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6653,7 +6761,7 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
 func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{111}
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
@@ -6676,7 +6784,7 @@ type GetSavedTradesRequest struct {
 
 func (x *GetSavedTradesRequest) Reset() {
 	*x = GetSavedTradesRequest{}
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6688,7 +6796,7 @@ func (x *GetSavedTradesRequest) String() string {
 func (*GetSavedTradesRequest) ProtoMessage() {}
 
 func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6701,7 +6809,7 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{112}
+	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetSavedTradesRequest) GetExchange() string {
@@ -6752,7 +6860,7 @@ type SavedTrades struct {
 
 func (x *SavedTrades) Reset() {
 	*x = SavedTrades{}
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6764,7 +6872,7 @@ func (x *SavedTrades) String() string {
 func (*SavedTrades) ProtoMessage() {}
 
 func (x *SavedTrades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6777,7 +6885,7 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
 func (*SavedTrades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{113}
+	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *SavedTrades) GetPrice() float64 {
@@ -6827,7 +6935,7 @@ type SavedTradesResponse struct {
 
 func (x *SavedTradesResponse) Reset() {
 	*x = SavedTradesResponse{}
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6839,7 +6947,7 @@ func (x *SavedTradesResponse) String() string {
 func (*SavedTradesResponse) ProtoMessage() {}
 
 func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6852,7 +6960,7 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
 func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{114}
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *SavedTradesResponse) GetExchangeName() string {
@@ -6899,7 +7007,7 @@ type ConvertTradesToCandlesRequest struct {
 
 func (x *ConvertTradesToCandlesRequest) Reset() {
 	*x = ConvertTradesToCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6911,7 +7019,7 @@ func (x *ConvertTradesToCandlesRequest) String() string {
 func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
 func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6924,7 +7032,7 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
 func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{115}
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ConvertTradesToCandlesRequest) GetExchange() string {
@@ -7002,7 +7110,7 @@ type GetHistoricCandlesRequest struct {
 
 func (x *GetHistoricCandlesRequest) Reset() {
 	*x = GetHistoricCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[116]
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7014,7 +7122,7 @@ func (x *GetHistoricCandlesRequest) String() string {
 func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
 func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7027,7 +7135,7 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GetHistoricCandlesRequest) GetExchange() string {
@@ -7121,7 +7229,7 @@ type GetHistoricCandlesResponse struct {
 
 func (x *GetHistoricCandlesResponse) Reset() {
 	*x = GetHistoricCandlesResponse{}
-	mi := &file_rpc_proto_msgTypes[117]
+	mi := &file_rpc_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7133,7 +7241,7 @@ func (x *GetHistoricCandlesResponse) String() string {
 func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
 func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7146,7 +7254,7 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+	return file_rpc_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *GetHistoricCandlesResponse) GetExchange() string {
@@ -7206,7 +7314,7 @@ type Candle struct {
 
 func (x *Candle) Reset() {
 	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7218,7 +7326,7 @@ func (x *Candle) String() string {
 func (*Candle) ProtoMessage() {}
 
 func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7231,7 +7339,7 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Candle.ProtoReflect.Descriptor instead.
 func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *Candle) GetTime() string {
@@ -7295,7 +7403,7 @@ type AuditEvent struct {
 
 func (x *AuditEvent) Reset() {
 	*x = AuditEvent{}
-	mi := &file_rpc_proto_msgTypes[119]
+	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7307,7 +7415,7 @@ func (x *AuditEvent) String() string {
 func (*AuditEvent) ProtoMessage() {}
 
 func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7320,7 +7428,7 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
 func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *AuditEvent) GetType() string {
@@ -7363,7 +7471,7 @@ type GCTScript struct {
 
 func (x *GCTScript) Reset() {
 	*x = GCTScript{}
-	mi := &file_rpc_proto_msgTypes[120]
+	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7375,7 +7483,7 @@ func (x *GCTScript) String() string {
 func (*GCTScript) ProtoMessage() {}
 
 func (x *GCTScript) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[120]
+	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7388,7 +7496,7 @@ func (x *GCTScript) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
 func (*GCTScript) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{120}
+	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *GCTScript) GetUuid() string {
@@ -7419,16 +7527,125 @@ func (x *GCTScript) GetNextRun() string {
 	return ""
 }
 
+type GCTScriptSimulation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,4,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	DataFile      string                 `protobuf:"bytes,7,opt,name=data_file,json=dataFile,proto3" json:"data_file,omitempty"`
+	Balances      map[string]float64     `protobuf:"bytes,8,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Trace         bool                   `protobuf:"varint,9,opt,name=trace,proto3" json:"trace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GCTScriptSimulation) Reset() {
+	*x = GCTScriptSimulation{}
+	mi := &file_rpc_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GCTScriptSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTScriptSimulation) ProtoMessage() {}
+
+func (x *GCTScriptSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCTScriptSimulation.ProtoReflect.Descriptor instead.
+func (*GCTScriptSimulation) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GCTScriptSimulation) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GCTScriptSimulation) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GCTScriptSimulation) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GCTScriptSimulation) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
+
+func (x *GCTScriptSimulation) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GCTScriptSimulation) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GCTScriptSimulation) GetDataFile() string {
+	if x != nil {
+		return x.DataFile
+	}
+	return ""
+}
+
+func (x *GCTScriptSimulation) GetBalances() map[string]float64 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GCTScriptSimulation) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
 type GCTScriptExecuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Simulation    *GCTScriptSimulation   `protobuf:"bytes,2,opt,name=simulation,proto3" json:"simulation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GCTScriptExecuteRequest) Reset() {
 	*x = GCTScriptExecuteRequest{}
-	mi := &file_rpc_proto_msgTypes[121]
+	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7440,7 +7657,7 @@ func (x *GCTScriptExecuteRequest) String() string {
 func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
 func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[121]
+	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7453,7 +7670,7 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{121}
+	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
@@ -7463,6 +7680,13 @@ func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
 	return nil
 }
 
+func (x *GCTScriptExecuteRequest) GetSimulation() *GCTScriptSimulation {
+	if x != nil {
+		return x.Simulation
+	}
+	return nil
+}
+
 type GCTScriptStopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
@@ -7472,7 +7696,7 @@ type GCTScriptStopRequest struct {
 
 func (x *GCTScriptStopRequest) Reset() {
 	*x = GCTScriptStopRequest{}
-	mi := &file_rpc_proto_msgTypes[122]
+	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7484,7 +7708,7 @@ func (x *GCTScriptStopRequest) String() string {
 func (*GCTScriptStopRequest) ProtoMessage() {}
 
 func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7497,7 +7721,7 @@ func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{122}
+	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *GCTScriptStopRequest) GetScript() *GCTScript {
@@ -7515,7 +7739,7 @@ type GCTScriptStopAllRequest struct {
 
 func (x *GCTScriptStopAllRequest) Reset() {
 	*x = GCTScriptStopAllRequest{}
-	mi := &file_rpc_proto_msgTypes[123]
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7527,7 +7751,7 @@ func (x *GCTScriptStopAllRequest) String() string {
 func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
 func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[123]
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7540,7 +7764,7 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{123}
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
 type GCTScriptStatusRequest struct {
@@ -7551,7 +7775,7 @@ type GCTScriptStatusRequest struct {
 
 func (x *GCTScriptStatusRequest) Reset() {
 	*x = GCTScriptStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[124]
+	mi := &file_rpc_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7563,7 +7787,7 @@ func (x *GCTScriptStatusRequest) String() string {
 func (*GCTScriptStatusRequest) ProtoMessage() {}
 
 func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[124]
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7576,7 +7800,7 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{124}
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
 type GCTScriptListAllRequest struct {
@@ -7587,7 +7811,7 @@ type GCTScriptListAllRequest struct {
 
 func (x *GCTScriptListAllRequest) Reset() {
 	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
+	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7599,7 +7823,7 @@ func (x *GCTScriptListAllRequest) String() string {
 func (*GCTScriptListAllRequest) ProtoMessage() {}
 
 func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7612,7 +7836,7 @@ func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
 type GCTScriptUploadRequest struct {
@@ -7628,7 +7852,7 @@ type GCTScriptUploadRequest struct {
 
 func (x *GCTScriptUploadRequest) Reset() {
 	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
+	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7640,7 +7864,7 @@ func (x *GCTScriptUploadRequest) String() string {
 func (*GCTScriptUploadRequest) ProtoMessage() {}
 
 func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7653,7 +7877,7 @@ func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *GCTScriptUploadRequest) GetScriptName() string {
@@ -7700,7 +7924,7 @@ type GCTScriptReadScriptRequest struct {
 
 func (x *GCTScriptReadScriptRequest) Reset() {
 	*x = GCTScriptReadScriptRequest{}
-	mi := &file_rpc_proto_msgTypes[127]
+	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7712,7 +7936,7 @@ func (x *GCTScriptReadScriptRequest) String() string {
 func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
 func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7725,7 +7949,7 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
@@ -7744,7 +7968,7 @@ type GCTScriptQueryRequest struct {
 
 func (x *GCTScriptQueryRequest) Reset() {
 	*x = GCTScriptQueryRequest{}
-	mi := &file_rpc_proto_msgTypes[128]
+	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7756,7 +7980,7 @@ func (x *GCTScriptQueryRequest) String() string {
 func (*GCTScriptQueryRequest) ProtoMessage() {}
 
 func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7769,7 +7993,7 @@ func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
@@ -7789,7 +8013,7 @@ type GCTScriptAutoLoadRequest struct {
 
 func (x *GCTScriptAutoLoadRequest) Reset() {
 	*x = GCTScriptAutoLoadRequest{}
-	mi := &file_rpc_proto_msgTypes[129]
+	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7801,7 +8025,7 @@ func (x *GCTScriptAutoLoadRequest) String() string {
 func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
 func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7814,7 +8038,7 @@ func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *GCTScriptAutoLoadRequest) GetScript() string {
@@ -7841,7 +8065,7 @@ type GCTScriptStatusResponse struct {
 
 func (x *GCTScriptStatusResponse) Reset() {
 	*x = GCTScriptStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[130]
+	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7853,7 +8077,7 @@ func (x *GCTScriptStatusResponse) String() string {
 func (*GCTScriptStatusResponse) ProtoMessage() {}
 
 func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7866,7 +8090,7 @@ func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
 func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *GCTScriptStatusResponse) GetStatus() string {
@@ -7894,7 +8118,7 @@ type GCTScriptQueryResponse struct {
 
 func (x *GCTScriptQueryResponse) Reset() {
 	*x = GCTScriptQueryResponse{}
-	mi := &file_rpc_proto_msgTypes[131]
+	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7906,7 +8130,7 @@ func (x *GCTScriptQueryResponse) String() string {
 func (*GCTScriptQueryResponse) ProtoMessage() {}
 
 func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7919,7 +8143,7 @@ func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
 func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GCTScriptQueryResponse) GetStatus() string {
@@ -7953,7 +8177,7 @@ type GenericResponse struct {
 
 func (x *GenericResponse) Reset() {
 	*x = GenericResponse{}
-	mi := &file_rpc_proto_msgTypes[132]
+	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7965,7 +8189,7 @@ func (x *GenericResponse) String() string {
 func (*GenericResponse) ProtoMessage() {}
 
 func (x *GenericResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7978,7 +8202,7 @@ func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
 func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GenericResponse) GetStatus() string {
@@ -8006,7 +8230,7 @@ type SetExchangeAssetRequest struct {
 
 func (x *SetExchangeAssetRequest) Reset() {
 	*x = SetExchangeAssetRequest{}
-	mi := &file_rpc_proto_msgTypes[133]
+	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8018,7 +8242,7 @@ func (x *SetExchangeAssetRequest) String() string {
 func (*SetExchangeAssetRequest) ProtoMessage() {}
 
 func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8031,7 +8255,7 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *SetExchangeAssetRequest) GetExchange() string {
@@ -8065,7 +8289,7 @@ type SetExchangeAllPairsRequest struct {
 
 func (x *SetExchangeAllPairsRequest) Reset() {
 	*x = SetExchangeAllPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[134]
+	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8077,7 +8301,7 @@ func (x *SetExchangeAllPairsRequest) String() string {
 func (*SetExchangeAllPairsRequest) ProtoMessage() {}
 
 func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8090,7 +8314,7 @@ func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *SetExchangeAllPairsRequest) GetExchange() string {
@@ -8116,7 +8340,7 @@ type UpdateExchangeSupportedPairsRequest struct {
 
 func (x *UpdateExchangeSupportedPairsRequest) Reset() {
 	*x = UpdateExchangeSupportedPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[135]
+	mi := &file_rpc_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8128,7 +8352,7 @@ func (x *UpdateExchangeSupportedPairsRequest) String() string {
 func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
 
 func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[135]
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8141,7 +8365,7 @@ func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{135}
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
@@ -8160,7 +8384,7 @@ type GetExchangeAssetsRequest struct {
 
 func (x *GetExchangeAssetsRequest) Reset() {
 	*x = GetExchangeAssetsRequest{}
-	mi := &file_rpc_proto_msgTypes[136]
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8172,7 +8396,7 @@ func (x *GetExchangeAssetsRequest) String() string {
 func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
 func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[136]
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8185,7 +8409,7 @@ func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{136}
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *GetExchangeAssetsRequest) GetExchange() string {
@@ -8204,7 +8428,7 @@ type GetExchangeAssetsResponse struct {
 
 func (x *GetExchangeAssetsResponse) Reset() {
 	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8216,7 +8440,7 @@ func (x *GetExchangeAssetsResponse) String() string {
 func (*GetExchangeAssetsResponse) ProtoMessage() {}
 
 func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8229,7 +8453,7 @@ func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
 func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *GetExchangeAssetsResponse) GetAssets() string {
@@ -8248,7 +8472,7 @@ type WebsocketGetInfoRequest struct {
 
 func (x *WebsocketGetInfoRequest) Reset() {
 	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
+	mi := &file_rpc_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8260,7 +8484,7 @@ func (x *WebsocketGetInfoRequest) String() string {
 func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
 func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8273,7 +8497,7 @@ func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
+	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *WebsocketGetInfoRequest) GetExchange() string {
@@ -8298,7 +8522,7 @@ type WebsocketGetInfoResponse struct {
 
 func (x *WebsocketGetInfoResponse) Reset() {
 	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8310,7 +8534,7 @@ func (x *WebsocketGetInfoResponse) String() string {
 func (*WebsocketGetInfoResponse) ProtoMessage() {}
 
 func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8323,7 +8547,7 @@ func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
 func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *WebsocketGetInfoResponse) GetExchange() string {
@@ -8385,7 +8609,7 @@ type WebsocketSetEnabledRequest struct {
 
 func (x *WebsocketSetEnabledRequest) Reset() {
 	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+	mi := &file_rpc_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8397,7 +8621,7 @@ func (x *WebsocketSetEnabledRequest) String() string {
 func (*WebsocketSetEnabledRequest) ProtoMessage() {}
 
 func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8410,7 +8634,7 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
+	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *WebsocketSetEnabledRequest) GetExchange() string {
@@ -8436,7 +8660,7 @@ type WebsocketGetSubscriptionsRequest struct {
 
 func (x *WebsocketGetSubscriptionsRequest) Reset() {
 	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
+	mi := &file_rpc_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8448,7 +8672,7 @@ func (x *WebsocketGetSubscriptionsRequest) String() string {
 func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
 func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+	mi := &file_rpc_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8461,7 +8685,7 @@ func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+	return file_rpc_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
@@ -8483,7 +8707,7 @@ type WebsocketSubscription struct {
 
 func (x *WebsocketSubscription) Reset() {
 	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+	mi := &file_rpc_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8495,7 +8719,7 @@ func (x *WebsocketSubscription) String() string {
 func (*WebsocketSubscription) ProtoMessage() {}
 
 func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8508,7 +8732,7 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
 func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *WebsocketSubscription) GetChannel() string {
@@ -8549,7 +8773,7 @@ type WebsocketGetSubscriptionsResponse struct {
 
 func (x *WebsocketGetSubscriptionsResponse) Reset() {
 	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+	mi := &file_rpc_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8561,7 +8785,7 @@ func (x *WebsocketGetSubscriptionsResponse) String() string {
 func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
 func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8574,7 +8798,7 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
+	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
@@ -8601,7 +8825,7 @@ type WebsocketSetProxyRequest struct {
 
 func (x *WebsocketSetProxyRequest) Reset() {
 	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
+	mi := &file_rpc_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8613,7 +8837,7 @@ func (x *WebsocketSetProxyRequest) String() string {
 func (*WebsocketSetProxyRequest) ProtoMessage() {}
 
 func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8626,7 +8850,7 @@ func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
+	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *WebsocketSetProxyRequest) GetExchange() string {
@@ -8653,7 +8877,7 @@ type WebsocketSetURLRequest struct {
 
 func (x *WebsocketSetURLRequest) Reset() {
 	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+	mi := &file_rpc_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8665,7 +8889,7 @@ func (x *WebsocketSetURLRequest) String() string {
 func (*WebsocketSetURLRequest) ProtoMessage() {}
 
 func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8678,7 +8902,7 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
+	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *WebsocketSetURLRequest) GetExchange() string {
@@ -8709,7 +8933,7 @@ type FindMissingCandlePeriodsRequest struct {
 
 func (x *FindMissingCandlePeriodsRequest) Reset() {
 	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+	mi := &file_rpc_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8721,7 +8945,7 @@ func (x *FindMissingCandlePeriodsRequest) String() string {
 func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
 func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+	mi := &file_rpc_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8734,7 +8958,7 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
 func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
+	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
@@ -8792,7 +9016,7 @@ type FindMissingTradePeriodsRequest struct {
 
 func (x *FindMissingTradePeriodsRequest) Reset() {
 	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
+	mi := &file_rpc_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8804,7 +9028,7 @@ func (x *FindMissingTradePeriodsRequest) String() string {
 func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
 
 func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8817,7 +9041,7 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
 func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
@@ -8868,7 +9092,7 @@ type FindMissingIntervalsResponse struct {
 
 func (x *FindMissingIntervalsResponse) Reset() {
 	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+	mi := &file_rpc_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8880,7 +9104,7 @@ func (x *FindMissingIntervalsResponse) String() string {
 func (*FindMissingIntervalsResponse) ProtoMessage() {}
 
 func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8893,7 +9117,7 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
 func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
+	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *FindMissingIntervalsResponse) GetExchangeName() string {
@@ -8941,7 +9165,7 @@ type SetExchangeTradeProcessingRequest struct {
 
 func (x *SetExchangeTradeProcessingRequest) Reset() {
 	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+	mi := &file_rpc_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8953,7 +9177,7 @@ func (x *SetExchangeTradeProcessingRequest) String() string {
 func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
 func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8966,7 +9190,7 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
+	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
@@ -9010,7 +9234,7 @@ type UpsertDataHistoryJobRequest struct {
 
 func (x *UpsertDataHistoryJobRequest) Reset() {
 	*x = UpsertDataHistoryJobRequest{}
-	mi := &file_rpc_proto_msgTypes[150]
+	mi := &file_rpc_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9022,7 +9246,7 @@ func (x *UpsertDataHistoryJobRequest) String() string {
 func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
 
 func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[150]
+	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9035,7 +9259,7 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
 func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{150}
+	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *UpsertDataHistoryJobRequest) GetNickname() string {
@@ -9180,7 +9404,7 @@ type InsertSequentialJobsRequest struct {
 
 func (x *InsertSequentialJobsRequest) Reset() {
 	*x = InsertSequentialJobsRequest{}
-	mi := &file_rpc_proto_msgTypes[151]
+	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9192,7 +9416,7 @@ func (x *InsertSequentialJobsRequest) String() string {
 func (*InsertSequentialJobsRequest) ProtoMessage() {}
 
 func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[151]
+	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9205,7 +9429,7 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
 func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151}
+	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
@@ -9224,7 +9448,7 @@ type InsertSequentialJobsResponse struct {
 
 func (x *InsertSequentialJobsResponse) Reset() {
 	*x = InsertSequentialJobsResponse{}
-	mi := &file_rpc_proto_msgTypes[152]
+	mi := &file_rpc_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9236,7 +9460,7 @@ func (x *InsertSequentialJobsResponse) String() string {
 func (*InsertSequentialJobsResponse) ProtoMessage() {}
 
 func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[152]
+	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9249,7 +9473,7 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
 func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{152}
+	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
@@ -9269,7 +9493,7 @@ type UpsertDataHistoryJobResponse struct {
 
 func (x *UpsertDataHistoryJobResponse) Reset() {
 	*x = UpsertDataHistoryJobResponse{}
-	mi := &file_rpc_proto_msgTypes[153]
+	mi := &file_rpc_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9281,7 +9505,7 @@ func (x *UpsertDataHistoryJobResponse) String() string {
 func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
 
 func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[153]
+	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9294,7 +9518,7 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
 func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{153}
+	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *UpsertDataHistoryJobResponse) GetMessage() string {
@@ -9322,7 +9546,7 @@ type GetDataHistoryJobDetailsRequest struct {
 
 func (x *GetDataHistoryJobDetailsRequest) Reset() {
 	*x = GetDataHistoryJobDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[154]
+	mi := &file_rpc_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9334,7 +9558,7 @@ func (x *GetDataHistoryJobDetailsRequest) String() string {
 func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
 
 func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[154]
+	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9347,7 +9571,7 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{154}
+	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *GetDataHistoryJobDetailsRequest) GetId() string {
@@ -9401,7 +9625,7 @@ type DataHistoryJob struct {
 
 func (x *DataHistoryJob) Reset() {
 	*x = DataHistoryJob{}
-	mi := &file_rpc_proto_msgTypes[155]
+	mi := &file_rpc_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9413,7 +9637,7 @@ func (x *DataHistoryJob) String() string {
 func (*DataHistoryJob) ProtoMessage() {}
 
 func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[155]
+	mi := &file_rpc_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9426,7 +9650,7 @@ func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
 func (*DataHistoryJob) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{155}
+	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *DataHistoryJob) GetId() string {
@@ -9596,7 +9820,7 @@ type DataHistoryJobResult struct {
 
 func (x *DataHistoryJobResult) Reset() {
 	*x = DataHistoryJobResult{}
-	mi := &file_rpc_proto_msgTypes[156]
+	mi := &file_rpc_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9608,7 +9832,7 @@ func (x *DataHistoryJobResult) String() string {
 func (*DataHistoryJobResult) ProtoMessage() {}
 
 func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[156]
+	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9621,7 +9845,7 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
 func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{156}
+	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *DataHistoryJobResult) GetStartDate() string {
@@ -9668,7 +9892,7 @@ type DataHistoryJobs struct {
 
 func (x *DataHistoryJobs) Reset() {
 	*x = DataHistoryJobs{}
-	mi := &file_rpc_proto_msgTypes[157]
+	mi := &file_rpc_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9680,7 +9904,7 @@ func (x *DataHistoryJobs) String() string {
 func (*DataHistoryJobs) ProtoMessage() {}
 
 func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[157]
+	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9693,7 +9917,7 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
 func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{157}
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
@@ -9713,7 +9937,7 @@ type GetDataHistoryJobsBetweenRequest struct {
 
 func (x *GetDataHistoryJobsBetweenRequest) Reset() {
 	*x = GetDataHistoryJobsBetweenRequest{}
-	mi := &file_rpc_proto_msgTypes[158]
+	mi := &file_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9725,7 +9949,7 @@ func (x *GetDataHistoryJobsBetweenRequest) String() string {
 func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
 
 func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9738,7 +9962,7 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
 func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
+	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
@@ -9766,7 +9990,7 @@ type SetDataHistoryJobStatusRequest struct {
 
 func (x *SetDataHistoryJobStatusRequest) Reset() {
 	*x = SetDataHistoryJobStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[159]
+	mi := &file_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9778,7 +10002,7 @@ func (x *SetDataHistoryJobStatusRequest) String() string {
 func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
 
 func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9791,7 +10015,7 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
 func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{159}
+	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *SetDataHistoryJobStatusRequest) GetId() string {
@@ -9825,7 +10049,7 @@ type UpdateDataHistoryJobPrerequisiteRequest struct {
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
 	*x = UpdateDataHistoryJobPrerequisiteRequest{}
-	mi := &file_rpc_proto_msgTypes[160]
+	mi := &file_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9837,7 +10061,7 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
 func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+	mi := &file_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9850,7 +10074,7 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{160}
+	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
@@ -9881,7 +10105,7 @@ type ModifyOrderRequest struct {
 
 func (x *ModifyOrderRequest) Reset() {
 	*x = ModifyOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[161]
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9893,7 +10117,7 @@ func (x *ModifyOrderRequest) String() string {
 func (*ModifyOrderRequest) ProtoMessage() {}
 
 func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+	mi := &file_rpc_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9906,7 +10130,7 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
 func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{161}
+	return file_rpc_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *ModifyOrderRequest) GetExchange() string {
@@ -9960,7 +10184,7 @@ type ModifyOrderResponse struct {
 
 func (x *ModifyOrderResponse) Reset() {
 	*x = ModifyOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[162]
+	mi := &file_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9972,7 +10196,7 @@ func (x *ModifyOrderResponse) String() string {
 func (*ModifyOrderResponse) ProtoMessage() {}
 
 func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[162]
+	mi := &file_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9985,7 +10209,7 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
 func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{162}
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *ModifyOrderResponse) GetModifiedOrderId() string {
@@ -10004,7 +10228,7 @@ type CurrencyStateGetAllRequest struct {
 
 func (x *CurrencyStateGetAllRequest) Reset() {
 	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
+	mi := &file_rpc_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10016,7 +10240,7 @@ func (x *CurrencyStateGetAllRequest) String() string {
 func (*CurrencyStateGetAllRequest) ProtoMessage() {}
 
 func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+	mi := &file_rpc_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10029,7 +10253,7 @@ func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+	return file_rpc_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *CurrencyStateGetAllRequest) GetExchange() string {
@@ -10050,7 +10274,7 @@ type CurrencyStateTradingRequest struct {
 
 func (x *CurrencyStateTradingRequest) Reset() {
 	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+	mi := &file_rpc_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10062,7 +10286,7 @@ func (x *CurrencyStateTradingRequest) String() string {
 func (*CurrencyStateTradingRequest) ProtoMessage() {}
 
 func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+	mi := &file_rpc_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10075,7 +10299,7 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+	return file_rpc_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *CurrencyStateTradingRequest) GetExchange() string {
@@ -10110,7 +10334,7 @@ type CurrencyStateTradingPairRequest struct {
 
 func (x *CurrencyStateTradingPairRequest) Reset() {
 	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+	mi := &file_rpc_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10122,7 +10346,7 @@ func (x *CurrencyStateTradingPairRequest) String() string {
 func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
 
 func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+	mi := &file_rpc_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10135,7 +10359,7 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+	return file_rpc_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *CurrencyStateTradingPairRequest) GetExchange() string {
@@ -10170,7 +10394,7 @@ type CurrencyStateWithdrawRequest struct {
 
 func (x *CurrencyStateWithdrawRequest) Reset() {
 	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+	mi := &file_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10182,7 +10406,7 @@ func (x *CurrencyStateWithdrawRequest) String() string {
 func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
 
 func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+	mi := &file_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10195,7 +10419,7 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+	return file_rpc_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *CurrencyStateWithdrawRequest) GetExchange() string {
@@ -10230,7 +10454,7 @@ type CurrencyStateDepositRequest struct {
 
 func (x *CurrencyStateDepositRequest) Reset() {
 	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
+	mi := &file_rpc_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10242,7 +10466,7 @@ func (x *CurrencyStateDepositRequest) String() string {
 func (*CurrencyStateDepositRequest) ProtoMessage() {}
 
 func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+	mi := &file_rpc_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10255,7 +10479,7 @@ func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+	return file_rpc_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *CurrencyStateDepositRequest) GetExchange() string {
@@ -10288,7 +10512,7 @@ type CurrencyStateResponse struct {
 
 func (x *CurrencyStateResponse) Reset() {
 	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
+	mi := &file_rpc_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10300,7 +10524,7 @@ func (x *CurrencyStateResponse) String() string {
 func (*CurrencyStateResponse) ProtoMessage() {}
 
 func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+	mi := &file_rpc_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10313,7 +10537,7 @@ func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
 func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+	return file_rpc_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
@@ -10336,7 +10560,7 @@ type CurrencyState struct {
 
 func (x *CurrencyState) Reset() {
 	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
+	mi := &file_rpc_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10348,7 +10572,7 @@ func (x *CurrencyState) String() string {
 func (*CurrencyState) ProtoMessage() {}
 
 func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+	mi := &file_rpc_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10361,7 +10585,7 @@ func (x *CurrencyState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
 func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+	return file_rpc_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *CurrencyState) GetCurrency() string {
@@ -10410,7 +10634,7 @@ type FundingRate struct {
 
 func (x *FundingRate) Reset() {
 	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+	mi := &file_rpc_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10422,7 +10646,7 @@ func (x *FundingRate) String() string {
 func (*FundingRate) ProtoMessage() {}
 
 func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+	mi := &file_rpc_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10435,7 +10659,7 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
 func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
+	return file_rpc_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *FundingRate) GetDate() string {
@@ -10479,7 +10703,7 @@ type FundingData struct {
 
 func (x *FundingData) Reset() {
 	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+	mi := &file_rpc_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10491,7 +10715,7 @@ func (x *FundingData) String() string {
 func (*FundingData) ProtoMessage() {}
 
 func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+	mi := &file_rpc_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10504,7 +10728,7 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
 func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
+	return file_rpc_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *FundingData) GetExchange() string {
@@ -10626,7 +10850,7 @@ type FuturesPositionStats struct {
 
 func (x *FuturesPositionStats) Reset() {
 	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+	mi := &file_rpc_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10638,7 +10862,7 @@ func (x *FuturesPositionStats) String() string {
 func (*FuturesPositionStats) ProtoMessage() {}
 
 func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+	mi := &file_rpc_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10651,7 +10875,7 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
 func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
+	return file_rpc_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
@@ -10864,13 +11088,14 @@ type FuturePosition struct {
 	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
 	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
 	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	Account                string                 `protobuf:"bytes,20,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *FuturePosition) Reset() {
 	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
+	mi := &file_rpc_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10882,7 +11107,7 @@ func (x *FuturePosition) String() string {
 func (*FuturePosition) ProtoMessage() {}
 
 func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+	mi := &file_rpc_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10895,7 +11120,7 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
 func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+	return file_rpc_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *FuturePosition) GetExchange() string {
@@ -11031,6 +11256,13 @@ func (x *FuturePosition) GetFundingData() *FundingData {
 	return nil
 }
 
+func (x *FuturePosition) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type GetManagedPositionRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -11046,7 +11278,7 @@ type GetManagedPositionRequest struct {
 
 func (x *GetManagedPositionRequest) Reset() {
 	*x = GetManagedPositionRequest{}
-	mi := &file_rpc_proto_msgTypes[174]
+	mi := &file_rpc_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11058,7 +11290,7 @@ func (x *GetManagedPositionRequest) String() string {
 func (*GetManagedPositionRequest) ProtoMessage() {}
 
 func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[174]
+	mi := &file_rpc_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11071,7 +11303,7 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
 func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{174}
+	return file_rpc_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *GetManagedPositionRequest) GetExchange() string {
@@ -11135,7 +11367,7 @@ type GetAllManagedPositionsRequest struct {
 
 func (x *GetAllManagedPositionsRequest) Reset() {
 	*x = GetAllManagedPositionsRequest{}
-	mi := &file_rpc_proto_msgTypes[175]
+	mi := &file_rpc_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11147,7 +11379,7 @@ func (x *GetAllManagedPositionsRequest) String() string {
 func (*GetAllManagedPositionsRequest) ProtoMessage() {}
 
 func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[175]
+	mi := &file_rpc_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11160,7 +11392,7 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{175}
+	return file_rpc_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
@@ -11200,7 +11432,7 @@ type GetManagedPositionsResponse struct {
 
 func (x *GetManagedPositionsResponse) Reset() {
 	*x = GetManagedPositionsResponse{}
-	mi := &file_rpc_proto_msgTypes[176]
+	mi := &file_rpc_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11212,7 +11444,7 @@ func (x *GetManagedPositionsResponse) String() string {
 func (*GetManagedPositionsResponse) ProtoMessage() {}
 
 func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[176]
+	mi := &file_rpc_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11225,7 +11457,7 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
 func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{176}
+	return file_rpc_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
@@ -11247,7 +11479,7 @@ type GetFuturesPositionsSummaryRequest struct {
 
 func (x *GetFuturesPositionsSummaryRequest) Reset() {
 	*x = GetFuturesPositionsSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[177]
+	mi := &file_rpc_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11259,7 +11491,7 @@ func (x *GetFuturesPositionsSummaryRequest) String() string {
 func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
 
 func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[177]
+	mi := &file_rpc_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11272,7 +11504,7 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{177}
+	return file_rpc_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
@@ -11315,7 +11547,7 @@ type GetFuturesPositionsSummaryResponse struct {
 
 func (x *GetFuturesPositionsSummaryResponse) Reset() {
 	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+	mi := &file_rpc_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11327,7 +11559,7 @@ func (x *GetFuturesPositionsSummaryResponse) String() string {
 func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
 
 func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+	mi := &file_rpc_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11340,7 +11572,7 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+	return file_rpc_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
@@ -11387,7 +11619,7 @@ type GetFuturesPositionsOrdersRequest struct {
 
 func (x *GetFuturesPositionsOrdersRequest) Reset() {
 	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
+	mi := &file_rpc_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11399,7 +11631,7 @@ func (x *GetFuturesPositionsOrdersRequest) String() string {
 func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
 
 func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+	mi := &file_rpc_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11412,7 +11644,7 @@ func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+	return file_rpc_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
@@ -11480,7 +11712,7 @@ type GetFuturesPositionsOrdersResponse struct {
 
 func (x *GetFuturesPositionsOrdersResponse) Reset() {
 	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+	mi := &file_rpc_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11492,7 +11724,7 @@ func (x *GetFuturesPositionsOrdersResponse) String() string {
 func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
 
 func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+	mi := &file_rpc_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11505,7 +11737,7 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+	return file_rpc_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
@@ -11525,7 +11757,7 @@ type GetCollateralModeRequest struct {
 
 func (x *GetCollateralModeRequest) Reset() {
 	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
+	mi := &file_rpc_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11537,7 +11769,7 @@ func (x *GetCollateralModeRequest) String() string {
 func (*GetCollateralModeRequest) ProtoMessage() {}
 
 func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+	mi := &file_rpc_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11550,7 +11782,7 @@ func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
 func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
+	return file_rpc_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *GetCollateralModeRequest) GetExchange() string {
@@ -11578,7 +11810,7 @@ type GetCollateralModeResponse struct {
 
 func (x *GetCollateralModeResponse) Reset() {
 	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+	mi := &file_rpc_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11590,7 +11822,7 @@ func (x *GetCollateralModeResponse) String() string {
 func (*GetCollateralModeResponse) ProtoMessage() {}
 
 func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+	mi := &file_rpc_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11603,7 +11835,7 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
 func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+	return file_rpc_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *GetCollateralModeResponse) GetExchange() string {
@@ -11638,7 +11870,7 @@ type SetCollateralModeRequest struct {
 
 func (x *SetCollateralModeRequest) Reset() {
 	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
+	mi := &file_rpc_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11650,7 +11882,7 @@ func (x *SetCollateralModeRequest) String() string {
 func (*SetCollateralModeRequest) ProtoMessage() {}
 
 func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+	mi := &file_rpc_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11663,7 +11895,7 @@ func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
 func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+	return file_rpc_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *SetCollateralModeRequest) GetExchange() string {
@@ -11698,7 +11930,7 @@ type SetCollateralModeResponse struct {
 
 func (x *SetCollateralModeResponse) Reset() {
 	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
+	mi := &file_rpc_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11710,7 +11942,7 @@ func (x *SetCollateralModeResponse) String() string {
 func (*SetCollateralModeResponse) ProtoMessage() {}
 
 func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+	mi := &file_rpc_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11723,7 +11955,7 @@ func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
 func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+	return file_rpc_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *SetCollateralModeResponse) GetExchange() string {
@@ -11758,7 +11990,7 @@ type GetMarginTypeRequest struct {
 
 func (x *GetMarginTypeRequest) Reset() {
 	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+	mi := &file_rpc_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11770,7 +12002,7 @@ func (x *GetMarginTypeRequest) String() string {
 func (*GetMarginTypeRequest) ProtoMessage() {}
 
 func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+	mi := &file_rpc_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11783,7 +12015,7 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+	return file_rpc_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *GetMarginTypeRequest) GetExchange() string {
@@ -11819,7 +12051,7 @@ type GetMarginTypeResponse struct {
 
 func (x *GetMarginTypeResponse) Reset() {
 	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
+	mi := &file_rpc_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11831,7 +12063,7 @@ func (x *GetMarginTypeResponse) String() string {
 func (*GetMarginTypeResponse) ProtoMessage() {}
 
 func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+	mi := &file_rpc_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11844,7 +12076,7 @@ func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
 func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+	return file_rpc_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *GetMarginTypeResponse) GetExchange() string {
@@ -11890,7 +12122,7 @@ type ChangePositionMarginRequest struct {
 
 func (x *ChangePositionMarginRequest) Reset() {
 	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
+	mi := &file_rpc_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11902,7 +12134,7 @@ func (x *ChangePositionMarginRequest) String() string {
 func (*ChangePositionMarginRequest) ProtoMessage() {}
 
 func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+	mi := &file_rpc_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11915,7 +12147,7 @@ func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
 func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+	return file_rpc_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *ChangePositionMarginRequest) GetExchange() string {
@@ -11981,7 +12213,7 @@ type ChangePositionMarginResponse struct {
 
 func (x *ChangePositionMarginResponse) Reset() {
 	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+	mi := &file_rpc_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11993,7 +12225,7 @@ func (x *ChangePositionMarginResponse) String() string {
 func (*ChangePositionMarginResponse) ProtoMessage() {}
 
 func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+	mi := &file_rpc_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12006,7 +12238,7 @@ func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
 func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+	return file_rpc_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *ChangePositionMarginResponse) GetExchange() string {
@@ -12063,7 +12295,7 @@ type SetMarginTypeRequest struct {
 
 func (x *SetMarginTypeRequest) Reset() {
 	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+	mi := &file_rpc_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12075,7 +12307,7 @@ func (x *SetMarginTypeRequest) String() string {
 func (*SetMarginTypeRequest) ProtoMessage() {}
 
 func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+	mi := &file_rpc_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12088,7 +12320,7 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
 func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+	return file_rpc_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *SetMarginTypeRequest) GetExchange() string {
@@ -12131,7 +12363,7 @@ type SetMarginTypeResponse struct {
 
 func (x *SetMarginTypeResponse) Reset() {
 	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
+	mi := &file_rpc_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12143,7 +12375,7 @@ func (x *SetMarginTypeResponse) String() string {
 func (*SetMarginTypeResponse) ProtoMessage() {}
 
 func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+	mi := &file_rpc_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12156,7 +12388,7 @@ func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
 func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
+	return file_rpc_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *SetMarginTypeResponse) GetExchange() string {
@@ -12201,7 +12433,7 @@ type GetLeverageRequest struct {
 
 func (x *GetLeverageRequest) Reset() {
 	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+	mi := &file_rpc_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12213,7 +12445,7 @@ func (x *GetLeverageRequest) String() string {
 func (*GetLeverageRequest) ProtoMessage() {}
 
 func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+	mi := &file_rpc_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12226,7 +12458,7 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
 func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
+	return file_rpc_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *GetLeverageRequest) GetExchange() string {
@@ -12286,7 +12518,7 @@ type GetLeverageResponse struct {
 
 func (x *GetLeverageResponse) Reset() {
 	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+	mi := &file_rpc_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12298,7 +12530,7 @@ func (x *GetLeverageResponse) String() string {
 func (*GetLeverageResponse) ProtoMessage() {}
 
 func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+	mi := &file_rpc_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12311,7 +12543,7 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
 func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
+	return file_rpc_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *GetLeverageResponse) GetExchange() string {
@@ -12378,7 +12610,7 @@ type SetLeverageRequest struct {
 
 func (x *SetLeverageRequest) Reset() {
 	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+	mi := &file_rpc_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12390,7 +12622,7 @@ func (x *SetLeverageRequest) String() string {
 func (*SetLeverageRequest) ProtoMessage() {}
 
 func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+	mi := &file_rpc_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12403,7 +12635,7 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
 func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
+	return file_rpc_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *SetLeverageRequest) GetExchange() string {
@@ -12470,7 +12702,7 @@ type SetLeverageResponse struct {
 
 func (x *SetLeverageResponse) Reset() {
 	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
+	mi := &file_rpc_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12482,7 +12714,7 @@ func (x *SetLeverageResponse) String() string {
 func (*SetLeverageResponse) ProtoMessage() {}
 
 func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+	mi := &file_rpc_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12495,7 +12727,7 @@ func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
 func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
+	return file_rpc_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *SetLeverageResponse) GetExchange() string {
@@ -12560,7 +12792,7 @@ type GetCollateralRequest struct {
 
 func (x *GetCollateralRequest) Reset() {
 	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
+	mi := &file_rpc_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12572,7 +12804,7 @@ func (x *GetCollateralRequest) String() string {
 func (*GetCollateralRequest) ProtoMessage() {}
 
 func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+	mi := &file_rpc_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12585,7 +12817,7 @@ func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
 func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+	return file_rpc_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *GetCollateralRequest) GetExchange() string {
@@ -12642,7 +12874,7 @@ type GetCollateralResponse struct {
 
 func (x *GetCollateralResponse) Reset() {
 	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+	mi := &file_rpc_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12654,7 +12886,7 @@ func (x *GetCollateralResponse) String() string {
 func (*GetCollateralResponse) ProtoMessage() {}
 
 func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+	mi := &file_rpc_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12667,7 +12899,7 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
 func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
+	return file_rpc_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *GetCollateralResponse) GetSubAccount() string {
@@ -12768,7 +13000,7 @@ type CollateralForCurrency struct {
 
 func (x *CollateralForCurrency) Reset() {
 	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+	mi := &file_rpc_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12780,7 +13012,7 @@ func (x *CollateralForCurrency) String() string {
 func (*CollateralForCurrency) ProtoMessage() {}
 
 func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+	mi := &file_rpc_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12793,7 +13025,7 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
 func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+	return file_rpc_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *CollateralForCurrency) GetCurrency() string {
@@ -12902,7 +13134,7 @@ type CollateralByPosition struct {
 
 func (x *CollateralByPosition) Reset() {
 	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
+	mi := &file_rpc_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12914,7 +13146,7 @@ func (x *CollateralByPosition) String() string {
 func (*CollateralByPosition) ProtoMessage() {}
 
 func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+	mi := &file_rpc_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12927,7 +13159,7 @@ func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
 func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+	return file_rpc_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *CollateralByPosition) GetCurrency() string {
@@ -12995,7 +13227,7 @@ type CollateralUsedBreakdown struct {
 
 func (x *CollateralUsedBreakdown) Reset() {
 	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+	mi := &file_rpc_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13007,7 +13239,7 @@ func (x *CollateralUsedBreakdown) String() string {
 func (*CollateralUsedBreakdown) ProtoMessage() {}
 
 func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+	mi := &file_rpc_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13020,7 +13252,7 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
 func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+	return file_rpc_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
@@ -13096,7 +13328,7 @@ type GetFundingRatesRequest struct {
 
 func (x *GetFundingRatesRequest) Reset() {
 	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
+	mi := &file_rpc_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13108,7 +13340,7 @@ func (x *GetFundingRatesRequest) String() string {
 func (*GetFundingRatesRequest) ProtoMessage() {}
 
 func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+	mi := &file_rpc_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13121,7 +13353,7 @@ func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+	return file_rpc_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *GetFundingRatesRequest) GetExchange() string {
@@ -13196,7 +13428,7 @@ type GetFundingRatesResponse struct {
 
 func (x *GetFundingRatesResponse) Reset() {
 	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+	mi := &file_rpc_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13208,7 +13440,7 @@ func (x *GetFundingRatesResponse) String() string {
 func (*GetFundingRatesResponse) ProtoMessage() {}
 
 func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+	mi := &file_rpc_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13221,7 +13453,7 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+	return file_rpc_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *GetFundingRatesResponse) GetRates() *FundingData {
@@ -13243,7 +13475,7 @@ type GetLatestFundingRateRequest struct {
 
 func (x *GetLatestFundingRateRequest) Reset() {
 	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
+	mi := &file_rpc_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13255,7 +13487,7 @@ func (x *GetLatestFundingRateRequest) String() string {
 func (*GetLatestFundingRateRequest) ProtoMessage() {}
 
 func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+	mi := &file_rpc_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13268,7 +13500,7 @@ func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
 func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+	return file_rpc_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *GetLatestFundingRateRequest) GetExchange() string {
@@ -13308,7 +13540,7 @@ type GetLatestFundingRateResponse struct {
 
 func (x *GetLatestFundingRateResponse) Reset() {
 	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+	mi := &file_rpc_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13320,7 +13552,7 @@ func (x *GetLatestFundingRateResponse) String() string {
 func (*GetLatestFundingRateResponse) ProtoMessage() {}
 
 func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+	mi := &file_rpc_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13333,7 +13565,7 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
 func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+	return file_rpc_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
@@ -13351,7 +13583,7 @@ type ShutdownRequest struct {
 
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
+	mi := &file_rpc_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13363,7 +13595,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+	mi := &file_rpc_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13376,7 +13608,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+	return file_rpc_proto_rawDescGZIP(), []int{206}
 }
 
 type ShutdownResponse struct {
@@ -13387,7 +13619,7 @@ type ShutdownResponse struct {
 
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
+	mi := &file_rpc_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13399,7 +13631,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+	mi := &file_rpc_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13412,7 +13644,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+	return file_rpc_proto_rawDescGZIP(), []int{207}
 }
 
 type GetTechnicalAnalysisRequest struct {
@@ -13439,7 +13671,7 @@ type GetTechnicalAnalysisRequest struct {
 
 func (x *GetTechnicalAnalysisRequest) Reset() {
 	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+	mi := &file_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13451,7 +13683,7 @@ func (x *GetTechnicalAnalysisRequest) String() string {
 func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
 
 func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+	mi := &file_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13464,7 +13696,7 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
 func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+	return file_rpc_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *GetTechnicalAnalysisRequest) GetExchange() string {
@@ -13588,7 +13820,7 @@ type ListOfSignals struct {
 
 func (x *ListOfSignals) Reset() {
 	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
+	mi := &file_rpc_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13600,7 +13832,7 @@ func (x *ListOfSignals) String() string {
 func (*ListOfSignals) ProtoMessage() {}
 
 func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+	mi := &file_rpc_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13613,7 +13845,7 @@ func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
 func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+	return file_rpc_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *ListOfSignals) GetSignals() []float64 {
@@ -13632,7 +13864,7 @@ type GetTechnicalAnalysisResponse struct {
 
 func (x *GetTechnicalAnalysisResponse) Reset() {
 	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
+	mi := &file_rpc_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13644,7 +13876,7 @@ func (x *GetTechnicalAnalysisResponse) String() string {
 func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
 
 func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+	mi := &file_rpc_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13657,7 +13889,7 @@ func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
 func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+	return file_rpc_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
@@ -13688,7 +13920,7 @@ type GetMarginRatesHistoryRequest struct {
 
 func (x *GetMarginRatesHistoryRequest) Reset() {
 	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+	mi := &file_rpc_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13700,7 +13932,7 @@ func (x *GetMarginRatesHistoryRequest) String() string {
 func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
 func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+	mi := &file_rpc_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13713,7 +13945,7 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+	return file_rpc_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *GetMarginRatesHistoryRequest) GetExchange() string {
@@ -13817,7 +14049,7 @@ type LendingPayment struct {
 
 func (x *LendingPayment) Reset() {
 	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+	mi := &file_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13829,7 +14061,7 @@ func (x *LendingPayment) String() string {
 func (*LendingPayment) ProtoMessage() {}
 
 func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+	mi := &file_rpc_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13842,7 +14074,7 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
 func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+	return file_rpc_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *LendingPayment) GetPayment() string {
@@ -13869,7 +14101,7 @@ type BorrowCost struct {
 
 func (x *BorrowCost) Reset() {
 	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+	mi := &file_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13881,7 +14113,7 @@ func (x *BorrowCost) String() string {
 func (*BorrowCost) ProtoMessage() {}
 
 func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+	mi := &file_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13894,7 +14126,7 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
 func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
+	return file_rpc_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *BorrowCost) GetCost() string {
@@ -13927,7 +14159,7 @@ type MarginRate struct {
 
 func (x *MarginRate) Reset() {
 	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+	mi := &file_rpc_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13939,7 +14171,7 @@ func (x *MarginRate) String() string {
 func (*MarginRate) ProtoMessage() {}
 
 func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+	mi := &file_rpc_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13952,7 +14184,7 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
 func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+	return file_rpc_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *MarginRate) GetTime() string {
@@ -14028,7 +14260,7 @@ type GetMarginRatesHistoryResponse struct {
 
 func (x *GetMarginRatesHistoryResponse) Reset() {
 	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
+	mi := &file_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14040,7 +14272,7 @@ func (x *GetMarginRatesHistoryResponse) String() string {
 func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
 func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+	mi := &file_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14053,7 +14285,7 @@ func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+	return file_rpc_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
@@ -14134,7 +14366,7 @@ type GetOrderbookMovementRequest struct {
 
 func (x *GetOrderbookMovementRequest) Reset() {
 	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
+	mi := &file_rpc_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14146,7 +14378,7 @@ func (x *GetOrderbookMovementRequest) String() string {
 func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
 func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+	mi := &file_rpc_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14159,7 +14391,7 @@ func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+	return file_rpc_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *GetOrderbookMovementRequest) GetExchange() string {
@@ -14233,7 +14465,7 @@ type GetOrderbookMovementResponse struct {
 
 func (x *GetOrderbookMovementResponse) Reset() {
 	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+	mi := &file_rpc_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14245,7 +14477,7 @@ func (x *GetOrderbookMovementResponse) String() string {
 func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
 func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+	mi := &file_rpc_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14258,7 +14490,7 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+	return file_rpc_proto_rawDescGZIP(), []int{217}
 }
 
 func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
@@ -14373,7 +14605,7 @@ type GetOrderbookAmountByNominalRequest struct {
 
 func (x *GetOrderbookAmountByNominalRequest) Reset() {
 	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+	mi := &file_rpc_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14385,7 +14617,7 @@ func (x *GetOrderbookAmountByNominalRequest) String() string {
 func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+	mi := &file_rpc_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14398,7 +14630,7 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+	return file_rpc_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
@@ -14462,7 +14694,7 @@ type GetOrderbookAmountByNominalResponse struct {
 
 func (x *GetOrderbookAmountByNominalResponse) Reset() {
 	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+	mi := &file_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14474,7 +14706,7 @@ func (x *GetOrderbookAmountByNominalResponse) String() string {
 func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+	mi := &file_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14487,7 +14719,7 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
+	return file_rpc_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
@@ -14581,7 +14813,7 @@ type GetOrderbookAmountByImpactRequest struct {
 
 func (x *GetOrderbookAmountByImpactRequest) Reset() {
 	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+	mi := &file_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14593,7 +14825,7 @@ func (x *GetOrderbookAmountByImpactRequest) String() string {
 func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+	mi := &file_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14606,7 +14838,7 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+	return file_rpc_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
@@ -14670,7 +14902,7 @@ type GetOrderbookAmountByImpactResponse struct {
 
 func (x *GetOrderbookAmountByImpactResponse) Reset() {
 	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
+	mi := &file_rpc_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14682,7 +14914,7 @@ func (x *GetOrderbookAmountByImpactResponse) String() string {
 func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+	mi := &file_rpc_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14695,7 +14927,7 @@ func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+	return file_rpc_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
@@ -14756,48 +14988,3836 @@ func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
 
 func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
 	if x != nil {
-		return x.ApproximateImpactSlippagePercentage
+		return x.ApproximateImpactSlippagePercentage
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+	if x != nil {
+		return x.UpdateProtocol
+	}
+	return ""
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
+}
+
+type GetOpenInterestRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestRequest) ProtoMessage() {}
+
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *GetOpenInterestRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OpenInterestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenInterestDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenInterestDataRequest) ProtoMessage() {}
+
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *OpenInterestDataRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestResponse) ProtoMessage() {}
+
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OpenInterestDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenInterestDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenInterestDataResponse) ProtoMessage() {}
+
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *OpenInterestDataResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *OpenInterestDataResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetRateLimitStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStateRequest) Reset() {
+	*x = GetRateLimitStateRequest{}
+	mi := &file_rpc_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStateRequest) ProtoMessage() {}
+
+func (x *GetRateLimitStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStateRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *GetRateLimitStateRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type RateLimitQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      uint32                 `protobuf:"varint,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Account       string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used          int64                  `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining     int64                  `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Window        string                 `protobuf:"bytes,7,opt,name=window,proto3" json:"window,omitempty"`
+	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Rate          float64                `protobuf:"fixed64,10,opt,name=rate,proto3" json:"rate,omitempty"`
+	Exhausted     bool                   `protobuf:"varint,11,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitQuota) Reset() {
+	*x = RateLimitQuota{}
+	mi := &file_rpc_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitQuota) ProtoMessage() {}
+
+func (x *RateLimitQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitQuota.ProtoReflect.Descriptor instead.
+func (*RateLimitQuota) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *RateLimitQuota) GetEndpoint() uint32 {
+	if x != nil {
+		return x.Endpoint
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RateLimitQuota) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *RateLimitQuota) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *RateLimitQuota) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+func (x *RateLimitQuota) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RateLimitQuota) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimitQuota) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+type GetRateLimitStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Quotas        []*RateLimitQuota      `protobuf:"bytes,3,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStateResponse) Reset() {
+	*x = GetRateLimitStateResponse{}
+	mi := &file_rpc_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStateResponse) ProtoMessage() {}
+
+func (x *GetRateLimitStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStateResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *GetRateLimitStateResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetRateLimitStateResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetRateLimitStateResponse) GetQuotas() []*RateLimitQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+type GetExchangeHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeHealthRequest) Reset() {
+	*x = GetExchangeHealthRequest{}
+	mi := &file_rpc_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeHealthRequest) ProtoMessage() {}
+
+func (x *GetExchangeHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeHealthRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GetExchangeHealthRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type ExchangeHealth struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reasons            []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Since              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	LastChecked        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	RestRequests       int64                  `protobuf:"varint,6,opt,name=rest_requests,json=restRequests,proto3" json:"rest_requests,omitempty"`
+	RestErrors         int64                  `protobuf:"varint,7,opt,name=rest_errors,json=restErrors,proto3" json:"rest_errors,omitempty"`
+	RestErrorRate      float64                `protobuf:"fixed64,8,opt,name=rest_error_rate,json=restErrorRate,proto3" json:"rest_error_rate,omitempty"`
+	AverageLatency     string                 `protobuf:"bytes,9,opt,name=average_latency,json=averageLatency,proto3" json:"average_latency,omitempty"`
+	WebsocketEnabled   bool                   `protobuf:"varint,10,opt,name=websocket_enabled,json=websocketEnabled,proto3" json:"websocket_enabled,omitempty"`
+	WebsocketConnected bool                   `protobuf:"varint,11,opt,name=websocket_connected,json=websocketConnected,proto3" json:"websocket_connected,omitempty"`
+	OrderbookStaleness string                 `protobuf:"bytes,12,opt,name=orderbook_staleness,json=orderbookStaleness,proto3" json:"orderbook_staleness,omitempty"`
+	TimeDrift          string                 `protobuf:"bytes,13,opt,name=time_drift,json=timeDrift,proto3" json:"time_drift,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExchangeHealth) Reset() {
+	*x = ExchangeHealth{}
+	mi := &file_rpc_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeHealth) ProtoMessage() {}
+
+func (x *ExchangeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeHealth.ProtoReflect.Descriptor instead.
+func (*ExchangeHealth) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *ExchangeHealth) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ExchangeHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExchangeHealth) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ExchangeHealth) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ExchangeHealth) GetLastChecked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChecked
+	}
+	return nil
+}
+
+func (x *ExchangeHealth) GetRestRequests() int64 {
+	if x != nil {
+		return x.RestRequests
+	}
+	return 0
+}
+
+func (x *ExchangeHealth) GetRestErrors() int64 {
+	if x != nil {
+		return x.RestErrors
+	}
+	return 0
+}
+
+func (x *ExchangeHealth) GetRestErrorRate() float64 {
+	if x != nil {
+		return x.RestErrorRate
+	}
+	return 0
+}
+
+func (x *ExchangeHealth) GetAverageLatency() string {
+	if x != nil {
+		return x.AverageLatency
+	}
+	return ""
+}
+
+func (x *ExchangeHealth) GetWebsocketEnabled() bool {
+	if x != nil {
+		return x.WebsocketEnabled
+	}
+	return false
+}
+
+func (x *ExchangeHealth) GetWebsocketConnected() bool {
+	if x != nil {
+		return x.WebsocketConnected
+	}
+	return false
+}
+
+func (x *ExchangeHealth) GetOrderbookStaleness() string {
+	if x != nil {
+		return x.OrderbookStaleness
+	}
+	return ""
+}
+
+func (x *ExchangeHealth) GetTimeDrift() string {
+	if x != nil {
+		return x.TimeDrift
+	}
+	return ""
+}
+
+type GetExchangeHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchanges     []*ExchangeHealth      `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeHealthResponse) Reset() {
+	*x = GetExchangeHealthResponse{}
+	mi := &file_rpc_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeHealthResponse) ProtoMessage() {}
+
+func (x *GetExchangeHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeHealthResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *GetExchangeHealthResponse) GetExchanges() []*ExchangeHealth {
+	if x != nil {
+		return x.Exchanges
+	}
+	return nil
+}
+
+type OptionGreeks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delta         float64                `protobuf:"fixed64,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Gamma         float64                `protobuf:"fixed64,2,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Vega          float64                `protobuf:"fixed64,3,opt,name=vega,proto3" json:"vega,omitempty"`
+	Theta         float64                `protobuf:"fixed64,4,opt,name=theta,proto3" json:"theta,omitempty"`
+	Rho           float64                `protobuf:"fixed64,5,opt,name=rho,proto3" json:"rho,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionGreeks) Reset() {
+	*x = OptionGreeks{}
+	mi := &file_rpc_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionGreeks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionGreeks) ProtoMessage() {}
+
+func (x *OptionGreeks) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionGreeks.ProtoReflect.Descriptor instead.
+func (*OptionGreeks) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *OptionGreeks) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *OptionGreeks) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *OptionGreeks) GetVega() float64 {
+	if x != nil {
+		return x.Vega
+	}
+	return 0
+}
+
+func (x *OptionGreeks) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *OptionGreeks) GetRho() float64 {
+	if x != nil {
+		return x.Rho
+	}
+	return 0
+}
+
+type OptionAnalytics struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair              string                 `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Underlying        string                 `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Settlement        string                 `protobuf:"bytes,5,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	Type              string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Strike            float64                `protobuf:"fixed64,7,opt,name=strike,proto3" json:"strike,omitempty"`
+	Expiry            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Premium           float64                `protobuf:"fixed64,9,opt,name=premium,proto3" json:"premium,omitempty"`
+	Price             float64                `protobuf:"fixed64,10,opt,name=price,proto3" json:"price,omitempty"`
+	UnderlyingPrice   float64                `protobuf:"fixed64,11,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	TimeToExpiry      float64                `protobuf:"fixed64,12,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`
+	ImpliedVolatility float64                `protobuf:"fixed64,13,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"`
+	Greeks            *OptionGreeks          `protobuf:"bytes,14,opt,name=greeks,proto3" json:"greeks,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OptionAnalytics) Reset() {
+	*x = OptionAnalytics{}
+	mi := &file_rpc_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionAnalytics) ProtoMessage() {}
+
+func (x *OptionAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionAnalytics.ProtoReflect.Descriptor instead.
+func (*OptionAnalytics) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *OptionAnalytics) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetSettlement() string {
+	if x != nil {
+		return x.Settlement
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OptionAnalytics) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *OptionAnalytics) GetPremium() float64 {
+	if x != nil {
+		return x.Premium
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetTimeToExpiry() float64 {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetImpliedVolatility() float64 {
+	if x != nil {
+		return x.ImpliedVolatility
+	}
+	return 0
+}
+
+func (x *OptionAnalytics) GetGreeks() *OptionGreeks {
+	if x != nil {
+		return x.Greeks
+	}
+	return nil
+}
+
+func (x *OptionAnalytics) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetOptionAnalyticsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPrice float64                `protobuf:"fixed64,4,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	Model           string                 `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	RiskFreeRate    float64                `protobuf:"fixed64,6,opt,name=risk_free_rate,json=riskFreeRate,proto3" json:"risk_free_rate,omitempty"`
+	DividendYield   float64                `protobuf:"fixed64,7,opt,name=dividend_yield,json=dividendYield,proto3" json:"dividend_yield,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetOptionAnalyticsRequest) Reset() {
+	*x = GetOptionAnalyticsRequest{}
+	mi := &file_rpc_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetOptionAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *GetOptionAnalyticsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetOptionAnalyticsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetOptionAnalyticsRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetOptionAnalyticsRequest) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *GetOptionAnalyticsRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetOptionAnalyticsRequest) GetRiskFreeRate() float64 {
+	if x != nil {
+		return x.RiskFreeRate
+	}
+	return 0
+}
+
+func (x *GetOptionAnalyticsRequest) GetDividendYield() float64 {
+	if x != nil {
+		return x.DividendYield
+	}
+	return 0
+}
+
+type GetOptionAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analytics     *OptionAnalytics       `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionAnalyticsResponse) Reset() {
+	*x = GetOptionAnalyticsResponse{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetOptionAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetOptionAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GetOptionAnalyticsResponse) GetAnalytics() *OptionAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+type VolatilitySmilePoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Strike            float64                `protobuf:"fixed64,1,opt,name=strike,proto3" json:"strike,omitempty"`
+	Moneyness         float64                `protobuf:"fixed64,2,opt,name=moneyness,proto3" json:"moneyness,omitempty"`
+	ImpliedVolatility float64                `protobuf:"fixed64,3,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"`
+	Delta             float64                `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Type              string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Pair              string                 `protobuf:"bytes,6,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VolatilitySmilePoint) Reset() {
+	*x = VolatilitySmilePoint{}
+	mi := &file_rpc_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolatilitySmilePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolatilitySmilePoint) ProtoMessage() {}
+
+func (x *VolatilitySmilePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolatilitySmilePoint.ProtoReflect.Descriptor instead.
+func (*VolatilitySmilePoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *VolatilitySmilePoint) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *VolatilitySmilePoint) GetMoneyness() float64 {
+	if x != nil {
+		return x.Moneyness
+	}
+	return 0
+}
+
+func (x *VolatilitySmilePoint) GetImpliedVolatility() float64 {
+	if x != nil {
+		return x.ImpliedVolatility
+	}
+	return 0
+}
+
+func (x *VolatilitySmilePoint) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *VolatilitySmilePoint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VolatilitySmilePoint) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+type VolatilitySmile struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Expiry          *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	TimeToExpiry    float64                 `protobuf:"fixed64,2,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`
+	UnderlyingPrice float64                 `protobuf:"fixed64,3,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	AtmVolatility   float64                 `protobuf:"fixed64,4,opt,name=atm_volatility,json=atmVolatility,proto3" json:"atm_volatility,omitempty"`
+	Points          []*VolatilitySmilePoint `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VolatilitySmile) Reset() {
+	*x = VolatilitySmile{}
+	mi := &file_rpc_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolatilitySmile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolatilitySmile) ProtoMessage() {}
+
+func (x *VolatilitySmile) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolatilitySmile.ProtoReflect.Descriptor instead.
+func (*VolatilitySmile) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *VolatilitySmile) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *VolatilitySmile) GetTimeToExpiry() float64 {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return 0
+}
+
+func (x *VolatilitySmile) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *VolatilitySmile) GetAtmVolatility() float64 {
+	if x != nil {
+		return x.AtmVolatility
+	}
+	return 0
+}
+
+func (x *VolatilitySmile) GetPoints() []*VolatilitySmilePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetVolatilitySurfaceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Underlying       string                 `protobuf:"bytes,3,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	UnderlyingPrice  float64                `protobuf:"fixed64,4,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	Model            string                 `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	RiskFreeRate     float64                `protobuf:"fixed64,6,opt,name=risk_free_rate,json=riskFreeRate,proto3" json:"risk_free_rate,omitempty"`
+	DividendYield    float64                `protobuf:"fixed64,7,opt,name=dividend_yield,json=dividendYield,proto3" json:"dividend_yield,omitempty"`
+	IncludeContracts bool                   `protobuf:"varint,8,opt,name=include_contracts,json=includeContracts,proto3" json:"include_contracts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetVolatilitySurfaceRequest) Reset() {
+	*x = GetVolatilitySurfaceRequest{}
+	mi := &file_rpc_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolatilitySurfaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolatilitySurfaceRequest) ProtoMessage() {}
+
+func (x *GetVolatilitySurfaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolatilitySurfaceRequest.ProtoReflect.Descriptor instead.
+func (*GetVolatilitySurfaceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *GetVolatilitySurfaceRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceRequest) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceRequest) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *GetVolatilitySurfaceRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceRequest) GetRiskFreeRate() float64 {
+	if x != nil {
+		return x.RiskFreeRate
+	}
+	return 0
+}
+
+func (x *GetVolatilitySurfaceRequest) GetDividendYield() float64 {
+	if x != nil {
+		return x.DividendYield
+	}
+	return 0
+}
+
+func (x *GetVolatilitySurfaceRequest) GetIncludeContracts() bool {
+	if x != nil {
+		return x.IncludeContracts
+	}
+	return false
+}
+
+type GetVolatilitySurfaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Underlying    string                 `protobuf:"bytes,3,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Smiles        []*VolatilitySmile     `protobuf:"bytes,5,rep,name=smiles,proto3" json:"smiles,omitempty"`
+	Contracts     []*OptionAnalytics     `protobuf:"bytes,6,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolatilitySurfaceResponse) Reset() {
+	*x = GetVolatilitySurfaceResponse{}
+	mi := &file_rpc_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolatilitySurfaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolatilitySurfaceResponse) ProtoMessage() {}
+
+func (x *GetVolatilitySurfaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolatilitySurfaceResponse.ProtoReflect.Descriptor instead.
+func (*GetVolatilitySurfaceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *GetVolatilitySurfaceResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceResponse) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *GetVolatilitySurfaceResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GetVolatilitySurfaceResponse) GetSmiles() []*VolatilitySmile {
+	if x != nil {
+		return x.Smiles
+	}
+	return nil
+}
+
+func (x *GetVolatilitySurfaceResponse) GetContracts() []*OptionAnalytics {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+type CarrySnapshot struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Underlying            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	ContractType          string                 `protobuf:"bytes,5,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`
+	Expiry                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	SpotPrice             float64                `protobuf:"fixed64,7,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty"`
+	ContractPrice         float64                `protobuf:"fixed64,8,opt,name=contract_price,json=contractPrice,proto3" json:"contract_price,omitempty"`
+	Basis                 float64                `protobuf:"fixed64,9,opt,name=basis,proto3" json:"basis,omitempty"`
+	AnnualisedBasis       float64                `protobuf:"fixed64,10,opt,name=annualised_basis,json=annualisedBasis,proto3" json:"annualised_basis,omitempty"`
+	FundingRate           float64                `protobuf:"fixed64,11,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	FundingInterval       string                 `protobuf:"bytes,12,opt,name=funding_interval,json=fundingInterval,proto3" json:"funding_interval,omitempty"`
+	AnnualisedFundingRate float64                `protobuf:"fixed64,13,opt,name=annualised_funding_rate,json=annualisedFundingRate,proto3" json:"annualised_funding_rate,omitempty"`
+	Opportunity           bool                   `protobuf:"varint,14,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	Timestamp             *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CarrySnapshot) Reset() {
+	*x = CarrySnapshot{}
+	mi := &file_rpc_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarrySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrySnapshot) ProtoMessage() {}
+
+func (x *CarrySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrySnapshot.ProtoReflect.Descriptor instead.
+func (*CarrySnapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *CarrySnapshot) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CarrySnapshot) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CarrySnapshot) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *CarrySnapshot) GetUnderlying() *CurrencyPair {
+	if x != nil {
+		return x.Underlying
+	}
+	return nil
+}
+
+func (x *CarrySnapshot) GetContractType() string {
+	if x != nil {
+		return x.ContractType
+	}
+	return ""
+}
+
+func (x *CarrySnapshot) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *CarrySnapshot) GetSpotPrice() float64 {
+	if x != nil {
+		return x.SpotPrice
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetContractPrice() float64 {
+	if x != nil {
+		return x.ContractPrice
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetBasis() float64 {
+	if x != nil {
+		return x.Basis
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetAnnualisedBasis() float64 {
+	if x != nil {
+		return x.AnnualisedBasis
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetFundingRate() float64 {
+	if x != nil {
+		return x.FundingRate
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetFundingInterval() string {
+	if x != nil {
+		return x.FundingInterval
+	}
+	return ""
+}
+
+func (x *CarrySnapshot) GetAnnualisedFundingRate() float64 {
+	if x != nil {
+		return x.AnnualisedFundingRate
+	}
+	return 0
+}
+
+func (x *CarrySnapshot) GetOpportunity() bool {
+	if x != nil {
+		return x.Opportunity
+	}
+	return false
+}
+
+func (x *CarrySnapshot) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetCarrySnapshotsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Underlying        *CurrencyPair          `protobuf:"bytes,2,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	OpportunitiesOnly bool                   `protobuf:"varint,3,opt,name=opportunities_only,json=opportunitiesOnly,proto3" json:"opportunities_only,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCarrySnapshotsRequest) Reset() {
+	*x = GetCarrySnapshotsRequest{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCarrySnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarrySnapshotsRequest) ProtoMessage() {}
+
+func (x *GetCarrySnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarrySnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetCarrySnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *GetCarrySnapshotsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCarrySnapshotsRequest) GetUnderlying() *CurrencyPair {
+	if x != nil {
+		return x.Underlying
+	}
+	return nil
+}
+
+func (x *GetCarrySnapshotsRequest) GetOpportunitiesOnly() bool {
+	if x != nil {
+		return x.OpportunitiesOnly
+	}
+	return false
+}
+
+type GetCarrySnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*CarrySnapshot       `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCarrySnapshotsResponse) Reset() {
+	*x = GetCarrySnapshotsResponse{}
+	mi := &file_rpc_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCarrySnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarrySnapshotsResponse) ProtoMessage() {}
+
+func (x *GetCarrySnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarrySnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetCarrySnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *GetCarrySnapshotsResponse) GetSnapshots() []*CarrySnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type GetCarryHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Underlying    *CurrencyPair          `protobuf:"bytes,2,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCarryHistoryRequest) Reset() {
+	*x = GetCarryHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCarryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarryHistoryRequest) ProtoMessage() {}
+
+func (x *GetCarryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCarryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *GetCarryHistoryRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCarryHistoryRequest) GetUnderlying() *CurrencyPair {
+	if x != nil {
+		return x.Underlying
+	}
+	return nil
+}
+
+func (x *GetCarryHistoryRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetCarryHistoryRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type GetCarryHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*CarrySnapshot       `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCarryHistoryResponse) Reset() {
+	*x = GetCarryHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCarryHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarryHistoryResponse) ProtoMessage() {}
+
+func (x *GetCarryHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCarryHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *GetCarryHistoryResponse) GetSnapshots() []*CarrySnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type PositionMarginHealth struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                     string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account                      string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Asset                        string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                         *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                         string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Size                         float64                `protobuf:"fixed64,6,opt,name=size,proto3" json:"size,omitempty"`
+	MidPrice                     float64                `protobuf:"fixed64,7,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	MarginType                   string                 `protobuf:"bytes,8,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	LiquidationPrice             float64                `protobuf:"fixed64,9,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	LiquidationDistance          float64                `protobuf:"fixed64,10,opt,name=liquidation_distance,json=liquidationDistance,proto3" json:"liquidation_distance,omitempty"`
+	MarginRatio                  float64                `protobuf:"fixed64,11,opt,name=margin_ratio,json=marginRatio,proto3" json:"margin_ratio,omitempty"`
+	MaintenanceMarginRequirement float64                `protobuf:"fixed64,12,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
+	MarginBalance                float64                `protobuf:"fixed64,13,opt,name=margin_balance,json=marginBalance,proto3" json:"margin_balance,omitempty"`
+	UnrealisedPnl                float64                `protobuf:"fixed64,14,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	Level                        string                 `protobuf:"bytes,15,opt,name=level,proto3" json:"level,omitempty"`
+	Reasons                      []string               `protobuf:"bytes,16,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Reduced                      float64                `protobuf:"fixed64,17,opt,name=reduced,proto3" json:"reduced,omitempty"`
+	Timestamp                    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *PositionMarginHealth) Reset() {
+	*x = PositionMarginHealth{}
+	mi := &file_rpc_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionMarginHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionMarginHealth) ProtoMessage() {}
+
+func (x *PositionMarginHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionMarginHealth.ProtoReflect.Descriptor instead.
+func (*PositionMarginHealth) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *PositionMarginHealth) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *PositionMarginHealth) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetLiquidationPrice() float64 {
+	if x != nil {
+		return x.LiquidationPrice
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetLiquidationDistance() float64 {
+	if x != nil {
+		return x.LiquidationDistance
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetMarginRatio() float64 {
+	if x != nil {
+		return x.MarginRatio
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetMaintenanceMarginRequirement() float64 {
+	if x != nil {
+		return x.MaintenanceMarginRequirement
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetMarginBalance() float64 {
+	if x != nil {
+		return x.MarginBalance
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetUnrealisedPnl() float64 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *PositionMarginHealth) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *PositionMarginHealth) GetReduced() float64 {
+	if x != nil {
+		return x.Reduced
+	}
+	return 0
+}
+
+func (x *PositionMarginHealth) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetMarginHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginHealthRequest) Reset() {
+	*x = GetMarginHealthRequest{}
+	mi := &file_rpc_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginHealthRequest) ProtoMessage() {}
+
+func (x *GetMarginHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginHealthRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *GetMarginHealthRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type GetMarginHealthResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Positions     []*PositionMarginHealth `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginHealthResponse) Reset() {
+	*x = GetMarginHealthResponse{}
+	mi := &file_rpc_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginHealthResponse) ProtoMessage() {}
+
+func (x *GetMarginHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginHealthResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *GetMarginHealthResponse) GetPositions() []*PositionMarginHealth {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type RebalanceTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Chain         string                 `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,7,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	WithdrawalId  string                 `protobuf:"bytes,10,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceTransfer) Reset() {
+	*x = RebalanceTransfer{}
+	mi := &file_rpc_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceTransfer) ProtoMessage() {}
+
+func (x *RebalanceTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceTransfer.ProtoReflect.Descriptor instead.
+func (*RebalanceTransfer) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *RebalanceTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetAddressTag() string {
+	if x != nil {
+		return x.AddressTag
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RebalanceTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetWithdrawalId() string {
+	if x != nil {
+		return x.WithdrawalId
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RebalanceTransfer) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *RebalanceTransfer) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type GetRebalanceTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebalanceTransfersRequest) Reset() {
+	*x = GetRebalanceTransfersRequest{}
+	mi := &file_rpc_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebalanceTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalanceTransfersRequest) ProtoMessage() {}
+
+func (x *GetRebalanceTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalanceTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetRebalanceTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *GetRebalanceTransfersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetRebalanceTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*RebalanceTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebalanceTransfersResponse) Reset() {
+	*x = GetRebalanceTransfersResponse{}
+	mi := &file_rpc_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebalanceTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalanceTransfersResponse) ProtoMessage() {}
+
+func (x *GetRebalanceTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalanceTransfersResponse.ProtoReflect.Descriptor instead.
+func (*GetRebalanceTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *GetRebalanceTransfersResponse) GetTransfers() []*RebalanceTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type RebalanceTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceTransferRequest) Reset() {
+	*x = RebalanceTransferRequest{}
+	mi := &file_rpc_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceTransferRequest) ProtoMessage() {}
+
+func (x *RebalanceTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceTransferRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *RebalanceTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type QueuedWithdrawal struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange          string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount            float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address           string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag        string                 `protobuf:"bytes,6,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Chain             string                 `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
+	Description       string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Approvers         []string               `protobuf:"bytes,10,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	RequiredApprovals int64                  `protobuf:"varint,11,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	RejectedBy        string                 `protobuf:"bytes,12,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	Reason            string                 `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	WithdrawalId      string                 `protobuf:"bytes,14,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	ExchangeId        string                 `protobuf:"bytes,15,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	Error             string                 `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	Requested         *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=requested,proto3" json:"requested,omitempty"`
+	Updated           *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated,proto3" json:"updated,omitempty"`
+	ExecuteAfter      *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=execute_after,json=executeAfter,proto3" json:"execute_after,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QueuedWithdrawal) Reset() {
+	*x = QueuedWithdrawal{}
+	mi := &file_rpc_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedWithdrawal) ProtoMessage() {}
+
+func (x *QueuedWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedWithdrawal.ProtoReflect.Descriptor instead.
+func (*QueuedWithdrawal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *QueuedWithdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QueuedWithdrawal) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetAddressTag() string {
+	if x != nil {
+		return x.AddressTag
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *QueuedWithdrawal) GetRequiredApprovals() int64 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *QueuedWithdrawal) GetRejectedBy() string {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetWithdrawalId() string {
+	if x != nil {
+		return x.WithdrawalId
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawal) GetRequested() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Requested
+	}
+	return nil
+}
+
+func (x *QueuedWithdrawal) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *QueuedWithdrawal) GetExecuteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return nil
+}
+
+type GetQueuedWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuedWithdrawalsRequest) Reset() {
+	*x = GetQueuedWithdrawalsRequest{}
+	mi := &file_rpc_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuedWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuedWithdrawalsRequest) ProtoMessage() {}
+
+func (x *GetQueuedWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuedWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetQueuedWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *GetQueuedWithdrawalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetQueuedWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*QueuedWithdrawal    `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuedWithdrawalsResponse) Reset() {
+	*x = GetQueuedWithdrawalsResponse{}
+	mi := &file_rpc_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuedWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuedWithdrawalsResponse) ProtoMessage() {}
+
+func (x *GetQueuedWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuedWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetQueuedWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *GetQueuedWithdrawalsResponse) GetWithdrawals() []*QueuedWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type QueuedWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedWithdrawalRequest) Reset() {
+	*x = QueuedWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedWithdrawalRequest) ProtoMessage() {}
+
+func (x *QueuedWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*QueuedWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *QueuedWithdrawalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawalRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *QueuedWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PortfolioValuationHolding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioValuationHolding) Reset() {
+	*x = PortfolioValuationHolding{}
+	mi := &file_rpc_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioValuationHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioValuationHolding) ProtoMessage() {}
+
+func (x *PortfolioValuationHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioValuationHolding.ProtoReflect.Descriptor instead.
+func (*PortfolioValuationHolding) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *PortfolioValuationHolding) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PortfolioValuationHolding) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PortfolioValuationHolding) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PortfolioValuationHolding) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PortfolioValuationHolding) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PortfolioValuationHolding) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type GetPortfolioValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioValuationRequest) Reset() {
+	*x = GetPortfolioValuationRequest{}
+	mi := &file_rpc_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioValuationRequest) ProtoMessage() {}
+
+func (x *GetPortfolioValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioValuationRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{259}
+}
+
+type PortfolioValuation struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	ReportingCurrency string                       `protobuf:"bytes,1,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Total             float64                      `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	Holdings          []*PortfolioValuationHolding `protobuf:"bytes,3,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	Timestamp         *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PortfolioValuation) Reset() {
+	*x = PortfolioValuation{}
+	mi := &file_rpc_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioValuation) ProtoMessage() {}
+
+func (x *PortfolioValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioValuation.ProtoReflect.Descriptor instead.
+func (*PortfolioValuation) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *PortfolioValuation) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *PortfolioValuation) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PortfolioValuation) GetHoldings() []*PortfolioValuationHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *PortfolioValuation) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type PortfolioValuationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioValuationHistoryRequest) Reset() {
+	*x = PortfolioValuationHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioValuationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioValuationHistoryRequest) ProtoMessage() {}
+
+func (x *PortfolioValuationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioValuationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioValuationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *PortfolioValuationHistoryRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *PortfolioValuationHistoryRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type PortfolioEquityPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioEquityPoint) Reset() {
+	*x = PortfolioEquityPoint{}
+	mi := &file_rpc_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioEquityPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioEquityPoint) ProtoMessage() {}
+
+func (x *PortfolioEquityPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioEquityPoint.ProtoReflect.Descriptor instead.
+func (*PortfolioEquityPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *PortfolioEquityPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PortfolioEquityPoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetPortfolioEquityCurveResponse struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	ReportingCurrency string                  `protobuf:"bytes,1,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Points            []*PortfolioEquityPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPortfolioEquityCurveResponse) Reset() {
+	*x = GetPortfolioEquityCurveResponse{}
+	mi := &file_rpc_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioEquityCurveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioEquityCurveResponse) ProtoMessage() {}
+
+func (x *GetPortfolioEquityCurveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioEquityCurveResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioEquityCurveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetPoints() []*PortfolioEquityPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PortfolioDailyPNL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	Close         float64                `protobuf:"fixed64,3,opt,name=close,proto3" json:"close,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,4,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	PnlPercent    float64                `protobuf:"fixed64,5,opt,name=pnl_percent,json=pnlPercent,proto3" json:"pnl_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioDailyPNL) Reset() {
+	*x = PortfolioDailyPNL{}
+	mi := &file_rpc_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioDailyPNL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioDailyPNL) ProtoMessage() {}
+
+func (x *PortfolioDailyPNL) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioDailyPNL.ProtoReflect.Descriptor instead.
+func (*PortfolioDailyPNL) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{264}
+}
+
+func (x *PortfolioDailyPNL) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PortfolioDailyPNL) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PortfolioDailyPNL) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *PortfolioDailyPNL) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+func (x *PortfolioDailyPNL) GetPnlPercent() float64 {
+	if x != nil {
+		return x.PnlPercent
+	}
+	return 0
+}
+
+type GetPortfolioDailyPNLResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReportingCurrency string                 `protobuf:"bytes,1,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Days              []*PortfolioDailyPNL   `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPortfolioDailyPNLResponse) Reset() {
+	*x = GetPortfolioDailyPNLResponse{}
+	mi := &file_rpc_proto_msgTypes[265]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioDailyPNLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioDailyPNLResponse) ProtoMessage() {}
+
+func (x *GetPortfolioDailyPNLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[265]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioDailyPNLResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioDailyPNLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{265}
+}
+
+func (x *GetPortfolioDailyPNLResponse) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetPortfolioDailyPNLResponse) GetDays() []*PortfolioDailyPNL {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetPortfolioAttributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	GroupBy       string                 `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioAttributionRequest) Reset() {
+	*x = GetPortfolioAttributionRequest{}
+	mi := &file_rpc_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioAttributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioAttributionRequest) ProtoMessage() {}
+
+func (x *GetPortfolioAttributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[266]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioAttributionRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioAttributionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *GetPortfolioAttributionRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetPortfolioAttributionRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetPortfolioAttributionRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+type PortfolioAttribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	StartValue    float64                `protobuf:"fixed64,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      float64                `protobuf:"fixed64,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	Change        float64                `protobuf:"fixed64,4,opt,name=change,proto3" json:"change,omitempty"`
+	Contribution  float64                `protobuf:"fixed64,5,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioAttribution) Reset() {
+	*x = PortfolioAttribution{}
+	mi := &file_rpc_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioAttribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioAttribution) ProtoMessage() {}
+
+func (x *PortfolioAttribution) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioAttribution.ProtoReflect.Descriptor instead.
+func (*PortfolioAttribution) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *PortfolioAttribution) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PortfolioAttribution) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *PortfolioAttribution) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *PortfolioAttribution) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *PortfolioAttribution) GetContribution() float64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+type GetPortfolioAttributionResponse struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	ReportingCurrency string                  `protobuf:"bytes,1,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	GroupBy           string                  `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Attributions      []*PortfolioAttribution `protobuf:"bytes,3,rep,name=attributions,proto3" json:"attributions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPortfolioAttributionResponse) Reset() {
+	*x = GetPortfolioAttributionResponse{}
+	mi := &file_rpc_proto_msgTypes[268]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioAttributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioAttributionResponse) ProtoMessage() {}
+
+func (x *GetPortfolioAttributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[268]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioAttributionResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioAttributionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{268}
+}
+
+func (x *GetPortfolioAttributionResponse) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *GetPortfolioAttributionResponse) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetPortfolioAttributionResponse) GetAttributions() []*PortfolioAttribution {
+	if x != nil {
+		return x.Attributions
+	}
+	return nil
+}
+
+type GetTradeLedgerFillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeLedgerFillsRequest) Reset() {
+	*x = GetTradeLedgerFillsRequest{}
+	mi := &file_rpc_proto_msgTypes[269]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeLedgerFillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeLedgerFillsRequest) ProtoMessage() {}
+
+func (x *GetTradeLedgerFillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[269]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeLedgerFillsRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeLedgerFillsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{269}
+}
+
+func (x *GetTradeLedgerFillsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetTradeLedgerFillsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type TradeLedgerFill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TradeId       string                 `protobuf:"bytes,4,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Pair          string                 `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           float64                `protobuf:"fixed64,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeCurrency   string                 `protobuf:"bytes,10,opt,name=fee_currency,json=feeCurrency,proto3" json:"fee_currency,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeLedgerFill) Reset() {
+	*x = TradeLedgerFill{}
+	mi := &file_rpc_proto_msgTypes[270]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeLedgerFill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeLedgerFill) ProtoMessage() {}
+
+func (x *TradeLedgerFill) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[270]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeLedgerFill.ProtoReflect.Descriptor instead.
+func (*TradeLedgerFill) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{270}
+}
+
+func (x *TradeLedgerFill) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TradeLedgerFill) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TradeLedgerFill) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TradeLedgerFill) GetFeeCurrency() string {
+	if x != nil {
+		return x.FeeCurrency
+	}
+	return ""
+}
+
+func (x *TradeLedgerFill) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetTradeLedgerFillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fills         []*TradeLedgerFill     `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeLedgerFillsResponse) Reset() {
+	*x = GetTradeLedgerFillsResponse{}
+	mi := &file_rpc_proto_msgTypes[271]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeLedgerFillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeLedgerFillsResponse) ProtoMessage() {}
+
+func (x *GetTradeLedgerFillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[271]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeLedgerFillsResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeLedgerFillsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{271}
+}
+
+func (x *GetTradeLedgerFillsResponse) GetFills() []*TradeLedgerFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+type GetTradeLedgerLotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeLedgerLotsRequest) Reset() {
+	*x = GetTradeLedgerLotsRequest{}
+	mi := &file_rpc_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeLedgerLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeLedgerLotsRequest) ProtoMessage() {}
+
+func (x *GetTradeLedgerLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[272]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeLedgerLotsRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeLedgerLotsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{272}
+}
+
+type TradeLedgerLot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Exchange       string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId        string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Acquired       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Remaining      float64                `protobuf:"fixed64,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	UnitCost       float64                `protobuf:"fixed64,7,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	CostBasis      float64                `protobuf:"fixed64,8,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	Price          float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	Value          float64                `protobuf:"fixed64,10,opt,name=value,proto3" json:"value,omitempty"`
+	UnrealisedGain float64                `protobuf:"fixed64,11,opt,name=unrealised_gain,json=unrealisedGain,proto3" json:"unrealised_gain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TradeLedgerLot) Reset() {
+	*x = TradeLedgerLot{}
+	mi := &file_rpc_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeLedgerLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeLedgerLot) ProtoMessage() {}
+
+func (x *TradeLedgerLot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[273]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeLedgerLot.ProtoReflect.Descriptor instead.
+func (*TradeLedgerLot) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{273}
+}
+
+func (x *TradeLedgerLot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TradeLedgerLot) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *TradeLedgerLot) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TradeLedgerLot) GetAcquired() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Acquired
+	}
+	return nil
+}
+
+func (x *TradeLedgerLot) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TradeLedgerLot) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *TradeLedgerLot) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *TradeLedgerLot) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *TradeLedgerLot) GetPrice() float64 {
+	if x != nil {
+		return x.Price
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+func (x *TradeLedgerLot) GetValue() float64 {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+func (x *TradeLedgerLot) GetUnrealisedGain() float64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.UnrealisedGain
 	}
-	return false
+	return 0
 }
 
-type GetOpenInterestRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTradeLedgerLotsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReportingCurrency   string                 `protobuf:"bytes,1,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Method              string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Lots                []*TradeLedgerLot      `protobuf:"bytes,3,rep,name=lots,proto3" json:"lots,omitempty"`
+	TotalCostBasis      float64                `protobuf:"fixed64,4,opt,name=total_cost_basis,json=totalCostBasis,proto3" json:"total_cost_basis,omitempty"`
+	TotalValue          float64                `protobuf:"fixed64,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	TotalUnrealisedGain float64                `protobuf:"fixed64,6,opt,name=total_unrealised_gain,json=totalUnrealisedGain,proto3" json:"total_unrealised_gain,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *GetTradeLedgerLotsResponse) Reset() {
+	*x = GetTradeLedgerLotsResponse{}
+	mi := &file_rpc_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *GetTradeLedgerLotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*GetTradeLedgerLotsResponse) ProtoMessage() {}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *GetTradeLedgerLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14808,99 +18828,75 @@ func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
+// Deprecated: Use GetTradeLedgerLotsResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeLedgerLotsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{274}
 }
 
-func (x *GetOpenInterestRequest) GetExchange() string {
+func (x *GetTradeLedgerLotsResponse) GetReportingCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.ReportingCurrency
 	}
 	return ""
 }
 
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+func (x *GetTradeLedgerLotsResponse) GetMethod() string {
 	if x != nil {
-		return x.Data
+		return x.Method
 	}
-	return nil
-}
-
-type OpenInterestDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OpenInterestDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
-
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *GetTradeLedgerLotsResponse) GetLots() []*TradeLedgerLot {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Lots
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
+func (x *GetTradeLedgerLotsResponse) GetTotalCostBasis() float64 {
+	if x != nil {
+		return x.TotalCostBasis
+	}
+	return 0
 }
 
-func (x *OpenInterestDataRequest) GetAsset() string {
+func (x *GetTradeLedgerLotsResponse) GetTotalValue() float64 {
 	if x != nil {
-		return x.Asset
+		return x.TotalValue
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *GetTradeLedgerLotsResponse) GetTotalUnrealisedGain() float64 {
 	if x != nil {
-		return x.Pair
+		return x.TotalUnrealisedGain
 	}
-	return nil
+	return 0
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type GetTaxReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int64                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *GetTaxReportRequest) Reset() {
+	*x = GetTaxReportRequest{}
+	mi := &file_rpc_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestResponse) String() string {
+func (x *GetTaxReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
+func (*GetTaxReportRequest) ProtoMessage() {}
 
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *GetTaxReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14911,43 +18907,49 @@ func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+// Deprecated: Use GetTaxReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxReportRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{275}
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *GetTaxReportRequest) GetYear() int64 {
 	if x != nil {
-		return x.Data
+		return x.Year
 	}
-	return nil
+	return 0
 }
 
-type OpenInterestDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTaxReportResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Year              int64                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Method            string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,3,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Start             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Disposals         int64                  `protobuf:"varint,6,opt,name=disposals,proto3" json:"disposals,omitempty"`
+	Proceeds          float64                `protobuf:"fixed64,7,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	CostBasis         float64                `protobuf:"fixed64,8,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	Gain              float64                `protobuf:"fixed64,9,opt,name=gain,proto3" json:"gain,omitempty"`
+	Csv               string                 `protobuf:"bytes,10,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetTaxReportResponse) Reset() {
+	*x = GetTaxReportResponse{}
+	mi := &file_rpc_proto_msgTypes[276]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *GetTaxReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*GetTaxReportResponse) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetTaxReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[276]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,139 +18960,77 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
+// Deprecated: Use GetTaxReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxReportResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{276}
 }
 
-func (x *OpenInterestDataResponse) GetExchange() string {
+func (x *GetTaxReportResponse) GetYear() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataResponse) GetAsset() string {
+func (x *GetTaxReportResponse) GetMethod() string {
 	if x != nil {
-		return x.Asset
+		return x.Method
 	}
 	return ""
 }
 
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+func (x *GetTaxReportResponse) GetReportingCurrency() string {
 	if x != nil {
-		return x.Pair
+		return x.ReportingCurrency
 	}
-	return nil
+	return ""
 }
 
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *GetTaxReportResponse) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OpenInterest
+		return x.Start
 	}
-	return 0
-}
-
-type GetCurrencyTradeURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCurrencyTradeURLRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
-
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *GetTaxReportResponse) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
+	return nil
 }
 
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+func (x *GetTaxReportResponse) GetDisposals() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.Disposals
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+func (x *GetTaxReportResponse) GetProceeds() float64 {
 	if x != nil {
-		return x.Asset
+		return x.Proceeds
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+func (x *GetTaxReportResponse) GetCostBasis() float64 {
 	if x != nil {
-		return x.Pair
+		return x.CostBasis
 	}
-	return nil
-}
-
-type GetCurrencyTradeURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCurrencyTradeURLResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
-
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetTaxReportResponse) GetGain() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Gain
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+	return 0
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *GetTaxReportResponse) GetCsv() string {
 	if x != nil {
-		return x.Url
+		return x.Csv
 	}
 	return ""
 }
@@ -15291,20 +19231,31 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"CoinsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\xcb\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\x8e\x05\n" +
 	"\x1bGetPortfolioSummaryResponse\x12-\n" +
 	"\vcoin_totals\x18\x01 \x03(\v2\f.gctrpc.CoinR\n" +
 	"coinTotals\x121\n" +
 	"\rcoins_offline\x18\x02 \x03(\v2\f.gctrpc.CoinR\fcoinsOffline\x12p\n" +
 	"\x15coins_offline_summary\x18\x03 \x03(\v2<.gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntryR\x13coinsOfflineSummary\x12/\n" +
 	"\fcoins_online\x18\x04 \x03(\v2\f.gctrpc.CoinR\vcoinsOnline\x12m\n" +
-	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x1a\\\n" +
+	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x12A\n" +
+	"\x10options_exposure\x18\x06 \x03(\v2\x16.gctrpc.OptionExposureR\x0foptionsExposure\x1a\\\n" +
 	"\x18CoinsOfflineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
 	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
 	"\x17CoinsOnlineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x0eOptionExposure\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tR\n" +
+	"underlying\x12\x1c\n" +
+	"\tpositions\x18\x02 \x01(\x03R\tpositions\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\x05 \x01(\x01R\x05gamma\x12\x12\n" +
+	"\x04vega\x18\x06 \x01(\x01R\x04vega\x12\x14\n" +
+	"\x05theta\x18\a \x01(\x01R\x05theta\"\xe3\x01\n" +
 	"\x1aAddPortfolioAddressRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
 	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
@@ -15335,7 +19286,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\finverse_rate\x18\x04 \x01(\x01R\vinverseRate\"V\n" +
 	"\x15GetForexRatesResponse\x12=\n" +
 	"\vforex_rates\x18\x01 \x03(\v2\x1c.gctrpc.ForexRatesConversionR\n" +
-	"forexRates\"\xb5\x04\n" +
+	"forexRates\"\xcf\x04\n" +
 	"\fOrderDetails\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
@@ -15360,7 +19311,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12\x12\n" +
 	"\x04cost\x18\x10 \x01(\x01R\x04cost\x12,\n" +
 	"\x06trades\x18\x11 \x03(\v2\x14.gctrpc.TradeHistoryR\x06trades\x12'\n" +
-	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\"\xf3\x01\n" +
+	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\x12\x18\n" +
+	"\aaccount\x18\x13 \x01(\tR\aaccount\"\xf3\x01\n" +
 	"\fTradeHistory\x12#\n" +
 	"\rcreation_time\x18\x01 \x01(\x03R\fcreationTime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
@@ -15704,9 +19656,26 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\x12\x19\n" +
-	"\bnext_run\x18\x04 \x01(\tR\anextRun\"D\n" +
+	"\bnext_run\x18\x04 \x01(\tR\anextRun\"\xfe\x02\n" +
+	"\x13GCTScriptSimulation\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12#\n" +
+	"\rtime_interval\x18\x04 \x01(\x03R\ftimeInterval\x12\x14\n" +
+	"\x05start\x18\x05 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x06 \x01(\tR\x03end\x12\x1b\n" +
+	"\tdata_file\x18\a \x01(\tR\bdataFile\x12E\n" +
+	"\bbalances\x18\b \x03(\v2).gctrpc.GCTScriptSimulation.BalancesEntryR\bbalances\x12\x14\n" +
+	"\x05trace\x18\t \x01(\bR\x05trace\x1a;\n" +
+	"\rBalancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x81\x01\n" +
 	"\x17GCTScriptExecuteRequest\x12)\n" +
-	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\"A\n" +
+	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\x12;\n" +
+	"\n" +
+	"simulation\x18\x02 \x01(\v2\x1b.gctrpc.GCTScriptSimulationR\n" +
+	"simulation\"A\n" +
 	"\x14GCTScriptStopRequest\x12)\n" +
 	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\"\x19\n" +
 	"\x17GCTScriptStopAllRequest\"\x18\n" +
@@ -15974,7 +19943,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\fisolated_upl\x18\x18 \x01(\tR\visolatedUpl\x12+\n" +
 	"\x11notional_leverage\x18\x19 \x01(\tR\x10notionalLeverage\x12!\n" +
 	"\ftotal_equity\x18\x1a \x01(\tR\vtotalEquity\x12'\n" +
-	"\x0fstrategy_equity\x18\x1b \x01(\tR\x0estrategyEquity\"\x84\x06\n" +
+	"\x0fstrategy_equity\x18\x1b \x01(\tR\x0estrategyEquity\"\x9e\x06\n" +
 	"\x0eFuturePosition\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
@@ -15996,7 +19965,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x18contract_settlement_type\x18\x10 \x01(\tR\x16contractSettlementType\x12,\n" +
 	"\x06orders\x18\x11 \x03(\v2\x14.gctrpc.OrderDetailsR\x06orders\x12C\n" +
 	"\x0eposition_stats\x18\x12 \x01(\v2\x1c.gctrpc.FuturesPositionStatsR\rpositionStats\x126\n" +
-	"\ffunding_data\x18\x13 \x01(\v2\x13.gctrpc.FundingDataR\vfundingData\"\xd3\x02\n" +
+	"\ffunding_data\x18\x13 \x01(\v2\x13.gctrpc.FundingDataR\vfundingData\x12\x18\n" +
+	"\aaccount\x18\x14 \x01(\tR\aaccount\"\xd3\x02\n" +
 	"\x19GetManagedPositionRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
@@ -16367,7 +20337,337 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xccl\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"6\n" +
+	"\x18GetRateLimitStateRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\xe0\x02\n" +
+	"\x0eRateLimitQuota\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\rR\bendpoint\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\tR\aaccount\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\x05 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x03R\tremaining\x12\x16\n" +
+	"\x06window\x18\a \x01(\tR\x06window\x125\n" +
+	"\breset_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04rate\x18\n" +
+	" \x01(\x01R\x04rate\x12\x1c\n" +
+	"\texhausted\x18\v \x01(\bR\texhausted\"\x81\x01\n" +
+	"\x19GetRateLimitStateResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12.\n" +
+	"\x06quotas\x18\x03 \x03(\v2\x16.gctrpc.RateLimitQuotaR\x06quotas\"6\n" +
+	"\x18GetExchangeHealthRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x94\x04\n" +
+	"\x0eExchangeHealth\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasons\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12=\n" +
+	"\flast_checked\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastChecked\x12#\n" +
+	"\rrest_requests\x18\x06 \x01(\x03R\frestRequests\x12\x1f\n" +
+	"\vrest_errors\x18\a \x01(\x03R\n" +
+	"restErrors\x12&\n" +
+	"\x0frest_error_rate\x18\b \x01(\x01R\rrestErrorRate\x12'\n" +
+	"\x0faverage_latency\x18\t \x01(\tR\x0eaverageLatency\x12+\n" +
+	"\x11websocket_enabled\x18\n" +
+	" \x01(\bR\x10websocketEnabled\x12/\n" +
+	"\x13websocket_connected\x18\v \x01(\bR\x12websocketConnected\x12/\n" +
+	"\x13orderbook_staleness\x18\f \x01(\tR\x12orderbookStaleness\x12\x1d\n" +
+	"\n" +
+	"time_drift\x18\r \x01(\tR\ttimeDrift\"Q\n" +
+	"\x19GetExchangeHealthResponse\x124\n" +
+	"\texchanges\x18\x01 \x03(\v2\x16.gctrpc.ExchangeHealthR\texchanges\"v\n" +
+	"\fOptionGreeks\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\x02 \x01(\x01R\x05gamma\x12\x12\n" +
+	"\x04vega\x18\x03 \x01(\x01R\x04vega\x12\x14\n" +
+	"\x05theta\x18\x04 \x01(\x01R\x05theta\x12\x10\n" +
+	"\x03rho\x18\x05 \x01(\x01R\x03rho\"\x8f\x04\n" +
+	"\x0fOptionAnalytics\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04pair\x18\x03 \x01(\tR\x04pair\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x04 \x01(\tR\n" +
+	"underlying\x12\x1e\n" +
+	"\n" +
+	"settlement\x18\x05 \x01(\tR\n" +
+	"settlement\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x16\n" +
+	"\x06strike\x18\a \x01(\x01R\x06strike\x122\n" +
+	"\x06expiry\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12\x18\n" +
+	"\apremium\x18\t \x01(\x01R\apremium\x12\x14\n" +
+	"\x05price\x18\n" +
+	" \x01(\x01R\x05price\x12)\n" +
+	"\x10underlying_price\x18\v \x01(\x01R\x0funderlyingPrice\x12$\n" +
+	"\x0etime_to_expiry\x18\f \x01(\x01R\ftimeToExpiry\x12-\n" +
+	"\x12implied_volatility\x18\r \x01(\x01R\x11impliedVolatility\x12,\n" +
+	"\x06greeks\x18\x0e \x01(\v2\x14.gctrpc.OptionGreeksR\x06greeks\x128\n" +
+	"\ttimestamp\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x85\x02\n" +
+	"\x19GetOptionAnalyticsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12)\n" +
+	"\x10underlying_price\x18\x04 \x01(\x01R\x0funderlyingPrice\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\x12$\n" +
+	"\x0erisk_free_rate\x18\x06 \x01(\x01R\friskFreeRate\x12%\n" +
+	"\x0edividend_yield\x18\a \x01(\x01R\rdividendYield\"S\n" +
+	"\x1aGetOptionAnalyticsResponse\x125\n" +
+	"\tanalytics\x18\x01 \x01(\v2\x17.gctrpc.OptionAnalyticsR\tanalytics\"\xb9\x01\n" +
+	"\x14VolatilitySmilePoint\x12\x16\n" +
+	"\x06strike\x18\x01 \x01(\x01R\x06strike\x12\x1c\n" +
+	"\tmoneyness\x18\x02 \x01(\x01R\tmoneyness\x12-\n" +
+	"\x12implied_volatility\x18\x03 \x01(\x01R\x11impliedVolatility\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x01R\x05delta\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04pair\x18\x06 \x01(\tR\x04pair\"\xf3\x01\n" +
+	"\x0fVolatilitySmile\x122\n" +
+	"\x06expiry\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12$\n" +
+	"\x0etime_to_expiry\x18\x02 \x01(\x01R\ftimeToExpiry\x12)\n" +
+	"\x10underlying_price\x18\x03 \x01(\x01R\x0funderlyingPrice\x12%\n" +
+	"\x0eatm_volatility\x18\x04 \x01(\x01R\ratmVolatility\x124\n" +
+	"\x06points\x18\x05 \x03(\v2\x1c.gctrpc.VolatilitySmilePointR\x06points\"\xaa\x02\n" +
+	"\x1bGetVolatilitySurfaceRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x03 \x01(\tR\n" +
+	"underlying\x12)\n" +
+	"\x10underlying_price\x18\x04 \x01(\x01R\x0funderlyingPrice\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\x12$\n" +
+	"\x0erisk_free_rate\x18\x06 \x01(\x01R\friskFreeRate\x12%\n" +
+	"\x0edividend_yield\x18\a \x01(\x01R\rdividendYield\x12+\n" +
+	"\x11include_contracts\x18\b \x01(\bR\x10includeContracts\"\x92\x02\n" +
+	"\x1cGetVolatilitySurfaceResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x03 \x01(\tR\n" +
+	"underlying\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12/\n" +
+	"\x06smiles\x18\x05 \x03(\v2\x17.gctrpc.VolatilitySmileR\x06smiles\x125\n" +
+	"\tcontracts\x18\x06 \x03(\v2\x17.gctrpc.OptionAnalyticsR\tcontracts\"\xe3\x04\n" +
+	"\rCarrySnapshot\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x124\n" +
+	"\n" +
+	"underlying\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\n" +
+	"underlying\x12#\n" +
+	"\rcontract_type\x18\x05 \x01(\tR\fcontractType\x122\n" +
+	"\x06expiry\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12\x1d\n" +
+	"\n" +
+	"spot_price\x18\a \x01(\x01R\tspotPrice\x12%\n" +
+	"\x0econtract_price\x18\b \x01(\x01R\rcontractPrice\x12\x14\n" +
+	"\x05basis\x18\t \x01(\x01R\x05basis\x12)\n" +
+	"\x10annualised_basis\x18\n" +
+	" \x01(\x01R\x0fannualisedBasis\x12!\n" +
+	"\ffunding_rate\x18\v \x01(\x01R\vfundingRate\x12)\n" +
+	"\x10funding_interval\x18\f \x01(\tR\x0ffundingInterval\x126\n" +
+	"\x17annualised_funding_rate\x18\r \x01(\x01R\x15annualisedFundingRate\x12 \n" +
+	"\vopportunity\x18\x0e \x01(\bR\vopportunity\x128\n" +
+	"\ttimestamp\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9b\x01\n" +
+	"\x18GetCarrySnapshotsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x124\n" +
+	"\n" +
+	"underlying\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\n" +
+	"underlying\x12-\n" +
+	"\x12opportunities_only\x18\x03 \x01(\bR\x11opportunitiesOnly\"P\n" +
+	"\x19GetCarrySnapshotsResponse\x123\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x15.gctrpc.CarrySnapshotR\tsnapshots\"\x92\x01\n" +
+	"\x16GetCarryHistoryRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x124\n" +
+	"\n" +
+	"underlying\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\n" +
+	"underlying\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"N\n" +
+	"\x17GetCarryHistoryResponse\x123\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x15.gctrpc.CarrySnapshotR\tsnapshots\"\x8d\x05\n" +
+	"\x14PositionMarginHealth\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x01R\x04size\x12\x1b\n" +
+	"\tmid_price\x18\a \x01(\x01R\bmidPrice\x12\x1f\n" +
+	"\vmargin_type\x18\b \x01(\tR\n" +
+	"marginType\x12+\n" +
+	"\x11liquidation_price\x18\t \x01(\x01R\x10liquidationPrice\x121\n" +
+	"\x14liquidation_distance\x18\n" +
+	" \x01(\x01R\x13liquidationDistance\x12!\n" +
+	"\fmargin_ratio\x18\v \x01(\x01R\vmarginRatio\x12D\n" +
+	"\x1emaintenance_margin_requirement\x18\f \x01(\x01R\x1cmaintenanceMarginRequirement\x12%\n" +
+	"\x0emargin_balance\x18\r \x01(\x01R\rmarginBalance\x12%\n" +
+	"\x0eunrealised_pnl\x18\x0e \x01(\x01R\runrealisedPnl\x12\x14\n" +
+	"\x05level\x18\x0f \x01(\tR\x05level\x12\x18\n" +
+	"\areasons\x18\x10 \x03(\tR\areasons\x12\x18\n" +
+	"\areduced\x18\x11 \x01(\x01R\areduced\x128\n" +
+	"\ttimestamp\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"4\n" +
+	"\x16GetMarginHealthRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"U\n" +
+	"\x17GetMarginHealthResponse\x12:\n" +
+	"\tpositions\x18\x01 \x03(\v2\x1c.gctrpc.PositionMarginHealthR\tpositions\"\x8b\x03\n" +
+	"\x11RebalanceTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\a \x01(\tR\n" +
+	"addressTag\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12#\n" +
+	"\rwithdrawal_id\x18\n" +
+	" \x01(\tR\fwithdrawalId\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x124\n" +
+	"\acreated\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aupdated\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\"6\n" +
+	"\x1cGetRebalanceTransfersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"X\n" +
+	"\x1dGetRebalanceTransfersResponse\x127\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x19.gctrpc.RebalanceTransferR\ttransfers\"*\n" +
+	"\x18RebalanceTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x05\n" +
+	"\x10QueuedWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\x06 \x01(\tR\n" +
+	"addressTag\x12\x14\n" +
+	"\x05chain\x18\a \x01(\tR\x05chain\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\tapprovers\x18\n" +
+	" \x03(\tR\tapprovers\x12-\n" +
+	"\x12required_approvals\x18\v \x01(\x03R\x11requiredApprovals\x12\x1f\n" +
+	"\vrejected_by\x18\f \x01(\tR\n" +
+	"rejectedBy\x12\x16\n" +
+	"\x06reason\x18\r \x01(\tR\x06reason\x12#\n" +
+	"\rwithdrawal_id\x18\x0e \x01(\tR\fwithdrawalId\x12\x1f\n" +
+	"\vexchange_id\x18\x0f \x01(\tR\n" +
+	"exchangeId\x12\x14\n" +
+	"\x05error\x18\x10 \x01(\tR\x05error\x128\n" +
+	"\trequested\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\trequested\x124\n" +
+	"\aupdated\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\x12?\n" +
+	"\rexecute_after\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\fexecuteAfter\"5\n" +
+	"\x1bGetQueuedWithdrawalsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Z\n" +
+	"\x1cGetQueuedWithdrawalsResponse\x12:\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x18.gctrpc.QueuedWithdrawalR\vwithdrawals\"]\n" +
+	"\x17QueuedWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xad\x01\n" +
+	"\x19PortfolioValuationHolding\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\"\x1e\n" +
+	"\x1cGetPortfolioValuationRequest\"\xd2\x01\n" +
+	"\x12PortfolioValuation\x12-\n" +
+	"\x12reporting_currency\x18\x01 \x01(\tR\x11reportingCurrency\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\x12=\n" +
+	"\bholdings\x18\x03 \x03(\v2!.gctrpc.PortfolioValuationHoldingR\bholdings\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"J\n" +
+	" PortfolioValuationHistoryRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"f\n" +
+	"\x14PortfolioEquityPoint\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x86\x01\n" +
+	"\x1fGetPortfolioEquityCurveResponse\x12-\n" +
+	"\x12reporting_currency\x18\x01 \x01(\tR\x11reportingCurrency\x124\n" +
+	"\x06points\x18\x02 \x03(\v2\x1c.gctrpc.PortfolioEquityPointR\x06points\"\x84\x01\n" +
+	"\x11PortfolioDailyPNL\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x14\n" +
+	"\x05close\x18\x03 \x01(\x01R\x05close\x12\x10\n" +
+	"\x03pnl\x18\x04 \x01(\x01R\x03pnl\x12\x1f\n" +
+	"\vpnl_percent\x18\x05 \x01(\x01R\n" +
+	"pnlPercent\"|\n" +
+	"\x1cGetPortfolioDailyPNLResponse\x12-\n" +
+	"\x12reporting_currency\x18\x01 \x01(\tR\x11reportingCurrency\x12-\n" +
+	"\x04days\x18\x02 \x03(\v2\x19.gctrpc.PortfolioDailyPNLR\x04days\"c\n" +
+	"\x1eGetPortfolioAttributionRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\agroupBy\"\xa2\x01\n" +
+	"\x14PortfolioAttribution\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\x01R\bendValue\x12\x16\n" +
+	"\x06change\x18\x04 \x01(\x01R\x06change\x12\"\n" +
+	"\fcontribution\x18\x05 \x01(\x01R\fcontribution\"\xad\x01\n" +
+	"\x1fGetPortfolioAttributionResponse\x12-\n" +
+	"\x12reporting_currency\x18\x01 \x01(\tR\x11reportingCurrency\x12\x19\n" +
+	"\bgroup_by\x18\x02 \x01(\tR\agroupBy\x12@\n" +
+	"\fattributions\x18\x03 \x03(\v2\x1c.gctrpc.PortfolioAttributionR\fattributions\"D\n" +
+	"\x1aGetTradeLedgerFillsRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\xc2\x02\n" +
+	"\x0fTradeLedgerFill\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x19\n" +
+	"\btrade_id\x18\x04 \x01(\tR\atradeId\x12\x12\n" +
+	"\x04pair\x18\x05 \x01(\tR\x04pair\x12\x12\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03fee\x18\t \x01(\x01R\x03fee\x12!\n" +
+	"\ffee_currency\x18\n" +
+	" \x01(\tR\vfeeCurrency\x128\n" +
+	"\ttimestamp\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"L\n" +
+	"\x1bGetTradeLedgerFillsResponse\x12-\n" +
+	"\x05fills\x18\x01 \x03(\v2\x17.gctrpc.TradeLedgerFillR\x05fills\"\x1b\n" +
+	"\x19GetTradeLedgerLotsRequest\"\xe2\x02\n" +
+	"\x0eTradeLedgerLot\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x126\n" +
+	"\bacquired\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bacquired\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x01R\tremaining\x12\x1b\n" +
+	"\tunit_cost\x18\a \x01(\x01R\bunitCost\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\b \x01(\x01R\tcostBasis\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\n" +
+	" \x01(\x01R\x05value\x12'\n" +
+	"\x0funrealised_gain\x18\v \x01(\x01R\x0eunrealisedGain\"\x8e\x02\n" +
+	"\x1aGetTradeLedgerLotsResponse\x12-\n" +
+	"\x12reporting_currency\x18\x01 \x01(\tR\x11reportingCurrency\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12*\n" +
+	"\x04lots\x18\x03 \x03(\v2\x16.gctrpc.TradeLedgerLotR\x04lots\x12(\n" +
+	"\x10total_cost_basis\x18\x04 \x01(\x01R\x0etotalCostBasis\x12\x1f\n" +
+	"\vtotal_value\x18\x05 \x01(\x01R\n" +
+	"totalValue\x122\n" +
+	"\x15total_unrealised_gain\x18\x06 \x01(\x01R\x13totalUnrealisedGain\")\n" +
+	"\x13GetTaxReportRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x03R\x04year\"\xd0\x02\n" +
+	"\x14GetTaxReportResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x03R\x04year\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12-\n" +
+	"\x12reporting_currency\x18\x03 \x01(\tR\x11reportingCurrency\x120\n" +
+	"\x05start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1c\n" +
+	"\tdisposals\x18\x06 \x01(\x03R\tdisposals\x12\x1a\n" +
+	"\bproceeds\x18\a \x01(\x01R\bproceeds\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\b \x01(\x01R\tcostBasis\x12\x12\n" +
+	"\x04gain\x18\t \x01(\x01R\x04gain\x12\x10\n" +
+	"\x03csv\x18\n" +
+	" \x01(\tR\x03csv2\xc1\x81\x01\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSubsystemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16484,7 +20784,28 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12w\n" +
+	"\x11GetRateLimitState\x12 .gctrpc.GetRateLimitStateRequest\x1a!.gctrpc.GetRateLimitStateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/getratelimitstate\x12w\n" +
+	"\x11GetExchangeHealth\x12 .gctrpc.GetExchangeHealthRequest\x1a!.gctrpc.GetExchangeHealthResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/getexchangehealth\x12{\n" +
+	"\x12GetOptionAnalytics\x12!.gctrpc.GetOptionAnalyticsRequest\x1a\".gctrpc.GetOptionAnalyticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getoptionanalytics\x12\x83\x01\n" +
+	"\x14GetVolatilitySurface\x12#.gctrpc.GetVolatilitySurfaceRequest\x1a$.gctrpc.GetVolatilitySurfaceResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getvolatilitysurface\x12w\n" +
+	"\x11GetCarrySnapshots\x12 .gctrpc.GetCarrySnapshotsRequest\x1a!.gctrpc.GetCarrySnapshotsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/getcarrysnapshots\x12\x83\x01\n" +
+	"\x16GetCarrySnapshotStream\x12 .gctrpc.GetCarrySnapshotsRequest\x1a!.gctrpc.GetCarrySnapshotsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/getcarrysnapshotstream0\x01\x12o\n" +
+	"\x0fGetCarryHistory\x12\x1e.gctrpc.GetCarryHistoryRequest\x1a\x1f.gctrpc.GetCarryHistoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getcarryhistory\x12o\n" +
+	"\x0fGetMarginHealth\x12\x1e.gctrpc.GetMarginHealthRequest\x1a\x1f.gctrpc.GetMarginHealthResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getmarginhealth\x12\x87\x01\n" +
+	"\x15GetRebalanceTransfers\x12$.gctrpc.GetRebalanceTransfersRequest\x1a%.gctrpc.GetRebalanceTransfersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getrebalancetransfers\x12\x80\x01\n" +
+	"\x18ApproveRebalanceTransfer\x12 .gctrpc.RebalanceTransferRequest\x1a\x19.gctrpc.RebalanceTransfer\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/approverebalancetransfer\x12~\n" +
+	"\x17RejectRebalanceTransfer\x12 .gctrpc.RebalanceTransferRequest\x1a\x19.gctrpc.RebalanceTransfer\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/rejectrebalancetransfer\x12\x83\x01\n" +
+	"\x14GetQueuedWithdrawals\x12#.gctrpc.GetQueuedWithdrawalsRequest\x1a$.gctrpc.GetQueuedWithdrawalsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getqueuedwithdrawals\x12|\n" +
+	"\x17ApproveQueuedWithdrawal\x12\x1f.gctrpc.QueuedWithdrawalRequest\x1a\x18.gctrpc.QueuedWithdrawal\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/approvequeuedwithdrawal\x12z\n" +
+	"\x16RejectQueuedWithdrawal\x12\x1f.gctrpc.QueuedWithdrawalRequest\x1a\x18.gctrpc.QueuedWithdrawal\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/rejectqueuedwithdrawal\x12|\n" +
+	"\x15GetPortfolioValuation\x12$.gctrpc.GetPortfolioValuationRequest\x1a\x1a.gctrpc.PortfolioValuation\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getportfoliovaluation\x12\x91\x01\n" +
+	"\x17GetPortfolioEquityCurve\x12(.gctrpc.PortfolioValuationHistoryRequest\x1a'.gctrpc.GetPortfolioEquityCurveResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getportfolioequitycurve\x12\x88\x01\n" +
+	"\x14GetPortfolioDailyPNL\x12(.gctrpc.PortfolioValuationHistoryRequest\x1a$.gctrpc.GetPortfolioDailyPNLResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getportfoliodailypnl\x12\x8f\x01\n" +
+	"\x17GetPortfolioAttribution\x12&.gctrpc.GetPortfolioAttributionRequest\x1a'.gctrpc.GetPortfolioAttributionResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getportfolioattribution\x12\x7f\n" +
+	"\x13GetTradeLedgerFills\x12\".gctrpc.GetTradeLedgerFillsRequest\x1a#.gctrpc.GetTradeLedgerFillsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/gettradeledgerfills\x12{\n" +
+	"\x12GetTradeLedgerLots\x12!.gctrpc.GetTradeLedgerLotsRequest\x1a\".gctrpc.GetTradeLedgerLotsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/gettradeledgerlots\x12c\n" +
+	"\fGetTaxReport\x12\x1b.gctrpc.GetTaxReportRequest\x1a\x1c.gctrpc.GetTaxReportResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/gettaxreportB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +20819,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 292)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16548,208 +20869,260 @@ var file_rpc_proto_goTypes = []any{
 	(*OfflineCoins)(nil),                              // 45: gctrpc.OfflineCoins
 	(*OnlineCoins)(nil),                               // 46: gctrpc.OnlineCoins
 	(*GetPortfolioSummaryResponse)(nil),               // 47: gctrpc.GetPortfolioSummaryResponse
-	(*AddPortfolioAddressRequest)(nil),                // 48: gctrpc.AddPortfolioAddressRequest
-	(*RemovePortfolioAddressRequest)(nil),             // 49: gctrpc.RemovePortfolioAddressRequest
-	(*GetForexProvidersRequest)(nil),                  // 50: gctrpc.GetForexProvidersRequest
-	(*ForexProvider)(nil),                             // 51: gctrpc.ForexProvider
-	(*GetForexProvidersResponse)(nil),                 // 52: gctrpc.GetForexProvidersResponse
-	(*GetForexRatesRequest)(nil),                      // 53: gctrpc.GetForexRatesRequest
-	(*ForexRatesConversion)(nil),                      // 54: gctrpc.ForexRatesConversion
-	(*GetForexRatesResponse)(nil),                     // 55: gctrpc.GetForexRatesResponse
-	(*OrderDetails)(nil),                              // 56: gctrpc.OrderDetails
-	(*TradeHistory)(nil),                              // 57: gctrpc.TradeHistory
-	(*GetOrdersRequest)(nil),                          // 58: gctrpc.GetOrdersRequest
-	(*GetOrdersResponse)(nil),                         // 59: gctrpc.GetOrdersResponse
-	(*GetOrderRequest)(nil),                           // 60: gctrpc.GetOrderRequest
-	(*SubmitOrderRequest)(nil),                        // 61: gctrpc.SubmitOrderRequest
-	(*Trades)(nil),                                    // 62: gctrpc.Trades
-	(*SubmitOrderResponse)(nil),                       // 63: gctrpc.SubmitOrderResponse
-	(*SimulateOrderRequest)(nil),                      // 64: gctrpc.SimulateOrderRequest
-	(*SimulateOrderResponse)(nil),                     // 65: gctrpc.SimulateOrderResponse
-	(*WhaleBombRequest)(nil),                          // 66: gctrpc.WhaleBombRequest
-	(*CancelOrderRequest)(nil),                        // 67: gctrpc.CancelOrderRequest
-	(*CancelBatchOrdersRequest)(nil),                  // 68: gctrpc.CancelBatchOrdersRequest
-	(*Orders)(nil),                                    // 69: gctrpc.Orders
-	(*CancelBatchOrdersResponse)(nil),                 // 70: gctrpc.CancelBatchOrdersResponse
-	(*CancelAllOrdersRequest)(nil),                    // 71: gctrpc.CancelAllOrdersRequest
-	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
-	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
-	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawalExchangeEvent)(nil),                   // 96: gctrpc.WithdrawalExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*OptionExposure)(nil),                            // 48: gctrpc.OptionExposure
+	(*AddPortfolioAddressRequest)(nil),                // 49: gctrpc.AddPortfolioAddressRequest
+	(*RemovePortfolioAddressRequest)(nil),             // 50: gctrpc.RemovePortfolioAddressRequest
+	(*GetForexProvidersRequest)(nil),                  // 51: gctrpc.GetForexProvidersRequest
+	(*ForexProvider)(nil),                             // 52: gctrpc.ForexProvider
+	(*GetForexProvidersResponse)(nil),                 // 53: gctrpc.GetForexProvidersResponse
+	(*GetForexRatesRequest)(nil),                      // 54: gctrpc.GetForexRatesRequest
+	(*ForexRatesConversion)(nil),                      // 55: gctrpc.ForexRatesConversion
+	(*GetForexRatesResponse)(nil),                     // 56: gctrpc.GetForexRatesResponse
+	(*OrderDetails)(nil),                              // 57: gctrpc.OrderDetails
+	(*TradeHistory)(nil),                              // 58: gctrpc.TradeHistory
+	(*GetOrdersRequest)(nil),                          // 59: gctrpc.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                         // 60: gctrpc.GetOrdersResponse
+	(*GetOrderRequest)(nil),                           // 61: gctrpc.GetOrderRequest
+	(*SubmitOrderRequest)(nil),                        // 62: gctrpc.SubmitOrderRequest
+	(*Trades)(nil),                                    // 63: gctrpc.Trades
+	(*SubmitOrderResponse)(nil),                       // 64: gctrpc.SubmitOrderResponse
+	(*SimulateOrderRequest)(nil),                      // 65: gctrpc.SimulateOrderRequest
+	(*SimulateOrderResponse)(nil),                     // 66: gctrpc.SimulateOrderResponse
+	(*WhaleBombRequest)(nil),                          // 67: gctrpc.WhaleBombRequest
+	(*CancelOrderRequest)(nil),                        // 68: gctrpc.CancelOrderRequest
+	(*CancelBatchOrdersRequest)(nil),                  // 69: gctrpc.CancelBatchOrdersRequest
+	(*Orders)(nil),                                    // 70: gctrpc.Orders
+	(*CancelBatchOrdersResponse)(nil),                 // 71: gctrpc.CancelBatchOrdersResponse
+	(*CancelAllOrdersRequest)(nil),                    // 72: gctrpc.CancelAllOrdersRequest
+	(*CancelAllOrdersResponse)(nil),                   // 73: gctrpc.CancelAllOrdersResponse
+	(*GetEventsRequest)(nil),                          // 74: gctrpc.GetEventsRequest
+	(*ConditionParams)(nil),                           // 75: gctrpc.ConditionParams
+	(*GetEventsResponse)(nil),                         // 76: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 77: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 78: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 79: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 80: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 81: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 82: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 83: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 84: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 85: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 86: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 87: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 88: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 89: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 90: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 91: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 92: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 93: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 94: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 95: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 96: gctrpc.WithdrawalEventResponse
+	(*WithdrawalExchangeEvent)(nil),                   // 97: gctrpc.WithdrawalExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 98: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 99: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 100: gctrpc.CryptoWithdrawalEvent
+	(*GetLoggerDetailsRequest)(nil),                   // 101: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 102: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 103: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 104: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 105: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 106: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 107: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 108: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 109: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 110: gctrpc.GetExchangeTickerStreamRequest
+	(*GetAuditEventRequest)(nil),                      // 111: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 112: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 113: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 114: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 115: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 116: gctrpc.ConvertTradesToCandlesRequest
+	(*GetHistoricCandlesRequest)(nil),                 // 117: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 118: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 119: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 120: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 121: gctrpc.GCTScript
+	(*GCTScriptSimulation)(nil),                       // 122: gctrpc.GCTScriptSimulation
+	(*GCTScriptExecuteRequest)(nil),                   // 123: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptStopRequest)(nil),                      // 124: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 125: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 126: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 127: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 128: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 129: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 130: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 131: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 132: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 133: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 134: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 135: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 136: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 137: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 138: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 139: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 140: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 141: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketSetEnabledRequest)(nil),                // 142: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 143: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 144: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 145: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 146: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 147: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 148: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 149: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 150: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 151: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 152: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 153: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 154: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 155: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 156: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 157: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 158: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 159: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 160: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 161: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 162: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 163: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 164: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 165: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 166: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 167: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 168: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 169: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 170: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 171: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 172: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 173: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 174: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 175: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 176: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 177: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 178: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 179: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 180: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 181: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 182: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 183: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 184: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 185: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 186: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 187: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 188: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 189: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 190: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 191: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 192: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 193: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 194: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 195: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 196: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 197: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 198: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 199: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 200: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 201: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 202: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 203: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 204: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 205: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 206: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 207: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 208: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 209: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 210: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 211: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 212: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 213: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 214: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 215: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 216: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 217: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 218: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 219: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 220: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 221: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 222: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 223: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 224: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 225: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 226: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 227: gctrpc.GetCurrencyTradeURLResponse
+	(*GetRateLimitStateRequest)(nil),                  // 228: gctrpc.GetRateLimitStateRequest
+	(*RateLimitQuota)(nil),                            // 229: gctrpc.RateLimitQuota
+	(*GetRateLimitStateResponse)(nil),                 // 230: gctrpc.GetRateLimitStateResponse
+	(*GetExchangeHealthRequest)(nil),                  // 231: gctrpc.GetExchangeHealthRequest
+	(*ExchangeHealth)(nil),                            // 232: gctrpc.ExchangeHealth
+	(*GetExchangeHealthResponse)(nil),                 // 233: gctrpc.GetExchangeHealthResponse
+	(*OptionGreeks)(nil),                              // 234: gctrpc.OptionGreeks
+	(*OptionAnalytics)(nil),                           // 235: gctrpc.OptionAnalytics
+	(*GetOptionAnalyticsRequest)(nil),                 // 236: gctrpc.GetOptionAnalyticsRequest
+	(*GetOptionAnalyticsResponse)(nil),                // 237: gctrpc.GetOptionAnalyticsResponse
+	(*VolatilitySmilePoint)(nil),                      // 238: gctrpc.VolatilitySmilePoint
+	(*VolatilitySmile)(nil),                           // 239: gctrpc.VolatilitySmile
+	(*GetVolatilitySurfaceRequest)(nil),               // 240: gctrpc.GetVolatilitySurfaceRequest
+	(*GetVolatilitySurfaceResponse)(nil),              // 241: gctrpc.GetVolatilitySurfaceResponse
+	(*CarrySnapshot)(nil),                             // 242: gctrpc.CarrySnapshot
+	(*GetCarrySnapshotsRequest)(nil),                  // 243: gctrpc.GetCarrySnapshotsRequest
+	(*GetCarrySnapshotsResponse)(nil),                 // 244: gctrpc.GetCarrySnapshotsResponse
+	(*GetCarryHistoryRequest)(nil),                    // 245: gctrpc.GetCarryHistoryRequest
+	(*GetCarryHistoryResponse)(nil),                   // 246: gctrpc.GetCarryHistoryResponse
+	(*PositionMarginHealth)(nil),                      // 247: gctrpc.PositionMarginHealth
+	(*GetMarginHealthRequest)(nil),                    // 248: gctrpc.GetMarginHealthRequest
+	(*GetMarginHealthResponse)(nil),                   // 249: gctrpc.GetMarginHealthResponse
+	(*RebalanceTransfer)(nil),                         // 250: gctrpc.RebalanceTransfer
+	(*GetRebalanceTransfersRequest)(nil),              // 251: gctrpc.GetRebalanceTransfersRequest
+	(*GetRebalanceTransfersResponse)(nil),             // 252: gctrpc.GetRebalanceTransfersResponse
+	(*RebalanceTransferRequest)(nil),                  // 253: gctrpc.RebalanceTransferRequest
+	(*QueuedWithdrawal)(nil),                          // 254: gctrpc.QueuedWithdrawal
+	(*GetQueuedWithdrawalsRequest)(nil),               // 255: gctrpc.GetQueuedWithdrawalsRequest
+	(*GetQueuedWithdrawalsResponse)(nil),              // 256: gctrpc.GetQueuedWithdrawalsResponse
+	(*QueuedWithdrawalRequest)(nil),                   // 257: gctrpc.QueuedWithdrawalRequest
+	(*PortfolioValuationHolding)(nil),                 // 258: gctrpc.PortfolioValuationHolding
+	(*GetPortfolioValuationRequest)(nil),              // 259: gctrpc.GetPortfolioValuationRequest
+	(*PortfolioValuation)(nil),                        // 260: gctrpc.PortfolioValuation
+	(*PortfolioValuationHistoryRequest)(nil),          // 261: gctrpc.PortfolioValuationHistoryRequest
+	(*PortfolioEquityPoint)(nil),                      // 262: gctrpc.PortfolioEquityPoint
+	(*GetPortfolioEquityCurveResponse)(nil),           // 263: gctrpc.GetPortfolioEquityCurveResponse
+	(*PortfolioDailyPNL)(nil),                         // 264: gctrpc.PortfolioDailyPNL
+	(*GetPortfolioDailyPNLResponse)(nil),              // 265: gctrpc.GetPortfolioDailyPNLResponse
+	(*GetPortfolioAttributionRequest)(nil),            // 266: gctrpc.GetPortfolioAttributionRequest
+	(*PortfolioAttribution)(nil),                      // 267: gctrpc.PortfolioAttribution
+	(*GetPortfolioAttributionResponse)(nil),           // 268: gctrpc.GetPortfolioAttributionResponse
+	(*GetTradeLedgerFillsRequest)(nil),                // 269: gctrpc.GetTradeLedgerFillsRequest
+	(*TradeLedgerFill)(nil),                           // 270: gctrpc.TradeLedgerFill
+	(*GetTradeLedgerFillsResponse)(nil),               // 271: gctrpc.GetTradeLedgerFillsResponse
+	(*GetTradeLedgerLotsRequest)(nil),                 // 272: gctrpc.GetTradeLedgerLotsRequest
+	(*TradeLedgerLot)(nil),                            // 273: gctrpc.TradeLedgerLot
+	(*GetTradeLedgerLotsResponse)(nil),                // 274: gctrpc.GetTradeLedgerLotsResponse
+	(*GetTaxReportRequest)(nil),                       // 275: gctrpc.GetTaxReportRequest
+	(*GetTaxReportResponse)(nil),                      // 276: gctrpc.GetTaxReportResponse
+	nil,                                               // 277: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 278: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 279: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 280: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	nil,                                               // 281: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 282: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 283: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 284: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 285: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 286: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 287: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 288: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 289: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 290: gctrpc.GCTScriptSimulation.BalancesEntry
+	nil,                                               // 291: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil),                     // 292: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	277, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	278, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	279, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	280, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	281, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	282, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	283, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,379 +21134,471 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	292, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	284, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
 	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
 	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	285, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
 	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
-	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
-	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
-	21,  // 31: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 32: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
-	21,  // 33: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 34: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	62,  // 35: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
-	21,  // 36: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	27,  // 37: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
-	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
-	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
-	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	286, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	48,  // 28: gctrpc.GetPortfolioSummaryResponse.options_exposure:type_name -> gctrpc.OptionExposure
+	52,  // 29: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
+	55,  // 30: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
+	58,  // 31: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
+	21,  // 32: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 33: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
+	21,  // 34: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 35: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	63,  // 36: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
+	21,  // 37: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	27,  // 38: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
+	21,  // 39: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 40: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 41: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	287, // 42: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	70,  // 43: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
+	70,  // 44: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
+	75,  // 45: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 46: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 47: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 48: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	81,  // 49: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	288, // 50: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	96,  // 51: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	96,  // 52: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	97,  // 53: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
+	98,  // 54: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	292, // 55: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	292, // 56: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	99,  // 57: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	100, // 58: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	289, // 59: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 60: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 61: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 62: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	120, // 63: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 64: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 65: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	114, // 66: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 67: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 68: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 69: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	119, // 70: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	21,  // 71: gctrpc.GCTScriptSimulation.pair:type_name -> gctrpc.CurrencyPair
+	290, // 72: gctrpc.GCTScriptSimulation.balances:type_name -> gctrpc.GCTScriptSimulation.BalancesEntry
+	121, // 73: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	122, // 74: gctrpc.GCTScriptExecuteRequest.simulation:type_name -> gctrpc.GCTScriptSimulation
+	121, // 75: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	121, // 76: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	121, // 77: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	121, // 78: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	121, // 79: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	144, // 80: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 81: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 82: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 83: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 84: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	152, // 85: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	155, // 86: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 87: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	158, // 88: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	157, // 89: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 90: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	171, // 91: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 92: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	172, // 93: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	172, // 94: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	172, // 95: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 96: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 97: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	174, // 98: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	173, // 99: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 100: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	175, // 101: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 102: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 103: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 104: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	174, // 105: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 106: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 107: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	175, // 108: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 109: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 110: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 111: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 112: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 113: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 114: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 115: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 116: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 117: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 118: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 119: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 120: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 121: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 122: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	201, // 123: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	199, // 124: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	200, // 125: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	201, // 126: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 127: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	173, // 128: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 129: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	173, // 130: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 131: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	292, // 132: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	292, // 133: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 134: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	291, // 135: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	214, // 136: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	212, // 137: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	213, // 138: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	214, // 139: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	214, // 140: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	214, // 141: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 142: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 143: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 144: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	223, // 145: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 146: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	225, // 147: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 148: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 149: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	292, // 150: gctrpc.RateLimitQuota.reset_at:type_name -> google.protobuf.Timestamp
+	292, // 151: gctrpc.RateLimitQuota.updated_at:type_name -> google.protobuf.Timestamp
+	229, // 152: gctrpc.GetRateLimitStateResponse.quotas:type_name -> gctrpc.RateLimitQuota
+	292, // 153: gctrpc.ExchangeHealth.since:type_name -> google.protobuf.Timestamp
+	292, // 154: gctrpc.ExchangeHealth.last_checked:type_name -> google.protobuf.Timestamp
+	232, // 155: gctrpc.GetExchangeHealthResponse.exchanges:type_name -> gctrpc.ExchangeHealth
+	292, // 156: gctrpc.OptionAnalytics.expiry:type_name -> google.protobuf.Timestamp
+	234, // 157: gctrpc.OptionAnalytics.greeks:type_name -> gctrpc.OptionGreeks
+	292, // 158: gctrpc.OptionAnalytics.timestamp:type_name -> google.protobuf.Timestamp
+	21,  // 159: gctrpc.GetOptionAnalyticsRequest.pair:type_name -> gctrpc.CurrencyPair
+	235, // 160: gctrpc.GetOptionAnalyticsResponse.analytics:type_name -> gctrpc.OptionAnalytics
+	292, // 161: gctrpc.VolatilitySmile.expiry:type_name -> google.protobuf.Timestamp
+	238, // 162: gctrpc.VolatilitySmile.points:type_name -> gctrpc.VolatilitySmilePoint
+	292, // 163: gctrpc.GetVolatilitySurfaceResponse.timestamp:type_name -> google.protobuf.Timestamp
+	239, // 164: gctrpc.GetVolatilitySurfaceResponse.smiles:type_name -> gctrpc.VolatilitySmile
+	235, // 165: gctrpc.GetVolatilitySurfaceResponse.contracts:type_name -> gctrpc.OptionAnalytics
+	21,  // 166: gctrpc.CarrySnapshot.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 167: gctrpc.CarrySnapshot.underlying:type_name -> gctrpc.CurrencyPair
+	292, // 168: gctrpc.CarrySnapshot.expiry:type_name -> google.protobuf.Timestamp
+	292, // 169: gctrpc.CarrySnapshot.timestamp:type_name -> google.protobuf.Timestamp
+	21,  // 170: gctrpc.GetCarrySnapshotsRequest.underlying:type_name -> gctrpc.CurrencyPair
+	242, // 171: gctrpc.GetCarrySnapshotsResponse.snapshots:type_name -> gctrpc.CarrySnapshot
+	21,  // 172: gctrpc.GetCarryHistoryRequest.underlying:type_name -> gctrpc.CurrencyPair
+	242, // 173: gctrpc.GetCarryHistoryResponse.snapshots:type_name -> gctrpc.CarrySnapshot
+	21,  // 174: gctrpc.PositionMarginHealth.pair:type_name -> gctrpc.CurrencyPair
+	292, // 175: gctrpc.PositionMarginHealth.timestamp:type_name -> google.protobuf.Timestamp
+	247, // 176: gctrpc.GetMarginHealthResponse.positions:type_name -> gctrpc.PositionMarginHealth
+	292, // 177: gctrpc.RebalanceTransfer.created:type_name -> google.protobuf.Timestamp
+	292, // 178: gctrpc.RebalanceTransfer.updated:type_name -> google.protobuf.Timestamp
+	250, // 179: gctrpc.GetRebalanceTransfersResponse.transfers:type_name -> gctrpc.RebalanceTransfer
+	292, // 180: gctrpc.QueuedWithdrawal.requested:type_name -> google.protobuf.Timestamp
+	292, // 181: gctrpc.QueuedWithdrawal.updated:type_name -> google.protobuf.Timestamp
+	292, // 182: gctrpc.QueuedWithdrawal.execute_after:type_name -> google.protobuf.Timestamp
+	254, // 183: gctrpc.GetQueuedWithdrawalsResponse.withdrawals:type_name -> gctrpc.QueuedWithdrawal
+	258, // 184: gctrpc.PortfolioValuation.holdings:type_name -> gctrpc.PortfolioValuationHolding
+	292, // 185: gctrpc.PortfolioValuation.timestamp:type_name -> google.protobuf.Timestamp
+	292, // 186: gctrpc.PortfolioEquityPoint.timestamp:type_name -> google.protobuf.Timestamp
+	262, // 187: gctrpc.GetPortfolioEquityCurveResponse.points:type_name -> gctrpc.PortfolioEquityPoint
+	264, // 188: gctrpc.GetPortfolioDailyPNLResponse.days:type_name -> gctrpc.PortfolioDailyPNL
+	267, // 189: gctrpc.GetPortfolioAttributionResponse.attributions:type_name -> gctrpc.PortfolioAttribution
+	292, // 190: gctrpc.TradeLedgerFill.timestamp:type_name -> google.protobuf.Timestamp
+	270, // 191: gctrpc.GetTradeLedgerFillsResponse.fills:type_name -> gctrpc.TradeLedgerFill
+	292, // 192: gctrpc.TradeLedgerLot.acquired:type_name -> google.protobuf.Timestamp
+	273, // 193: gctrpc.GetTradeLedgerLotsResponse.lots:type_name -> gctrpc.TradeLedgerLot
+	292, // 194: gctrpc.GetTaxReportResponse.start:type_name -> google.protobuf.Timestamp
+	292, // 195: gctrpc.GetTaxReportResponse.end:type_name -> google.protobuf.Timestamp
+	9,   // 196: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 197: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 198: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 199: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 200: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 201: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 202: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	82,  // 203: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 204: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	209, // 205: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 206: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 207: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 208: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 209: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 210: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 211: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 212: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 213: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 214: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 215: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 216: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 217: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 218: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 219: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 220: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 221: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 222: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 223: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 224: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
+	36,  // 225: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 226: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 227: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	49,  // 228: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	50,  // 229: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	51,  // 230: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	54,  // 231: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	59,  // 232: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	61,  // 233: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	62,  // 234: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	65,  // 235: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	67,  // 236: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	68,  // 237: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	69,  // 238: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	72,  // 239: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	74,  // 240: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	77,  // 241: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	79,  // 242: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	80,  // 243: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	84,  // 244: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	86,  // 245: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	88,  // 246: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	89,  // 247: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	91,  // 248: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	93,  // 249: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	94,  // 250: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	101, // 251: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	103, // 252: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	104, // 253: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	106, // 254: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	107, // 255: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	108, // 256: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	109, // 257: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	110, // 258: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	111, // 259: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	123, // 260: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	128, // 261: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	129, // 262: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	126, // 263: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	130, // 264: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	124, // 265: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	125, // 266: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	127, // 267: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	131, // 268: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	117, // 269: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	135, // 270: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	136, // 271: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	137, // 272: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	138, // 273: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	140, // 274: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	142, // 275: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	143, // 276: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	146, // 277: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	147, // 278: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	113, // 279: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	113, // 280: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	113, // 281: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	116, // 282: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	148, // 283: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	149, // 284: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	151, // 285: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	152, // 286: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	156, // 287: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 288: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	160, // 289: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	156, // 290: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	161, // 291: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	162, // 292: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	59,  // 293: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	163, // 294: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	165, // 295: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	166, // 296: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	169, // 297: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	168, // 298: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	167, // 299: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	179, // 300: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	181, // 301: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	197, // 302: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	206, // 303: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	208, // 304: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	211, // 305: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	176, // 306: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	177, // 307: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	202, // 308: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	204, // 309: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	216, // 310: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	218, // 311: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	220, // 312: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	183, // 313: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	193, // 314: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	185, // 315: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	191, // 316: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	195, // 317: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	189, // 318: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	222, // 319: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	226, // 320: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	228, // 321: gctrpc.GoCryptoTraderService.GetRateLimitState:input_type -> gctrpc.GetRateLimitStateRequest
+	231, // 322: gctrpc.GoCryptoTraderService.GetExchangeHealth:input_type -> gctrpc.GetExchangeHealthRequest
+	236, // 323: gctrpc.GoCryptoTraderService.GetOptionAnalytics:input_type -> gctrpc.GetOptionAnalyticsRequest
+	240, // 324: gctrpc.GoCryptoTraderService.GetVolatilitySurface:input_type -> gctrpc.GetVolatilitySurfaceRequest
+	243, // 325: gctrpc.GoCryptoTraderService.GetCarrySnapshots:input_type -> gctrpc.GetCarrySnapshotsRequest
+	243, // 326: gctrpc.GoCryptoTraderService.GetCarrySnapshotStream:input_type -> gctrpc.GetCarrySnapshotsRequest
+	245, // 327: gctrpc.GoCryptoTraderService.GetCarryHistory:input_type -> gctrpc.GetCarryHistoryRequest
+	248, // 328: gctrpc.GoCryptoTraderService.GetMarginHealth:input_type -> gctrpc.GetMarginHealthRequest
+	251, // 329: gctrpc.GoCryptoTraderService.GetRebalanceTransfers:input_type -> gctrpc.GetRebalanceTransfersRequest
+	253, // 330: gctrpc.GoCryptoTraderService.ApproveRebalanceTransfer:input_type -> gctrpc.RebalanceTransferRequest
+	253, // 331: gctrpc.GoCryptoTraderService.RejectRebalanceTransfer:input_type -> gctrpc.RebalanceTransferRequest
+	255, // 332: gctrpc.GoCryptoTraderService.GetQueuedWithdrawals:input_type -> gctrpc.GetQueuedWithdrawalsRequest
+	257, // 333: gctrpc.GoCryptoTraderService.ApproveQueuedWithdrawal:input_type -> gctrpc.QueuedWithdrawalRequest
+	257, // 334: gctrpc.GoCryptoTraderService.RejectQueuedWithdrawal:input_type -> gctrpc.QueuedWithdrawalRequest
+	259, // 335: gctrpc.GoCryptoTraderService.GetPortfolioValuation:input_type -> gctrpc.GetPortfolioValuationRequest
+	261, // 336: gctrpc.GoCryptoTraderService.GetPortfolioEquityCurve:input_type -> gctrpc.PortfolioValuationHistoryRequest
+	261, // 337: gctrpc.GoCryptoTraderService.GetPortfolioDailyPNL:input_type -> gctrpc.PortfolioValuationHistoryRequest
+	266, // 338: gctrpc.GoCryptoTraderService.GetPortfolioAttribution:input_type -> gctrpc.GetPortfolioAttributionRequest
+	269, // 339: gctrpc.GoCryptoTraderService.GetTradeLedgerFills:input_type -> gctrpc.GetTradeLedgerFillsRequest
+	272, // 340: gctrpc.GoCryptoTraderService.GetTradeLedgerLots:input_type -> gctrpc.GetTradeLedgerLotsRequest
+	275, // 341: gctrpc.GoCryptoTraderService.GetTaxReport:input_type -> gctrpc.GetTaxReportRequest
+	1,   // 342: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 343: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
+	134, // 344: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	134, // 345: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 346: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 347: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 348: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	134, // 349: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 350: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 351: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 352: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	134, // 353: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 354: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 355: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 356: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 357: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 358: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 359: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 360: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
+	37,  // 361: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 362: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	47,  // 363: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	134, // 364: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	134, // 365: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	53,  // 366: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	56,  // 367: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	60,  // 368: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	57,  // 369: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	64,  // 370: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	66,  // 371: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	66,  // 372: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	134, // 373: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	71,  // 374: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	73,  // 375: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	76,  // 376: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	78,  // 377: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	134, // 378: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	83,  // 379: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	85,  // 380: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	87,  // 381: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	90,  // 382: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	90,  // 383: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	92,  // 384: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	95,  // 385: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	95,  // 386: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	102, // 387: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	102, // 388: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	105, // 389: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	134, // 390: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 391: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 392: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 393: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 394: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	112, // 395: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	134, // 396: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	134, // 397: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	133, // 398: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	132, // 399: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	133, // 400: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	134, // 401: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	134, // 402: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	132, // 403: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	134, // 404: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	118, // 405: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	134, // 406: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	134, // 407: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	134, // 408: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	139, // 409: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	141, // 410: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	134, // 411: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	145, // 412: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	134, // 413: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	134, // 414: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	115, // 415: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	115, // 416: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	115, // 417: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	118, // 418: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	150, // 419: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	150, // 420: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	134, // 421: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	155, // 422: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	157, // 423: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	159, // 424: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	159, // 425: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	157, // 426: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	134, // 427: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	134, // 428: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	60,  // 429: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	164, // 430: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	170, // 431: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	134, // 432: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	134, // 433: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	134, // 434: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	134, // 435: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	180, // 436: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	182, // 437: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	198, // 438: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	207, // 439: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	210, // 440: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	215, // 441: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	178, // 442: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	178, // 443: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	203, // 444: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	205, // 445: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	217, // 446: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	219, // 447: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	221, // 448: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	184, // 449: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	194, // 450: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	186, // 451: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	192, // 452: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	196, // 453: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	190, // 454: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	224, // 455: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	227, // 456: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	230, // 457: gctrpc.GoCryptoTraderService.GetRateLimitState:output_type -> gctrpc.GetRateLimitStateResponse
+	233, // 458: gctrpc.GoCryptoTraderService.GetExchangeHealth:output_type -> gctrpc.GetExchangeHealthResponse
+	237, // 459: gctrpc.GoCryptoTraderService.GetOptionAnalytics:output_type -> gctrpc.GetOptionAnalyticsResponse
+	241, // 460: gctrpc.GoCryptoTraderService.GetVolatilitySurface:output_type -> gctrpc.GetVolatilitySurfaceResponse
+	244, // 461: gctrpc.GoCryptoTraderService.GetCarrySnapshots:output_type -> gctrpc.GetCarrySnapshotsResponse
+	244, // 462: gctrpc.GoCryptoTraderService.GetCarrySnapshotStream:output_type -> gctrpc.GetCarrySnapshotsResponse
+	246, // 463: gctrpc.GoCryptoTraderService.GetCarryHistory:output_type -> gctrpc.GetCarryHistoryResponse
+	249, // 464: gctrpc.GoCryptoTraderService.GetMarginHealth:output_type -> gctrpc.GetMarginHealthResponse
+	252, // 465: gctrpc.GoCryptoTraderService.GetRebalanceTransfers:output_type -> gctrpc.GetRebalanceTransfersResponse
+	250, // 466: gctrpc.GoCryptoTraderService.ApproveRebalanceTransfer:output_type -> gctrpc.RebalanceTransfer
+	250, // 467: gctrpc.GoCryptoTraderService.RejectRebalanceTransfer:output_type -> gctrpc.RebalanceTransfer
+	256, // 468: gctrpc.GoCryptoTraderService.GetQueuedWithdrawals:output_type -> gctrpc.GetQueuedWithdrawalsResponse
+	254, // 469: gctrpc.GoCryptoTraderService.ApproveQueuedWithdrawal:output_type -> gctrpc.QueuedWithdrawal
+	254, // 470: gctrpc.GoCryptoTraderService.RejectQueuedWithdrawal:output_type -> gctrpc.QueuedWithdrawal
+	260, // 471: gctrpc.GoCryptoTraderService.GetPortfolioValuation:output_type -> gctrpc.PortfolioValuation
+	263, // 472: gctrpc.GoCryptoTraderService.GetPortfolioEquityCurve:output_type -> gctrpc.GetPortfolioEquityCurveResponse
+	265, // 473: gctrpc.GoCryptoTraderService.GetPortfolioDailyPNL:output_type -> gctrpc.GetPortfolioDailyPNLResponse
+	268, // 474: gctrpc.GoCryptoTraderService.GetPortfolioAttribution:output_type -> gctrpc.GetPortfolioAttributionResponse
+	271, // 475: gctrpc.GoCryptoTraderService.GetTradeLedgerFills:output_type -> gctrpc.GetTradeLedgerFillsResponse
+	274, // 476: gctrpc.GoCryptoTraderService.GetTradeLedgerLots:output_type -> gctrpc.GetTradeLedgerLotsResponse
+	276, // 477: gctrpc.GoCryptoTraderService.GetTaxReport:output_type -> gctrpc.GetTaxReportResponse
+	342, // [342:478] is the sub-list for method output_type
+	206, // [206:342] is the sub-list for method input_type
+	206, // [206:206] is the sub-list for extension type_name
+	206, // [206:206] is the sub-list for extension extendee
+	0,   // [0:206] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +21612,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   292,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -134,6 +134,27 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_GetRateLimitState_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetRateLimitState"
+	GoCryptoTraderService_GetExchangeHealth_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetExchangeHealth"
+	GoCryptoTraderService_GetOptionAnalytics_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetOptionAnalytics"
+	GoCryptoTraderService_GetVolatilitySurface_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetVolatilitySurface"
+	GoCryptoTraderService_GetCarrySnapshots_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetCarrySnapshots"
+	GoCryptoTraderService_GetCarrySnapshotStream_FullMethodName            = "/gctrpc.GoCryptoTraderService/GetCarrySnapshotStream"
+	GoCryptoTraderService_GetCarryHistory_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetCarryHistory"
+	GoCryptoTraderService_GetMarginHealth_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetMarginHealth"
+	GoCryptoTraderService_GetRebalanceTransfers_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetRebalanceTransfers"
+	GoCryptoTraderService_ApproveRebalanceTransfer_FullMethodName          = "/gctrpc.GoCryptoTraderService/ApproveRebalanceTransfer"
+	GoCryptoTraderService_RejectRebalanceTransfer_FullMethodName           = "/gctrpc.GoCryptoTraderService/RejectRebalanceTransfer"
+	GoCryptoTraderService_GetQueuedWithdrawals_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetQueuedWithdrawals"
+	GoCryptoTraderService_ApproveQueuedWithdrawal_FullMethodName           = "/gctrpc.GoCryptoTraderService/ApproveQueuedWithdrawal"
+	GoCryptoTraderService_RejectQueuedWithdrawal_FullMethodName            = "/gctrpc.GoCryptoTraderService/RejectQueuedWithdrawal"
+	GoCryptoTraderService_GetPortfolioValuation_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPortfolioValuation"
+	GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetPortfolioEquityCurve"
+	GoCryptoTraderService_GetPortfolioDailyPNL_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetPortfolioDailyPNL"
+	GoCryptoTraderService_GetPortfolioAttribution_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetPortfolioAttribution"
+	GoCryptoTraderService_GetTradeLedgerFills_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetTradeLedgerFills"
+	GoCryptoTraderService_GetTradeLedgerLots_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetTradeLedgerLots"
+	GoCryptoTraderService_GetTaxReport_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetTaxReport"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -255,6 +276,27 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	GetRateLimitState(ctx context.Context, in *GetRateLimitStateRequest, opts ...grpc.CallOption) (*GetRateLimitStateResponse, error)
+	GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error)
+	GetOptionAnalytics(ctx context.Context, in *GetOptionAnalyticsRequest, opts ...grpc.CallOption) (*GetOptionAnalyticsResponse, error)
+	GetVolatilitySurface(ctx context.Context, in *GetVolatilitySurfaceRequest, opts ...grpc.CallOption) (*GetVolatilitySurfaceResponse, error)
+	GetCarrySnapshots(ctx context.Context, in *GetCarrySnapshotsRequest, opts ...grpc.CallOption) (*GetCarrySnapshotsResponse, error)
+	GetCarrySnapshotStream(ctx context.Context, in *GetCarrySnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCarrySnapshotsResponse], error)
+	GetCarryHistory(ctx context.Context, in *GetCarryHistoryRequest, opts ...grpc.CallOption) (*GetCarryHistoryResponse, error)
+	GetMarginHealth(ctx context.Context, in *GetMarginHealthRequest, opts ...grpc.CallOption) (*GetMarginHealthResponse, error)
+	GetRebalanceTransfers(ctx context.Context, in *GetRebalanceTransfersRequest, opts ...grpc.CallOption) (*GetRebalanceTransfersResponse, error)
+	ApproveRebalanceTransfer(ctx context.Context, in *RebalanceTransferRequest, opts ...grpc.CallOption) (*RebalanceTransfer, error)
+	RejectRebalanceTransfer(ctx context.Context, in *RebalanceTransferRequest, opts ...grpc.CallOption) (*RebalanceTransfer, error)
+	GetQueuedWithdrawals(ctx context.Context, in *GetQueuedWithdrawalsRequest, opts ...grpc.CallOption) (*GetQueuedWithdrawalsResponse, error)
+	ApproveQueuedWithdrawal(ctx context.Context, in *QueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueuedWithdrawal, error)
+	RejectQueuedWithdrawal(ctx context.Context, in *QueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueuedWithdrawal, error)
+	GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*PortfolioValuation, error)
+	GetPortfolioEquityCurve(ctx context.Context, in *PortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioEquityCurveResponse, error)
+	GetPortfolioDailyPNL(ctx context.Context, in *PortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioDailyPNLResponse, error)
+	GetPortfolioAttribution(ctx context.Context, in *GetPortfolioAttributionRequest, opts ...grpc.CallOption) (*GetPortfolioAttributionResponse, error)
+	GetTradeLedgerFills(ctx context.Context, in *GetTradeLedgerFillsRequest, opts ...grpc.CallOption) (*GetTradeLedgerFillsResponse, error)
+	GetTradeLedgerLots(ctx context.Context, in *GetTradeLedgerLotsRequest, opts ...grpc.CallOption) (*GetTradeLedgerLotsResponse, error)
+	GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -1469,6 +1511,225 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetRateLimitState(ctx context.Context, in *GetRateLimitStateRequest, opts ...grpc.CallOption) (*GetRateLimitStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitStateResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRateLimitState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExchangeHealthResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetExchangeHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetOptionAnalytics(ctx context.Context, in *GetOptionAnalyticsRequest, opts ...grpc.CallOption) (*GetOptionAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOptionAnalyticsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOptionAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetVolatilitySurface(ctx context.Context, in *GetVolatilitySurfaceRequest, opts ...grpc.CallOption) (*GetVolatilitySurfaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolatilitySurfaceResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetVolatilitySurface_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetCarrySnapshots(ctx context.Context, in *GetCarrySnapshotsRequest, opts ...grpc.CallOption) (*GetCarrySnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCarrySnapshotsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetCarrySnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetCarrySnapshotStream(ctx context.Context, in *GetCarrySnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCarrySnapshotsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetCarrySnapshotStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCarrySnapshotsRequest, GetCarrySnapshotsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetCarrySnapshotStreamClient = grpc.ServerStreamingClient[GetCarrySnapshotsResponse]
+
+func (c *goCryptoTraderServiceClient) GetCarryHistory(ctx context.Context, in *GetCarryHistoryRequest, opts ...grpc.CallOption) (*GetCarryHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCarryHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetCarryHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetMarginHealth(ctx context.Context, in *GetMarginHealthRequest, opts ...grpc.CallOption) (*GetMarginHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarginHealthResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetMarginHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetRebalanceTransfers(ctx context.Context, in *GetRebalanceTransfersRequest, opts ...grpc.CallOption) (*GetRebalanceTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRebalanceTransfersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRebalanceTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveRebalanceTransfer(ctx context.Context, in *RebalanceTransferRequest, opts ...grpc.CallOption) (*RebalanceTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalanceTransfer)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveRebalanceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectRebalanceTransfer(ctx context.Context, in *RebalanceTransferRequest, opts ...grpc.CallOption) (*RebalanceTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalanceTransfer)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectRebalanceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetQueuedWithdrawals(ctx context.Context, in *GetQueuedWithdrawalsRequest, opts ...grpc.CallOption) (*GetQueuedWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueuedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetQueuedWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveQueuedWithdrawal(ctx context.Context, in *QueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueuedWithdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueuedWithdrawal)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveQueuedWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectQueuedWithdrawal(ctx context.Context, in *QueuedWithdrawalRequest, opts ...grpc.CallOption) (*QueuedWithdrawal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueuedWithdrawal)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectQueuedWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*PortfolioValuation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioValuation)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioEquityCurve(ctx context.Context, in *PortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioEquityCurveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioEquityCurveResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioDailyPNL(ctx context.Context, in *PortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioDailyPNLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioDailyPNLResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioDailyPNL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioAttribution(ctx context.Context, in *GetPortfolioAttributionRequest, opts ...grpc.CallOption) (*GetPortfolioAttributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioAttributionResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioAttribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetTradeLedgerFills(ctx context.Context, in *GetTradeLedgerFillsRequest, opts ...grpc.CallOption) (*GetTradeLedgerFillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradeLedgerFillsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTradeLedgerFills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetTradeLedgerLots(ctx context.Context, in *GetTradeLedgerLotsRequest, opts ...grpc.CallOption) (*GetTradeLedgerLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradeLedgerLotsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTradeLedgerLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaxReportResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTaxReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility.
@@ -1588,6 +1849,27 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	GetRateLimitState(context.Context, *GetRateLimitStateRequest) (*GetRateLimitStateResponse, error)
+	GetExchangeHealth(context.Context, *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error)
+	GetOptionAnalytics(context.Context, *GetOptionAnalyticsRequest) (*GetOptionAnalyticsResponse, error)
+	GetVolatilitySurface(context.Context, *GetVolatilitySurfaceRequest) (*GetVolatilitySurfaceResponse, error)
+	GetCarrySnapshots(context.Context, *GetCarrySnapshotsRequest) (*GetCarrySnapshotsResponse, error)
+	GetCarrySnapshotStream(*GetCarrySnapshotsRequest, grpc.ServerStreamingServer[GetCarrySnapshotsResponse]) error
+	GetCarryHistory(context.Context, *GetCarryHistoryRequest) (*GetCarryHistoryResponse, error)
+	GetMarginHealth(context.Context, *GetMarginHealthRequest) (*GetMarginHealthResponse, error)
+	GetRebalanceTransfers(context.Context, *GetRebalanceTransfersRequest) (*GetRebalanceTransfersResponse, error)
+	ApproveRebalanceTransfer(context.Context, *RebalanceTransferRequest) (*RebalanceTransfer, error)
+	RejectRebalanceTransfer(context.Context, *RebalanceTransferRequest) (*RebalanceTransfer, error)
+	GetQueuedWithdrawals(context.Context, *GetQueuedWithdrawalsRequest) (*GetQueuedWithdrawalsResponse, error)
+	ApproveQueuedWithdrawal(context.Context, *QueuedWithdrawalRequest) (*QueuedWithdrawal, error)
+	RejectQueuedWithdrawal(context.Context, *QueuedWithdrawalRequest) (*QueuedWithdrawal, error)
+	GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*PortfolioValuation, error)
+	GetPortfolioEquityCurve(context.Context, *PortfolioValuationHistoryRequest) (*GetPortfolioEquityCurveResponse, error)
+	GetPortfolioDailyPNL(context.Context, *PortfolioValuationHistoryRequest) (*GetPortfolioDailyPNLResponse, error)
+	GetPortfolioAttribution(context.Context, *GetPortfolioAttributionRequest) (*GetPortfolioAttributionResponse, error)
+	GetTradeLedgerFills(context.Context, *GetTradeLedgerFillsRequest) (*GetTradeLedgerFillsResponse, error)
+	GetTradeLedgerLots(context.Context, *GetTradeLedgerLotsRequest) (*GetTradeLedgerLotsResponse, error)
+	GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1943,6 +2225,69 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetRateLimitState(context.Context, *GetRateLimitStateRequest) (*GetRateLimitStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateLimitState not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeHealth(context.Context, *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeHealth not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOptionAnalytics(context.Context, *GetOptionAnalyticsRequest) (*GetOptionAnalyticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOptionAnalytics not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetVolatilitySurface(context.Context, *GetVolatilitySurfaceRequest) (*GetVolatilitySurfaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVolatilitySurface not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCarrySnapshots(context.Context, *GetCarrySnapshotsRequest) (*GetCarrySnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCarrySnapshots not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCarrySnapshotStream(*GetCarrySnapshotsRequest, grpc.ServerStreamingServer[GetCarrySnapshotsResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetCarrySnapshotStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCarryHistory(context.Context, *GetCarryHistoryRequest) (*GetCarryHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCarryHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetMarginHealth(context.Context, *GetMarginHealthRequest) (*GetMarginHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarginHealth not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRebalanceTransfers(context.Context, *GetRebalanceTransfersRequest) (*GetRebalanceTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRebalanceTransfers not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveRebalanceTransfer(context.Context, *RebalanceTransferRequest) (*RebalanceTransfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveRebalanceTransfer not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectRebalanceTransfer(context.Context, *RebalanceTransferRequest) (*RebalanceTransfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectRebalanceTransfer not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetQueuedWithdrawals(context.Context, *GetQueuedWithdrawalsRequest) (*GetQueuedWithdrawalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueuedWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveQueuedWithdrawal(context.Context, *QueuedWithdrawalRequest) (*QueuedWithdrawal, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveQueuedWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectQueuedWithdrawal(context.Context, *QueuedWithdrawalRequest) (*QueuedWithdrawal, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectQueuedWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*PortfolioValuation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioValuation not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioEquityCurve(context.Context, *PortfolioValuationHistoryRequest) (*GetPortfolioEquityCurveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioEquityCurve not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioDailyPNL(context.Context, *PortfolioValuationHistoryRequest) (*GetPortfolioDailyPNLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioDailyPNL not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioAttribution(context.Context, *GetPortfolioAttributionRequest) (*GetPortfolioAttributionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioAttribution not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTradeLedgerFills(context.Context, *GetTradeLedgerFillsRequest) (*GetTradeLedgerFillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTradeLedgerFills not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTradeLedgerLots(context.Context, *GetTradeLedgerLotsRequest) (*GetTradeLedgerLotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTradeLedgerLots not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaxReport not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 func (UnimplementedGoCryptoTraderServiceServer) testEmbeddedByValue()                               {}
 
@@ -3992,6 +4337,377 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetRateLimitState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRateLimitState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitState(ctx, req.(*GetRateLimitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExchangeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExchangeHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeHealth(ctx, req.(*GetExchangeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOptionAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOptionAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOptionAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOptionAnalytics(ctx, req.(*GetOptionAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetVolatilitySurface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolatilitySurfaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetVolatilitySurface(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetVolatilitySurface_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetVolatilitySurface(ctx, req.(*GetVolatilitySurfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCarrySnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarrySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetCarrySnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetCarrySnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetCarrySnapshots(ctx, req.(*GetCarrySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCarrySnapshotStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCarrySnapshotsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetCarrySnapshotStream(m, &grpc.GenericServerStream[GetCarrySnapshotsRequest, GetCarrySnapshotsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetCarrySnapshotStreamServer = grpc.ServerStreamingServer[GetCarrySnapshotsResponse]
+
+func _GoCryptoTraderService_GetCarryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetCarryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetCarryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetCarryHistory(ctx, req.(*GetCarryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetMarginHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetMarginHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetMarginHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetMarginHealth(ctx, req.(*GetMarginHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRebalanceTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalanceTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRebalanceTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRebalanceTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRebalanceTransfers(ctx, req.(*GetRebalanceTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveRebalanceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveRebalanceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveRebalanceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveRebalanceTransfer(ctx, req.(*RebalanceTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectRebalanceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectRebalanceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectRebalanceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectRebalanceTransfer(ctx, req.(*RebalanceTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetQueuedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetQueuedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetQueuedWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetQueuedWithdrawals(ctx, req.(*GetQueuedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveQueuedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveQueuedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveQueuedWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveQueuedWithdrawal(ctx, req.(*QueuedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectQueuedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectQueuedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectQueuedWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectQueuedWithdrawal(ctx, req.(*QueuedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuation(ctx, req.(*GetPortfolioValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioEquityCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioValuationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioEquityCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioEquityCurve(ctx, req.(*PortfolioValuationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioDailyPNL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioValuationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioDailyPNL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioDailyPNL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioDailyPNL(ctx, req.(*PortfolioValuationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioAttribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioAttributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioAttribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioAttribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioAttribution(ctx, req.(*GetPortfolioAttributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTradeLedgerFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeLedgerFillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTradeLedgerFills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTradeLedgerFills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTradeLedgerFills(ctx, req.(*GetTradeLedgerFillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTradeLedgerLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeLedgerLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTradeLedgerLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTradeLedgerLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTradeLedgerLots(ctx, req.(*GetTradeLedgerLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTaxReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTaxReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTaxReport(ctx, req.(*GetTaxReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4435,6 +5151,86 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "GetRateLimitState",
+			Handler:    _GoCryptoTraderService_GetRateLimitState_Handler,
+		},
+		{
+			MethodName: "GetExchangeHealth",
+			Handler:    _GoCryptoTraderService_GetExchangeHealth_Handler,
+		},
+		{
+			MethodName: "GetOptionAnalytics",
+			Handler:    _GoCryptoTraderService_GetOptionAnalytics_Handler,
+		},
+		{
+			MethodName: "GetVolatilitySurface",
+			Handler:    _GoCryptoTraderService_GetVolatilitySurface_Handler,
+		},
+		{
+			MethodName: "GetCarrySnapshots",
+			Handler:    _GoCryptoTraderService_GetCarrySnapshots_Handler,
+		},
+		{
+			MethodName: "GetCarryHistory",
+			Handler:    _GoCryptoTraderService_GetCarryHistory_Handler,
+		},
+		{
+			MethodName: "GetMarginHealth",
+			Handler:    _GoCryptoTraderService_GetMarginHealth_Handler,
+		},
+		{
+			MethodName: "GetRebalanceTransfers",
+			Handler:    _GoCryptoTraderService_GetRebalanceTransfers_Handler,
+		},
+		{
+			MethodName: "ApproveRebalanceTransfer",
+			Handler:    _GoCryptoTraderService_ApproveRebalanceTransfer_Handler,
+		},
+		{
+			MethodName: "RejectRebalanceTransfer",
+			Handler:    _GoCryptoTraderService_RejectRebalanceTransfer_Handler,
+		},
+		{
+			MethodName: "GetQueuedWithdrawals",
+			Handler:    _GoCryptoTraderService_GetQueuedWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveQueuedWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveQueuedWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectQueuedWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectQueuedWithdrawal_Handler,
+		},
+		{
+			MethodName: "GetPortfolioValuation",
+			Handler:    _GoCryptoTraderService_GetPortfolioValuation_Handler,
+		},
+		{
+			MethodName: "GetPortfolioEquityCurve",
+			Handler:    _GoCryptoTraderService_GetPortfolioEquityCurve_Handler,
+		},
+		{
+			MethodName: "GetPortfolioDailyPNL",
+			Handler:    _GoCryptoTraderService_GetPortfolioDailyPNL_Handler,
+		},
+		{
+			MethodName: "GetPortfolioAttribution",
+			Handler:    _GoCryptoTraderService_GetPortfolioAttribution_Handler,
+		},
+		{
+			MethodName: "GetTradeLedgerFills",
+			Handler:    _GoCryptoTraderService_GetTradeLedgerFills_Handler,
+		},
+		{
+			MethodName: "GetTradeLedgerLots",
+			Handler:    _GoCryptoTraderService_GetTradeLedgerLots_Handler,
+		},
+		{
+			MethodName: "GetTaxReport",
+			Handler:    _GoCryptoTraderService_GetTaxReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4467,6 +5263,11 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCarrySnapshotStream",
+			Handler:       _GoCryptoTraderService_GetCarrySnapshotStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
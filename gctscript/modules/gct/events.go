@@ -0,0 +1,68 @@
+package gct
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+// EventToObject converts an event to the map passed to a script handler
+func EventToObject(e *modules.Event) (objects.Object, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%T %w", e, common.ErrNilPointer)
+	}
+	var data objects.Object
+	switch d := e.Data.(type) {
+	case *ticker.Price:
+		data = tickerObject(d)
+	case *orderbook.Book:
+		data = orderbookObject(d)
+	case []trade.Data:
+		data = tradesObject(d)
+	case *order.Detail:
+		data = orderObject(d)
+	case *accounts.SubAccount:
+		funds := &objects.Array{}
+		for curr, bal := range d.Balances {
+			funds.Value = append(funds.Value, &objects.Map{Value: map[string]objects.Object{
+				"name":  &objects.String{Value: curr.String()},
+				"total": &objects.Float{Value: bal.Total},
+				"hold":  &objects.Float{Value: bal.Hold},
+				"free":  &objects.Float{Value: bal.Free},
+			}})
+		}
+		data = &objects.Map{Value: map[string]objects.Object{
+			"id":         &objects.String{Value: d.ID},
+			"asset":      &objects.String{Value: d.AssetType.String()},
+			"currencies": funds,
+		}}
+	case *modules.Message:
+		value, err := objects.FromInterface(d.Value)
+		if err != nil {
+			return nil, err
+		}
+		data = &objects.Map{Value: map[string]objects.Object{
+			"topic": &objects.String{Value: d.Topic},
+			"from":  &objects.String{Value: d.From},
+			"value": value,
+		}}
+	default:
+		return nil, common.GetTypeAssertError("event data", e.Data, e.Type)
+	}
+
+	return &objects.Map{Value: map[string]objects.Object{
+		"type":     &objects.String{Value: e.Type},
+		"exchange": &objects.String{Value: e.Exchange},
+		"pair":     &objects.String{Value: e.Pair.String()},
+		"asset":    &objects.String{Value: e.Asset.String()},
+		"time":     &objects.Time{Value: e.Time},
+		"data":     data,
+	}}, nil
+}
@@ -0,0 +1,37 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+func TestEventToObject(t *testing.T) {
+	t.Parallel()
+	_, err := EventToObject(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = EventToObject(&modules.Event{Type: modules.EventTicker, Data: "meow"})
+	assert.ErrorIs(t, err, common.ErrTypeAssertFailure)
+
+	p := currency.NewBTCUSDT()
+	for _, eventType := range []string{modules.EventTicker, modules.EventOrderbook, modules.EventTrade, modules.EventFill, modules.EventBalance} {
+		ch, err := modules.Wrapper.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: eventType, Exchange: exch.Value, Pair: p, Asset: asset.Spot})
+		require.NoErrorf(t, err, "SubscribeEvents must not error for %s", eventType)
+		obj, err := EventToObject(<-ch)
+		require.NoErrorf(t, err, "EventToObject must not error for %s", eventType)
+		m, ok := obj.(*objects.Map)
+		require.True(t, ok, "EventToObject must return a map")
+		assert.Equal(t, eventType, objects.ToInterface(m.Value["type"]), "type should be set")
+		assert.Equal(t, exch.Value, objects.ToInterface(m.Value["exchange"]), "exchange should be set")
+		assert.Equal(t, p.String(), objects.ToInterface(m.Value["pair"]), "pair should be set")
+		assert.Equal(t, "spot", objects.ToInterface(m.Value["asset"]), "asset should be set")
+		assert.NotEqual(t, objects.UndefinedValue, m.Value["data"], "data should be set")
+	}
+}
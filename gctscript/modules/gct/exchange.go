@@ -10,39 +10,65 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
-	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 const (
-	orderbookFunc       = "orderbook"
-	tickerFunc          = "ticker"
-	exchangesFunc       = "exchanges"
-	pairsFunc           = "pairs"
-	accountBalancesFunc = "accountbalances"
-	depositAddressFunc  = "depositaddress"
-	orderQueryFunc      = "orderquery"
-	orderCancelFunc     = "ordercancel"
-	orderSubmitFunc     = "ordersubmit"
-	withdrawCryptoFunc  = "withdrawcrypto"
-	withdrawFiatFunc    = "withdrawfiat"
-	ohlcvFunc           = "ohlcv"
+	orderbookFunc         = "orderbook"
+	tickerFunc            = "ticker"
+	exchangesFunc         = "exchanges"
+	pairsFunc             = "pairs"
+	accountBalancesFunc   = "accountbalances"
+	depositAddressFunc    = "depositaddress"
+	orderQueryFunc        = "orderquery"
+	orderCancelFunc       = "ordercancel"
+	orderSubmitFunc       = "ordersubmit"
+	withdrawCryptoFunc    = "withdrawcrypto"
+	withdrawFiatFunc      = "withdrawfiat"
+	ohlcvFunc             = "ohlcv"
+	orderModifyFunc       = "ordermodify"
+	orderCancelAllFunc    = "ordercancelall"
+	activeOrdersFunc      = "activeorders"
+	orderHistoryFunc      = "orderhistory"
+	tradesFunc            = "trades"
+	positionsFunc         = "positions"
+	fundingRateFunc       = "fundingrate"
+	fundingRatesFunc      = "fundingrates"
+	openInterestFunc      = "openinterest"
+	setLeverageFunc       = "setleverage"
+	optionAnalyticsFunc   = "optionanalytics"
+	volatilitySurfaceFunc = "volatilitysurface"
 )
 
 var exchangeModule = map[string]objects.Object{
-	orderbookFunc:       &objects.UserFunction{Name: orderbookFunc, Value: ExchangeOrderbook},
-	tickerFunc:          &objects.UserFunction{Name: tickerFunc, Value: ExchangeTicker},
-	exchangesFunc:       &objects.UserFunction{Name: exchangesFunc, Value: ExchangeExchanges},
-	pairsFunc:           &objects.UserFunction{Name: pairsFunc, Value: ExchangePairs},
-	accountBalancesFunc: &objects.UserFunction{Name: accountBalancesFunc, Value: ExchangeAccountBalances},
-	depositAddressFunc:  &objects.UserFunction{Name: depositAddressFunc, Value: ExchangeDepositAddress},
-	orderQueryFunc:      &objects.UserFunction{Name: orderQueryFunc, Value: ExchangeOrderQuery},
-	orderCancelFunc:     &objects.UserFunction{Name: orderCancelFunc, Value: ExchangeOrderCancel},
-	orderSubmitFunc:     &objects.UserFunction{Name: orderSubmitFunc, Value: ExchangeOrderSubmit},
-	withdrawCryptoFunc:  &objects.UserFunction{Name: withdrawCryptoFunc, Value: ExchangeWithdrawCrypto},
-	withdrawFiatFunc:    &objects.UserFunction{Name: withdrawFiatFunc, Value: ExchangeWithdrawFiat},
-	ohlcvFunc:           &objects.UserFunction{Name: ohlcvFunc, Value: exchangeOHLCV},
+	orderbookFunc:         &objects.UserFunction{Name: orderbookFunc, Value: ExchangeOrderbook},
+	tickerFunc:            &objects.UserFunction{Name: tickerFunc, Value: ExchangeTicker},
+	exchangesFunc:         &objects.UserFunction{Name: exchangesFunc, Value: ExchangeExchanges},
+	pairsFunc:             &objects.UserFunction{Name: pairsFunc, Value: ExchangePairs},
+	accountBalancesFunc:   &objects.UserFunction{Name: accountBalancesFunc, Value: ExchangeAccountBalances},
+	depositAddressFunc:    &objects.UserFunction{Name: depositAddressFunc, Value: ExchangeDepositAddress},
+	orderQueryFunc:        &objects.UserFunction{Name: orderQueryFunc, Value: ExchangeOrderQuery},
+	orderCancelFunc:       &objects.UserFunction{Name: orderCancelFunc, Value: ExchangeOrderCancel},
+	orderSubmitFunc:       &objects.UserFunction{Name: orderSubmitFunc, Value: ExchangeOrderSubmit},
+	withdrawCryptoFunc:    &objects.UserFunction{Name: withdrawCryptoFunc, Value: ExchangeWithdrawCrypto},
+	withdrawFiatFunc:      &objects.UserFunction{Name: withdrawFiatFunc, Value: ExchangeWithdrawFiat},
+	ohlcvFunc:             &objects.UserFunction{Name: ohlcvFunc, Value: exchangeOHLCV},
+	orderModifyFunc:       &objects.UserFunction{Name: orderModifyFunc, Value: ExchangeOrderModify},
+	orderCancelAllFunc:    &objects.UserFunction{Name: orderCancelAllFunc, Value: ExchangeOrderCancelAll},
+	activeOrdersFunc:      &objects.UserFunction{Name: activeOrdersFunc, Value: ExchangeActiveOrders},
+	orderHistoryFunc:      &objects.UserFunction{Name: orderHistoryFunc, Value: ExchangeOrderHistory},
+	tradesFunc:            &objects.UserFunction{Name: tradesFunc, Value: ExchangeTrades},
+	positionsFunc:         &objects.UserFunction{Name: positionsFunc, Value: ExchangePositions},
+	fundingRateFunc:       &objects.UserFunction{Name: fundingRateFunc, Value: ExchangeFundingRate},
+	fundingRatesFunc:      &objects.UserFunction{Name: fundingRatesFunc, Value: ExchangeFundingRates},
+	openInterestFunc:      &objects.UserFunction{Name: openInterestFunc, Value: ExchangeOpenInterest},
+	setLeverageFunc:       &objects.UserFunction{Name: setLeverageFunc, Value: ExchangeSetLeverage},
+	optionAnalyticsFunc:   &objects.UserFunction{Name: optionAnalyticsFunc, Value: ExchangeOptionAnalytics},
+	volatilitySurfaceFunc: &objects.UserFunction{Name: volatilitySurfaceFunc, Value: ExchangeVolatilitySurface},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -83,35 +109,12 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ob, err := wrappers.GetWrapper().Orderbook(ctx, exchangeName, pair, assetType)
+	ob, err := scriptCtx.exchangeWrapper().Orderbook(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
-	for x := range ob.Asks {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
-		asks.Value[x] = &objects.Map{Value: temp}
-	}
-
-	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
-	for x := range ob.Bids {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
-		bids.Value[x] = &objects.Map{Value: temp}
-	}
-
-	data := make(map[string]objects.Object, 5)
-	data["exchange"] = &objects.String{Value: ob.Exchange}
-	data["pair"] = &objects.String{Value: ob.Pair.String()}
-	data["asks"] = &asks
-	data["bids"] = &bids
-	data["asset"] = &objects.String{Value: ob.Asset.String()}
-
-	return &objects.Map{Value: data}, nil
+	return orderbookObject(ob), nil
 }
 
 // ExchangeTicker returns ticker data for requested exchange and currency pair
@@ -153,34 +156,22 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	tx, err := wrappers.GetWrapper().Ticker(ctx, exchangeName, pair, assetType)
+	tx, err := scriptCtx.exchangeWrapper().Ticker(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: tx.ExchangeName}
-	data["last"] = &objects.Float{Value: tx.Last}
-	data["High"] = &objects.Float{Value: tx.High}
-	data["Low"] = &objects.Float{Value: tx.Low}
-	data["bid"] = &objects.Float{Value: tx.Bid}
-	data["ask"] = &objects.Float{Value: tx.Ask}
-	data["volume"] = &objects.Float{Value: tx.Volume}
-	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
-	data["priceath"] = &objects.Float{Value: tx.PriceATH}
-	data["open"] = &objects.Float{Value: tx.Open}
-	data["close"] = &objects.Float{Value: tx.Close}
-	data["pair"] = &objects.String{Value: tx.Pair.String()}
-	data["asset"] = &objects.String{Value: tx.AssetType.String()}
-	data["updated"] = &objects.Time{Value: tx.LastUpdated}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return tickerObject(tx), nil
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
 func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+	return exchangeExchanges(nil, args...)
+}
+
+// exchangeExchanges returns list of exchanges either enabled or all from the
+// wrapper of the script context
+func exchangeExchanges(scriptCtx *Context, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -189,7 +180,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, constructRuntimeError(1, exchangesFunc, "bool", args[0])
 	}
-	rtnValue := wrappers.GetWrapper().Exchanges(enabledOnly)
+	rtnValue := scriptCtx.exchangeWrapper().Exchanges(enabledOnly)
 
 	r := objects.Array{
 		Value: make([]objects.Object, len(rtnValue)),
@@ -203,6 +194,12 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 
 // ExchangePairs returns currency pairs for requested exchange
 func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+	return exchangePairs(nil, args...)
+}
+
+// exchangePairs returns currency pairs for requested exchange from the
+// wrapper of the script context
+func exchangePairs(scriptCtx *Context, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -224,7 +221,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	pairs, err := wrappers.GetWrapper().Pairs(exchangeName, enabledOnly, assetType)
+	pairs, err := scriptCtx.exchangeWrapper().Pairs(exchangeName, enabledOnly, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -263,7 +260,7 @@ func ExchangeAccountBalances(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtnValue, err := wrappers.GetWrapper().AccountBalances(ctx, exchangeName, assetType)
+	rtnValue, err := scriptCtx.exchangeWrapper().AccountBalances(ctx, exchangeName, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -333,7 +330,7 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	orderDetails, err := wrappers.GetWrapper().
+	orderDetails, err := scriptCtx.exchangeWrapper().
 		QueryOrder(ctx, exchangeName, orderID, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -424,7 +421,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	isCancelled, err := wrappers.GetWrapper().
+	isCancelled, err := scriptCtx.exchangeWrapper().
 		CancelOrder(ctx, exchangeName, orderID, cp, a)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -515,7 +512,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().SubmitOrder(ctx, tempSubmit)
+	rtn, err := scriptCtx.exchangeWrapper().SubmitOrder(ctx, tempSubmit)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -527,8 +524,266 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	return &objects.Map{Value: data}, nil
 }
 
+// ExchangeOrderModify amends the price and amount of an open order
+func ExchangeOrderModify(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderModifyFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderModifyFunc, "string", args[1])
+	}
+	orderID, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderModifyFunc, "string", args[2])
+	}
+	if orderID == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "orderID")
+	}
+	currencyPair, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderModifyFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderModifyFunc, "string", args[4])
+	}
+	price, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderModifyFunc, "float64", args[5])
+	}
+	amount, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, orderModifyFunc, "float64", args[6])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().ModifyOrder(ctx, &order.Modify{
+		Exchange:  exchangeName,
+		OrderID:   orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, 10)
+	data["exchange"] = &objects.String{Value: rtn.Exchange}
+	data["id"] = &objects.String{Value: rtn.OrderID}
+	data["currencypair"] = &objects.String{Value: rtn.Pair.String()}
+	data["asset"] = &objects.String{Value: rtn.AssetType.String()}
+	data["side"] = &objects.String{Value: rtn.Side.String()}
+	data["type"] = &objects.String{Value: rtn.Type.String()}
+	data["status"] = &objects.String{Value: rtn.Status.String()}
+	data["price"] = &objects.Float{Value: rtn.Price}
+	data["amount"] = &objects.Float{Value: rtn.Amount}
+	data["amountremaining"] = &objects.Float{Value: rtn.RemainingAmount}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeOrderCancelAll cancels all open orders for an asset type on
+// requested exchange, an empty pair cancels orders for all pairs
+func ExchangeOrderCancelAll(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderCancelAllFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderCancelAllFunc, "string", args[1])
+	}
+	if exchangeName == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "exchange name")
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderCancelAllFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderCancelAllFunc, "string", args[3])
+	}
+
+	pair, err := optionalPair(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().CancelAllOrders(ctx, &order.Cancel{
+		Exchange:  exchangeName,
+		Pair:      pair,
+		AssetType: assetType,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, len(rtn.Status))
+	for id, status := range rtn.Status {
+		data[id] = &objects.String{Value: status}
+	}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeActiveOrders returns open orders for an asset type on requested
+// exchange, an empty pair returns orders for all pairs
+func ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, activeOrdersFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, activeOrdersFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, activeOrdersFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, activeOrdersFunc, "string", args[3])
+	}
+
+	req, err := multiOrderRequest(currencyPair, assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().ActiveOrders(ctx, exchangeName, req)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersObject(rtn), nil
+}
+
+// ExchangeOrderHistory returns closed orders for an asset type on requested
+// exchange between start and end, an empty pair returns orders for all pairs
+func ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderHistoryFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderHistoryFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderHistoryFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderHistoryFunc, "string", args[3])
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderHistoryFunc, "time.Time", args[4])
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderHistoryFunc, "time.Time", args[5])
+	}
+
+	req, err := multiOrderRequest(currencyPair, assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	req.StartTime = startTime
+	req.EndTime = endTime
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().OrderHistory(ctx, exchangeName, req)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersObject(rtn), nil
+}
+
+// ExchangeTrades returns recent public trades for requested exchange and
+// currency pair
+func ExchangeTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, tradesFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, tradesFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, tradesFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, tradesFunc, "string", args[3])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().RecentTrades(ctx, exchangeName, pair, assetType)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return tradesObject(rtn), nil
+}
+
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+	return exchangeDepositAddress(nil, args...)
+}
+
+// exchangeDepositAddress returns deposit address (if supported by exchange)
+// from the wrapper of the script context
+func exchangeDepositAddress(scriptCtx *Context, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -548,7 +803,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, chain, currCode)
+	rtn, err := scriptCtx.exchangeWrapper().DepositAddress(exchangeName, chain, currCode)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -611,7 +866,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(ctx, withdrawRequest)
+	rtn, err := scriptCtx.exchangeWrapper().WithdrawalCryptoFunds(ctx, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -658,7 +913,7 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().
+	rtn, err := scriptCtx.exchangeWrapper().
 		WithdrawalFiatFunds(ctx, bankAccountID, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -731,7 +986,7 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ret, err := wrappers.GetWrapper().
+	ret, err := scriptCtx.exchangeWrapper().
 		OHLCV(ctx,
 			exchangeName,
 			pair,
@@ -784,3 +1039,129 @@ func parseInterval(in string) (time.Duration, error) {
 	}
 	return time.ParseDuration(in)
 }
+
+// optionalPair parses a currency pair, returning an empty pair when none is
+// provided
+func optionalPair(currencyPair string) (currency.Pair, error) {
+	if currencyPair == "" {
+		return currency.EMPTYPAIR, nil
+	}
+	return currency.NewPairFromString(currencyPair)
+}
+
+// multiOrderRequest returns a request matching orders of any side and type
+func multiOrderRequest(currencyPair, assetTypeParam string) (*order.MultiOrderRequest, error) {
+	pair, err := optionalPair(currencyPair)
+	if err != nil {
+		return nil, err
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return nil, err
+	}
+	req := &order.MultiOrderRequest{
+		AssetType: assetType,
+		Side:      order.AnySide,
+		Type:      order.AnyType,
+	}
+	if !pair.IsEmpty() {
+		req.Pairs = currency.Pairs{pair}
+	}
+	return req, nil
+}
+
+// tickerObject converts a ticker to a script map
+func tickerObject(tx *ticker.Price) objects.Object {
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: tx.ExchangeName}
+	data["last"] = &objects.Float{Value: tx.Last}
+	data["High"] = &objects.Float{Value: tx.High}
+	data["Low"] = &objects.Float{Value: tx.Low}
+	data["bid"] = &objects.Float{Value: tx.Bid}
+	data["ask"] = &objects.Float{Value: tx.Ask}
+	data["volume"] = &objects.Float{Value: tx.Volume}
+	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
+	data["priceath"] = &objects.Float{Value: tx.PriceATH}
+	data["open"] = &objects.Float{Value: tx.Open}
+	data["close"] = &objects.Float{Value: tx.Close}
+	data["pair"] = &objects.String{Value: tx.Pair.String()}
+	data["asset"] = &objects.String{Value: tx.AssetType.String()}
+	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+	return &objects.Map{Value: data}
+}
+
+// orderbookObject converts an orderbook to a script map
+func orderbookObject(ob *orderbook.Book) objects.Object {
+	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
+	for x := range ob.Asks {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
+		asks.Value[x] = &objects.Map{Value: temp}
+	}
+
+	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
+	for x := range ob.Bids {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
+		bids.Value[x] = &objects.Map{Value: temp}
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: ob.Exchange}
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asks"] = &asks
+	data["bids"] = &bids
+	data["asset"] = &objects.String{Value: ob.Asset.String()}
+	return &objects.Map{Value: data}
+}
+
+// orderObject converts order details to a script map
+func orderObject(d *order.Detail) objects.Object {
+	return &objects.Map{Value: map[string]objects.Object{
+		"exchange":        &objects.String{Value: d.Exchange},
+		"id":              &objects.String{Value: d.OrderID},
+		"clientorderid":   &objects.String{Value: d.ClientOrderID},
+		"account":         &objects.String{Value: d.Account},
+		"currencypair":    &objects.String{Value: d.Pair.String()},
+		"asset":           &objects.String{Value: d.AssetType.String()},
+		"side":            &objects.String{Value: d.Side.String()},
+		"type":            &objects.String{Value: d.Type.String()},
+		"status":          &objects.String{Value: d.Status.String()},
+		"price":           &objects.Float{Value: d.Price},
+		"averageprice":    &objects.Float{Value: d.AverageExecutedPrice},
+		"amount":          &objects.Float{Value: d.Amount},
+		"amountexecuted":  &objects.Float{Value: d.ExecutedAmount},
+		"amountremaining": &objects.Float{Value: d.RemainingAmount},
+		"fee":             &objects.Float{Value: d.Fee},
+		"date":            &objects.Time{Value: d.Date},
+		"updated":         &objects.Time{Value: d.LastUpdated},
+	}}
+}
+
+// ordersObject converts a slice of order details to a script array
+func ordersObject(d []order.Detail) objects.Object {
+	orders := &objects.Array{Value: make([]objects.Object, len(d))}
+	for i := range d {
+		orders.Value[i] = orderObject(&d[i])
+	}
+	return orders
+}
+
+// tradesObject converts public trades to a script array
+func tradesObject(d []trade.Data) objects.Object {
+	trades := &objects.Array{Value: make([]objects.Object, len(d))}
+	for i := range d {
+		trades.Value[i] = &objects.Map{Value: map[string]objects.Object{
+			"id":        &objects.String{Value: d[i].TID},
+			"pair":      &objects.String{Value: d[i].CurrencyPair.String()},
+			"asset":     &objects.String{Value: d[i].AssetType.String()},
+			"side":      &objects.String{Value: d[i].Side.String()},
+			"price":     &objects.Float{Value: d[i].Price},
+			"amount":    &objects.Float{Value: d[i].Amount},
+			"timestamp": &objects.Time{Value: d[i].Timestamp},
+		}}
+	}
+	return trades
+}
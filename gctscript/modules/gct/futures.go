@@ -0,0 +1,341 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ExchangePositions returns futures position orders for requested exchange
+// and currency pair between start and end
+func ExchangePositions(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, positionsFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, positionsFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, positionsFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, positionsFunc, "string", args[3])
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, positionsFunc, "time.Time", args[4])
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, positionsFunc, "time.Time", args[5])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().FuturesPositions(ctx, exchangeName, &futures.PositionsRequest{
+		Asset:     assetType,
+		Pairs:     currency.Pairs{pair},
+		StartDate: startTime,
+		EndDate:   endTime,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	positions := &objects.Array{Value: make([]objects.Object, len(rtn))}
+	for i := range rtn {
+		positions.Value[i] = &objects.Map{Value: map[string]objects.Object{
+			"exchange": &objects.String{Value: rtn[i].Exchange},
+			"pair":     &objects.String{Value: rtn[i].Pair.String()},
+			"asset":    &objects.String{Value: rtn[i].Asset.String()},
+			"orders":   ordersObject(rtn[i].Orders),
+		}}
+	}
+	return positions, nil
+}
+
+// ExchangeFundingRate returns the latest funding rate for requested exchange
+// and perpetual contract
+func ExchangeFundingRate(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, fundingRateFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, fundingRateFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, fundingRateFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, fundingRateFunc, "string", args[3])
+	}
+	includePredicted, ok := objects.ToBool(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, fundingRateFunc, "bool", args[4])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().LatestFundingRates(ctx, exchangeName, &fundingrate.LatestRateRequest{
+		Asset:                assetType,
+		Pair:                 pair,
+		IncludePredictedRate: includePredicted,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	rates := &objects.Array{Value: make([]objects.Object, len(rtn))}
+	for i := range rtn {
+		rates.Value[i] = &objects.Map{Value: map[string]objects.Object{
+			"exchange":      &objects.String{Value: rtn[i].Exchange},
+			"pair":          &objects.String{Value: rtn[i].Pair.String()},
+			"asset":         &objects.String{Value: rtn[i].Asset.String()},
+			"rate":          fundingRateObject(&rtn[i].LatestRate),
+			"predictedrate": fundingRateObject(&rtn[i].PredictedUpcomingRate),
+			"nextrate":      &objects.Time{Value: rtn[i].TimeOfNextRate},
+			"checked":       &objects.Time{Value: rtn[i].TimeChecked},
+		}}
+	}
+	return rates, nil
+}
+
+// ExchangeFundingRates returns funding rates for requested exchange and
+// perpetual contract between start and end
+func ExchangeFundingRates(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, fundingRatesFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, fundingRatesFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, fundingRatesFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, fundingRatesFunc, "string", args[3])
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, fundingRatesFunc, "time.Time", args[4])
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, fundingRatesFunc, "time.Time", args[5])
+	}
+	includePayments, ok := objects.ToBool(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, fundingRatesFunc, "bool", args[6])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().HistoricalFundingRates(ctx, exchangeName, &fundingrate.HistoricalRatesRequest{
+		Asset:                assetType,
+		Pair:                 pair,
+		StartDate:            startTime,
+		EndDate:              endTime,
+		IncludePayments:      includePayments,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	rates := &objects.Array{Value: make([]objects.Object, len(rtn.FundingRates))}
+	for i := range rtn.FundingRates {
+		rates.Value[i] = fundingRateObject(&rtn.FundingRates[i])
+	}
+
+	data := make(map[string]objects.Object, 8)
+	data["exchange"] = &objects.String{Value: rtn.Exchange}
+	data["pair"] = &objects.String{Value: rtn.Pair.String()}
+	data["asset"] = &objects.String{Value: rtn.Asset.String()}
+	data["rates"] = rates
+	data["latestrate"] = fundingRateObject(&rtn.LatestRate)
+	data["paymentsum"] = &objects.Float{Value: rtn.PaymentSum.InexactFloat64()}
+	data["paymentcurrency"] = &objects.String{Value: rtn.PaymentCurrency.String()}
+	data["nextrate"] = &objects.Time{Value: rtn.TimeOfNextRate}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeOpenInterest returns open interest for requested exchange and
+// futures pair, an empty pair returns open interest for all enabled pairs
+func ExchangeOpenInterest(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, openInterestFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, openInterestFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, openInterestFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, openInterestFunc, "string", args[3])
+	}
+
+	pair, err := optionalPair(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	var keys []key.PairAsset
+	if !pair.IsEmpty() {
+		assetType, err := asset.New(assetTypeParam)
+		if err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+		keys = append(keys, key.PairAsset{Base: pair.Base.Item, Quote: pair.Quote.Item, Asset: assetType})
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := scriptCtx.exchangeWrapper().OpenInterest(ctx, exchangeName, keys...)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	interest := &objects.Array{Value: make([]objects.Object, len(rtn))}
+	for i := range rtn {
+		interest.Value[i] = &objects.Map{Value: map[string]objects.Object{
+			"exchange":     &objects.String{Value: rtn[i].Key.Exchange},
+			"pair":         &objects.String{Value: rtn[i].Key.Pair().String()},
+			"asset":        &objects.String{Value: rtn[i].Key.Asset.String()},
+			"openinterest": &objects.Float{Value: rtn[i].OpenInterest},
+		}}
+	}
+	return interest, nil
+}
+
+// ExchangeSetLeverage sets the leverage of a futures pair on requested
+// exchange, side may be empty for exchanges which do not set leverage by side
+func ExchangeSetLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, setLeverageFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, setLeverageFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, setLeverageFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, setLeverageFunc, "string", args[3])
+	}
+	marginTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, setLeverageFunc, "string", args[4])
+	}
+	leverage, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, setLeverageFunc, "float64", args[5])
+	}
+	orderSide, ok := objects.ToString(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, setLeverageFunc, "string", args[6])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	marginType, err := margin.StringToMarginType(marginTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	var side order.Side
+	if orderSide != "" {
+		side, err = order.StringToOrderSide(orderSide)
+		if err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	err = scriptCtx.exchangeWrapper().SetLeverage(ctx, exchangeName, assetType, pair, marginType, leverage, side)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// fundingRateObject converts a funding rate to a script map
+func fundingRateObject(r *fundingrate.Rate) objects.Object {
+	return &objects.Map{Value: map[string]objects.Object{
+		"time":    &objects.Time{Value: r.Time},
+		"rate":    &objects.Float{Value: r.Rate.InexactFloat64()},
+		"payment": &objects.Float{Value: r.Payment.InexactFloat64()},
+	}}
+}
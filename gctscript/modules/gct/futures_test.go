@@ -0,0 +1,121 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var futuresAsset = &objects.String{Value: "perpetualcontract"}
+
+func TestExchangePositions(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangePositions()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	r, err := ExchangePositions(ctx, exch, currencyPair, futuresAsset, start, end)
+	require.NoError(t, err, "ExchangePositions must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangePositions must return an array")
+	require.Len(t, a.Value, 1, "ExchangePositions must return a position")
+	orders, ok := a.Value[0].(*objects.Map).Value["orders"].(*objects.Array)
+	require.True(t, ok, "position orders must be an array")
+	assert.Len(t, orders.Value, 1, "position should contain its orders")
+
+	r, err = ExchangePositions(ctx, exch, currencyPair, assetType, start, end)
+	require.NoError(t, err, "ExchangePositions must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangePositions should return an error object for a spot asset")
+}
+
+func TestExchangeFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRate()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeFundingRate(exch, exch, currencyPair, futuresAsset, tv)
+	assert.Error(t, err, "ExchangeFundingRate should error on an invalid context")
+
+	r, err := ExchangeFundingRate(ctx, exch, currencyPair, futuresAsset, tv)
+	require.NoError(t, err, "ExchangeFundingRate must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangeFundingRate must return an array")
+	require.Len(t, a.Value, 1, "ExchangeFundingRate must return a rate")
+	rate, ok := a.Value[0].(*objects.Map).Value["rate"].(*objects.Map)
+	require.True(t, ok, "rate must be a map")
+	assert.NotZero(t, rate.Value["rate"].(*objects.Float).Value, "rate should be set")
+
+	r, err = ExchangeFundingRate(ctx, exch, currencyPair, assetType, tv)
+	require.NoError(t, err, "ExchangeFundingRate must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeFundingRate should return an error object for a spot asset")
+}
+
+func TestExchangeFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRates()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	end := time.Now()
+	start := &objects.Time{Value: end.Add(-time.Hour * 24)}
+	r, err := ExchangeFundingRates(ctx, exch, currencyPair, futuresAsset, start, &objects.Time{Value: end}, tv)
+	require.NoError(t, err, "ExchangeFundingRates must not error")
+	m, ok := r.(*objects.Map)
+	require.True(t, ok, "ExchangeFundingRates must return a map")
+	rates, ok := m.Value["rates"].(*objects.Array)
+	require.True(t, ok, "rates must be an array")
+	assert.NotEmpty(t, rates.Value, "ExchangeFundingRates should return rates")
+	assert.NotZero(t, m.Value["paymentsum"].(*objects.Float).Value, "payment sum should be set when payments are included")
+
+	r, err = ExchangeFundingRates(ctx, exch, currencyPair, futuresAsset, &objects.Time{Value: end}, start, tv)
+	require.NoError(t, err, "ExchangeFundingRates must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeFundingRates should return an error object when start is after end")
+}
+
+func TestExchangeOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOpenInterest()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	r, err := ExchangeOpenInterest(ctx, exch, currencyPair, futuresAsset)
+	require.NoError(t, err, "ExchangeOpenInterest must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangeOpenInterest must return an array")
+	require.Len(t, a.Value, 1, "ExchangeOpenInterest must return the requested pair")
+	assert.Equal(t, &objects.String{Value: "BTCAUD"}, a.Value[0].(*objects.Map).Value["pair"], "pair should match the request")
+
+	r, err = ExchangeOpenInterest(ctx, exch, blank, blank)
+	require.NoError(t, err, "ExchangeOpenInterest must not error")
+	assert.IsType(t, &objects.Array{}, r, "ExchangeOpenInterest should return all pairs")
+
+	r, err = ExchangeOpenInterest(ctx, exch, currencyPair, blank)
+	require.NoError(t, err, "ExchangeOpenInterest must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOpenInterest should return an error object on an invalid asset")
+}
+
+func TestExchangeSetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetLeverage()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	isolated := &objects.String{Value: "isolated"}
+	leverage := &objects.Float{Value: 5}
+	r, err := ExchangeSetLeverage(ctx, exch, currencyPair, futuresAsset, isolated, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.Equal(t, tv, r, "ExchangeSetLeverage should return true")
+
+	r, err = ExchangeSetLeverage(ctx, exch, currencyPair, futuresAsset, isolated, leverage, &objects.String{Value: "long"})
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.Equal(t, tv, r, "ExchangeSetLeverage should return true")
+
+	r, err = ExchangeSetLeverage(ctx, exch, currencyPair, futuresAsset, exch, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeSetLeverage should return an error object on an invalid margin type")
+
+	r, err = ExchangeSetLeverage(ctx, exch, currencyPair, assetType, isolated, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeSetLeverage should return an error object for a spot asset")
+}
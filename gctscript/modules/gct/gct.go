@@ -2,10 +2,13 @@ package gct
 
 import (
 	"context"
+	"maps"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 )
 
 const (
@@ -48,10 +51,12 @@ func setVerbose(args ...objects.Object) (objects.Object, error) {
 }
 
 // setAccount sets account details which overrides default credentials for
-// script account management, api key and secret are required.
+// script account management, api key and secret are required. Alternatively a
+// credential profile configured for the exchange can be selected by name.
 // Params: scriptCTX, apiKey, apiSecret, subAccount, clientID, PEMKey, OneTimePassword string
+// Params: scriptCTX, profileName string
 func setAccount(args ...objects.Object) (objects.Object, error) {
-	if len(args) < 3 || len(args) > 7 {
+	if len(args) < 2 || len(args) > 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
 
@@ -60,6 +65,19 @@ func setAccount(args ...objects.Object) (objects.Object, error) {
 		return nil, constructRuntimeError(1, setAccountFunc, "*gct.Context", args[0])
 	}
 
+	if len(args) == 2 {
+		var profile string
+		profile, ok = objects.ToInterface(args[1]).(string)
+		if !ok {
+			return nil, constructRuntimeError(2, setAccountFunc, "string", args[1])
+		}
+		if ctx.Value == nil {
+			ctx.Value = make(map[string]objects.Object)
+		}
+		ctx.Value["account"] = &objects.String{Value: profile}
+		return ctx, nil
+	}
+
 	apikey, ok := objects.ToInterface(args[1]).(string)
 	if !ok {
 		return nil, constructRuntimeError(2, setAccountFunc, "string", args[1])
@@ -161,6 +179,11 @@ func processScriptContext(scriptCtx *Context) context.Context {
 		ctx = request.WithVerbose(ctx)
 	}
 
+	if object = scriptCtx.Value["account"]; object != nil {
+		account, _ := objects.ToString(object)
+		ctx = accounts.DeployAccountToContext(ctx, account)
+	}
+
 	if object = scriptCtx.Value["apikey"]; object != nil {
 		key, _ := objects.ToString(object)
 
@@ -208,3 +231,63 @@ func processScriptContext(scriptCtx *Context) context.Context {
 func (c *Context) TypeName() string {
 	return "scriptContext"
 }
+
+// SetWrapper sets the exchange wrapper used by the script in place of the
+// default wrapper, scripts with a wrapper set do not publish messages
+func (c *Context) SetWrapper(w modules.GCTExchange) {
+	c.wrapper = w
+}
+
+// SetStateStore sets the state store used by the script in place of the
+// default store
+func (c *Context) SetStateStore(s StateStore) {
+	c.state = s
+}
+
+// ContextModules returns the modules with the exchange functions which are
+// not passed a script context bound to the context, so every exchange call of
+// the script uses its wrapper
+func ContextModules(c *Context) map[string]map[string]objects.Object {
+	exch := maps.Clone(exchangeModule)
+	for name, fn := range map[string]func(*Context, ...objects.Object) (objects.Object, error){
+		exchangesFunc:      exchangeExchanges,
+		pairsFunc:          exchangePairs,
+		depositAddressFunc: exchangeDepositAddress,
+	} {
+		exch[name] = &objects.UserFunction{Name: name, Value: func(args ...objects.Object) (objects.Object, error) {
+			return fn(c, args...)
+		}}
+	}
+	m := maps.Clone(Modules)
+	m["exchange"] = exch
+	return m
+}
+
+// exchangeWrapper returns the wrapper the script should use
+func (c *Context) exchangeWrapper() modules.GCTExchange {
+	if c == nil {
+		return wrappers.GetWrapper()
+	}
+	w := c.wrapper
+	if w == nil {
+		w = wrappers.GetWrapper()
+	}
+	if c.policy != nil {
+		return &policyWrapper{GCTExchange: w, ctx: c, state: c.policy}
+	}
+	return w
+}
+
+// stateStore returns the state store the script should use
+func (c *Context) stateStore() StateStore {
+	if c == nil || c.state == nil {
+		return State
+	}
+	return c.state
+}
+
+// simulated returns whether the script is running against a wrapper other
+// than the default
+func (c *Context) simulated() bool {
+	return c != nil && c.wrapper != nil
+}
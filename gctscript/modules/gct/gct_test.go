@@ -183,6 +183,107 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestExchangeOrderModify(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderModify()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	price := &objects.Float{Value: 1}
+	amount := &objects.Float{Value: 2}
+	_, err = ExchangeOrderModify(ctx, exch, blank, currencyPair, assetType, price, amount)
+	assert.Error(t, err, "ExchangeOrderModify should error on an empty order ID")
+
+	r, err := ExchangeOrderModify(ctx, exch, orderID, currencyPair, assetType, price, amount)
+	require.NoError(t, err, "ExchangeOrderModify must not error")
+	m, ok := r.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderModify must return a map")
+	assert.Equal(t, orderID, m.Value["id"], "id should match the modified order")
+	assert.Equal(t, price, m.Value["price"], "price should be amended")
+	assert.Equal(t, amount, m.Value["amount"], "amount should be amended")
+
+	r, err = ExchangeOrderModify(ctx, exch, orderID, currencyPair, blank, price, amount)
+	require.NoError(t, err, "ExchangeOrderModify must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOrderModify should return an error object on an invalid asset")
+}
+
+func TestExchangeOrderCancelAll(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderCancelAll()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeOrderCancelAll(ctx, blank, currencyPair, assetType)
+	assert.Error(t, err, "ExchangeOrderCancelAll should error on an empty exchange name")
+
+	r, err := ExchangeOrderCancelAll(ctx, exch, blank, assetType)
+	require.NoError(t, err, "ExchangeOrderCancelAll must not error")
+	m, ok := r.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderCancelAll must return a map")
+	assert.NotEmpty(t, m.Value, "ExchangeOrderCancelAll should return cancelled order statuses")
+
+	r, err = ExchangeOrderCancelAll(ctx, exch, currencyPair, blank)
+	require.NoError(t, err, "ExchangeOrderCancelAll must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOrderCancelAll should return an error object on an invalid asset")
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeActiveOrders()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	r, err := ExchangeActiveOrders(ctx, exch, currencyPair, assetType)
+	require.NoError(t, err, "ExchangeActiveOrders must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangeActiveOrders must return an array")
+	require.Len(t, a.Value, 1, "ExchangeActiveOrders must return an order")
+	assert.Equal(t, &objects.String{Value: "BTC-AUD"}, a.Value[0].(*objects.Map).Value["currencypair"], "order pair should match the request")
+
+	r, err = ExchangeActiveOrders(ctx, exch, blank, assetType)
+	require.NoError(t, err, "ExchangeActiveOrders must not error")
+	assert.IsType(t, &objects.Array{}, r, "ExchangeActiveOrders should return an array for all pairs")
+
+	r, err = ExchangeActiveOrders(ctx, exch, currencyPair, blank)
+	require.NoError(t, err, "ExchangeActiveOrders must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeActiveOrders should return an error object on an invalid asset")
+}
+
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderHistory()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	_, err = ExchangeOrderHistory(ctx, exch, currencyPair, assetType, exch, end)
+	assert.Error(t, err, "ExchangeOrderHistory should error on an invalid start time")
+
+	r, err := ExchangeOrderHistory(ctx, exch, currencyPair, assetType, start, end)
+	require.NoError(t, err, "ExchangeOrderHistory must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangeOrderHistory must return an array")
+	require.Len(t, a.Value, 1, "ExchangeOrderHistory must return an order")
+	assert.Equal(t, &objects.String{Value: "FILLED"}, a.Value[0].(*objects.Map).Value["status"], "order history should return closed orders")
+
+	r, err = ExchangeOrderHistory(ctx, exch, currencyPair, blank, start, end)
+	require.NoError(t, err, "ExchangeOrderHistory must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOrderHistory should return an error object on an invalid asset")
+}
+
+func TestExchangeTrades(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeTrades()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	r, err := ExchangeTrades(ctx, exch, currencyPair, assetType)
+	require.NoError(t, err, "ExchangeTrades must not error")
+	a, ok := r.(*objects.Array)
+	require.True(t, ok, "ExchangeTrades must return an array")
+	assert.Len(t, a.Value, 1, "ExchangeTrades should return a trade")
+
+	r, err = ExchangeTrades(ctx, exch, currencyPair, blank)
+	require.NoError(t, err, "ExchangeTrades must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeTrades should return an error object on an invalid asset")
+}
+
 func TestAllModuleNames(t *testing.T) {
 	t.Parallel()
 	require.NotEmpty(t, AllModuleNames(), "AllModuleNames must not return an empty slice")
@@ -355,6 +456,15 @@ func TestSetAccount(t *testing.T) {
 	if val.String() != dummyStr.String() {
 		t.Fatal("should contain otp string in map")
 	}
+
+	_, err = setAccount(&Context{}, objects.TrueValue)
+	require.ErrorIs(t, err, common.ErrTypeAssertFailure)
+
+	resp, err = setAccount(&Context{}, &objects.String{Value: "hedging"})
+	require.NoError(t, err, "setAccount must not error when selecting a credential profile")
+	ctx, ok = objects.ToInterface(resp).(*Context)
+	require.True(t, ok, "response must be a *Context")
+	assert.Equal(t, "hedging", accounts.AccountFromContext(processScriptContext(ctx)), "credential profile should be selected")
 }
 
 func TestSetSubAccount(t *testing.T) {
@@ -2,6 +2,7 @@ package gct
 
 import (
 	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -18,9 +19,17 @@ var Modules = map[string]map[string]objects.Object{
 	"exchange": exchangeModule,
 	"common":   commonModule,
 	"global":   globalModules,
+	"state":    stateModule,
 }
 
 // Context defines a juncture for script context to go context awareness
 type Context struct {
 	objects.Map
+
+	// wrapper and state override the default exchange wrapper and state
+	// store for the script, they are set when a script is simulated
+	wrapper modules.GCTExchange
+	state   StateStore
+	// policy restricts the exchange calls the script may make
+	policy *policyState
 }
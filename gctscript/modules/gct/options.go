@@ -0,0 +1,190 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+)
+
+// ExchangeOptionAnalytics returns the implied volatility and Greeks of an
+// option contract worked out from its ticker using the Black-76 model
+func ExchangeOptionAnalytics(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, optionAnalyticsFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, optionAnalyticsFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, optionAnalyticsFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, optionAnalyticsFunc, "string", args[3])
+	}
+	underlyingPrice, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, optionAnalyticsFunc, "float64", args[4])
+	}
+	riskFreeRate, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, optionAnalyticsFunc, "float64", args[5])
+	}
+
+	pair, err := currency.NewPairFromString(currencyPair)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	t, err := scriptCtx.exchangeWrapper().Ticker(ctx, exchangeName, pair, assetType)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	q, err := options.QuoteFromTicker(t, underlyingPrice)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	a, err := options.Analyse(q, &options.Parameters{RiskFreeRate: riskFreeRate})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	a.Exchange, a.Asset = exchangeName, assetType
+	return optionAnalyticsObject(a), nil
+}
+
+// ExchangeVolatilitySurface returns the volatility smile of every expiry of
+// an underlying built from the enabled option contracts of an exchange
+func ExchangeVolatilitySurface(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, volatilitySurfaceFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, volatilitySurfaceFunc, "string", args[1])
+	}
+	underlyingParam, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, volatilitySurfaceFunc, "string", args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, volatilitySurfaceFunc, "string", args[3])
+	}
+	underlyingPrice, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, volatilitySurfaceFunc, "float64", args[4])
+	}
+	riskFreeRate, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, volatilitySurfaceFunc, "float64", args[5])
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	underlying := currency.NewCode(underlyingParam).Upper()
+
+	ctx := processScriptContext(scriptCtx)
+	wrapper := scriptCtx.exchangeWrapper()
+	pairs, err := wrapper.Pairs(exchangeName, true, assetType)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	params := &options.Parameters{RiskFreeRate: riskFreeRate}
+	var analytics []options.Analytics
+	for _, p := range *pairs {
+		if c, err := options.ParseContract(p); err != nil || !c.Underlying.Equal(underlying) {
+			continue
+		}
+		t, err := wrapper.Ticker(ctx, exchangeName, p, assetType)
+		if err != nil {
+			continue
+		}
+		q, err := options.QuoteFromTicker(t, underlyingPrice)
+		if err != nil {
+			continue
+		}
+		a, err := options.Analyse(q, params)
+		if err != nil {
+			continue
+		}
+		a.Exchange, a.Asset = exchangeName, assetType
+		analytics = append(analytics, *a)
+	}
+	surface, err := options.BuildSurface(underlying, analytics)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	smiles := &objects.Array{Value: make([]objects.Object, len(surface.Smiles))}
+	for i := range surface.Smiles {
+		s := &surface.Smiles[i]
+		points := &objects.Array{Value: make([]objects.Object, len(s.Points))}
+		for j := range s.Points {
+			points.Value[j] = &objects.Map{Value: map[string]objects.Object{
+				"pair":       &objects.String{Value: s.Points[j].Pair.String()},
+				"type":       &objects.String{Value: s.Points[j].Type.String()},
+				"strike":     &objects.Float{Value: s.Points[j].Strike},
+				"moneyness":  &objects.Float{Value: s.Points[j].Moneyness},
+				"volatility": &objects.Float{Value: s.Points[j].ImpliedVolatility},
+				"delta":      &objects.Float{Value: s.Points[j].Delta},
+			}}
+		}
+		smiles.Value[i] = &objects.Map{Value: map[string]objects.Object{
+			"expiry":          &objects.Time{Value: s.Expiry},
+			"timetoexpiry":    &objects.Float{Value: s.TimeToExpiry},
+			"underlyingprice": &objects.Float{Value: s.UnderlyingPrice},
+			"atmvolatility":   &objects.Float{Value: s.ATMVolatility},
+			"points":          points,
+		}}
+	}
+	return &objects.Map{Value: map[string]objects.Object{
+		"exchange":   &objects.String{Value: surface.Exchange},
+		"asset":      &objects.String{Value: surface.Asset.String()},
+		"underlying": &objects.String{Value: surface.Underlying.String()},
+		"timestamp":  &objects.Time{Value: surface.Timestamp},
+		"smiles":     smiles,
+	}}, nil
+}
+
+// optionAnalyticsObject converts option analytics to a script map
+func optionAnalyticsObject(a *options.Analytics) objects.Object {
+	return &objects.Map{Value: map[string]objects.Object{
+		"exchange":        &objects.String{Value: a.Exchange},
+		"asset":           &objects.String{Value: a.Asset.String()},
+		"pair":            &objects.String{Value: a.Contract.Pair.String()},
+		"underlying":      &objects.String{Value: a.Contract.Underlying.String()},
+		"type":            &objects.String{Value: a.Contract.Type.String()},
+		"strike":          &objects.Float{Value: a.Contract.Strike},
+		"expiry":          &objects.Time{Value: a.Contract.Expiry},
+		"premium":         &objects.Float{Value: a.Premium},
+		"price":           &objects.Float{Value: a.Price},
+		"underlyingprice": &objects.Float{Value: a.UnderlyingPrice},
+		"timetoexpiry":    &objects.Float{Value: a.TimeToExpiry},
+		"volatility":      &objects.Float{Value: a.ImpliedVolatility},
+		"delta":           &objects.Float{Value: a.Greeks.Delta},
+		"gamma":           &objects.Float{Value: a.Greeks.Gamma},
+		"vega":            &objects.Float{Value: a.Greeks.Vega},
+		"theta":           &objects.Float{Value: a.Greeks.Theta},
+		"rho":             &objects.Float{Value: a.Greeks.Rho},
+	}}
+}
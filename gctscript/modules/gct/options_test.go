@@ -0,0 +1,56 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	optionsAsset = &objects.String{Value: "options"}
+	optionPair   = &objects.String{Value: "BTC-27DEC30-100-C-USDT"}
+)
+
+func TestExchangeOptionAnalytics(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOptionAnalytics()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeOptionAnalytics(exch, exch, optionPair, optionsAsset, &objects.Float{Value: 100}, &objects.Float{})
+	assert.Error(t, err, "ExchangeOptionAnalytics should error on an invalid context")
+
+	r, err := ExchangeOptionAnalytics(ctx, exch, optionPair, optionsAsset, &objects.Float{Value: 100}, &objects.Float{})
+	require.NoError(t, err, "ExchangeOptionAnalytics must not error")
+	m, ok := r.(*objects.Map)
+	require.True(t, ok, "ExchangeOptionAnalytics must return a map")
+	assert.Equal(t, "call", m.Value["type"].(*objects.String).Value, "type should be set")
+	assert.Positive(t, m.Value["volatility"].(*objects.Float).Value, "volatility should be set")
+	assert.Positive(t, m.Value["delta"].(*objects.Float).Value, "delta should be set")
+
+	r, err = ExchangeOptionAnalytics(ctx, exch, currencyPair, optionsAsset, &objects.Float{Value: 100}, &objects.Float{})
+	require.NoError(t, err, "ExchangeOptionAnalytics must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOptionAnalytics should return an error object for a non option pair")
+
+	r, err = ExchangeOptionAnalytics(ctx, exch, optionPair, optionsAsset, &objects.Float{}, &objects.Float{})
+	require.NoError(t, err, "ExchangeOptionAnalytics must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeOptionAnalytics should return an error object without an underlying price")
+}
+
+func TestExchangeVolatilitySurface(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeVolatilitySurface()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeVolatilitySurface(ctx, exch, &objects.String{Value: "btc"}, optionsAsset, &objects.Float{Value: 100}, assetType)
+	assert.Error(t, err, "ExchangeVolatilitySurface should error on an invalid rate")
+
+	r, err := ExchangeVolatilitySurface(ctx, exch, &objects.String{Value: "btc"}, optionsAsset, &objects.Float{Value: 100}, &objects.Float{})
+	require.NoError(t, err, "ExchangeVolatilitySurface must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeVolatilitySurface should return an error object without option pairs")
+
+	r, err = ExchangeVolatilitySurface(ctx, exchError, &objects.String{Value: "btc"}, optionsAsset, &objects.Float{Value: 100}, &objects.Float{})
+	require.NoError(t, err, "ExchangeVolatilitySurface must not error")
+	assert.IsType(t, &objects.Error{}, r, "ExchangeVolatilitySurface should return an error object when pairs cannot be fetched")
+}
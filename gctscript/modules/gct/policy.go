@@ -0,0 +1,418 @@
+package gct
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// AuditPolicyViolation is the audit event type recorded when a script
+// breaches its policy
+const AuditPolicyViolation = "gctscript_policy_violation"
+
+// ErrPolicyViolation is returned when a script breaches its policy
+var ErrPolicyViolation = errors.New("script policy violation")
+
+var errInvalidPolicy = errors.New("invalid script policy")
+
+// Policy restricts what a script may do, empty or zero values are
+// unrestricted. MaxNotional is the largest order value allowed in quote
+// currency and MaxAllocs limits the objects a single run of the script may
+// allocate
+type Policy struct {
+	AllowedModules     []string `json:"allowed_modules,omitempty"`
+	AllowedExchanges   []string `json:"allowed_exchanges,omitempty"`
+	MaxOrdersPerMinute int      `json:"max_orders_per_minute,omitempty"`
+	MaxNotional        float64  `json:"max_notional,omitempty"`
+	DisableWithdrawals bool     `json:"disable_withdrawals,omitempty"`
+	MaxAllocs          int64    `json:"max_allocs,omitempty"`
+}
+
+// Validate checks the policy limits
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxOrdersPerMinute < 0 {
+		return fmt.Errorf("%w: max orders per minute cannot be negative", errInvalidPolicy)
+	}
+	if p.MaxNotional < 0 {
+		return fmt.Errorf("%w: max notional cannot be negative", errInvalidPolicy)
+	}
+	if p.MaxAllocs < 0 {
+		return fmt.Errorf("%w: max allocs cannot be negative", errInvalidPolicy)
+	}
+	return nil
+}
+
+// ModuleAllowed returns whether the script may import the module
+func (p *Policy) ModuleAllowed(name string) bool {
+	return p == nil || len(p.AllowedModules) == 0 || slices.Contains(p.AllowedModules, name)
+}
+
+// ExchangeAllowed returns whether the script may use the exchange
+func (p *Policy) ExchangeAllowed(exch string) bool {
+	return p == nil || len(p.AllowedExchanges) == 0 || slices.ContainsFunc(p.AllowedExchanges, func(e string) bool {
+		return strings.EqualFold(e, exch)
+	})
+}
+
+// policyState tracks the orders submitted by a script to enforce its policy
+type policyState struct {
+	policy *Policy
+	m      sync.Mutex
+	orders []time.Time
+}
+
+// SetPolicy sets the policy enforced on the script's exchange calls
+func (c *Context) SetPolicy(p *Policy) {
+	if p == nil {
+		c.policy = nil
+		return
+	}
+	c.policy = &policyState{policy: p}
+}
+
+// PolicyViolation logs a breach of the script's policy and records it in
+// the audit log, breaches by scripts being validated or simulated are only
+// logged
+func (c *Context) PolicyViolation(err error) {
+	var script string
+	if o, ok := c.Value["script"].(*objects.String); ok {
+		script = o.Value
+	}
+	log.Warnf(log.GCTScriptMgr, "Script %s: %v", script, err)
+	if validator.IsTestExecution.Load() == true || c.simulated() {
+		return
+	}
+	audit.Event(script, AuditPolicyViolation, err.Error())
+}
+
+// policyWrapper enforces the script policy on calls to the exchange wrapper
+type policyWrapper struct {
+	modules.GCTExchange
+	ctx   *Context
+	state *policyState
+}
+
+// violation returns a policy violation error and reports it
+func (w *policyWrapper) violation(format string, a ...any) error {
+	err := fmt.Errorf("%w: "+format, append([]any{ErrPolicyViolation}, a...)...)
+	w.ctx.PolicyViolation(err)
+	return err
+}
+
+func (w *policyWrapper) checkExchange(exch string) error {
+	if !w.state.policy.ExchangeAllowed(exch) {
+		return w.violation("exchange %q not allowed", exch)
+	}
+	return nil
+}
+
+// checkNotional returns an error if the order value exceeds the policy
+func (w *policyWrapper) checkNotional(notional float64) error {
+	if w.state.policy.MaxNotional > 0 && notional > w.state.policy.MaxNotional {
+		return w.violation("order value %v exceeds max notional %v", notional, w.state.policy.MaxNotional)
+	}
+	return nil
+}
+
+// checkOrderRate returns an error if the script has submitted the maximum
+// orders in the last minute otherwise records the order
+func (w *policyWrapper) checkOrderRate() error {
+	if w.state.policy.MaxOrdersPerMinute <= 0 {
+		return nil
+	}
+	w.state.m.Lock()
+	defer w.state.m.Unlock()
+	now := time.Now()
+	w.state.orders = slices.DeleteFunc(w.state.orders, func(t time.Time) bool {
+		return now.Sub(t) >= time.Minute
+	})
+	if len(w.state.orders) >= w.state.policy.MaxOrdersPerMinute {
+		return w.violation("max orders per minute %d reached", w.state.policy.MaxOrdersPerMinute)
+	}
+	w.state.orders = append(w.state.orders, now)
+	return nil
+}
+
+// Exchanges returns the exchanges allowed by the policy
+func (w *policyWrapper) Exchanges(enabledOnly bool) []string {
+	return slices.DeleteFunc(w.GCTExchange.Exchanges(enabledOnly), func(exch string) bool {
+		return !w.state.policy.ExchangeAllowed(exch)
+	})
+}
+
+// IsEnabled returns false for exchanges not allowed by the policy
+func (w *policyWrapper) IsEnabled(exch string) bool {
+	return w.state.policy.ExchangeAllowed(exch) && w.GCTExchange.IsEnabled(exch)
+}
+
+// Orderbook checks the policy and returns the orderbook
+func (w *policyWrapper) Orderbook(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*orderbook.Book, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.Orderbook(ctx, exch, pair, item)
+}
+
+// Ticker checks the policy and returns the ticker
+func (w *policyWrapper) Ticker(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.Ticker(ctx, exch, pair, item)
+}
+
+// Pairs checks the policy and returns the pairs
+func (w *policyWrapper) Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.Pairs(exch, enabledOnly, item)
+}
+
+// QueryOrder checks the policy and returns the order
+func (w *policyWrapper) QueryOrder(ctx context.Context, exch, orderID string, pair currency.Pair, assetType asset.Item) (*order.Detail, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.QueryOrder(ctx, exch, orderID, pair, assetType)
+}
+
+// SubmitOrder checks the policy order rate and notional before submitting
+// the order, market orders are valued at the last traded price
+func (w *policyWrapper) SubmitOrder(ctx context.Context, submit *order.Submit) (*order.SubmitResponse, error) {
+	if submit == nil {
+		return nil, order.ErrSubmissionIsNil
+	}
+	if err := w.checkExchange(submit.Exchange); err != nil {
+		return nil, err
+	}
+	if w.state.policy.MaxNotional > 0 {
+		notional := submit.QuoteAmount
+		if submit.Amount > 0 {
+			price := submit.Price
+			if submit.Type == order.Market || price <= 0 {
+				t, err := w.GCTExchange.Ticker(ctx, submit.Exchange, submit.Pair, submit.AssetType)
+				if err != nil {
+					return nil, fmt.Errorf("cannot value order for policy: %w", err)
+				}
+				price = t.Last
+			}
+			notional = price * submit.Amount
+		}
+		if err := w.checkNotional(notional); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.checkOrderRate(); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.SubmitOrder(ctx, submit)
+}
+
+// CancelOrder checks the policy and cancels the order
+func (w *policyWrapper) CancelOrder(ctx context.Context, exch, orderID string, pair currency.Pair, item asset.Item) (bool, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return false, err
+	}
+	return w.GCTExchange.CancelOrder(ctx, exch, orderID, pair, item)
+}
+
+// ModifyOrder checks the policy order rate and notional before modifying the
+// order, unset prices and amounts are taken from the order
+func (w *policyWrapper) ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil {
+		return nil, order.ErrModifyOrderIsNil
+	}
+	if err := w.checkExchange(mod.Exchange); err != nil {
+		return nil, err
+	}
+	if w.state.policy.MaxNotional > 0 {
+		price, amount := mod.Price, mod.Amount
+		if price <= 0 || amount <= 0 {
+			d, err := w.GCTExchange.QueryOrder(ctx, mod.Exchange, mod.OrderID, mod.Pair, mod.AssetType)
+			if err != nil {
+				return nil, fmt.Errorf("cannot value order for policy: %w", err)
+			}
+			if price <= 0 {
+				price = d.Price
+			}
+			if amount <= 0 {
+				amount = d.Amount
+			}
+		}
+		if err := w.checkNotional(price * amount); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.checkOrderRate(); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.ModifyOrder(ctx, mod)
+}
+
+// CancelAllOrders checks the policy and cancels the orders
+func (w *policyWrapper) CancelAllOrders(ctx context.Context, cancel *order.Cancel) (order.CancelAllResponse, error) {
+	if cancel == nil {
+		return order.CancelAllResponse{}, order.ErrCancelOrderIsNil
+	}
+	if err := w.checkExchange(cancel.Exchange); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	return w.GCTExchange.CancelAllOrders(ctx, cancel)
+}
+
+// ActiveOrders checks the policy and returns the open orders
+func (w *policyWrapper) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.ActiveOrders(ctx, exch, req)
+}
+
+// OrderHistory checks the policy and returns the order history
+func (w *policyWrapper) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.OrderHistory(ctx, exch, req)
+}
+
+// RecentTrades checks the policy and returns the trades
+func (w *policyWrapper) RecentTrades(ctx context.Context, exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.RecentTrades(ctx, exch, pair, item)
+}
+
+// AccountBalances checks the policy and returns the balances
+func (w *policyWrapper) AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.AccountBalances(ctx, exch, assetType)
+}
+
+// DepositAddress checks the policy and returns the deposit address
+func (w *policyWrapper) DepositAddress(exch, chain string, currencyCode currency.Code) (*deposit.Address, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.DepositAddress(exch, chain, currencyCode)
+}
+
+// WithdrawalFiatFunds checks the policy allows withdrawals before
+// withdrawing
+func (w *policyWrapper) WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (string, error) {
+	if err := w.checkWithdrawal(request); err != nil {
+		return "", err
+	}
+	return w.GCTExchange.WithdrawalFiatFunds(ctx, bankAccountID, request)
+}
+
+// WithdrawalCryptoFunds checks the policy allows withdrawals before
+// withdrawing
+func (w *policyWrapper) WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (string, error) {
+	if err := w.checkWithdrawal(request); err != nil {
+		return "", err
+	}
+	return w.GCTExchange.WithdrawalCryptoFunds(ctx, request)
+}
+
+func (w *policyWrapper) checkWithdrawal(request *withdraw.Request) error {
+	if request == nil {
+		return withdraw.ErrRequestCannotBeNil
+	}
+	if err := w.checkExchange(request.Exchange); err != nil {
+		return err
+	}
+	if w.state.policy.DisableWithdrawals {
+		return w.violation("withdrawals disabled, %v %s withdrawal from %s blocked", request.Amount, request.Currency, request.Exchange)
+	}
+	return nil
+}
+
+// OHLCV checks the policy and returns the candles
+func (w *policyWrapper) OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.OHLCV(ctx, exch, pair, item, start, end, interval)
+}
+
+// FuturesPositions checks the policy and returns the positions
+func (w *policyWrapper) FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.FuturesPositions(ctx, exch, req)
+}
+
+// LatestFundingRates checks the policy and returns the funding rates
+func (w *policyWrapper) LatestFundingRates(ctx context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.LatestFundingRates(ctx, exch, req)
+}
+
+// HistoricalFundingRates checks the policy and returns the funding rates
+func (w *policyWrapper) HistoricalFundingRates(ctx context.Context, exch string, req *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.HistoricalFundingRates(ctx, exch, req)
+}
+
+// OpenInterest checks the policy and returns the open interest
+func (w *policyWrapper) OpenInterest(ctx context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error) {
+	if err := w.checkExchange(exch); err != nil {
+		return nil, err
+	}
+	return w.GCTExchange.OpenInterest(ctx, exch, keys...)
+}
+
+// SetLeverage checks the policy and sets the leverage
+func (w *policyWrapper) SetLeverage(ctx context.Context, exch string, item asset.Item, pair currency.Pair, marginType margin.Type, amount float64, side order.Side) error {
+	if err := w.checkExchange(exch); err != nil {
+		return err
+	}
+	return w.GCTExchange.SetLeverage(ctx, exch, item, pair, marginType, amount, side)
+}
+
+// SubscribeEvents checks the policy and subscribes to the events
+func (w *policyWrapper) SubscribeEvents(ctx context.Context, sub *modules.EventSubscription) (<-chan *modules.Event, error) {
+	if sub != nil && sub.Type != modules.EventMessage {
+		if err := w.checkExchange(sub.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	return w.GCTExchange.SubscribeEvents(ctx, sub)
+}
@@ -0,0 +1,98 @@
+package gct
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	t.Parallel()
+	var p *Policy
+	assert.NoError(t, p.Validate(), "Validate should not error on a nil policy")
+	assert.NoError(t, (&Policy{MaxOrdersPerMinute: 1, MaxNotional: 1, MaxAllocs: 1}).Validate(), "Validate should not error")
+	assert.ErrorIs(t, (&Policy{MaxOrdersPerMinute: -1}).Validate(), errInvalidPolicy)
+	assert.ErrorIs(t, (&Policy{MaxNotional: -1}).Validate(), errInvalidPolicy)
+	assert.ErrorIs(t, (&Policy{MaxAllocs: -1}).Validate(), errInvalidPolicy)
+}
+
+func TestPolicyAllowed(t *testing.T) {
+	t.Parallel()
+	var p *Policy
+	assert.True(t, p.ModuleAllowed("os"), "ModuleAllowed should allow all modules without a policy")
+	assert.True(t, p.ExchangeAllowed("binance"), "ExchangeAllowed should allow all exchanges without a policy")
+
+	p = &Policy{AllowedModules: []string{"exchange"}, AllowedExchanges: []string{"Binance"}}
+	assert.True(t, p.ModuleAllowed("exchange"), "ModuleAllowed should allow a listed module")
+	assert.False(t, p.ModuleAllowed("os"), "ModuleAllowed should not allow an unlisted module")
+	assert.True(t, p.ExchangeAllowed("binance"), "ExchangeAllowed should match exchange names case insensitively")
+	assert.False(t, p.ExchangeAllowed("kraken"), "ExchangeAllowed should not allow an unlisted exchange")
+}
+
+func TestPolicyWrapper(t *testing.T) {
+	t.Parallel()
+	c := &Context{}
+	c.SetWrapper(validator.Wrapper{})
+	c.SetPolicy(&Policy{
+		AllowedExchanges:   []string{"true"},
+		MaxOrdersPerMinute: 2,
+		MaxNotional:        5,
+		DisableWithdrawals: true,
+	})
+	w := c.exchangeWrapper()
+	pair := currency.NewBTCUSDT()
+
+	assert.Empty(t, w.Exchanges(true), "Exchanges should not return exchanges not allowed")
+	assert.True(t, w.IsEnabled("true"), "IsEnabled should return true for an allowed exchange")
+	assert.False(t, w.IsEnabled("kraken"), "IsEnabled should return false for an exchange not allowed")
+	_, err := w.Ticker(t.Context(), "kraken", pair, asset.Spot)
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	_, err = w.Ticker(t.Context(), "true", pair, asset.Spot)
+	assert.NoError(t, err, "Ticker should not error for an allowed exchange")
+
+	submit := &order.Submit{Exchange: "true", Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Price: 2, Amount: 3}
+	_, err = w.SubmitOrder(t.Context(), submit)
+	assert.ErrorIs(t, err, ErrPolicyViolation, "SubmitOrder should error when the order value exceeds max notional")
+
+	// The validator ticker last price is 1
+	submit.Type = order.Market
+	_, err = w.SubmitOrder(t.Context(), submit)
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	// The validator order price is 1 and amount is 2
+	_, err = w.ModifyOrder(t.Context(), &order.Modify{Exchange: "true", OrderID: "1", Pair: pair, AssetType: asset.Spot, Price: 3})
+	assert.ErrorIs(t, err, ErrPolicyViolation, "ModifyOrder should error when the order value exceeds max notional")
+	mod := &order.Modify{Exchange: "true", OrderID: "1", Pair: pair, AssetType: asset.Spot, Amount: 5}
+	_, err = w.ModifyOrder(t.Context(), mod)
+	require.NoError(t, err, "ModifyOrder must not error within max notional")
+
+	submit.Type, submit.Amount, submit.QuoteAmount = order.Limit, 0, 4
+	_, err = w.SubmitOrder(t.Context(), submit)
+	assert.ErrorIs(t, err, ErrPolicyViolation, "SubmitOrder should error when max orders per minute is reached")
+	_, err = w.ModifyOrder(t.Context(), mod)
+	assert.ErrorIs(t, err, ErrPolicyViolation, "ModifyOrder should error when max orders per minute is reached")
+
+	_, err = w.WithdrawalCryptoFunds(t.Context(), &withdraw.Request{Exchange: "true", Currency: currency.BTC, Amount: 1})
+	assert.ErrorIs(t, err, ErrPolicyViolation, "WithdrawalCryptoFunds should error when withdrawals are disabled")
+	_, err = w.WithdrawalFiatFunds(t.Context(), "", nil)
+	assert.ErrorIs(t, err, withdraw.ErrRequestCannotBeNil)
+}
+
+func TestPolicyExchangeWrapper(t *testing.T) {
+	t.Parallel()
+	c := &Context{}
+	c.SetWrapper(validator.Wrapper{})
+	assert.Equal(t, validator.Wrapper{}, c.exchangeWrapper(), "exchangeWrapper should return the wrapper without a policy")
+	c.SetPolicy(&Policy{})
+	assert.IsType(t, &policyWrapper{}, c.exchangeWrapper(), "exchangeWrapper should wrap the wrapper with a policy")
+	c.SetPolicy(&Policy{AllowedExchanges: []string{"Hello World"}})
+	assert.Equal(t, []string{"hello world"}, c.exchangeWrapper().Exchanges(true), "Exchanges should return allowed exchanges")
+	c.SetPolicy(nil)
+	assert.Equal(t, validator.Wrapper{}, c.exchangeWrapper(), "exchangeWrapper should not wrap the wrapper once the policy is removed")
+}
@@ -0,0 +1,182 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+const (
+	stateGetFunc     = "get"
+	stateSetFunc     = "set"
+	stateDeleteFunc  = "delete"
+	stateKeysFunc    = "keys"
+	statePublishFunc = "publish"
+)
+
+var stateModule = map[string]objects.Object{
+	stateGetFunc:     &objects.UserFunction{Name: stateGetFunc, Value: StateGet},
+	stateSetFunc:     &objects.UserFunction{Name: stateSetFunc, Value: StateSet},
+	stateDeleteFunc:  &objects.UserFunction{Name: stateDeleteFunc, Value: StateDelete},
+	stateKeysFunc:    &objects.UserFunction{Name: stateKeysFunc, Value: StateKeys},
+	statePublishFunc: &objects.UserFunction{Name: statePublishFunc, Value: StatePublish},
+}
+
+// StateGet returns the value stored for a key in the script's namespace or
+// undefined when the key is not set
+// Params: scriptCTX, key string
+func StateGet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, namespace, _, err := stateScript(stateGetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateGetFunc, "string", args[1])
+	}
+	store := scriptCtx.stateStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStateStoreUnset)
+	}
+	v, found, err := store.Get(namespace, key)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if !found {
+		return objects.UndefinedValue, nil
+	}
+	return objects.FromInterface(v)
+}
+
+// StateSet stores a value for a key in the script's namespace, the value
+// persists across runs and restarts of the script
+// Params: scriptCTX, key string, value any
+func StateSet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, namespace, _, err := stateScript(stateSetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateSetFunc, "string", args[1])
+	}
+	store := scriptCtx.stateStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStateStoreUnset)
+	}
+	if validator.IsTestExecution.Load() == true {
+		// Scripts being validated must not affect running scripts
+		return objects.TrueValue, nil
+	}
+	if err := store.Set(namespace, key, objects.ToInterface(args[2])); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// StateDelete removes a key from the script's namespace and returns whether
+// it was set
+// Params: scriptCTX, key string
+func StateDelete(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, namespace, _, err := stateScript(stateDeleteFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateDeleteFunc, "string", args[1])
+	}
+	store := scriptCtx.stateStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStateStoreUnset)
+	}
+	if validator.IsTestExecution.Load() == true {
+		return objects.FalseValue, nil
+	}
+	found, err := store.Delete(namespace, key)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if found {
+		return objects.TrueValue, nil
+	}
+	return objects.FalseValue, nil
+}
+
+// StateKeys returns the keys set in the script's namespace
+// Params: scriptCTX
+func StateKeys(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, namespace, _, err := stateScript(stateKeysFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	store := scriptCtx.stateStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStateStoreUnset)
+	}
+	keys, err := store.Keys(namespace)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	r := &objects.Array{Value: make([]objects.Object, len(keys))}
+	for i := range keys {
+		r.Value[i] = &objects.String{Value: keys[i]}
+	}
+	return r, nil
+}
+
+// StatePublish sends a value to running scripts subscribed to a topic with
+// a message event and returns the number of scripts it was delivered to
+// Params: scriptCTX, topic string, value any
+func StatePublish(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, namespace, id, err := stateScript(statePublishFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	topic, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, statePublishFunc, "string", args[1])
+	}
+	if validator.IsTestExecution.Load() == true || scriptCtx.simulated() {
+		// Simulated scripts must not signal running scripts
+		return &objects.Int{Value: 0}, nil
+	}
+	delivered, err := PublishMessage(id, namespace, topic, objects.ToInterface(args[2]))
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.Int{Value: int64(delivered)}, nil
+}
+
+// stateScript returns the script context along with the state namespace and
+// unique id of the script
+func stateScript(funcName string, arg objects.Object) (scriptCtx *Context, namespace, id string, err error) {
+	scriptCtx, ok := objects.ToInterface(arg).(*Context)
+	if !ok {
+		return nil, "", "", constructRuntimeError(1, funcName, "*gct.Context", arg)
+	}
+	if o, ok := scriptCtx.Value["namespace"].(*objects.String); ok {
+		namespace = o.Value
+	}
+	if o, ok := scriptCtx.Value["script"].(*objects.String); ok {
+		id = o.Value
+	}
+	if namespace == "" {
+		return nil, "", "", errNamespaceUnset
+	}
+	return scriptCtx, namespace, id, nil
+}
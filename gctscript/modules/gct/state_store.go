@@ -0,0 +1,394 @@
+package gct
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// MessageBufferSize is the number of messages buffered for each subscriber
+// before further messages to it are dropped
+const MessageBufferSize = 100
+
+var (
+	errStateStoreUnset    = errors.New("script state store not set")
+	errNamespaceUnset     = errors.New("script state namespace not set")
+	errInvalidNamespace   = errors.New("invalid script state namespace")
+	errStateKeyUnset      = errors.New("script state key not set")
+	errTopicUnset         = errors.New("message topic not set")
+	errSubscriberIDUnset  = errors.New("message subscriber id not set")
+	errInvalidNumberValue = errors.New("invalid number value")
+)
+
+// State is the store used by the state module, it is set when the script
+// manager starts
+var State StateStore
+
+// StateStore persists script state values by namespace, values are
+// limited to those which can be encoded as JSON
+type StateStore interface {
+	Get(namespace, key string) (value any, found bool, err error)
+	Set(namespace, key string, value any) error
+	Delete(namespace, key string) (found bool, err error)
+	Keys(namespace string) ([]string, error)
+}
+
+// FileStateStore stores each namespace as a JSON file in a directory
+type FileStateStore struct {
+	dir        string
+	mu         sync.Mutex
+	namespaces map[string]map[string]any
+}
+
+// NewFileStateStore returns a state store which persists to dir
+func NewFileStateStore(dir string) *FileStateStore {
+	return &FileStateStore{dir: dir, namespaces: make(map[string]map[string]any)}
+}
+
+// Get returns the value stored for a key
+func (f *FileStateStore) Get(namespace, key string) (any, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	values, err := f.load(namespace)
+	if err != nil {
+		return nil, false, err
+	}
+	v, ok := values[key]
+	return v, ok, nil
+}
+
+// Set stores a value for a key and persists the namespace
+func (f *FileStateStore) Set(namespace, key string, value any) error {
+	if key == "" {
+		return errStateKeyUnset
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	values, err := f.load(namespace)
+	if err != nil {
+		return err
+	}
+	// Round trip the value so that the stored value matches what is read
+	// back after a restart
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if value, err = decodeStateValue(data); err != nil {
+		return err
+	}
+	prev, existed := values[key]
+	values[key] = value
+	if err := f.save(namespace, values); err != nil {
+		if existed {
+			values[key] = prev
+		} else {
+			delete(values, key)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete removes a key and persists the namespace
+func (f *FileStateStore) Delete(namespace, key string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	values, err := f.load(namespace)
+	if err != nil {
+		return false, err
+	}
+	prev, ok := values[key]
+	if !ok {
+		return false, nil
+	}
+	delete(values, key)
+	if err := f.save(namespace, values); err != nil {
+		values[key] = prev
+		return false, err
+	}
+	return true, nil
+}
+
+// Keys returns the sorted keys stored in a namespace
+func (f *FileStateStore) Keys(namespace string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	values, err := f.load(namespace)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// load returns the cached namespace values, reading them from file on first
+// use
+func (f *FileStateStore) load(namespace string) (map[string]any, error) {
+	if err := checkNamespace(namespace); err != nil {
+		return nil, err
+	}
+	if values, ok := f.namespaces[namespace]; ok {
+		return values, nil
+	}
+	values := make(map[string]any)
+	data, err := os.ReadFile(f.path(namespace))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		v, err := decodeStateValue(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.path(namespace), err)
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", f.path(namespace), errInvalidNamespace)
+		}
+		values = m
+	}
+	f.namespaces[namespace] = values
+	return values, nil
+}
+
+func (f *FileStateStore) save(namespace string, values map[string]any) error {
+	data, err := json.MarshalIndent(values, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(f.path(namespace), data)
+}
+
+func (f *FileStateStore) path(namespace string) string {
+	return filepath.Join(f.dir, namespace+".json")
+}
+
+// MemoryStateStore stores namespaces in memory, it is used when scripts are
+// simulated so that simulations do not affect persisted state
+type MemoryStateStore struct {
+	mu         sync.Mutex
+	namespaces map[string]map[string]any
+}
+
+// NewMemoryStateStore returns an empty in memory state store
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{namespaces: make(map[string]map[string]any)}
+}
+
+// Get returns the value stored for a key
+func (m *MemoryStateStore) Get(namespace, key string) (any, bool, error) {
+	if err := checkNamespace(namespace); err != nil {
+		return nil, false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.namespaces[namespace][key]
+	return v, ok, nil
+}
+
+// Set stores a value for a key
+func (m *MemoryStateStore) Set(namespace, key string, value any) error {
+	if err := checkNamespace(namespace); err != nil {
+		return err
+	}
+	if key == "" {
+		return errStateKeyUnset
+	}
+	// Round trip the value so values behave the same as the file store
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if value, err = decodeStateValue(data); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values, ok := m.namespaces[namespace]
+	if !ok {
+		values = make(map[string]any)
+		m.namespaces[namespace] = values
+	}
+	values[key] = value
+	return nil
+}
+
+// Delete removes a key
+func (m *MemoryStateStore) Delete(namespace, key string) (bool, error) {
+	if err := checkNamespace(namespace); err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.namespaces[namespace][key]; !ok {
+		return false, nil
+	}
+	delete(m.namespaces[namespace], key)
+	return true, nil
+}
+
+// Keys returns the sorted keys stored in a namespace
+func (m *MemoryStateStore) Keys(namespace string) ([]string, error) {
+	if err := checkNamespace(namespace); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0, len(m.namespaces[namespace]))
+	for k := range m.namespaces[namespace] {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func checkNamespace(namespace string) error {
+	if namespace == "" {
+		return errNamespaceUnset
+	}
+	if namespace == "." || namespace == ".." || strings.ContainsAny(namespace, `/\`) {
+		return fmt.Errorf("%w: %q", errInvalidNamespace, namespace)
+	}
+	return nil
+}
+
+// decodeStateValue decodes JSON keeping whole numbers as int64 so they are
+// returned to scripts as ints rather than floats
+func decodeStateValue(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return normaliseNumbers(v)
+}
+
+type jsonNumber interface {
+	Int64() (int64, error)
+	Float64() (float64, error)
+	String() string
+}
+
+func normaliseNumbers(v any) (any, error) {
+	switch t := v.(type) {
+	case jsonNumber:
+		if !strings.ContainsAny(t.String(), ".eE") {
+			if i, err := t.Int64(); err == nil {
+				return i, nil
+			}
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidNumberValue, t.String())
+		}
+		return f, nil
+	case map[string]any:
+		for k := range t {
+			n, err := normaliseNumbers(t[k])
+			if err != nil {
+				return nil, err
+			}
+			t[k] = n
+		}
+	case []any:
+		for i := range t {
+			n, err := normaliseNumbers(t[i])
+			if err != nil {
+				return nil, err
+			}
+			t[i] = n
+		}
+	}
+	return v, nil
+}
+
+// messageBroker relays messages published by scripts to subscribed scripts
+type messageBroker struct {
+	mu     sync.RWMutex
+	topics map[string]map[*messageSubscriber]struct{}
+}
+
+type messageSubscriber struct {
+	id string
+	ch chan *modules.Event
+}
+
+var broker = &messageBroker{topics: make(map[string]map[*messageSubscriber]struct{})}
+
+// SubscribeMessages returns a channel receiving messages published to a
+// topic by other scripts until ctx is cancelled, at which point the channel
+// is closed. Messages published by the subscriber id are not received
+func SubscribeMessages(ctx context.Context, id, topic string) (<-chan *modules.Event, error) {
+	if id == "" {
+		return nil, errSubscriberIDUnset
+	}
+	if topic == "" {
+		return nil, errTopicUnset
+	}
+	sub := &messageSubscriber{id: id, ch: make(chan *modules.Event, MessageBufferSize)}
+	broker.mu.Lock()
+	if broker.topics[topic] == nil {
+		broker.topics[topic] = make(map[*messageSubscriber]struct{})
+	}
+	broker.topics[topic][sub] = struct{}{}
+	broker.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		broker.mu.Lock()
+		delete(broker.topics[topic], sub)
+		if len(broker.topics[topic]) == 0 {
+			delete(broker.topics, topic)
+		}
+		close(sub.ch)
+		broker.mu.Unlock()
+	}()
+	return sub.ch, nil
+}
+
+// PublishMessage sends a message to all subscribers of a topic except the
+// publisher and returns the number of subscribers it was delivered to.
+// Subscribers whose buffer is full miss the message
+func PublishMessage(id, from, topic string, value any) (int, error) {
+	if topic == "" {
+		return 0, errTopicUnset
+	}
+	e := &modules.Event{
+		Type: modules.EventMessage,
+		Time: time.Now(),
+		Data: &modules.Message{Topic: topic, From: from, Value: value},
+	}
+	broker.mu.RLock()
+	defer broker.mu.RUnlock()
+	var delivered int
+	for sub := range broker.topics[topic] {
+		if sub.id == id {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+			delivered++
+		default:
+			log.Warnf(log.GCTScriptMgr, "Script %s message buffer full, dropped %q message from %s", sub.id, topic, from)
+		}
+	}
+	return delivered, nil
+}
@@ -0,0 +1,235 @@
+package gct
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+func TestFileStateStore(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	s := NewFileStateStore(dir)
+
+	_, _, err := s.Get("", "key")
+	assert.ErrorIs(t, err, errNamespaceUnset)
+	_, _, err = s.Get("../escape", "key")
+	assert.ErrorIs(t, err, errInvalidNamespace)
+	assert.ErrorIs(t, s.Set("script", "", 1), errStateKeyUnset)
+	assert.Error(t, s.Set("script", "func", func() {}), "Set should error on a value which cannot be encoded")
+
+	_, found, err := s.Get("script", "count")
+	require.NoError(t, err, "Get must not error")
+	assert.False(t, found, "Get should not find an unset key")
+
+	require.NoError(t, s.Set("script", "count", int64(5)), "Set must not error")
+	require.NoError(t, s.Set("script", "position", map[string]any{"size": 1.5, "pair": "BTC-USD", "legs": []any{int64(1), int64(2)}}), "Set must not error")
+	require.NoError(t, s.Set("other", "count", int64(1)), "Set must not error")
+
+	// A new store must read back the persisted values
+	s = NewFileStateStore(dir)
+	v, found, err := s.Get("script", "count")
+	require.NoError(t, err, "Get must not error")
+	require.True(t, found, "Get must find a persisted key")
+	assert.Equal(t, int64(5), v, "Whole numbers should be returned as int64")
+
+	v, found, err = s.Get("script", "position")
+	require.NoError(t, err, "Get must not error")
+	require.True(t, found, "Get must find a persisted key")
+	assert.Equal(t, map[string]any{"size": 1.5, "pair": "BTC-USD", "legs": []any{int64(1), int64(2)}}, v, "Nested values should be restored")
+
+	keys, err := s.Keys("script")
+	require.NoError(t, err, "Keys must not error")
+	assert.Equal(t, []string{"count", "position"}, keys, "Keys should return the sorted namespace keys")
+
+	found, err = s.Delete("script", "count")
+	require.NoError(t, err, "Delete must not error")
+	assert.True(t, found, "Delete should report the key was set")
+	found, err = s.Delete("script", "count")
+	require.NoError(t, err, "Delete must not error")
+	assert.False(t, found, "Delete should report the key was not set")
+
+	v, _, err = NewFileStateStore(dir).Get("other", "count")
+	require.NoError(t, err, "Get must not error")
+	assert.Equal(t, int64(1), v, "Namespaces should be stored separately")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("[1]"), 0o600), "WriteFile must not error")
+	_, _, err = s.Get("broken", "key")
+	assert.ErrorIs(t, err, errInvalidNamespace)
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	t.Parallel()
+	s := NewMemoryStateStore()
+
+	_, _, err := s.Get("", "key")
+	assert.ErrorIs(t, err, errNamespaceUnset)
+	assert.ErrorIs(t, s.Set("script", "", 1), errStateKeyUnset)
+	assert.Error(t, s.Set("script", "func", func() {}), "Set should error on a value which cannot be encoded")
+
+	require.NoError(t, s.Set("script", "count", 5), "Set must not error")
+	v, found, err := s.Get("script", "count")
+	require.NoError(t, err, "Get must not error")
+	require.True(t, found, "Get must find a set key")
+	assert.Equal(t, int64(5), v, "Whole numbers should be returned as int64")
+
+	keys, err := s.Keys("script")
+	require.NoError(t, err, "Keys must not error")
+	assert.Equal(t, []string{"count"}, keys, "Keys should return the namespace keys")
+
+	found, err = s.Delete("script", "count")
+	require.NoError(t, err, "Delete must not error")
+	assert.True(t, found, "Delete should report the key was set")
+	found, err = s.Delete("script", "count")
+	require.NoError(t, err, "Delete must not error")
+	assert.False(t, found, "Delete should report the key was not set")
+}
+
+func TestStateModuleSimulated(t *testing.T) {
+	t.Parallel()
+	store := NewMemoryStateStore()
+	scriptCtx := &Context{}
+	scriptCtx.Value = map[string]objects.Object{
+		"namespace": &objects.String{Value: "simulated_test"},
+		"script":    &objects.String{Value: "simulated_test.gct-1"},
+	}
+	assert.False(t, scriptCtx.simulated(), "simulated should be false without a wrapper")
+	scriptCtx.SetWrapper(validator.Wrapper{})
+	scriptCtx.SetStateStore(store)
+	assert.True(t, scriptCtx.simulated(), "simulated should be true with a wrapper")
+	assert.Equal(t, validator.Wrapper{}, scriptCtx.exchangeWrapper(), "exchangeWrapper should return the context wrapper")
+
+	_, err := StateSet(scriptCtx, &objects.String{Value: "key"}, &objects.Int{Value: 1})
+	require.NoError(t, err, "StateSet must not error")
+	v, found, err := store.Get("simulated_test", "key")
+	require.NoError(t, err, "Get must not error")
+	require.True(t, found, "StateSet must use the context state store")
+	assert.Equal(t, int64(1), v, "StateSet should store the value")
+
+	subscriber, err := SubscribeMessages(t.Context(), "b", "simulated_test")
+	require.NoError(t, err, "SubscribeMessages must not error")
+	r, err := StatePublish(scriptCtx, &objects.String{Value: "simulated_test"}, &objects.Int{Value: 1})
+	require.NoError(t, err, "StatePublish must not error")
+	assert.Equal(t, &objects.Int{Value: 0}, r, "StatePublish should not deliver messages from simulated scripts")
+	assert.Empty(t, subscriber, "subscribers should not receive messages from simulated scripts")
+}
+
+func TestStateModule(t *testing.T) {
+	t.Parallel()
+	_, err := StateGet()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StateSet()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StateDelete()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StateKeys()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StatePublish()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = StateGet(ctx, &objects.String{Value: "key"})
+	assert.ErrorIs(t, err, errNamespaceUnset)
+	_, err = StateGet(exch, &objects.String{Value: "key"})
+	assert.Error(t, err, "StateGet should error on an invalid context")
+
+	State = NewFileStateStore(t.TempDir())
+	scriptCtx := &Context{}
+	scriptCtx.Value = map[string]objects.Object{
+		"namespace": &objects.String{Value: "state_test"},
+		"script":    &objects.String{Value: "state_test.gct-1"},
+	}
+	key := &objects.String{Value: "signal"}
+
+	r, err := StateGet(scriptCtx, key)
+	require.NoError(t, err, "StateGet must not error")
+	assert.Equal(t, objects.UndefinedValue, r, "StateGet should return undefined for an unset key")
+
+	r, err = StateSet(scriptCtx, key, &objects.Map{Value: map[string]objects.Object{"side": &objects.String{Value: "buy"}, "size": &objects.Int{Value: 2}}})
+	require.NoError(t, err, "StateSet must not error")
+	assert.Equal(t, objects.TrueValue, r, "StateSet should return true")
+
+	r, err = StateGet(scriptCtx, key)
+	require.NoError(t, err, "StateGet must not error")
+	m, ok := r.(*objects.Map)
+	require.True(t, ok, "StateGet must return the stored map")
+	assert.Equal(t, &objects.Int{Value: 2}, m.Value["size"], "StateGet should return ints as ints")
+
+	r, err = StateKeys(scriptCtx)
+	require.NoError(t, err, "StateKeys must not error")
+	assert.Equal(t, &objects.Array{Value: []objects.Object{key}}, r, "StateKeys should return the set keys")
+
+	r, err = StateDelete(scriptCtx, key)
+	require.NoError(t, err, "StateDelete must not error")
+	assert.Equal(t, objects.TrueValue, r, "StateDelete should return true when the key was set")
+
+	r, err = StatePublish(scriptCtx, &objects.String{Value: "state_module_test"}, &objects.Int{Value: 1})
+	require.NoError(t, err, "StatePublish must not error")
+	assert.Equal(t, &objects.Int{Value: 0}, r, "StatePublish should deliver to no subscribers")
+
+	r, err = StatePublish(scriptCtx, &objects.String{Value: ""}, &objects.Int{Value: 1})
+	require.NoError(t, err, "StatePublish must not error")
+	assert.IsType(t, &objects.Error{}, r, "StatePublish should return an error object without a topic")
+}
+
+func TestMessages(t *testing.T) {
+	t.Parallel()
+	_, err := SubscribeMessages(t.Context(), "", "topic")
+	assert.ErrorIs(t, err, errSubscriberIDUnset)
+	_, err = SubscribeMessages(t.Context(), "a", "")
+	assert.ErrorIs(t, err, errTopicUnset)
+	_, err = PublishMessage("a", "a", "", 1)
+	assert.ErrorIs(t, err, errTopicUnset)
+
+	const topic = "test_messages"
+	subA, err := SubscribeMessages(t.Context(), "a", topic)
+	require.NoError(t, err, "SubscribeMessages must not error")
+	subB, err := SubscribeMessages(t.Context(), "b", topic)
+	require.NoError(t, err, "SubscribeMessages must not error")
+
+	delivered, err := PublishMessage("a", "script_a", topic, int64(1337))
+	require.NoError(t, err, "PublishMessage must not error")
+	assert.Equal(t, 1, delivered, "PublishMessage should not deliver to the publisher")
+
+	e := <-subB
+	require.Equal(t, modules.EventMessage, e.Type, "Event type must be message")
+	msg, ok := e.Data.(*modules.Message)
+	require.True(t, ok, "Event data must be a message")
+	assert.Equal(t, &modules.Message{Topic: topic, From: "script_a", Value: int64(1337)}, msg, "Message should match published values")
+	assert.Empty(t, subA, "Publisher should not receive its own message")
+
+	obj, err := EventToObject(e)
+	require.NoError(t, err, "EventToObject must not error")
+	data, ok := obj.(*objects.Map).Value["data"].(*objects.Map)
+	require.True(t, ok, "Message data must be a map")
+	assert.Equal(t, &objects.Int{Value: 1337}, data.Value["value"], "Message value should be converted")
+
+	for range MessageBufferSize + 1 {
+		_, err = PublishMessage("a", "script_a", topic, int64(1))
+		require.NoError(t, err, "PublishMessage must not error")
+	}
+	assert.Len(t, subB, MessageBufferSize, "Messages should be dropped once a subscriber buffer is full")
+}
+
+func TestSubscribeMessagesCancel(t *testing.T) {
+	t.Parallel()
+	const topic = "test_messages_cancel"
+	ctx, cancel := context.WithCancel(t.Context())
+	ch, err := SubscribeMessages(ctx, "a", topic)
+	require.NoError(t, err, "SubscribeMessages must not error")
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, ok := <-ch
+		return !ok
+	}, time.Second, time.Millisecond, "Channel should be closed when the context is cancelled")
+	delivered, err := PublishMessage("b", "b", topic, 1)
+	require.NoError(t, err, "PublishMessage must not error")
+	assert.Zero(t, delivered, "Cancelled subscribers should not receive messages")
+}
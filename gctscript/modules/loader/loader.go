@@ -7,22 +7,44 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta"
 )
 
+// TraceFunc is called after each GCT and TA module function call with the
+// arguments passed and the object or error returned
+type TraceFunc func(module, function string, args []tengo.Object, ret tengo.Object, err error)
+
 // GetModuleMap returns the module map that includes all modules
 // for the given module names.
 func GetModuleMap() *tengo.ModuleMap {
+	return GetTracedModuleMap(nil)
+}
+
+// GetTracedModuleMap returns the module map that includes all modules with
+// the GCT and TA module functions wrapped to call trace, a nil trace returns
+// the modules unwrapped
+func GetTracedModuleMap(trace TraceFunc) *tengo.ModuleMap {
+	return GetContextModuleMap(nil, trace)
+}
+
+// GetContextModuleMap returns the traced module map with the GCT module
+// functions bound to the script context, so they use its exchange wrapper. A
+// nil context returns the modules using the default wrapper
+func GetContextModuleMap(ctx *gct.Context, trace TraceFunc) *tengo.ModuleMap {
 	modules := tengo.NewModuleMap()
 
+	gctModules := gct.Modules
+	if ctx != nil {
+		gctModules = gct.ContextModules(ctx)
+	}
 	gctModuleList := gct.AllModuleNames()
 	for _, name := range gctModuleList {
-		if mod := gct.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+		if mod := gctModules[name]; mod != nil {
+			modules.AddBuiltinModule(name, traceModule(name, mod, trace))
 		}
 	}
 
 	taModuleList := ta.AllModuleNames()
 	for _, name := range taModuleList {
 		if mod := ta.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+			modules.AddBuiltinModule(name, traceModule(name, mod, trace))
 		}
 	}
 
@@ -38,7 +60,44 @@ func GetModuleMap() *tengo.ModuleMap {
 	return modules
 }
 
+// ModuleNames returns the names of all modules in the module map
+func ModuleNames() []string {
+	names := append(gct.AllModuleNames(), ta.AllModuleNames()...)
+	return append(names, stdlib.AllModuleNames()...)
+}
+
+// traceModule returns a copy of the module with its functions wrapped to call
+// trace
+func traceModule(name string, mod map[string]tengo.Object, trace TraceFunc) map[string]tengo.Object {
+	if trace == nil {
+		return mod
+	}
+	traced := make(map[string]tengo.Object, len(mod))
+	for k, v := range mod {
+		fn, ok := v.(*tengo.UserFunction)
+		if !ok {
+			traced[k] = v
+			continue
+		}
+		traced[k] = &tengo.UserFunction{
+			Name: fn.Name,
+			Value: func(args ...tengo.Object) (tengo.Object, error) {
+				ret, err := fn.Value(args...)
+				trace(name, k, args, ret, err)
+				return ret, err
+			},
+		}
+	}
+	return traced
+}
+
 // SetDefaultScriptOutput sets the output folder
 func SetDefaultScriptOutput(path string) {
 	gct.OutputDir = path
 }
+
+// SetDefaultStateStore sets the script state store to persist to files in
+// the folder
+func SetDefaultStateStore(path string) {
+	gct.State = gct.NewFileStateStore(path)
+}
@@ -3,6 +3,7 @@ package loader
 import (
 	"testing"
 
+	"github.com/d5/tengo/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -12,3 +13,31 @@ func TestGetModuleMap(t *testing.T) {
 	require.NotNil(t, x, "GetModuleMap must not return nil")
 	assert.NotZero(t, x.Len(), "GetModuleMap should return a map with entries")
 }
+
+func TestGetTracedModuleMap(t *testing.T) {
+	var module, function string
+	var args []tengo.Object
+	x := GetTracedModuleMap(func(m, f string, a []tengo.Object, _ tengo.Object, _ error) {
+		module, function, args = m, f, a
+	})
+	require.NotNil(t, x, "GetTracedModuleMap must not return nil")
+	assert.Equal(t, GetModuleMap().Len(), x.Len(), "GetTracedModuleMap should return all modules")
+
+	mod, ok := x.GetBuiltinModule("common").Attrs["writeascsv"].(*tengo.UserFunction)
+	require.True(t, ok, "writeascsv must be a user function")
+	_, err := mod.Value()
+	require.Error(t, err, "writeascsv must error without arguments")
+	assert.Equal(t, "common", module, "trace should receive the module name")
+	assert.Equal(t, "writeascsv", function, "trace should receive the function name")
+	assert.Empty(t, args, "trace should receive the arguments")
+}
+
+func TestModuleNames(t *testing.T) {
+	t.Parallel()
+	names := ModuleNames()
+	m := GetModuleMap()
+	assert.Len(t, names, m.Len(), "ModuleNames should return a name for each module")
+	for _, name := range names {
+		assert.NotNilf(t, m.Get(name), "module %s should be in the module map", name)
+	}
+}
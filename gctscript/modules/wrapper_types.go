@@ -4,17 +4,32 @@ import (
 	"context"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
+// Event types scripts can subscribe to
+const (
+	EventTicker    = "ticker"
+	EventOrderbook = "orderbook"
+	EventTrade     = "trade"
+	EventFill      = "fill"
+	EventBalance   = "balance"
+	EventMessage   = "message"
+)
+
 const (
 	// ErrParameterConvertFailed error to return when type conversion fails
 	ErrParameterConvertFailed = "%v failed conversion"
@@ -35,11 +50,52 @@ type GCTExchange interface {
 	QueryOrder(ctx context.Context, exch, orderid string, pair currency.Pair, assetType asset.Item) (*order.Detail, error)
 	SubmitOrder(ctx context.Context, submit *order.Submit) (*order.SubmitResponse, error)
 	CancelOrder(ctx context.Context, exch, orderid string, pair currency.Pair, item asset.Item) (bool, error)
+	ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error)
+	CancelAllOrders(ctx context.Context, cancel *order.Cancel) (order.CancelAllResponse, error)
+	ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
+	OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
+	RecentTrades(ctx context.Context, exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error)
 	AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error)
 	DepositAddress(exch, chain string, currencyCode currency.Code) (*deposit.Address, error)
 	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (out string, err error)
 	OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
+	FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error)
+	LatestFundingRates(ctx context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error)
+	HistoricalFundingRates(ctx context.Context, exch string, req *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error)
+	OpenInterest(ctx context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error)
+	SetLeverage(ctx context.Context, exch string, item asset.Item, pair currency.Pair, marginType margin.Type, amount float64, side order.Side) error
+	SubscribeEvents(ctx context.Context, sub *EventSubscription) (<-chan *Event, error)
+}
+
+// EventSubscription defines the events a script receives from an exchange,
+// an empty pair or asset matches all pairs or assets. Message events are
+// received by Topic instead of Exchange
+type EventSubscription struct {
+	Type     string
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Topic    string
+}
+
+// Event holds a market or account update delivered to a script. Data is
+// *ticker.Price, *orderbook.Book, []trade.Data, *order.Detail,
+// *accounts.SubAccount or *Message depending on the event type
+type Event struct {
+	Type     string
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Time     time.Time
+	Data     any
+}
+
+// Message is a value published by a script to a topic
+type Message struct {
+	Topic string
+	From  string
+	Value any
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
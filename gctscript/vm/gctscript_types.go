@@ -3,6 +3,8 @@ package vm
 import (
 	"errors"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
 const (
@@ -19,6 +21,13 @@ type Config struct {
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
+	// EventQueueSize is the number of events buffered per script, once full
+	// ticker, orderbook and trade events are dropped
+	EventQueueSize int `json:"event_queue_size"`
+	// Policies restricts individual scripts by name without the file
+	// extension, scripts without a policy use DefaultPolicy
+	Policies      map[string]*gct.Policy `json:"policies,omitempty"`
+	DefaultPolicy *gct.Policy            `json:"default_policy,omitempty"`
 }
 
 // Error interface to meet error requirements
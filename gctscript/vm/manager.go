@@ -76,6 +76,7 @@ func (g *GctScriptManager) run(wg *sync.WaitGroup) {
 	log.Debugf(log.Global, "%s starting", caseName)
 
 	SetDefaultScriptOutput()
+	SetDefaultStateStore()
 	g.autoLoad()
 	defer wg.Done()
 
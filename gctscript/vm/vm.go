@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -63,6 +64,11 @@ func SetDefaultScriptOutput() {
 	loader.SetDefaultScriptOutput(filepath.Join(ScriptPath, "output"))
 }
 
+// SetDefaultStateStore sets the default store used to persist script state
+func SetDefaultStateStore() {
+	loader.SetDefaultStateStore(filepath.Join(ScriptPath, "state"))
+}
+
 // Load parses and creates a new instance of tengo script vm
 func (vm *VM) Load(file string) error {
 	if vm == nil {
@@ -86,17 +92,28 @@ func (vm *VM) Load(file string) error {
 	vm.Path = filepath.Dir(file)
 	vm.Script = tengo.NewScript(code)
 
-	scriptCtx := &gct.Context{}
-	scriptCtx.Value = map[string]tengo.Object{
-		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
+	vm.ctx = &gct.Context{}
+	vm.ctx.Value = map[string]tengo.Object{
+		"script":    &tengo.String{Value: vm.scriptID()},
+		"namespace": &tengo.String{Value: strings.TrimSuffix(vm.ShortName(), common.GctExt)},
+	}
+
+	err = vm.Script.Add("ctx", vm.ctx)
+	if err != nil {
+		return err
 	}
 
-	err = vm.Script.Add("ctx", scriptCtx)
+	err = vm.Script.Add(eventVariable, tengo.UndefinedValue)
 	if err != nil {
 		return err
 	}
 
-	vm.Script.SetImports(loader.GetModuleMap())
+	policy := vm.config.Policy(vm.ShortName())
+	vm.ctx.SetPolicy(policy)
+	if policy != nil && policy.MaxAllocs > 0 {
+		vm.Script.SetMaxAllocs(policy.MaxAllocs)
+	}
+	vm.setImports(loader.GetContextModuleMap(vm.ctx, nil))
 	vm.Hash = vm.getHash()
 
 	if vm.config.AllowImports {
@@ -116,7 +133,13 @@ func (vm *VM) Compile() (err error) {
 }
 
 // RunCtx runs compiled byte code with context.Context support.
-func (vm *VM) RunCtx() (err error) {
+func (vm *VM) RunCtx() error {
+	vm.m.Lock()
+	defer vm.m.Unlock()
+	return vm.runCtx()
+}
+
+func (vm *VM) runCtx() (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), vm.config.ScriptTimeout)
 	defer cancel()
 
@@ -129,6 +152,7 @@ func (vm *VM) RunCtx() (err error) {
 
 	err = vm.Compiled.RunContext(ctx)
 	if err != nil {
+		vm.checkPolicyError(err)
 		vm.event(StatusFailure, TypeExecute)
 		return Error{Action: "RunCtx", Cause: err}
 	}
@@ -160,6 +184,15 @@ func (vm *VM) CompileAndRun() {
 		}
 		return
 	}
+	subs, err := vm.parseEvents()
+	if err != nil {
+		log.Errorln(log.GCTScriptMgr, err)
+		err = vm.Shutdown()
+		if err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+		return
+	}
 	if vm.Compiled.Get("timer").String() != "" {
 		vm.T, err = time.ParseDuration(vm.Compiled.Get("timer").String())
 		if err != nil {
@@ -170,15 +203,28 @@ func (vm *VM) CompileAndRun() {
 			}
 			return
 		}
-		if vm.T > 0 {
-			vm.runner()
-			return
-		}
-
 		if vm.T < 0 {
 			log.Errorln(log.GCTScriptMgr, "Repeat timer cannot be under 1 nano second")
 		}
 	}
+	if vm.T > 0 || len(subs) > 0 {
+		vm.S = make(chan struct{}, 1)
+		if len(subs) > 0 {
+			err = vm.eventRunner(subs)
+			if err != nil {
+				log.Errorln(log.GCTScriptMgr, Error{Action: "Events", Script: vm.File, Cause: err})
+				err = vm.Shutdown()
+				if err != nil {
+					log.Errorln(log.GCTScriptMgr, err)
+				}
+				return
+			}
+		}
+		if vm.T > 0 {
+			vm.runner()
+		}
+		return
+	}
 	err = vm.Shutdown()
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
@@ -191,7 +237,7 @@ func (vm *VM) Shutdown() error {
 		return ErrNoVMLoaded
 	}
 	if vm.S != nil {
-		close(vm.S)
+		vm.stopOnce.Do(func() { close(vm.S) })
 	}
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
@@ -221,8 +267,13 @@ func (vm *VM) ShortName() string {
 	return filepath.Base(vm.File)
 }
 
+// scriptID returns the unique identifier of the running script
+func (vm *VM) scriptID() string {
+	return vm.ShortName() + "-" + vm.ID.String()
+}
+
 func (vm *VM) event(status, executionType string) {
-	if validator.IsTestExecution.Load() == true {
+	if validator.IsTestExecution.Load() == true || vm.simulated {
 		return
 	}
 
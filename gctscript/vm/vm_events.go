@@ -0,0 +1,164 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errInvalidEventSubscription = errors.New("invalid event subscription")
+	errEventWrapperUnset        = errors.New("module wrapper not set")
+)
+
+// parseEvents returns the subscriptions declared by the script's events
+// variable, each entry is a map with type and exchange keys and optional
+// pair, delimiter and asset keys. Message entries require a topic key instead
+// of exchange
+func (vm *VM) parseEvents() ([]*modules.EventSubscription, error) {
+	v := vm.Compiled.Get(eventsVariable)
+	if v.IsUndefined() {
+		return nil, nil
+	}
+	arr, ok := v.Object().(*tengo.Array)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be an array", errInvalidEventSubscription, eventsVariable)
+	}
+	subs := make([]*modules.EventSubscription, 0, len(arr.Value))
+	for i := range arr.Value {
+		m, ok := tengo.ToInterface(arr.Value[i]).(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: entry %d must be a map", errInvalidEventSubscription, i)
+		}
+		sub := &modules.EventSubscription{}
+		sub.Type, _ = m["type"].(string)
+		sub.Type = strings.ToLower(sub.Type)
+		if sub.Type == modules.EventMessage {
+			if sub.Topic, _ = m["topic"].(string); sub.Topic == "" {
+				return nil, fmt.Errorf("%w: entry %d requires topic", errInvalidEventSubscription, i)
+			}
+			subs = append(subs, sub)
+			continue
+		}
+		sub.Exchange, _ = m["exchange"].(string)
+		if sub.Type == "" || sub.Exchange == "" {
+			return nil, fmt.Errorf("%w: entry %d requires type and exchange", errInvalidEventSubscription, i)
+		}
+		if p, _ := m["pair"].(string); p != "" {
+			delimiter, _ := m["delimiter"].(string)
+			var err error
+			if sub.Pair, err = currency.NewPairDelimiter(p, delimiter); err != nil {
+				return nil, fmt.Errorf("%w: entry %d: %w", errInvalidEventSubscription, i, err)
+			}
+		}
+		if a, _ := m["asset"].(string); a != "" {
+			var err error
+			if sub.Asset, err = asset.New(a); err != nil {
+				return nil, fmt.Errorf("%w: entry %d: %w", errInvalidEventSubscription, i, err)
+			}
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// eventRunner subscribes to the script's events and runs the script for each
+// event received until the VM is shut down, a failing event run shuts down the
+// VM. Events are queued up to the configured queue size, once full market data
+// events are dropped whereas fill, balance and message events wait for the
+// script to catch up
+func (vm *VM) eventRunner(subs []*modules.EventSubscription) error {
+	if modules.Wrapper == nil {
+		return errEventWrapperUnset
+	}
+	size := vm.config.EventQueueSize
+	if size <= 0 {
+		size = DefaultEventQueueSize
+	}
+	vm.eventQueue = make(chan *modules.Event, size)
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, sub := range subs {
+		var ch <-chan *modules.Event
+		var err error
+		if sub.Type == modules.EventMessage {
+			ch, err = gct.SubscribeMessages(ctx, vm.scriptID(), sub.Topic)
+		} else {
+			ch, err = modules.Wrapper.SubscribeEvents(ctx, sub)
+		}
+		if err != nil {
+			cancel()
+			return fmt.Errorf("%s %s events: %w", sub.Exchange, sub.Type, err)
+		}
+		go vm.queueEvents(ctx, ch)
+	}
+
+	go func() {
+		defer cancel()
+		for {
+			select {
+			case <-vm.S:
+				return
+			case e := <-vm.eventQueue:
+				obj, err := gct.EventToObject(e)
+				if err != nil {
+					log.Errorln(log.GCTScriptMgr, err)
+					continue
+				}
+				if err := vm.runEvent(obj); err != nil {
+					log.Errorln(log.GCTScriptMgr, err)
+					if err := vm.Shutdown(); err != nil {
+						log.Errorln(log.GCTScriptMgr, err)
+					}
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// queueEvents forwards events to the VM event queue applying backpressure
+func (vm *VM) queueEvents(ctx context.Context, ch <-chan *modules.Event) {
+	for e := range ch {
+		switch e.Type {
+		case modules.EventFill, modules.EventBalance, modules.EventMessage:
+			select {
+			case vm.eventQueue <- e:
+			case <-ctx.Done():
+				return
+			}
+		default:
+			select {
+			case vm.eventQueue <- e:
+			default:
+				if dropped := vm.eventsDropped.Add(1); vm.config.Verbose || dropped == 1 {
+					log.Warnf(log.GCTScriptMgr, "Script %s ID: %v event queue full, dropped %s %s event (%d dropped)",
+						vm.ShortName(), vm.ID, e.Exchange, e.Type, dropped)
+				}
+			}
+		}
+	}
+}
+
+// runEvent runs the script with the event variable set to the event
+func (vm *VM) runEvent(obj tengo.Object) error {
+	vm.m.Lock()
+	defer vm.m.Unlock()
+	if err := vm.Compiled.Set(eventVariable, obj); err != nil {
+		return Error{Action: "RunEvent", Cause: err}
+	}
+	defer func() {
+		if err := vm.Compiled.Set(eventVariable, tengo.UndefinedValue); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+	}()
+	return vm.runCtx()
+}
@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+)
+
+// Policy returns the policy for the named script, the file extension is
+// ignored
+func (c *Config) Policy(name string) *gct.Policy {
+	if p, ok := c.Policies[strings.TrimSuffix(name, common.GctExt)]; ok {
+		return p
+	}
+	return c.DefaultPolicy
+}
+
+// ValidatePolicies checks the script policies
+func (c *Config) ValidatePolicies() error {
+	if err := c.DefaultPolicy.Validate(); err != nil {
+		return fmt.Errorf("default policy: %w", err)
+	}
+	for name, p := range c.Policies {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("%s policy: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// deniedModule is imported in place of modules the script policy does not
+// allow, importing it reports the violation and fails compilation
+type deniedModule struct {
+	ctx  *gct.Context
+	name string
+}
+
+// Import reports the policy violation and returns an error
+func (d *deniedModule) Import(string) (any, error) {
+	err := fmt.Errorf("%w: module %q not allowed", gct.ErrPolicyViolation, d.name)
+	d.ctx.PolicyViolation(err)
+	return nil, err
+}
+
+// setImports sets the modules the script may import, modules not allowed by
+// the script policy are replaced by a module which fails to import
+func (vm *VM) setImports(m *tengo.ModuleMap) {
+	p := vm.config.Policy(vm.ShortName())
+	for _, name := range loader.ModuleNames() {
+		if !p.ModuleAllowed(name) {
+			m.Remove(name)
+			m.Add(name, &deniedModule{ctx: vm.ctx, name: name})
+		}
+	}
+	vm.Script.SetImports(m)
+}
+
+// checkPolicyError reports errors caused by the script exceeding its
+// allocation limit as policy violations
+func (vm *VM) checkPolicyError(err error) {
+	if err != nil && errors.Is(err, tengo.ErrObjectAllocLimit) {
+		vm.ctx.PolicyViolation(fmt.Errorf("%w: %w", gct.ErrPolicyViolation, err))
+	}
+}
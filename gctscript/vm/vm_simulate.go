@@ -0,0 +1,219 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/simulator"
+)
+
+// maxTraceValueLength is the length trace arguments and results are
+// truncated to
+const maxTraceValueLength = 512
+
+var (
+	errSimulationConfigNil = errors.New("simulation config is nil")
+	errIntervalUnset       = errors.New("simulation interval not set")
+)
+
+// Simulate runs a script against candles from recorded or database market
+// data without sending orders or withdrawals to exchanges. The script is run
+// once on the first candle with event undefined, then for each matching
+// event raised by each candle or, when the script does not declare events,
+// once for each candle. The script timer is ignored, state changes are kept
+// in memory and published messages are not delivered. Errors raised by the
+// script stop the simulation and are returned in the result
+func (g *GctScriptManager) Simulate(file string, cfg *SimulationConfig) (*SimulationResult, error) {
+	if cfg == nil {
+		return nil, errSimulationConfigNil
+	}
+	k, err := cfg.candles()
+	if err != nil {
+		return nil, err
+	}
+	w, err := simulator.NewWrapper(k, cfg.Balances)
+	if err != nil {
+		return nil, err
+	}
+	tempVM := g.NewVM()
+	if tempVM == nil {
+		return nil, ErrNoVMLoaded
+	}
+	tempVM.simulated = true
+	if err := tempVM.Load(file); err != nil {
+		return nil, err
+	}
+	tempVM.ctx.SetWrapper(w)
+	tempVM.ctx.SetStateStore(gct.NewMemoryStateStore())
+
+	result := &SimulationResult{}
+	if cfg.Trace {
+		tempVM.setImports(loader.GetContextModuleMap(tempVM.ctx, func(module, function string, args []tengo.Object, ret tengo.Object, err error) {
+			entry := TraceEntry{
+				Step:     result.Steps,
+				Time:     w.Time(),
+				Module:   module,
+				Function: function,
+				Args:     make([]string, len(args)),
+			}
+			for i := range args {
+				entry.Args[i] = traceValue(args[i])
+			}
+			if ret != nil {
+				entry.Result = traceValue(ret)
+			}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+			result.Trace = append(result.Trace, entry)
+		}))
+	}
+	if err := tempVM.Compile(); err != nil {
+		return nil, err
+	}
+
+	if err := tempVM.simulate(w, result); err != nil {
+		result.Error = err.Error()
+	}
+	result.Orders = w.Orders()
+	result.Withdrawals = w.Withdrawals()
+	balances := w.Balances()
+	result.Balances = make(map[string]float64, len(balances))
+	for code, amount := range balances {
+		result.Balances[code.String()] = amount
+	}
+	return result, nil
+}
+
+// simulate steps through the simulated candles running the script
+func (vm *VM) simulate(w *simulator.Wrapper, result *SimulationResult) error {
+	var subs []*modules.EventSubscription
+	for w.Next() {
+		result.Steps++
+		events := w.Events()
+		if result.Steps == 1 || len(subs) == 0 {
+			result.Runs++
+			if err := vm.RunCtx(); err != nil {
+				return err
+			}
+		}
+		if result.Steps == 1 {
+			var err error
+			if subs, err = vm.parseEvents(); err != nil {
+				return err
+			}
+			if err := checkSubscriptions(w, subs); err != nil {
+				return err
+			}
+		}
+		for _, e := range events {
+			if !subscribed(subs, e) {
+				continue
+			}
+			obj, err := gct.EventToObject(e)
+			if err != nil {
+				return err
+			}
+			result.Runs++
+			if err := vm.runEvent(obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// candles returns the candles to simulate
+func (cfg *SimulationConfig) candles() (*kline.Item, error) {
+	if cfg.Exchange == "" {
+		return nil, common.ErrExchangeNameNotSet
+	}
+	if cfg.Pair.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if !cfg.Asset.IsValid() {
+		return nil, fmt.Errorf("%w: %q", asset.ErrNotSupported, cfg.Asset)
+	}
+	if cfg.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	if cfg.DataFile == "" {
+		if err := common.StartEndTimeCheck(cfg.Start, cfg.End); err != nil {
+			return nil, err
+		}
+		return kline.LoadFromDatabase(cfg.Exchange, cfg.Pair, cfg.Asset, cfg.Interval, cfg.Start, cfg.End)
+	}
+	candles, err := kline.LoadFromGCTScriptCSV(cfg.DataFile)
+	if err != nil {
+		return nil, err
+	}
+	k := &kline.Item{
+		Exchange: cfg.Exchange,
+		Pair:     cfg.Pair,
+		Asset:    cfg.Asset,
+		Interval: cfg.Interval,
+	}
+	for i := range candles {
+		if (cfg.Start.IsZero() || !candles[i].Time.Before(cfg.Start)) && (cfg.End.IsZero() || candles[i].Time.Before(cfg.End)) {
+			k.Candles = append(k.Candles, candles[i])
+		}
+	}
+	return k, nil
+}
+
+// checkSubscriptions returns an error if a subscription cannot be met by the
+// simulation, message subscriptions are ignored
+func checkSubscriptions(w *simulator.Wrapper, subs []*modules.EventSubscription) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, sub := range subs {
+		if sub.Type == modules.EventMessage {
+			continue
+		}
+		if _, err := w.SubscribeEvents(ctx, sub); err != nil {
+			return fmt.Errorf("%s %s events: %w", sub.Exchange, sub.Type, err)
+		}
+	}
+	return nil
+}
+
+// subscribed returns whether an event matches any of the subscriptions
+func subscribed(subs []*modules.EventSubscription, e *modules.Event) bool {
+	for _, sub := range subs {
+		if sub.Type == e.Type &&
+			strings.EqualFold(sub.Exchange, e.Exchange) &&
+			(sub.Pair.IsEmpty() || sub.Pair.Equal(e.Pair)) &&
+			(sub.Asset == asset.Empty || sub.Asset == e.Asset) {
+			return true
+		}
+	}
+	return false
+}
+
+// traceValue returns the traced representation of an object, the script
+// context is not included so credentials are not recorded
+func traceValue(o tengo.Object) string {
+	if _, ok := o.(*gct.Context); ok {
+		return "ctx"
+	}
+	s := o.String()
+	if len(s) <= maxTraceValueLength {
+		return s
+	}
+	s = s[:maxTraceValueLength]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s + "..."
+}
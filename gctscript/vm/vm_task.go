@@ -7,7 +7,9 @@ import (
 )
 
 func (vm *VM) runner() {
-	vm.S = make(chan struct{}, 1)
+	if vm.S == nil {
+		vm.S = make(chan struct{}, 1)
+	}
 	waitTime := time.NewTicker(vm.T)
 	vm.NextRun = time.Now().Add(vm.T)
 
@@ -1,15 +1,26 @@
 package vm
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 )
 
 const (
@@ -26,6 +37,10 @@ var (
 	testScriptRunner1s       = filepath.Join("..", "..", "testdata", "gctscript", "1s_timer.gct")
 	testScriptRunnerNegative = filepath.Join("..", "..", "testdata", "gctscript", "negative_timer.gct")
 	testScriptRunnerInvalid  = filepath.Join("..", "..", "testdata", "gctscript", "invalid_timer.gct")
+	testScriptEvents         = filepath.Join("..", "..", "testdata", "gctscript", "events.gct")
+	testScriptMessages       = filepath.Join("..", "..", "testdata", "gctscript", "messages.gct")
+	testScriptSimulate       = filepath.Join("..", "..", "testdata", "gctscript", "simulate.gct")
+	testSimulationData       = filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
 )
 
 func TestNewVM(t *testing.T) {
@@ -65,6 +80,19 @@ func TestVMLoad1s(t *testing.T) {
 	require.NoError(t, testVM.Shutdown())
 }
 
+func TestVMShutdownTwice(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NoError(t, testVM.Load(testScriptRunner1s))
+
+	testVM.CompileAndRun()
+	require.NoError(t, testVM.Shutdown())
+	assert.NotPanics(t, func() { _ = testVM.Shutdown() }, "Shutdown should not close the stop channel twice")
+}
+
 func TestVMLoadNegativeTimer(t *testing.T) {
 	manager := GctScriptManager{
 		config:  configHelper(true, true, maxTestVirtualMachines),
@@ -456,6 +484,140 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestSimulate(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	_, err := manager.Simulate(testScriptSimulate, nil)
+	require.ErrorIs(t, err, errSimulationConfigNil, "Simulate must error with a nil config")
+
+	cfg := &SimulationConfig{
+		Exchange: "binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		DataFile: testSimulationData,
+		Trace:    true,
+	}
+	_, err = manager.Simulate(testScriptSimulate, cfg)
+	require.ErrorIs(t, err, errIntervalUnset, "Simulate must error without an interval")
+
+	cfg.Interval = kline.OneDay
+	cfg.End = time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	result, err := manager.Simulate(testScriptSimulate, cfg)
+	require.NoError(t, err, "Simulate must not error")
+	assert.Empty(t, result.Error, "script should not error")
+	assert.Equal(t, 59, result.Steps, "Simulate should step through each candle before end")
+	require.Len(t, result.Orders, 1, "Simulate must capture submitted orders")
+	assert.Equal(t, order.Filled, result.Orders[0].Status, "market orders should be filled")
+	assert.Equal(t, 0.1, result.Balances["BTC"], "balances should be settled from fills")
+	assert.Equal(t, 61, result.Runs, "script should run on start, each ticker and each fill")
+	require.NotEmpty(t, result.Trace, "Simulate must trace module calls")
+	assert.Equal(t, "state", result.Trace[0].Module, "trace should record the module")
+	assert.Equal(t, "get", result.Trace[0].Function, "trace should record the function")
+	assert.Equal(t, "ctx", result.Trace[0].Args[0], "trace should not record the script context")
+
+	cfg.Balances = map[currency.Code]float64{currency.USDT: 100}
+	cfg.Trace = false
+	result, err = manager.Simulate(testScriptSimulate, cfg)
+	require.NoError(t, err, "Simulate must not error")
+	assert.Empty(t, result.Trace, "Simulate should not trace when disabled")
+	require.Len(t, result.Orders, 1, "Simulate must capture rejected orders")
+	assert.Equal(t, order.Rejected, result.Orders[0].Status, "orders should be rejected without funds")
+
+	result, err = manager.Simulate(testScriptEvents, cfg)
+	require.NoError(t, err, "Simulate must not error")
+	assert.Contains(t, result.Error, "exchange not simulated", "Simulate should return script errors in the result")
+}
+
+// defaultWrapper fails the test when a script calls the default exchange
+// wrapper, any other call panics
+type defaultWrapper struct {
+	modules.GCTExchange
+	t *testing.T
+}
+
+func (w defaultWrapper) Exchanges(bool) []string {
+	w.t.Error("Exchanges must not be called on the default wrapper")
+	return nil
+}
+
+func (w defaultWrapper) Pairs(string, bool, asset.Item) (*currency.Pairs, error) {
+	w.t.Error("Pairs must not be called on the default wrapper")
+	return nil, nil
+}
+
+func (w defaultWrapper) DepositAddress(string, string, currency.Code) (*deposit.Address, error) {
+	w.t.Error("DepositAddress must not be called on the default wrapper")
+	return nil, nil
+}
+
+func TestSimulateDoesNotUseDefaultWrapper(t *testing.T) {
+	modules.SetModuleWrapper(defaultWrapper{t: t})
+	t.Cleanup(func() { modules.SetModuleWrapper(validator.Wrapper{}) })
+	script := filepath.Join(t.TempDir(), "exchanges.gct")
+	require.NoError(t, os.WriteFile(script, []byte(`exch := import("exchange")
+names := exch.exchanges(false)
+pairs := exch.pairs("binance", true, "spot")
+address := exch.depositaddress("binance", "BTC", "")
+`), 0o600))
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	result, err := manager.Simulate(script, &SimulationConfig{
+		Exchange: "binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: kline.OneDay,
+		DataFile: testSimulationData,
+		End:      time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC),
+		Trace:    true,
+	})
+	require.NoError(t, err, "Simulate must not error")
+	assert.Empty(t, result.Error, "script should not error")
+	require.Len(t, result.Trace, 3*result.Runs, "Simulate must trace each exchange call of each run")
+	assert.Equal(t, `["binance"]`, result.Trace[0].Result, "exchanges should be returned by the simulated wrapper")
+	assert.Equal(t, `["BTCUSDT"]`, result.Trace[1].Result, "pairs should be returned by the simulated wrapper")
+	assert.Contains(t, result.Trace[2].Result, common.ErrFunctionNotSupported.Error(), "deposit addresses should not be simulated")
+}
+
+func TestVMPolicy(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	manager.config.Policies = map[string]*gct.Policy{"once": {AllowedModules: []string{"exchange"}}}
+	testVM := manager.NewVM()
+	require.NoError(t, testVM.Load(testScript), "Load must not error")
+	assert.ErrorIs(t, testVM.Compile(), gct.ErrPolicyViolation, "Compile should error when importing a module not allowed")
+
+	manager.config.Policies = nil
+	manager.config.DefaultPolicy = &gct.Policy{AllowedModules: []string{"fmt"}, MaxAllocs: 10}
+	testVM = manager.NewVM()
+	require.NoError(t, testVM.Load(testScript), "Load must not error")
+	require.NoError(t, testVM.Compile(), "Compile must not error importing an allowed module")
+	require.NoError(t, testVM.RunCtx(), "RunCtx must not error within the allocation limit")
+
+	file := filepath.Join(t.TempDir(), "allocs.gct")
+	require.NoError(t, os.WriteFile(file, []byte("a := []\nfor i := 0; i < 100; i++ { a = append(a, [i]) }"), 0o600), "WriteFile must not error")
+	testVM = manager.NewVM()
+	require.NoError(t, testVM.Load(file), "Load must not error")
+	require.NoError(t, testVM.Compile(), "Compile must not error")
+	assert.ErrorIs(t, testVM.RunCtx(), tengo.ErrObjectAllocLimit, "RunCtx should error when the allocation limit is exceeded")
+}
+
+func TestConfigPolicy(t *testing.T) {
+	t.Parallel()
+	p := &gct.Policy{MaxNotional: 100}
+	c := &Config{Policies: map[string]*gct.Policy{"test": p}, DefaultPolicy: &gct.Policy{}}
+	assert.Same(t, p, c.Policy("test.gct"), "Policy should return the script policy")
+	assert.Same(t, c.DefaultPolicy, c.Policy("other"), "Policy should return the default policy")
+	require.NoError(t, c.ValidatePolicies(), "ValidatePolicies must not error")
+	p.MaxNotional = -1
+	assert.Error(t, c.ValidatePolicies(), "ValidatePolicies should error on an invalid policy")
+}
+
 func TestVMLimit(t *testing.T) {
 	manager := GctScriptManager{
 		config:  configHelper(true, false, 0),
@@ -508,6 +670,148 @@ func TestVMCount(t *testing.T) {
 	}
 }
 
+func TestVMWithEvents(t *testing.T) {
+	modules.SetModuleWrapper(validator.Wrapper{})
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must create a VM")
+	require.NoError(t, testVM.Load(testScriptEvents), "Load must not error")
+	testVM.CompileAndRun()
+	require.NotNil(t, testVM.S, "CompileAndRun must keep the VM running for events")
+	assert.Eventually(t, func() bool {
+		return testVM.Compiled.Get("last").String() == "ticker:BTC-AUD"
+	}, 5*time.Second, 10*time.Millisecond, "script should run for the ticker event")
+	assert.True(t, testVM.Compiled.Get("event").IsUndefined(), "event should be reset after the script runs")
+	require.NoError(t, testVM.Shutdown(), "Shutdown must not error")
+}
+
+func TestVMWithFailingEvents(t *testing.T) {
+	modules.SetModuleWrapper(validator.Wrapper{})
+	script := filepath.Join(t.TempDir(), "failing_events.gct")
+	require.NoError(t, os.WriteFile(script, []byte(`events := [
+	{type: "ticker", exchange: "BTC Markets", pair: "BTC-AUD", delimiter: "-", asset: "spot"}
+]
+
+if !is_undefined(event) {
+	zero := 0
+	last := 1 / zero
+}
+`), 0o600))
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must create a VM")
+	require.NoError(t, testVM.Load(script), "Load must not error")
+	_, ok := AllVMSync.Load(testVM.ID)
+	require.True(t, ok, "Load must register the VM")
+	testVM.CompileAndRun()
+	require.NotNil(t, testVM.S, "CompileAndRun must keep the VM running for events")
+	assert.Eventually(t, func() bool {
+		_, ok := AllVMSync.Load(testVM.ID)
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond, "a failing event run should shut down and unregister the VM")
+}
+
+func TestVMWithMessages(t *testing.T) {
+	gct.State = gct.NewFileStateStore(t.TempDir())
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must create a VM")
+	require.NoError(t, testVM.Load(testScriptMessages), "Load must not error")
+	testVM.CompileAndRun()
+	require.NotNil(t, testVM.S, "CompileAndRun must keep the VM running for messages")
+
+	assert.Eventually(t, func() bool {
+		_, err := gct.PublishMessage("publisher", "publisher", "vm_test_signals", "buy")
+		require.NoError(t, err, "PublishMessage must not error")
+		return testVM.Compiled.Get("last").String() == "publisher:buy"
+	}, 5*time.Second, 50*time.Millisecond, "script should run for the published message")
+
+	v, found, err := gct.State.Get("messages", "last")
+	require.NoError(t, err, "Get must not error")
+	assert.True(t, found, "Script state should be stored in the script namespace")
+	assert.Equal(t, "publisher:buy", v, "Script state should be persisted")
+	require.NoError(t, testVM.Shutdown(), "Shutdown must not error")
+}
+
+func TestParseEvents(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		script string
+		subs   int
+		err    error
+	}{
+		{script: `a := 1`},
+		{script: `events := "ticker"`, err: errInvalidEventSubscription},
+		{script: `events := ["ticker"]`, err: errInvalidEventSubscription},
+		{script: `events := [{type: "ticker"}]`, err: errInvalidEventSubscription},
+		{script: `events := [{type: "ticker", exchange: "bitstamp", pair: "X", delimiter: "-"}]`, err: errInvalidEventSubscription},
+		{script: `events := [{type: "ticker", exchange: "bitstamp", asset: "meow"}]`, err: errInvalidEventSubscription},
+		{script: `events := [{type: "message"}]`, err: errInvalidEventSubscription},
+		{script: `events := [{type: "Ticker", exchange: "bitstamp", pair: "BTC-USD", delimiter: "-", asset: "spot"}, {type: "fill", exchange: "bitstamp"}, {type: "message", topic: "signals"}]`, subs: 3},
+	} {
+		s := tengo.NewScript([]byte(tc.script))
+		c, err := s.Run()
+		require.NoErrorf(t, err, "Run must not error for %s", tc.script)
+		testVM := &VM{Compiled: c}
+		subs, err := testVM.parseEvents()
+		require.ErrorIsf(t, err, tc.err, "parseEvents must return the expected error for %s", tc.script)
+		assert.Lenf(t, subs, tc.subs, "parseEvents should return the expected subscriptions for %s", tc.script)
+	}
+
+	c, err := tengo.NewScript([]byte(`events := [{type: "Ticker", exchange: "bitstamp", pair: "BTC-USD", delimiter: "-", asset: "spot"}]`)).Run()
+	require.NoError(t, err, "Run must not error")
+	subs, err := (&VM{Compiled: c}).parseEvents()
+	require.NoError(t, err, "parseEvents must not error")
+	require.Len(t, subs, 1, "parseEvents must return one subscription")
+	assert.Equal(t, modules.EventTicker, subs[0].Type, "Type should be lower cased")
+	assert.Equal(t, "bitstamp", subs[0].Exchange, "Exchange should be set")
+	assert.Equal(t, "BTC-USD", subs[0].Pair.String(), "Pair should be set")
+	assert.Equal(t, asset.Spot, subs[0].Asset, "Asset should be set")
+}
+
+func TestQueueEvents(t *testing.T) {
+	t.Parallel()
+	testVM := &VM{config: configHelper(true, true, maxTestVirtualMachines), eventQueue: make(chan *modules.Event, 1)}
+	testVM.eventQueue <- &modules.Event{Type: modules.EventOrderbook}
+	ch := make(chan *modules.Event, 2)
+	ch <- &modules.Event{Type: modules.EventTicker}
+	ch <- &modules.Event{Type: modules.EventTrade}
+	close(ch)
+	testVM.queueEvents(t.Context(), ch)
+	assert.Equal(t, uint64(2), testVM.eventsDropped.Load(), "Market data events should be dropped when the queue is full")
+
+	ch = make(chan *modules.Event, 1)
+	ch <- &modules.Event{Type: modules.EventFill}
+	close(ch)
+	done := make(chan struct{})
+	go func() {
+		testVM.queueEvents(t.Context(), ch)
+		close(done)
+	}()
+	e := <-testVM.eventQueue
+	assert.Equal(t, modules.EventOrderbook, e.Type, "Queued event should be received first")
+	e = <-testVM.eventQueue
+	assert.Equal(t, modules.EventFill, e.Type, "Fill event should wait for queue space")
+	<-done
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	ch = make(chan *modules.Event, 1)
+	ch <- &modules.Event{Type: modules.EventBalance}
+	testVM.eventQueue <- &modules.Event{Type: modules.EventTicker}
+	testVM.queueEvents(ctx, ch)
+	assert.Len(t, testVM.eventQueue, 1, "Balance event should not be queued once cancelled")
+}
+
 func configHelper(enabled, imports bool, maxVMs uint64) *Config {
 	return &Config{
 		Enabled:            enabled,
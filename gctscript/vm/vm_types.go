@@ -2,10 +2,18 @@ package vm
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 const (
@@ -13,6 +21,8 @@ const (
 	DefaultTimeoutValue = 30 * time.Second
 	// DefaultMaxVirtualMachines max number of virtual machines that can be loaded at one time
 	DefaultMaxVirtualMachines uint64 = 10
+	// DefaultEventQueueSize number of events queued for a script before market data events are dropped
+	DefaultEventQueueSize = 100
 
 	// TypeLoad text to display in script_event table when a VM is loaded
 	TypeLoad = "load"
@@ -31,6 +41,12 @@ const (
 	StatusFailure = "failure"
 )
 
+// Script variables used to receive events
+const (
+	eventsVariable = "events"
+	eventVariable  = "event"
+)
+
 type vmscount uint64
 
 var (
@@ -54,4 +70,59 @@ type VM struct {
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+
+	// ctx is the script context passed to modules, simulated scripts are not
+	// recorded as script events
+	ctx       *gct.Context
+	simulated bool
+
+	// m serialises timer and event runs of the compiled script
+	m             sync.Mutex
+	eventQueue    chan *modules.Event
+	eventsDropped atomic.Uint64
+	// stopOnce closes S once when both a failing event run and the manager
+	// shut down the VM
+	stopOnce sync.Once
+}
+
+// SimulationConfig defines the market data a script is simulated against,
+// candles are loaded from DataFile when set otherwise from the database
+type SimulationConfig struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Start    time.Time
+	End      time.Time
+	DataFile string
+	// Balances are the starting balances, orders are rejected when there
+	// are not enough free funds. Without balances funds are not checked
+	Balances map[currency.Code]float64
+	// Trace records each module function call made by the script
+	Trace bool
+}
+
+// SimulationResult holds the orders and withdrawals captured while
+// simulating a script along with the final balances
+type SimulationResult struct {
+	Steps       int                `json:"steps"`
+	Runs        int                `json:"runs"`
+	Orders      []order.Detail     `json:"orders"`
+	Withdrawals []withdraw.Request `json:"withdrawals"`
+	Balances    map[string]float64 `json:"balances"`
+	Trace       []TraceEntry       `json:"trace,omitempty"`
+	// Error is set when the script fails, the simulation stops at the
+	// failing step
+	Error string `json:"error,omitempty"`
+}
+
+// TraceEntry is a module function call made by a simulated script
+type TraceEntry struct {
+	Step     int       `json:"step"`
+	Time     time.Time `json:"time"`
+	Module   string    `json:"module"`
+	Function string    `json:"function"`
+	Args     []string  `json:"args"`
+	Result   string    `json:"result,omitempty"`
+	Error    string    `json:"error,omitempty"`
 }
@@ -0,0 +1,163 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// eventSubscribeRetryDelay is how long to wait before retrying a subscription
+// to a feed which is not yet available, such as tickers which have not been
+// fetched for an exchange
+var eventSubscribeRetryDelay = time.Second * 5
+
+var (
+	errUnsupportedEventType = errors.New("unsupported event type")
+	errEventSourceDisabled  = errors.New("event source subsystem is not running")
+)
+
+// SubscribeEvents streams the events matching the subscription until ctx is
+// cancelled, at which point the returned channel is closed
+func (e Exchange) SubscribeEvents(ctx context.Context, sub *modules.EventSubscription) (<-chan *modules.Event, error) {
+	if sub == nil {
+		return nil, fmt.Errorf("%T %w", sub, common.ErrNilPointer)
+	}
+	exch, err := e.GetExchange(sub.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	name := exch.GetName()
+
+	var subscribe func() (dispatch.Pipe, error)
+	switch sub.Type {
+	case modules.EventTicker:
+		subscribe = func() (dispatch.Pipe, error) { return ticker.SubscribeToExchangeTickers(name) }
+	case modules.EventOrderbook:
+		subscribe = func() (dispatch.Pipe, error) { return orderbook.SubscribeToExchangeOrderbooks(name) }
+	case modules.EventTrade:
+		if engine.Bot.WebsocketRoutineManager == nil {
+			return nil, fmt.Errorf("%s %w", sub.Type, errEventSourceDisabled)
+		}
+		subscribe = engine.Bot.WebsocketRoutineManager.SubscribeTrades
+	case modules.EventFill:
+		if !engine.Bot.OrderManager.IsRunning() {
+			return nil, fmt.Errorf("%s %w", sub.Type, errEventSourceDisabled)
+		}
+		subscribe = engine.Bot.OrderManager.SubscribeOrderFills
+	case modules.EventBalance:
+		subscribe = exch.SubscribeAccountBalances
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedEventType, sub.Type)
+	}
+
+	out := make(chan *modules.Event)
+	go relayEvents(ctx, sub, name, subscribe, out)
+	return out, nil
+}
+
+// relayEvents forwards matching dispatch updates to out, retrying the
+// subscription until the feed becomes available
+func relayEvents(ctx context.Context, sub *modules.EventSubscription, exchName string, subscribe func() (dispatch.Pipe, error), out chan<- *modules.Event) {
+	defer close(out)
+	var pipe dispatch.Pipe
+	for {
+		var err error
+		if pipe, err = subscribe(); err == nil {
+			break
+		}
+		log.Debugf(log.GCTScriptMgr, "%s %s events unavailable, retrying in %s: %v", exchName, sub.Type, eventSubscribeRetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventSubscribeRetryDelay):
+		}
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorln(log.DispatchMgr, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			ev := eventFromData(sub, exchName, data)
+			if ev == nil {
+				continue
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// eventFromData converts a dispatch update to an event, returning nil when
+// the update does not match the subscription
+func eventFromData(sub *modules.EventSubscription, exchName string, data any) *modules.Event {
+	ev := &modules.Event{Type: sub.Type, Exchange: exchName, Time: time.Now()}
+	switch d := data.(type) {
+	case *ticker.Price:
+		if !strings.EqualFold(d.ExchangeName, exchName) || !eventMatches(sub, d.Pair, d.AssetType) {
+			return nil
+		}
+		ev.Pair, ev.Asset, ev.Data = d.Pair, d.AssetType, d
+	case orderbook.Outbound:
+		book, err := d.Retrieve()
+		if err != nil || !eventMatches(sub, book.Pair, book.Asset) {
+			return nil
+		}
+		ev.Pair, ev.Asset, ev.Data = book.Pair, book.Asset, book
+	case []trade.Data:
+		trades := make([]trade.Data, 0, len(d))
+		for i := range d {
+			if strings.EqualFold(d[i].Exchange, exchName) && eventMatches(sub, d[i].CurrencyPair, d[i].AssetType) {
+				trades = append(trades, d[i])
+			}
+		}
+		if len(trades) == 0 {
+			return nil
+		}
+		ev.Pair, ev.Asset, ev.Data = trades[0].CurrencyPair, trades[0].AssetType, trades
+	case *order.Detail:
+		if !strings.EqualFold(d.Exchange, exchName) || !eventMatches(sub, d.Pair, d.AssetType) {
+			return nil
+		}
+		ev.Pair, ev.Asset, ev.Data = d.Pair, d.AssetType, d
+	case *accounts.SubAccount:
+		if sub.Asset != asset.Empty && sub.Asset != d.AssetType {
+			return nil
+		}
+		ev.Asset, ev.Data = d.AssetType, d
+	default:
+		return nil
+	}
+	return ev
+}
+
+// eventMatches returns whether the pair and asset match the subscription
+func eventMatches(sub *modules.EventSubscription, p currency.Pair, a asset.Item) bool {
+	return (sub.Pair.IsEmpty() || sub.Pair.Equal(p)) && (sub.Asset == asset.Empty || sub.Asset == a)
+}
@@ -7,16 +7,21 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -130,6 +135,92 @@ func (e Exchange) CancelOrder(ctx context.Context, exch, orderID string, cp curr
 	return true, nil
 }
 
+// ModifyOrder amends an existing order on exchange and updates the order
+// manager with the result
+func (e Exchange) ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	return engine.Bot.OrderManager.Modify(ctx, mod)
+}
+
+// CancelAllOrders cancels all orders on exchange matching the cancel request
+// and updates the order manager with the result
+func (e Exchange) CancelAllOrders(ctx context.Context, cancel *order.Cancel) (order.CancelAllResponse, error) {
+	return engine.Bot.OrderManager.CancelAll(ctx, cancel)
+}
+
+// ActiveOrders returns open orders on exchange matching the request
+func (e Exchange) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetActiveOrders(ctx, req)
+}
+
+// OrderHistory returns closed orders on exchange matching the request
+func (e Exchange) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOrderHistory(ctx, req)
+}
+
+// RecentTrades returns the most recent public trades for a currency pair
+func (e Exchange) RecentTrades(ctx context.Context, exch string, pair currency.Pair, a asset.Item) ([]trade.Data, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetRecentTrades(ctx, pair, a)
+}
+
+// FuturesPositions returns position order history for the requested futures
+// pairs
+func (e Exchange) FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositions(ctx, req)
+}
+
+// LatestFundingRates returns the current funding rates for perpetual contracts
+func (e Exchange) LatestFundingRates(ctx context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetLatestFundingRates(ctx, req)
+}
+
+// HistoricalFundingRates returns funding rates and payments over a period
+func (e Exchange) HistoricalFundingRates(ctx context.Context, exch string, req *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetHistoricalFundingRates(ctx, req)
+}
+
+// OpenInterest returns open interest for the requested pairs or all pairs
+// when none are provided
+func (e Exchange) OpenInterest(ctx context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOpenInterest(ctx, keys...)
+}
+
+// SetLeverage sets the account leverage for a futures pair
+func (e Exchange) SetLeverage(ctx context.Context, exch string, item asset.Item, pair currency.Pair, marginType margin.Type, amount float64, side order.Side) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(ctx, item, pair, marginType, amount, side)
+}
+
 // AccountBalances returns account balances for requested exchange
 func (e Exchange) AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
 	ex, err := e.GetExchange(exch)
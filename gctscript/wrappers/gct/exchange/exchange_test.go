@@ -8,12 +8,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -40,6 +49,7 @@ var (
 		DataDir:      filepath.Join("..", "..", "..", "..", "testdata", "gocryptotrader"),
 	}
 	exchangeTest = Exchange{}
+	currencyPair = currency.NewPairWithDelimiter("BTC", "AUD", delimiter)
 )
 
 func TestMain(m *testing.M) {
@@ -183,6 +193,81 @@ func TestExchange_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestExchange_ModifyOrder(t *testing.T) {
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	t.Parallel()
+	_, err := exchangeTest.ModifyOrder(t.Context(), &order.Modify{
+		Exchange:  exchName,
+		OrderID:   orderID,
+		Pair:      currencyPair,
+		AssetType: assetType,
+		Price:     orderPrice,
+		Amount:    orderAmount,
+	})
+	require.NoError(t, err, "ModifyOrder must not error")
+}
+
+func TestExchange_CancelAllOrders(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.CancelAllOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, engine.ErrNilSubsystem, "CancelAllOrders should cancel through the order manager")
+
+	_, err = exchangeTest.CancelAllOrders(t.Context(), &order.Cancel{Exchange: "hello world"})
+	assert.Error(t, err, "CancelAllOrders should error on an unknown exchange")
+}
+
+func TestExchange_ActiveOrders(t *testing.T) {
+	t.Parallel()
+	req := &order.MultiOrderRequest{AssetType: assetType, Side: order.AnySide, Type: order.AnyType}
+	_, err := exchangeTest.ActiveOrders(t.Context(), "hello world", req)
+	assert.Error(t, err, "ActiveOrders should error on an unknown exchange")
+
+	_, err = exchangeTest.OrderHistory(t.Context(), "hello world", req)
+	assert.Error(t, err, "OrderHistory should error on an unknown exchange")
+
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	_, err = exchangeTest.ActiveOrders(t.Context(), exchName, req)
+	require.NoError(t, err, "ActiveOrders must not error")
+	_, err = exchangeTest.OrderHistory(t.Context(), exchName, req)
+	require.NoError(t, err, "OrderHistory must not error")
+}
+
+func TestExchange_RecentTrades(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.RecentTrades(t.Context(), "hello world", currencyPair, assetType)
+	assert.Error(t, err, "RecentTrades should error on an unknown exchange")
+
+	trades, err := exchangeTest.RecentTrades(t.Context(), exchName, currencyPair, assetType)
+	require.NoError(t, err, "RecentTrades must not error")
+	assert.NotEmpty(t, trades, "RecentTrades should return trades")
+}
+
+func TestExchange_Futures(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.FuturesPositions(t.Context(), "hello world", &futures.PositionsRequest{})
+	assert.Error(t, err, "FuturesPositions should error on an unknown exchange")
+
+	_, err = exchangeTest.LatestFundingRates(t.Context(), "hello world", &fundingrate.LatestRateRequest{})
+	assert.Error(t, err, "LatestFundingRates should error on an unknown exchange")
+
+	_, err = exchangeTest.HistoricalFundingRates(t.Context(), "hello world", &fundingrate.HistoricalRatesRequest{})
+	assert.Error(t, err, "HistoricalFundingRates should error on an unknown exchange")
+
+	_, err = exchangeTest.OpenInterest(t.Context(), "hello world")
+	assert.Error(t, err, "OpenInterest should error on an unknown exchange")
+
+	err = exchangeTest.SetLeverage(t.Context(), "hello world", asset.Futures, currencyPair, margin.Isolated, 1, order.UnknownSide)
+	assert.Error(t, err, "SetLeverage should error on an unknown exchange")
+
+	// BTC Markets does not support futures
+	_, err = exchangeTest.OpenInterest(t.Context(), exchName)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
+
 func TestOHLCV(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPair(currency.BTC, currency.AUD)
@@ -225,3 +310,57 @@ func configureExchangeKeys() bool {
 	b.SkipAuthCheck = true
 	return b.AreCredentialsValid(context.Background())
 }
+
+func TestExchange_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.SubscribeEvents(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = exchangeTest.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: modules.EventTicker, Exchange: "hello world"})
+	assert.Error(t, err, "SubscribeEvents should error on an unknown exchange")
+
+	_, err = exchangeTest.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: "meow", Exchange: exchName})
+	assert.ErrorIs(t, err, errUnsupportedEventType)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	ch, err := exchangeTest.SubscribeEvents(ctx, &modules.EventSubscription{Type: modules.EventBalance, Exchange: exchName})
+	require.NoError(t, err, "SubscribeEvents must not error")
+	cancel()
+	assert.Eventually(t, func() bool {
+		select {
+		case _, ok := <-ch:
+			return !ok
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond, "Event channel should be closed when context is cancelled")
+}
+
+func TestEventFromData(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.AUD)
+	sub := &modules.EventSubscription{Type: modules.EventTicker, Exchange: exchName, Pair: p, Asset: asset.Spot}
+
+	assert.Nil(t, eventFromData(sub, exchName, "meow"), "Unknown data should be ignored")
+	assert.Nil(t, eventFromData(sub, exchName, &ticker.Price{ExchangeName: "other", Pair: p, AssetType: asset.Spot}), "Other exchange tickers should be ignored")
+	assert.Nil(t, eventFromData(sub, exchName, &ticker.Price{ExchangeName: exchName, Pair: currency.NewBTCUSDT(), AssetType: asset.Spot}), "Other pairs should be ignored")
+	e := eventFromData(sub, exchName, &ticker.Price{ExchangeName: exchName, Pair: p, AssetType: asset.Spot})
+	require.NotNil(t, e, "Matching ticker must produce an event")
+	assert.Equal(t, p, e.Pair, "Pair should be set")
+
+	sub = &modules.EventSubscription{Type: modules.EventTrade, Exchange: exchName}
+	e = eventFromData(sub, exchName, []trade.Data{
+		{Exchange: "other", CurrencyPair: p, AssetType: asset.Spot},
+		{Exchange: exchName, CurrencyPair: p, AssetType: asset.Spot},
+	})
+	require.NotNil(t, e, "Matching trades must produce an event")
+	assert.Len(t, e.Data, 1, "Only trades for the exchange should be included")
+
+	sub = &modules.EventSubscription{Type: modules.EventFill, Exchange: exchName, Asset: asset.Futures}
+	assert.Nil(t, eventFromData(sub, exchName, &order.Detail{Exchange: exchName, Pair: p, AssetType: asset.Spot}), "Other assets should be ignored")
+	assert.NotNil(t, eventFromData(sub, exchName, &order.Detail{Exchange: exchName, Pair: p, AssetType: asset.Futures}), "Matching fill should produce an event")
+
+	sub = &modules.EventSubscription{Type: modules.EventBalance, Exchange: exchName, Asset: asset.Spot}
+	assert.Nil(t, eventFromData(sub, exchName, &accounts.SubAccount{AssetType: asset.Futures}), "Other asset balances should be ignored")
+	assert.NotNil(t, eventFromData(sub, exchName, &accounts.SubAccount{AssetType: asset.Spot}), "Matching balance should produce an event")
+}
@@ -0,0 +1,657 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// NewWrapper returns a wrapper simulating the exchange, pair and asset of the
+// candles. When balances are supplied orders are rejected if there are not
+// enough free funds, otherwise balances are tracked from zero and may go
+// negative
+func NewWrapper(k *kline.Item, balances map[currency.Code]float64) (*Wrapper, error) {
+	if k == nil || len(k.Candles) == 0 {
+		return nil, errNoCandles
+	}
+	candles := slices.Clone(k.Candles)
+	slices.SortFunc(candles, func(a, b kline.Candle) int {
+		return a.Time.Compare(b.Time)
+	})
+	w := &Wrapper{
+		exchange: k.Exchange,
+		pair:     k.Pair,
+		asset:    k.Asset,
+		interval: k.Interval,
+		candles:  candles,
+		step:     -1,
+		enforce:  len(balances) > 0,
+		balances: make(map[currency.Code]float64, len(balances)),
+		holds:    make(map[currency.Code]float64),
+	}
+	maps.Copy(w.balances, balances)
+	return w, nil
+}
+
+// Next advances the simulation to the next candle, filling resting orders
+// which trade through their price, and returns false once all candles have
+// been processed
+func (w *Wrapper) Next() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.step+1 >= len(w.candles) {
+		return false
+	}
+	w.step++
+	c := w.candles[w.step]
+	w.queueMarketEvents(&c)
+	for _, d := range w.orders {
+		if !d.IsActive() {
+			continue
+		}
+		if (d.Side.IsLong() && c.Low <= d.Price) || (d.Side.IsShort() && c.High >= d.Price) {
+			w.fill(d, d.Price, c.Time)
+		}
+	}
+	return true
+}
+
+// Time returns the time of the current candle
+func (w *Wrapper) Time() time.Time {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.step < 0 {
+		return time.Time{}
+	}
+	return w.candles[w.step].Time
+}
+
+// Events returns and clears the events raised since the last call, events
+// raised by orders submitted while running a script are returned with the
+// events of the next candle
+func (w *Wrapper) Events() []*modules.Event {
+	w.m.Lock()
+	defer w.m.Unlock()
+	e := w.events
+	w.events = nil
+	return e
+}
+
+// Orders returns the orders submitted to the simulation
+func (w *Wrapper) Orders() []order.Detail {
+	w.m.Lock()
+	defer w.m.Unlock()
+	resp := make([]order.Detail, len(w.orders))
+	for i := range w.orders {
+		resp[i] = w.orders[i].Copy()
+	}
+	return resp
+}
+
+// Withdrawals returns the withdrawal requests captured by the simulation
+func (w *Wrapper) Withdrawals() []withdraw.Request {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return slices.Clone(w.withdrawals)
+}
+
+// Balances returns the simulated balances
+func (w *Wrapper) Balances() map[currency.Code]float64 {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return maps.Clone(w.balances)
+}
+
+// Exchanges returns the simulated exchange
+func (w *Wrapper) Exchanges(_ bool) []string {
+	return []string{w.exchange}
+}
+
+// IsEnabled returns if the exchange is the simulated exchange
+func (w *Wrapper) IsEnabled(exch string) bool {
+	return strings.EqualFold(exch, w.exchange)
+}
+
+// Orderbook returns a single level orderbook at the current close price
+func (w *Wrapper) Orderbook(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*orderbook.Book, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	c, err := w.current(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	return w.book(&c), nil
+}
+
+// Ticker returns a ticker derived from the current candle
+func (w *Wrapper) Ticker(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	c, err := w.current(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	return w.ticker(&c), nil
+}
+
+// Pairs returns the simulated currency pair
+func (w *Wrapper) Pairs(exch string, _ bool, item asset.Item) (*currency.Pairs, error) {
+	if err := w.check(exch, currency.EMPTYPAIR, item); err != nil {
+		return nil, err
+	}
+	return &currency.Pairs{w.pair}, nil
+}
+
+// QueryOrder returns a submitted order
+func (w *Wrapper) QueryOrder(_ context.Context, exch, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	d, err := w.order(exch, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return d.CopyToPointer(), nil
+}
+
+// SubmitOrder captures a market or limit order, market orders and limit
+// orders which cross the current close are filled at the close whereas other
+// limit orders rest until a later candle trades through their price
+func (w *Wrapper) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if err := s.Validate(protocol.TradingRequirements{}); err != nil {
+		return nil, err
+	}
+	if s.Type != order.Market && s.Type != order.Limit {
+		return nil, fmt.Errorf("%w: %s", errOrderTypeNotSimulated, s.Type)
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	c, err := w.current(s.Exchange, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	w.nextID++
+	resp, err := s.DeriveSubmitResponse(strconv.FormatInt(w.nextID, 10))
+	if err != nil {
+		return nil, err
+	}
+	resp.Date, resp.LastUpdated = c.Time, c.Time
+	if resp.Type == order.Market {
+		resp.Price = c.Close
+	}
+	if resp.Amount == 0 {
+		resp.Amount = resp.QuoteAmount / resp.Price
+	}
+	resp.Status = order.New
+	d, err := resp.DeriveDetail(uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	d.RemainingAmount = d.Amount
+	if err := w.hold(d); err != nil {
+		d.Status = order.Rejected
+		w.orders = append(w.orders, d)
+		return nil, err
+	}
+	w.orders = append(w.orders, d)
+	if crosses(d, c.Close) {
+		w.fill(d, c.Close, c.Time)
+	}
+	resp.Status = d.Status
+	return resp, nil
+}
+
+// CancelOrder cancels an open order
+func (w *Wrapper) CancelOrder(_ context.Context, exch, orderID string, _ currency.Pair, _ asset.Item) (bool, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	d, err := w.order(exch, orderID)
+	if err != nil {
+		return false, err
+	}
+	if !d.IsActive() {
+		return false, fmt.Errorf("%w: %s %s", errOrderNotOpen, orderID, d.Status)
+	}
+	w.cancel(d)
+	return true, nil
+}
+
+// ModifyOrder amends the price and amount of an open limit order, zero values
+// are left unchanged
+func (w *Wrapper) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil {
+		return nil, order.ErrModifyOrderIsNil
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	d, err := w.order(mod.Exchange, mod.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if !d.IsActive() {
+		return nil, fmt.Errorf("%w: %s %s", errOrderNotOpen, mod.OrderID, d.Status)
+	}
+	if d.Type != order.Limit {
+		return nil, fmt.Errorf("%w: cannot modify %s order", errOrderTypeNotSimulated, d.Type)
+	}
+	price, amount := d.Price, d.Amount
+	w.release(d)
+	if mod.Price > 0 {
+		d.Price = mod.Price
+	}
+	if mod.Amount > 0 {
+		d.Amount, d.RemainingAmount = mod.Amount, mod.Amount
+	}
+	if err := w.hold(d); err != nil {
+		d.Price, d.Amount, d.RemainingAmount = price, amount, amount
+		if holdErr := w.hold(d); holdErr != nil {
+			return nil, holdErr
+		}
+		return nil, err
+	}
+	c := w.candles[w.step]
+	d.LastUpdated = c.Time
+	if crosses(d, c.Close) {
+		w.fill(d, c.Close, c.Time)
+	}
+	resp, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Pair = d.Pair
+	resp.AssetType = d.AssetType
+	resp.Type = d.Type
+	resp.Side = d.Side
+	resp.Status = d.Status
+	resp.Price = d.Price
+	resp.Amount = d.Amount
+	resp.RemainingAmount = d.RemainingAmount
+	resp.Date = d.Date
+	resp.LastUpdated = d.LastUpdated
+	return resp, nil
+}
+
+// CancelAllOrders cancels all open orders
+func (w *Wrapper) CancelAllOrders(_ context.Context, cancel *order.Cancel) (order.CancelAllResponse, error) {
+	if cancel == nil {
+		return order.CancelAllResponse{}, order.ErrCancelOrderIsNil
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err := w.check(cancel.Exchange, cancel.Pair, cancel.AssetType); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	for _, d := range w.orders {
+		if d.IsActive() {
+			w.cancel(d)
+			resp.Status[d.OrderID] = d.Status.String()
+		}
+	}
+	return resp, nil
+}
+
+// ActiveOrders returns open orders matching the request
+func (w *Wrapper) ActiveOrders(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return w.filterOrders(exch, req, true)
+}
+
+// OrderHistory returns filled, cancelled and rejected orders matching the
+// request
+func (w *Wrapper) OrderHistory(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return w.filterOrders(exch, req, false)
+}
+
+// RecentTrades returns a single trade at the current close for the candle
+// volume
+func (w *Wrapper) RecentTrades(_ context.Context, exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	c, err := w.current(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	return w.trades(&c), nil
+}
+
+// AccountBalances returns the simulated balances
+func (w *Wrapper) AccountBalances(_ context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err := w.check(exch, currency.EMPTYPAIR, assetType); err != nil {
+		return nil, err
+	}
+	return accounts.SubAccounts{w.subAccount()}, nil
+}
+
+// DepositAddress is not supported by the simulation
+func (w *Wrapper) DepositAddress(_, _ string, _ currency.Code) (*deposit.Address, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// WithdrawalCryptoFunds captures a crypto withdrawal request
+func (w *Wrapper) WithdrawalCryptoFunds(_ context.Context, r *withdraw.Request) (string, error) {
+	return w.withdraw(r)
+}
+
+// WithdrawalFiatFunds captures a fiat withdrawal request
+func (w *Wrapper) WithdrawalFiatFunds(_ context.Context, _ string, r *withdraw.Request) (string, error) {
+	return w.withdraw(r)
+}
+
+// OHLCV returns the candles between start and end up to and including the
+// current candle so scripts cannot see future prices
+func (w *Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.Item, start, end time.Time, i kline.Interval) (*kline.Item, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	c, err := w.current(exch, p, a)
+	if err != nil {
+		return nil, err
+	}
+	if i != w.interval {
+		return nil, fmt.Errorf("%w: %s", errIntervalNotSimulated, i)
+	}
+	k := &kline.Item{
+		Exchange: w.exchange,
+		Pair:     w.pair,
+		Asset:    w.asset,
+		Interval: w.interval,
+	}
+	for x := range w.candles[:w.step+1] {
+		if !w.candles[x].Time.Before(start) && w.candles[x].Time.Before(end) && !w.candles[x].Time.After(c.Time) {
+			k.Candles = append(k.Candles, w.candles[x])
+		}
+	}
+	return k, nil
+}
+
+// FuturesPositions is not supported by the simulation
+func (w *Wrapper) FuturesPositions(_ context.Context, _ string, _ *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// LatestFundingRates is not supported by the simulation
+func (w *Wrapper) LatestFundingRates(_ context.Context, _ string, _ *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// HistoricalFundingRates is not supported by the simulation
+func (w *Wrapper) HistoricalFundingRates(_ context.Context, _ string, _ *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// OpenInterest is not supported by the simulation
+func (w *Wrapper) OpenInterest(_ context.Context, _ string, _ ...key.PairAsset) ([]futures.OpenInterest, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage accepts leverage changes for the simulated exchange, leverage
+// does not affect simulated orders
+func (w *Wrapper) SetLeverage(_ context.Context, exch string, item asset.Item, pair currency.Pair, _ margin.Type, _ float64, _ order.Side) error {
+	return w.check(exch, pair, item)
+}
+
+// SubscribeEvents returns a channel which is closed when ctx is cancelled,
+// simulated events are returned by Events as the simulation is stepped
+func (w *Wrapper) SubscribeEvents(ctx context.Context, sub *modules.EventSubscription) (<-chan *modules.Event, error) {
+	if sub == nil {
+		return nil, common.ErrNilPointer
+	}
+	if err := w.check(sub.Exchange, sub.Pair, sub.Asset); err != nil {
+		return nil, err
+	}
+	ch := make(chan *modules.Event)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// check returns an error if the exchange, pair or asset are not simulated,
+// an empty pair or asset matches the simulated pair and asset
+func (w *Wrapper) check(exch string, pair currency.Pair, item asset.Item) error {
+	if !strings.EqualFold(exch, w.exchange) {
+		return fmt.Errorf("%w: %q", errExchangeNotSimulated, exch)
+	}
+	if !pair.IsEmpty() && !pair.Equal(w.pair) {
+		return fmt.Errorf("%w: %s", errPairNotSimulated, pair)
+	}
+	if item != asset.Empty && item != w.asset {
+		return fmt.Errorf("%w: %s", errAssetNotSimulated, item)
+	}
+	return nil
+}
+
+// current checks the request and returns the current candle, w.m must be
+// held
+func (w *Wrapper) current(exch string, pair currency.Pair, item asset.Item) (kline.Candle, error) {
+	if err := w.check(exch, pair, item); err != nil {
+		return kline.Candle{}, err
+	}
+	if w.step < 0 {
+		return kline.Candle{}, errSimulationNotStarted
+	}
+	return w.candles[w.step], nil
+}
+
+// order returns a submitted order by ID, w.m must be held
+func (w *Wrapper) order(exch, orderID string) (*order.Detail, error) {
+	if err := w.check(exch, currency.EMPTYPAIR, asset.Empty); err != nil {
+		return nil, err
+	}
+	for _, d := range w.orders {
+		if d.OrderID == orderID {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", errOrderNotFound, orderID)
+}
+
+func (w *Wrapper) filterOrders(exch string, req *order.MultiOrderRequest, active bool) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err := w.check(exch, currency.EMPTYPAIR, req.AssetType); err != nil {
+		return nil, err
+	}
+	orders := make([]order.Detail, 0, len(w.orders))
+	for _, d := range w.orders {
+		if d.IsActive() == active {
+			orders = append(orders, d.Copy())
+		}
+	}
+	return req.Filter(w.exchange, orders), nil
+}
+
+// hold reserves the funds required by an order, w.m must be held
+func (w *Wrapper) hold(d *order.Detail) error {
+	code, amount := w.required(d)
+	if w.enforce && w.balances[code]-w.holds[code] < amount {
+		return fmt.Errorf("%w: %s %v required %v free", errInsufficientBalance, code, amount, w.balances[code]-w.holds[code])
+	}
+	w.holds[code] += amount
+	return nil
+}
+
+// release returns the funds reserved by an order, w.m must be held
+func (w *Wrapper) release(d *order.Detail) {
+	code, amount := w.required(d)
+	w.holds[code] -= amount
+}
+
+// required returns the currency and amount reserved by an order
+func (w *Wrapper) required(d *order.Detail) (currency.Code, float64) {
+	if d.Side.IsLong() {
+		return w.pair.Quote, d.RemainingAmount * d.Price
+	}
+	return w.pair.Base, d.RemainingAmount
+}
+
+// fill fills an order at price and settles balances, w.m must be held
+func (w *Wrapper) fill(d *order.Detail, price float64, t time.Time) {
+	w.release(d)
+	cost := d.RemainingAmount * price
+	if d.Side.IsLong() {
+		w.balances[w.pair.Base] += d.RemainingAmount
+		w.balances[w.pair.Quote] -= cost
+	} else {
+		w.balances[w.pair.Base] -= d.RemainingAmount
+		w.balances[w.pair.Quote] += cost
+	}
+	d.Trades = append(d.Trades, order.TradeHistory{
+		TID:       d.OrderID,
+		Price:     price,
+		Amount:    d.RemainingAmount,
+		Exchange:  d.Exchange,
+		Type:      d.Type,
+		Side:      d.Side,
+		Timestamp: t,
+	})
+	d.ExecutedAmount = d.Amount
+	d.RemainingAmount = 0
+	d.AverageExecutedPrice = price
+	d.Cost = cost
+	d.Status = order.Filled
+	d.LastUpdated = t
+	d.CloseTime = t
+	w.queueEvent(modules.EventFill, t, d.CopyToPointer())
+	w.queueEvent(modules.EventBalance, t, w.subAccount())
+}
+
+// cancel cancels an order and releases its funds, w.m must be held
+func (w *Wrapper) cancel(d *order.Detail) {
+	w.release(d)
+	d.Status = order.Cancelled
+	if w.step >= 0 {
+		d.LastUpdated = w.candles[w.step].Time
+		d.CloseTime = d.LastUpdated
+	}
+}
+
+func (w *Wrapper) withdraw(r *withdraw.Request) (string, error) {
+	if r == nil {
+		return "", errWithdrawRequestIsNil
+	}
+	if r.Amount <= 0 {
+		return "", errWithdrawAmountIsInvalid
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err := w.check(r.Exchange, currency.EMPTYPAIR, asset.Empty); err != nil {
+		return "", err
+	}
+	w.withdrawals = append(w.withdrawals, *r)
+	w.nextID++
+	return strconv.FormatInt(w.nextID, 10), nil
+}
+
+// crosses returns whether an order would trade at price
+func crosses(d *order.Detail, price float64) bool {
+	if d.Type == order.Market {
+		return true
+	}
+	if d.Side.IsLong() {
+		return d.Price >= price
+	}
+	return d.Price <= price
+}
+
+func (w *Wrapper) subAccount() *accounts.SubAccount {
+	s := &accounts.SubAccount{
+		ID:        "simulated",
+		AssetType: w.asset,
+		Balances:  make(accounts.CurrencyBalances, len(w.balances)),
+	}
+	for code, total := range w.balances {
+		s.Balances.Set(code, accounts.Balance{
+			Total: total,
+			Hold:  w.holds[code],
+			Free:  total - w.holds[code],
+		})
+	}
+	return s
+}
+
+func (w *Wrapper) ticker(c *kline.Candle) *ticker.Price {
+	return &ticker.Price{
+		Last:         c.Close,
+		High:         c.High,
+		Low:          c.Low,
+		Bid:          c.Close,
+		Ask:          c.Close,
+		Volume:       c.Volume,
+		Open:         c.Open,
+		Close:        c.Close,
+		Pair:         w.pair,
+		ExchangeName: w.exchange,
+		AssetType:    w.asset,
+		LastUpdated:  c.Time,
+	}
+}
+
+func (w *Wrapper) book(c *kline.Candle) *orderbook.Book {
+	return &orderbook.Book{
+		Exchange:    w.exchange,
+		Pair:        w.pair,
+		Asset:       w.asset,
+		Bids:        []orderbook.Level{{Price: c.Close, Amount: c.Volume}},
+		Asks:        []orderbook.Level{{Price: c.Close, Amount: c.Volume}},
+		LastUpdated: c.Time,
+	}
+}
+
+func (w *Wrapper) trades(c *kline.Candle) []trade.Data {
+	return []trade.Data{{
+		TID:          strconv.Itoa(w.step),
+		Exchange:     w.exchange,
+		CurrencyPair: w.pair,
+		AssetType:    w.asset,
+		Price:        c.Close,
+		Amount:       c.Volume,
+		Timestamp:    c.Time,
+	}}
+}
+
+// queueMarketEvents queues ticker, orderbook and trade events for a candle,
+// w.m must be held
+func (w *Wrapper) queueMarketEvents(c *kline.Candle) {
+	w.queueEvent(modules.EventTicker, c.Time, w.ticker(c))
+	w.queueEvent(modules.EventOrderbook, c.Time, w.book(c))
+	w.queueEvent(modules.EventTrade, c.Time, w.trades(c))
+}
+
+func (w *Wrapper) queueEvent(eventType string, t time.Time, data any) {
+	w.events = append(w.events, &modules.Event{
+		Type:     eventType,
+		Exchange: w.exchange,
+		Pair:     w.pair,
+		Asset:    w.asset,
+		Time:     t,
+		Data:     data,
+	})
+}
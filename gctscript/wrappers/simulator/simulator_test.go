@@ -0,0 +1,282 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const exchName = "binance"
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var _ modules.GCTExchange = (*Wrapper)(nil)
+
+func newTestWrapper(t *testing.T, balances map[currency.Code]float64) *Wrapper {
+	t.Helper()
+	k := &kline.Item{
+		Exchange: exchName,
+		Pair:     testPair,
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+		Candles: []kline.Candle{
+			{Time: testStart.Add(time.Hour * 2), Open: 95, High: 100, Low: 80, Close: 85, Volume: 3},
+			{Time: testStart, Open: 100, High: 110, Low: 90, Close: 100, Volume: 1},
+			{Time: testStart.Add(time.Hour), Open: 100, High: 120, Low: 95, Close: 110, Volume: 2},
+		},
+	}
+	w, err := NewWrapper(k, balances)
+	require.NoError(t, err, "NewWrapper must not error")
+	return w
+}
+
+func TestNewWrapper(t *testing.T) {
+	t.Parallel()
+	_, err := NewWrapper(nil, nil)
+	assert.ErrorIs(t, err, errNoCandles, "NewWrapper should error with nil candles")
+
+	_, err = NewWrapper(&kline.Item{}, nil)
+	assert.ErrorIs(t, err, errNoCandles, "NewWrapper should error without candles")
+
+	w := newTestWrapper(t, nil)
+	assert.True(t, w.Time().IsZero(), "Time should be zero before the simulation starts")
+	assert.Equal(t, []string{exchName}, w.Exchanges(true), "Exchanges should return the simulated exchange")
+	assert.True(t, w.IsEnabled("Binance"), "IsEnabled should match the simulated exchange case insensitively")
+	assert.False(t, w.IsEnabled("bitstamp"), "IsEnabled should not match other exchanges")
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+
+	_, err := w.Ticker(t.Context(), exchName, testPair, asset.Spot)
+	assert.ErrorIs(t, err, errSimulationNotStarted, "Ticker should error before the simulation starts")
+
+	var steps int
+	for w.Next() {
+		steps++
+		assert.Equal(t, testStart.Add(time.Hour*time.Duration(steps-1)), w.Time(), "Next should step through candles in time order")
+		e := w.Events()
+		require.Len(t, e, 3, "Next must queue market data events")
+		assert.Equal(t, modules.EventTicker, e[0].Type, "first event should be a ticker")
+		assert.Equal(t, modules.EventOrderbook, e[1].Type, "second event should be an orderbook")
+		assert.Equal(t, modules.EventTrade, e[2].Type, "third event should be a trade")
+		assert.Empty(t, w.Events(), "Events should be cleared once returned")
+	}
+	assert.Equal(t, 3, steps, "Next should step once for each candle")
+}
+
+func TestMarketData(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+	require.True(t, w.Next(), "Next must step to the first candle")
+
+	tick, err := w.Ticker(t.Context(), exchName, testPair, asset.Spot)
+	require.NoError(t, err, "Ticker must not error")
+	assert.Equal(t, 100.0, tick.Last, "Ticker should use the candle close")
+	assert.Equal(t, testStart, tick.LastUpdated, "Ticker should use the candle time")
+
+	_, err = w.Ticker(t.Context(), "bitstamp", testPair, asset.Spot)
+	assert.ErrorIs(t, err, errExchangeNotSimulated, "Ticker should error for other exchanges")
+	_, err = w.Ticker(t.Context(), exchName, currency.NewBTCUSD(), asset.Spot)
+	assert.ErrorIs(t, err, errPairNotSimulated, "Ticker should error for other pairs")
+	_, err = w.Ticker(t.Context(), exchName, testPair, asset.Futures)
+	assert.ErrorIs(t, err, errAssetNotSimulated, "Ticker should error for other assets")
+
+	ob, err := w.Orderbook(t.Context(), exchName, testPair, asset.Spot)
+	require.NoError(t, err, "Orderbook must not error")
+	require.Len(t, ob.Bids, 1, "Orderbook must have a bid")
+	assert.Equal(t, 100.0, ob.Bids[0].Price, "Orderbook should use the candle close")
+
+	trades, err := w.RecentTrades(t.Context(), exchName, testPair, asset.Spot)
+	require.NoError(t, err, "RecentTrades must not error")
+	require.Len(t, trades, 1, "RecentTrades must return a trade")
+	assert.Equal(t, 1.0, trades[0].Amount, "RecentTrades should use the candle volume")
+
+	pairs, err := w.Pairs(exchName, true, asset.Spot)
+	require.NoError(t, err, "Pairs must not error")
+	assert.Equal(t, currency.Pairs{testPair}, *pairs, "Pairs should return the simulated pair")
+
+	require.True(t, w.Next(), "Next must step to the second candle")
+	k, err := w.OHLCV(t.Context(), exchName, testPair, asset.Spot, testStart, testStart.Add(time.Hour*24), kline.OneHour)
+	require.NoError(t, err, "OHLCV must not error")
+	assert.Len(t, k.Candles, 2, "OHLCV should not return candles after the current candle")
+
+	_, err = w.OHLCV(t.Context(), exchName, testPair, asset.Spot, testStart, testStart.Add(time.Hour*24), kline.OneDay)
+	assert.ErrorIs(t, err, errIntervalNotSimulated, "OHLCV should error for other intervals")
+}
+
+func TestSubmitOrder(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+	require.True(t, w.Next(), "Next must step to the first candle")
+	w.Events()
+
+	_, err := w.SubmitOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, order.ErrSubmissionIsNil, "SubmitOrder should error with a nil submission")
+
+	_, err = w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Stop, Amount: 1, Price: 1})
+	assert.ErrorIs(t, err, errOrderTypeNotSimulated, "SubmitOrder should error for unsupported order types")
+
+	resp, err := w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Market, Amount: 2})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "market orders should fill immediately")
+
+	e := w.Events()
+	require.Len(t, e, 2, "a fill must queue fill and balance events")
+	assert.Equal(t, modules.EventFill, e[0].Type, "first event should be a fill")
+	assert.Equal(t, modules.EventBalance, e[1].Type, "second event should be a balance")
+
+	resp, err = w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 1, Price: 115})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, resp.Status, "limit orders above the close should rest")
+
+	active, err := w.ActiveOrders(t.Context(), exchName, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "ActiveOrders must not error")
+	require.Len(t, active, 1, "ActiveOrders must return the resting order")
+
+	require.True(t, w.Next(), "Next must step to the second candle")
+	d, err := w.QueryOrder(t.Context(), exchName, resp.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "QueryOrder must not error")
+	assert.Equal(t, order.Filled, d.Status, "resting orders should fill when the high trades through their price")
+	assert.Equal(t, 115.0, d.AverageExecutedPrice, "resting orders should fill at their price")
+
+	history, err := w.OrderHistory(t.Context(), exchName, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "OrderHistory must not error")
+	assert.Len(t, history, 2, "OrderHistory should return filled orders")
+
+	assert.Equal(t, map[currency.Code]float64{currency.BTC: 1, currency.USDT: -85}, w.Balances(), "Balances should be settled from fills")
+	assert.Len(t, w.Orders(), 2, "Orders should return submitted orders")
+
+	_, err = w.QueryOrder(t.Context(), exchName, "1337", testPair, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotFound, "QueryOrder should error for unknown orders")
+}
+
+func TestSubmitOrderBalances(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, map[currency.Code]float64{currency.USDT: 150})
+	require.True(t, w.Next(), "Next must step to the first candle")
+
+	_, err := w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Market, Amount: 2})
+	assert.ErrorIs(t, err, errInsufficientBalance, "SubmitOrder should error without enough free balance")
+	require.Len(t, w.Orders(), 1, "rejected orders must be captured")
+	assert.Equal(t, order.Rejected, w.Orders()[0].Status, "rejected orders should have a rejected status")
+
+	_, err = w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 1, Price: 90})
+	require.NoError(t, err, "SubmitOrder must not error")
+	_, err = w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 1, Price: 90})
+	assert.ErrorIs(t, err, errInsufficientBalance, "SubmitOrder should not use funds held by resting orders")
+
+	subs, err := w.AccountBalances(t.Context(), exchName, asset.Spot)
+	require.NoError(t, err, "AccountBalances must not error")
+	require.Len(t, subs, 1, "AccountBalances must return a sub account")
+	assert.Equal(t, 90.0, subs[0].Balances[currency.USDT].Hold, "AccountBalances should return held funds")
+	assert.Equal(t, 60.0, subs[0].Balances[currency.USDT].Free, "AccountBalances should return free funds")
+}
+
+func TestModifyAndCancelOrders(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+	require.True(t, w.Next(), "Next must step to the first candle")
+
+	resp, err := w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 1, Price: 50})
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	_, err = w.ModifyOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, order.ErrModifyOrderIsNil, "ModifyOrder should error with a nil request")
+
+	mod, err := w.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName, OrderID: resp.OrderID, Price: 60, Amount: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 60.0, mod.Price, "ModifyOrder should amend the price")
+	assert.Equal(t, 2.0, mod.RemainingAmount, "ModifyOrder should amend the amount")
+	assert.Equal(t, order.New, mod.Status, "ModifyOrder should leave the order open")
+
+	ok, err := w.CancelOrder(t.Context(), exchName, resp.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "CancelOrder must not error")
+	assert.True(t, ok, "CancelOrder should cancel the order")
+
+	_, err = w.CancelOrder(t.Context(), exchName, resp.OrderID, testPair, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotOpen, "CancelOrder should error for closed orders")
+	_, err = w.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName, OrderID: resp.OrderID, Price: 60})
+	assert.ErrorIs(t, err, errOrderNotOpen, "ModifyOrder should error for closed orders")
+
+	resp, err = w.SubmitOrder(t.Context(), &order.Submit{Exchange: exchName, Pair: testPair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 1, Price: 50})
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	_, err = w.CancelAllOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, order.ErrCancelOrderIsNil, "CancelAllOrders should error with a nil request")
+
+	all, err := w.CancelAllOrders(t.Context(), &order.Cancel{Exchange: exchName, AssetType: asset.Spot})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Equal(t, map[string]string{resp.OrderID: order.Cancelled.String()}, all.Status, "CancelAllOrders should cancel open orders")
+}
+
+func TestWithdrawals(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+
+	_, err := w.WithdrawalCryptoFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, errWithdrawRequestIsNil, "WithdrawalCryptoFunds should error with a nil request")
+
+	_, err = w.WithdrawalCryptoFunds(t.Context(), &withdraw.Request{Exchange: exchName, Currency: currency.BTC})
+	assert.ErrorIs(t, err, errWithdrawAmountIsInvalid, "WithdrawalCryptoFunds should error without an amount")
+
+	id, err := w.WithdrawalCryptoFunds(t.Context(), &withdraw.Request{Exchange: exchName, Currency: currency.BTC, Amount: 1})
+	require.NoError(t, err, "WithdrawalCryptoFunds must not error")
+	assert.NotEmpty(t, id, "WithdrawalCryptoFunds should return an id")
+
+	_, err = w.WithdrawalFiatFunds(t.Context(), "bank", &withdraw.Request{Exchange: exchName, Currency: currency.USD, Amount: 1})
+	require.NoError(t, err, "WithdrawalFiatFunds must not error")
+
+	assert.Len(t, w.Withdrawals(), 2, "Withdrawals should return captured requests")
+}
+
+func TestUnsupported(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+
+	_, err := w.DepositAddress(exchName, "", currency.BTC)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "DepositAddress should not be supported")
+	_, err = w.FuturesPositions(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "FuturesPositions should not be supported")
+	_, err = w.LatestFundingRates(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "LatestFundingRates should not be supported")
+	_, err = w.HistoricalFundingRates(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "HistoricalFundingRates should not be supported")
+	_, err = w.OpenInterest(t.Context(), exchName)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "OpenInterest should not be supported")
+
+	assert.NoError(t, w.SetLeverage(t.Context(), exchName, asset.Spot, testPair, margin.Isolated, 2, order.UnknownSide), "SetLeverage should not error")
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	t.Parallel()
+	w := newTestWrapper(t, nil)
+
+	_, err := w.SubscribeEvents(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "SubscribeEvents should error with a nil subscription")
+
+	_, err = w.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: modules.EventTicker, Exchange: "bitstamp"})
+	assert.ErrorIs(t, err, errExchangeNotSimulated, "SubscribeEvents should error for other exchanges")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	ch, err := w.SubscribeEvents(ctx, &modules.EventSubscription{Type: modules.EventTicker, Exchange: exchName})
+	require.NoError(t, err, "SubscribeEvents must not error")
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok, "channel should be closed when ctx is cancelled")
+}
@@ -0,0 +1,52 @@
+package simulator
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errNoCandles               = errors.New("no candles to simulate")
+	errSimulationNotStarted    = errors.New("simulation not started")
+	errExchangeNotSimulated    = errors.New("exchange not simulated")
+	errPairNotSimulated        = errors.New("currency pair not simulated")
+	errAssetNotSimulated       = errors.New("asset not simulated")
+	errIntervalNotSimulated    = errors.New("interval not simulated")
+	errOrderNotFound           = errors.New("order not found")
+	errOrderNotOpen            = errors.New("order not open")
+	errOrderTypeNotSimulated   = errors.New("order type not simulated")
+	errInsufficientBalance     = errors.New("insufficient balance")
+	errWithdrawRequestIsNil    = errors.New("withdraw request is nil")
+	errWithdrawAmountIsInvalid = errors.New("withdraw amount must be greater than zero")
+)
+
+// Wrapper simulates a single exchange, currency pair and asset from recorded
+// candles for dry running scripts. Market data is derived from the current
+// candle and orders are captured and filled against candle prices rather
+// than being sent to an exchange
+type Wrapper struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	interval kline.Interval
+	candles  []kline.Candle
+
+	m sync.Mutex
+	// step is the index of the current candle, -1 before the first call
+	// to Next
+	step        int
+	enforce     bool
+	balances    map[currency.Code]float64
+	holds       map[currency.Code]float64
+	orders      []*order.Detail
+	withdrawals []withdraw.Request
+	events      []*modules.Event
+	nextID      int64
+}
@@ -5,24 +5,33 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 const (
-	validatorOpen  float64 = 5000
-	validatorHigh  float64 = 6000
-	validatorLow   float64 = 5500
-	validatorClose float64 = 5700
-	validatorVol   float64 = 10
+	validatorOpen        float64 = 5000
+	validatorHigh        float64 = 6000
+	validatorLow         float64 = 5500
+	validatorClose       float64 = 5700
+	validatorVol         float64 = 10
+	validatorFundingRate float64 = 0.0001
 )
 
 // Exchanges validator for test execution/scripts
@@ -184,6 +193,213 @@ func (w Wrapper) CancelOrder(_ context.Context, exch, orderid string, cp currenc
 	return true, nil
 }
 
+// ModifyOrder validator for test execution/scripts
+func (w Wrapper) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil || mod.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	if mod.OrderID == "" {
+		return nil, errTestFailed
+	}
+	resp, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = order.Open
+	resp.RemainingAmount = mod.Amount
+	return resp, nil
+}
+
+// CancelAllOrders validator for test execution/scripts
+func (w Wrapper) CancelAllOrders(_ context.Context, cancel *order.Cancel) (order.CancelAllResponse, error) {
+	if cancel == nil || cancel.Exchange == exchError.String() {
+		return order.CancelAllResponse{}, errTestFailed
+	}
+	return order.CancelAllResponse{Status: map[string]string{"1337": order.Cancelled.String()}}, nil
+}
+
+// ActiveOrders validator for test execution/scripts
+func (w Wrapper) ActiveOrders(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return order.FilteredOrders{validatorOrder(exch, req, order.Open)}, nil
+}
+
+// OrderHistory validator for test execution/scripts
+func (w Wrapper) OrderHistory(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return order.FilteredOrders{validatorOrder(exch, req, order.Filled)}, nil
+}
+
+// RecentTrades validator for test execution/scripts
+func (w Wrapper) RecentTrades(_ context.Context, exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []trade.Data{
+		{
+			TID:          "1337",
+			Exchange:     exch,
+			CurrencyPair: pair,
+			AssetType:    item,
+			Side:         order.Buy,
+			Price:        validatorClose,
+			Amount:       validatorVol,
+			Timestamp:    time.Now(),
+		},
+	}, nil
+}
+
+// FuturesPositions validator for test execution/scripts
+func (w Wrapper) FuturesPositions(_ context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if req == nil || !req.Asset.IsFutures() {
+		return nil, errTestFailed
+	}
+	resp := make([]futures.PositionDetails, len(req.Pairs))
+	for i := range req.Pairs {
+		resp[i] = futures.PositionDetails{
+			Exchange: exch,
+			Asset:    req.Asset,
+			Pair:     req.Pairs[i],
+			Orders: []order.Detail{
+				validatorOrder(exch, &order.MultiOrderRequest{AssetType: req.Asset, Pairs: currency.Pairs{req.Pairs[i]}}, order.Filled),
+			},
+		}
+	}
+	return resp, nil
+}
+
+// LatestFundingRates validator for test execution/scripts
+func (w Wrapper) LatestFundingRates(_ context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if req == nil || !req.Asset.IsFutures() {
+		return nil, errTestFailed
+	}
+	now := time.Now()
+	resp := fundingrate.LatestRateResponse{
+		Exchange:       exch,
+		Asset:          req.Asset,
+		Pair:           req.Pair,
+		LatestRate:     fundingrate.Rate{Time: now.Truncate(time.Hour * 8), Rate: decimal.NewFromFloat(validatorFundingRate)},
+		TimeOfNextRate: now.Truncate(time.Hour * 8).Add(time.Hour * 8),
+		TimeChecked:    now,
+	}
+	if req.IncludePredictedRate {
+		resp.PredictedUpcomingRate = fundingrate.Rate{Time: resp.TimeOfNextRate, Rate: decimal.NewFromFloat(validatorFundingRate)}
+	}
+	return []fundingrate.LatestRateResponse{resp}, nil
+}
+
+// HistoricalFundingRates validator for test execution/scripts
+func (w Wrapper) HistoricalFundingRates(_ context.Context, exch string, req *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if req == nil || !req.Asset.IsFutures() {
+		return nil, errTestFailed
+	}
+	if err := common.StartEndTimeCheck(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+	resp := &fundingrate.HistoricalRates{
+		Exchange:        exch,
+		Asset:           req.Asset,
+		Pair:            req.Pair,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		PaymentCurrency: req.PaymentCurrency,
+	}
+	rate := decimal.NewFromFloat(validatorFundingRate)
+	for t := req.StartDate.Truncate(time.Hour * 8); t.Before(req.EndDate); t = t.Add(time.Hour * 8) {
+		r := fundingrate.Rate{Time: t, Rate: rate}
+		if req.IncludePayments {
+			r.Payment = rate.Mul(decimal.NewFromFloat(validatorClose))
+			resp.PaymentSum = resp.PaymentSum.Add(r.Payment)
+		}
+		resp.FundingRates = append(resp.FundingRates, r)
+	}
+	if len(resp.FundingRates) > 0 {
+		resp.LatestRate = resp.FundingRates[len(resp.FundingRates)-1]
+		resp.TimeOfNextRate = resp.LatestRate.Time.Add(time.Hour * 8)
+	}
+	return resp, nil
+}
+
+// OpenInterest validator for test execution/scripts
+func (w Wrapper) OpenInterest(_ context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if len(keys) == 0 {
+		keys = []key.PairAsset{{Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.PerpetualContract}}
+	}
+	resp := make([]futures.OpenInterest, len(keys))
+	for i := range keys {
+		resp[i] = futures.OpenInterest{
+			Key: key.ExchangeAssetPair{
+				Exchange: exch,
+				Base:     keys[i].Base,
+				Quote:    keys[i].Quote,
+				Asset:    keys[i].Asset,
+			},
+			OpenInterest: validatorVol,
+		}
+	}
+	return resp, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(_ context.Context, exch string, item asset.Item, _ currency.Pair, _ margin.Type, amount float64, _ order.Side) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	if !item.IsFutures() || amount <= 0 {
+		return errTestFailed
+	}
+	return nil
+}
+
+// validatorOrder returns a sample order matching the request
+func validatorOrder(exch string, req *order.MultiOrderRequest, status order.Status) order.Detail {
+	pair := currency.NewBTCUSD()
+	if len(req.Pairs) > 0 {
+		pair = req.Pairs[0]
+	}
+	d := order.Detail{
+		Exchange:  exch,
+		OrderID:   "1337",
+		Pair:      pair,
+		AssetType: req.AssetType,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    status,
+		Price:     validatorClose,
+		Amount:    1,
+		Date:      time.Now(),
+	}
+	if status == order.Filled {
+		d.ExecutedAmount = d.Amount
+		d.AverageExecutedPrice = d.Price
+	} else {
+		d.RemainingAmount = d.Amount
+	}
+	return d
+}
+
 // AccountBalances validator for test execution/scripts
 func (w Wrapper) AccountBalances(_ context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
 	if exch == exchError.String() {
@@ -277,3 +493,45 @@ func (w Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.
 		Candles:  candles,
 	}, nil
 }
+
+// SubscribeEvents returns a single sample event of the subscribed type, the
+// channel is closed when ctx is cancelled
+func (w Wrapper) SubscribeEvents(ctx context.Context, sub *modules.EventSubscription) (<-chan *modules.Event, error) {
+	if sub == nil || sub.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	e := &modules.Event{
+		Type:     sub.Type,
+		Exchange: sub.Exchange,
+		Pair:     sub.Pair,
+		Asset:    sub.Asset,
+		Time:     time.Now(),
+	}
+	switch sub.Type {
+	case modules.EventTicker:
+		e.Data = &ticker.Price{ExchangeName: sub.Exchange, Pair: sub.Pair, AssetType: sub.Asset, Last: validatorClose, LastUpdated: e.Time}
+	case modules.EventOrderbook:
+		e.Data = &orderbook.Book{
+			Exchange: sub.Exchange,
+			Pair:     sub.Pair,
+			Asset:    sub.Asset,
+			Bids:     []orderbook.Level{{Amount: 1, Price: validatorLow}},
+			Asks:     []orderbook.Level{{Amount: 1, Price: validatorHigh}},
+		}
+	case modules.EventTrade:
+		e.Data = []trade.Data{{Exchange: sub.Exchange, CurrencyPair: sub.Pair, AssetType: sub.Asset, Side: order.Buy, Price: validatorClose, Amount: validatorVol, Timestamp: e.Time}}
+	case modules.EventFill:
+		e.Data = &order.Detail{Exchange: sub.Exchange, Pair: sub.Pair, AssetType: sub.Asset, OrderID: "1337", Side: order.Buy, Type: order.Limit, Status: order.Filled, Price: validatorClose, Amount: 1, ExecutedAmount: 1}
+	case modules.EventBalance:
+		e.Data = &accounts.SubAccount{AssetType: sub.Asset, Balances: accounts.CurrencyBalances{currency.BTC: {Currency: currency.BTC, Total: 1}}}
+	default:
+		return nil, errTestFailed
+	}
+	ch := make(chan *modules.Event, 1)
+	ch <- e
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch, nil
+}
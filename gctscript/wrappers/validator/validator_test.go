@@ -1,15 +1,22 @@
 package validator
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -260,3 +267,172 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.SubscribeEvents(t.Context(), nil)
+	assert.ErrorIs(t, err, errTestFailed, "SubscribeEvents should error on nil subscription")
+
+	_, err = testWrapper.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: modules.EventTicker, Exchange: exchError.String()})
+	assert.ErrorIs(t, err, errTestFailed, "SubscribeEvents should error with invalid name")
+
+	_, err = testWrapper.SubscribeEvents(t.Context(), &modules.EventSubscription{Type: "meow", Exchange: exchName})
+	assert.ErrorIs(t, err, errTestFailed, "SubscribeEvents should error with invalid type")
+
+	for _, eventType := range []string{modules.EventTicker, modules.EventOrderbook, modules.EventTrade, modules.EventFill, modules.EventBalance} {
+		ctx, cancel := context.WithCancel(t.Context())
+		ch, err := testWrapper.SubscribeEvents(ctx, &modules.EventSubscription{Type: eventType, Exchange: exchName, Pair: currencyPair, Asset: assetType})
+		require.NoErrorf(t, err, "SubscribeEvents must not error for %s", eventType)
+		e := <-ch
+		require.NotNilf(t, e, "SubscribeEvents must send a %s event", eventType)
+		assert.Equal(t, eventType, e.Type, "Event type should match subscription")
+		assert.NotNil(t, e.Data, "Event data should be set")
+		cancel()
+		_, ok := <-ch
+		assert.False(t, ok, "Event channel should be closed when context is cancelled")
+	}
+}
+
+func TestWrapper_ModifyOrder(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.ModifyOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, errTestFailed, "ModifyOrder should error on nil request")
+
+	_, err = testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchError.String(), OrderID: orderID})
+	assert.ErrorIs(t, err, errTestFailed, "ModifyOrder should error with invalid name")
+
+	_, err = testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName})
+	assert.ErrorIs(t, err, errTestFailed, "ModifyOrder should error without an order ID")
+
+	resp, err := testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName, OrderID: orderID, Pair: currencyPair, AssetType: assetType, Price: orderPrice, Amount: orderAmount})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, orderID, resp.OrderID, "OrderID should match the request")
+	assert.Equal(t, float64(orderPrice), resp.Price, "Price should match the request")
+}
+
+func TestWrapper_CancelAllOrders(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.CancelAllOrders(t.Context(), &order.Cancel{Exchange: exchError.String()})
+	assert.ErrorIs(t, err, errTestFailed, "CancelAllOrders should error with invalid name")
+
+	resp, err := testWrapper.CancelAllOrders(t.Context(), &order.Cancel{Exchange: exchName, AssetType: assetType})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.NotEmpty(t, resp.Status, "CancelAllOrders should return order statuses")
+}
+
+func TestWrapper_ActiveOrders(t *testing.T) {
+	t.Parallel()
+	req := &order.MultiOrderRequest{AssetType: assetType, Pairs: currency.Pairs{currencyPair}, Side: order.AnySide, Type: order.AnyType}
+	_, err := testWrapper.ActiveOrders(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed, "ActiveOrders should error with invalid name")
+
+	_, err = testWrapper.ActiveOrders(t.Context(), exchName, &order.MultiOrderRequest{AssetType: assetType})
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid, "ActiveOrders should validate the request")
+
+	orders, err := testWrapper.ActiveOrders(t.Context(), exchName, req)
+	require.NoError(t, err, "ActiveOrders must not error")
+	require.Len(t, orders, 1, "ActiveOrders must return an order")
+	assert.Equal(t, order.Open, orders[0].Status, "ActiveOrders should return an open order")
+	assert.Equal(t, currencyPair, orders[0].Pair, "ActiveOrders should return an order for the requested pair")
+}
+
+func TestWrapper_OrderHistory(t *testing.T) {
+	t.Parallel()
+	req := &order.MultiOrderRequest{AssetType: assetType, Side: order.AnySide, Type: order.AnyType}
+	_, err := testWrapper.OrderHistory(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed, "OrderHistory should error with invalid name")
+
+	orders, err := testWrapper.OrderHistory(t.Context(), exchName, req)
+	require.NoError(t, err, "OrderHistory must not error")
+	require.Len(t, orders, 1, "OrderHistory must return an order")
+	assert.Equal(t, order.Filled, orders[0].Status, "OrderHistory should return a filled order")
+}
+
+func TestWrapper_RecentTrades(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.RecentTrades(t.Context(), exchError.String(), currencyPair, assetType)
+	assert.ErrorIs(t, err, errTestFailed, "RecentTrades should error with invalid name")
+
+	trades, err := testWrapper.RecentTrades(t.Context(), exchName, currencyPair, assetType)
+	require.NoError(t, err, "RecentTrades must not error")
+	require.Len(t, trades, 1, "RecentTrades must return a trade")
+	assert.Equal(t, currencyPair, trades[0].CurrencyPair, "RecentTrades should return trades for the requested pair")
+}
+
+func TestWrapper_FuturesPositions(t *testing.T) {
+	t.Parallel()
+	req := &futures.PositionsRequest{Asset: asset.PerpetualContract, Pairs: currency.Pairs{currencyPair}}
+	_, err := testWrapper.FuturesPositions(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed, "FuturesPositions should error with invalid name")
+
+	_, err = testWrapper.FuturesPositions(t.Context(), exchName, &futures.PositionsRequest{Asset: assetType})
+	assert.ErrorIs(t, err, errTestFailed, "FuturesPositions should error on a spot asset")
+
+	positions, err := testWrapper.FuturesPositions(t.Context(), exchName, req)
+	require.NoError(t, err, "FuturesPositions must not error")
+	require.Len(t, positions, 1, "FuturesPositions must return a position per pair")
+	assert.NotEmpty(t, positions[0].Orders, "FuturesPositions should return position orders")
+}
+
+func TestWrapper_LatestFundingRates(t *testing.T) {
+	t.Parallel()
+	req := &fundingrate.LatestRateRequest{Asset: asset.PerpetualContract, Pair: currencyPair, IncludePredictedRate: true}
+	_, err := testWrapper.LatestFundingRates(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed, "LatestFundingRates should error with invalid name")
+
+	_, err = testWrapper.LatestFundingRates(t.Context(), exchName, &fundingrate.LatestRateRequest{Asset: assetType})
+	assert.ErrorIs(t, err, errTestFailed, "LatestFundingRates should error on a spot asset")
+
+	rates, err := testWrapper.LatestFundingRates(t.Context(), exchName, req)
+	require.NoError(t, err, "LatestFundingRates must not error")
+	require.Len(t, rates, 1, "LatestFundingRates must return a rate")
+	assert.False(t, rates[0].LatestRate.Rate.IsZero(), "LatestRate should be set")
+	assert.False(t, rates[0].PredictedUpcomingRate.Rate.IsZero(), "PredictedUpcomingRate should be set when requested")
+}
+
+func TestWrapper_HistoricalFundingRates(t *testing.T) {
+	t.Parallel()
+	end := time.Now()
+	req := &fundingrate.HistoricalRatesRequest{Asset: asset.PerpetualContract, Pair: currencyPair, StartDate: end.Add(-time.Hour * 24), EndDate: end, IncludePayments: true}
+	_, err := testWrapper.HistoricalFundingRates(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed, "HistoricalFundingRates should error with invalid name")
+
+	_, err = testWrapper.HistoricalFundingRates(t.Context(), exchName, &fundingrate.HistoricalRatesRequest{Asset: asset.PerpetualContract, StartDate: end, EndDate: end.Add(-time.Hour)})
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd, "HistoricalFundingRates should error when start is after end")
+
+	rates, err := testWrapper.HistoricalFundingRates(t.Context(), exchName, req)
+	require.NoError(t, err, "HistoricalFundingRates must not error")
+	assert.NotEmpty(t, rates.FundingRates, "HistoricalFundingRates should return rates")
+	assert.True(t, rates.PaymentSum.IsPositive(), "PaymentSum should be set when payments are included")
+}
+
+func TestWrapper_OpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.OpenInterest(t.Context(), exchError.String())
+	assert.ErrorIs(t, err, errTestFailed, "OpenInterest should error with invalid name")
+
+	oi, err := testWrapper.OpenInterest(t.Context(), exchName)
+	require.NoError(t, err, "OpenInterest must not error")
+	assert.Len(t, oi, 1, "OpenInterest should return a sample pair when none are requested")
+
+	oi, err = testWrapper.OpenInterest(t.Context(), exchName, key.PairAsset{Base: currencyPair.Base.Item, Quote: currencyPair.Quote.Item, Asset: asset.PerpetualContract})
+	require.NoError(t, err, "OpenInterest must not error")
+	require.Len(t, oi, 1, "OpenInterest must return the requested pair")
+	assert.Equal(t, exchName, oi[0].Key.Exchange, "Exchange should be set")
+	assert.True(t, oi[0].Key.Pair().Equal(currencyPair), "Pair should match the request")
+}
+
+func TestWrapper_SetLeverage(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetLeverage(t.Context(), exchError.String(), asset.PerpetualContract, currencyPair, margin.Isolated, 2, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed, "SetLeverage should error with invalid name")
+
+	err = testWrapper.SetLeverage(t.Context(), exchName, assetType, currencyPair, margin.Isolated, 2, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed, "SetLeverage should error on a spot asset")
+
+	err = testWrapper.SetLeverage(t.Context(), exchName, asset.PerpetualContract, currencyPair, margin.Isolated, 0, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed, "SetLeverage should error on zero leverage")
+
+	err = testWrapper.SetLeverage(t.Context(), exchName, asset.PerpetualContract, currencyPair, margin.Isolated, 2, order.Long)
+	assert.NoError(t, err, "SetLeverage should not error")
+}
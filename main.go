@@ -46,6 +46,7 @@ func main() {
 	flag.BoolVar(&settings.EnableCommsRelayer, "enablecommsrelayer", true, "enables available communications relayer")
 	flag.BoolVar(&settings.Verbose, "verbose", false, "increases logging verbosity for GoCryptoTrader")
 	flag.BoolVar(&settings.EnableFuturesTracking, "enablefuturestracking", true, "tracks futures orders PNL is supported by the exchange")
+	flag.BoolVar(&settings.EnableTracing, "tracing", false, "enables OpenTelemetry tracing, overriding false config value")
 	flag.BoolVar(&settings.EnableExchangeSyncManager, "syncmanager", false, "enables to exchange sync manager")
 	flag.BoolVar(&settings.EnableWebsocketRoutine, "websocketroutine", true, "enables the websocket routine for all loaded exchanges")
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
@@ -59,6 +60,14 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableExchangeHealthManager, "exchangehealthmanager", false, "enables the exchange health manager")
+	flag.BoolVar(&settings.EnableCarryMonitor, "carrymonitor", false, "enables the carry monitor, collecting funding rates and basis of configured underlyings")
+	flag.BoolVar(&settings.EnableMarginMonitor, "marginmonitor", false, "enables the margin monitor, alerting on and optionally de-risking open futures positions nearing liquidation")
+	flag.BoolVar(&settings.EnableRebalancer, "rebalancer", false, "enables the rebalancer, moving currency holdings between exchanges towards target allocations with withdrawals")
+	flag.BoolVar(&settings.EnableWithdrawalReconciler, "withdrawalreconciler", false, "enables the withdrawal reconciler, tracking the status and transaction hash of submitted withdrawals")
+	flag.BoolVar(&settings.EnableWithdrawalPolicy, "withdrawalpolicy", false, "enables the withdrawal policy, holding withdrawals to daily limits, address cooldowns and operator approval")
+	flag.BoolVar(&settings.EnablePortfolioValuer, "portfoliovaluer", false, "enables the portfolio valuer, periodically valuing exchange and personal address holdings in a reporting currency")
+	flag.BoolVar(&settings.EnableTradeLedger, "tradeledger", false, "enables the trade ledger, importing spot fills to the database and calculating cost basis and realised gains")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
@@ -0,0 +1,351 @@
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	esploraAPIURL  = "https://blockstream.info/api"
+	solanaRPCURL   = "https://api.mainnet-beta.solana.com"
+	ethereumRPCURL = "https://ethereum-rpc.publicnode.com"
+	tronRPCURL     = "https://api.trongrid.io/jsonrpc"
+
+	tronAPIKeyHeader = "TRON-PRO-API-KEY"
+	// erc20BalanceOf is the selector of the ERC-20 and TRC-20 balanceOf(address)
+	// function
+	erc20BalanceOf = "0x70a08231"
+
+	bitcoinDecimals = 8
+	solanaDecimals  = 9
+	etherDecimals   = 18
+	tronDecimals    = 6
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	errProviderNameEmpty  = errors.New("provider name is empty")
+	errJSONRPC            = errors.New("JSON-RPC error")
+	errInvalidTronAddress = errors.New("invalid Tron address")
+	errInvalidHexAmount   = errors.New("invalid hex amount")
+	errTokenNotConfigured = errors.New("token not configured")
+
+	ethereumAddressRegexp = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	solanaAddressRegexp   = regexp.MustCompile("^[1-9A-HJ-NP-Za-km-z]{32,44}$")
+)
+
+// knownProviders are the names of the built in address balance providers
+var knownProviders = []string{"Ethplorer", "XRPScan", "CryptoID", "Esplora", "Solana", "Ethereum", "Tron"}
+
+// RegisterAddressBalanceProvider adds a provider of address balances, used
+// once a provider of the same name is enabled in the providers config.
+// Registered providers take precedence over the built in providers
+func (b *Base) RegisterAddressBalanceProvider(name string, p AddressBalanceProvider) error {
+	if name == "" {
+		return errProviderNameEmpty
+	}
+	if p == nil {
+		return fmt.Errorf("%w: AddressBalanceProvider", common.ErrNilPointer)
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.balanceProviders == nil {
+		b.balanceProviders = make(map[string]AddressBalanceProvider)
+	}
+	b.balanceProviders[strings.ToLower(name)] = p
+	return nil
+}
+
+// getBalanceProvider returns the first enabled provider in the providers
+// config which supports the coin type held by the address
+func (b *Base) getBalanceProvider(address string, coinType currency.Code) (AddressBalanceProvider, error) {
+	var disabled string
+	for i := range b.Providers {
+		p := b.balanceProvider(&b.Providers[i])
+		if p == nil || !p.Supports(address, coinType) {
+			continue
+		}
+		if !b.Providers[i].Enabled {
+			if disabled == "" {
+				disabled = b.Providers[i].Name
+			}
+			continue
+		}
+		return p, nil
+	}
+	if disabled != "" {
+		return nil, fmt.Errorf("%w: %s", errProviderNotEnabled, disabled)
+	}
+	for _, name := range knownProviders {
+		if p := b.balanceProvider(&provider{Name: name}); p.Supports(address, coinType) {
+			return nil, fmt.Errorf("%w: %s", errProviderNotFound, name)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", currency.ErrCurrencyNotSupported, coinType)
+}
+
+// balanceProvider returns the registered or built in provider of the
+// provider config or nil if there is none
+func (b *Base) balanceProvider(p *provider) AddressBalanceProvider {
+	b.mtx.RLock()
+	registered, ok := b.balanceProviders[strings.ToLower(p.Name)]
+	b.mtx.RUnlock()
+	if ok {
+		return registered
+	}
+	switch strings.ToLower(p.Name) {
+	case "ethplorer":
+		return &ethplorerProvider{b}
+	case "xrpscan":
+		return &xrpScanProvider{b}
+	case "cryptoid":
+		return &cryptoIDProvider{b}
+	case "esplora":
+		return &esploraProvider{url: providerURL(p, esploraAPIURL), verbose: b.Verbose}
+	case "solana":
+		return &solanaProvider{url: providerURL(p, solanaRPCURL), verbose: b.Verbose}
+	case "ethereum":
+		return &jsonRPCProvider{
+			url:      providerURL(p, ethereumRPCURL),
+			native:   currency.ETH,
+			decimals: etherDecimals,
+			tokens:   p.Tokens,
+			toHex:    ethereumAddressToHex,
+			verbose:  b.Verbose,
+		}
+	case "tron":
+		r := &jsonRPCProvider{
+			url:      providerURL(p, tronRPCURL),
+			native:   currency.TRX,
+			decimals: tronDecimals,
+			tokens:   p.Tokens,
+			toHex:    tronAddressToHex,
+			verbose:  b.Verbose,
+		}
+		if p.APIKey != "" && p.APIKey != defaultAPIKey {
+			r.headers = map[string]string{tronAPIKeyHeader: p.APIKey}
+		}
+		return r
+	}
+	return nil
+}
+
+// providerURL returns the configured endpoint of a provider or its default
+func providerURL(p *provider, defaultURL string) string {
+	if p.URL != "" {
+		return strings.TrimSuffix(p.URL, "/")
+	}
+	return defaultURL
+}
+
+// Supports returns whether the coin type is ETH
+func (p *ethplorerProvider) Supports(_ string, coinType currency.Code) bool {
+	return coinType.Equal(currency.ETH)
+}
+
+// GetBalance returns the ETH balance of an address from Ethplorer
+func (p *ethplorerProvider) GetBalance(ctx context.Context, address string, _ currency.Code) (float64, error) {
+	return p.b.GetEthereumAddressBalance(ctx, address)
+}
+
+// Supports returns whether the coin type is XRP
+func (p *xrpScanProvider) Supports(_ string, coinType currency.Code) bool {
+	return coinType.Equal(currency.XRP)
+}
+
+// GetBalance returns the XRP balance of an address from XRPScan
+func (p *xrpScanProvider) GetBalance(ctx context.Context, address string, _ currency.Code) (float64, error) {
+	return p.b.GetRippleAddressBalance(ctx, address)
+}
+
+// Supports returns whether the coin type is BTC or LTC
+func (p *cryptoIDProvider) Supports(_ string, coinType currency.Code) bool {
+	return coinType.Equal(currency.BTC) || coinType.Equal(currency.LTC)
+}
+
+// GetBalance returns the balance of an address from CryptoID
+func (p *cryptoIDProvider) GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	return p.b.GetCryptoIDAddressBalance(ctx, address, coinType)
+}
+
+// Supports returns whether the coin type is BTC
+func (p *esploraProvider) Supports(_ string, coinType currency.Code) bool {
+	return coinType.Equal(currency.BTC)
+}
+
+// GetBalance returns the confirmed BTC balance of an address from an Esplora
+// compatible API
+func (p *esploraProvider) GetBalance(ctx context.Context, address string, _ currency.Code) (float64, error) {
+	if err := common.IsValidCryptoAddress(address, "btc"); err != nil {
+		return 0, err
+	}
+	contents, err := common.SendHTTPRequest(ctx, http.MethodGet, p.url+"/address/"+address, nil, nil, p.verbose)
+	if err != nil {
+		return 0, err
+	}
+	var result EsploraAddress
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return 0, fmt.Errorf("esplora: %w: %s", err, contents)
+	}
+	sats := result.ChainStats.FundedTxoSum - result.ChainStats.SpentTxoSum
+	return decimal.New(sats, -bitcoinDecimals).InexactFloat64(), nil
+}
+
+// Supports returns whether the coin type is SOL and the address is a Solana
+// address
+func (p *solanaProvider) Supports(address string, coinType currency.Code) bool {
+	return coinType.Equal(currency.SOL) && solanaAddressRegexp.MatchString(address)
+}
+
+// GetBalance returns the SOL balance of an address from a Solana JSON-RPC node
+func (p *solanaProvider) GetBalance(ctx context.Context, address string, _ currency.Code) (float64, error) {
+	var result SolanaBalance
+	if err := sendJSONRPC(ctx, p.url, nil, "getBalance", []any{address}, &result, p.verbose); err != nil {
+		return 0, err
+	}
+	return decimal.NewFromUint64(result.Value).Shift(-solanaDecimals).InexactFloat64(), nil
+}
+
+// Supports returns whether the address is valid for the chain and the coin
+// type is its native currency or a configured token
+func (p *jsonRPCProvider) Supports(address string, coinType currency.Code) bool {
+	if _, err := p.toHex(address); err != nil {
+		return false
+	}
+	if coinType.Equal(p.native) {
+		return true
+	}
+	_, err := p.token(coinType)
+	return err == nil
+}
+
+// GetBalance returns the native or token balance of an address from an
+// Ethereum compatible JSON-RPC node
+func (p *jsonRPCProvider) GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	owner, err := p.toHex(address)
+	if err != nil {
+		return 0, err
+	}
+	var result string
+	if coinType.Equal(p.native) {
+		if err := sendJSONRPC(ctx, p.url, p.headers, "eth_getBalance", []any{"0x" + owner, "latest"}, &result, p.verbose); err != nil {
+			return 0, err
+		}
+		return hexToAmount(result, p.decimals)
+	}
+	t, err := p.token(coinType)
+	if err != nil {
+		return 0, err
+	}
+	contract, err := p.toHex(t.Contract)
+	if err != nil {
+		return 0, fmt.Errorf("%s contract: %w", t.Currency, err)
+	}
+	call := map[string]string{
+		"to":   "0x" + contract,
+		"data": erc20BalanceOf + strings.Repeat("0", 24) + owner,
+	}
+	if err := sendJSONRPC(ctx, p.url, p.headers, "eth_call", []any{call, "latest"}, &result, p.verbose); err != nil {
+		return 0, err
+	}
+	return hexToAmount(result, t.Decimals)
+}
+
+// token returns the configured token of a coin type
+func (p *jsonRPCProvider) token(coinType currency.Code) (*Token, error) {
+	for i := range p.tokens {
+		if p.tokens[i].Currency.Equal(coinType) {
+			return &p.tokens[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errTokenNotConfigured, coinType)
+}
+
+// sendJSONRPC sends a JSON-RPC request and unmarshals its result
+func sendJSONRPC(ctx context.Context, url string, headers map[string]string, method string, params []any, result any, verbose bool) error {
+	body, err := json.Marshal(&jsonRPCRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	h := map[string]string{"Content-Type": "application/json"}
+	for k, v := range headers {
+		h[k] = v
+	}
+	contents, err := common.SendHTTPRequest(ctx, http.MethodPost, url, h, bytes.NewReader(body), verbose)
+	if err != nil {
+		return err
+	}
+	var resp jsonRPCResponse
+	if err := json.Unmarshal(contents, &resp); err != nil {
+		return fmt.Errorf("%s: %w: %s", method, err, contents)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %w %d: %s", method, errJSONRPC, resp.Error.Code, resp.Error.Message)
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// hexToAmount converts a hex encoded integer amount to a float with the
+// currency decimals
+func hexToAmount(s string, decimals int32) (float64, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return 0, nil
+	}
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", errInvalidHexAmount, s)
+	}
+	return decimal.NewFromBigInt(n, -decimals).InexactFloat64(), nil
+}
+
+// ethereumAddressToHex returns the lower case hex of an Ethereum address
+// without its prefix
+func ethereumAddressToHex(address string) (string, error) {
+	if !ethereumAddressRegexp.MatchString(address) {
+		return "", fmt.Errorf("%w: %q", common.ErrAddressIsEmptyOrInvalid, address)
+	}
+	return strings.ToLower(address[2:]), nil
+}
+
+// tronAddressToHex returns the hex of the 20 byte account of a base58check
+// encoded Tron address, as used by the Tron JSON-RPC API
+func tronAddressToHex(address string) (string, error) {
+	if len(address) != 34 || address[0] != 'T' {
+		return "", fmt.Errorf("%w: %q", errInvalidTronAddress, address)
+	}
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, r := range address {
+		idx := strings.IndexRune(base58Alphabet, r)
+		if idx == -1 {
+			return "", fmt.Errorf("%w: %q", errInvalidTronAddress, address)
+		}
+		n.Mul(n, radix).Add(n, big.NewInt(int64(idx)))
+	}
+	// A Tron address is its 0x41 prefixed account followed by a 4 byte checksum
+	b := n.Bytes()
+	if len(b) != 25 || b[0] != 0x41 {
+		return "", fmt.Errorf("%w: %q", errInvalidTronAddress, address)
+	}
+	first := sha256.Sum256(b[:21])
+	checksum := sha256.Sum256(first[:])
+	if !bytes.Equal(checksum[:4], b[21:]) {
+		return "", fmt.Errorf("%w: %q checksum mismatch", errInvalidTronAddress, address)
+	}
+	return hex.EncodeToString(b[1:21]), nil
+}
@@ -0,0 +1,255 @@
+package portfolio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	testSOLAddress      = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	testTronAddress     = "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV"
+	testTronAccount     = "1111111111111111111111111111111111111111"
+	testTronUSDT        = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	testTronUSDTAccount = "a614f803b6fd780986a42c78ec9c7f77e6ded13c"
+	testERC20USDT       = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+)
+
+type testBalanceProvider struct {
+	coin    currency.Code
+	balance float64
+}
+
+func (p *testBalanceProvider) Supports(_ string, coinType currency.Code) bool {
+	return coinType.Equal(p.coin)
+}
+
+func (p *testBalanceProvider) GetBalance(context.Context, string, currency.Code) (float64, error) {
+	return p.balance, nil
+}
+
+// newJSONRPCServer returns a JSON-RPC stub replying to each method with its
+// result, or an error for unknown methods
+func newJSONRPCServer(t *testing.T, results map[string]func(params []json.RawMessage) any) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &req), "Unmarshal should not error")
+		resp := map[string]any{"jsonrpc": "2.0", "id": 1}
+		if f, ok := results[req.Method]; ok {
+			resp["result"] = f(req.Params)
+		} else {
+			resp["error"] = map[string]any{"code": -32601, "message": "method not found"}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp), "Encode should not error")
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRegisterAddressBalanceProvider(t *testing.T) {
+	t.Parallel()
+	b := Base{}
+	assert.ErrorIs(t, b.RegisterAddressBalanceProvider("", nil), errProviderNameEmpty)
+	assert.ErrorIs(t, b.RegisterAddressBalanceProvider("Cardano", nil), common.ErrNilPointer)
+	require.NoError(t, b.RegisterAddressBalanceProvider("Cardano", &testBalanceProvider{coin: currency.ADA, balance: 42}), "RegisterAddressBalanceProvider must not error")
+
+	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{"addr1"}, currency.ADA), currency.ErrCurrencyNotSupported, "UpdatePortfolio should not use providers missing from the providers config")
+	b.Providers = providers{{Name: "cardano"}}
+	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{"addr1"}, currency.ADA), errProviderNotEnabled)
+	b.Providers[0].Enabled = true
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{"addr1"}, currency.ADA), "UpdatePortfolio must not error")
+	bal, ok := b.GetAddressBalance("addr1", PersonalAddress, currency.ADA)
+	require.True(t, ok, "UpdatePortfolio must add the address")
+	assert.Equal(t, 42.0, bal, "UpdatePortfolio should set the balance from the registered provider")
+
+	require.NoError(t, b.RegisterAddressBalanceProvider("Esplora", &testBalanceProvider{coin: currency.BTC, balance: 1}), "RegisterAddressBalanceProvider must not error")
+	b.Providers = append(b.Providers, provider{Name: "Esplora", Enabled: true})
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testBTCAddress}, currency.BTC), "UpdatePortfolio must not error")
+	bal, _ = b.GetAddressBalance(testBTCAddress, PersonalAddress, currency.BTC)
+	assert.Equal(t, 1.0, bal, "registered providers should take precedence over built in providers")
+}
+
+func TestGetBalanceProvider(t *testing.T) {
+	t.Parallel()
+	b := Base{Providers: providers{{Name: "CryptoID"}, {Name: "Esplora", Enabled: true}, {Name: "Tron", Enabled: true}}}
+	p, err := b.getBalanceProvider(testBTCAddress, currency.BTC)
+	require.NoError(t, err, "getBalanceProvider must not error")
+	assert.IsType(t, &esploraProvider{}, p, "getBalanceProvider should skip disabled providers")
+	_, err = b.getBalanceProvider(testLTCAddress, currency.LTC)
+	assert.ErrorIs(t, err, errProviderNotEnabled)
+	_, err = b.getBalanceProvider(testSOLAddress, currency.SOL)
+	assert.ErrorIs(t, err, errProviderNotFound)
+	_, err = b.getBalanceProvider(testTronAddress, currency.USDT)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported, "getBalanceProvider should not support unconfigured tokens")
+	_, err = b.getBalanceProvider(testETHAddress, currency.TRX)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported, "getBalanceProvider should not support addresses of another chain")
+}
+
+func TestEsploraProvider(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/address/"+testBTCAddress {
+			http.Error(w, "Invalid Bitcoin address", http.StatusBadRequest)
+			return
+		}
+		_, err := w.Write([]byte(`{"address":"` + testBTCAddress + `","chain_stats":{"funded_txo_count":3,"funded_txo_sum":250000000,"spent_txo_count":1,"spent_txo_sum":100000000,"tx_count":4},"mempool_stats":{"funded_txo_count":1,"funded_txo_sum":5000,"spent_txo_count":0,"spent_txo_sum":0,"tx_count":1}}`))
+		assert.NoError(t, err, "Write should not error")
+	}))
+	defer srv.Close()
+
+	b := Base{Providers: providers{{Name: "Esplora", Enabled: true, URL: srv.URL + "/"}}}
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testBTCAddress}, currency.BTC), "UpdatePortfolio must not error")
+	bal, ok := b.GetAddressBalance(testBTCAddress, PersonalAddress, currency.BTC)
+	require.True(t, ok, "UpdatePortfolio must add the address")
+	assert.Equal(t, 1.5, bal, "UpdatePortfolio should set the confirmed balance")
+
+	p := &esploraProvider{url: srv.URL}
+	_, err := p.GetBalance(t.Context(), testInvalidBTCAddress, currency.BTC)
+	assert.ErrorIs(t, err, common.ErrAddressIsEmptyOrInvalid)
+	_, err = p.GetBalance(t.Context(), "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", currency.BTC)
+	assert.ErrorContains(t, err, "Invalid Bitcoin address", "GetBalance should return the API error")
+}
+
+func TestSolanaProvider(t *testing.T) {
+	t.Parallel()
+	srv := newJSONRPCServer(t, map[string]func([]json.RawMessage) any{
+		"getBalance": func(params []json.RawMessage) any {
+			assert.Equal(t, `"`+testSOLAddress+`"`, string(params[0]))
+			return map[string]any{"context": map[string]any{"slot": 1}, "value": 1500000000}
+		},
+	})
+	b := Base{Providers: providers{{Name: "Solana", Enabled: true, URL: srv.URL}}}
+	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{testETHAddress}, currency.SOL), currency.ErrCurrencyNotSupported, "UpdatePortfolio should not support invalid Solana addresses")
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testSOLAddress}, currency.SOL), "UpdatePortfolio must not error")
+	bal, _ := b.GetAddressBalance(testSOLAddress, PersonalAddress, currency.SOL)
+	assert.Equal(t, 1.5, bal, "UpdatePortfolio should set the balance in SOL")
+}
+
+func TestEthereumProvider(t *testing.T) {
+	t.Parallel()
+	srv := newJSONRPCServer(t, map[string]func([]json.RawMessage) any{
+		"eth_getBalance": func(params []json.RawMessage) any {
+			assert.Equal(t, `"`+testETHAddress+`"`, string(params[0]))
+			return "0x1bc16d674ec80000" // 2 ETH
+		},
+		"eth_call": func(params []json.RawMessage) any {
+			var call map[string]string
+			assert.NoError(t, json.Unmarshal(params[0], &call), "Unmarshal should not error")
+			assert.Equal(t, "0xdac17f958d2ee523a2206206994597c13d831ec7", call["to"])
+			assert.Equal(t, "0x70a08231000000000000000000000000"+testETHAddress[2:], call["data"])
+			return "0x00000000000000000000000000000000000000000000000000000000002625a0" // 2.5 USDT
+		},
+	})
+	b := Base{Providers: providers{{
+		Name:    "Ethereum",
+		Enabled: true,
+		URL:     srv.URL,
+		Tokens:  []Token{{Currency: currency.USDT, Contract: testERC20USDT, Decimals: 6}},
+	}}}
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testETHAddress}, currency.ETH), "UpdatePortfolio must not error")
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testETHAddress}, currency.USDT), "UpdatePortfolio must not error")
+	bal, _ := b.GetAddressBalance(testETHAddress, PersonalAddress, currency.ETH)
+	assert.Equal(t, 2.0, bal, "UpdatePortfolio should set the ETH balance")
+	bal, _ = b.GetAddressBalance(testETHAddress, PersonalAddress, currency.USDT)
+	assert.Equal(t, 2.5, bal, "UpdatePortfolio should set the token balance")
+	summary := b.GetPortfolioSummary()
+	assert.Len(t, summary.Offline, 2, "GetPortfolioSummary should include the native and token balances of an address")
+
+	b.Providers[0].Tokens[0].Contract = "0x1234"
+	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{testETHAddress}, currency.USDT), common.ErrAddressIsEmptyOrInvalid)
+	p := &jsonRPCProvider{url: srv.URL, native: currency.BNB, toHex: ethereumAddressToHex}
+	_, err := p.GetBalance(t.Context(), testETHAddress, currency.USDC)
+	assert.ErrorIs(t, err, errTokenNotConfigured)
+}
+
+func TestTronProvider(t *testing.T) {
+	t.Parallel()
+	var apiKey string
+	srv := newJSONRPCServer(t, map[string]func([]json.RawMessage) any{
+		"eth_getBalance": func(params []json.RawMessage) any {
+			assert.Equal(t, `"0x`+testTronAccount+`"`, string(params[0]))
+			return "0x3b9aca0" // 62.5 TRX
+		},
+		"eth_call": func(params []json.RawMessage) any {
+			var call map[string]string
+			assert.NoError(t, json.Unmarshal(params[0], &call), "Unmarshal should not error")
+			assert.Equal(t, "0x"+testTronUSDTAccount, call["to"])
+			assert.Equal(t, "0x70a08231000000000000000000000000"+testTronAccount, call["data"])
+			return "0x0f4240" // 1 USDT
+		},
+	})
+	wrapped := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey = r.Header.Get(tronAPIKeyHeader)
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer wrapped.Close()
+
+	b := Base{Providers: providers{{
+		Name:    "Tron",
+		Enabled: true,
+		APIKey:  "secret",
+		URL:     wrapped.URL,
+		Tokens:  []Token{{Currency: currency.USDT, Contract: testTronUSDT, Decimals: 6}},
+	}}}
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testTronAddress}, currency.TRX), "UpdatePortfolio must not error")
+	assert.Equal(t, "secret", apiKey, "UpdatePortfolio should send the TronGrid API key")
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testTronAddress}, currency.USDT), "UpdatePortfolio must not error")
+	bal, _ := b.GetAddressBalance(testTronAddress, PersonalAddress, currency.TRX)
+	assert.Equal(t, 62.5, bal, "UpdatePortfolio should set the TRX balance")
+	bal, _ = b.GetAddressBalance(testTronAddress, PersonalAddress, currency.USDT)
+	assert.Equal(t, 1.0, bal, "UpdatePortfolio should set the token balance")
+
+	p := &jsonRPCProvider{url: srv.URL, native: currency.TRX, toHex: tronAddressToHex}
+	_, err := p.GetBalance(t.Context(), testTronAddress, currency.BTC)
+	assert.ErrorIs(t, err, errTokenNotConfigured)
+	p.url = newJSONRPCServer(t, nil).URL
+	_, err = p.GetBalance(t.Context(), testTronAddress, currency.TRX)
+	assert.ErrorIs(t, err, errJSONRPC)
+}
+
+func TestTronAddressToHex(t *testing.T) {
+	t.Parallel()
+	h, err := tronAddressToHex(testTronUSDT)
+	require.NoError(t, err, "tronAddressToHex must not error")
+	assert.Equal(t, testTronUSDTAccount, h)
+	_, err = tronAddressToHex(testETHAddress)
+	assert.ErrorIs(t, err, errInvalidTronAddress)
+	_, err = tronAddressToHex("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj60")
+	assert.ErrorIs(t, err, errInvalidTronAddress, "tronAddressToHex should error on characters outside the base58 alphabet")
+	_, err = tronAddressToHex("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u")
+	assert.ErrorIs(t, err, errInvalidTronAddress, "tronAddressToHex should error on a checksum mismatch")
+}
+
+func TestHexToAmount(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in       string
+		decimals int32
+		want     float64
+	}{
+		{"0x", 18, 0},
+		{"0x0", 18, 0},
+		{"0xde0b6b3a7640000", 18, 1},
+		{"0x000000000000000000000000000000000000000000000000000000003b9aca00", 6, 1000},
+	} {
+		got, err := hexToAmount(tc.in, tc.decimals)
+		require.NoError(t, err, "hexToAmount must not error")
+		assert.Equal(t, tc.want, got, "hexToAmount should return the correct amount for %s", tc.in)
+	}
+	_, err := hexToAmount("0xzz", 6)
+	assert.ErrorIs(t, err, errInvalidHexAmount)
+}
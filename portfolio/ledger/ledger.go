@@ -0,0 +1,292 @@
+package ledger
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// ParseMethod returns the cost basis method from its name
+func ParseMethod(method string) (Method, error) {
+	switch m := Method(strings.ToLower(method)); m {
+	case FIFO, LIFO, HIFO:
+		return m, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidMethod, method)
+	}
+}
+
+// TaxYear returns the bounds of the tax year labelled by the calendar year it
+// starts in, from the start month and day inclusive to the following year's
+// start exclusive, in UTC
+func TaxYear(year int, startMonth time.Month, startDay int) (start, end time.Time, err error) {
+	start = time.Date(year, startMonth, startDay, 0, 0, 0, 0, time.UTC)
+	if startMonth < time.January || startMonth > time.December || startDay < 1 || start.Month() != startMonth || startMonth == time.February && startDay == 29 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s %d", errInvalidTaxYearStart, startMonth, startDay)
+	}
+	return start, start.AddDate(1, 0, 0), nil
+}
+
+// UnitCost returns the cost of one unit of the lot
+func (l *Lot) UnitCost() float64 {
+	if l.Amount == 0 {
+		return 0
+	}
+	return l.Cost / l.Amount
+}
+
+// Calculate matches the disposals of fills against the lots acquired using
+// the cost basis method, valuing each fill in the reporting currency. Lots of
+// a currency are pooled across exchanges
+func Calculate(fills []Fill, p *Params) (*Ledger, error) {
+	if p == nil {
+		return nil, errNilParams
+	}
+	if p.Value == nil {
+		return nil, errNilValuer
+	}
+	method, err := ParseMethod(string(p.Method))
+	if err != nil {
+		return nil, err
+	}
+	isCash := p.IsCash
+	if isCash == nil {
+		isCash = func(c currency.Code) bool { return c.IsFiatCurrency() }
+	}
+	sorted := slices.Clone(fills)
+	slices.SortStableFunc(sorted, func(a, b Fill) int { return a.Time.Compare(b.Time) })
+
+	c := &calculator{
+		method: method,
+		value:  p.Value,
+		isCash: isCash,
+		lots:   make(map[*currency.Item][]*Lot),
+	}
+	for i := range sorted {
+		if err := c.apply(&sorted[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	l := &Ledger{Method: method, Disposals: c.disposals}
+	for _, lots := range c.lots {
+		for _, lot := range lots {
+			if lot.Remaining > amountTolerance {
+				l.Lots = append(l.Lots, *lot)
+			}
+		}
+	}
+	slices.SortFunc(l.Lots, func(a, b Lot) int {
+		if c := a.Acquired.Compare(b.Acquired); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Currency.String(), b.Currency.String())
+	})
+	return l, nil
+}
+
+// Realised returns the disposals within the period, start inclusive and end
+// exclusive
+func (l *Ledger) Realised(start, end time.Time) []Disposal {
+	var resp []Disposal
+	for i := range l.Disposals {
+		if !l.Disposals[i].Disposed.Before(start) && l.Disposals[i].Disposed.Before(end) {
+			resp = append(resp, l.Disposals[i])
+		}
+	}
+	return resp
+}
+
+// Unrealised values the remaining amount of each open lot at its currency's
+// price at a point in time
+func (l *Ledger) Unrealised(value Valuer, at time.Time) ([]UnrealisedLot, error) {
+	if value == nil {
+		return nil, errNilValuer
+	}
+	prices := make(map[*currency.Item]float64)
+	resp := make([]UnrealisedLot, len(l.Lots))
+	for i := range l.Lots {
+		lot := &l.Lots[i]
+		price, ok := prices[lot.Currency.Item]
+		if !ok {
+			var err error
+			if price, err = value(lot.Currency, at); err != nil {
+				return nil, fmt.Errorf("%s lot acquired on %s: %w", lot.Currency, lot.Exchange, err)
+			}
+			prices[lot.Currency.Item] = price
+		}
+		resp[i] = UnrealisedLot{
+			Lot:       *lot,
+			Price:     price,
+			CostBasis: lot.UnitCost() * lot.Remaining,
+			Value:     price * lot.Remaining,
+		}
+		resp[i].Gain = resp[i].Value - resp[i].CostBasis
+	}
+	return resp, nil
+}
+
+type calculator struct {
+	method    Method
+	value     Valuer
+	isCash    func(currency.Code) bool
+	lots      map[*currency.Item][]*Lot
+	disposals []Disposal
+}
+
+// apply books the currency given and received by a fill. Fees reduce the
+// proceeds of a non-cash currency given, or are added to the cost of the
+// currency received when paying with cash. A fee charged in a third non-cash
+// currency is also a disposal of that currency at its market value
+func (c *calculator) apply(f *Fill) error {
+	if f.Pair.IsEmpty() || f.Amount <= 0 || f.Price <= 0 || f.Fee < 0 {
+		return fmt.Errorf("%w: %s %s order %s", ErrInvalidFill, f.Exchange, f.Pair, f.OrderID)
+	}
+	given, received := f.Pair.Quote, f.Pair.Base
+	givenAmount, receivedAmount := f.Price*f.Amount, f.Amount
+	switch {
+	case f.Side.IsLong():
+	case f.Side.IsShort():
+		given, received = received, given
+		givenAmount, receivedAmount = receivedAmount, givenAmount
+	default:
+		return fmt.Errorf("%w: %s %s order %s side %s", ErrInvalidFill, f.Exchange, f.Pair, f.OrderID, f.Side)
+	}
+
+	quoteRate, err := c.value(f.Pair.Quote, f.Time)
+	if err != nil {
+		return fmt.Errorf("%s %s order %s: %w", f.Exchange, f.Pair, f.OrderID, err)
+	}
+	tradeValue := f.Price * f.Amount * quoteRate
+
+	feeCurrency := f.FeeCurrency
+	if feeCurrency.IsEmpty() {
+		feeCurrency = f.Pair.Quote
+	}
+	var feeValue float64
+	if f.Fee > 0 {
+		// A fee in the pair's currencies is valued at the fill's own price
+		feeRate := quoteRate
+		if feeCurrency.Equal(f.Pair.Base) {
+			feeRate = f.Price * quoteRate
+		} else if !feeCurrency.Equal(f.Pair.Quote) {
+			if feeRate, err = c.value(feeCurrency, f.Time); err != nil {
+				return fmt.Errorf("%s %s order %s fee: %w", f.Exchange, f.Pair, f.OrderID, err)
+			}
+		}
+		feeValue = f.Fee * feeRate
+	}
+
+	givenIsCash := c.isCash(given)
+	proceeds, cost := tradeValue, tradeValue
+	switch {
+	case feeCurrency.Equal(received):
+		receivedAmount -= f.Fee
+		if !givenIsCash {
+			proceeds -= feeValue
+			cost -= feeValue
+		}
+	case feeCurrency.Equal(given):
+		givenAmount += f.Fee
+		if givenIsCash {
+			cost += feeValue
+		}
+	default:
+		if givenIsCash {
+			cost += feeValue
+		} else {
+			proceeds -= feeValue
+		}
+		if f.Fee > 0 && !c.isCash(feeCurrency) {
+			c.dispose(f, feeCurrency, f.Fee, feeValue, true)
+		}
+	}
+
+	if !givenIsCash {
+		c.dispose(f, given, givenAmount, proceeds, false)
+	}
+	if !c.isCash(received) && receivedAmount > 0 {
+		c.lots[received.Item] = append(c.lots[received.Item], &Lot{
+			Currency:  received,
+			Exchange:  f.Exchange,
+			OrderID:   f.OrderID,
+			Acquired:  f.Time,
+			Amount:    receivedAmount,
+			Remaining: receivedAmount,
+			Cost:      cost,
+		})
+	}
+	return nil
+}
+
+// dispose matches an amount of a currency against its open lots, allocating
+// the proceeds pro rata to the amount matched with each lot
+func (c *calculator) dispose(f *Fill, code currency.Code, amount, proceeds float64, fee bool) {
+	remaining := amount
+	for remaining > amountTolerance {
+		lot := c.nextLot(code)
+		if lot == nil {
+			break
+		}
+		matched := math.Min(remaining, lot.Remaining)
+		d := Disposal{
+			Currency:  code,
+			Exchange:  f.Exchange,
+			OrderID:   f.OrderID,
+			Amount:    matched,
+			Acquired:  lot.Acquired,
+			Disposed:  f.Time,
+			Proceeds:  proceeds * matched / amount,
+			CostBasis: lot.UnitCost() * matched,
+			Fee:       fee,
+		}
+		d.Gain = d.Proceeds - d.CostBasis
+		c.disposals = append(c.disposals, d)
+		lot.Remaining -= matched
+		remaining -= matched
+	}
+	if remaining > amountTolerance {
+		d := Disposal{
+			Currency:  code,
+			Exchange:  f.Exchange,
+			OrderID:   f.OrderID,
+			Amount:    remaining,
+			Disposed:  f.Time,
+			Proceeds:  proceeds * remaining / amount,
+			Unmatched: true,
+			Fee:       fee,
+		}
+		d.Gain = d.Proceeds
+		c.disposals = append(c.disposals, d)
+	}
+}
+
+// nextLot returns the open lot of a currency to dispose of next under the
+// cost basis method, or nil when none remain
+func (c *calculator) nextLot(code currency.Code) *Lot {
+	var next *Lot
+	for _, lot := range c.lots[code.Item] {
+		if lot.Remaining <= amountTolerance {
+			continue
+		}
+		if next == nil {
+			next = lot
+			continue
+		}
+		switch c.method {
+		case LIFO:
+			if !lot.Acquired.Before(next.Acquired) {
+				next = lot
+			}
+		case HIFO:
+			if lot.UnitCost() > next.UnitCost() {
+				next = lot
+			}
+		}
+	}
+	return next
+}
@@ -0,0 +1,183 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var testStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testValuer(c currency.Code, at time.Time) (float64, error) {
+	switch {
+	case c.Equal(currency.USDT):
+		return 1, nil
+	case c.Equal(currency.BNB):
+		return 100, nil
+	case c.Equal(currency.ETH):
+		return 2000, nil
+	case c.Equal(currency.BTC):
+		return 10000 * (1 + float64(at.Sub(testStart)/time.Hour)), nil
+	}
+	return 0, errors.New("no price")
+}
+
+func testParams(m Method) *Params {
+	return &Params{
+		Method: m,
+		Value:  testValuer,
+		IsCash: func(c currency.Code) bool { return c.IsFiatCurrency() || c.Equal(currency.USDT) },
+	}
+}
+
+func testFills() []Fill {
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	return []Fill{
+		{Exchange: "Kraken", OrderID: "4", Pair: btcusdt, Side: order.Sell, Price: 30000, Amount: 1.5, Fee: 30, Time: testStart.Add(3 * time.Hour)},
+		{Exchange: "Binance", OrderID: "1", Pair: btcusdt, Side: order.Buy, Price: 10000, Amount: 1, Fee: 10, FeeCurrency: currency.USDT, Time: testStart},
+		{Exchange: "Binance", OrderID: "2", Pair: btcusdt, Side: order.Bid, Price: 20000, Amount: 1, Time: testStart.Add(time.Hour)},
+		{Exchange: "Binance", OrderID: "3", Pair: btcusdt, Side: order.Buy, Price: 15000, Amount: 1, Fee: 1, FeeCurrency: currency.BNB, Time: testStart.Add(2 * time.Hour)},
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	m, err := ParseMethod("HIFO")
+	require.NoError(t, err)
+	assert.Equal(t, HIFO, m)
+	_, err = ParseMethod("average")
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+}
+
+func TestTaxYear(t *testing.T) {
+	t.Parallel()
+	start, end, err := TaxYear(2026, time.April, 6)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2027, 4, 6, 0, 0, 0, 0, time.UTC), end)
+	_, _, err = TaxYear(2026, time.February, 30)
+	assert.ErrorIs(t, err, errInvalidTaxYearStart)
+	_, _, err = TaxYear(2026, 13, 1)
+	assert.ErrorIs(t, err, errInvalidTaxYearStart)
+}
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	_, err := Calculate(nil, nil)
+	assert.ErrorIs(t, err, errNilParams)
+	_, err = Calculate(nil, &Params{Method: FIFO})
+	assert.ErrorIs(t, err, errNilValuer)
+	_, err = Calculate(nil, testParams("average"))
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+	_, err = Calculate([]Fill{{Pair: currency.NewPair(currency.BTC, currency.USDT), Side: order.Buy, Amount: 1}}, testParams(FIFO))
+	assert.ErrorIs(t, err, ErrInvalidFill)
+	_, err = Calculate([]Fill{{Pair: currency.NewPair(currency.XRP, currency.DOGE), Side: order.Buy, Price: 1, Amount: 1}}, testParams(FIFO))
+	assert.ErrorContains(t, err, "no price")
+
+	for _, tc := range []struct {
+		method    Method
+		gains     []float64
+		remaining []float64
+	}{
+		{FIFO, []float64{100, 19970, 4990}, []float64{0.5, 1}},
+		{LIFO, []float64{100, 14880, 4990}, []float64{1, 0.5}},
+		{HIFO, []float64{100, 9980, 7440}, []float64{1, 0.5}},
+	} {
+		t.Run(string(tc.method), func(t *testing.T) {
+			t.Parallel()
+			l, err := Calculate(testFills(), testParams(tc.method))
+			require.NoError(t, err, "Calculate must not error")
+			assert.Equal(t, tc.method, l.Method)
+			require.Len(t, l.Disposals, len(tc.gains))
+			for i := range tc.gains {
+				assert.InDelta(t, tc.gains[i], l.Disposals[i].Gain, 1e-9, "disposal %d gain should be correct", i)
+			}
+			assert.True(t, l.Disposals[0].Fee, "fee paid in BNB should be a fee disposal")
+			assert.True(t, l.Disposals[0].Unmatched, "BNB without lots should be unmatched")
+			assert.Equal(t, "Kraken", l.Disposals[1].Exchange, "lots should be pooled across exchanges")
+			require.Len(t, l.Lots, len(tc.remaining))
+			for i := range tc.remaining {
+				assert.InDelta(t, tc.remaining[i], l.Lots[i].Remaining, 1e-9, "lot %d remaining should be correct", i)
+			}
+		})
+	}
+}
+
+func TestCalculateFees(t *testing.T) {
+	t.Parallel()
+	ethbtc := currency.NewPair(currency.ETH, currency.BTC)
+	l, err := Calculate([]Fill{
+		{Exchange: "Binance", OrderID: "1", Pair: currency.NewPair(currency.BTC, currency.USDT), Side: order.Buy, Price: 10000, Amount: 1, Fee: 0.01, FeeCurrency: currency.BTC, Time: testStart},
+		{Exchange: "Binance", OrderID: "2", Pair: ethbtc, Side: order.Buy, Price: 0.1, Amount: 1, Fee: 0.001, FeeCurrency: currency.ETH, Time: testStart.Add(time.Hour)},
+		{Exchange: "Binance", OrderID: "3", Pair: ethbtc, Side: order.Sell, Price: 0.1, Amount: 0.5, Fee: 0.001, FeeCurrency: currency.ETH, Time: testStart.Add(time.Hour)},
+	}, testParams(FIFO))
+	require.NoError(t, err, "Calculate must not error")
+	require.Len(t, l.Lots, 3)
+	assert.Equal(t, 0.99, l.Lots[0].Amount, "fee in the received currency should reduce the amount acquired")
+	assert.Equal(t, 10000.0, l.Lots[0].Cost, "fee should be included in the cost of an amount bought with cash")
+	assert.InDelta(t, 0.89, l.Lots[0].Remaining, 1e-9)
+	assert.Equal(t, "3", l.Lots[1].OrderID)
+	assert.InDelta(t, 1000, l.Lots[1].Cost, 1e-9, "fee in the given currency should not change the cost of the currency received")
+	assert.InDelta(t, 0.999, l.Lots[2].Amount, 1e-9)
+	assert.InDelta(t, 1998, l.Lots[2].Cost, 1e-9, "fee in the received currency should reduce the cost of a crypto acquisition")
+	assert.InDelta(t, 0.498, l.Lots[2].Remaining, 1e-9)
+
+	require.Len(t, l.Disposals, 2)
+	btc := l.Disposals[0]
+	assert.True(t, btc.Currency.Equal(currency.BTC))
+	assert.InDelta(t, 0.1, btc.Amount, 1e-9)
+	assert.InDelta(t, 1998, btc.Proceeds, 1e-9, "fee should reduce the proceeds of the currency given")
+	assert.InDelta(t, 1010.101010101, btc.CostBasis, 1e-6)
+	eth := l.Disposals[1]
+	assert.InDelta(t, 0.501, eth.Amount, 1e-9, "fee in the given currency should increase the amount disposed of")
+	assert.InDelta(t, 1000, eth.Proceeds, 1e-9)
+	assert.InDelta(t, 1002, eth.CostBasis, 1e-9)
+}
+
+func TestUnrealised(t *testing.T) {
+	t.Parallel()
+	l, err := Calculate(testFills(), testParams(FIFO))
+	require.NoError(t, err, "Calculate must not error")
+	_, err = l.Unrealised(nil, testStart)
+	assert.ErrorIs(t, err, errNilValuer)
+
+	u, err := l.Unrealised(testValuer, testStart.Add(4*time.Hour))
+	require.NoError(t, err, "Unrealised must not error")
+	require.Len(t, u, 2)
+	assert.Equal(t, 50000.0, u[0].Price)
+	assert.Equal(t, 10000.0, u[0].CostBasis)
+	assert.Equal(t, 25000.0, u[0].Value)
+	assert.Equal(t, 15000.0, u[0].Gain)
+	assert.Equal(t, 34900.0, u[1].Gain)
+
+	_, err = l.Unrealised(func(currency.Code, time.Time) (float64, error) { return 0, errors.New("no price") }, testStart)
+	assert.ErrorContains(t, err, "no price")
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+	l, err := Calculate(testFills(), testParams(FIFO))
+	require.NoError(t, err, "Calculate must not error")
+
+	var buf bytes.Buffer
+	s, err := l.Report(&buf, testStart.Add(3*time.Hour), testStart.Add(4*time.Hour))
+	require.NoError(t, err, "Report must not error")
+	assert.Equal(t, 2, s.Disposals, "disposals outside the period must be excluded")
+	assert.Equal(t, 44970.0, s.Proceeds)
+	assert.Equal(t, 20010.0, s.CostBasis)
+	assert.Equal(t, 24960.0, s.Gain)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 4)
+	assert.Equal(t, reportHeader, records[0])
+	assert.Equal(t, []string{"BTC", "Kraken", "4", "1", "2026-01-01T00:00:00Z", "2026-01-01T03:00:00Z", "29980", "10010", "19970", "trade", "false"}, records[1])
+	assert.Equal(t, []string{"Total", "", "", "", "", "", "44970", "20010", "24960", "", ""}, records[3])
+}
@@ -0,0 +1,124 @@
+package ledger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Cost basis methods
+const (
+	// FIFO disposes of the earliest acquired lots first
+	FIFO Method = "fifo"
+	// LIFO disposes of the latest acquired lots first
+	LIFO Method = "lifo"
+	// HIFO disposes of the lots with the highest unit cost first
+	HIFO Method = "hifo"
+)
+
+// amountTolerance is the remaining amount below which a lot is considered
+// fully disposed of, absorbing float rounding of partial disposals
+const amountTolerance = 1e-12
+
+// Public errors
+var (
+	ErrInvalidMethod = errors.New("invalid cost basis method")
+	ErrInvalidFill   = errors.New("invalid fill")
+)
+
+var (
+	errNilParams           = errors.New("nil ledger params")
+	errNilValuer           = errors.New("nil ledger valuer")
+	errInvalidTaxYearStart = errors.New("invalid tax year start")
+)
+
+// Method is how disposals are matched against the lots acquired
+type Method string
+
+// Valuer returns the value of one unit of a currency in the reporting
+// currency at a point in time
+type Valuer func(c currency.Code, at time.Time) (float64, error)
+
+// Params configures how a ledger is calculated
+type Params struct {
+	Method Method
+	Value  Valuer
+	// IsCash reports currencies that are not tracked as lots, such as fiat
+	// and stablecoins, whose disposal realises no gain. When nil, only fiat
+	// currencies are treated as cash
+	IsCash func(currency.Code) bool
+}
+
+// Fill is a trade executed on an exchange. Fee is charged in FeeCurrency,
+// which defaults to the quote currency when empty
+type Fill struct {
+	Exchange    string
+	Account     string
+	OrderID     string
+	TradeID     string
+	Pair        currency.Pair
+	Side        order.Side
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeCurrency currency.Code
+	Time        time.Time
+}
+
+// Lot is an amount of a currency acquired by a fill. Cost is the value of the
+// whole amount in the reporting currency when acquired, including fees
+type Lot struct {
+	Currency  currency.Code
+	Exchange  string
+	OrderID   string
+	Acquired  time.Time
+	Amount    float64
+	Remaining float64
+	Cost      float64
+}
+
+// Disposal is an amount of a currency disposed of against a single lot. An
+// Unmatched disposal exceeded the lots held, such as holdings acquired before
+// the ledger's first fill, and has a zero cost basis. A Fee disposal is a
+// currency spent paying the fee of a fill in another currency pair
+type Disposal struct {
+	Currency  currency.Code
+	Exchange  string
+	OrderID   string
+	Amount    float64
+	Acquired  time.Time
+	Disposed  time.Time
+	Proceeds  float64
+	CostBasis float64
+	Gain      float64
+	Unmatched bool
+	Fee       bool
+}
+
+// Ledger is the open lots and disposals calculated from fills
+type Ledger struct {
+	Method    Method
+	Lots      []Lot
+	Disposals []Disposal
+}
+
+// UnrealisedLot is an open lot valued at the current price of its currency
+type UnrealisedLot struct {
+	Lot
+	Price     float64
+	CostBasis float64
+	Value     float64
+	Gain      float64
+}
+
+// Summary totals the disposals within a period
+type Summary struct {
+	Start     time.Time
+	End       time.Time
+	Disposals int
+	Proceeds  float64
+	CostBasis float64
+	Gain      float64
+}
@@ -0,0 +1,59 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var reportHeader = []string{"Currency", "Exchange", "Order ID", "Amount", "Acquired", "Disposed", "Proceeds", "Cost Basis", "Gain", "Type", "Unmatched"}
+
+// Report writes the disposals within the period, start inclusive and end
+// exclusive, as CSV followed by a totals row, and returns the totals
+func (l *Ledger) Report(w io.Writer, start, end time.Time) (*Summary, error) {
+	disposals := l.Realised(start, end)
+	s := &Summary{Start: start, End: end, Disposals: len(disposals)}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(reportHeader); err != nil {
+		return nil, err
+	}
+	for i := range disposals {
+		d := &disposals[i]
+		s.Proceeds += d.Proceeds
+		s.CostBasis += d.CostBasis
+		s.Gain += d.Gain
+		var acquired string
+		if !d.Unmatched {
+			acquired = d.Acquired.UTC().Format(time.RFC3339)
+		}
+		kind := "trade"
+		if d.Fee {
+			kind = "fee"
+		}
+		if err := cw.Write([]string{
+			d.Currency.Upper().String(),
+			d.Exchange,
+			d.OrderID,
+			formatFloat(d.Amount),
+			acquired,
+			d.Disposed.UTC().Format(time.RFC3339),
+			formatFloat(d.Proceeds),
+			formatFloat(d.CostBasis),
+			formatFloat(d.Gain),
+			kind,
+			strconv.FormatBool(d.Unmatched),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := cw.Write([]string{"Total", "", "", "", "", "", formatFloat(s.Proceeds), formatFloat(s.CostBasis), formatFloat(s.Gain), "", ""}); err != nil {
+		return nil, err
+	}
+	cw.Flush()
+	return s, cw.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
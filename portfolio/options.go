@@ -0,0 +1,137 @@
+package portfolio
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+)
+
+var (
+	errOptionPositionNotFound = errors.New("option position not found")
+	errZeroOptionPositionSize = errors.New("option position size cannot be zero")
+)
+
+// AddOptionPosition adds an option position to the portfolio or updates its
+// size if it already exists
+func (b *Base) AddOptionPosition(exchangeName string, a asset.Item, pair currency.Pair, size float64) error {
+	if exchangeName == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if !a.IsOptions() {
+		return fmt.Errorf("%w: %v", asset.ErrNotSupported, a)
+	}
+	if _, err := options.ParseContract(pair); err != nil {
+		return err
+	}
+	if size == 0 {
+		return errZeroOptionPositionSize
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if i := b.optionPositionIndex(exchangeName, a, pair); i != -1 {
+		b.OptionPositions[i].Size = size
+		b.OptionPositions[i].Valuation = nil
+		return nil
+	}
+	b.OptionPositions = append(b.OptionPositions, OptionPosition{
+		Exchange: exchangeName,
+		Asset:    a,
+		Pair:     pair,
+		Size:     size,
+	})
+	return nil
+}
+
+// RemoveOptionPosition removes an option position from the portfolio
+func (b *Base) RemoveOptionPosition(exchangeName string, a asset.Item, pair currency.Pair) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	i := b.optionPositionIndex(exchangeName, a, pair)
+	if i == -1 {
+		return fmt.Errorf("%w: %s %s %s", errOptionPositionNotFound, exchangeName, a, pair)
+	}
+	b.OptionPositions = slices.Clip(slices.Delete(b.OptionPositions, i, i+1))
+	return nil
+}
+
+// GetOptionPositions returns a copy of the option positions held in the
+// portfolio
+func (b *Base) GetOptionPositions() []OptionPosition {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	positions := slices.Clone(b.OptionPositions)
+	for i := range positions {
+		if positions[i].Valuation != nil {
+			v := *positions[i].Valuation
+			positions[i].Valuation = &v
+		}
+	}
+	return positions
+}
+
+// UpdateOptionValuation values an option position from the analytics of its
+// contract. The value and Greeks of the contract are scaled by the position
+// size
+func (b *Base) UpdateOptionValuation(exchangeName string, a asset.Item, pair currency.Pair, analytics *options.Analytics) error {
+	if err := common.NilGuard(analytics); err != nil {
+		return err
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	i := b.optionPositionIndex(exchangeName, a, pair)
+	if i == -1 {
+		return fmt.Errorf("%w: %s %s %s", errOptionPositionNotFound, exchangeName, a, pair)
+	}
+	size := b.OptionPositions[i].Size
+	b.OptionPositions[i].Valuation = &OptionValuation{
+		Underlying:        analytics.Contract.Underlying,
+		UnderlyingPrice:   analytics.UnderlyingPrice,
+		ImpliedVolatility: analytics.ImpliedVolatility,
+		Value:             size * analytics.Price,
+		Delta:             size * analytics.Greeks.Delta,
+		Gamma:             size * analytics.Greeks.Gamma,
+		Vega:              size * analytics.Greeks.Vega,
+		Theta:             size * analytics.Greeks.Theta,
+		Updated:           analytics.Timestamp,
+	}
+	return nil
+}
+
+// GetOptionExposures aggregates the valued option positions by underlying
+func (b *Base) GetOptionExposures() []OptionExposure {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	var exposures []OptionExposure
+	for i := range b.OptionPositions {
+		v := b.OptionPositions[i].Valuation
+		if v == nil {
+			continue
+		}
+		j := slices.IndexFunc(exposures, func(e OptionExposure) bool { return e.Underlying.Equal(v.Underlying) })
+		if j == -1 {
+			exposures = append(exposures, OptionExposure{Underlying: v.Underlying})
+			j = len(exposures) - 1
+		}
+		exposures[j].Positions++
+		exposures[j].Value += v.Value
+		exposures[j].Delta += v.Delta
+		exposures[j].Gamma += v.Gamma
+		exposures[j].Vega += v.Vega
+		exposures[j].Theta += v.Theta
+	}
+	slices.SortFunc(exposures, func(a, b OptionExposure) int { return strings.Compare(a.Underlying.String(), b.Underlying.String()) })
+	return exposures
+}
+
+func (b *Base) optionPositionIndex(exchangeName string, a asset.Item, pair currency.Pair) int {
+	return slices.IndexFunc(b.OptionPositions, func(p OptionPosition) bool {
+		return p.Exchange == exchangeName && p.Asset == a && p.Pair.Equal(pair)
+	})
+}
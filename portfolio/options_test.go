@@ -0,0 +1,67 @@
+package portfolio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+)
+
+func TestOptionPositions(t *testing.T) {
+	t.Parallel()
+	b := &Base{}
+	call, err := currency.NewPairFromString("BTC-27DEC30-50000-C")
+	require.NoError(t, err, "NewPairFromString must not error")
+	put, err := currency.NewPairFromString("BTC-27DEC30-40000-P")
+	require.NoError(t, err, "NewPairFromString must not error")
+
+	assert.ErrorIs(t, b.AddOptionPosition("", asset.Options, call, 1), common.ErrExchangeNameNotSet)
+	assert.ErrorIs(t, b.AddOptionPosition("Deribit", asset.Spot, call, 1), asset.ErrNotSupported)
+	assert.ErrorIs(t, b.AddOptionPosition("Deribit", asset.Options, currency.NewBTCUSD(), 1), options.ErrNotOptionContract)
+	assert.ErrorIs(t, b.AddOptionPosition("Deribit", asset.Options, call, 0), errZeroOptionPositionSize)
+
+	require.NoError(t, b.AddOptionPosition("Deribit", asset.Options, call, 1), "AddOptionPosition must not error")
+	require.NoError(t, b.AddOptionPosition("Deribit", asset.Options, call, 2), "AddOptionPosition must not error")
+	require.NoError(t, b.AddOptionPosition("Deribit", asset.Options, put, -3), "AddOptionPosition must not error")
+	require.Len(t, b.GetOptionPositions(), 2, "AddOptionPosition must update an existing position")
+	assert.Equal(t, 2.0, b.GetOptionPositions()[0].Size, "AddOptionPosition should update the size")
+
+	assert.ErrorIs(t, b.UpdateOptionValuation("Deribit", asset.Options, call, nil), common.ErrNilPointer)
+	assert.ErrorIs(t, b.UpdateOptionValuation("Okx", asset.Options, call, &options.Analytics{}), errOptionPositionNotFound)
+
+	greeks := options.Greeks{Delta: 0.5, Gamma: 0.01, Vega: 10, Theta: -5}
+	require.NoError(t, b.UpdateOptionValuation("Deribit", asset.Options, call, &options.Analytics{
+		Contract: options.Contract{Underlying: currency.BTC},
+		Price:    1000,
+		Greeks:   greeks,
+	}), "UpdateOptionValuation must not error")
+	assert.Equal(t, 1, b.GetOptionExposures()[0].Positions, "only valued positions should be aggregated")
+
+	greeks.Delta = -0.2
+	require.NoError(t, b.UpdateOptionValuation("Deribit", asset.Options, put, &options.Analytics{
+		Contract: options.Contract{Underlying: currency.BTC},
+		Price:    400,
+		Greeks:   greeks,
+	}), "UpdateOptionValuation must not error")
+
+	positions := b.GetOptionPositions()
+	require.NotNil(t, positions[0].Valuation, "Valuation must be set")
+	positions[0].Valuation.Value = 0
+	assert.Equal(t, 2000.0, b.GetOptionPositions()[0].Valuation.Value, "GetOptionPositions should return a copy")
+
+	exposures := b.GetOptionExposures()
+	require.Len(t, exposures, 1, "GetOptionExposures must aggregate by underlying")
+	assert.Equal(t, 2, exposures[0].Positions, "Positions should count every valued position")
+	assert.Equal(t, 800.0, exposures[0].Value, "Value should net long and short positions")
+	assert.InDelta(t, 1.6, exposures[0].Delta, 1e-12, "Delta should be scaled by size")
+	assert.Equal(t, -10.0, exposures[0].Vega, "Vega should be scaled by size")
+	assert.Equal(t, exposures, b.GetPortfolioSummary().Options, "GetPortfolioSummary should include option exposures")
+
+	assert.ErrorIs(t, b.RemoveOptionPosition("Deribit", asset.Options, currency.NewBTCUSD()), errOptionPositionNotFound)
+	require.NoError(t, b.RemoveOptionPosition("Deribit", asset.Options, call), "RemoveOptionPosition must not error")
+	assert.Len(t, b.GetOptionPositions(), 1, "RemoveOptionPosition should remove the position")
+}
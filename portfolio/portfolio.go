@@ -214,7 +214,8 @@ func (b *Base) UpdateExchangeAddressBalance(exchangeName string, coinType curren
 	}
 }
 
-// AddAddress adds an address to the portfolio base or updates its balance if it already exists.
+// AddAddress adds an address to the portfolio base or updates its balance if
+// it already exists for the coin type.
 func (b *Base) AddAddress(address, description string, coinType currency.Code, balance float64) error {
 	if address == "" {
 		return common.ErrAddressIsEmptyOrInvalid
@@ -229,20 +230,24 @@ func (b *Base) AddAddress(address, description string, coinType currency.Code, b
 		return nil
 	}
 
-	if !b.AddressExists(address) {
-		b.mtx.Lock()
-		defer b.mtx.Unlock()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
 
-		b.Addresses = append(b.Addresses, Address{
-			Address:     address,
-			CoinType:    coinType,
-			Balance:     balance,
-			Description: description,
-		})
+	// An address may hold several coins, such as a native coin and its tokens
+	idx := slices.IndexFunc(b.Addresses, func(a Address) bool {
+		return a.Address == address && a.CoinType.Equal(coinType)
+	})
+	if idx != -1 {
+		b.Addresses[idx].Balance = balance
 		return nil
 	}
 
-	b.UpdateAddressBalance(address, balance)
+	b.Addresses = append(b.Addresses, Address{
+		Address:     address,
+		CoinType:    coinType,
+		Balance:     balance,
+		Description: description,
+	})
 	return nil
 }
 
@@ -270,7 +275,9 @@ func (b *Base) RemoveAddress(address, description string, coinType currency.Code
 	return nil
 }
 
-// UpdatePortfolio adds to the portfolio addresses by coin type
+// UpdatePortfolio adds to the portfolio addresses by coin type, fetching
+// each balance from the first enabled provider supporting the address and
+// coin type
 func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType currency.Code) error {
 	if slices.ContainsFunc(addresses, func(a string) bool {
 		return a == PersonalAddress || a == ExchangeAddress
@@ -278,41 +285,15 @@ func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType
 		return nil
 	}
 
-	var providerName string
-	var getBalance func(ctx context.Context, address string) (float64, error)
-
-	switch coinType {
-	case currency.ETH:
-		providerName = "Ethplorer"
-		getBalance = b.GetEthereumAddressBalance
-	case currency.XRP:
-		providerName = "XRPScan"
-		getBalance = b.GetRippleAddressBalance
-	case currency.BTC, currency.LTC:
-		providerName = "CryptoID"
-		getBalance = func(ctx context.Context, address string) (float64, error) {
-			return b.GetCryptoIDAddressBalance(ctx, address, coinType)
-		}
-	default:
-		return fmt.Errorf("%w: %s", currency.ErrCurrencyNotSupported, coinType)
-	}
-
-	p, ok := b.Providers.GetProvider(providerName)
-	if !ok {
-		return fmt.Errorf("%w: %s", errProviderNotFound, providerName)
-	}
-
-	if !p.Enabled {
-		return fmt.Errorf("%w: %s", errProviderNotEnabled, providerName)
-	}
-
-	if p.Name == "CryptoID" && (p.APIKey == "" || p.APIKey == defaultAPIKey) {
-		return fmt.Errorf("%w: %s", errProviderAPIKeyNotSet, providerName)
-	}
-
 	var errs error
 	for x := range addresses {
-		balance, err := getBalance(ctx, addresses[x])
+		p, err := b.getBalanceProvider(addresses[x], coinType)
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("error getting balance for %s: %w", addresses[x], err))
+			continue
+		}
+
+		balance, err := p.GetBalance(ctx, addresses[x], coinType)
 		if err != nil {
 			errs = common.AppendError(errs, fmt.Errorf("error getting balance for %s: %w", addresses[x], err))
 			continue
@@ -482,6 +463,7 @@ func (b *Base) GetPortfolioSummary() Summary {
 		}
 	}
 	portfolioOutput.OfflineSummary = offlineSummary
+	portfolioOutput.Options = b.GetOptionExposures()
 	return portfolioOutput
 }
 
@@ -572,6 +554,31 @@ func (b *Base) IsWhiteListed(address string) bool {
 	})
 }
 
+// SetWhiteListedTimes sets the whitelisted time of whitelisted addresses
+// without one, so newly whitelisted addresses can be held before withdrawals
+// are allowed to them
+func (b *Base) SetWhiteListedTimes(t time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for i := range b.Addresses {
+		if b.Addresses[i].WhiteListed && b.Addresses[i].WhiteListedAt.IsZero() {
+			b.Addresses[i].WhiteListedAt = t
+		}
+	}
+}
+
+// GetWhiteListedTime returns when a whitelisted address was first loaded
+func (b *Base) GetWhiteListedTime(address string) (time.Time, bool) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	for i := range b.Addresses {
+		if b.Addresses[i].Address == address && b.Addresses[i].WhiteListed {
+			return b.Addresses[i].WhiteListedAt, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // GetProvider returns a provider by name
 func (p providers) GetProvider(name string) (provider, bool) {
 	for _, provider := range p {
@@ -343,6 +343,29 @@ func TestIsWhiteListed(t *testing.T) {
 	assert.False(t, b.IsWhiteListed(testBTCAddress), "IsWhiteListed should return false for non-whitelisted address")
 }
 
+func TestWhiteListedTimes(t *testing.T) {
+	t.Parallel()
+	existing := time.Now().Add(-time.Hour)
+	b := Base{
+		Addresses: []Address{
+			{Address: core.BitcoinDonationAddress, WhiteListed: true},
+			{Address: testBTCAddress},
+			{Address: testLTCAddress, WhiteListed: true, WhiteListedAt: existing},
+		},
+	}
+	now := time.Now()
+	b.SetWhiteListedTimes(now)
+	got, ok := b.GetWhiteListedTime(core.BitcoinDonationAddress)
+	assert.True(t, ok, "GetWhiteListedTime should find a whitelisted address")
+	assert.Equal(t, now, got, "SetWhiteListedTimes should set the time of whitelisted addresses without one")
+	got, ok = b.GetWhiteListedTime(testLTCAddress)
+	assert.True(t, ok, "GetWhiteListedTime should find a whitelisted address")
+	assert.Equal(t, existing, got, "SetWhiteListedTimes should not change an existing time")
+	_, ok = b.GetWhiteListedTime(testBTCAddress)
+	assert.False(t, ok, "GetWhiteListedTime should not find an address which is not whitelisted")
+	assert.True(t, b.Addresses[1].WhiteListedAt.IsZero(), "SetWhiteListedTimes should not set the time of addresses which are not whitelisted")
+}
+
 func TestStartPortfolioWatcher(t *testing.T) {
 	t.Parallel()
 	b := Base{}
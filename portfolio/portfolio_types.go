@@ -1,22 +1,27 @@
 package portfolio
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"golang.org/x/time/rate"
 )
 
 // Base holds the portfolio base addresses
 type Base struct {
-	Addresses []Address `json:"addresses"`
-	Providers providers `json:"providers,omitzero"`
-	Verbose   bool      `json:"verbose"`
+	Addresses       []Address        `json:"addresses"`
+	OptionPositions []OptionPosition `json:"optionPositions,omitempty"`
+	Providers       providers        `json:"providers,omitzero"`
+	Verbose         bool             `json:"verbose"`
 
 	mtx                 sync.RWMutex
 	cryptoIDLimiter     *rate.Limiter
 	cryptoIDLimiterOnce sync.Once
+	balanceProviders    map[string]AddressBalanceProvider
 }
 
 // Address sub type holding address information for portfolio
@@ -29,6 +34,8 @@ type Address struct {
 	WhiteListed        bool
 	ColdStorage        bool
 	SupportedExchanges string
+	// WhiteListedAt is when the address was first loaded as whitelisted
+	WhiteListedAt time.Time `json:",omitzero"`
 }
 
 // EtherchainBalanceResponse holds JSON incoming and outgoing data for
@@ -132,6 +139,44 @@ type Summary struct {
 	OfflineSummary map[currency.Code][]OfflineCoinSummary         `json:"offline_summary"`
 	Online         []Coin                                         `json:"coins_online"`
 	OnlineSummary  map[string]map[currency.Code]OnlineCoinSummary `json:"online_summary"`
+	Options        []OptionExposure                               `json:"options_exposure,omitempty"`
+}
+
+// OptionPosition holds an options contract held on an exchange
+type OptionPosition struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	// Size is the amount of the underlying covered by the position, negative
+	// when short
+	Size      float64          `json:"size"`
+	Valuation *OptionValuation `json:"-"`
+}
+
+// OptionValuation holds the model value and Greeks of an option position,
+// values are expressed in the quote currency of the underlying
+type OptionValuation struct {
+	Underlying        currency.Code
+	UnderlyingPrice   float64
+	ImpliedVolatility float64
+	Value             float64
+	Delta             float64
+	Gamma             float64
+	Vega              float64
+	Theta             float64
+	Updated           time.Time
+}
+
+// OptionExposure aggregates the valuations of option positions sharing an
+// underlying
+type OptionExposure struct {
+	Underlying currency.Code `json:"underlying"`
+	Positions  int           `json:"positions"`
+	Value      float64       `json:"value"`
+	Delta      float64       `json:"delta"`
+	Gamma      float64       `json:"gamma"`
+	Vega       float64       `json:"vega"`
+	Theta      float64       `json:"theta"`
 }
 
 // XRPScanAccount defines the return type for account data
@@ -171,6 +216,97 @@ type provider struct {
 	Name    string `json:"name"`
 	Enabled bool   `json:"enabled"`
 	APIKey  string `json:"apiKey,omitempty"`
+	// URL overrides the default endpoint of the provider
+	URL string `json:"url,omitempty"`
+	// Tokens are the token contracts whose balances are fetched by the
+	// Ethereum and Tron providers
+	Tokens []Token `json:"tokens,omitempty"`
 }
 
 type providers []provider
+
+// AddressBalanceProvider fetches the on-chain balances of portfolio addresses
+type AddressBalanceProvider interface {
+	// Supports returns whether the provider can fetch the balance of the coin
+	// type held by the address
+	Supports(address string, coinType currency.Code) bool
+	GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error)
+}
+
+// Token is an ERC-20 or TRC-20 token contract
+type Token struct {
+	Currency currency.Code `json:"currency"`
+	Contract string        `json:"contract"`
+	Decimals int32         `json:"decimals"`
+}
+
+type ethplorerProvider struct{ b *Base }
+
+type xrpScanProvider struct{ b *Base }
+
+type cryptoIDProvider struct{ b *Base }
+
+// esploraProvider fetches BTC balances from an Esplora compatible API
+type esploraProvider struct {
+	url     string
+	verbose bool
+}
+
+// solanaProvider fetches SOL balances from a Solana JSON-RPC node
+type solanaProvider struct {
+	url     string
+	verbose bool
+}
+
+// jsonRPCProvider fetches native and token balances from an Ethereum
+// compatible JSON-RPC node
+type jsonRPCProvider struct {
+	url      string
+	headers  map[string]string
+	native   currency.Code
+	decimals int32
+	tokens   []Token
+	// toHex returns the hex account of an address without its prefix
+	toHex   func(string) (string, error)
+	verbose bool
+}
+
+// EsploraAddress holds the address stats of an Esplora compatible API
+type EsploraAddress struct {
+	Address      string          `json:"address"`
+	ChainStats   EsploraTxoStats `json:"chain_stats"`
+	MempoolStats EsploraTxoStats `json:"mempool_stats"`
+}
+
+// EsploraTxoStats holds the funded and spent outputs of an address in sats
+type EsploraTxoStats struct {
+	FundedTxoCount int64 `json:"funded_txo_count"`
+	FundedTxoSum   int64 `json:"funded_txo_sum"`
+	SpentTxoCount  int64 `json:"spent_txo_count"`
+	SpentTxoSum    int64 `json:"spent_txo_sum"`
+	TxCount        int64 `json:"tx_count"`
+}
+
+// SolanaBalance holds the getBalance result of a Solana JSON-RPC node in
+// lamports
+type SolanaBalance struct {
+	Context struct {
+		Slot uint64 `json:"slot"`
+	} `json:"context"`
+	Value uint64 `json:"value"`
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
@@ -0,0 +1,157 @@
+package portfolio
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Attribution groups
+const (
+	AttributeByExchange AttributionGroup = "exchange"
+	AttributeByCurrency AttributionGroup = "currency"
+)
+
+// ErrInvalidAttributionGroup is returned when valuations are attributed by an
+// unknown group
+var ErrInvalidAttributionGroup = errors.New("invalid attribution group")
+
+// AttributionGroup is how changes in the portfolio's value are attributed
+type AttributionGroup string
+
+// Valuation is the value of the portfolio's holdings in a reporting currency
+// at a point in time
+type Valuation struct {
+	ReportingCurrency currency.Code
+	Total             float64
+	Holdings          []ValuedHolding
+	Time              time.Time
+}
+
+// ValuedHolding is the amount of a currency held on an exchange or at a
+// personal address, and its value in the reporting currency
+type ValuedHolding struct {
+	// Source is the exchange name, or PersonalAddress for addresses
+	Source   string
+	Address  string
+	Currency currency.Code
+	Amount   float64
+	Price    float64
+	Value    float64
+}
+
+// EquityPoint is the total value of the portfolio at a point in time
+type EquityPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// DailyPNL is the change in the portfolio's value over a UTC day, from the
+// previous day's closing value, or the day's first valuation on the first day
+type DailyPNL struct {
+	Date       time.Time
+	Open       float64
+	Close      float64
+	PNL        float64
+	PNLPercent float64
+}
+
+// Attribution is the change in value of the holdings of an exchange or
+// currency between the first and last valuations. Deposits and withdrawals
+// are included in the change
+type Attribution struct {
+	Key        string
+	StartValue float64
+	EndValue   float64
+	Change     float64
+	// Contribution is the share of the portfolio's total change
+	Contribution float64
+}
+
+// EquityCurve returns the total value of each valuation, valuations must be
+// ordered by time
+func EquityCurve(valuations []Valuation) []EquityPoint {
+	resp := make([]EquityPoint, len(valuations))
+	for i := range valuations {
+		resp[i] = EquityPoint{Time: valuations[i].Time, Value: valuations[i].Total}
+	}
+	return resp
+}
+
+// DailyPNLs returns the change in value over each UTC day with valuations,
+// valuations must be ordered by time
+func DailyPNLs(valuations []Valuation) []DailyPNL {
+	var resp []DailyPNL
+	for i := range valuations {
+		date := valuations[i].Time.UTC().Truncate(24 * time.Hour)
+		if len(resp) == 0 || !resp[len(resp)-1].Date.Equal(date) {
+			open := valuations[i].Total
+			if len(resp) > 0 {
+				open = resp[len(resp)-1].Close
+			}
+			resp = append(resp, DailyPNL{Date: date, Open: open})
+		}
+		d := &resp[len(resp)-1]
+		d.Close = valuations[i].Total
+		d.PNL = d.Close - d.Open
+		if d.Open != 0 {
+			d.PNLPercent = d.PNL / d.Open * 100
+		}
+	}
+	return resp
+}
+
+// Attribute returns the change in value of each exchange or currency between
+// the first and last valuations, ordered by the largest absolute change
+func Attribute(valuations []Valuation, group AttributionGroup) ([]Attribution, error) {
+	var key func(*ValuedHolding) string
+	switch group {
+	case AttributeByExchange:
+		key = func(h *ValuedHolding) string { return h.Source }
+	case AttributeByCurrency:
+		key = func(h *ValuedHolding) string { return h.Currency.Upper().String() }
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAttributionGroup, group)
+	}
+	if len(valuations) == 0 {
+		return nil, nil
+	}
+	first, last := &valuations[0], &valuations[len(valuations)-1]
+	values := make(map[string]*Attribution)
+	get := func(k string) *Attribution {
+		a, ok := values[k]
+		if !ok {
+			a = &Attribution{Key: k}
+			values[k] = a
+		}
+		return a
+	}
+	for i := range first.Holdings {
+		get(key(&first.Holdings[i])).StartValue += first.Holdings[i].Value
+	}
+	for i := range last.Holdings {
+		get(key(&last.Holdings[i])).EndValue += last.Holdings[i].Value
+	}
+	totalChange := last.Total - first.Total
+	resp := make([]Attribution, 0, len(values))
+	for _, a := range values {
+		a.Change = a.EndValue - a.StartValue
+		if totalChange != 0 {
+			a.Contribution = a.Change / totalChange
+		}
+		resp = append(resp, *a)
+	}
+	slices.SortFunc(resp, func(a, b Attribution) int {
+		if c := cmp.Compare(math.Abs(b.Change), math.Abs(a.Change)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+	return resp, nil
+}
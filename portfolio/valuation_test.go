@@ -0,0 +1,75 @@
+package portfolio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func testValuations() []Valuation {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	return []Valuation{
+		{Time: day.Add(time.Hour), Total: 1000, Holdings: []ValuedHolding{
+			{Source: "Binance", Currency: currency.BTC, Value: 600},
+			{Source: PersonalAddress, Currency: currency.ETH, Value: 400},
+		}},
+		{Time: day.Add(20 * time.Hour), Total: 1100},
+		{Time: day.Add(30 * time.Hour), Total: 1050, Holdings: []ValuedHolding{
+			{Source: "Binance", Currency: currency.BTC, Value: 500},
+			{Source: "Kraken", Currency: currency.BTC, Value: 50},
+			{Source: PersonalAddress, Currency: currency.ETH, Value: 500},
+		}},
+	}
+}
+
+func TestEquityCurve(t *testing.T) {
+	t.Parallel()
+	v := testValuations()
+	curve := EquityCurve(v)
+	require.Len(t, curve, 3)
+	assert.Equal(t, v[2].Time, curve[2].Time)
+	assert.Equal(t, 1050.0, curve[2].Value)
+	assert.Empty(t, EquityCurve(nil))
+}
+
+func TestDailyPNLs(t *testing.T) {
+	t.Parallel()
+	pnl := DailyPNLs(testValuations())
+	require.Len(t, pnl, 2, "valuations must be grouped by UTC day")
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), pnl[0].Date)
+	assert.Equal(t, 1000.0, pnl[0].Open, "first day should open at its first valuation")
+	assert.Equal(t, 1100.0, pnl[0].Close)
+	assert.Equal(t, 100.0, pnl[0].PNL)
+	assert.Equal(t, 10.0, pnl[0].PNLPercent)
+	assert.Equal(t, 1100.0, pnl[1].Open, "later days should open at the previous close")
+	assert.Equal(t, -50.0, pnl[1].PNL)
+}
+
+func TestAttribute(t *testing.T) {
+	t.Parallel()
+	_, err := Attribute(testValuations(), "chain")
+	assert.ErrorIs(t, err, ErrInvalidAttributionGroup)
+
+	resp, err := Attribute(nil, AttributeByExchange)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+
+	resp, err = Attribute(testValuations(), AttributeByExchange)
+	require.NoError(t, err)
+	require.Len(t, resp, 3)
+	assert.Equal(t, Attribution{Key: "Binance", StartValue: 600, EndValue: 500, Change: -100, Contribution: -2}, resp[0])
+	assert.Equal(t, PersonalAddress, resp[1].Key)
+	assert.Equal(t, "Kraken", resp[2].Key)
+	assert.Equal(t, 50.0, resp[2].Change)
+
+	resp, err = Attribute(testValuations(), AttributeByCurrency)
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, "ETH", resp[0].Key)
+	assert.Equal(t, 100.0, resp[0].Change)
+	assert.Equal(t, "BTC", resp[1].Key)
+	assert.Equal(t, -50.0, resp[1].Change)
+}
@@ -146,4 +146,6 @@ type ExchangeResponse struct {
 	UUID   uuid.UUID
 	ID     string `json:"id"`
 	Status string `json:"status"`
+	// TxID is the transaction hash of a crypto withdrawal once broadcast
+	TxID string `json:"tx_id"`
 }